@@ -0,0 +1,101 @@
+package api
+
+import "github.com/idena-network/idena-go/rpc"
+
+// GraphQLMethod is the method name which GraphQL queries are authorized and limited as
+const GraphQLMethod = "graphql_query"
+
+// MethodAccess defines access levels required by methods which change the node or the chain, the rest of methods
+// require the read access
+var MethodAccess = map[string]rpc.AccessLevel{
+	// txs signed by the node key and other data signed on behalf of the node
+	"dna_sendInvite":                        rpc.AccessSign,
+	"dna_activateInvite":                    rpc.AccessSign,
+	"dna_activateInviteToRandAddr":          rpc.AccessSign,
+	"dna_becomeOnline":                      rpc.AccessSign,
+	"dna_becomeOffline":                     rpc.AccessSign,
+	"dna_delegate":                          rpc.AccessSign,
+	"dna_undelegate":                        rpc.AccessSign,
+	"dna_killDelegator":                     rpc.AccessSign,
+	"dna_storeToIpfs":                       rpc.AccessSign,
+	"dna_lockStake":                         rpc.AccessSign,
+	"dna_unlockStake":                       rpc.AccessSign,
+	"dna_changeBlockGasLimit":               rpc.AccessSign,
+	"dna_changeProposerThreshold":           rpc.AccessSign,
+	"dna_changeWordsDictionary":             rpc.AccessSign,
+	"dna_registerBlsKey":                    rpc.AccessSign,
+	"dna_authorizeAnswersSubmitter":         rpc.AccessSign,
+	"dna_sendTransaction":                   rpc.AccessSign,
+	"dna_scheduleTransaction":               rpc.AccessSign,
+	"dna_cancelScheduledTransaction":        rpc.AccessSign,
+	"dna_burn":                              rpc.AccessSign,
+	"dna_changeProfile":                     rpc.AccessSign,
+	"dna_sign":                              rpc.AccessSign,
+	"flip_rawSubmit":                        rpc.AccessSign,
+	"flip_submit":                           rpc.AccessSign,
+	"flip_delete":                           rpc.AccessSign,
+	"flip_sendPublicEncryptionKey":          rpc.AccessSign,
+	"flip_sendPrivateEncryptionKeysPackage": rpc.AccessSign,
+	"flip_submitShortAnswers":               rpc.AccessSign,
+	"flip_submitLongAnswers":                rpc.AccessSign,
+	"flip_prepareDelegatedAnswers":          rpc.AccessSign,
+	"flip_submitDelegatedAnswers":           rpc.AccessSign,
+	"flip_submitAnswerPartial":              rpc.AccessSign,
+	"contract_deploy":                       rpc.AccessSign,
+	"contract_call":                         rpc.AccessSign,
+	"contract_terminate":                    rpc.AccessSign,
+	"bcn_sendMulti":                         rpc.AccessSign,
+	// txs signed by clients
+	"bcn_sendRawTx":      rpc.AccessSign,
+	"bcn_sendBatch":      rpc.AccessSign,
+	"bcn_submitSignedTx": rpc.AccessSign,
+	"ipfs_add":           rpc.AccessSign,
+
+	// keys, peers and node settings
+	"dna_exportKey":                 rpc.AccessAdmin,
+	"bcn_simulateProposal":          rpc.AccessAdmin,
+	"dna_importKey":                 rpc.AccessAdmin,
+	"account_*":                     rpc.AccessAdmin,
+	"net_addPeer":                   rpc.AccessAdmin,
+	"net_setPeerScore":              rpc.AccessAdmin,
+	"net_banPeer":                   rpc.AccessAdmin,
+	"net_unbanPeer":                 rpc.AccessAdmin,
+	"contract_subscribeToEvent":     rpc.AccessAdmin,
+	"contract_unsubscribeFromEvent": rpc.AccessAdmin,
+	"watch_add":                     rpc.AccessAdmin,
+	"watch_remove":                  rpc.AccessAdmin,
+	"debug_*":                       rpc.AccessAdmin,
+	// admin methods are authorized by the admin key only
+	"admin_*": rpc.AccessAdmin,
+}
+
+// GrpcMethods maps methods of the gRPC service to the RPC methods which they are authorized and limited as
+var GrpcMethods = map[string]string{
+	"/models.IdenaApi/LastBlock":               "bcn_lastBlock",
+	"/models.IdenaApi/Block":                   "bcn_block",
+	"/models.IdenaApi/Transaction":             "bcn_transaction",
+	"/models.IdenaApi/SendRawTransaction":      "bcn_sendRawTx",
+	"/models.IdenaApi/Identity":                "dna_identity",
+	"/models.IdenaApi/Epoch":                   "dna_epoch",
+	"/models.IdenaApi/SubscribeBlocks":         "bcn_subscribe",
+	"/models.IdenaApi/SubscribeCeremonyEvents": "dna_subscribe",
+}
+
+// ExpensiveMethods are methods which scan the chain or execute contracts, their calls are limited
+// by the expensive methods quota of the RPC config
+var ExpensiveMethods = []string{
+	GraphQLMethod,
+	"bcn_transactions",
+	"bcn_balanceChanges",
+	"bcn_traceBlock",
+	"bcn_traceTx",
+	"bcn_simulateProposal",
+	"dna_auditFlipLottery",
+	"oracle_activeVotings",
+	"contract_events",
+	"contract_getLogs",
+	"contract_estimateDeploy",
+	"contract_estimateCall",
+	"contract_estimateTerminate",
+	"contract_iterateMap",
+}
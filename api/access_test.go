@@ -0,0 +1,25 @@
+package api
+
+import (
+	"fmt"
+	"testing"
+
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGrpcMethods(t *testing.T) {
+	desc := models.IdenaApi_ServiceDesc
+	var methods []string
+	for _, m := range desc.Methods {
+		methods = append(methods, m.MethodName)
+	}
+	for _, s := range desc.Streams {
+		methods = append(methods, s.StreamName)
+	}
+	for _, m := range methods {
+		_, ok := GrpcMethods[fmt.Sprintf("/%s/%s", desc.ServiceName, m)]
+		require.True(t, ok, "gRPC method %s is not authorized", m)
+	}
+	require.Len(t, GrpcMethods, len(methods))
+}
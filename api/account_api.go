@@ -1,7 +1,9 @@
 package api
 
 import (
+	"encoding/hex"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/keystore"
 	"time"
 )
@@ -17,17 +19,67 @@ func NewAccountApi(baseApi *BaseApi) *AccountApi {
 	}
 }
 
-func (api *AccountApi) List() []common.Address {
-	list := make([]common.Address, 0)
-	for _, item := range api.baseApi.ks.Accounts() {
-		list = append(list, item.Address)
+type AccountInfo struct {
+	Address common.Address `json:"address"`
+	Name    string         `json:"name"`
+	// Selected is true for the account which signs txs when the from address isn't set explicitly
+	Selected bool `json:"selected"`
+}
+
+func (api *AccountApi) List() []AccountInfo {
+	ks := api.baseApi.ks
+	selected := ks.Selected()
+	list := make([]AccountInfo, 0)
+	for _, item := range ks.Accounts() {
+		list = append(list, AccountInfo{
+			Address:  item.Address,
+			Name:     ks.Name(item.Address),
+			Selected: selected != nil && *selected == item.Address,
+		})
 	}
 	return list
 }
 
-func (api *AccountApi) Create(passPhrase string) (common.Address, error) {
+func (api *AccountApi) Create(passPhrase string, name *string) (common.Address, error) {
 	account, err := api.baseApi.ks.NewAccount(passPhrase)
-	return account.Address, err
+	if err != nil {
+		return common.Address{}, err
+	}
+	return account.Address, api.setName(account.Address, name)
+}
+
+// Import adds the hex encoded private key to the keystore encrypting it by the passphrase
+func (api *AccountApi) Import(key string, passPhrase string, name *string) (common.Address, error) {
+	b, err := hex.DecodeString(key)
+	if err != nil {
+		return common.Address{}, err
+	}
+	ecdsaKey, err := crypto.ToECDSA(b)
+	if err != nil {
+		return common.Address{}, err
+	}
+	account, err := api.baseApi.ks.ImportECDSA(ecdsaKey, passPhrase)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return account.Address, api.setName(account.Address, name)
+}
+
+// Select makes the account sign txs by default instead of the node key, nil restores the node key
+func (api *AccountApi) Select(addr *common.Address) error {
+	return api.baseApi.ks.Select(addr)
+}
+
+// SetName names the account, an empty name removes the current one
+func (api *AccountApi) SetName(addr common.Address, name string) error {
+	return api.baseApi.ks.SetName(addr, name)
+}
+
+func (api *AccountApi) setName(addr common.Address, name *string) error {
+	if name == nil || *name == "" {
+		return nil
+	}
+	return api.baseApi.ks.SetName(addr, *name)
 }
 
 func (api *AccountApi) Unlock(addr common.Address, passPhrase string, timeout time.Duration) error {
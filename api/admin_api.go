@@ -0,0 +1,73 @@
+package api
+
+import (
+	"errors"
+
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/protocol"
+)
+
+// NodeController is the part of the node controlled by admin methods
+type NodeController interface {
+	// CompactDatabase starts the compaction of the chain database in the background
+	CompactDatabase() error
+	// RotateApiKey replaces the API key by a random one and returns it
+	RotateApiKey() (string, error)
+	// Shutdown stops the node gracefully after the response is sent
+	Shutdown()
+}
+
+// AdminApi controls the node at runtime, its methods are authorized by the admin key only
+type AdminApi struct {
+	pm   *protocol.IdenaGossipHandler
+	node NodeController
+}
+
+func NewAdminApi(pm *protocol.IdenaGossipHandler, node NodeController) *AdminApi {
+	return &AdminApi{pm, node}
+}
+
+// AddPeer connects to the peer by multiaddr
+func (api *AdminApi) AddPeer(url string) error {
+	return api.pm.AddPeer(url)
+}
+
+// RemovePeer disconnects the peer by id
+func (api *AdminApi) RemovePeer(id string) error {
+	return api.pm.RemovePeer(id)
+}
+
+// SetLogLevel sets the verbosity of the node log (crit, error, warn, info, debug or trace), vmodule raises
+// the verbosity of the given packages and files (e.g. "protocol/*=5")
+func (api *AdminApi) SetLogLevel(level string, vmodule *string) error {
+	lvl, err := log.LvlFromString(level)
+	if err != nil {
+		return err
+	}
+	glogger, ok := log.Root().GetHandler().(*log.GlogHandler)
+	if !ok {
+		return errors.New("log level can't be changed at runtime")
+	}
+	if vmodule != nil {
+		if err := glogger.Vmodule(*vmodule); err != nil {
+			return err
+		}
+	}
+	glogger.Verbosity(lvl)
+	return nil
+}
+
+// CompactDatabase starts the compaction of the chain database in the background
+func (api *AdminApi) CompactDatabase() error {
+	return api.node.CompactDatabase()
+}
+
+// RotateApiKey replaces the API key by a random one, the new key is returned and saved to the data dir
+func (api *AdminApi) RotateApiKey() (string, error) {
+	return api.node.RotateApiKey()
+}
+
+// Shutdown stops the node gracefully
+func (api *AdminApi) Shutdown() {
+	api.node.Shutdown()
+}
@@ -10,10 +10,12 @@ import (
 	"github.com/idena-network/idena-go/consensus"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/keystore"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/secstore"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"math/big"
 )
@@ -29,6 +31,8 @@ type BaseApi struct {
 type BaseTxArgs struct {
 	Nonce uint32 `json:"nonce"`
 	Epoch uint16 `json:"epoch"`
+	// From is the keystore account which signs the tx, the selected account or the node key is used if it is not set
+	From *common.Address `json:"from,omitempty"`
 }
 
 func NewBaseApi(engine *consensus.Engine, txpool *mempool.TxPool, ks *keystore.KeyStore, secStore *secstore.SecStore, ipfs ipfs.Proxy) *BaseApi {
@@ -43,6 +47,16 @@ func (api *BaseApi) getReadonlyAppState() *appstate.AppState {
 	return state
 }
 
+// stateAt returns the readonly state of the given height, an error is returned if the state of the height
+// is not available anymore
+func (api *BaseApi) stateAt(height uint64) (*state.StateDB, error) {
+	s, err := api.getReadonlyAppState().State.Readonly(int64(height))
+	if err != nil {
+		return nil, errors.Wrapf(err, "state of block %v is not available", height)
+	}
+	return s, nil
+}
+
 func (api *BaseApi) getAppStateForCheck() *appstate.AppState {
 	state, err := api.engine.AppStateForCheck()
 	if err != nil {
@@ -55,6 +69,18 @@ func (api *BaseApi) getCurrentCoinbase() common.Address {
 	return api.secStore.GetAddress()
 }
 
+// getSigner returns the account which signs txs on behalf of the caller: the explicit one, the account selected
+// in the keystore or the node key
+func (api *BaseApi) getSigner(from *common.Address) common.Address {
+	if from != nil && !from.IsEmpty() {
+		return *from
+	}
+	if selected := api.ks.Selected(); selected != nil {
+		return *selected
+	}
+	return api.getCurrentCoinbase()
+}
+
 func (api *BaseApi) getTx(from common.Address, to *common.Address, txType types.TxType, amount decimal.Decimal,
 	maxFee decimal.Decimal, tips decimal.Decimal, nonce uint32, epoch uint16, payload []byte) *types.Transaction {
 
@@ -72,6 +98,14 @@ func (api *BaseApi) getTx(from common.Address, to *common.Address, txType types.
 	return tx
 }
 
+// getExpiryHeight returns explicit expiry height if set, otherwise expiry height calculated from ttl, 0 means no expiry
+func (api *BaseApi) getExpiryHeight(expiryHeight uint64, ttl uint64) uint64 {
+	if expiryHeight > 0 || ttl == 0 {
+		return expiryHeight
+	}
+	return uint64(api.getReadonlyAppState().State.Version()) + ttl
+}
+
 func (api *BaseApi) getSignedTx(from common.Address, to *common.Address, txType types.TxType, amount decimal.Decimal,
 	maxFee decimal.Decimal, tips decimal.Decimal, nonce uint32, epoch uint16, payload []byte,
 	key *ecdsa.PrivateKey) (*types.Transaction, error) {
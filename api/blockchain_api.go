@@ -3,45 +3,76 @@ package api
 import (
 	"context"
 	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/keywords"
+	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/protocol"
 	"github.com/idena-network/idena-go/rlp"
+	"github.com/idena-network/idena-go/rpc"
 	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"math"
 	"math/big"
 	"sort"
 )
 
+const (
+	pendingTxsSubscriptionBuffer = 256
+	chainSubscriptionBuffer      = 64
+
+	feeEstimationBlocks = 20
+)
+
+var (
+	defaultFeePercentiles = []int{50, 90, 99}
+)
+
 var (
 	txTypeMap = map[types.TxType]string{
-		types.SendTx:               "send",
-		types.ActivationTx:         "activation",
-		types.InviteTx:             "invite",
-		types.KillTx:               "kill",
-		types.KillInviteeTx:        "killInvitee",
-		types.SubmitFlipTx:         "submitFlip",
-		types.SubmitAnswersHashTx:  "submitAnswersHash",
-		types.SubmitShortAnswersTx: "submitShortAnswers",
-		types.SubmitLongAnswersTx:  "submitLongAnswers",
-		types.EvidenceTx:           "evidence",
-		types.OnlineStatusTx:       "online",
-		types.ChangeGodAddressTx:   "changeGodAddress",
-		types.BurnTx:               "burn",
-		types.ChangeProfileTx:      "changeProfile",
-		types.DeleteFlipTx:         "deleteFlip",
-		types.DeployContractTx:     "deployContract",
-		types.CallContractTx:       "callContract",
-		types.TerminateContractTx:  "terminateContract",
-		types.DelegateTx:           "delegate",
-		types.UndelegateTx:         "undelegate",
-		types.KillDelegatorTx:      "killDelegator",
-		types.StoreToIpfsTx:        "storeToIpfs",
+		types.SendTx:                      "send",
+		types.ActivationTx:                "activation",
+		types.InviteTx:                    "invite",
+		types.KillTx:                      "kill",
+		types.KillInviteeTx:               "killInvitee",
+		types.SubmitFlipTx:                "submitFlip",
+		types.SubmitAnswersHashTx:         "submitAnswersHash",
+		types.SubmitShortAnswersTx:        "submitShortAnswers",
+		types.SubmitLongAnswersTx:         "submitLongAnswers",
+		types.EvidenceTx:                  "evidence",
+		types.OnlineStatusTx:              "online",
+		types.ChangeGodAddressTx:          "changeGodAddress",
+		types.BurnTx:                      "burn",
+		types.ChangeProfileTx:             "changeProfile",
+		types.DeleteFlipTx:                "deleteFlip",
+		types.DeployContractTx:            "deployContract",
+		types.CallContractTx:              "callContract",
+		types.TerminateContractTx:         "terminateContract",
+		types.DelegateTx:                  "delegate",
+		types.UndelegateTx:                "undelegate",
+		types.KillDelegatorTx:             "killDelegator",
+		types.StoreToIpfsTx:               "storeToIpfs",
+		types.LockStakeTx:                 "lockStake",
+		types.UnlockStakeTx:               "unlockStake",
+		types.ChangeBlockGasLimitTx:       "changeBlockGasLimit",
+		types.MisbehaviorEvidenceTx:       "misbehaviorEvidence",
+		types.RegisterBlsKeyTx:            "registerBlsKey",
+		types.ChangeProposerThresholdTx:   "changeProposerThreshold",
+		types.AuthorizeAnswersSubmitterTx: "authorizeAnswersSubmitter",
+		types.ChangeWordsDictionaryTx:     "changeWordsDictionary",
+		types.MultiSendTx:                 "multiSend",
 	}
 )
 
@@ -52,10 +83,12 @@ type BlockchainApi struct {
 	pool    *mempool.TxPool
 	d       *protocol.Downloader
 	pm      *protocol.IdenaGossipHandler
+	bus     eventbus.Bus
+	md      *blockchain.MisbehaviorDetector
 }
 
-func NewBlockchainApi(baseApi *BaseApi, bc *blockchain.Blockchain, ipfs ipfs.Proxy, pool *mempool.TxPool, d *protocol.Downloader, pm *protocol.IdenaGossipHandler) *BlockchainApi {
-	return &BlockchainApi{bc, baseApi, ipfs, pool, d, pm}
+func NewBlockchainApi(baseApi *BaseApi, bc *blockchain.Blockchain, ipfs ipfs.Proxy, pool *mempool.TxPool, d *protocol.Downloader, pm *protocol.IdenaGossipHandler, bus eventbus.Bus, md *blockchain.MisbehaviorDetector) *BlockchainApi {
+	return &BlockchainApi{bc, baseApi, ipfs, pool, d, pm, bus, md}
 }
 
 type Block struct {
@@ -71,6 +104,7 @@ type Block struct {
 	Flags        []string        `json:"flags"`
 	IsEmpty      bool            `json:"isEmpty"`
 	OfflineAddr  *common.Address `json:"offlineAddress"`
+	GasLimit     uint64          `json:"gasLimit"`
 }
 
 type Transaction struct {
@@ -87,6 +121,15 @@ type Transaction struct {
 	BlockHash common.Hash     `json:"blockHash"`
 	UsedFee   decimal.Decimal `json:"usedFee"`
 	Timestamp int64           `json:"timestamp"`
+	// ExpiryHeight is the last block height the tx can be included at, 0 if the tx never expires
+	ExpiryHeight uint64 `json:"expiryHeight,omitempty"`
+	// Payments are recipients and amounts of the multi send tx
+	Payments []*Payment `json:"payments,omitempty"`
+}
+
+type Payment struct {
+	To     common.Address  `json:"to"`
+	Amount decimal.Decimal `json:"amount"`
 }
 
 type BurntCoins struct {
@@ -105,6 +148,24 @@ func (api *BlockchainApi) BlockAt(height uint64) *Block {
 	return convertToBlock(block)
 }
 
+// BlockAtTime returns the last block with timestamp not greater than the given unix timestamp
+func (api *BlockchainApi) BlockAtTime(timestamp int64) *Block {
+	header := api.bc.BlockAtTime(timestamp)
+	if header == nil {
+		return nil
+	}
+	return api.Block(header.Hash())
+}
+
+// FinalBlock returns the latest finality checkpoint, the block and all blocks below it are final
+func (api *BlockchainApi) FinalBlock() *Block {
+	header := api.bc.FinalBlock()
+	if header == nil {
+		return nil
+	}
+	return api.Block(header.Hash())
+}
+
 func (api *BlockchainApi) Block(hash common.Hash) *Block {
 	block := api.bc.GetBlock(hash)
 
@@ -157,19 +218,53 @@ func (api *BlockchainApi) TxReceipt(hash common.Hash) *TxReceipt {
 		idx = api.bc.GetTxIndex(hash)
 	}
 
-	var blockHash common.Hash
+	// pending txs have no receipts
+	if idx == nil {
+		return nil
+	}
+
+	blockHash := idx.BlockHash
 	var feePerGas *big.Int
-	if idx != nil {
-		blockHash = idx.BlockHash
-		block := api.bc.GetBlock(blockHash)
-		if block != nil {
-			feePerGas = block.Header.FeePerGas()
-		}
+	if block := api.bc.GetBlock(blockHash); block != nil {
+		feePerGas = block.Header.FeePerGas()
 	}
 
 	receipt := api.bc.GetReceipt(hash)
+	if receipt == nil {
+		if tx.Type == types.DeployContractTx || tx.Type == types.CallContractTx || tx.Type == types.TerminateContractTx {
+			return nil
+		}
+		// txs mined before receipts were generated for all txs are applied unconditionally
+		sender, _ := types.Sender(tx)
+		receipt = &types.TxReceipt{
+			Success: true,
+			From:    sender,
+			TxHash:  hash,
+		}
+	}
 
-	return convertReceipt(tx, receipt, feePerGas)
+	result := convertReceipt(tx, receipt, feePerGas)
+	result.BlockHash = &blockHash
+	if receipt.Success {
+		result.Status = "applied"
+	} else {
+		result.Status = "failed"
+	}
+	if receipt.FeeBurned != nil {
+		feeBurned := blockchain.ConvertToFloat(receipt.FeeBurned)
+		result.FeeBurned = &feeBurned
+	}
+	for _, event := range receipt.Events {
+		e := &Event{
+			Contract: receipt.ContractAddress,
+			Event:    event.EventName,
+		}
+		for _, arg := range event.Data {
+			e.Args = append(e.Args, arg)
+		}
+		result.Events = append(result.Events, e)
+	}
+	return result
 }
 
 func (api *BlockchainApi) Mempool() []common.Hash {
@@ -183,12 +278,154 @@ func (api *BlockchainApi) Mempool() []common.Hash {
 	return txs
 }
 
+// MempoolLanes returns sizes and limits of mempool lanes, ceremony txs are kept in a separate lane
+func (api *BlockchainApi) MempoolLanes() []mempool.LaneInfo {
+	return api.pool.Lanes()
+}
+
+// NewPendingTransactions notifies the subscriber about txs added to the mempool, tx hashes are sent by default
+// and full txs if fullTx is set. Notifications are dropped for subscribers which can't keep up.
+func (api *BlockchainApi) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	full := fullTx != nil && *fullTx
+	rpcSub := notifier.CreateSubscription()
+
+	txs := make(chan *types.Transaction, pendingTxsSubscriptionBuffer)
+	busSub := api.bus.Subscribe(events.NewTxEventID, func(e eventbus.Event) {
+		newTxEvent := e.(*events.NewTxEvent)
+		if newTxEvent.Deferred {
+			return
+		}
+		select {
+		case txs <- newTxEvent.Tx:
+		default:
+		}
+	})
+
+	go func() {
+		defer api.bus.Unsubscribe(busSub)
+		for {
+			select {
+			case tx := <-txs:
+				if full {
+					notifier.Notify(rpcSub.ID, convertToTransaction(tx, common.Hash{}, nil, 0))
+				} else {
+					notifier.Notify(rpcSub.ID, tx.Hash())
+				}
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// NewHeads notifies the subscriber about new canonical heads, the resulting head of a reorg is sent once
+func (api *BlockchainApi) NewHeads(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	blocks := make(chan *types.Block, chainSubscriptionBuffer)
+	busSub := api.bus.Subscribe(events.ChainHeadEventID, func(e eventbus.Event) {
+		select {
+		case blocks <- e.(*events.ChainHeadEvent).Block:
+		default:
+		}
+	})
+
+	go func() {
+		defer api.bus.Unsubscribe(busSub)
+		for {
+			select {
+			case block := <-blocks:
+				notifier.Notify(rpcSub.ID, convertToBlock(block))
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+type Reorg struct {
+	CommonHeight uint64        `json:"commonHeight"`
+	Reverted     []common.Hash `json:"reverted"`
+	Applied      []common.Hash `json:"applied"`
+}
+
+// Blocks notifies the subscriber about every canonical block, the subscription is resumed from the block next
+// to the token of the last received notification if it is set
+func (api *BlockchainApi) Blocks(ctx context.Context, token *ChainToken) (*rpc.Subscription, error) {
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		return []interface{}{convertToBlock(block)}, nil
+	})
+}
+
+// Reorgs notifies the subscriber about blocks removed from the canonical chain and blocks applied instead of them
+func (api *BlockchainApi) Reorgs(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	reorgs := make(chan *Reorg, chainSubscriptionBuffer)
+	busSub := api.bus.Subscribe(events.ReorgEventID, func(e eventbus.Event) {
+		reorgEvent := e.(*events.ReorgEvent)
+		reorg := &Reorg{
+			CommonHeight: reorgEvent.CommonHeight,
+		}
+		for _, block := range reorgEvent.Reverted {
+			reorg.Reverted = append(reorg.Reverted, block.Hash())
+		}
+		for _, block := range reorgEvent.Applied {
+			reorg.Applied = append(reorg.Applied, block.Hash())
+		}
+		select {
+		case reorgs <- reorg:
+		default:
+		}
+	})
+
+	go func() {
+		defer api.bus.Unsubscribe(busSub)
+		for {
+			select {
+			case reorg := <-reorgs:
+				notifier.Notify(rpcSub.ID, reorg)
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+		}
+	}()
+	return rpcSub, nil
+}
+
+// MempoolSpamScore returns the spam score of the sender, senders above the threshold are rate limited
+func (api *BlockchainApi) MempoolSpamScore(address common.Address) float64 {
+	return api.pool.SpamScore(address)
+}
+
 type Syncing struct {
 	Syncing      bool   `json:"syncing"`
 	CurrentBlock uint64 `json:"currentBlock"`
 	HighestBlock uint64 `json:"highestBlock"`
 	WrongTime    bool   `json:"wrongTime"`
 	GenesisBlock uint64 `json:"genesisBlock"`
+	// LastResync is the unix time of the last recovery resync triggered by stale head, zero if there were none
+	LastResync int64 `json:"lastResync,omitempty"`
 }
 
 func (api *BlockchainApi) Syncing() Syncing {
@@ -201,19 +438,28 @@ func (api *BlockchainApi) Syncing() Syncing {
 	if !isSyncing {
 		highest = current
 	}
-	return Syncing{
+	result := Syncing{
 		Syncing:      isSyncing,
 		GenesisBlock: api.bc.GenesisInfo().Genesis.Height(),
 		CurrentBlock: current,
 		HighestBlock: highest,
 		WrongTime:    api.pm.WrongTime(),
 	}
+	if lastRecovery := api.d.LastRecovery(); !lastRecovery.IsZero() {
+		result.LastResync = lastRecovery.Unix()
+	}
+	return result
 }
 
 type TransactionsArgs struct {
 	Address common.Address `json:"address"`
 	Count   int            `json:"count"`
 	Token   hexutil.Bytes  `json:"token"`
+	// Types restricts the history to txs of the given types
+	Types []types.TxType `json:"types,omitempty"`
+	// FromTime and ToTime restrict the history to txs of blocks with timestamps in the range, both are inclusive
+	FromTime *int64 `json:"fromTime,omitempty"`
+	ToTime   *int64 `json:"toTime,omitempty"`
 }
 
 type Transactions struct {
@@ -250,6 +496,233 @@ func (api *BlockchainApi) FeePerGas() *big.Int {
 	return api.baseApi.getReadonlyAppState().State.FeePerGas()
 }
 
+// BlockGasLimit returns the gas limit of the next block
+func (api *BlockchainApi) BlockGasLimit() uint64 {
+	return api.baseApi.getReadonlyAppState().State.BlockGasLimit()
+}
+
+type ProposalSimulation struct {
+	*DryRunBlock
+	IsProposer bool         `json:"isProposer"`
+	Receipts   []*TxReceipt `json:"receipts"`
+	Valid      bool         `json:"valid"`
+	Error      string       `json:"error,omitempty"`
+}
+
+// SimulateProposal builds the block the node would propose right now and validates it the way other nodes do,
+// the block is neither signed nor broadcast
+func (api *BlockchainApi) SimulateProposal() (*ProposalSimulation, error) {
+	simulation := api.bc.SimulateProposal()
+	block, err := convertToDryRunBlock(simulation.BlockBuildResult)
+	if err != nil {
+		return nil, err
+	}
+	result := &ProposalSimulation{
+		DryRunBlock: block,
+		IsProposer:  simulation.IsProposer,
+		Valid:       simulation.ValidationError == nil,
+	}
+	if simulation.ValidationError != nil {
+		result.Error = simulation.ValidationError.Error()
+	}
+	txs := make(map[common.Hash]*types.Transaction, len(simulation.Block.Body.Transactions))
+	for _, tx := range simulation.Block.Body.Transactions {
+		txs[tx.Hash()] = tx
+	}
+	feePerGas := simulation.Block.Header.FeePerGas()
+	for _, receipt := range simulation.Receipts {
+		if tx, ok := txs[receipt.TxHash]; ok {
+			result.Receipts = append(result.Receipts, convertReceipt(tx, receipt, feePerGas))
+		}
+	}
+	return result, nil
+}
+
+type RejectedBlock struct {
+	Block        *Block      `json:"block"`
+	Error        string      `json:"error"`
+	Timestamp    int64       `json:"timestamp"`
+	ParentHeight uint64      `json:"parentHeight"`
+	ParentHash   common.Hash `json:"parentHash"`
+	StateRoot    common.Hash `json:"stateRoot"`
+	IdentityRoot common.Hash `json:"identityRoot"`
+}
+
+// LastRejectedBlocks returns the last blocks which failed validation starting from the latest one,
+// dumps of the blocks are kept in the forensics directory of the datadir
+func (api *BlockchainApi) LastRejectedBlocks() []*RejectedBlock {
+	var result []*RejectedBlock
+	for _, b := range api.bc.LastRejectedBlocks() {
+		result = append(result, &RejectedBlock{
+			Block:        convertToBlock(b.Block),
+			Error:        b.Error,
+			Timestamp:    b.Time,
+			ParentHeight: b.ParentHeight,
+			ParentHash:   b.ParentHash,
+			StateRoot:    b.StateRoot,
+			IdentityRoot: b.IdentityRoot,
+		})
+	}
+	return result
+}
+
+type MisbehaviorEvidence struct {
+	Type     string         `json:"type"`
+	Offender common.Address `json:"offender"`
+	Round    uint64         `json:"round"`
+	Step     uint8          `json:"step"`
+	Hashes   []common.Hash  `json:"hashes"`
+	Time     int64          `json:"timestamp"`
+	TxHash   *common.Hash   `json:"txHash"`
+}
+
+// MisbehaviorEvidence returns the recently detected conflicting votes and proposals starting from the latest one
+func (api *BlockchainApi) MisbehaviorEvidence() []*MisbehaviorEvidence {
+	var result []*MisbehaviorEvidence
+	for _, e := range api.md.Evidence() {
+		result = append(result, &MisbehaviorEvidence{
+			Type:     string(e.Type),
+			Offender: e.Offender,
+			Round:    e.Round,
+			Step:     e.Step,
+			Hashes:   []common.Hash{e.Hashes[0], e.Hashes[1]},
+			Time:     e.Time.Unix(),
+			TxHash:   e.TxHash,
+		})
+	}
+	return result
+}
+
+type FeeEstimate struct {
+	Percentile int `json:"percentile"`
+	// Blocks is the expected number of blocks before the tx is included
+	Blocks    int             `json:"blocks"`
+	FeePerGas *big.Int        `json:"feePerGas"`
+	MaxFee    decimal.Decimal `json:"maxFee"`
+}
+
+type FeeEstimation struct {
+	Gas       int             `json:"gas"`
+	FeePerGas *big.Int        `json:"feePerGas"`
+	Fee       decimal.Decimal `json:"fee"`
+	// BlockUtilization is the average gas utilization of recent blocks
+	BlockUtilization float64        `json:"blockUtilization"`
+	MempoolGas       int            `json:"mempoolGas"`
+	Estimates        []*FeeEstimate `json:"estimates"`
+}
+
+// EstimateFee recommends max fee for a tx of the given type and size (in bytes, including signature).
+// Fee per gas is projected from the current value over the blocks the tx is expected to wait considering executable
+// mempool txs, every block is assumed to be utilized not less than the given percentile of recent blocks utilization.
+// One more block is added to the projection to cover tx propagation.
+func (api *BlockchainApi) EstimateFee(txType types.TxType, size int, percentiles *[]int) (*FeeEstimation, error) {
+	if size <= 0 {
+		return nil, errors.New("size should be positive")
+	}
+	if _, ok := txTypeMap[txType]; !ok {
+		return nil, errors.New("unknown tx type")
+	}
+	ps := defaultFeePercentiles
+	if percentiles != nil && len(*percentiles) > 0 {
+		ps = *percentiles
+	}
+	for _, p := range ps {
+		if p <= 0 || p > 100 {
+			return nil, errors.Errorf("percentile %v is out of range (0, 100]", p)
+		}
+	}
+
+	appState := api.baseApi.getReadonlyAppState()
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	feePerGas := appState.State.FeePerGas()
+	minFeePerGas := fee.GetFeePerGasForNetwork(networkSize)
+	k := api.bc.Config().Consensus.FeeSensitivityCoef
+	gas := fee.CalculateGasForSize(size)
+
+	utilizations := api.recentBlockUtilizations()
+	mempoolGas := api.pool.ExecutableGas()
+	backlog := float64(mempoolGas) / float64(appState.State.BlockGasLimit())
+	blocks := int(backlog) + 1
+
+	txFeePerGas := fee.GetFeePerGasForTxType(networkSize, feePerGas, txType)
+	result := &FeeEstimation{
+		Gas:        gas,
+		FeePerGas:  txFeePerGas,
+		Fee:        blockchain.ConvertToFloat(new(big.Int).Mul(txFeePerGas, big.NewInt(int64(gas)))),
+		MempoolGas: mempoolGas,
+	}
+	if len(utilizations) > 0 {
+		var sum float64
+		for _, u := range utilizations {
+			sum += u
+		}
+		result.BlockUtilization = sum / float64(len(utilizations))
+	}
+
+	for _, p := range ps {
+		utilization := percentileOf(utilizations, p)
+		projection := make([]float64, 0, blocks+1)
+		for i := 0; i <= blocks; i++ {
+			u := math.Min(1, backlog-float64(i))
+			if u < utilization {
+				u = utilization
+			}
+			projection = append(projection, u)
+		}
+		projected := fee.PredictFeePerGas(feePerGas, minFeePerGas, k, projection)
+		// the tx may be included into the next block, so the current fee per gas is the lower bound
+		if !common.ZeroOrNil(feePerGas) && projected.Cmp(feePerGas) < 0 {
+			projected = feePerGas
+		}
+		projected = fee.GetFeePerGasForTxType(networkSize, projected, txType)
+		result.Estimates = append(result.Estimates, &FeeEstimate{
+			Percentile: p,
+			Blocks:     blocks,
+			FeePerGas:  projected,
+			MaxFee:     blockchain.ConvertToFloat(new(big.Int).Mul(projected, big.NewInt(int64(gas)))),
+		})
+	}
+	return result, nil
+}
+
+// recentBlockUtilizations returns sorted gas utilizations of recent blocks, gas used by contracts is not taken into account
+func (api *BlockchainApi) recentBlockUtilizations() []float64 {
+	var result []float64
+	head := api.bc.Head.Height()
+	for height := head; height > 0 && head-height < feeEstimationBlocks; height-- {
+		block := api.bc.GetBlockByHeight(height)
+		if block == nil {
+			break
+		}
+		// empty blocks have no gas limit, they are counted as blocks without txs
+		gasLimit := block.Header.GasLimit()
+		if gasLimit == 0 {
+			result = append(result, 0)
+			continue
+		}
+		gas := 0
+		if block.Body != nil {
+			for _, tx := range block.Body.Transactions {
+				gas += fee.CalculateGas(tx)
+			}
+		}
+		result = append(result, math.Min(1, float64(gas)/float64(gasLimit)))
+	}
+	sort.Float64s(result)
+	return result
+}
+
+func percentileOf(sorted []float64, p int) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(float64(p)/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
 func (api *BlockchainApi) SendRawTx(ctx context.Context, bytesTx hexutil.Bytes) (common.Hash, error) {
 	var tx types.Transaction
 	if err := tx.FromBytes(bytesTx); err != nil {
@@ -264,6 +737,86 @@ func (api *BlockchainApi) SendRawTx(ctx context.Context, bytesTx hexutil.Bytes)
 	return api.baseApi.sendInternalTx(ctx, &tx)
 }
 
+type SendMultiArgs struct {
+	Payments []Payment       `json:"payments"`
+	MaxFee   decimal.Decimal `json:"maxFee"`
+	Tips     decimal.Decimal `json:"tips"`
+	BaseTxArgs
+}
+
+// SendMulti pays amounts to several recipients by one multi send tx signed by the signer of BaseTxArgs,
+// the fee is paid once for the whole tx
+func (api *BlockchainApi) SendMulti(ctx context.Context, args SendMultiArgs) (common.Hash, error) {
+	if len(args.Payments) == 0 {
+		return common.Hash{}, errors.New("payments are required")
+	}
+	if max := api.bc.Config().Consensus.MaxMultiSendPayments; len(args.Payments) > max {
+		return common.Hash{}, errors.Errorf("number of payments exceeds %v", max)
+	}
+	payments := make([]*attachments.MultiSendPayment, 0, len(args.Payments))
+	total := decimal.Zero
+	for _, payment := range args.Payments {
+		if !payment.Amount.IsPositive() {
+			return common.Hash{}, errors.Errorf("amount of the payment to %v should be positive", payment.To.Hex())
+		}
+		payments = append(payments, &attachments.MultiSendPayment{
+			To:     payment.To,
+			Amount: blockchain.ConvertToInt(payment.Amount),
+		})
+		total = total.Add(payment.Amount)
+	}
+	from := api.baseApi.getSigner(args.From)
+	return api.baseApi.sendTx(ctx, from, nil, types.MultiSendTx, total, args.MaxFee, args.Tips, args.Nonce, args.Epoch,
+		attachments.CreateMultiSendAttachment(payments), nil)
+}
+
+type BatchTxResult struct {
+	Hash  common.Hash `json:"hash"`
+	Error string      `json:"error,omitempty"`
+}
+
+// SendBatch adds raw txs to the mempool atomically, either all txs are accepted or none of them.
+// Txs of every sender should have consecutive nonces following the last nonce of the sender.
+func (api *BlockchainApi) SendBatch(ctx context.Context, rawTxs []hexutil.Bytes) ([]*BatchTxResult, error) {
+	txs := make([]*types.Transaction, 0, len(rawTxs))
+	results := make([]*BatchTxResult, len(rawTxs))
+	decodeErr := false
+	for i, bytesTx := range rawTxs {
+		tx := new(types.Transaction)
+		results[i] = &BatchTxResult{}
+		if err := tx.FromBytes(bytesTx); err != nil {
+			if err := rlp.DecodeBytes(bytesTx, tx); err != nil {
+				results[i].Error = err.Error()
+				decodeErr = true
+				continue
+			}
+			tx.UseRlp = true
+		}
+		results[i].Hash = tx.Hash()
+		txs = append(txs, tx)
+	}
+	if decodeErr {
+		for _, result := range results {
+			if result.Error == "" {
+				result.Error = mempool.BatchRejectedError.Error()
+			}
+		}
+		return results, nil
+	}
+
+	log.Info("Sending tx batch", "ip", ctx.Value("remote"), "size", len(txs))
+	errs, err := api.pool.AddTxBatch(txs)
+	if err != nil {
+		return nil, err
+	}
+	for i, err := range errs {
+		if err != nil {
+			results[i].Error = err.Error()
+		}
+	}
+	return results, nil
+}
+
 func (api *BlockchainApi) GetRawTx(args SendTxArgs) (hexutil.Bytes, error) {
 	var payload []byte
 	if args.Payload != nil {
@@ -271,6 +824,10 @@ func (api *BlockchainApi) GetRawTx(args SendTxArgs) (hexutil.Bytes, error) {
 	}
 
 	tx := api.baseApi.getTx(args.From, args.To, args.Type, args.Amount, args.MaxFee, args.Tips, args.Nonce, args.Epoch, payload)
+	tx.ExpiryHeight = api.baseApi.getExpiryHeight(args.ExpiryHeight, args.Ttl)
+	if tx.ExpiryHeight > 0 && !args.UseProto {
+		return nil, errors.New("tx expiry is supported only for proto txs")
+	}
 
 	var data []byte
 	var err error
@@ -287,9 +844,190 @@ func (api *BlockchainApi) GetRawTx(args SendTxArgs) (hexutil.Bytes, error) {
 	return data, nil
 }
 
+type UnsignedTx struct {
+	// Tx is the unsigned proto tx to be passed to bcn_submitSignedTx along with the signature
+	Tx hexutil.Bytes `json:"tx"`
+	// SigningPayload is the canonical tx data the signature is computed over
+	SigningPayload hexutil.Bytes `json:"signingPayload"`
+	// SigningHash is the hash of the signing payload to be signed by the secp256k1 key of the sender
+	SigningHash  common.Hash     `json:"signingHash"`
+	Nonce        uint32          `json:"nonce"`
+	Epoch        uint16          `json:"epoch"`
+	MaxFee       decimal.Decimal `json:"maxFee"`
+	ExpiryHeight uint64          `json:"expiryHeight,omitempty"`
+}
+
+// BuildUnsignedTx builds the proto tx with the nonce, epoch and max fee of the current state for signing on another
+// device, the signed tx is submitted with SubmitSignedTx
+func (api *BlockchainApi) BuildUnsignedTx(args SendTxArgs) (*UnsignedTx, error) {
+	var payload []byte
+	if args.Payload != nil {
+		payload = *args.Payload
+	}
+	tx := api.baseApi.getTx(args.From, args.To, args.Type, args.Amount, args.MaxFee, args.Tips, args.Nonce, args.Epoch, payload)
+	tx.ExpiryHeight = api.baseApi.getExpiryHeight(args.ExpiryHeight, args.Ttl)
+	return newUnsignedTx(tx)
+}
+
+func newUnsignedTx(tx *types.Transaction) (*UnsignedTx, error) {
+	data, err := tx.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	signingPayload, err := tx.ToSignatureBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &UnsignedTx{
+		Tx:             data,
+		SigningPayload: signingPayload,
+		SigningHash:    crypto.SignatureHash(tx),
+		Nonce:          tx.AccountNonce,
+		Epoch:          tx.Epoch,
+		MaxFee:         blockchain.ConvertToFloat(tx.MaxFee),
+		ExpiryHeight:   tx.ExpiryHeight,
+	}, nil
+}
+
+type SignedTxArgs struct {
+	// Tx is the unsigned tx returned by bcn_buildUnsignedTx
+	Tx        hexutil.Bytes `json:"tx"`
+	Signature hexutil.Bytes `json:"signature"`
+	// From is the expected sender, the tx is rejected if the signature is made by another key
+	From *common.Address `json:"from"`
+}
+
+// SubmitSignedTx attaches the signature made on another device to the unsigned tx and adds the tx to the mempool
+func (api *BlockchainApi) SubmitSignedTx(ctx context.Context, args SignedTxArgs) (common.Hash, error) {
+	var tx types.Transaction
+	if err := tx.FromBytes(args.Tx); err != nil {
+		return common.Hash{}, err
+	}
+	if len(tx.Signature) > 0 {
+		return common.Hash{}, errors.New("tx is already signed")
+	}
+	tx.Signature = args.Signature
+	sender, err := types.Sender(&tx)
+	if err != nil {
+		return common.Hash{}, errors.Wrap(err, "invalid signature")
+	}
+	if args.From != nil && *args.From != sender {
+		return common.Hash{}, errors.Errorf("tx is signed by %v instead of %v", sender.Hex(), args.From.Hex())
+	}
+	return api.baseApi.sendInternalTx(ctx, &tx)
+}
+
+type SweepPlanArgs struct {
+	Addresses   []common.Address `json:"addresses"`
+	Destination common.Address   `json:"destination"`
+	UseProto    bool             `json:"useProto"`
+}
+
+type SweepTx struct {
+	From   common.Address  `json:"from"`
+	To     common.Address  `json:"to"`
+	Amount decimal.Decimal `json:"amount"`
+	MaxFee decimal.Decimal `json:"maxFee"`
+	Nonce  uint32          `json:"nonce"`
+	Epoch  uint16          `json:"epoch"`
+	RawTx  hexutil.Bytes   `json:"rawTx"`
+}
+
+type SweepPlan struct {
+	Transactions []*SweepTx       `json:"transactions"`
+	Skipped      []common.Address `json:"skipped"`
+	Total        decimal.Decimal  `json:"total"`
+	TotalFee     decimal.Decimal  `json:"totalFee"`
+}
+
+// SweepPlan builds unsigned send transactions which move the whole balance of every given address to the destination.
+// Addresses with a balance insufficient to pay the fee are reported as skipped.
+func (api *BlockchainApi) SweepPlan(args SweepPlanArgs) (*SweepPlan, error) {
+	if args.Destination == (common.Address{}) {
+		return nil, errors.New("destination is required")
+	}
+	return buildSweepPlan(api.baseApi.getReadonlyAppState(), args)
+}
+
+func buildSweepPlan(appState *appstate.AppState, args SweepPlanArgs) (*SweepPlan, error) {
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	feePerGas := appState.State.FeePerGas()
+	epoch := appState.State.Epoch()
+
+	plan := &SweepPlan{}
+	total, totalFee := new(big.Int), new(big.Int)
+	seen := make(map[common.Address]struct{})
+	for _, addr := range args.Addresses {
+		if _, ok := seen[addr]; ok || addr == args.Destination {
+			continue
+		}
+		seen[addr] = struct{}{}
+
+		balance := appState.State.GetBalance(addr)
+		to := args.Destination
+		tx := &types.Transaction{
+			AccountNonce: appState.NonceCache.GetNonce(addr, epoch) + 1,
+			Type:         types.SendTx,
+			To:           &to,
+			Amount:       new(big.Int).Set(balance),
+			Epoch:        epoch,
+		}
+		// maxFee is set as 2x from fee, the same as for regular transactions
+		txFee := fee.CalculateFee(networkSize, feePerGas, tx)
+		maxFee := new(big.Int).Mul(txFee, big.NewInt(2))
+		tx.MaxFee = maxFee
+		amount := new(big.Int).Sub(balance, maxFee)
+		if amount.Sign() <= 0 {
+			plan.Skipped = append(plan.Skipped, addr)
+			continue
+		}
+		tx.Amount = amount
+
+		var data []byte
+		var err error
+		if args.UseProto {
+			data, err = tx.ToBytes()
+		} else {
+			data, err = rlp.EncodeToBytes(tx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		plan.Transactions = append(plan.Transactions, &SweepTx{
+			From:   addr,
+			To:     to,
+			Amount: blockchain.ConvertToFloat(amount),
+			MaxFee: blockchain.ConvertToFloat(maxFee),
+			Nonce:  tx.AccountNonce,
+			Epoch:  tx.Epoch,
+			RawTx:  data,
+		})
+		total.Add(total, amount)
+		totalFee.Add(totalFee, maxFee)
+	}
+	plan.Total = blockchain.ConvertToFloat(total)
+	plan.TotalFee = blockchain.ConvertToFloat(totalFee)
+	return plan, nil
+}
+
+// Transactions returns the tx history of the address from the newest tx to the oldest one, the token of the result
+// is passed to get the next page
 func (api *BlockchainApi) Transactions(args TransactionsArgs) Transactions {
+	filter := &database.TxFilter{}
+	if len(args.Types) > 0 {
+		filter.Types = make(map[types.TxType]struct{}, len(args.Types))
+		for _, txType := range args.Types {
+			filter.Types[txType] = struct{}{}
+		}
+	}
+	if args.FromTime != nil {
+		filter.FromTime = *args.FromTime
+	}
+	if args.ToTime != nil {
+		filter.ToTime = *args.ToTime
+	}
 
-	txs, nextToken := api.bc.ReadTxs(args.Address, args.Count, args.Token)
+	txs, nextToken := api.bc.ReadTxs(args.Address, args.Count, args.Token, filter)
 
 	var list []*Transaction
 	for _, item := range txs {
@@ -320,8 +1058,94 @@ func (api *BlockchainApi) BurntCoins() []BurntCoins {
 	return res
 }
 
+var balanceChangeReasonMap = map[types.BalanceChangeReason]string{
+	types.TxReason:               "tx",
+	types.FeeReason:              "fee",
+	types.BlockRewardReason:      "blockReward",
+	types.ValidationRewardReason: "validationReward",
+	types.StakingReason:          "staking",
+	types.PenaltyReason:          "penalty",
+	types.DustClearingReason:     "dustClearing",
+}
+
+type BalanceChange struct {
+	Height     uint64          `json:"height"`
+	Reason     string          `json:"reason"`
+	TxHash     *common.Hash    `json:"txHash"`
+	BalanceOld decimal.Decimal `json:"balanceOld"`
+	BalanceNew decimal.Decimal `json:"balanceNew"`
+	StakeOld   decimal.Decimal `json:"stakeOld"`
+	StakeNew   decimal.Decimal `json:"stakeNew"`
+}
+
+func (api *BlockchainApi) BalanceChanges(address common.Address, fromHeight, toHeight uint64) ([]*BalanceChange, error) {
+	if !api.bc.Config().Blockchain.WriteBalanceJournal {
+		return nil, errors.New("balance journal is disabled")
+	}
+	var res []*BalanceChange
+	for _, change := range api.bc.ReadBalanceChanges(address, fromHeight, toHeight) {
+		res = append(res, &BalanceChange{
+			Height:     change.Height,
+			Reason:     balanceChangeReasonMap[change.Reason],
+			TxHash:     change.TxHash,
+			BalanceOld: blockchain.ConvertToFloat(change.BalanceOld),
+			BalanceNew: blockchain.ConvertToFloat(change.BalanceNew),
+			StakeOld:   blockchain.ConvertToFloat(change.StakeOld),
+			StakeNew:   blockchain.ConvertToFloat(change.StakeNew),
+		})
+	}
+	return res, nil
+}
+
+type EpochCheckpoint struct {
+	Cid          string          `json:"cid"`
+	Publisher    common.Address  `json:"publisher"`
+	Epoch        uint16          `json:"epoch"`
+	Height       uint64          `json:"height"`
+	BlockHash    common.Hash     `json:"blockHash"`
+	Root         common.Hash     `json:"root"`
+	IdentityRoot common.Hash     `json:"identityRoot"`
+	Supply       decimal.Decimal `json:"supply"`
+	Stake        decimal.Decimal `json:"stake"`
+}
+
+func (api *BlockchainApi) Checkpoints(epoch uint16) []*EpochCheckpoint {
+	res := make([]*EpochCheckpoint, 0)
+	for _, announcement := range api.bc.ReadCheckpointAnnouncements(epoch) {
+		c, err := cid.Cast(announcement.Cid)
+		if err != nil {
+			continue
+		}
+		checkpoint := announcement.Checkpoint
+		publisher, _ := types.SenderEpochCheckpoint(checkpoint)
+		res = append(res, &EpochCheckpoint{
+			Cid:          c.String(),
+			Publisher:    publisher,
+			Epoch:        checkpoint.Epoch,
+			Height:       checkpoint.Height,
+			BlockHash:    checkpoint.BlockHash,
+			Root:         checkpoint.Root,
+			IdentityRoot: checkpoint.IdentityRoot,
+			Supply:       blockchain.ConvertToFloat(checkpoint.Supply),
+			Stake:        blockchain.ConvertToFloat(checkpoint.Stake),
+		})
+	}
+	return res
+}
+
 func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerGas *big.Int, timestamp int64) *Transaction {
 	sender, _ := types.Sender(tx)
+	var payments []*Payment
+	if tx.Type == types.MultiSendTx {
+		if attachment := attachments.ParseMultiSendAttachment(tx); attachment != nil {
+			for _, payment := range attachment.Payments {
+				payments = append(payments, &Payment{
+					To:     payment.To,
+					Amount: blockchain.ConvertToFloat(payment.Amount),
+				})
+			}
+		}
+	}
 	return &Transaction{
 		Hash:      tx.Hash(),
 		Epoch:     tx.Epoch,
@@ -336,6 +1160,9 @@ func convertToTransaction(tx *types.Transaction, blockHash common.Hash, feePerGa
 		BlockHash: blockHash,
 		Timestamp: timestamp,
 		UsedFee:   blockchain.ConvertToFloat(fee.CalculateFee(1, feePerGas, tx)),
+
+		ExpiryHeight: tx.ExpiryHeight,
+		Payments:     payments,
 	}
 }
 
@@ -407,9 +1234,221 @@ func convertToBlock(block *types.Block) *Block {
 		Transactions: txs,
 		Flags:        flags,
 		OfflineAddr:  block.Header.OfflineAddr(),
+		GasLimit:     block.Header.GasLimit(),
 	}
 }
 
+// KeyWord returns the keyword of the flip words dictionary used in the current epoch
 func (api *BlockchainApi) KeyWord(index int) (keywords.Keyword, error) {
-	return keywords.Get(index)
+	var hash common.Hash
+	if dictionary := api.baseApi.getReadonlyAppState().State.WordsDictionary(); dictionary != nil {
+		hash = dictionary.Hash
+	}
+	return keywords.GetFromDictionary(hash, index)
+}
+
+type WordsDictionary struct {
+	Hash   common.Hash `json:"hash"`
+	Size   uint32      `json:"size"`
+	Epoch  uint16      `json:"epoch"`
+	Loaded bool        `json:"loaded"`
+}
+
+type WordsDictionaries struct {
+	Current *WordsDictionary `json:"current"`
+	Next    *WordsDictionary `json:"next"`
+}
+
+// WordsDictionaries returns flip words dictionaries pinned on-chain, nil current dictionary means the built-in one
+func (api *BlockchainApi) WordsDictionaries() WordsDictionaries {
+	current, next := api.baseApi.getReadonlyAppState().State.GetOrNewGlobalObject().WordsDictionaries()
+	convert := func(dictionary *state.WordsDictionary) *WordsDictionary {
+		if dictionary == nil {
+			return nil
+		}
+		_, err := keywords.GetFromDictionary(dictionary.Hash, 0)
+		return &WordsDictionary{
+			Hash:   dictionary.Hash,
+			Size:   dictionary.Size,
+			Epoch:  dictionary.Epoch,
+			Loaded: err == nil,
+		}
+	}
+	return WordsDictionaries{
+		Current: convert(current),
+		Next:    convert(next),
+	}
+}
+
+type TraceStateRead struct {
+	Object  string         `json:"object"`
+	Address common.Address `json:"address"`
+	Key     hexutil.Bytes  `json:"key,omitempty"`
+}
+
+type TraceAccount struct {
+	Address common.Address  `json:"address"`
+	Balance decimal.Decimal `json:"balance"`
+	Nonce   uint32          `json:"nonce"`
+	Epoch   uint16          `json:"epoch"`
+}
+
+type TraceIdentity struct {
+	Address common.Address  `json:"address"`
+	State   string          `json:"state"`
+	Stake   decimal.Decimal `json:"stake"`
+}
+
+type TraceContractValue struct {
+	Address common.Address `json:"address"`
+	Key     hexutil.Bytes  `json:"key"`
+	Value   hexutil.Bytes  `json:"value"`
+	Removed bool           `json:"removed"`
+}
+
+type TraceState struct {
+	Reads          []*TraceStateRead     `json:"reads"`
+	Accounts       []*TraceAccount       `json:"accounts"`
+	Identities     []*TraceIdentity      `json:"identities"`
+	ContractValues []*TraceContractValue `json:"contractValues"`
+}
+
+type TraceTxReceipt struct {
+	*TxReceipt
+	Events []*Event `json:"events"`
+}
+
+type TxTrace struct {
+	Hash    common.Hash     `json:"hash"`
+	Fee     decimal.Decimal `json:"fee"`
+	Receipt *TraceTxReceipt `json:"receipt,omitempty"`
+	State   *TraceState     `json:"state"`
+}
+
+type TraceReward struct {
+	Type        string          `json:"type"`
+	BalanceDest common.Address  `json:"balanceDest"`
+	StakeDest   common.Address  `json:"stakeDest"`
+	Balance     decimal.Decimal `json:"balance"`
+	Stake       decimal.Decimal `json:"stake"`
+}
+
+type BlockTrace struct {
+	Height      uint64          `json:"height"`
+	Hash        common.Hash     `json:"hash"`
+	Txs         []*TxTrace      `json:"txs"`
+	State       *TraceState     `json:"state"`
+	Rewards     []*TraceReward  `json:"rewards"`
+	MintedCoins decimal.Decimal `json:"mintedCoins"`
+}
+
+// TraceBlock re-executes the block and returns state reads and writes, fees, receipts and rewards of its execution
+func (api *BlockchainApi) TraceBlock(height uint64) (*BlockTrace, error) {
+	trace, err := api.bc.TraceBlock(height)
+	if err != nil {
+		return nil, err
+	}
+	result := &BlockTrace{
+		Height:      trace.Height,
+		Hash:        trace.Hash,
+		State:       convertTraceState(trace.State),
+		MintedCoins: blockchain.ConvertToFloat(trace.MintedCoins),
+	}
+	for _, txTrace := range trace.Txs {
+		result.Txs = append(result.Txs, convertTxTrace(txTrace))
+	}
+	for _, reward := range trace.Rewards {
+		result.Rewards = append(result.Rewards, &TraceReward{
+			Type:        string(reward.Type),
+			BalanceDest: reward.BalanceDest,
+			StakeDest:   reward.StakeDest,
+			Balance:     blockchain.ConvertToFloat(reward.Balance),
+			Stake:       blockchain.ConvertToFloat(reward.Stake),
+		})
+	}
+	return result, nil
+}
+
+// TraceTx re-executes the block of the tx and returns the trace of the tx
+func (api *BlockchainApi) TraceTx(hash common.Hash) (*TxTrace, error) {
+	trace, err := api.bc.TraceTx(hash)
+	if err != nil {
+		return nil, err
+	}
+	return convertTxTrace(trace), nil
+}
+
+func convertTxTrace(trace *blockchain.TxTrace) *TxTrace {
+	result := &TxTrace{
+		Hash:  trace.Hash,
+		Fee:   blockchain.ConvertToFloat(trace.Fee),
+		State: convertTraceState(trace.State),
+	}
+	if receipt := trace.Receipt; receipt != nil {
+		var err string
+		if receipt.Error != nil {
+			err = receipt.Error.Error()
+		}
+		result.Receipt = &TraceTxReceipt{
+			TxReceipt: &TxReceipt{
+				Success:  receipt.Success,
+				Error:    err,
+				Method:   receipt.Method,
+				Contract: receipt.ContractAddress,
+				TxHash:   receipt.TxHash,
+				GasUsed:  receipt.GasUsed,
+				GasCost:  blockchain.ConvertToFloat(receipt.GasCost),
+				TxFee:    result.Fee,
+			},
+		}
+		for _, event := range receipt.Events {
+			e := &Event{
+				Contract: receipt.ContractAddress,
+				Event:    event.EventName,
+			}
+			for _, arg := range event.Data {
+				e.Args = append(e.Args, arg)
+			}
+			result.Receipt.Events = append(result.Receipt.Events, e)
+		}
+	}
+	return result
+}
+
+func convertTraceState(trace *blockchain.StateTrace) *TraceState {
+	if trace == nil {
+		return nil
+	}
+	result := &TraceState{}
+	for _, read := range trace.Reads {
+		result.Reads = append(result.Reads, &TraceStateRead{
+			Object:  read.Object.String(),
+			Address: read.Address,
+			Key:     read.Key,
+		})
+	}
+	for _, account := range trace.Accounts {
+		result.Accounts = append(result.Accounts, &TraceAccount{
+			Address: account.Address,
+			Balance: blockchain.ConvertToFloat(account.Balance),
+			Nonce:   account.Nonce,
+			Epoch:   account.Epoch,
+		})
+	}
+	for _, identity := range trace.Identities {
+		result.Identities = append(result.Identities, &TraceIdentity{
+			Address: identity.Address,
+			State:   identity.State.Name(),
+			Stake:   blockchain.ConvertToFloat(identity.Stake),
+		})
+	}
+	for _, value := range trace.ContractValues {
+		result.ContractValues = append(result.ContractValues, &TraceContractValue{
+			Address: value.Address,
+			Key:     value.Key,
+			Value:   value.Value,
+			Removed: value.Removed,
+		})
+	}
+	return result
 }
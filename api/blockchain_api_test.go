@@ -0,0 +1,211 @@
+package api
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/fee"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchainApi_recentBlockUtilizations(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	chain, _ := blockchain.NewCustomTestBlockchain(3, 2, key)
+	api := &BlockchainApi{bc: chain.Blockchain}
+
+	utilizations := api.recentBlockUtilizations()
+	require.Len(t, utilizations, int(chain.Head.Height()))
+	for _, u := range utilizations {
+		require.False(t, math.IsNaN(u))
+		require.Zero(t, u)
+	}
+}
+
+func TestBlockchainApi_unsignedTx(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.Address{0x1}
+	tx := &types.Transaction{
+		AccountNonce: 3,
+		Epoch:        2,
+		Type:         types.SendTx,
+		To:           &to,
+		Amount:       big.NewInt(1e18),
+		MaxFee:       big.NewInt(1e17),
+		ExpiryHeight: 100,
+	}
+	unsignedTx, err := newUnsignedTx(tx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), unsignedTx.Nonce)
+	require.Equal(t, uint16(2), unsignedTx.Epoch)
+	require.Equal(t, uint64(100), unsignedTx.ExpiryHeight)
+	require.Equal(t, blockchain.ConvertToFloat(tx.MaxFee), unsignedTx.MaxFee)
+	require.Equal(t, common.Hash(crypto.Hash(unsignedTx.SigningPayload)), unsignedTx.SigningHash)
+
+	// the signature of the signing hash is the one of the tx signed by the node
+	signature, err := crypto.Sign(unsignedTx.SigningHash[:], key)
+	require.NoError(t, err)
+	signedTx, _ := types.SignTx(tx, key)
+	require.Equal(t, signedTx.Signature, signature)
+
+	var decoded types.Transaction
+	require.NoError(t, decoded.FromBytes(unsignedTx.Tx))
+	require.Empty(t, decoded.Signature)
+	decoded.Signature = signature
+	decodedSender, err := types.Sender(&decoded)
+	require.NoError(t, err)
+	require.Equal(t, sender, decodedSender)
+	require.Equal(t, signedTx.Hash(), decoded.Hash())
+}
+
+func TestBlockchainApi_SubmitSignedTx_invalid(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	to := common.Address{0x1}
+	tx := &types.Transaction{Type: types.SendTx, To: &to, Amount: big.NewInt(1)}
+	unsignedTx, _ := newUnsignedTx(tx)
+	signature, _ := crypto.Sign(unsignedTx.SigningHash[:], key)
+	signedTx, _ := types.SignTx(tx, key)
+	signedData, _ := signedTx.ToBytes()
+	other := common.Address{0x2}
+
+	api := &BlockchainApi{}
+	tests := []struct {
+		name string
+		args SignedTxArgs
+		err  string
+	}{
+		{"malformed tx", SignedTxArgs{Tx: []byte{0x0a, 0x10}, Signature: signature}, ""},
+		{"signed tx", SignedTxArgs{Tx: signedData, Signature: signature}, "tx is already signed"},
+		{"malformed signature", SignedTxArgs{Tx: unsignedTx.Tx, Signature: []byte{0x1, 0x2}}, "invalid signature"},
+		{"wrong signer", SignedTxArgs{Tx: unsignedTx.Tx, Signature: signature, From: &other}, "tx is signed by"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := api.SubmitSignedTx(context.Background(), tc.args)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.err)
+		})
+	}
+}
+
+func TestBlockchainApi_buildSweepPlan(t *testing.T) {
+	_, appState, _, _ := blockchain.NewTestBlockchain(true, nil)
+	appState.State.SetFeePerGas(big.NewInt(1e10))
+	epoch := appState.State.Epoch()
+	networkSize := appState.ValidatorsCache.NetworkSize()
+
+	destination := common.Address{0x10}
+	rich, poor, empty := common.Address{0x1}, common.Address{0x2}, common.Address{0x3}
+	appState.State.SetBalance(rich, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+	appState.NonceCache.SetNonce(rich, epoch, 5)
+	appState.State.SetBalance(poor, big.NewInt(1))
+	appState.State.SetBalance(destination, common.DnaBase)
+
+	plan, err := buildSweepPlan(appState, SweepPlanArgs{
+		Addresses:   []common.Address{rich, poor, empty, destination, rich},
+		Destination: destination,
+		UseProto:    true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{poor, empty}, plan.Skipped)
+	require.Len(t, plan.Transactions, 1)
+
+	sweepTx := plan.Transactions[0]
+	balance := appState.State.GetBalance(rich)
+	txFee := fee.CalculateFee(networkSize, appState.State.FeePerGas(), &types.Transaction{
+		AccountNonce: 6,
+		Type:         types.SendTx,
+		To:           &destination,
+		Amount:       balance,
+		Epoch:        epoch,
+	})
+	require.Positive(t, txFee.Sign())
+	maxFee := new(big.Int).Mul(txFee, big.NewInt(2))
+	amount := new(big.Int).Sub(balance, maxFee)
+	require.Equal(t, rich, sweepTx.From)
+	require.Equal(t, destination, sweepTx.To)
+	require.Equal(t, uint32(6), sweepTx.Nonce)
+	require.Equal(t, epoch, sweepTx.Epoch)
+	require.Equal(t, blockchain.ConvertToFloat(maxFee), sweepTx.MaxFee)
+	require.Equal(t, blockchain.ConvertToFloat(amount), sweepTx.Amount)
+	require.Equal(t, blockchain.ConvertToFloat(amount), plan.Total)
+	require.Equal(t, blockchain.ConvertToFloat(maxFee), plan.TotalFee)
+
+	var tx types.Transaction
+	require.NoError(t, tx.FromBytes(sweepTx.RawTx))
+	require.Equal(t, uint32(6), tx.AccountNonce)
+	require.Equal(t, types.SendTx, tx.Type)
+	require.Equal(t, destination, *tx.To)
+	require.Equal(t, amount, tx.Amount)
+	require.Equal(t, maxFee, tx.MaxFee)
+	require.Empty(t, tx.Signature)
+
+	// the balance which covers the fee but not the max fee is skipped too
+	appState.State.SetBalance(rich, txFee)
+	plan, err = buildSweepPlan(appState, SweepPlanArgs{Addresses: []common.Address{rich}, Destination: destination})
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{rich}, plan.Skipped)
+	require.Empty(t, plan.Transactions)
+	require.True(t, plan.Total.IsZero())
+}
+
+func TestBlockchainApi_SweepPlan_noDestination(t *testing.T) {
+	api := &BlockchainApi{}
+	_, err := api.SweepPlan(SweepPlanArgs{Addresses: []common.Address{{0x1}}})
+	require.Error(t, err)
+}
+
+func TestBlockchainApi_NewPendingTransactions(t *testing.T) {
+	bus := eventbus.New()
+	server := rpc.NewServer("")
+	require.NoError(t, server.RegisterName("bcn", &BlockchainApi{bus: bus}))
+	defer server.Stop()
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	hashes := make(chan common.Hash, 10)
+	hashesSub, err := client.Subscribe(context.Background(), "bcn", hashes, "newPendingTransactions")
+	require.NoError(t, err)
+	txs := make(chan *Transaction, 10)
+	txsSub, err := client.Subscribe(context.Background(), "bcn", txs, "newPendingTransactions", true)
+	require.NoError(t, err)
+
+	key, _ := crypto.GenerateKey()
+	to := common.Address{0x1}
+	deferredTx, _ := types.SignTx(&types.Transaction{Type: types.SendTx, To: &to, Amount: big.NewInt(1)}, key)
+	tx, _ := types.SignTx(&types.Transaction{Type: types.SendTx, To: &to, Amount: big.NewInt(2), AccountNonce: 1}, key)
+	bus.Publish(&events.NewTxEvent{Tx: deferredTx, Deferred: true})
+	bus.Publish(&events.NewTxEvent{Tx: tx})
+
+	select {
+	case hash := <-hashes:
+		require.Equal(t, tx.Hash(), hash)
+	case <-time.After(time.Second):
+		t.Fatal("tx hash is not received")
+	}
+	select {
+	case received := <-txs:
+		require.Equal(t, tx.Hash(), received.Hash)
+		require.Equal(t, to, *received.To)
+		require.Equal(t, "send", received.Type)
+	case <-time.After(time.Second):
+		t.Fatal("tx is not received")
+	}
+
+	// the deferred tx is not sent
+	require.Empty(t, hashes)
+	require.Empty(t, txs)
+
+	hashesSub.Unsubscribe()
+	txsSub.Unsubscribe()
+}
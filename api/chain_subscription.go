@@ -0,0 +1,191 @@
+package api
+
+import (
+	"context"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/rpc"
+)
+
+// maxResumeBlocks limits how far back a subscription can be resumed by a token
+const maxResumeBlocks = 1000
+
+// ChainToken identifies the block a chain notification belongs to
+type ChainToken struct {
+	Height hexutil.Uint64 `json:"height"`
+	Hash   common.Hash    `json:"hash"`
+}
+
+// ChainNotification is sent by chain subscriptions, passing the token to the subscription again resumes
+// notifications from the next block, or from the fork point if the block was reverted. The last notification
+// of a failed subscription contains only the error and the token of the last processed block.
+type ChainNotification struct {
+	Token *ChainToken `json:"token,omitempty"`
+	Data  interface{} `json:"data,omitempty"`
+	Error string      `json:"error,omitempty"`
+}
+
+// chainCursor tracks canonical blocks processed by a chain subscription to notify again about blocks
+// which replaced reverted ones
+type chainCursor struct {
+	next uint64
+	// processed are hashes of processed blocks of the last maxResumeBlocks heights
+	processed map[uint64]common.Hash
+	// processedBelow is set if blocks below the tracked ones were processed by the subscription or before the token
+	processedBelow bool
+}
+
+func newChainCursor(head uint64, token *ChainToken) *chainCursor {
+	c := &chainCursor{
+		next:      head + 1,
+		processed: make(map[uint64]common.Hash),
+	}
+	if token == nil {
+		return c
+	}
+	height := uint64(token.Height)
+	c.processed[height] = token.Hash
+	c.processedBelow = true
+	c.next = height + 1
+	if head > height && head-height > maxResumeBlocks {
+		c.next = head - maxResumeBlocks + 1
+	}
+	return c
+}
+
+// rewind moves the cursor back to the lowest processed block which is not canonical anymore. If the fork point
+// is below the tracked blocks, notifications are repeated from the block next to the final one within maxResumeBlocks.
+func (c *chainCursor) rewind(head uint64, hashAt func(height uint64) common.Hash, finalHeight func() uint64) {
+	reverted := false
+	for c.next > 1 {
+		hash, ok := c.processed[c.next-1]
+		if !ok || hashAt(c.next-1) == hash {
+			break
+		}
+		delete(c.processed, c.next-1)
+		c.next--
+		reverted = true
+	}
+	if c.next > head+1 {
+		c.next = head + 1
+	}
+	if !reverted || !c.processedBelow || c.next <= 1 {
+		return
+	}
+	if _, ok := c.processed[c.next-1]; ok {
+		return
+	}
+	from := finalHeight() + 1
+	if head >= maxResumeBlocks && from < head-maxResumeBlocks+1 {
+		from = head - maxResumeBlocks + 1
+	}
+	if from < c.next {
+		c.next = from
+	}
+}
+
+func (c *chainCursor) advance(height uint64, hash common.Hash) {
+	c.processed[height] = hash
+	c.next = height + 1
+	if height > maxResumeBlocks {
+		if _, ok := c.processed[height-maxResumeBlocks]; ok {
+			delete(c.processed, height-maxResumeBlocks)
+			c.processedBelow = true
+		}
+	}
+}
+
+func (c *chainCursor) token() *ChainToken {
+	if c.next <= 1 {
+		return nil
+	}
+	hash, ok := c.processed[c.next-1]
+	if !ok {
+		return nil
+	}
+	return &ChainToken{Height: hexutil.Uint64(c.next - 1), Hash: hash}
+}
+
+// subscribeChain notifies the subscriber about data extracted by handle from every canonical block. New heads only
+// wake the subscription up and blocks are read from the chain, so a slow subscriber isn't fed by an unbounded buffer,
+// it catches up with the head at its own pace instead. Notifications start from the block next to the token
+// if it is set and from the next head otherwise. The subscription stops after the error notification if handle fails.
+func subscribeChain(ctx context.Context, bc *blockchain.Blockchain, bus eventbus.Bus, token *ChainToken, handle func(block *types.Block) ([]interface{}, error)) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	rpcSub := notifier.CreateSubscription()
+
+	cursor := newChainCursor(bc.Head.Height(), token)
+	hashAt := func(height uint64) common.Hash {
+		if header := bc.GetBlockHeaderByHeight(height); header != nil {
+			return header.Hash()
+		}
+		return common.Hash{}
+	}
+	finalHeight := func() uint64 {
+		if final := bc.FinalBlock(); final != nil {
+			return final.Height()
+		}
+		return 0
+	}
+
+	wake := make(chan struct{}, 1)
+	wake <- struct{}{}
+	busSub := bus.Subscribe(events.ChainHeadEventID, func(e eventbus.Event) {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	})
+
+	go func() {
+		defer bus.Unsubscribe(busSub)
+		for {
+			select {
+			case <-wake:
+			case <-rpcSub.Err():
+				return
+			case <-notifier.Closed():
+				return
+			}
+			head := bc.Head.Height()
+			cursor.rewind(head, hashAt, finalHeight)
+			for cursor.next <= head {
+				block := bc.GetBlockByHeight(cursor.next)
+				if block == nil {
+					break
+				}
+				items, err := handle(block)
+				if err != nil {
+					notifier.Notify(rpcSub.ID, &ChainNotification{
+						Token: cursor.token(),
+						Error: err.Error(),
+					})
+					return
+				}
+				cursor.advance(block.Height(), block.Hash())
+				for _, data := range items {
+					notifier.Notify(rpcSub.ID, &ChainNotification{
+						Token: cursor.token(),
+						Data:  data,
+					})
+				}
+				select {
+				case <-rpcSub.Err():
+					return
+				case <-notifier.Closed():
+					return
+				default:
+				}
+			}
+		}
+	}()
+	return rpcSub, nil
+}
@@ -0,0 +1,127 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+type testChain struct {
+	hashes map[uint64]common.Hash
+	final  uint64
+}
+
+func newTestChain(head uint64, fork byte) *testChain {
+	c := &testChain{hashes: make(map[uint64]common.Hash)}
+	for h := uint64(1); h <= head; h++ {
+		c.hashes[h] = common.Hash{fork, byte(h >> 8), byte(h)}
+	}
+	return c
+}
+
+func (c *testChain) reorg(from, head uint64, fork byte) {
+	for h := range c.hashes {
+		if h >= from {
+			delete(c.hashes, h)
+		}
+	}
+	for h := from; h <= head; h++ {
+		c.hashes[h] = common.Hash{fork, byte(h >> 8), byte(h)}
+	}
+}
+
+func (c *testChain) head() uint64 {
+	return uint64(len(c.hashes))
+}
+
+func (c *testChain) hashAt(height uint64) common.Hash {
+	return c.hashes[height]
+}
+
+func (c *testChain) finalHeight() uint64 {
+	return c.final
+}
+
+// process moves the cursor to the head of the chain and returns heights of processed blocks
+func (c *testChain) process(cursor *chainCursor) []uint64 {
+	var result []uint64
+	cursor.rewind(c.head(), c.hashAt, c.finalHeight)
+	for cursor.next <= c.head() {
+		result = append(result, cursor.next)
+		cursor.advance(cursor.next, c.hashAt(cursor.next))
+	}
+	return result
+}
+
+func heights(from, to uint64) []uint64 {
+	var result []uint64
+	for h := from; h <= to; h++ {
+		result = append(result, h)
+	}
+	return result
+}
+
+func TestChainCursor_resume(t *testing.T) {
+	chain := newTestChain(20, 0)
+
+	require.Nil(t, chain.process(newChainCursor(chain.head(), nil)))
+
+	token := &ChainToken{Height: 15, Hash: chain.hashAt(15)}
+	cursor := newChainCursor(chain.head(), token)
+	require.Equal(t, heights(16, 20), chain.process(cursor))
+	require.Equal(t, &ChainToken{Height: 20, Hash: chain.hashAt(20)}, cursor.token())
+
+	chain = newTestChain(maxResumeBlocks+100, 0)
+	token = &ChainToken{Height: 10, Hash: chain.hashAt(10)}
+	require.Equal(t, heights(101, maxResumeBlocks+100), chain.process(newChainCursor(chain.head(), token)))
+}
+
+func TestChainCursor_resumeReverted(t *testing.T) {
+	chain := newTestChain(20, 0)
+	token := &ChainToken{Height: 15, Hash: chain.hashAt(15)}
+
+	// the block of the token is replaced by the block of the same height
+	chain.reorg(12, 15, 1)
+	chain.final = 10
+	cursor := newChainCursor(chain.head(), token)
+	require.Equal(t, heights(11, 15), chain.process(cursor))
+	require.Equal(t, hexutil.Uint64(15), cursor.token().Height)
+	require.Equal(t, chain.hashAt(15), cursor.token().Hash)
+
+	// the chain is shorter than the token
+	chain = newTestChain(20, 0)
+	token = &ChainToken{Height: 20, Hash: chain.hashAt(20)}
+	chain.reorg(18, 19, 1)
+	chain.final = 15
+	require.Equal(t, heights(16, 19), chain.process(newChainCursor(chain.head(), token)))
+
+	// the fork point is limited by maxResumeBlocks if there is no final block
+	chain = newTestChain(maxResumeBlocks+100, 0)
+	token = &ChainToken{Height: maxResumeBlocks + 100, Hash: chain.hashAt(maxResumeBlocks + 100)}
+	chain.reorg(maxResumeBlocks+100, maxResumeBlocks+100, 1)
+	require.Equal(t, heights(101, maxResumeBlocks+100), chain.process(newChainCursor(chain.head(), token)))
+}
+
+func TestChainCursor_reorg(t *testing.T) {
+	chain := newTestChain(10, 0)
+	cursor := newChainCursor(chain.head(), nil)
+	chain.reorg(11, 15, 0)
+	require.Equal(t, heights(11, 15), chain.process(cursor))
+
+	// the same height reorg
+	chain.reorg(13, 15, 1)
+	require.Equal(t, heights(13, 15), chain.process(cursor))
+	require.Equal(t, chain.hashAt(15), cursor.token().Hash)
+
+	// the reorg to a shorter chain
+	chain.reorg(14, 14, 2)
+	require.Equal(t, []uint64{14}, chain.process(cursor))
+
+	// blocks which were not processed by the subscription are not notified
+	chain.reorg(5, 16, 3)
+	require.Equal(t, heights(11, 16), chain.process(cursor))
+
+	require.Nil(t, chain.process(cursor))
+}
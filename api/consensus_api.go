@@ -0,0 +1,118 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/consensus"
+	"sort"
+	"time"
+)
+
+// ConsensusApi offers introspection of the agreement protocol
+type ConsensusApi struct {
+	engine *consensus.Engine
+}
+
+// NewConsensusApi creates a new ConsensusApi instance
+func NewConsensusApi(engine *consensus.Engine) *ConsensusApi {
+	return &ConsensusApi{engine}
+}
+
+type RoundInfo struct {
+	Round          uint64          `json:"round"`
+	Step           uint8           `json:"step"`
+	Process        string          `json:"process"`
+	Start          time.Time       `json:"start"`
+	ProposerPubKey hexutil.Bytes   `json:"proposerPubKey"`
+	ProposedBlock  *common.Hash    `json:"proposedBlock"`
+	Steps          []RoundStepInfo `json:"steps"`
+}
+
+type RoundStepInfo struct {
+	Step           uint8        `json:"step"`
+	Start          *time.Time   `json:"start"`
+	Deadline       *time.Time   `json:"deadline"`
+	NecessaryVotes int          `json:"necessaryVotes"`
+	VotedHash      *common.Hash `json:"votedHash"`
+	Result         *common.Hash `json:"result"`
+	Completed      bool         `json:"completed"`
+	Votes          []StepVotes  `json:"votes"`
+}
+
+type StepVotes struct {
+	Hash  common.Hash `json:"hash"`
+	Count int         `json:"count"`
+}
+
+// RoundInfo returns the state of the current consensus round: the step, the seen proposal, votes collected per step
+// and step deadlines
+func (api *ConsensusApi) RoundInfo() RoundInfo {
+	info := api.engine.RoundInfo()
+	result := RoundInfo{
+		Round:          info.Round,
+		Step:           info.Step,
+		Process:        info.Process,
+		Start:          info.Start,
+		ProposerPubKey: info.ProposerPubKey,
+		ProposedBlock:  info.ProposedBlock,
+		Steps:          make([]RoundStepInfo, 0, len(info.Steps)),
+	}
+	for _, step := range info.Steps {
+		item := RoundStepInfo{
+			Step:           step.Step,
+			NecessaryVotes: step.NecessaryVotes,
+			VotedHash:      step.VotedHash,
+			Result:         step.Result,
+			Completed:      step.Completed,
+			Votes:          make([]StepVotes, 0, len(step.Votes)),
+		}
+		if !step.Start.IsZero() {
+			start, deadline := step.Start, step.Deadline
+			item.Start, item.Deadline = &start, &deadline
+		}
+		for hash, count := range step.Votes {
+			item.Votes = append(item.Votes, StepVotes{Hash: hash, Count: count})
+		}
+		sort.Slice(item.Votes, func(i, j int) bool {
+			return item.Votes[i].Count > item.Votes[j].Count
+		})
+		result.Steps = append(result.Steps, item)
+	}
+	return result
+}
+
+type EmptyBlock struct {
+	Height         uint64        `json:"height"`
+	Hash           common.Hash   `json:"hash"`
+	Reason         string        `json:"reason"`
+	ProposerPubKey hexutil.Bytes `json:"proposerPubKey"`
+	Timestamp      int64         `json:"timestamp"`
+}
+
+type EmptyBlocksResponse struct {
+	Blocks   []EmptyBlock     `json:"blocks"`
+	ByReason map[string]int64 `json:"byReason"`
+}
+
+// EmptyBlocks returns the last empty blocks reached by the node with their reasons, starting from the latest one,
+// and the numbers of empty blocks by reason since the start of the node
+func (api *ConsensusApi) EmptyBlocks() EmptyBlocksResponse {
+	records, counts := api.engine.EmptyBlocks()
+	result := EmptyBlocksResponse{
+		Blocks:   make([]EmptyBlock, 0, len(records)),
+		ByReason: make(map[string]int64, len(counts)),
+	}
+	for _, record := range records {
+		result.Blocks = append(result.Blocks, EmptyBlock{
+			Height:         record.Height,
+			Hash:           record.Hash,
+			Reason:         string(record.Reason),
+			ProposerPubKey: record.ProposerPubKey,
+			Timestamp:      record.Time,
+		})
+	}
+	for reason, count := range counts {
+		result.ByReason[string(reason)] = count
+	}
+	return result
+}
@@ -8,28 +8,39 @@ import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/hexutil"
 	"github.com/idena-network/idena-go/deferredtx"
+	"github.com/idena-network/idena-go/rpc"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/idena-network/idena-go/vm"
 	"github.com/idena-network/idena-go/vm/env"
 	"github.com/idena-network/idena-go/vm/helpers"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"math"
 	"math/big"
 	"strconv"
 )
 
+const (
+	// maxLogsBlockRange limits the block range of a contract events query
+	maxLogsBlockRange = 10000
+	// maxLogs limits the number of events returned by a contract events query
+	maxLogs = 10000
+)
+
 type ContractApi struct {
 	baseApi     *BaseApi
 	bc          *blockchain.Blockchain
 	deferredTxs *deferredtx.Job
 	subManager  *subscriptions.Manager
+	bus         eventbus.Bus
 }
 
 // NewContractApi creates a new NetApi instance
-func NewContractApi(baseApi *BaseApi, bc *blockchain.Blockchain, deferredTxs *deferredtx.Job, subManager *subscriptions.Manager) *ContractApi {
-	return &ContractApi{baseApi: baseApi, bc: bc, deferredTxs: deferredTxs, subManager: subManager}
+func NewContractApi(baseApi *BaseApi, bc *blockchain.Blockchain, deferredTxs *deferredtx.Job, subManager *subscriptions.Manager, bus eventbus.Bus) *ContractApi {
+	return &ContractApi{baseApi: baseApi, bc: bc, deferredTxs: deferredTxs, subManager: subManager, bus: bus}
 }
 
 type DeployArgs struct {
@@ -167,6 +178,11 @@ type TxReceipt struct {
 	Error    string          `json:"error"`
 	GasCost  decimal.Decimal `json:"gasCost"`
 	TxFee    decimal.Decimal `json:"txFee"`
+	// fields below are set for mined txs only
+	Status    string           `json:"status,omitempty"`
+	BlockHash *common.Hash     `json:"blockHash,omitempty"`
+	FeeBurned *decimal.Decimal `json:"feeBurned,omitempty"`
+	Events    []*Event         `json:"events,omitempty"`
 }
 
 type Event struct {
@@ -175,6 +191,63 @@ type Event struct {
 	Args     []hexutil.Bytes `json:"args"`
 }
 
+// ContractLog is a contract event with its position in the chain
+type ContractLog struct {
+	Event
+	BlockHeight uint64      `json:"blockHeight"`
+	BlockHash   common.Hash `json:"blockHash"`
+	TxHash      common.Hash `json:"txHash"`
+	Index       uint32      `json:"index"`
+}
+
+// LogFilterArgs selects contract events, an empty list matches any value. Topics are matched against event args
+// by position, an empty position matches any arg.
+type LogFilterArgs struct {
+	FromBlock uint64            `json:"fromBlock"`
+	ToBlock   *uint64           `json:"toBlock"`
+	Addresses []common.Address  `json:"addresses"`
+	Events    []string          `json:"events"`
+	Topics    [][]hexutil.Bytes `json:"topics"`
+}
+
+func (args LogFilterArgs) toFilter() *blockchain.LogFilter {
+	filter := &blockchain.LogFilter{
+		FromBlock: args.FromBlock,
+		Addresses: args.Addresses,
+		Events:    args.Events,
+	}
+	if args.ToBlock != nil {
+		filter.ToBlock = *args.ToBlock
+	} else {
+		filter.ToBlock = math.MaxUint64
+	}
+	for _, topics := range args.Topics {
+		position := make([][]byte, 0, len(topics))
+		for _, topic := range topics {
+			position = append(position, topic)
+		}
+		filter.Topics = append(filter.Topics, position)
+	}
+	return filter
+}
+
+func convertLog(l *blockchain.Log) *ContractLog {
+	result := &ContractLog{
+		Event: Event{
+			Contract: l.Contract,
+			Event:    l.Event.EventName,
+		},
+		BlockHeight: l.BlockHeight,
+		BlockHash:   l.BlockHash,
+		TxHash:      l.TxHash,
+		Index:       l.Index,
+	}
+	for i := range l.Event.Data {
+		result.Args = append(result.Args, l.Event.Data[i])
+	}
+	return result
+}
+
 type MapItem struct {
 	Key   interface{} `json:"key"`
 	Value interface{} `json:"value"`
@@ -189,10 +262,7 @@ func (api *ContractApi) buildDeployContractTx(args DeployArgs) (*types.Transacti
 	var codeHash common.Hash
 	codeHash.SetBytes(args.CodeHash)
 
-	from := args.From
-	if from == (common.Address{}) {
-		from = api.baseApi.getCurrentCoinbase()
-	}
+	from := api.baseApi.getSigner(&args.From)
 	convertedArgs, err := args.Args.ToSlice()
 	if err != nil {
 		return nil, err
@@ -206,10 +276,7 @@ func (api *ContractApi) buildDeployContractTx(args DeployArgs) (*types.Transacti
 
 func (api *ContractApi) buildCallContractTx(args CallArgs) (*types.Transaction, error) {
 
-	from := args.From
-	if from == (common.Address{}) {
-		from = api.baseApi.getCurrentCoinbase()
-	}
+	from := api.baseApi.getSigner(&args.From)
 	convertedArgs, err := args.Args.ToSlice()
 	if err != nil {
 		return nil, err
@@ -223,10 +290,7 @@ func (api *ContractApi) buildCallContractTx(args CallArgs) (*types.Transaction,
 
 func (api *ContractApi) buildTerminateContractTx(args TerminateArgs) (*types.Transaction, error) {
 
-	from := args.From
-	if from == (common.Address{}) {
-		from = api.baseApi.getCurrentCoinbase()
-	}
+	from := api.baseApi.getSigner(&args.From)
 	convertedArgs, err := args.Args.ToSlice()
 	if err != nil {
 		return nil, err
@@ -295,6 +359,9 @@ func convertReceipt(tx *types.Transaction, receipt *types.TxReceipt, feePerGas *
 	if receipt.Error != nil {
 		err = receipt.Error.Error()
 	}
+	if receipt.Fee != nil {
+		fee = receipt.Fee
+	}
 	return &TxReceipt{
 		Success:  receipt.Success,
 		Error:    err,
@@ -321,10 +388,7 @@ func (api *ContractApi) Call(ctx context.Context, args CallArgs) (common.Hash, e
 		return common.Hash{}, err
 	}
 	if args.BroadcastBlock > 0 {
-		from := args.From
-		if from.IsEmpty() {
-			from = api.baseApi.getCurrentCoinbase()
-		}
+		from := api.baseApi.getSigner(&args.From)
 
 		err = api.deferredTxs.AddDeferredTx(from, &args.Contract, blockchain.ConvertToInt(args.Amount), tx.Payload, common.Big0, args.BroadcastBlock)
 		return tx.Hash(), err
@@ -372,6 +436,46 @@ func (api *ContractApi) GetStake(contract common.Address) interface{} {
 	}
 }
 
+type StorageUsage struct {
+	StorageSize  uint64          `json:"storageSize"`
+	Stake        decimal.Decimal `json:"stake"`
+	RentPerEpoch decimal.Decimal `json:"rentPerEpoch"`
+	// PaidEpochs is the number of epochs the current stake covers storage rent for, nil if there is no rent
+	PaidEpochs *uint64 `json:"paidEpochs"`
+	// UnpaidRentEpochs is the number of epochs in a row the stake didn't cover storage rent,
+	// the contract is reclaimed when the rent isn't paid after GraceEpochs such epochs
+	UnpaidRentEpochs uint16 `json:"unpaidRentEpochs"`
+	GraceEpochs      uint16 `json:"graceEpochs"`
+}
+
+func (api *ContractApi) StorageUsage(contract common.Address) (*StorageUsage, error) {
+	appState := api.baseApi.getReadonlyAppState()
+	if appState.State.GetCodeHash(contract) == nil {
+		return nil, errors.New("contract not found")
+	}
+	size := appState.State.GetContractStorageSize(contract)
+	stake := appState.State.GetContractStake(contract)
+	result := &StorageUsage{
+		StorageSize:      size,
+		Stake:            blockchain.ConvertToFloat(stake),
+		RentPerEpoch:     decimal.Zero,
+		UnpaidRentEpochs: appState.State.GetContractUnpaidRentEpochs(contract),
+	}
+	consensus := api.bc.Config().Consensus
+	if !consensus.EnableContractStorageRent || common.ZeroOrNil(consensus.ContractStorageRentPerByte) || size == 0 {
+		return result, nil
+	}
+	result.GraceEpochs = consensus.ContractStorageRentGraceEpochs
+	rent := new(big.Int).Mul(consensus.ContractStorageRentPerByte, new(big.Int).SetUint64(size))
+	result.RentPerEpoch = blockchain.ConvertToFloat(rent)
+	var paidEpochs uint64
+	if stake != nil {
+		paidEpochs = new(big.Int).Div(stake, rent).Uint64()
+	}
+	result.PaidEpochs = &paidEpochs
+	return result, nil
+}
+
 func (api *ContractApi) SubscribeToEvent(contract common.Address, event string) error {
 	return api.subManager.Subscribe(contract, event)
 }
@@ -398,6 +502,81 @@ func (api *ContractApi) Events(args EventsArgs) interface{} {
 	return list
 }
 
+// ContractEvents notifies the subscriber about events emitted by the contract, all events are sent if the event name
+// is not set, the subscription is resumed from the block next to the token of the last received notification if it is set
+func (api *ContractApi) ContractEvents(ctx context.Context, contract common.Address, event *string, token *ChainToken) (*rpc.Subscription, error) {
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		if block.IsEmpty() {
+			return nil, nil
+		}
+		var result []interface{}
+		for _, tx := range block.Body.Transactions {
+			if tx.Type != types.DeployContractTx && (tx.To == nil || *tx.To != contract) {
+				continue
+			}
+			receipt := api.bc.GetReceipt(tx.Hash())
+			if receipt == nil || receipt.ContractAddress != contract {
+				continue
+			}
+			for _, e := range receipt.Events {
+				if event != nil && e.EventName != *event {
+					continue
+				}
+				item := &Event{
+					Contract: contract,
+					Event:    e.EventName,
+				}
+				for i := range e.Data {
+					item.Args = append(item.Args, e.Data[i])
+				}
+				result = append(result, item)
+			}
+		}
+		return result, nil
+	})
+}
+
+// GetLogs returns events of contracts emitted in the block range which match the filter, the range is limited
+// by maxLogsBlockRange blocks and is up to the head if the last block is not set
+func (api *ContractApi) GetLogs(args LogFilterArgs) ([]*ContractLog, error) {
+	filter := args.toFilter()
+	if filter.ToBlock == math.MaxUint64 {
+		filter.ToBlock = api.bc.Head.Height()
+	}
+	if filter.ToBlock < filter.FromBlock {
+		return nil, errors.New("toBlock is less than fromBlock")
+	}
+	if filter.ToBlock-filter.FromBlock >= maxLogsBlockRange {
+		return nil, errors.Errorf("block range exceeds %v blocks", maxLogsBlockRange)
+	}
+	logs, err := api.bc.GetLogs(filter, maxLogs)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*ContractLog, 0, len(logs))
+	for _, l := range logs {
+		result = append(result, convertLog(l))
+	}
+	return result, nil
+}
+
+// Logs notifies the subscriber about events of contracts which match the filter, the block range of the filter
+// is ignored and the subscription is resumed from the block next to the token if it is set
+func (api *ContractApi) Logs(ctx context.Context, args LogFilterArgs, token *ChainToken) (*rpc.Subscription, error) {
+	filter := args.toFilter()
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		logs, err := api.bc.BlockLogs(block.Header, filter)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read contract events of block %v", block.Height())
+		}
+		var result []interface{}
+		for _, l := range logs {
+			result = append(result, convertLog(l))
+		}
+		return result, nil
+	})
+}
+
 func (api *ContractApi) ReadMap(contract common.Address, mapName string, key hexutil.Bytes, format string) (interface{}, error) {
 	data := api.baseApi.getReadonlyAppState().State.GetContractValue(contract, env.FormatMapKey([]byte(mapName), key))
 	if data == nil {
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/shopspring/decimal"
+)
+
+// DebugApi offers tools for checking node behavior
+type DebugApi struct {
+	bc *blockchain.Blockchain
+}
+
+// NewDebugApi creates a new DebugApi instance
+func NewDebugApi(bc *blockchain.Blockchain) *DebugApi {
+	return &DebugApi{bc}
+}
+
+type DryRunBlock struct {
+	*Block
+	Size         int             `json:"size"`
+	TotalFee     decimal.Decimal `json:"totalFee"`
+	TotalTips    decimal.Decimal `json:"totalTips"`
+	UsedGas      uint64          `json:"usedGas"`
+	CandidateTxs int             `json:"candidateTxs"`
+	SkippedTxs   int             `json:"skippedTxs"`
+}
+
+// BuildBlockDryRun returns the block the node would propose right now, the block is neither signed nor broadcast
+func (api *DebugApi) BuildBlockDryRun() (*DryRunBlock, error) {
+	return convertToDryRunBlock(api.bc.BuildBlockDryRun())
+}
+
+func convertToDryRunBlock(result *blockchain.BlockBuildResult) (*DryRunBlock, error) {
+	data, err := result.Block.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	return &DryRunBlock{
+		Block:        convertToBlock(result.Block),
+		Size:         len(data),
+		TotalFee:     blockchain.ConvertToFloat(result.TotalFee),
+		TotalTips:    blockchain.ConvertToFloat(result.TotalTips),
+		UsedGas:      result.UsedGas,
+		CandidateTxs: result.CandidateTxs,
+		SkippedTxs:   result.CandidateTxs - len(result.Block.Body.Transactions),
+	}, nil
+}
@@ -10,15 +10,19 @@ import (
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/hexutil"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/ceremony"
 	"github.com/idena-network/idena-go/core/profile"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/deferredtx"
+	"github.com/idena-network/idena-go/rpc"
 	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
+	"math/big"
 	"time"
 )
 
@@ -28,11 +32,19 @@ type DnaApi struct {
 	ceremony       *ceremony.ValidationCeremony
 	appVersion     string
 	profileManager *profile.Manager
+	deferredTxs    *deferredtx.Job
+	bus            eventbus.Bus
+	node           NodeStatusProvider
+}
+
+// NodeStatusProvider reports the state of the node checked by health and readiness probes
+type NodeStatusProvider interface {
+	Status() *NodeStatus
 }
 
 func NewDnaApi(baseApi *BaseApi, bc *blockchain.Blockchain, ceremony *ceremony.ValidationCeremony, appVersion string,
-	profileManager *profile.Manager) *DnaApi {
-	return &DnaApi{bc, baseApi, ceremony, appVersion, profileManager}
+	profileManager *profile.Manager, deferredTxs *deferredtx.Job, bus eventbus.Bus, node NodeStatusProvider) *DnaApi {
+	return &DnaApi{bc, baseApi, ceremony, appVersion, profileManager, deferredTxs, bus, node}
 }
 
 type State struct {
@@ -80,6 +92,10 @@ type SendTxArgs struct {
 	Payload  *hexutil.Bytes  `json:"payload"`
 	Tips     decimal.Decimal `json:"tips"`
 	UseProto bool            `json:"useProto"`
+	// ExpiryHeight is the last block height the tx can be included at
+	ExpiryHeight uint64 `json:"expiryHeight"`
+	// Ttl sets expiry height relative to the current head, it is ignored if ExpiryHeight is set
+	Ttl uint64 `json:"ttl"`
 	BaseTxArgs
 }
 
@@ -111,6 +127,40 @@ type StoreToIpfsTxArgs struct {
 	BaseTxArgs
 }
 
+type LockStakeTxArgs struct {
+	To     common.Address  `json:"to"`
+	Amount decimal.Decimal `json:"amount"`
+	MaxFee decimal.Decimal `json:"maxFee"`
+	Delay  uint16          `json:"delay"`
+	Epochs uint16          `json:"epochs"`
+	BaseTxArgs
+}
+
+type ChangeBlockGasLimitTxArgs struct {
+	GasLimit uint64          `json:"gasLimit"`
+	MaxFee   decimal.Decimal `json:"maxFee"`
+	BaseTxArgs
+}
+
+type ChangeProposerThresholdTxArgs struct {
+	Threshold float64         `json:"threshold"`
+	MaxFee    decimal.Decimal `json:"maxFee"`
+	BaseTxArgs
+}
+
+type ChangeWordsDictionaryTxArgs struct {
+	Hash   common.Hash     `json:"hash"`
+	Size   uint32          `json:"size"`
+	MaxFee decimal.Decimal `json:"maxFee"`
+	BaseTxArgs
+}
+
+type AuthorizeAnswersSubmitterTxArgs struct {
+	Submitter common.Address  `json:"submitter"`
+	MaxFee    decimal.Decimal `json:"maxFee"`
+	BaseTxArgs
+}
+
 type Invite struct {
 	Hash     common.Hash    `json:"hash"`
 	Receiver common.Address `json:"receiver"`
@@ -126,7 +176,7 @@ func (api *DnaApi) SendInvite(ctx context.Context, args SendInviteArgs) (Invite,
 		receiver = crypto.PubkeyToAddress(key.PublicKey)
 	}
 
-	hash, err := api.baseApi.sendTx(ctx, api.baseApi.getCurrentCoinbase(), &receiver, types.InviteTx, args.Amount, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
+	hash, err := api.baseApi.sendTx(ctx, api.baseApi.getSigner(args.From), &receiver, types.InviteTx, args.Amount, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
 
 	if err != nil {
 		return Invite{}, err
@@ -180,7 +230,7 @@ func (api *DnaApi) ActivateInvite(ctx context.Context, args ActivateInviteArgs)
 }
 
 func (api *DnaApi) BecomeOnline(ctx context.Context, args BaseTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, nil, types.OnlineStatusTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateOnlineStatusAttachment(true), nil)
 
 	if err != nil {
@@ -191,7 +241,7 @@ func (api *DnaApi) BecomeOnline(ctx context.Context, args BaseTxArgs) (common.Ha
 }
 
 func (api *DnaApi) BecomeOffline(ctx context.Context, args BaseTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, nil, types.OnlineStatusTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateOnlineStatusAttachment(false), nil)
 
 	if err != nil {
@@ -202,7 +252,7 @@ func (api *DnaApi) BecomeOffline(ctx context.Context, args BaseTxArgs) (common.H
 }
 
 func (api *DnaApi) Delegate(ctx context.Context, args DelegateTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, args.To, types.DelegateTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
 
 	if err != nil {
@@ -213,7 +263,7 @@ func (api *DnaApi) Delegate(ctx context.Context, args DelegateTxArgs) (common.Ha
 }
 
 func (api *DnaApi) Undelegate(ctx context.Context, args BaseTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, nil, types.UndelegateTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
 
 	if err != nil {
@@ -223,7 +273,7 @@ func (api *DnaApi) Undelegate(ctx context.Context, args BaseTxArgs) (common.Hash
 }
 
 func (api *DnaApi) KillDelegator(ctx context.Context, args KillDelegatorTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, args.To, types.KillDelegatorTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
 
 	if err != nil {
@@ -233,7 +283,7 @@ func (api *DnaApi) KillDelegator(ctx context.Context, args KillDelegatorTxArgs)
 }
 
 func (api *DnaApi) StoreToIpfs(ctx context.Context, args StoreToIpfsTxArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(args.From)
 	c, err := cid.Decode(args.Cid)
 	if err != nil {
 		return common.Hash{}, err
@@ -251,6 +301,155 @@ func (api *DnaApi) StoreToIpfs(ctx context.Context, args StoreToIpfsTxArgs) (com
 	return hash, nil
 }
 
+func (api *DnaApi) LockStake(ctx context.Context, args LockStakeTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	hash, err := api.baseApi.sendTx(ctx, from, &args.To, types.LockStakeTx, args.Amount, args.MaxFee, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateLockStakeAttachment(args.Delay, args.Epochs), nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// ChangeBlockGasLimit sends god tx which changes the gas limit of blocks, the recipient of the tx is the god address
+func (api *DnaApi) ChangeBlockGasLimit(ctx context.Context, args ChangeBlockGasLimitTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	god := api.baseApi.getReadonlyAppState().State.GodAddress()
+	hash, err := api.baseApi.sendTx(ctx, from, &god, types.ChangeBlockGasLimitTx, decimal.Zero, args.MaxFee, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateChangeBlockGasLimitAttachment(args.GasLimit), nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// ChangeProposerThreshold sends god tx which sets the minimal proposer VRF threshold of the test network,
+// the threshold can't exceed validation.MaxGovernedProposerThreshold, zero threshold resets it to the value of the network config
+func (api *DnaApi) ChangeProposerThreshold(ctx context.Context, args ChangeProposerThresholdTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	god := api.baseApi.getReadonlyAppState().State.GodAddress()
+	hash, err := api.baseApi.sendTx(ctx, from, &god, types.ChangeProposerThresholdTx, decimal.Zero, args.MaxFee, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateChangeProposerThresholdAttachment(args.Threshold), nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// ChangeWordsDictionary sends god tx which pins the flip words dictionary of next epochs,
+// zero hash and size reset it to the built-in dictionary
+func (api *DnaApi) ChangeWordsDictionary(ctx context.Context, args ChangeWordsDictionaryTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	god := api.baseApi.getReadonlyAppState().State.GodAddress()
+	hash, err := api.baseApi.sendTx(ctx, from, &god, types.ChangeWordsDictionaryTx, decimal.Zero, args.MaxFee, decimal.Zero, args.Nonce, args.Epoch, attachments.CreateChangeWordsDictionaryAttachment(args.Hash, args.Size), nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// RegisterBlsKey sends tx which registers the BLS key of the node, the key is used to aggregate votes of the node
+func (api *DnaApi) RegisterBlsKey(ctx context.Context, args BaseTxArgs) (common.Hash, error) {
+	from := api.baseApi.getCurrentCoinbase()
+	payload := attachments.CreateRegisterBlsKeyAttachment(api.baseApi.secStore.BlsPublicKey(), api.baseApi.secStore.BlsProof())
+	hash, err := api.baseApi.sendTx(ctx, from, nil, types.RegisterBlsKeyTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, payload, nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+// AuthorizeAnswersSubmitter sends tx which authorizes the session key address to submit ceremony answers
+// of the node identity, the own address revokes the authorization
+func (api *DnaApi) AuthorizeAnswersSubmitter(ctx context.Context, args AuthorizeAnswersSubmitterTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	hash, err := api.baseApi.sendTx(ctx, from, &args.Submitter, types.AuthorizeAnswersSubmitterTx, decimal.Zero, args.MaxFee, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+func (api *DnaApi) UnlockStake(ctx context.Context, args BaseTxArgs) (common.Hash, error) {
+	from := api.baseApi.getSigner(args.From)
+	hash, err := api.baseApi.sendTx(ctx, from, nil, types.UnlockStakeTx, decimal.Zero, decimal.Zero, decimal.Zero, args.Nonce, args.Epoch, nil, nil)
+
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return hash, nil
+}
+
+type StakeLock struct {
+	Amount     decimal.Decimal `json:"amount"`
+	Unlocked   decimal.Decimal `json:"unlocked"`
+	StartEpoch uint16          `json:"startEpoch"`
+	Epochs     uint16          `json:"epochs"`
+}
+
+type StakeUnlock struct {
+	Epoch  uint16          `json:"epoch"`
+	Amount decimal.Decimal `json:"amount"`
+}
+
+type StakeLocks struct {
+	Locked     decimal.Decimal `json:"locked"`
+	Releasable decimal.Decimal `json:"releasable"`
+	Locks      []StakeLock     `json:"locks"`
+	// Timeline contains amounts which become available in every future epoch
+	Timeline []StakeUnlock `json:"timeline"`
+}
+
+// StakeLocks returns stake locks of the address and their unlock timeline starting from the next epoch
+func (api *DnaApi) StakeLocks(address common.Address) StakeLocks {
+	appState := api.baseApi.getReadonlyAppState()
+	epoch := appState.State.Epoch()
+	locks := appState.State.GetStakeLocks(address)
+
+	amounts := make(map[uint16]*big.Int)
+	var lastEpoch uint16
+	result := StakeLocks{
+		Locked:     blockchain.ConvertToFloat(appState.State.GetLockedStake(address)),
+		Releasable: blockchain.ConvertToFloat(appState.State.GetReleasableStake(address, epoch)),
+		Locks:      []StakeLock{},
+		Timeline:   []StakeUnlock{},
+	}
+	for _, lock := range locks {
+		result.Locks = append(result.Locks, StakeLock{
+			Amount:     blockchain.ConvertToFloat(lock.Amount),
+			Unlocked:   blockchain.ConvertToFloat(lock.Unlocked),
+			StartEpoch: lock.StartEpoch,
+			Epochs:     lock.Epochs,
+		})
+		end := lock.StartEpoch + lock.Epochs - 1
+		for e := epoch + 1; e <= end && e > epoch; e++ {
+			amount := new(big.Int).Sub(lock.UnlockedAt(e), lock.UnlockedAt(e-1))
+			if amount.Sign() == 0 {
+				continue
+			}
+			if _, ok := amounts[e]; !ok {
+				amounts[e] = new(big.Int)
+			}
+			amounts[e].Add(amounts[e], amount)
+		}
+		if end > lastEpoch {
+			lastEpoch = end
+		}
+	}
+	for e := epoch + 1; e <= lastEpoch && e > epoch; e++ {
+		if amount, ok := amounts[e]; ok {
+			result.Timeline = append(result.Timeline, StakeUnlock{
+				Epoch:  e,
+				Amount: blockchain.ConvertToFloat(amount),
+			})
+		}
+	}
+	return result
+}
+
 func (api *DnaApi) SendTransaction(ctx context.Context, args SendTxArgs) (common.Hash, error) {
 
 	var payload []byte
@@ -263,7 +462,98 @@ func (api *DnaApi) SendTransaction(ctx context.Context, args SendTxArgs) (common
 		args.To = nil
 	}
 
-	return api.baseApi.sendTx(ctx, args.From, args.To, args.Type, args.Amount, args.MaxFee, args.Tips, args.Nonce, args.Epoch, payload, nil)
+	from := api.baseApi.getSigner(&args.From)
+	tx := api.baseApi.getTx(from, args.To, args.Type, args.Amount, args.MaxFee, args.Tips, args.Nonce, args.Epoch, payload)
+	tx.ExpiryHeight = api.baseApi.getExpiryHeight(args.ExpiryHeight, args.Ttl)
+
+	signedTx, err := api.baseApi.signTransaction(from, tx, nil)
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return api.baseApi.sendInternalTx(ctx, signedTx)
+}
+
+type ScheduleTxArgs struct {
+	Type    types.TxType    `json:"type"`
+	From    common.Address  `json:"from"`
+	To      *common.Address `json:"to"`
+	Amount  decimal.Decimal `json:"amount"`
+	MaxFee  decimal.Decimal `json:"maxFee"`
+	Payload *hexutil.Bytes  `json:"payload"`
+	Tips    decimal.Decimal `json:"tips"`
+	// BroadcastBlock is the height the tx is broadcast at
+	BroadcastBlock uint64 `json:"broadcastBlock"`
+	// BroadcastEpoch is the epoch the tx is broadcast at
+	BroadcastEpoch uint16 `json:"broadcastEpoch"`
+	// AfterValidation schedules the tx for the first block of the next epoch
+	AfterValidation bool `json:"afterValidation"`
+}
+
+type ScheduledTx struct {
+	Id             common.Hash     `json:"id"`
+	Type           string          `json:"type"`
+	From           common.Address  `json:"from"`
+	To             *common.Address `json:"to"`
+	Amount         decimal.Decimal `json:"amount"`
+	MaxFee         decimal.Decimal `json:"maxFee"`
+	Tips           decimal.Decimal `json:"tips"`
+	Payload        hexutil.Bytes   `json:"payload"`
+	BroadcastBlock uint64          `json:"broadcastBlock"`
+	BroadcastEpoch uint16          `json:"broadcastEpoch"`
+}
+
+// ScheduleTransaction schedules a tx to be signed and sent at the given block or epoch,
+// if max fee is not set it is calculated at the broadcast time
+func (api *DnaApi) ScheduleTransaction(args ScheduleTxArgs) (common.Hash, error) {
+	if _, ok := txTypeMap[args.Type]; !ok {
+		return common.Hash{}, errors.New("unknown tx type")
+	}
+	epoch := args.BroadcastEpoch
+	if args.AfterValidation {
+		epoch = api.baseApi.getReadonlyAppState().State.Epoch() + 1
+	}
+	if args.BroadcastBlock == 0 && epoch == 0 {
+		return common.Hash{}, errors.New("broadcast block or epoch should be set")
+	}
+	var payload []byte
+	if args.Payload != nil {
+		payload = *args.Payload
+	}
+	return api.deferredTxs.ScheduleTx(&deferredtx.DeferredTx{
+		Type:           args.Type,
+		From:           args.From,
+		To:             args.To,
+		Amount:         blockchain.ConvertToInt(args.Amount),
+		MaxFee:         blockchain.ConvertToInt(args.MaxFee),
+		Tips:           blockchain.ConvertToInt(args.Tips),
+		Payload:        payload,
+		BroadcastBlock: args.BroadcastBlock,
+		BroadcastEpoch: epoch,
+	})
+}
+
+func (api *DnaApi) ScheduledTransactions() []*ScheduledTx {
+	var result []*ScheduledTx
+	for _, tx := range api.deferredTxs.ScheduledTxs() {
+		result = append(result, &ScheduledTx{
+			Id:             tx.Id,
+			Type:           txTypeMap[tx.Type],
+			From:           tx.From,
+			To:             tx.To,
+			Amount:         blockchain.ConvertToFloat(tx.Amount),
+			MaxFee:         blockchain.ConvertToFloat(tx.MaxFee),
+			Tips:           blockchain.ConvertToFloat(tx.Tips),
+			Payload:        tx.Payload,
+			BroadcastBlock: tx.BroadcastBlock,
+			BroadcastEpoch: tx.BroadcastEpoch,
+		})
+	}
+	return result
+}
+
+func (api *DnaApi) CancelScheduledTransaction(id common.Hash) error {
+	return api.deferredTxs.CancelTx(id)
 }
 
 type FlipWords struct {
@@ -302,34 +592,51 @@ type Identity struct {
 	Delegatee           *common.Address `json:"delegatee"`
 	DelegationEpoch     uint16          `json:"delegationEpoch"`
 	DelegationNonce     uint32          `json:"delegationNonce"`
+	AnswersSubmitter    *common.Address `json:"answersSubmitter"`
 	IsPool              bool            `json:"isPool"`
 	Inviter             *Inviter        `json:"inviter"`
 }
 
 func (api *DnaApi) Identities() []Identity {
+	return api.identitiesPage(nil, 0, nil)
+}
+
+// identitiesPage returns identities matching the filter in the order of addresses starting after the given address
+// if it is set, count limits the number of identities unless it is zero
+func (api *DnaApi) identitiesPage(after *common.Address, count int, filter func(data *state.Identity) bool) []Identity {
 	var identities []Identity
 
 	appState := api.baseApi.getReadonlyAppState()
 
 	epoch := appState.State.Epoch()
-	appState.State.IterateIdentities(func(key []byte, value []byte) bool {
+	from := common.MinAddr
+	if after != nil {
+		from = *after
+	}
+	appState.State.IterateIdentitiesFrom(from, func(key []byte, value []byte) bool {
 		if key == nil {
 			return true
 		}
 		addr := common.Address{}
 		addr.SetBytes(key[1:])
+		if after != nil && addr == *after {
+			return false
+		}
 
 		var data state.Identity
 		if err := data.FromBytes(value); err != nil {
 			return false
 		}
+		if filter != nil && !filter(&data) {
+			return false
+		}
 		var flipKeyWordPairs []int
 		if addr == api.GetCoinbaseAddr() {
 			flipKeyWordPairs = api.ceremony.FlipKeyWordPairs()
 		}
 		identities = append(identities, convertIdentity(epoch, addr, data, flipKeyWordPairs, appState))
 
-		return false
+		return count > 0 && len(identities) == count
 	})
 
 	return identities
@@ -348,27 +655,7 @@ func (api *DnaApi) Identity(address *common.Address) Identity {
 }
 
 func convertIdentity(currentEpoch uint16, address common.Address, data state.Identity, flipKeyWordPairs []int, appState *appstate.AppState) Identity {
-	var s string
-	switch data.State {
-	case state.Invite:
-		s = "Invite"
-	case state.Candidate:
-		s = "Candidate"
-	case state.Newbie:
-		s = "Newbie"
-	case state.Verified:
-		s = "Verified"
-	case state.Suspended:
-		s = "Suspended"
-	case state.Zombie:
-		s = "Zombie"
-	case state.Killed:
-		s = "Killed"
-	case state.Human:
-		s = "Human"
-	default:
-		s = "Undefined"
-	}
+	s := data.State.Name()
 
 	var flags []string
 	if data.LastValidationStatus.HasFlag(state.AllFlipsNotQualified) {
@@ -468,6 +755,7 @@ func convertIdentity(currentEpoch uint16, address common.Address, data state.Ide
 		Delegatee:           delegatee,
 		DelegationEpoch:     data.DelegationEpoch,
 		DelegationNonce:     data.DelegationNonce,
+		AnswersSubmitter:    data.AnswersSubmitter,
 		Online:              isOnline,
 		IsPool:              appState.ValidatorsCache.IsPool(address),
 		Inviter:             inviter,
@@ -483,13 +771,23 @@ type Epoch struct {
 
 func (api *DnaApi) Epoch() Epoch {
 	s := api.baseApi.getReadonlyAppState()
+	return Epoch{
+		Epoch:          s.State.Epoch(),
+		StartBlock:     s.State.EpochBlock(),
+		NextValidation: s.State.NextValidationTime(),
+		CurrentPeriod:  CeremonyPeriod(s, api.ceremony),
+	}
+}
+
+// CeremonyPeriod returns the name of the current period of the validation ceremony
+func CeremonyPeriod(s *appstate.AppState, ceremony *ceremony.ValidationCeremony) string {
 	var res string
 	switch s.State.ValidationPeriod() {
 	case state.NonePeriod:
 		res = "None"
 	case state.FlipLotteryPeriod:
 		res = "FlipLottery"
-		if api.ceremony.ShortSessionStarted() {
+		if ceremony.ShortSessionStarted() {
 			res = "ShortSession"
 		}
 	case state.ShortSessionPeriod:
@@ -499,13 +797,471 @@ func (api *DnaApi) Epoch() Epoch {
 	case state.AfterLongSessionPeriod:
 		res = "AfterLongSession"
 	}
+	return res
+}
 
-	return Epoch{
-		Epoch:          s.State.Epoch(),
-		StartBlock:     s.State.EpochBlock(),
-		NextValidation: s.State.NextValidationTime(),
-		CurrentPeriod:  res,
+type NodeStatus struct {
+	// Healthy is false if the node can't keep working properly and should be restarted or fixed
+	Healthy bool `json:"healthy"`
+	// Ready is true if the node is healthy, synchronized and connected to peers, so it serves the actual chain
+	Ready        bool   `json:"ready"`
+	Syncing      bool   `json:"syncing"`
+	CurrentBlock uint64 `json:"currentBlock"`
+	HighestBlock uint64 `json:"highestBlock"`
+	Peers        int    `json:"peers"`
+	Epoch        uint16 `json:"epoch"`
+	// CeremonyPeriod is the current period of the validation ceremony
+	CeremonyPeriod   string `json:"ceremonyPeriod"`
+	KeystoreAccounts int    `json:"keystoreAccounts"`
+	// UnlockedAccounts are keystore accounts which can sign txs without a passphrase
+	UnlockedAccounts []common.Address `json:"unlockedAccounts"`
+	// FreeDiskSpace is the space in bytes available on the file system of the data dir
+	FreeDiskSpace    uint64 `json:"freeDiskSpace"`
+	MinFreeDiskSpace uint64 `json:"minFreeDiskSpace"`
+	// Problems are reasons why the node isn't healthy or ready
+	Problems []string `json:"problems,omitempty"`
+}
+
+// NodeStatus returns the state of the node served by /health and /ready HTTP endpoints along with the details
+func (api *DnaApi) NodeStatus() *NodeStatus {
+	return api.node.Status()
+}
+
+// maxWatchedIdentities limits the number of addresses watched by a subscription to identity changes
+const maxWatchedIdentities = 100
+
+type EpochPhase struct {
+	Epoch     uint16 `json:"epoch"`
+	Phase     string `json:"phase"`
+	Height    uint64 `json:"height"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// EpochPhases notifies the subscriber about transitions between phases of the validation ceremony, the subscription
+// is resumed from the block next to the token of the last received notification if it is set
+func (api *DnaApi) EpochPhases(ctx context.Context, token *ChainToken) (*rpc.Subscription, error) {
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		var phase string
+		flags := block.Header.Flags()
+		switch {
+		case flags.HasFlag(types.FlipLotteryStarted):
+			phase = "FlipLottery"
+		case flags.HasFlag(types.ShortSessionStarted):
+			phase = "ShortSession"
+		case flags.HasFlag(types.LongSessionStarted):
+			phase = "LongSession"
+		case flags.HasFlag(types.AfterLongSessionStarted):
+			phase = "AfterLongSession"
+		case flags.HasFlag(types.ValidationFinished):
+			phase = "None"
+		default:
+			return nil, nil
+		}
+		blockState, err := api.baseApi.stateAt(block.Height())
+		if err != nil {
+			return nil, err
+		}
+		return []interface{}{&EpochPhase{
+			Epoch:     blockState.Epoch(),
+			Phase:     phase,
+			Height:    block.Height(),
+			Timestamp: block.Header.Time(),
+		}}, nil
+	})
+}
+
+type IdentityChange struct {
+	Address   common.Address `json:"address"`
+	PrevState string         `json:"prevState"`
+	State     string         `json:"state"`
+	Height    uint64         `json:"height"`
+}
+
+// IdentityChanges notifies the subscriber about changes of states of the watched identities, the subscription
+// is resumed from the block next to the token of the last received notification if it is set
+func (api *DnaApi) IdentityChanges(ctx context.Context, addresses []common.Address, token *ChainToken) (*rpc.Subscription, error) {
+	if len(addresses) == 0 {
+		return nil, errors.New("no addresses to watch")
+	}
+	if len(addresses) > maxWatchedIdentities {
+		return nil, errors.Errorf("too many addresses to watch, max %v", maxWatchedIdentities)
+	}
+	var states map[common.Address]state.IdentityState
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		if states == nil {
+			prevState, err := api.baseApi.stateAt(block.Height() - 1)
+			if err != nil {
+				return nil, err
+			}
+			states = make(map[common.Address]state.IdentityState, len(addresses))
+			for _, addr := range addresses {
+				states[addr] = prevState.GetIdentityState(addr)
+			}
+		}
+		if !identitiesTouched(block, states) {
+			return nil, nil
+		}
+		blockState, err := api.baseApi.stateAt(block.Height())
+		if err != nil {
+			return nil, err
+		}
+		var result []interface{}
+		for _, addr := range addresses {
+			identityState := blockState.GetIdentityState(addr)
+			if identityState == states[addr] {
+				continue
+			}
+			result = append(result, &IdentityChange{
+				Address:   addr,
+				PrevState: states[addr].Name(),
+				State:     identityState.Name(),
+				Height:    block.Height(),
+			})
+			states[addr] = identityState
+		}
+		return result, nil
+	})
+}
+
+// identitiesTouched reports whether the block can change states of the given identities
+func identitiesTouched(block *types.Block, identities map[common.Address]state.IdentityState) bool {
+	if block.Header.Flags().HasFlag(types.IdentityUpdate) {
+		return true
+	}
+	if block.IsEmpty() {
+		return false
+	}
+	for _, tx := range block.Body.Transactions {
+		if tx.To != nil {
+			if _, ok := identities[*tx.To]; ok {
+				return true
+			}
+		}
+		if sender, _ := types.Sender(tx); sender != (common.Address{}) {
+			if _, ok := identities[sender]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type EpochSummary struct {
+	Epoch      uint16          `json:"epoch"`
+	Block      uint64          `json:"block"`
+	FirstBlock uint64          `json:"firstBlock"`
+	Minted     decimal.Decimal `json:"minted"`
+	FeeBurnt   decimal.Decimal `json:"feeBurnt"`
+	// Identities contains counts of identities by state after the validation
+	Identities map[string]uint32 `json:"identities"`
+	// ValidationResults contains counts of new states of identities which took part in the validation
+	ValidationResults map[string]uint32 `json:"validationResults"`
+}
+
+// EpochSummary returns the summary of the finished epoch recorded by the node at the epoch change
+func (api *DnaApi) EpochSummary(epoch uint16) (*EpochSummary, error) {
+	summary := api.bc.GetEpochSummary(epoch)
+	if summary == nil {
+		return nil, errors.Errorf("summary of epoch %v is not found", epoch)
+	}
+	convertCounts := func(counts map[uint8]uint32) map[string]uint32 {
+		result := make(map[string]uint32, len(counts))
+		for identityState, count := range counts {
+			result[state.IdentityState(identityState).Name()] += count
+		}
+		return result
+	}
+	return &EpochSummary{
+		Epoch:             summary.Epoch,
+		Block:             summary.Height,
+		FirstBlock:        summary.FirstBlock,
+		Minted:            blockchain.ConvertToFloat(summary.Minted),
+		FeeBurnt:          blockchain.ConvertToFloat(summary.FeeBurnt),
+		Identities:        convertCounts(summary.Identities),
+		ValidationResults: convertCounts(summary.ValidationResults),
+	}, nil
+}
+
+type ValidationSessionResult struct {
+	FlipsToSolve   uint32  `json:"flipsToSolve"`
+	MissedAnswers  uint32  `json:"missedAnswers"`
+	QualifiedFlips uint32  `json:"qualifiedFlips"`
+	Point          float32 `json:"point"`
+	Score          float32 `json:"score"`
+	// Answered is false if answers of the session were not submitted
+	Answered         bool `json:"answered"`
+	NoQualifiedFlips bool `json:"noQualifiedFlips"`
+}
+
+type ValidationThresholds struct {
+	MinShortScore       float32 `json:"minShortScore"`
+	MinLongScore        float32 `json:"minLongScore"`
+	MinTotalScore       float32 `json:"minTotalScore"`
+	MinHumanTotalScore  float32 `json:"minHumanTotalScore"`
+	MinFlipsForVerified uint32  `json:"minFlipsForVerified"`
+	MinFlipsForHuman    uint32  `json:"minFlipsForHuman"`
+}
+
+type ValidationResult struct {
+	Address   common.Address `json:"address"`
+	Epoch     uint16         `json:"epoch"`
+	PrevState string         `json:"prevState"`
+	NewState  string         `json:"newState"`
+	// Reason is the first check of the validation which the identity failed or "passed"
+	Reason              string                  `json:"reason"`
+	Approved            bool                    `json:"approved"`
+	Missed              bool                    `json:"missed"`
+	ValidationFailed    bool                    `json:"validationFailed"`
+	RequiredFlips       uint32                  `json:"requiredFlips"`
+	MadeFlips           uint32                  `json:"madeFlips"`
+	ReportedFlips       uint32                  `json:"reportedFlips"`
+	BadAuthorReason     *string                 `json:"badAuthorReason,omitempty"`
+	Short               ValidationSessionResult `json:"short"`
+	Long                ValidationSessionResult `json:"long"`
+	TotalQualifiedFlips uint32                  `json:"totalQualifiedFlips"`
+	TotalScore          float32                 `json:"totalScore"`
+	Thresholds          ValidationThresholds    `json:"thresholds"`
+}
+
+// ValidationResult explains the new state of the identity after the validation, the last validation is used
+// if the epoch is not specified, results are available only for validations processed by the node
+func (api *DnaApi) ValidationResult(address common.Address, epoch *uint16) (*ValidationResult, error) {
+	var e uint16
+	if epoch != nil {
+		e = *epoch
+	} else {
+		current := api.baseApi.getReadonlyAppState().State.Epoch()
+		if current == 0 {
+			return nil, errors.New("no validation has been finished yet")
+		}
+		e = current - 1
+	}
+	explanation := api.ceremony.ValidationExplanation(e, address)
+	if explanation == nil {
+		return nil, errors.Errorf("validation result of %v for epoch %v is not found", address.Hex(), e)
+	}
+	convertSession := func(session types.SessionExplanation) ValidationSessionResult {
+		return ValidationSessionResult{
+			FlipsToSolve:     session.FlipsToSolve,
+			MissedAnswers:    session.MissedAnswers,
+			QualifiedFlips:   session.QualifiedFlips,
+			Point:            session.Point,
+			Score:            session.Score,
+			Answered:         !session.NoAnswers,
+			NoQualifiedFlips: session.NoQualifiedFlips,
+		}
+	}
+	result := &ValidationResult{
+		Address:             address,
+		Epoch:               explanation.Epoch,
+		PrevState:           state.IdentityState(explanation.PrevState).Name(),
+		NewState:            state.IdentityState(explanation.NewState).Name(),
+		Reason:              explanation.Reason,
+		Approved:            explanation.Approved,
+		Missed:              explanation.Missed,
+		ValidationFailed:    explanation.ValidationFailed,
+		RequiredFlips:       explanation.RequiredFlips,
+		MadeFlips:           explanation.MadeFlips,
+		ReportedFlips:       explanation.ReportedFlips,
+		Short:               convertSession(explanation.Short),
+		Long:                convertSession(explanation.Long),
+		TotalQualifiedFlips: explanation.TotalQualifiedFlips,
+		TotalScore:          explanation.TotalScore,
+		Thresholds: ValidationThresholds{
+			MinShortScore:       common.MinShortScore,
+			MinLongScore:        common.MinLongScore,
+			MinTotalScore:       common.MinTotalScore,
+			MinHumanTotalScore:  common.MinHumanTotalScore,
+			MinFlipsForVerified: common.MinFlipsForVerified,
+			MinFlipsForHuman:    common.MinFlipsForHuman,
+		},
+	}
+	if explanation.BadAuthor {
+		var reason string
+		switch explanation.BadAuthorReason {
+		case types.NoQualifiedFlipsBadAuthor:
+			reason = "noQualifiedFlips"
+		case types.QualifiedByNoneBadAuthor:
+			reason = "qualifiedByNone"
+		case types.WrongWordsBadAuthor:
+			reason = "wrongWords"
+		}
+		result.BadAuthorReason = &reason
+	}
+	return result, nil
+}
+
+type FlipLotteryAuditArgs struct {
+	// Block is the height of the block which finished the validation, the block of the epoch summary is used if it is omitted
+	Block      *uint64                    `json:"block"`
+	Epoch      *uint16                    `json:"epoch"`
+	Seed       hexutil.Bytes              `json:"seed"`
+	Candidates []FlipLotteryCandidateArgs `json:"candidates"`
+}
+
+type FlipLotteryCandidateArgs struct {
+	Address common.Address `json:"address"`
+	Flips   []string       `json:"flips"`
+}
+
+type FlipLotteryAudit struct {
+	LotteryBlock    uint64                   `json:"lotteryBlock"`
+	SeedBlock       uint64                   `json:"seedBlock"`
+	ValidationBlock uint64                   `json:"validationBlock"`
+	Seed            hexutil.Bytes            `json:"seed"`
+	Assignments     []*FlipLotteryAssignment `json:"assignments"`
+	ShortAnswers    int                      `json:"shortAnswers"`
+	LongAnswers     int                      `json:"longAnswers"`
+	Mismatches      []*AnswersMismatch       `json:"mismatches"`
+}
+
+type FlipLotteryAssignment struct {
+	Address    common.Address `json:"address"`
+	ShortFlips []string       `json:"shortFlips"`
+	LongFlips  []string       `json:"longFlips"`
+}
+
+type AnswersMismatch struct {
+	Address common.Address `json:"address"`
+	TxHash  common.Hash    `json:"txHash"`
+	Session string         `json:"session"`
+	Reason  string         `json:"reason"`
+}
+
+// AuditFlipLottery recomputes the flips distribution of the validation for the given candidates and verifies
+// short and long answers submitted to the chain against it
+func (api *DnaApi) AuditFlipLottery(args FlipLotteryAuditArgs) (*FlipLotteryAudit, error) {
+	var height uint64
+	if args.Block != nil {
+		height = *args.Block
+	} else if args.Epoch != nil {
+		summary := api.bc.GetEpochSummary(*args.Epoch)
+		if summary == nil {
+			return nil, errors.Errorf("summary of epoch %v is not found", *args.Epoch)
+		}
+		height = summary.Height
+	} else {
+		return nil, errors.New("block or epoch should be specified")
+	}
+	candidates := make([]*ceremony.LotteryCandidate, 0, len(args.Candidates))
+	for _, item := range args.Candidates {
+		candidate := &ceremony.LotteryCandidate{
+			Address: item.Address,
+		}
+		for _, hash := range item.Flips {
+			c, err := cid.Decode(hash)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid flip %v of %v", hash, item.Address.Hex())
+			}
+			candidate.Flips = append(candidate.Flips, c.Bytes())
+		}
+		candidates = append(candidates, candidate)
+	}
+	var seed []byte
+	if len(args.Seed) > 0 {
+		seed = args.Seed
+	}
+	audit, err := ceremony.AuditFlipLottery(api.bc, api.bc.GenesisInfo().Genesis.Height(), height, seed, candidates)
+	if err != nil {
+		return nil, err
+	}
+	return convertFlipLotteryAudit(audit), nil
+}
+
+func convertFlipLotteryAudit(audit *ceremony.LotteryAudit) *FlipLotteryAudit {
+	convertFlips := func(flips [][]byte) []string {
+		result := make([]string, 0, len(flips))
+		for _, flip := range flips {
+			c, _ := cid.Cast(flip)
+			result = append(result, c.String())
+		}
+		return result
+	}
+	result := &FlipLotteryAudit{
+		LotteryBlock:    audit.LotteryBlock,
+		SeedBlock:       audit.SeedBlock,
+		ValidationBlock: audit.ValidationBlock,
+		Seed:            audit.Seed,
+		ShortAnswers:    audit.ShortAnswers,
+		LongAnswers:     audit.LongAnswers,
+		Mismatches:      []*AnswersMismatch{},
+	}
+	for _, assignment := range audit.Assignments {
+		result.Assignments = append(result.Assignments, &FlipLotteryAssignment{
+			Address:    assignment.Address,
+			ShortFlips: convertFlips(assignment.ShortFlips),
+			LongFlips:  convertFlips(assignment.LongFlips),
+		})
+	}
+	for _, mismatch := range audit.Mismatches {
+		result.Mismatches = append(result.Mismatches, &AnswersMismatch{
+			Address: mismatch.Address,
+			TxHash:  mismatch.TxHash,
+			Session: mismatch.Session,
+			Reason:  mismatch.Reason,
+		})
+	}
+	return result
+}
+
+type MinerPerformance struct {
+	Epoch             uint16  `json:"epoch"`
+	Rounds            uint32  `json:"rounds"`
+	ExpectedProposals uint32  `json:"expectedProposals"`
+	Proposals         uint32  `json:"proposals"`
+	ExpectedVotes     uint32  `json:"expectedVotes"`
+	Votes             uint32  `json:"votes"`
+	LastMissedRound   uint64  `json:"lastMissedRound"`
+	Reliability       float64 `json:"reliability"`
+	// RecentDuties contains the last rounds the node identity had duties in since the start of the node
+	RecentDuties []*Duty `json:"recentDuties"`
+}
+
+type Duty struct {
+	Round      uint64  `json:"round"`
+	Propose    bool    `json:"propose"`
+	Proposed   bool    `json:"proposed"`
+	VoteSteps  []uint8 `json:"voteSteps"`
+	VotedSteps []uint8 `json:"votedSteps"`
+	Missed     bool    `json:"missed"`
+}
+
+// MinerPerformance returns the statistics of proposals and votes the node identity was expected to make in the epoch,
+// the current epoch is used by default
+func (api *DnaApi) MinerPerformance(epoch *uint16) *MinerPerformance {
+	if epoch == nil {
+		currentEpoch := api.baseApi.getReadonlyAppState().State.Epoch()
+		epoch = &currentEpoch
+	}
+	result := &MinerPerformance{
+		Epoch:        *epoch,
+		RecentDuties: []*Duty{},
+	}
+	if performance := api.bc.GetMinerPerformance(*epoch); performance != nil {
+		result.Rounds = performance.Rounds
+		result.ExpectedProposals = performance.ExpectedProposals
+		result.Proposals = performance.Proposals
+		result.ExpectedVotes = performance.ExpectedVotes
+		result.Votes = performance.Votes
+		result.LastMissedRound = performance.LastMissedRound
+	}
+	if expected := result.ExpectedProposals + result.ExpectedVotes; expected > 0 {
+		result.Reliability = float64(result.Proposals+result.Votes) / float64(expected)
 	}
+	for _, record := range api.bc.RecentDuties() {
+		if record.Epoch != *epoch {
+			continue
+		}
+		result.RecentDuties = append(result.RecentDuties, &Duty{
+			Round:      record.Round,
+			Propose:    record.Propose,
+			Proposed:   record.Proposed,
+			VoteSteps:  record.VoteSteps,
+			VotedSteps: record.VotedSteps,
+			Missed:     record.Missed(),
+		})
+	}
+	return result
 }
 
 type CeremonyIntervals struct {
@@ -525,6 +1281,52 @@ func (api *DnaApi) CeremonyIntervals() CeremonyIntervals {
 	}
 }
 
+type CeremonyPhase struct {
+	Name   string `json:"name"`
+	Height uint64 `json:"height"`
+	// Timestamp is the unix time in milliseconds when the node reached the phase
+	Timestamp int64 `json:"timestamp"`
+	// Scheduled is the unix time in milliseconds when the phase is expected by the schedule of the validation
+	Scheduled *int64 `json:"scheduled,omitempty"`
+	// Delay is the difference between Timestamp and Scheduled in milliseconds
+	Delay *int64 `json:"delay,omitempty"`
+}
+
+type CeremonyTimeline struct {
+	Epoch  uint16           `json:"epoch"`
+	Phases []*CeremonyPhase `json:"phases"`
+}
+
+// CeremonyTimeline returns the phases of the validation ceremony reached by the node with millisecond timings,
+// the validation of the current epoch is used if the epoch is not specified
+func (api *DnaApi) CeremonyTimeline(epoch *uint16) (*CeremonyTimeline, error) {
+	e := api.baseApi.getReadonlyAppState().State.Epoch()
+	if epoch != nil {
+		e = *epoch
+	}
+	timeline := api.ceremony.CeremonyTimeline(e)
+	if timeline == nil {
+		return nil, errors.Errorf("timeline of epoch %v is not found", e)
+	}
+	result := &CeremonyTimeline{
+		Epoch:  timeline.Epoch,
+		Phases: make([]*CeremonyPhase, 0, len(timeline.Phases)),
+	}
+	for _, phase := range timeline.Phases {
+		item := &CeremonyPhase{
+			Name:      phase.Name,
+			Height:    phase.Height,
+			Timestamp: phase.Timestamp,
+		}
+		if phase.Scheduled > 0 {
+			scheduled, delay := phase.Scheduled, phase.Timestamp-phase.Scheduled
+			item.Scheduled, item.Delay = &scheduled, &delay
+		}
+		result.Phases = append(result.Phases, item)
+	}
+	return result, nil
+}
+
 func (api *DnaApi) ExportKey(password string) (string, error) {
 	if password == "" {
 		return "", errors.New("password should not be empty")
@@ -554,7 +1356,7 @@ type BurnArgs struct {
 }
 
 func (api *DnaApi) Burn(ctx context.Context, args BurnArgs) (common.Hash, error) {
-	from := api.baseApi.getCurrentCoinbase()
+	from := api.baseApi.getSigner(&args.From)
 	hash, err := api.baseApi.sendTx(ctx, from, nil, types.BurnTx, args.Amount, args.MaxFee, decimal.Zero, args.Nonce,
 		args.Epoch, attachments.CreateBurnAttachment(args.Key), nil)
 
@@ -569,6 +1371,7 @@ type ChangeProfileArgs struct {
 	Info     *hexutil.Bytes  `json:"info"`
 	Nickname string          `json:"nickname"`
 	MaxFee   decimal.Decimal `json:"maxFee"`
+	From     *common.Address `json:"from"`
 }
 
 type ChangeProfileResponse struct {
@@ -596,7 +1399,7 @@ func (api *DnaApi) ChangeProfile(ctx context.Context, args ChangeProfileArgs) (C
 		return ChangeProfileResponse{}, errors.Wrap(err, "failed to add profile data")
 	}
 
-	txHash, err := api.baseApi.sendTx(ctx, api.baseApi.getCurrentCoinbase(), nil, types.ChangeProfileTx, decimal.Zero,
+	txHash, err := api.baseApi.sendTx(ctx, api.baseApi.getSigner(args.From), nil, types.ChangeProfileTx, decimal.Zero,
 		args.MaxFee, decimal.Zero, 0, 0, attachments.CreateChangeProfileAttachment(profileHash),
 		nil)
 
@@ -636,18 +1439,36 @@ func (api *DnaApi) Profile(address *common.Address) (ProfileResponse, error) {
 	}, nil
 }
 
-func (api *DnaApi) Sign(value string) hexutil.Bytes {
-	hash := signatureHash(value)
-	return api.baseApi.secStore.Sign(hash[:])
+const (
+	// signatureFormatDoubleHash signs the double hash of the value, it is the default format
+	signatureFormatDoubleHash = "doubleHash"
+	// signatureFormatPrefix signs the hash of the value prefixed by signedMessagePrefix and the value length,
+	// so the signed value can't be a tx, a vote or other data signed by the node
+	signatureFormatPrefix = "prefix"
+
+	signedMessagePrefix = "\x19Idena Signed Message:\n"
+)
+
+// Sign signs the value by the node key in the given format, the double hash format is used by default
+func (api *DnaApi) Sign(value string, format *string) (hexutil.Bytes, error) {
+	hash, err := signatureHash(value, format)
+	if err != nil {
+		return nil, err
+	}
+	return api.baseApi.secStore.Sign(hash[:]), nil
 }
 
 type SignatureAddressArgs struct {
-	Value     string
-	Signature hexutil.Bytes
+	Value     string        `json:"value"`
+	Signature hexutil.Bytes `json:"signature"`
+	Format    *string       `json:"format,omitempty"`
 }
 
 func (api *DnaApi) SignatureAddress(args SignatureAddressArgs) (common.Address, error) {
-	hash := signatureHash(args.Value)
+	hash, err := signatureHash(args.Value, args.Format)
+	if err != nil {
+		return common.Address{}, err
+	}
 	pubKey, err := crypto.Ecrecover(hash[:], args.Signature)
 	if err != nil {
 		return common.Address{}, err
@@ -659,9 +1480,33 @@ func (api *DnaApi) SignatureAddress(args SignatureAddressArgs) (common.Address,
 	return addr, nil
 }
 
-func signatureHash(value string) common.Hash {
-	h := crypto.Hash([]byte(value))
-	return crypto.Hash(h[:])
+// Verify checks that the message is signed by the address in the given format, it lets the owner of the address
+// prove the ownership off-chain. The double hash format is used by default.
+func (api *DnaApi) Verify(address common.Address, message string, signature hexutil.Bytes, format *string) (bool, error) {
+	hash, err := signatureHash(message, format)
+	if err != nil {
+		return false, err
+	}
+	pubKey, err := crypto.Ecrecover(hash[:], signature)
+	if err != nil {
+		return false, nil
+	}
+	addr, err := crypto.PubKeyBytesToAddress(pubKey)
+	if err != nil {
+		return false, nil
+	}
+	return addr == address, nil
+}
+
+func signatureHash(value string, format *string) (common.Hash, error) {
+	if format == nil || *format == "" || *format == signatureFormatDoubleHash {
+		h := crypto.Hash([]byte(value))
+		return crypto.Hash(h[:]), nil
+	}
+	if *format == signatureFormatPrefix {
+		return crypto.Hash([]byte(fmt.Sprintf("%v%v%v", signedMessagePrefix, len(value), value))), nil
+	}
+	return common.Hash{}, errors.Errorf("unknown signature format %v", *format)
 }
 
 type ActivateInviteToRandAddrArgs struct {
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/secstore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDnaApi_signAndVerify(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	secStore := secstore.NewSecStore()
+	secStore.AddKey(crypto.FromECDSA(key))
+	api := &DnaApi{baseApi: &BaseApi{secStore: secStore}}
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	const message = "idena"
+	doubleHash, prefix, unknown := signatureFormatDoubleHash, signatureFormatPrefix, "unknown"
+	for _, format := range []*string{nil, &doubleHash, &prefix} {
+		signature, err := api.Sign(message, format)
+		require.NoError(t, err)
+
+		var args SignatureAddressArgs
+		data, _ := json.Marshal(map[string]interface{}{"value": message, "signature": signature, "format": format})
+		require.NoError(t, json.Unmarshal(data, &args))
+		recovered, err := api.SignatureAddress(args)
+		require.NoError(t, err)
+		require.Equal(t, addr, recovered)
+
+		verified, err := api.Verify(addr, message, signature, format)
+		require.NoError(t, err)
+		require.True(t, verified)
+
+		verified, err = api.Verify(addr, message+"!", signature, format)
+		require.NoError(t, err)
+		require.False(t, verified)
+
+		verified, err = api.Verify(common.Address{0x1}, message, signature, format)
+		require.NoError(t, err)
+		require.False(t, verified)
+	}
+
+	doubleHashSignature, _ := api.Sign(message, &doubleHash)
+	prefixSignature, _ := api.Sign(message, &prefix)
+	require.NotEqual(t, doubleHashSignature, prefixSignature)
+
+	// the signature of the other format is wrong
+	verified, err := api.Verify(addr, message, doubleHashSignature, &prefix)
+	require.NoError(t, err)
+	require.False(t, verified)
+	recovered, err := api.SignatureAddress(SignatureAddressArgs{Value: message, Signature: prefixSignature, Format: &doubleHash})
+	require.NoError(t, err)
+	require.NotEqual(t, addr, recovered)
+
+	verified, err = api.Verify(addr, message, []byte{0x1, 0x2}, nil)
+	require.NoError(t, err)
+	require.False(t, verified)
+
+	_, err = api.Sign(message, &unknown)
+	require.Error(t, err)
+	_, err = api.Verify(addr, message, prefixSignature, &unknown)
+	require.Error(t, err)
+}
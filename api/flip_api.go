@@ -11,6 +11,7 @@ import (
 	"github.com/idena-network/idena-go/core/ceremony"
 	"github.com/idena-network/idena-go/core/flip"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/log"
 	"github.com/ipfs/go-cid"
@@ -80,19 +81,95 @@ func (api *FlipApi) RawSubmit(args RawFlipSubmitArgs) (FlipSubmitResponse, error
 	}, nil
 }
 
-func (api *FlipApi) Submit(args FlipSubmitArgs) (FlipSubmitResponse, error) {
+func rawFlipParts(args FlipSubmitArgs) (publicPart []byte, privatePart []byte, err error) {
 	if args.Hex == nil && args.PublicHex == nil {
-		return FlipSubmitResponse{}, errors.New("flip is empty")
+		return nil, nil, errors.New("flip is empty")
 	}
-
-	var rawPublicPart, rawPrivatePart []byte
 	if args.PublicHex != nil {
-		rawPublicPart = *args.PublicHex
+		publicPart = *args.PublicHex
 	} else {
-		rawPublicPart = *args.Hex
+		publicPart = *args.Hex
 	}
 	if args.PrivateHex != nil {
-		rawPrivatePart = *args.PrivateHex
+		privatePart = *args.PrivateHex
+	}
+	return publicPart, privatePart, nil
+}
+
+type FlipPrescreenIssue struct {
+	Kind    string `json:"kind"`
+	Image   int    `json:"image"`
+	Message string `json:"message"`
+}
+
+type FlipPrescreenResponse struct {
+	Passed bool                 `json:"passed"`
+	Issues []FlipPrescreenIssue `json:"issues"`
+}
+
+// Prescreen checks images of the flip with local heuristics and reports issues which are likely to make
+// validators report the flip, image is -1 for issues of the whole flip
+func (api *FlipApi) Prescreen(args FlipSubmitArgs) (FlipPrescreenResponse, error) {
+	rawPublicPart, rawPrivatePart, err := rawFlipParts(args)
+	if err != nil {
+		return FlipPrescreenResponse{}, err
+	}
+	report := api.fp.PrescreenFlip(rawPublicPart, rawPrivatePart)
+	response := FlipPrescreenResponse{
+		Passed: report.Passed(),
+		Issues: make([]FlipPrescreenIssue, 0, len(report.Issues)),
+	}
+	for _, issue := range report.Issues {
+		response.Issues = append(response.Issues, FlipPrescreenIssue{
+			Kind:    issue.Kind,
+			Image:   issue.Image,
+			Message: issue.Message,
+		})
+	}
+	return response, nil
+}
+
+type FlipsEpochStorage struct {
+	Epoch    uint16 `json:"epoch"`
+	Flips    int    `json:"flips"`
+	OwnFlips int    `json:"ownFlips"`
+	Size     uint64 `json:"size"`
+}
+
+type FlipsStorageResponse struct {
+	Epochs     []FlipsEpochStorage `json:"epochs"`
+	FlipsSize  uint64              `json:"flipsSize"`
+	RepoSize   uint64              `json:"repoSize"`
+	StorageMax uint64              `json:"storageMax"`
+}
+
+// StorageUsage reports flips pinned in the local ipfs store by epochs and the total size of the store
+func (api *FlipApi) StorageUsage() (FlipsStorageResponse, error) {
+	repoSize, storageMax, err := api.ipfsProxy.RepoSize()
+	if err != nil {
+		return FlipsStorageResponse{}, err
+	}
+	response := FlipsStorageResponse{
+		Epochs:     []FlipsEpochStorage{},
+		RepoSize:   repoSize,
+		StorageMax: storageMax,
+	}
+	for _, usage := range api.fp.FlipsStorageUsage() {
+		response.Epochs = append(response.Epochs, FlipsEpochStorage{
+			Epoch:    usage.Epoch,
+			Flips:    usage.Flips,
+			OwnFlips: usage.OwnFlips,
+			Size:     usage.Size,
+		})
+		response.FlipsSize += usage.Size
+	}
+	return response, nil
+}
+
+func (api *FlipApi) Submit(args FlipSubmitArgs) (FlipSubmitResponse, error) {
+	rawPublicPart, rawPrivatePart, err := rawFlipParts(args)
+	if err != nil {
+		return FlipSubmitResponse{}, err
 	}
 	cid, encryptedPublicPart, encryptedPrivatePart, err := api.fp.PrepareFlip(rawPublicPart, rawPrivatePart)
 
@@ -121,6 +198,7 @@ func (api *FlipApi) Submit(args FlipSubmitArgs) (FlipSubmitResponse, error) {
 	}
 
 	log.Info("Flip submitted", "hash", tx.Hash().Hex())
+	api.fp.RememberFlipImages(rawPublicPart, rawPrivatePart)
 
 	return FlipSubmitResponse{
 		TxHash: tx.Hash(),
@@ -239,6 +317,73 @@ func prepareHashes(ceremony *ceremony.ValidationCeremony, flips [][]byte, shortS
 	return result, nil
 }
 
+type FlipReadinessResponse struct {
+	Total     int              `json:"total"`
+	Available int              `json:"available"`
+	Ready     int              `json:"ready"`
+	Flips     []*FlipReadiness `json:"flips"`
+}
+
+type FlipReadiness struct {
+	Hash      string `json:"hash"`
+	Session   string `json:"session"`
+	Extra     bool   `json:"extra"`
+	Available bool   `json:"available"`
+	Ready     bool   `json:"ready"`
+	Attempts  int    `json:"attempts"`
+	Providers int    `json:"providers"`
+	LastError string `json:"lastError,omitempty"`
+}
+
+// Readiness returns the availability of short and long session flips of the coinbase address,
+// flips are prefetched after the flip lottery so missing ones are known before the validation
+func (api *FlipApi) Readiness() (*FlipReadinessResponse, error) {
+	period := api.baseApi.getReadonlyAppState().State.ValidationPeriod()
+
+	if period != state.FlipLotteryPeriod && period != state.ShortSessionPeriod && period != state.LongSessionPeriod {
+		return nil, errors.New("this method is available during FlipLottery, ShortSession and LongSession periods")
+	}
+
+	coinbase := api.baseApi.getCurrentCoinbase()
+	if !api.isCeremonyCandidate(coinbase) {
+		return nil, errors.Errorf("0x%x address is not a ceremony candidate", coinbase)
+	}
+
+	result := &FlipReadinessResponse{
+		Flips: []*FlipReadiness{},
+	}
+	addFlips := func(flips [][]byte, session string) {
+		for i, v := range flips {
+			c, _ := cid.Cast(v)
+			item := &FlipReadiness{
+				Hash:      c.String(),
+				Session:   session,
+				Extra:     session == ceremony.ShortSession && i >= int(common.ShortSessionFlipsCount()),
+				Available: api.ceremony.IsFlipInMemory(v),
+			}
+			if item.Available {
+				item.Ready = api.ceremony.IsFlipReadyToSolve(v)
+			}
+			if status := api.fp.GetLoadingStatus(v); status != nil {
+				item.Attempts = status.Attempts
+				item.Providers = status.Providers
+				item.LastError = status.LastError
+			}
+			result.Total++
+			if item.Available {
+				result.Available++
+			}
+			if item.Ready {
+				result.Ready++
+			}
+			result.Flips = append(result.Flips, item)
+		}
+	}
+	addFlips(api.ceremony.GetShortFlipsToSolve(coinbase), ceremony.ShortSession)
+	addFlips(api.ceremony.GetLongFlipsToSolve(coinbase), ceremony.LongSession)
+	return result, nil
+}
+
 type FlipResponse struct {
 	Hex        hexutil.Bytes `json:"hex"`
 	PrivateHex hexutil.Bytes `json:"privateHex"`
@@ -413,7 +558,7 @@ func (api *FlipApi) SubmitLongAnswers(args SubmitAnswersArgs) (SubmitAnswersResp
 
 	flips := api.ceremony.GetLongFlipsToSolve(api.baseApi.getCurrentCoinbase())
 
-	answers := prepareAnswers(args.Answers, flips, false)
+	answers := prepareAnswers(mergeSavedAnswers(args.Answers, api.savedLongAnswers()), flips, false)
 
 	hash, err := api.ceremony.SubmitLongAnswers(answers)
 
@@ -426,6 +571,132 @@ func (api *FlipApi) SubmitLongAnswers(args SubmitAnswersArgs) (SubmitAnswersResp
 	}, nil
 }
 
+type PrepareDelegatedAnswersArgs struct {
+	ShortAnswers []FlipAnswer `json:"shortAnswers"`
+	LongAnswers  []FlipAnswer `json:"longAnswers"`
+}
+
+type DelegatedAnswers struct {
+	Delegator    common.Address `json:"delegator"`
+	Epoch        uint16         `json:"epoch"`
+	ShortAnswers hexutil.Bytes  `json:"shortAnswers"`
+	LongAnswers  hexutil.Bytes  `json:"longAnswers"`
+	Salt         hexutil.Bytes  `json:"salt"`
+	Proof        hexutil.Bytes  `json:"proof"`
+	FlipKey      hexutil.Bytes  `json:"flipKey"`
+}
+
+type SubmitDelegatedAnswersArgs struct {
+	Answers    DelegatedAnswers `json:"answers"`
+	SessionKey hexutil.Bytes    `json:"sessionKey"`
+}
+
+// PrepareDelegatedAnswers returns answers of the coinbase identity which can be submitted by the pool node
+// with the session key authorized by the identity, empty answers are omitted
+func (api *FlipApi) PrepareDelegatedAnswers(args PrepareDelegatedAnswersArgs) (*DelegatedAnswers, error) {
+	coinbase := api.baseApi.getCurrentCoinbase()
+	if !api.isCeremonyCandidate(coinbase) {
+		return nil, errors.New("coinbase address is not a ceremony candidate")
+	}
+	var shortAnswers, longAnswers *types.Answers
+	if len(args.ShortAnswers) > 0 {
+		shortAnswers = prepareAnswers(args.ShortAnswers, api.ceremony.GetShortFlipsToSolve(coinbase), true)
+	}
+	if len(args.LongAnswers) > 0 {
+		longAnswers = prepareAnswers(args.LongAnswers, api.ceremony.GetLongFlipsToSolve(coinbase), false)
+	}
+	answers, err := api.ceremony.PrepareDelegatedAnswers(shortAnswers, longAnswers)
+	if err != nil {
+		return nil, err
+	}
+	return &DelegatedAnswers{
+		Delegator:    answers.Delegator,
+		Epoch:        answers.Epoch,
+		ShortAnswers: answers.ShortAnswers,
+		LongAnswers:  answers.LongAnswers,
+		Salt:         answers.Salt,
+		Proof:        answers.Proof,
+		FlipKey:      answers.FlipKey,
+	}, nil
+}
+
+// SubmitDelegatedAnswers schedules submission of the delegator answers signed by the session key,
+// txs are sent when the corresponding ceremony period starts and are resent until they are mined
+func (api *FlipApi) SubmitDelegatedAnswers(args SubmitDelegatedAnswersArgs) error {
+	log.Info("delegated answers submitting request", "delegator", args.Answers.Delegator.Hex())
+	defer log.Info("delegated answers submitting response")
+
+	sessionKey, err := crypto.ToECDSA(args.SessionKey)
+	if err != nil {
+		return errors.Wrap(err, "invalid session key")
+	}
+	return api.ceremony.AddDelegatedAnswers(&ceremony.DelegatedAnswers{
+		Delegator:    args.Answers.Delegator,
+		Epoch:        args.Answers.Epoch,
+		ShortAnswers: args.Answers.ShortAnswers,
+		LongAnswers:  args.Answers.LongAnswers,
+		Salt:         args.Answers.Salt,
+		Proof:        args.Answers.Proof,
+		FlipKey:      args.Answers.FlipKey,
+	}, sessionKey)
+}
+
+// SubmitAnswerPartial saves the answer to the long session flip, saved answers are submitted
+// by flip_submitLongAnswers for flips which are not answered in its args
+func (api *FlipApi) SubmitAnswerPartial(args FlipAnswer) error {
+	if !api.isCeremonyCandidate(api.baseApi.getCurrentCoinbase()) {
+		return errors.New("coinbase address is not a ceremony candidate")
+	}
+	c, err := cid.Parse(args.Hash)
+	if err != nil {
+		return errors.New("invalid flip hash")
+	}
+	return api.ceremony.SaveLongAnswer(c.Bytes(), args.Answer, args.Grade)
+}
+
+// PartialAnswers returns saved answers to long session flips
+func (api *FlipApi) PartialAnswers() []FlipAnswer {
+	return api.savedLongAnswers()
+}
+
+func (api *FlipApi) savedLongAnswers() []FlipAnswer {
+	saved := api.ceremony.SavedLongAnswers()
+	result := make([]FlipAnswer, 0, len(saved))
+	for _, answer := range saved {
+		c, err := cid.Cast(answer.Flip)
+		if err != nil {
+			continue
+		}
+		result = append(result, FlipAnswer{
+			Grade:  answer.Grade,
+			Answer: answer.Answer,
+			Hash:   c.String(),
+		})
+	}
+	return result
+}
+
+// mergeSavedAnswers completes answers with saved answers to flips which are missing or not answered
+func mergeSavedAnswers(answers []FlipAnswer, saved []FlipAnswer) []FlipAnswer {
+	result := make([]FlipAnswer, 0, len(answers)+len(saved))
+	answered := make(map[string]bool)
+	for _, answer := range answers {
+		if answer.Answer == types.None {
+			continue
+		}
+		if c, err := cid.Parse(answer.Hash); err == nil {
+			answered[c.String()] = true
+		}
+		result = append(result, answer)
+	}
+	for _, answer := range saved {
+		if !answered[answer.Hash] {
+			result = append(result, answer)
+		}
+	}
+	return result
+}
+
 type FlipWordsResponse struct {
 	Words [2]int `json:"words"`
 }
@@ -491,11 +762,12 @@ func prepareAnswers(answers []FlipAnswer, flips [][]byte, isShort bool) *types.A
 }
 
 func (api *FlipApi) WordPairs(addr common.Address, vrfHash hexutil.Bytes) []FlipWords {
-	identity := api.baseApi.getReadonlyAppState().State.GetIdentity(addr)
+	appState := api.baseApi.getReadonlyAppState()
+	identity := appState.State.GetIdentity(addr)
 	var hash [32]byte
 	copy(hash[:], vrfHash[:])
 
-	wordPairs := ceremony.GeneratePairsFromVrfHash(hash, common.WordDictionarySize, identity.GetTotalWordPairsCount())
+	wordPairs := ceremony.GeneratePairsFromVrfHash(hash, appState.State.WordsDictionarySize(), identity.GetTotalWordPairsCount())
 
 	usedPairs := mapset.NewSet()
 	for _, v := range identity.Flips {
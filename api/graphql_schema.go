@@ -0,0 +1,431 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/graphql"
+	"github.com/pkg/errors"
+)
+
+const (
+	graphQLMaxListSize     = 100
+	graphQLDefaultListSize = 20
+)
+
+type graphQLResolvers struct {
+	bc       *BlockchainApi
+	dna      *DnaApi
+	contract *ContractApi
+}
+
+// NewGraphQLSchema builds read-only GraphQL schema over blocks, transactions, identities, epochs and contracts
+func NewGraphQLSchema(bc *BlockchainApi, dna *DnaApi, contract *ContractApi, maxDepth, maxComplexity int) *graphql.Schema {
+	r := &graphQLResolvers{bc, dna, contract}
+
+	block := graphql.NewObject("Block")
+	tx := graphql.NewObject("Transaction")
+	receipt := graphql.NewObject("TxReceipt")
+	identity := graphql.NewObject("Identity")
+	epoch := graphql.NewObject("Epoch")
+	contractObj := graphql.NewObject("Contract")
+	query := graphql.NewObject("Query")
+
+	block.Fields = map[string]*graphql.Field{
+		"height":         scalar(func(b *Block) interface{} { return b.Height }),
+		"hash":           scalar(func(b *Block) interface{} { return b.Hash }),
+		"parentHash":     scalar(func(b *Block) interface{} { return b.ParentHash }),
+		"timestamp":      scalar(func(b *Block) interface{} { return b.Time }),
+		"root":           scalar(func(b *Block) interface{} { return b.Root }),
+		"identityRoot":   scalar(func(b *Block) interface{} { return b.IdentityRoot }),
+		"coinbase":       scalar(func(b *Block) interface{} { return b.Coinbase }),
+		"ipfsCid":        scalar(func(b *Block) interface{} { return b.IpfsHash }),
+		"flags":          scalar(func(b *Block) interface{} { return b.Flags }),
+		"isEmpty":        scalar(func(b *Block) interface{} { return b.IsEmpty }),
+		"offlineAddress": scalar(func(b *Block) interface{} { return b.OfflineAddr }),
+		"txCount":        scalar(func(b *Block) interface{} { return len(b.Transactions) }),
+		"transactions": {
+			Type: graphql.NewList(tx),
+			Cost: 5,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				var txs []*Transaction
+				for _, hash := range p.Source.(*Block).Transactions {
+					txs = append(txs, r.bc.Transaction(hash))
+				}
+				return txs, nil
+			},
+		},
+		"parent": {
+			Type: block,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.bc.Block(p.Source.(*Block).ParentHash), nil
+			},
+		},
+	}
+
+	tx.Fields = map[string]*graphql.Field{
+		"hash":      scalar(func(t *Transaction) interface{} { return t.Hash }),
+		"type":      scalar(func(t *Transaction) interface{} { return t.Type }),
+		"from":      scalar(func(t *Transaction) interface{} { return t.From }),
+		"to":        scalar(func(t *Transaction) interface{} { return t.To }),
+		"amount":    scalar(func(t *Transaction) interface{} { return t.Amount }),
+		"tips":      scalar(func(t *Transaction) interface{} { return t.Tips }),
+		"maxFee":    scalar(func(t *Transaction) interface{} { return t.MaxFee }),
+		"usedFee":   scalar(func(t *Transaction) interface{} { return t.UsedFee }),
+		"nonce":     scalar(func(t *Transaction) interface{} { return t.Nonce }),
+		"epoch":     scalar(func(t *Transaction) interface{} { return t.Epoch }),
+		"payload":   scalar(func(t *Transaction) interface{} { return t.Payload }),
+		"blockHash": scalar(func(t *Transaction) interface{} { return t.BlockHash }),
+		"timestamp": scalar(func(t *Transaction) interface{} { return t.Timestamp }),
+		"block": {
+			Type: block,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				hash := p.Source.(*Transaction).BlockHash
+				if hash == (common.Hash{}) {
+					return nil, nil
+				}
+				return r.bc.Block(hash), nil
+			},
+		},
+		"receipt": {
+			Type: receipt,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.bc.TxReceipt(p.Source.(*Transaction).Hash), nil
+			},
+		},
+		"sender": {
+			Type: identity,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.identity(p.Source.(*Transaction).From), nil
+			},
+		},
+		"recipient": {
+			Type: identity,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				to := p.Source.(*Transaction).To
+				if to == nil {
+					return nil, nil
+				}
+				return r.identity(*to), nil
+			},
+		},
+	}
+
+	receipt.Fields = map[string]*graphql.Field{
+		"contract": scalar(func(rc *TxReceipt) interface{} { return rc.Contract }),
+		"method":   scalar(func(rc *TxReceipt) interface{} { return rc.Method }),
+		"success":  scalar(func(rc *TxReceipt) interface{} { return rc.Success }),
+		"gasUsed":  scalar(func(rc *TxReceipt) interface{} { return rc.GasUsed }),
+		"gasCost":  scalar(func(rc *TxReceipt) interface{} { return rc.GasCost }),
+		"txFee":    scalar(func(rc *TxReceipt) interface{} { return rc.TxFee }),
+		"error":    scalar(func(rc *TxReceipt) interface{} { return rc.Error }),
+	}
+
+	identity.Fields = map[string]*graphql.Field{
+		"address":              scalar(func(i *Identity) interface{} { return i.Address }),
+		"state":                scalar(func(i *Identity) interface{} { return i.State }),
+		"stake":                scalar(func(i *Identity) interface{} { return i.Stake }),
+		"age":                  scalar(func(i *Identity) interface{} { return i.Age }),
+		"online":               scalar(func(i *Identity) interface{} { return i.Online }),
+		"isPool":               scalar(func(i *Identity) interface{} { return i.IsPool }),
+		"invites":              scalar(func(i *Identity) interface{} { return i.Invites }),
+		"penalty":              scalar(func(i *Identity) interface{} { return i.Penalty }),
+		"generation":           scalar(func(i *Identity) interface{} { return i.Generation }),
+		"profileHash":          scalar(func(i *Identity) interface{} { return i.ProfileHash }),
+		"requiredFlips":        scalar(func(i *Identity) interface{} { return i.RequiredFlips }),
+		"madeFlips":            scalar(func(i *Identity) interface{} { return i.MadeFlips }),
+		"totalQualifiedFlips":  scalar(func(i *Identity) interface{} { return i.QualifiedFlips }),
+		"totalShortFlipPoints": scalar(func(i *Identity) interface{} { return i.ShortFlipPoints }),
+		"lastValidationFlags":  scalar(func(i *Identity) interface{} { return i.LastValidationFlags }),
+		"delegationEpoch":      scalar(func(i *Identity) interface{} { return i.DelegationEpoch }),
+		"balance": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.dna.GetBalance(p.Source.(*Identity).Address).Balance, nil
+			},
+		},
+		"delegatee": {
+			Type: identity,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				delegatee := p.Source.(*Identity).Delegatee
+				if delegatee == nil {
+					return nil, nil
+				}
+				return r.identity(*delegatee), nil
+			},
+		},
+		"inviter": {
+			Type: identity,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				inviter := p.Source.(*Identity).Inviter
+				if inviter == nil {
+					return nil, nil
+				}
+				return r.identity(inviter.Address), nil
+			},
+		},
+		"transactions": {
+			Type: graphql.NewList(tx),
+			Cost: 10,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				count, err := listSize(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				// the indexed history of the address, the newest txs are first, only own addresses are indexed
+				// unless the node indexes txs of all addresses
+				return r.bc.Transactions(TransactionsArgs{
+					Address: p.Source.(*Identity).Address,
+					Count:   count,
+				}).Transactions, nil
+			},
+		},
+	}
+
+	epoch.Fields = map[string]*graphql.Field{
+		"epoch":          scalar(func(e *Epoch) interface{} { return e.Epoch }),
+		"startBlock":     scalar(func(e *Epoch) interface{} { return e.StartBlock }),
+		"nextValidation": scalar(func(e *Epoch) interface{} { return e.NextValidation }),
+		"currentPeriod":  scalar(func(e *Epoch) interface{} { return e.CurrentPeriod }),
+		"firstBlock": {
+			Type: block,
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.bc.BlockAt(p.Source.(*Epoch).StartBlock), nil
+			},
+		},
+	}
+
+	contractObj.Fields = map[string]*graphql.Field{
+		"address": scalar(func(addr common.Address) interface{} { return addr }),
+		"codeHash": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.contract.baseApi.getReadonlyAppState().State.GetCodeHash(p.Source.(common.Address)), nil
+			},
+		},
+		"stake": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				stake := r.contract.baseApi.getReadonlyAppState().State.GetContractStake(p.Source.(common.Address))
+				return blockchain.ConvertToFloat(stake), nil
+			},
+		},
+		"balance": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.dna.GetBalance(p.Source.(common.Address)).Balance, nil
+			},
+		},
+		"storageSize": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.contract.baseApi.getReadonlyAppState().State.GetContractStorageSize(p.Source.(common.Address)), nil
+			},
+		},
+		"data": {
+			Cost: 2,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				key, ok, err := graphql.StringArg(p.Args, "key")
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, errors.New("argument \"key\" is required")
+				}
+				format, _, err := graphql.StringArg(p.Args, "format")
+				if err != nil {
+					return nil, err
+				}
+				return r.contract.ReadData(p.Source.(common.Address), key, format)
+			},
+		},
+	}
+
+	query.Fields = map[string]*graphql.Field{
+		"lastBlock": {
+			Type: block,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return r.bc.LastBlock(), nil
+			},
+		},
+		"block": {
+			Type: block,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				hash, ok, err := graphql.StringArg(p.Args, "hash")
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					return r.bc.Block(common.HexToHash(hash)), nil
+				}
+				height, err := graphql.IntArg(p.Args, "height", -1)
+				if err != nil {
+					return nil, err
+				}
+				if height < 0 {
+					return nil, errors.New("either \"height\" or \"hash\" argument is required")
+				}
+				return r.bc.BlockAt(uint64(height)), nil
+			},
+		},
+		"blocks": {
+			Type: graphql.NewList(block),
+			Cost: 5,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				head := r.bc.bc.Head.Height()
+				from, err := graphql.IntArg(p.Args, "from", int64(head))
+				if err != nil {
+					return nil, err
+				}
+				count, err := listSize(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				// blocks are returned in descending order starting from the given height
+				var blocks []*Block
+				for height := from; height > 0 && height <= int64(head) && len(blocks) < count; height-- {
+					blocks = append(blocks, r.bc.BlockAt(uint64(height)))
+				}
+				return blocks, nil
+			},
+		},
+		"transaction": {
+			Type: tx,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				hash, ok, err := graphql.StringArg(p.Args, "hash")
+				if err != nil {
+					return nil, err
+				}
+				if !ok {
+					return nil, errors.New("argument \"hash\" is required")
+				}
+				return r.bc.Transaction(common.HexToHash(hash)), nil
+			},
+		},
+		"identity": {
+			Type: identity,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				addr, err := addressArg(p.Args, "address")
+				if err != nil {
+					return nil, err
+				}
+				return r.identity(addr), nil
+			},
+		},
+		"identities": {
+			Type: graphql.NewList(identity),
+			Cost: 50,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				stateFilter, filtered, err := graphql.StringArg(p.Args, "state")
+				if err != nil {
+					return nil, err
+				}
+				var after *common.Address
+				if _, ok := p.Args["after"]; ok {
+					addr, err := addressArg(p.Args, "after")
+					if err != nil {
+						return nil, err
+					}
+					after = &addr
+				}
+				count, err := listSize(p.Args)
+				if err != nil {
+					return nil, err
+				}
+				if count == 0 {
+					return []*Identity{}, nil
+				}
+				var filter func(data *state.Identity) bool
+				if filtered {
+					filter = func(data *state.Identity) bool {
+						return data.State.Name() == stateFilter
+					}
+				}
+				// identities are paginated by address, the address of the last identity is the cursor of the next page
+				var identities []*Identity
+				for _, item := range r.dna.identitiesPage(after, count, filter) {
+					identity := item
+					identities = append(identities, &identity)
+				}
+				return identities, nil
+			},
+		},
+		"epoch": {
+			Type: epoch,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				e := r.dna.Epoch()
+				return &e, nil
+			},
+		},
+		"contract": {
+			Type: contractObj,
+			Resolve: func(p graphql.Params) (interface{}, error) {
+				return addressArg(p.Args, "address")
+			},
+		},
+	}
+
+	return &graphql.Schema{
+		Query:         query,
+		MaxDepth:      maxDepth,
+		MaxComplexity: maxComplexity,
+	}
+}
+
+func (r *graphQLResolvers) identity(addr common.Address) *Identity {
+	identity := r.dna.Identity(&addr)
+	return &identity
+}
+
+// scalar creates a field which is read from the source of type T without arguments
+func scalar(getter interface{}) *graphql.Field {
+	return &graphql.Field{
+		Resolve: func(p graphql.Params) (interface{}, error) {
+			switch g := getter.(type) {
+			case func(*Block) interface{}:
+				return g(p.Source.(*Block)), nil
+			case func(*Transaction) interface{}:
+				return g(p.Source.(*Transaction)), nil
+			case func(*TxReceipt) interface{}:
+				return g(p.Source.(*TxReceipt)), nil
+			case func(*Identity) interface{}:
+				return g(p.Source.(*Identity)), nil
+			case func(*Epoch) interface{}:
+				return g(p.Source.(*Epoch)), nil
+			case func(common.Address) interface{}:
+				return g(p.Source.(common.Address)), nil
+			}
+			return nil, errors.New("unsupported source type")
+		},
+	}
+}
+
+func listSize(args map[string]interface{}) (int, error) {
+	count, err := graphql.IntArg(args, "first", graphQLDefaultListSize)
+	if err != nil {
+		return 0, err
+	}
+	if count < 0 || count > graphQLMaxListSize {
+		return 0, errors.Errorf("argument \"first\" must be between 0 and %v", graphQLMaxListSize)
+	}
+	return int(count), nil
+}
+
+func addressArg(args map[string]interface{}, name string) (common.Address, error) {
+	value, ok, err := graphql.StringArg(args, name)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if !ok || !common.IsHexAddress(value) {
+		return common.Address{}, errors.Errorf("argument %q must be a valid address", name)
+	}
+	return common.HexToAddress(value), nil
+}
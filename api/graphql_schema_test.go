@@ -0,0 +1,53 @@
+package api
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/graphql"
+	"github.com/idena-network/idena-go/tests"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGraphQLSchema_identityTransactions(t *testing.T) {
+	chain, _, _, key := blockchain.NewTestBlockchain(true, nil)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, _ := crypto.GenerateKey()
+	other := crypto.PubkeyToAddress(otherKey.PublicKey)
+
+	var txs []*types.Transaction
+	for i := uint32(1); i <= 3; i++ {
+		tx := tests.GetFullTx(i, 1, key, types.SendTx, nil, &other, nil)
+		header := &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Height:    uint64(i),
+				Time:      int64(i * 10),
+				FeePerGas: big.NewInt(1),
+			},
+		}
+		chain.Indexer().HandleBlockTransactions(header, []*types.Transaction{tx})
+		txs = append(txs, tx)
+	}
+
+	schema := NewGraphQLSchema(&BlockchainApi{bc: chain.Blockchain}, nil, nil, 0, 0)
+	field := schema.Query.Fields["identity"].Type.Fields["transactions"]
+	require.NotNil(t, field)
+
+	result, err := field.Resolve(graphql.Params{Source: &Identity{Address: addr}, Args: map[string]interface{}{"first": int64(2)}})
+	require.NoError(t, err)
+	list := result.([]*Transaction)
+	require.Len(t, list, 2)
+	require.Equal(t, txs[2].Hash(), list[0].Hash)
+	require.Equal(t, txs[1].Hash(), list[1].Hash)
+	require.Equal(t, int64(30), list[0].Timestamp)
+
+	result, err = field.Resolve(graphql.Params{Source: &Identity{Address: addr}, Args: map[string]interface{}{}})
+	require.NoError(t, err)
+	require.Len(t, result.([]*Transaction), 3)
+
+	_, err = field.Resolve(graphql.Params{Source: &Identity{Address: addr}, Args: map[string]interface{}{"first": int64(graphQLMaxListSize + 1)}})
+	require.Error(t, err)
+}
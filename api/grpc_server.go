@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/events"
+	models "github.com/idena-network/idena-go/protobuf"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcServer serves chain queries, tx submission and identity/ceremony status over gRPC, the messages are defined
+// in protobuf/models.proto and the service in protobuf/api.proto
+type GrpcServer struct {
+	models.UnimplementedIdenaApiServer
+	bc       *BlockchainApi
+	dna      *DnaApi
+	readOnly bool
+}
+
+// NewGrpcServer creates the server, tx submission is rejected if the server is read-only
+func NewGrpcServer(bc *BlockchainApi, dna *DnaApi, readOnly bool) *GrpcServer {
+	return &GrpcServer{bc: bc, dna: dna, readOnly: readOnly}
+}
+
+func (s *GrpcServer) LastBlock(ctx context.Context, _ *models.ProtoApiEmpty) (*models.ProtoApiBlock, error) {
+	return blockToProto(s.bc.LastBlock())
+}
+
+// Block returns the block by hash if it is set, by height otherwise
+func (s *GrpcServer) Block(ctx context.Context, req *models.ProtoApiBlockRequest) (*models.ProtoApiBlock, error) {
+	if len(req.Hash) > 0 {
+		return blockToProto(s.bc.Block(common.BytesToHash(req.Hash)))
+	}
+	return blockToProto(s.bc.BlockAt(req.Height))
+}
+
+func (s *GrpcServer) Transaction(ctx context.Context, req *models.ProtoApiTransactionRequest) (*models.ProtoApiTransaction, error) {
+	tx := s.bc.Transaction(common.BytesToHash(req.Hash))
+	if tx == nil {
+		return nil, status.Error(codes.NotFound, "transaction not found")
+	}
+	res := &models.ProtoApiTransaction{
+		Hash:      tx.Hash.Bytes(),
+		Type:      tx.Type,
+		From:      tx.From.Bytes(),
+		Amount:    tx.Amount.String(),
+		Tips:      tx.Tips.String(),
+		MaxFee:    tx.MaxFee.String(),
+		Nonce:     tx.Nonce,
+		Epoch:     uint32(tx.Epoch),
+		Payload:   tx.Payload,
+		BlockHash: tx.BlockHash.Bytes(),
+		UsedFee:   tx.UsedFee.String(),
+		Timestamp: tx.Timestamp,
+	}
+	if tx.To != nil {
+		res.To = tx.To.Bytes()
+	}
+	return res, nil
+}
+
+func (s *GrpcServer) SendRawTransaction(ctx context.Context, req *models.ProtoApiRawTransaction) (*models.ProtoApiTransactionHash, error) {
+	if s.readOnly {
+		return nil, status.Error(codes.PermissionDenied, "tx submission is disabled on the read-only node")
+	}
+	hash, err := s.bc.SendRawTx(ctx, req.Tx)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &models.ProtoApiTransactionHash{Hash: hash.Bytes()}, nil
+}
+
+// Identity returns the identity of the address, the identity of the node is returned if the address is empty
+func (s *GrpcServer) Identity(ctx context.Context, req *models.ProtoApiIdentityRequest) (*models.ProtoApiIdentity, error) {
+	var address *common.Address
+	if len(req.Address) > 0 {
+		addr := common.BytesToAddress(req.Address)
+		address = &addr
+	}
+	identity := s.dna.Identity(address)
+	res := &models.ProtoApiIdentity{
+		Address:       identity.Address.Bytes(),
+		State:         identity.State,
+		Stake:         identity.Stake.String(),
+		Age:           uint32(identity.Age),
+		Invites:       uint32(identity.Invites),
+		RequiredFlips: uint32(identity.RequiredFlips),
+		MadeFlips:     uint32(identity.MadeFlips),
+		Online:        identity.Online,
+		Penalty:       identity.Penalty.String(),
+		Flips:         identity.Flips,
+	}
+	if identity.Delegatee != nil {
+		res.Delegatee = identity.Delegatee.Bytes()
+	}
+	return res, nil
+}
+
+func (s *GrpcServer) Epoch(ctx context.Context, _ *models.ProtoApiEmpty) (*models.ProtoApiEpoch, error) {
+	epoch := s.dna.Epoch()
+	return &models.ProtoApiEpoch{
+		Epoch:          uint32(epoch.Epoch),
+		StartBlock:     epoch.StartBlock,
+		NextValidation: epoch.NextValidation.Unix(),
+		CurrentPeriod:  epoch.CurrentPeriod,
+	}, nil
+}
+
+// SubscribeBlocks streams new canonical heads until the client cancels the stream
+func (s *GrpcServer) SubscribeBlocks(_ *models.ProtoApiEmpty, stream models.IdenaApi_SubscribeBlocksServer) error {
+	blocks, unsubscribe := s.subscribeHeads()
+	defer unsubscribe()
+	for {
+		select {
+		case block := <-blocks:
+			msg, err := blockToProto(convertToBlock(block))
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// SubscribeCeremonyEvents streams the current epoch and period first and then each change of them observed
+// on new canonical heads until the client cancels the stream
+func (s *GrpcServer) SubscribeCeremonyEvents(_ *models.ProtoApiEmpty, stream models.IdenaApi_SubscribeCeremonyEventsServer) error {
+	blocks, unsubscribe := s.subscribeHeads()
+	defer unsubscribe()
+	last := s.dna.Epoch()
+	head := s.bc.bc.Head
+	if err := stream.Send(ceremonyEventToProto(last, head.Height(), head.Time())); err != nil {
+		return err
+	}
+	for {
+		select {
+		case block := <-blocks:
+			epoch := s.dna.Epoch()
+			if epoch.Epoch == last.Epoch && epoch.CurrentPeriod == last.CurrentPeriod {
+				continue
+			}
+			last = epoch
+			if err := stream.Send(ceremonyEventToProto(epoch, block.Height(), block.Header.Time())); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func (s *GrpcServer) subscribeHeads() (chan *types.Block, func()) {
+	blocks := make(chan *types.Block, chainSubscriptionBuffer)
+	busSub := s.bc.bus.Subscribe(events.ChainHeadEventID, func(e eventbus.Event) {
+		select {
+		case blocks <- e.(*events.ChainHeadEvent).Block:
+		default:
+		}
+	})
+	return blocks, func() {
+		s.bc.bus.Unsubscribe(busSub)
+	}
+}
+
+func blockToProto(block *Block) (*models.ProtoApiBlock, error) {
+	if block == nil {
+		return nil, status.Error(codes.NotFound, "block not found")
+	}
+	res := &models.ProtoApiBlock{
+		Hash:         block.Hash.Bytes(),
+		ParentHash:   block.ParentHash.Bytes(),
+		Height:       block.Height,
+		Timestamp:    block.Time,
+		Coinbase:     block.Coinbase.Bytes(),
+		Root:         block.Root.Bytes(),
+		IdentityRoot: block.IdentityRoot.Bytes(),
+		Flags:        block.Flags,
+		IsEmpty:      block.IsEmpty,
+		GasLimit:     block.GasLimit,
+	}
+	if block.IpfsHash != nil {
+		res.IpfsCid = *block.IpfsHash
+	}
+	for _, hash := range block.Transactions {
+		res.Transactions = append(res.Transactions, hash.Bytes())
+	}
+	if block.OfflineAddr != nil {
+		res.OfflineAddress = block.OfflineAddr.Bytes()
+	}
+	return res, nil
+}
+
+func ceremonyEventToProto(epoch Epoch, height uint64, timestamp int64) *models.ProtoApiCeremonyEvent {
+	return &models.ProtoApiCeremonyEvent{
+		Epoch:     uint32(epoch.Epoch),
+		Period:    epoch.CurrentPeriod,
+		Height:    height,
+		Timestamp: timestamp,
+	}
+}
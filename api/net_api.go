@@ -21,16 +21,18 @@ func (api *NetApi) PeersCount() int {
 }
 
 type Peer struct {
-	ID         string `json:"id"`
-	RemoteAddr string `json:"addr"`
+	ID           string   `json:"id"`
+	RemoteAddr   string   `json:"addr"`
+	Capabilities []string `json:"capabilities"`
 }
 
 func (api *NetApi) Peers() []Peer {
 	peers := make([]Peer, 0)
 	for _, p := range api.pm.Peers() {
 		peers = append(peers, Peer{
-			ID:         p.ID(),
-			RemoteAddr: p.RemoteAddr(),
+			ID:           p.ID(),
+			RemoteAddr:   p.RemoteAddr(),
+			Capabilities: protocol.CapabilityNames(p.Capabilities()),
 		})
 	}
 	return peers
@@ -40,6 +42,116 @@ func (api *NetApi) IpfsAddress() string {
 	return api.pm.Endpoint()
 }
 
+type PeersDiversity struct {
+	Subnets        map[string]int `json:"subnets"`
+	Asns           map[uint32]int `json:"asns"`
+	MaxSubnetPeers int            `json:"maxSubnetPeers"`
+	MaxAsnPeers    int            `json:"maxAsnPeers"`
+	AsnDatabase    bool           `json:"asnDatabase"`
+}
+
+// PeersDiversity returns the number of connected peers per subnet and autonomous system with the configured limits
+func (api *NetApi) PeersDiversity() PeersDiversity {
+	stats := api.pm.PeersDiversity()
+	return PeersDiversity{
+		Subnets:        stats.Subnets,
+		Asns:           stats.Asns,
+		MaxSubnetPeers: stats.MaxSubnetPeers,
+		MaxAsnPeers:    stats.MaxAsnPeers,
+		AsnDatabase:    stats.AsnDatabaseReady,
+	}
+}
+
+// Capabilities returns sub-protocol capabilities advertised by the node in the handshake
+func (api *NetApi) Capabilities() []string {
+	return protocol.CapabilityNames(api.pm.Capabilities())
+}
+
+// IpfsAddresses returns all IPv4 and IPv6 multiaddrs of the node advertised to peers
+func (api *NetApi) IpfsAddresses() []string {
+	return api.pm.Endpoints()
+}
+
 func (api *NetApi) AddPeer(url string) error {
 	return api.pm.AddPeer(url)
 }
+
+type PeerTraffic struct {
+	BytesIn  uint64 `json:"bytesIn"`
+	BytesOut uint64 `json:"bytesOut"`
+	MsgsIn   uint64 `json:"msgsIn"`
+	MsgsOut  uint64 `json:"msgsOut"`
+}
+
+type PeerStats struct {
+	ID          string                 `json:"id"`
+	RemoteAddr  string                 `json:"addr"`
+	ConnectedAt int64                  `json:"connectedAt"`
+	LatencyMs   int64                  `json:"latencyMs"`
+	BytesIn     uint64                 `json:"bytesIn"`
+	BytesOut    uint64                 `json:"bytesOut"`
+	Traffic     map[string]PeerTraffic `json:"traffic"`
+}
+
+// PeerStats returns bytes received from and sent to every connected peer per message type
+func (api *NetApi) PeerStats() []PeerStats {
+	result := make([]PeerStats, 0)
+	for _, p := range api.pm.PeersStats() {
+		stats := PeerStats{
+			ID:          p.ID,
+			RemoteAddr:  p.RemoteAddr,
+			ConnectedAt: p.ConnectedAt.Unix(),
+			LatencyMs:   p.Latency.Milliseconds(),
+			Traffic:     make(map[string]PeerTraffic, len(p.Traffic)),
+		}
+		for name, traffic := range p.Traffic {
+			stats.BytesIn += traffic.BytesIn
+			stats.BytesOut += traffic.BytesOut
+			stats.Traffic[name] = PeerTraffic{
+				BytesIn:  traffic.BytesIn,
+				BytesOut: traffic.BytesOut,
+				MsgsIn:   traffic.MsgsIn,
+				MsgsOut:  traffic.MsgsOut,
+			}
+		}
+		result = append(result, stats)
+	}
+	return result
+}
+
+type PeerScore struct {
+	ID          string `json:"id"`
+	Score       int32  `json:"score"`
+	Banned      bool   `json:"banned"`
+	BannedUntil int64  `json:"bannedUntil,omitempty"`
+}
+
+// PeerScores returns reputation scores of known peers and their bans
+func (api *NetApi) PeerScores() []PeerScore {
+	result := make([]PeerScore, 0)
+	for _, item := range api.pm.PeerReputations() {
+		score := PeerScore{
+			ID:    item.ID.Pretty(),
+			Score: item.Score,
+		}
+		if !item.BannedUntil.IsZero() {
+			score.Banned = true
+			score.BannedUntil = item.BannedUntil.Unix()
+		}
+		result = append(result, score)
+	}
+	return result
+}
+
+// SetPeerScore overrides the reputation score of the peer
+func (api *NetApi) SetPeerScore(id string, score int32) error {
+	return api.pm.SetPeerScore(id, score)
+}
+
+func (api *NetApi) BanPeer(id string) error {
+	return api.pm.BanPeerById(id)
+}
+
+func (api *NetApi) UnbanPeer(id string) error {
+	return api.pm.UnbanPeer(id)
+}
@@ -0,0 +1,423 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"math/big"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/rpc"
+	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/idena-network/idena-go/vm/helpers"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// oracleVotingSaltLength is the length of the salt generated for a vote proof
+const oracleVotingSaltLength = 32
+
+// oracleVotingTransitions maps methods of the oracle voting contract which change its state to the new state
+var oracleVotingTransitions = map[string]string{
+	"deploy":                    "pending",
+	"startVoting":               "started",
+	"prolongVoting":             "started",
+	embedded.FinishVotingMethod: "finished",
+	"terminate":                 "terminated",
+}
+
+// OracleApi serves the lifecycle of oracle voting contracts: votings, deposits, rewards and txs of oracles
+type OracleApi struct {
+	baseApi *BaseApi
+	bc      *blockchain.Blockchain
+	bus     eventbus.Bus
+}
+
+// NewOracleApi creates a new OracleApi instance
+func NewOracleApi(baseApi *BaseApi, bc *blockchain.Blockchain, bus eventbus.Bus) *OracleApi {
+	return &OracleApi{baseApi: baseApi, bc: bc, bus: bus}
+}
+
+type OracleVoting struct {
+	Contract   common.Address `json:"contract"`
+	Owner      common.Address `json:"owner"`
+	Fact       hexutil.Bytes  `json:"fact"`
+	State      string         `json:"state"`
+	StartTime  uint64         `json:"startTime"`
+	StartBlock *uint64        `json:"startBlock"`
+	// VoteBlock is the first block of the public voting when votes are revealed
+	VoteBlock *uint64 `json:"voteBlock"`
+	// FinishBlock is the block since which the voting can be finished without the winner
+	FinishBlock          *uint64          `json:"finishBlock"`
+	Epoch                uint16           `json:"epoch"`
+	VotingDuration       uint64           `json:"votingDuration"`
+	PublicVotingDuration uint64           `json:"publicVotingDuration"`
+	WinnerThreshold      byte             `json:"winnerThreshold"`
+	Quorum               byte             `json:"quorum"`
+	CommitteeSize        uint64           `json:"committeeSize"`
+	OwnerFee             byte             `json:"ownerFee"`
+	VotingMinPayment     *decimal.Decimal `json:"votingMinPayment"`
+	Balance              decimal.Decimal  `json:"balance"`
+	Stake                decimal.Decimal  `json:"stake"`
+	SecretVotes          uint64           `json:"secretVotes"`
+	VotedCount           uint64           `json:"votedCount"`
+	Result               *byte            `json:"result"`
+}
+
+type OracleVotingDeposits struct {
+	// MinFund is the contract balance required to start the voting with the committee of the current network
+	MinFund decimal.Decimal `json:"minFund"`
+	Balance decimal.Decimal `json:"balance"`
+	// FundShortage is the amount to be sent to the pending contract to start the voting
+	FundShortage decimal.Decimal `json:"fundShortage"`
+	// VoteDeposit is paid by an oracle with the vote proof, it is 1/20 of the balance at the start
+	// if it isn't set on deploy
+	VoteDeposit decimal.Decimal `json:"voteDeposit"`
+}
+
+type OracleRewardEstimation struct {
+	Votes        uint64          `json:"votes"`
+	Fund         decimal.Decimal `json:"fund"`
+	OwnerReward  decimal.Decimal `json:"ownerReward"`
+	OracleReward decimal.Decimal `json:"oracleReward"`
+}
+
+type OracleVoteArgs struct {
+	// From is the oracle, the selected account or the node key is used if it is not set
+	From     *common.Address `json:"from"`
+	Contract common.Address  `json:"contract"`
+	Vote     byte            `json:"vote"`
+	// Salt hides the vote until it is revealed, a random salt is generated for the vote proof if it is not set
+	Salt   hexutil.Bytes   `json:"salt"`
+	MaxFee decimal.Decimal `json:"maxFee"`
+}
+
+type OracleVoteTx struct {
+	*UnsignedTx
+	Salt     hexutil.Bytes `json:"salt"`
+	VoteHash hexutil.Bytes `json:"voteHash"`
+	// VoteBlock is the block since which the vote can be revealed
+	VoteBlock uint64 `json:"voteBlock"`
+}
+
+// OracleVotingTransition is sent when a tx changes the state of an oracle voting contract
+type OracleVotingTransition struct {
+	Contract common.Address `json:"contract"`
+	TxHash   common.Hash    `json:"txHash"`
+	Method   string         `json:"method"`
+	State    string         `json:"state"`
+}
+
+func readOracleVoting(appState *appstate.AppState, contract common.Address) (*OracleVoting, error) {
+	s := appState.State
+	if hash := s.GetCodeHash(contract); hash == nil || *hash != embedded.OracleVotingContract {
+		return nil, errors.New("oracle voting contract not found")
+	}
+	getUint64 := func(key string) uint64 {
+		v, _ := helpers.ExtractUInt64(0, s.GetContractValue(contract, []byte(key)))
+		return v
+	}
+	getByte := func(key string) byte {
+		v, _ := helpers.ExtractByte(0, s.GetContractValue(contract, []byte(key)))
+		return v
+	}
+	voting := &OracleVoting{
+		Contract:             contract,
+		Owner:                common.BytesToAddress(s.GetContractValue(contract, []byte("owner"))),
+		Fact:                 s.GetContractValue(contract, []byte("fact")),
+		State:                embedded.OracleVotingStateName(getByte("state")),
+		StartTime:            getUint64("startTime"),
+		VotingDuration:       getUint64("votingDuration"),
+		PublicVotingDuration: getUint64("publicVotingDuration"),
+		WinnerThreshold:      getByte("winnerThreshold"),
+		Quorum:               getByte("quorum"),
+		CommitteeSize:        getUint64("committeeSize"),
+		OwnerFee:             getByte("ownerFee"),
+		Balance:              blockchain.ConvertToFloat(s.GetBalance(contract)),
+		Stake:                blockchain.ConvertToFloat(s.GetContractStake(contract)),
+		VotedCount:           getUint64("votedCount"),
+	}
+	if data := s.GetContractValue(contract, []byte("votingMinPayment")); data != nil {
+		payment := blockchain.ConvertToFloat(new(big.Int).SetBytes(data))
+		voting.VotingMinPayment = &payment
+	}
+	if voting.State != "pending" {
+		startBlock := getUint64("startBlock")
+		voteBlock := startBlock + voting.VotingDuration
+		finishBlock := voteBlock + voting.PublicVotingDuration
+		voting.StartBlock, voting.VoteBlock, voting.FinishBlock = &startBlock, &voteBlock, &finishBlock
+		voting.Epoch, _ = helpers.ExtractUInt16(0, s.GetContractValue(contract, []byte("epoch")))
+	}
+	if data := s.GetContractValue(contract, []byte("result")); len(data) > 0 {
+		result := data[0]
+		voting.Result = &result
+	}
+	if data := s.GetContractValue(contract, []byte("secretVotesCount")); data != nil {
+		voting.SecretVotes, _ = helpers.ExtractUInt64(0, data)
+	} else {
+		// the counter is stored by the contract on the first change, votes of older contracts are counted
+		prefix := []byte("voteHashes")
+		maxKey := append([]byte{}, prefix...)
+		for len(maxKey) < common.MaxContractStoreKeyLength {
+			maxKey = append(maxKey, 0xFF)
+		}
+		s.IterateContractStore(contract, prefix, maxKey, func(key []byte, value []byte) bool {
+			voting.SecretVotes++
+			return false
+		})
+	}
+	return voting, nil
+}
+
+// voteDeposit returns the amount paid with the vote proof, it is estimated by the current balance
+// if it isn't set yet
+func voteDeposit(voting *OracleVoting) decimal.Decimal {
+	if voting.VotingMinPayment != nil {
+		return *voting.VotingMinPayment
+	}
+	return voting.Balance.Div(decimal.New(20, 0))
+}
+
+// GetVoting returns the state of the oracle voting contract
+func (api *OracleApi) GetVoting(contract common.Address) (*OracleVoting, error) {
+	return readOracleVoting(api.baseApi.getReadonlyAppState(), contract)
+}
+
+// ActiveVotings returns pending and started oracle votings, the list is filtered by the owner if it is set.
+// All accounts of the state are iterated, so the method isn't intended for frequent calls.
+func (api *OracleApi) ActiveVotings(owner *common.Address) []*OracleVoting {
+	appState := api.baseApi.getReadonlyAppState()
+	var contracts []common.Address
+	appState.State.IterateOverAccounts(func(addr common.Address, account state.Account) {
+		if account.Contract != nil && account.Contract.CodeHash == embedded.OracleVotingContract {
+			contracts = append(contracts, addr)
+		}
+	})
+	result := make([]*OracleVoting, 0)
+	for _, contract := range contracts {
+		voting, err := readOracleVoting(appState, contract)
+		if err != nil || voting.State != "pending" && voting.State != "started" {
+			continue
+		}
+		if owner != nil && voting.Owner != *owner {
+			continue
+		}
+		result = append(result, voting)
+	}
+	return result
+}
+
+// Deposits returns the fund required to start the voting and the deposit paid by oracles with vote proofs
+func (api *OracleApi) Deposits(contract common.Address) (*OracleVotingDeposits, error) {
+	appState := api.baseApi.getReadonlyAppState()
+	voting, err := readOracleVoting(appState, contract)
+	if err != nil {
+		return nil, err
+	}
+	networkSize := appState.ValidatorsCache.NetworkSize()
+	minFund := blockchain.ConvertToFloat(embedded.MinOracleVotingFund(voting.CommitteeSize, networkSize))
+	result := &OracleVotingDeposits{
+		MinFund:      minFund,
+		Balance:      voting.Balance,
+		FundShortage: decimal.Zero,
+		VoteDeposit:  voteDeposit(voting),
+	}
+	if voting.State == "pending" && voting.Balance.LessThan(minFund) {
+		result.FundShortage = minFund.Sub(voting.Balance)
+		if voting.VotingMinPayment == nil {
+			result.VoteDeposit = minFund.Div(decimal.New(20, 0))
+		}
+	}
+	return result, nil
+}
+
+// EstimateReward estimates the reward of an oracle if the given number of oracles vote for the winning option,
+// the whole committee is assumed to vote if it is not set. Deposits of oracles which haven't voted yet are added
+// to the fund.
+func (api *OracleApi) EstimateReward(contract common.Address, votes *uint64) (*OracleRewardEstimation, error) {
+	voting, err := readOracleVoting(api.baseApi.getReadonlyAppState(), contract)
+	if err != nil {
+		return nil, err
+	}
+	if voting.State != "pending" && voting.State != "started" {
+		return nil, errors.New("voting is finished")
+	}
+	voters := voting.CommitteeSize
+	if votes != nil {
+		voters = *votes
+	}
+	if voters == 0 {
+		return nil, errors.New("votes count should be positive")
+	}
+	deposit := voteDeposit(voting)
+	fund := voting.Balance
+	if voted := voting.VotedCount + voting.SecretVotes; voters > voted {
+		fund = fund.Add(deposit.Mul(decimal.NewFromInt(int64(voters - voted))))
+	}
+	ownerReward := decimal.Zero
+	if voting.OwnerFee > 0 {
+		userLocks := deposit.Mul(decimal.NewFromInt(int64(voters)))
+		ownerReward = fund.Sub(userLocks).Mul(decimal.NewFromFloat(float64(voting.OwnerFee) / 100.0))
+		if ownerReward.Sign() < 0 {
+			ownerReward = decimal.Zero
+		}
+	}
+	return &OracleRewardEstimation{
+		Votes:        voters,
+		Fund:         fund,
+		OwnerReward:  ownerReward,
+		OracleReward: fund.Sub(ownerReward).Div(decimal.NewFromInt(int64(voters))),
+	}, nil
+}
+
+func (api *OracleApi) readContract(appState *appstate.AppState, contract common.Address, method string, args ...[]byte) ([]byte, error) {
+	return vm.NewVmImpl(appState, api.bc.Head, api.baseApi.secStore, nil, api.bc.Config()).Read(contract, method, args...)
+}
+
+func (api *OracleApi) buildCallTx(from common.Address, contract common.Address, amount decimal.Decimal, maxFee decimal.Decimal, method string, args ...[]byte) (*UnsignedTx, error) {
+	payload, err := attachments.CreateCallContractAttachment(method, args...).ToBytes()
+	if err != nil {
+		return nil, err
+	}
+	tx := api.baseApi.getTx(from, &contract, types.CallContractTx, amount, maxFee, decimal.Zero, 0, 0, payload)
+	return newUnsignedTx(tx)
+}
+
+// BuildVoteProofTx builds the unsigned tx which commits the hidden vote of the oracle with the vote deposit.
+// The salt is returned along with the tx and has to be kept to reveal the vote by the tx built with BuildVoteTx.
+func (api *OracleApi) BuildVoteProofTx(args OracleVoteArgs) (*OracleVoteTx, error) {
+	appState := api.baseApi.getReadonlyAppState()
+	voting, err := readOracleVoting(appState, args.Contract)
+	if err != nil {
+		return nil, err
+	}
+	from := api.baseApi.getSigner(args.From)
+	if !appState.State.GetIdentityState(from).NewbieOrBetter() {
+		return nil, errors.New("oracle is not a validated identity")
+	}
+	if _, err := api.readContract(appState, args.Contract, "proof", from.Bytes()); err != nil {
+		return nil, errors.Wrap(err, "oracle can't vote")
+	}
+	salt := args.Salt
+	if len(salt) == 0 {
+		salt = make([]byte, oracleVotingSaltLength)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+	}
+	voteHash, err := api.readContract(appState, args.Contract, "voteHash", common.ToBytes(args.Vote), salt)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := api.buildCallTx(from, args.Contract, voteDeposit(voting), args.MaxFee, "sendVoteProof", voteHash)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleVoteTx{
+		UnsignedTx: tx,
+		Salt:       salt,
+		VoteHash:   voteHash,
+		VoteBlock:  *voting.VoteBlock,
+	}, nil
+}
+
+// BuildVoteTx builds the unsigned tx which reveals the vote committed by the vote proof, the tx is accepted
+// since the vote block
+func (api *OracleApi) BuildVoteTx(args OracleVoteArgs) (*OracleVoteTx, error) {
+	if len(args.Salt) == 0 {
+		return nil, errors.New("salt of the vote proof is required")
+	}
+	appState := api.baseApi.getReadonlyAppState()
+	voting, err := readOracleVoting(appState, args.Contract)
+	if err != nil {
+		return nil, err
+	}
+	if voting.State != "started" {
+		return nil, errors.New("voting is not started")
+	}
+	from := api.baseApi.getSigner(args.From)
+	voteHash, err := api.readContract(appState, args.Contract, "voteHash", common.ToBytes(args.Vote), args.Salt)
+	if err != nil {
+		return nil, err
+	}
+	stored := appState.State.GetContractValue(args.Contract, append([]byte("voteHashes"), from.Bytes()...))
+	if !bytes.Equal(stored, voteHash) {
+		return nil, errors.New("vote and salt don't match the vote proof of the oracle")
+	}
+	tx, err := api.buildCallTx(from, args.Contract, decimal.Zero, args.MaxFee, "sendVote", common.ToBytes(args.Vote), args.Salt)
+	if err != nil {
+		return nil, err
+	}
+	return &OracleVoteTx{
+		UnsignedTx: tx,
+		Salt:       args.Salt,
+		VoteHash:   voteHash,
+		VoteBlock:  *voting.VoteBlock,
+	}, nil
+}
+
+// StateChanges notifies the subscriber about txs which change states of oracle votings, all votings are watched
+// if the contract is not set. Terminations are sent only to subscribers of the contract since the code of
+// a terminated contract is removed from the state.
+func (api *OracleApi) StateChanges(ctx context.Context, contract *common.Address, token *ChainToken) (*rpc.Subscription, error) {
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		if block.IsEmpty() {
+			return nil, nil
+		}
+		receipts, err := api.bc.GetBlockReceipts(block.Header)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read receipts of block %v", block.Height())
+		}
+		byHash := make(map[common.Hash]*types.TxReceipt, len(receipts))
+		for _, r := range receipts {
+			if r != nil {
+				byHash[r.TxHash] = r
+			}
+		}
+		var result []interface{}
+		for _, tx := range block.Body.Transactions {
+			r, ok := byHash[tx.Hash()]
+			if !ok || !r.Success {
+				continue
+			}
+			newState, ok := oracleVotingTransitions[r.Method]
+			if !ok {
+				continue
+			}
+			if contract != nil && r.ContractAddress != *contract {
+				continue
+			}
+			if !api.isOracleVoting(tx, r, contract) {
+				continue
+			}
+			result = append(result, &OracleVotingTransition{
+				Contract: r.ContractAddress,
+				TxHash:   r.TxHash,
+				Method:   r.Method,
+				State:    newState,
+			})
+		}
+		return result, nil
+	})
+}
+
+func (api *OracleApi) isOracleVoting(tx *types.Transaction, r *types.TxReceipt, contract *common.Address) bool {
+	switch tx.Type {
+	case types.DeployContractTx:
+		attachment := attachments.ParseDeployContractAttachment(tx)
+		return attachment != nil && attachment.CodeHash == embedded.OracleVotingContract
+	case types.TerminateContractTx:
+		return contract != nil
+	default:
+		hash := api.baseApi.getReadonlyAppState().State.GetCodeHash(r.ContractAddress)
+		return hash != nil && *hash == embedded.OracleVotingContract
+	}
+}
@@ -0,0 +1,128 @@
+package api
+
+import (
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/core/training"
+	"github.com/idena-network/idena-go/log"
+	"github.com/ipfs/go-cid"
+)
+
+// TrainingApi serves flips of the previous validation to practice short sessions, it mirrors flip_ methods of the short session
+type TrainingApi struct {
+	simulator *training.Simulator
+}
+
+// NewTrainingApi creates a new TrainingApi instance
+func NewTrainingApi(simulator *training.Simulator) *TrainingApi {
+	return &TrainingApi{simulator}
+}
+
+type TrainingSessionResponse struct {
+	StartTime  int64 `json:"startTime"`
+	Duration   int64 `json:"duration"`
+	FlipsCount int   `json:"flipsCount"`
+}
+
+// Start begins a new training short session with the given number of flips, the default is the short session flips count
+func (api *TrainingApi) Start(count *int) (TrainingSessionResponse, error) {
+	log.Info("training session start request")
+	defer log.Info("training session start response")
+
+	var flipsCount int
+	if count != nil {
+		flipsCount = *count
+	}
+	info, err := api.simulator.Start(flipsCount)
+	if err != nil {
+		return TrainingSessionResponse{}, err
+	}
+	return TrainingSessionResponse{
+		StartTime:  info.StartTime.Unix(),
+		Duration:   int64(info.Duration / time.Second),
+		FlipsCount: info.FlipsCount,
+	}, nil
+}
+
+func (api *TrainingApi) ShortHashes() ([]FlipHashesResponse, error) {
+	cids, err := api.simulator.Hashes()
+	if err != nil {
+		return nil, err
+	}
+	var result []FlipHashesResponse
+	for _, v := range cids {
+		c, _ := cid.Parse(v)
+		result = append(result, FlipHashesResponse{
+			Hash:      c.String(),
+			Ready:     true,
+			Available: true,
+		})
+	}
+	return result, nil
+}
+
+func (api *TrainingApi) Get(hash string) (FlipResponse, error) {
+	c, err := cid.Decode(hash)
+	if err != nil {
+		return FlipResponse{}, err
+	}
+	flip, err := api.simulator.Get(c.Bytes())
+	if err != nil {
+		return FlipResponse{}, err
+	}
+	return FlipResponse{
+		Hex:        flip.PublicPart,
+		PrivateHex: flip.PrivatePart,
+	}, nil
+}
+
+type TrainingFlipResult struct {
+	Hash          string       `json:"hash"`
+	Answer        types.Answer `json:"answer"`
+	CorrectAnswer types.Answer `json:"correctAnswer"`
+	Grade         types.Grade  `json:"grade"`
+	Epoch         uint16       `json:"epoch"`
+}
+
+type TrainingResultResponse struct {
+	Correct int                  `json:"correct"`
+	Total   int                  `json:"total"`
+	Score   float32              `json:"score"`
+	Flips   []TrainingFlipResult `json:"flips"`
+}
+
+// SubmitShortAnswers finishes the training session and returns the answers reached by the consensus, no transaction is sent
+func (api *TrainingApi) SubmitShortAnswers(args SubmitAnswersArgs) (TrainingResultResponse, error) {
+	log.Info("training short answers submitting request")
+	defer log.Info("training short answers submitting response")
+
+	answers := make(map[string]types.Answer, len(args.Answers))
+	for _, item := range args.Answers {
+		c, err := cid.Decode(item.Hash)
+		if err != nil {
+			return TrainingResultResponse{}, err
+		}
+		answers[string(c.Bytes())] = item.Answer
+	}
+	result, err := api.simulator.SubmitAnswers(answers)
+	if err != nil {
+		return TrainingResultResponse{}, err
+	}
+	response := TrainingResultResponse{
+		Correct: result.Correct,
+		Total:   result.Total,
+		Score:   result.Score,
+	}
+	for _, item := range result.Flips {
+		c, _ := cid.Parse(item.Cid)
+		response.Flips = append(response.Flips, TrainingFlipResult{
+			Hash:          c.String(),
+			Answer:        item.Answer,
+			CorrectAnswer: item.CorrectAnswer,
+			Grade:         item.Grade,
+			Epoch:         item.Epoch,
+		})
+	}
+	return response, nil
+}
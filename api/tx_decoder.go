@@ -0,0 +1,342 @@
+package api
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+)
+
+// DecodedTx is a tx with the attachment decoded into named fields, the attachment is omitted for txs without payload.
+// AttachmentError is set instead of the attachment if the payload can't be decoded.
+type DecodedTx struct {
+	*Transaction
+	Attachment      interface{} `json:"attachment,omitempty"`
+	AttachmentError string      `json:"attachmentError,omitempty"`
+}
+
+type FlipSubmitDetails struct {
+	Cid  string `json:"cid"`
+	Pair uint8  `json:"pair"`
+}
+
+type AnswersHashDetails struct {
+	Hash common.Hash `json:"hash"`
+}
+
+type ShortAnswersDetails struct {
+	Answers hexutil.Bytes `json:"answers"`
+	Rnd     uint64        `json:"rnd"`
+}
+
+type LongAnswersDetails struct {
+	Answers hexutil.Bytes `json:"answers"`
+	Proof   hexutil.Bytes `json:"proof"`
+	Key     hexutil.Bytes `json:"key"`
+	Salt    hexutil.Bytes `json:"salt"`
+}
+
+type ActivationDetails struct {
+	PubKey hexutil.Bytes `json:"pubKey"`
+}
+
+type OnlineStatusDetails struct {
+	Online bool `json:"online"`
+}
+
+type DelegationDetails struct {
+	Delegatee *common.Address `json:"delegatee"`
+}
+
+type KillDelegatorDetails struct {
+	Delegator *common.Address `json:"delegator"`
+}
+
+type BurnDetails struct {
+	Key string `json:"key"`
+}
+
+type ChangeProfileDetails struct {
+	Cid string `json:"cid"`
+}
+
+type DeleteFlipDetails struct {
+	Cid string `json:"cid"`
+}
+
+type DeployContractDetails struct {
+	CodeHash common.Hash     `json:"codeHash"`
+	Args     []hexutil.Bytes `json:"args"`
+}
+
+type CallContractDetails struct {
+	Contract *common.Address `json:"contract"`
+	Method   string          `json:"method"`
+	Args     []hexutil.Bytes `json:"args"`
+}
+
+type TerminateContractDetails struct {
+	Contract *common.Address `json:"contract"`
+	Args     []hexutil.Bytes `json:"args"`
+}
+
+type StoreToIpfsDetails struct {
+	Cid  string `json:"cid"`
+	Size uint32 `json:"size"`
+}
+
+type LockStakeDetails struct {
+	Delay  uint16 `json:"delay"`
+	Epochs uint16 `json:"epochs"`
+}
+
+type ChangeBlockGasLimitDetails struct {
+	GasLimit uint64 `json:"gasLimit"`
+}
+
+type ChangeProposerThresholdDetails struct {
+	Threshold float64 `json:"threshold"`
+}
+
+type ChangeWordsDictionaryDetails struct {
+	Hash common.Hash `json:"hash"`
+	Size uint32      `json:"size"`
+}
+
+type RegisterBlsKeyDetails struct {
+	PubKey hexutil.Bytes `json:"pubKey"`
+	Proof  hexutil.Bytes `json:"proof"`
+}
+
+type VoteDetails struct {
+	Round       uint64      `json:"round"`
+	Step        uint8       `json:"step"`
+	ParentHash  common.Hash `json:"parentHash"`
+	VotedHash   common.Hash `json:"votedHash"`
+	TurnOffline bool        `json:"turnOffline"`
+	Upgrade     uint32      `json:"upgrade"`
+}
+
+type MisbehaviorEvidenceDetails struct {
+	Offender common.Address `json:"offender"`
+	Votes    []*VoteDetails `json:"votes"`
+}
+
+type MultiSendDetails struct {
+	Payments []*Payment `json:"payments"`
+}
+
+// DecodeTx decodes the raw tx, the tx doesn't need to be signed
+func (api *BlockchainApi) DecodeTx(raw hexutil.Bytes) (*DecodedTx, error) {
+	var tx types.Transaction
+	if err := tx.FromBytes(raw); err != nil {
+		if err := rlp.DecodeBytes(raw, &tx); err != nil {
+			return nil, errors.Wrap(err, "cannot decode tx")
+		}
+		tx.UseRlp = true
+	}
+	return decodeTx(convertToTransaction(&tx, common.Hash{}, nil, 0), &tx), nil
+}
+
+// TxDetails returns the tx of the chain or the mempool with the decoded attachment
+func (api *BlockchainApi) TxDetails(hash common.Hash) (*DecodedTx, error) {
+	transaction := api.Transaction(hash)
+	if transaction == nil {
+		return nil, errors.New("tx is not found")
+	}
+	tx := api.pool.GetTx(hash)
+	if tx == nil {
+		tx, _ = api.bc.GetTx(hash)
+	}
+	return decodeTx(transaction, tx), nil
+}
+
+func decodeTx(transaction *Transaction, tx *types.Transaction) *DecodedTx {
+	result := &DecodedTx{Transaction: transaction}
+	attachment, err := decodeAttachment(tx)
+	if err != nil {
+		result.AttachmentError = err.Error()
+	} else {
+		result.Attachment = attachment
+	}
+	return result
+}
+
+var errInvalidAttachment = errors.New("invalid attachment")
+
+// decodeAttachment returns the payload of the tx decoded according to the tx type, recipients which have a meaning
+// for the tx type are named as well. Nil is returned for txs without payload and without named recipients.
+func decodeAttachment(tx *types.Transaction) (interface{}, error) {
+	switch tx.Type {
+	case types.SubmitFlipTx:
+		attachment := attachments.ParseFlipSubmitAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &FlipSubmitDetails{Cid: cidString(attachment.Cid), Pair: attachment.Pair}, nil
+	case types.SubmitAnswersHashTx:
+		if len(tx.Payload) != common.HashLength {
+			return nil, errInvalidAttachment
+		}
+		return &AnswersHashDetails{Hash: common.BytesToHash(tx.Payload)}, nil
+	case types.SubmitShortAnswersTx:
+		attachment := attachments.ParseShortAnswerAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &ShortAnswersDetails{Answers: attachment.Answers, Rnd: attachment.Rnd}, nil
+	case types.SubmitLongAnswersTx:
+		attachment := attachments.ParseLongAnswerAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &LongAnswersDetails{
+			Answers: attachment.Answers,
+			Proof:   attachment.Proof,
+			Key:     attachment.Key,
+			Salt:    attachment.Salt,
+		}, nil
+	case types.ActivationTx:
+		if len(tx.Payload) == 0 {
+			return nil, nil
+		}
+		return &ActivationDetails{PubKey: tx.Payload}, nil
+	case types.OnlineStatusTx:
+		attachment := attachments.ParseOnlineStatusAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &OnlineStatusDetails{Online: attachment.Online}, nil
+	case types.DelegateTx:
+		return &DelegationDetails{Delegatee: tx.To}, nil
+	case types.KillDelegatorTx:
+		return &KillDelegatorDetails{Delegator: tx.To}, nil
+	case types.BurnTx:
+		attachment := attachments.ParseBurnAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &BurnDetails{Key: attachment.Key}, nil
+	case types.ChangeProfileTx:
+		attachment := attachments.ParseChangeProfileAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &ChangeProfileDetails{Cid: cidString(attachment.Hash)}, nil
+	case types.DeleteFlipTx:
+		attachment := attachments.ParseDeleteFlipAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &DeleteFlipDetails{Cid: cidString(attachment.Cid)}, nil
+	case types.DeployContractTx:
+		attachment := attachments.ParseDeployContractAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &DeployContractDetails{CodeHash: attachment.CodeHash, Args: convertArgs(attachment.Args)}, nil
+	case types.CallContractTx:
+		attachment := attachments.ParseCallContractAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &CallContractDetails{Contract: tx.To, Method: attachment.Method, Args: convertArgs(attachment.Args)}, nil
+	case types.TerminateContractTx:
+		attachment := attachments.ParseTerminateContractAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &TerminateContractDetails{Contract: tx.To, Args: convertArgs(attachment.Args)}, nil
+	case types.StoreToIpfsTx:
+		attachment := attachments.ParseStoreToIpfsAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &StoreToIpfsDetails{Cid: cidString(attachment.Cid), Size: attachment.Size}, nil
+	case types.LockStakeTx:
+		attachment := attachments.ParseLockStakeAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &LockStakeDetails{Delay: attachment.Delay, Epochs: attachment.Epochs}, nil
+	case types.ChangeBlockGasLimitTx:
+		attachment := attachments.ParseChangeBlockGasLimitAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &ChangeBlockGasLimitDetails{GasLimit: attachment.GasLimit}, nil
+	case types.ChangeProposerThresholdTx:
+		attachment := attachments.ParseChangeProposerThresholdAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &ChangeProposerThresholdDetails{Threshold: attachment.Threshold}, nil
+	case types.ChangeWordsDictionaryTx:
+		attachment := attachments.ParseChangeWordsDictionaryAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &ChangeWordsDictionaryDetails{Hash: attachment.Hash, Size: attachment.Size}, nil
+	case types.RegisterBlsKeyTx:
+		attachment := attachments.ParseRegisterBlsKeyAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		return &RegisterBlsKeyDetails{PubKey: attachment.PubKey, Proof: attachment.Proof}, nil
+	case types.MisbehaviorEvidenceTx:
+		attachment := attachments.ParseMisbehaviorEvidenceAttachment(tx)
+		if attachment == nil || attachment.Vote1 == nil || attachment.Vote2 == nil {
+			return nil, errInvalidAttachment
+		}
+		return &MisbehaviorEvidenceDetails{
+			Offender: attachment.Vote1.VoterAddr(),
+			Votes:    []*VoteDetails{convertVoteDetails(attachment.Vote1), convertVoteDetails(attachment.Vote2)},
+		}, nil
+	case types.MultiSendTx:
+		attachment := attachments.ParseMultiSendAttachment(tx)
+		if attachment == nil {
+			return nil, errInvalidAttachment
+		}
+		payments := make([]*Payment, 0, len(attachment.Payments))
+		for _, payment := range attachment.Payments {
+			payments = append(payments, &Payment{To: payment.To, Amount: blockchain.ConvertToFloat(payment.Amount)})
+		}
+		return &MultiSendDetails{Payments: payments}, nil
+	}
+	return nil, nil
+}
+
+func convertArgs(args [][]byte) []hexutil.Bytes {
+	result := make([]hexutil.Bytes, 0, len(args))
+	for _, arg := range args {
+		result = append(result, arg)
+	}
+	return result
+}
+
+func convertVoteDetails(vote *types.Vote) *VoteDetails {
+	if vote.Header == nil {
+		return &VoteDetails{}
+	}
+	return &VoteDetails{
+		Round:       vote.Header.Round,
+		Step:        vote.Header.Step,
+		ParentHash:  vote.Header.ParentHash,
+		VotedHash:   vote.Header.VotedHash,
+		TurnOffline: vote.Header.TurnOffline,
+		Upgrade:     vote.Header.Upgrade,
+	}
+}
+
+// cidString returns the string form of the cid, raw bytes are returned as hex if they aren't a valid cid
+func cidString(data []byte) string {
+	c, err := cid.Cast(data)
+	if err != nil {
+		return hexutil.Encode(data)
+	}
+	return c.String()
+}
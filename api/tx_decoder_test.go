@@ -0,0 +1,234 @@
+package api
+
+import (
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/ecies"
+	"github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAttachment(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	flipCid, _ := cid.Parse("bafkreie4bmmmaoo2nneaaq7t3n3wrjmvpz7jnwlhe6tgwsfuijyvgdrupu")
+	to := common.Address{0x1}
+	hash := common.Hash{0x2}
+
+	signVote := func(header *types.VoteHeader) *types.Vote {
+		vote := &types.Vote{Header: header}
+		hash := crypto.SignatureHash(vote)
+		vote.Signature, _ = crypto.Sign(hash[:], key)
+		return vote
+	}
+	vote1 := &types.VoteHeader{Round: 10, Step: 1, ParentHash: hash, VotedHash: common.Hash{0x3}}
+	vote2 := &types.VoteHeader{Round: 10, Step: 1, ParentHash: hash, VotedHash: common.Hash{0x4}, Upgrade: 5}
+
+	payload := func(attachment interface{ ToBytes() ([]byte, error) }) []byte {
+		data, err := attachment.ToBytes()
+		require.NoError(t, err)
+		return data
+	}
+	malformed := []byte{0x0a, 0x10}
+
+	type test struct {
+		name     string
+		tx       *types.Transaction
+		expected interface{}
+		err      error
+	}
+	tests := []test{
+		{
+			name:     "send",
+			tx:       &types.Transaction{Type: types.SendTx, To: &to},
+			expected: nil,
+		},
+		{
+			name:     "submit flip",
+			tx:       &types.Transaction{Type: types.SubmitFlipTx, Payload: attachments.CreateFlipSubmitAttachment(flipCid.Bytes(), 2)},
+			expected: &FlipSubmitDetails{Cid: flipCid.String(), Pair: 2},
+		},
+		{
+			name:     "submit flip with raw cid",
+			tx:       &types.Transaction{Type: types.SubmitFlipTx, Payload: attachments.CreateFlipSubmitAttachment([]byte{0x1, 0x2}, 0)},
+			expected: &FlipSubmitDetails{Cid: "0x0102"},
+		},
+		{
+			name:     "answers hash",
+			tx:       &types.Transaction{Type: types.SubmitAnswersHashTx, Payload: hash.Bytes()},
+			expected: &AnswersHashDetails{Hash: hash},
+		},
+		{
+			name: "answers hash of wrong length",
+			tx:   &types.Transaction{Type: types.SubmitAnswersHashTx, Payload: hash.Bytes()[:10]},
+			err:  errInvalidAttachment,
+		},
+		{
+			name:     "short answers",
+			tx:       &types.Transaction{Type: types.SubmitShortAnswersTx, Payload: attachments.CreateShortAnswerAttachment([]byte{0x1, 0x2}, 100)},
+			expected: &ShortAnswersDetails{Answers: []byte{0x1, 0x2}, Rnd: 100},
+		},
+		{
+			name: "long answers",
+			tx: &types.Transaction{Type: types.SubmitLongAnswersTx,
+				Payload: attachments.CreateLongAnswerAttachment([]byte{0x1}, []byte{0x2}, []byte{0x3}, ecies.ImportECDSA(key))},
+			expected: &LongAnswersDetails{Answers: []byte{0x1}, Proof: []byte{0x2}, Key: crypto.FromECDSA(key), Salt: []byte{0x3}},
+		},
+		{
+			name:     "activation",
+			tx:       &types.Transaction{Type: types.ActivationTx, To: &to, Payload: []byte{0x4}},
+			expected: &ActivationDetails{PubKey: []byte{0x4}},
+		},
+		{
+			name:     "activation without pub key",
+			tx:       &types.Transaction{Type: types.ActivationTx, To: &to},
+			expected: nil,
+		},
+		{
+			name:     "online status",
+			tx:       &types.Transaction{Type: types.OnlineStatusTx, Payload: attachments.CreateOnlineStatusAttachment(true)},
+			expected: &OnlineStatusDetails{Online: true},
+		},
+		{
+			name:     "delegate",
+			tx:       &types.Transaction{Type: types.DelegateTx, To: &to},
+			expected: &DelegationDetails{Delegatee: &to},
+		},
+		{
+			name:     "kill delegator",
+			tx:       &types.Transaction{Type: types.KillDelegatorTx, To: &to},
+			expected: &KillDelegatorDetails{Delegator: &to},
+		},
+		{
+			name:     "burn",
+			tx:       &types.Transaction{Type: types.BurnTx, Payload: attachments.CreateBurnAttachment("key")},
+			expected: &BurnDetails{Key: "key"},
+		},
+		{
+			name:     "change profile",
+			tx:       &types.Transaction{Type: types.ChangeProfileTx, Payload: attachments.CreateChangeProfileAttachment(flipCid.Bytes())},
+			expected: &ChangeProfileDetails{Cid: flipCid.String()},
+		},
+		{
+			name:     "delete flip",
+			tx:       &types.Transaction{Type: types.DeleteFlipTx, Payload: attachments.CreateDeleteFlipAttachment(flipCid.Bytes())},
+			expected: &DeleteFlipDetails{Cid: flipCid.String()},
+		},
+		{
+			name:     "deploy contract",
+			tx:       &types.Transaction{Type: types.DeployContractTx, Payload: payload(attachments.CreateDeployContractAttachment(hash, []byte{0x5}))},
+			expected: &DeployContractDetails{CodeHash: hash, Args: []hexutil.Bytes{{0x5}}},
+		},
+		{
+			name:     "call contract",
+			tx:       &types.Transaction{Type: types.CallContractTx, To: &to, Payload: payload(attachments.CreateCallContractAttachment("vote", []byte{0x6}, nil))},
+			expected: &CallContractDetails{Contract: &to, Method: "vote", Args: []hexutil.Bytes{{0x6}, {}}},
+		},
+		{
+			name:     "terminate contract",
+			tx:       &types.Transaction{Type: types.TerminateContractTx, To: &to, Payload: payload(attachments.CreateTerminateContractAttachment([]byte{0x9}))},
+			expected: &TerminateContractDetails{Contract: &to, Args: []hexutil.Bytes{{0x9}}},
+		},
+		{
+			name:     "store to ipfs",
+			tx:       &types.Transaction{Type: types.StoreToIpfsTx, Payload: attachments.CreateStoreToIpfsAttachment(flipCid.Bytes(), 1024)},
+			expected: &StoreToIpfsDetails{Cid: flipCid.String(), Size: 1024},
+		},
+		{
+			name:     "lock stake",
+			tx:       &types.Transaction{Type: types.LockStakeTx, Payload: attachments.CreateLockStakeAttachment(3, 10)},
+			expected: &LockStakeDetails{Delay: 3, Epochs: 10},
+		},
+		{
+			name:     "change block gas limit",
+			tx:       &types.Transaction{Type: types.ChangeBlockGasLimitTx, Payload: attachments.CreateChangeBlockGasLimitAttachment(1000000)},
+			expected: &ChangeBlockGasLimitDetails{GasLimit: 1000000},
+		},
+		{
+			name:     "change proposer threshold",
+			tx:       &types.Transaction{Type: types.ChangeProposerThresholdTx, Payload: attachments.CreateChangeProposerThresholdAttachment(0.5)},
+			expected: &ChangeProposerThresholdDetails{Threshold: 0.5},
+		},
+		{
+			name:     "change words dictionary",
+			tx:       &types.Transaction{Type: types.ChangeWordsDictionaryTx, Payload: attachments.CreateChangeWordsDictionaryAttachment(hash, 3300)},
+			expected: &ChangeWordsDictionaryDetails{Hash: hash, Size: 3300},
+		},
+		{
+			name:     "register bls key",
+			tx:       &types.Transaction{Type: types.RegisterBlsKeyTx, Payload: attachments.CreateRegisterBlsKeyAttachment([]byte{0x7}, []byte{0x8})},
+			expected: &RegisterBlsKeyDetails{PubKey: []byte{0x7}, Proof: []byte{0x8}},
+		},
+		{
+			name: "misbehavior evidence",
+			tx: &types.Transaction{Type: types.MisbehaviorEvidenceTx,
+				Payload: attachments.CreateMisbehaviorEvidenceAttachment(signVote(vote1), signVote(vote2))},
+			expected: &MisbehaviorEvidenceDetails{
+				Offender: crypto.PubkeyToAddress(key.PublicKey),
+				Votes: []*VoteDetails{
+					{Round: 10, Step: 1, ParentHash: hash, VotedHash: common.Hash{0x3}},
+					{Round: 10, Step: 1, ParentHash: hash, VotedHash: common.Hash{0x4}, Upgrade: 5},
+				},
+			},
+		},
+		{
+			name: "misbehavior evidence with one vote",
+			tx:   &types.Transaction{Type: types.MisbehaviorEvidenceTx, Payload: attachments.CreateMisbehaviorEvidenceAttachment(signVote(vote1), nil)},
+			err:  errInvalidAttachment,
+		},
+		{
+			name: "multi send",
+			tx: &types.Transaction{Type: types.MultiSendTx, Payload: attachments.CreateMultiSendAttachment([]*attachments.MultiSendPayment{
+				{To: to, Amount: big.NewInt(1e18)},
+				{To: common.Address{0x2}, Amount: big.NewInt(5e17)},
+			})},
+			expected: &MultiSendDetails{Payments: []*Payment{
+				{To: to, Amount: blockchain.ConvertToFloat(big.NewInt(1e18))},
+				{To: common.Address{0x2}, Amount: blockchain.ConvertToFloat(big.NewInt(5e17))},
+			}},
+		},
+	}
+
+	// every tx type with the attachment is invalid if the attachment is malformed
+	for _, txType := range []types.TxType{types.SubmitFlipTx, types.SubmitShortAnswersTx, types.SubmitLongAnswersTx,
+		types.OnlineStatusTx, types.BurnTx, types.ChangeProfileTx, types.DeleteFlipTx, types.DeployContractTx,
+		types.CallContractTx, types.TerminateContractTx, types.StoreToIpfsTx, types.LockStakeTx,
+		types.ChangeBlockGasLimitTx, types.ChangeProposerThresholdTx, types.ChangeWordsDictionaryTx,
+		types.RegisterBlsKeyTx, types.MisbehaviorEvidenceTx, types.MultiSendTx} {
+		tests = append(tests, test{
+			name: fmt.Sprintf("malformed type %v", txType),
+			tx:   &types.Transaction{Type: txType, To: &to, Payload: malformed},
+			err:  errInvalidAttachment,
+		})
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attachment, err := decodeAttachment(tc.tx)
+			if tc.err != nil {
+				require.Equal(t, tc.err, err)
+				require.Nil(t, attachment)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, attachment)
+		})
+	}
+}
+
+func TestDecodeTx(t *testing.T) {
+	decoded := decodeTx(&Transaction{}, &types.Transaction{Type: types.BurnTx, Payload: []byte{0x0a, 0x10}})
+	require.Nil(t, decoded.Attachment)
+	require.Equal(t, errInvalidAttachment.Error(), decoded.AttachmentError)
+
+	decoded = decodeTx(&Transaction{}, &types.Transaction{Type: types.BurnTx, Payload: attachments.CreateBurnAttachment("key")})
+	require.Equal(t, &BurnDetails{Key: "key"}, decoded.Attachment)
+	require.Empty(t, decoded.AttachmentError)
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/rpc"
+	"github.com/idena-network/idena-go/watchlist"
+)
+
+// WatchApi manages the list of addresses watched by the node and notifies about their changes
+type WatchApi struct {
+	baseApi   *BaseApi
+	bc        *blockchain.Blockchain
+	watchlist *watchlist.Manager
+	bus       eventbus.Bus
+}
+
+// NewWatchApi creates a new WatchApi instance
+func NewWatchApi(baseApi *BaseApi, bc *blockchain.Blockchain, watchlist *watchlist.Manager, bus eventbus.Bus) *WatchApi {
+	return &WatchApi{baseApi: baseApi, bc: bc, watchlist: watchlist, bus: bus}
+}
+
+// Add starts watching the address, changes of the address are posted to the webhook if it is set
+func (api *WatchApi) Add(address common.Address, webhook *string) error {
+	var url string
+	if webhook != nil {
+		url = *webhook
+	}
+	return api.watchlist.Add(address, url)
+}
+
+// Remove stops watching the address
+func (api *WatchApi) Remove(address common.Address) error {
+	return api.watchlist.Remove(address)
+}
+
+func (api *WatchApi) List() []watchlist.Entry {
+	return api.watchlist.List()
+}
+
+// Changes notifies the subscriber about balance changes, identity state changes and incoming txs of the watched
+// addresses, the subscription is resumed from the block next to the token of the last received notification if it is set
+func (api *WatchApi) Changes(ctx context.Context, token *ChainToken) (*rpc.Subscription, error) {
+	tracker := watchlist.NewTracker()
+	return subscribeChain(ctx, api.bc, api.bus, token, func(block *types.Block) ([]interface{}, error) {
+		addresses := api.watchlist.Addresses()
+		if len(addresses) == 0 {
+			return nil, nil
+		}
+		prevState, err := api.baseApi.stateAt(block.Height() - 1)
+		if err != nil {
+			return nil, err
+		}
+		blockState, err := api.baseApi.stateAt(block.Height())
+		if err != nil {
+			return nil, err
+		}
+		var result []interface{}
+		for _, change := range tracker.Track(block, prevState, blockState, addresses) {
+			result = append(result, change)
+		}
+		return result, nil
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/crypto/ecies"
 	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/pkg/errors"
+	"math/big"
 )
 
 type ShortAnswerAttachment struct {
@@ -475,3 +477,325 @@ func ParseStoreToIpfsAttachment(tx *types.Transaction) *StoreToIpfsAttachment {
 	}
 	return attachment
 }
+
+type LockStakeAttachment struct {
+	Delay  uint16
+	Epochs uint16
+}
+
+func CreateLockStakeAttachment(delay uint16, epochs uint16) []byte {
+	attach := &LockStakeAttachment{
+		Delay:  delay,
+		Epochs: epochs,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *LockStakeAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoLockStakeAttachment{
+		Delay:  uint32(t.Delay),
+		Epochs: uint32(t.Epochs),
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *LockStakeAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoLockStakeAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	t.Delay = uint16(protoAttachment.Delay)
+	t.Epochs = uint16(protoAttachment.Epochs)
+	return nil
+}
+
+func ParseLockStakeAttachment(tx *types.Transaction) *LockStakeAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(LockStakeAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
+
+type ChangeBlockGasLimitAttachment struct {
+	GasLimit uint64
+}
+
+func CreateChangeBlockGasLimitAttachment(gasLimit uint64) []byte {
+	attach := &ChangeBlockGasLimitAttachment{
+		GasLimit: gasLimit,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *ChangeBlockGasLimitAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoChangeBlockGasLimitAttachment{
+		GasLimit: t.GasLimit,
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *ChangeBlockGasLimitAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoChangeBlockGasLimitAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	t.GasLimit = protoAttachment.GasLimit
+	return nil
+}
+
+type ChangeProposerThresholdAttachment struct {
+	Threshold float64
+}
+
+func CreateChangeProposerThresholdAttachment(threshold float64) []byte {
+	attach := &ChangeProposerThresholdAttachment{
+		Threshold: threshold,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *ChangeProposerThresholdAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoChangeProposerThresholdAttachment{
+		Threshold: t.Threshold,
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *ChangeProposerThresholdAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoChangeProposerThresholdAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	t.Threshold = protoAttachment.Threshold
+	return nil
+}
+
+// ParseChangeProposerThresholdAttachment parses the attachment, an empty payload is the encoded zero threshold
+func ParseChangeProposerThresholdAttachment(tx *types.Transaction) *ChangeProposerThresholdAttachment {
+	attachment := new(ChangeProposerThresholdAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
+
+type ChangeWordsDictionaryAttachment struct {
+	Hash common.Hash
+	Size uint32
+}
+
+func CreateChangeWordsDictionaryAttachment(hash common.Hash, size uint32) []byte {
+	attach := &ChangeWordsDictionaryAttachment{
+		Hash: hash,
+		Size: size,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *ChangeWordsDictionaryAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoChangeWordsDictionaryAttachment{
+		Hash: t.Hash.Bytes(),
+		Size: t.Size,
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *ChangeWordsDictionaryAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoChangeWordsDictionaryAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	if len(protoAttachment.Hash) != common.HashLength {
+		return errors.New("invalid dictionary hash")
+	}
+	t.Hash = common.BytesToHash(protoAttachment.Hash)
+	t.Size = protoAttachment.Size
+	return nil
+}
+
+func ParseChangeWordsDictionaryAttachment(tx *types.Transaction) *ChangeWordsDictionaryAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(ChangeWordsDictionaryAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
+
+func ParseChangeBlockGasLimitAttachment(tx *types.Transaction) *ChangeBlockGasLimitAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(ChangeBlockGasLimitAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
+
+// MisbehaviorEvidenceAttachment holds two conflicting votes signed by the same identity
+type MisbehaviorEvidenceAttachment struct {
+	Vote1 *types.Vote
+	Vote2 *types.Vote
+}
+
+func CreateMisbehaviorEvidenceAttachment(vote1, vote2 *types.Vote) []byte {
+	attach := &MisbehaviorEvidenceAttachment{
+		Vote1: vote1,
+		Vote2: vote2,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *MisbehaviorEvidenceAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoMisbehaviorEvidenceAttachment{}
+	if t.Vote1 != nil {
+		protoAttachment.Vote1 = t.Vote1.ToProto()
+	}
+	if t.Vote2 != nil {
+		protoAttachment.Vote2 = t.Vote2.ToProto()
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *MisbehaviorEvidenceAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoMisbehaviorEvidenceAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	if protoAttachment.Vote1 != nil {
+		t.Vote1 = new(types.Vote).FromProto(protoAttachment.Vote1)
+	}
+	if protoAttachment.Vote2 != nil {
+		t.Vote2 = new(types.Vote).FromProto(protoAttachment.Vote2)
+	}
+	return nil
+}
+
+// Offender returns the signer of the first vote
+func (t *MisbehaviorEvidenceAttachment) Offender() common.Address {
+	return t.Vote1.VoterAddr()
+}
+
+func ParseMisbehaviorEvidenceAttachment(tx *types.Transaction) *MisbehaviorEvidenceAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(MisbehaviorEvidenceAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil || attachment.Vote1 == nil || attachment.Vote2 == nil {
+		return nil
+	}
+	return attachment
+}
+
+type RegisterBlsKeyAttachment struct {
+	PubKey []byte
+	// Proof is the proof of possession of the private key of PubKey
+	Proof []byte
+}
+
+func CreateRegisterBlsKeyAttachment(pubKey []byte, proof []byte) []byte {
+	attach := &RegisterBlsKeyAttachment{
+		PubKey: pubKey,
+		Proof:  proof,
+	}
+	data, _ := attach.ToBytes()
+	return data
+}
+
+func (t *RegisterBlsKeyAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := &models.ProtoRegisterBlsKeyAttachment{
+		PubKey: t.PubKey,
+		Proof:  t.Proof,
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (t *RegisterBlsKeyAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoRegisterBlsKeyAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	t.PubKey = protoAttachment.PubKey
+	t.Proof = protoAttachment.Proof
+	return nil
+}
+
+func ParseRegisterBlsKeyAttachment(tx *types.Transaction) *RegisterBlsKeyAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(RegisterBlsKeyAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
+
+type MultiSendPayment struct {
+	To     common.Address
+	Amount *big.Int
+}
+
+type MultiSendAttachment struct {
+	Payments []*MultiSendPayment
+}
+
+func CreateMultiSendAttachment(payments []*MultiSendPayment) []byte {
+	attachment := &MultiSendAttachment{
+		Payments: payments,
+	}
+	data, _ := attachment.ToBytes()
+	return data
+}
+
+func (m *MultiSendAttachment) ToBytes() ([]byte, error) {
+	protoAttachment := new(models.ProtoMultiSendAttachment)
+	for _, payment := range m.Payments {
+		protoAttachment.Payments = append(protoAttachment.Payments, &models.ProtoMultiSendAttachment_Payment{
+			To:     payment.To.Bytes(),
+			Amount: common.BigIntBytesOrNil(payment.Amount),
+		})
+	}
+	return proto.Marshal(protoAttachment)
+}
+
+func (m *MultiSendAttachment) FromBytes(data []byte) error {
+	protoAttachment := new(models.ProtoMultiSendAttachment)
+	if err := proto.Unmarshal(data, protoAttachment); err != nil {
+		return err
+	}
+	m.Payments = make([]*MultiSendPayment, 0, len(protoAttachment.Payments))
+	for _, payment := range protoAttachment.Payments {
+		if len(payment.To) != common.AddressLength {
+			return errors.New("invalid recipient")
+		}
+		m.Payments = append(m.Payments, &MultiSendPayment{
+			To:     common.BytesToAddress(payment.To),
+			Amount: new(big.Int).SetBytes(payment.Amount),
+		})
+	}
+	return nil
+}
+
+func ParseMultiSendAttachment(tx *types.Transaction) *MultiSendAttachment {
+	if len(tx.Payload) == 0 {
+		return nil
+	}
+	attachment := new(MultiSendAttachment)
+	if err := attachment.FromBytes(tx.Payload); err != nil {
+		return nil
+	}
+	return attachment
+}
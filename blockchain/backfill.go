@@ -0,0 +1,62 @@
+package blockchain
+
+import (
+	"time"
+)
+
+const (
+	// backfillCheckpointInterval is the number of blocks after which the progress of backfilling is persisted
+	backfillCheckpointInterval = 1000
+	backfillAttempts           = 3
+)
+
+var backfillRetryDelay = 5 * time.Second
+
+// backfillIndex is an index which is built on block import and has to be backfilled for blocks imported before it
+type backfillIndex struct {
+	name string
+	// readFrom returns the lowest indexed height, false is returned if backfilling has never been started
+	readFrom  func() (uint64, bool)
+	writeFrom func(height uint64)
+	// indexBlock indexes the canonical block of the height and returns true if the block has data to index
+	indexBlock func(height uint64) (bool, error)
+}
+
+// backfill indexes blocks imported before the index was introduced. Blocks are indexed from the head downwards and
+// the lowest indexed height is persisted, so backfilling is resumed after restart. Blocks which can't be indexed
+// after backfillAttempts are skipped.
+func (chain *Blockchain) backfill(head uint64, index backfillIndex) {
+	from, ok := index.readFrom()
+	if !ok {
+		// blocks above the head are indexed on import
+		from = head + 1
+		index.writeFrom(from)
+	}
+	if from <= 1 {
+		return
+	}
+	chain.log.Info("Backfilling index", "index", index.name, "from", from-1)
+	var indexed, skipped int
+	for height := from - 1; height > 0; height-- {
+		for attempt := 1; ; attempt++ {
+			hasData, err := index.indexBlock(height)
+			if err == nil {
+				if hasData {
+					indexed++
+				}
+				break
+			}
+			if attempt == backfillAttempts {
+				chain.log.Warn("Failed to index block, it is skipped", "index", index.name, "height", height, "err", err)
+				skipped++
+				break
+			}
+			time.Sleep(backfillRetryDelay)
+		}
+		if height%backfillCheckpointInterval == 0 {
+			index.writeFrom(height)
+		}
+	}
+	index.writeFrom(1)
+	chain.log.Info("Index is backfilled", "index", index.name, "blocks", indexed, "skipped", skipped)
+}
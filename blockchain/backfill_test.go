@@ -0,0 +1,49 @@
+package blockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_backfill(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	chain, _ := NewCustomTestBlockchain(1, 0, key)
+	backfillRetryDelay = 0
+
+	var from uint64
+	var fromSet bool
+	attempts := make(map[uint64]int)
+	var indexed []uint64
+	index := backfillIndex{
+		name: "test",
+		readFrom: func() (uint64, bool) {
+			return from, fromSet
+		},
+		writeFrom: func(height uint64) {
+			from, fromSet = height, true
+		},
+		indexBlock: func(height uint64) (bool, error) {
+			attempts[height]++
+			// the block 3 is indexed by the last attempt, the block 2 is never indexed
+			if height == 2 || height == 3 && attempts[height] < backfillAttempts {
+				return false, errors.New("not available")
+			}
+			indexed = append(indexed, height)
+			return true, nil
+		},
+	}
+
+	chain.backfill(5, index)
+	require.Equal([]uint64{5, 4, 3, 1}, indexed)
+	require.Equal(backfillAttempts, attempts[2])
+	require.Equal(uint64(1), from)
+
+	// backfilling is completed
+	indexed = nil
+	chain.backfill(10, index)
+	require.Empty(indexed)
+}
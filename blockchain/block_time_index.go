@@ -0,0 +1,41 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+)
+
+// BlockAtTime returns the header of the last canonical block with timestamp not greater than the given one
+func (chain *Blockchain) BlockAtTime(timestamp int64) *types.Header {
+	head := chain.Head.Height()
+	var result *types.Header
+	chain.repo.IterateBlocksBeforeTime(timestamp, func(height uint64, hash common.Hash) bool {
+		// entries of reverted blocks and not yet applied headers are skipped
+		if height > head || chain.repo.ReadCanonicalHash(height) != hash {
+			return false
+		}
+		result = chain.repo.ReadBlockHeader(hash)
+		return result != nil
+	})
+	return result
+}
+
+// indexBlockTimes adds blocks imported before the timestamp index was introduced to the index.
+// Blocks are indexed from the head downwards until an already indexed block is met.
+func (chain *Blockchain) indexBlockTimes(head uint64) {
+	indexed := 0
+	for height := head; height > 0; height-- {
+		header := chain.GetBlockHeaderByHeight(height)
+		if header == nil {
+			continue
+		}
+		if chain.repo.HasBlockTime(header) {
+			break
+		}
+		chain.repo.WriteBlockTime(header)
+		indexed++
+	}
+	if indexed > 0 {
+		chain.log.Info("Block timestamp index is built", "blocks", indexed)
+	}
+}
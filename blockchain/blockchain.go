@@ -21,6 +21,7 @@ import (
 	"github.com/idena-network/idena-go/core/upgrade"
 	"github.com/idena-network/idena-go/core/validators"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/bls"
 	"github.com/idena-network/idena-go/crypto/vrf/p256"
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
@@ -32,6 +33,7 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/vm/embedded"
 	cid2 "github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
@@ -84,6 +86,11 @@ type Blockchain struct {
 	applyNewEpochFn func(height uint64, appState *appstate.AppState, collector collector.StatsCollector) (int, *types.ValidationResults, bool)
 	isSyncing       bool
 	ipfsLoadQueue   chan *attachments.StoreToIpfsAttachment
+	rejectedBlocks  *rejectedBlocks
+	duties          *dutyTracker
+	// onlineHeight is the head height at which the node started or finished the last sync,
+	// duties are tracked only for rounds played after it
+	onlineHeight uint64
 }
 
 type txsExecutionContext struct {
@@ -110,10 +117,11 @@ type blockInsertionResult struct {
 
 func NewBlockchain(config *config.Config, db dbm.DB, txpool *mempool.TxPool, appState *appstate.AppState,
 	ipfs ipfs.Proxy, secStore *secstore.SecStore, bus eventbus.Bus, offlineDetector *OfflineDetector, keyStore *keystore.KeyStore, subManager *subscriptions.Manager, upgrader *upgrade.Upgrader) *Blockchain {
+	logger := log.New()
 	return &Blockchain{
 		repo:            database.NewRepo(db),
 		config:          config,
-		log:             log.New(),
+		log:             logger,
 		txpool:          txpool,
 		appState:        appState,
 		ipfs:            ipfs,
@@ -125,6 +133,8 @@ func NewBlockchain(config *config.Config, db dbm.DB, txpool *mempool.TxPool, app
 		subManager:      subManager,
 		upgrader:        upgrader,
 		ipfsLoadQueue:   make(chan *attachments.StoreToIpfsAttachment, 100),
+		rejectedBlocks:  newRejectedBlocks(config.ForensicsDir(), logger),
+		duties:          newDutyTracker(),
 	}
 }
 
@@ -212,7 +222,11 @@ func (chain *Blockchain) InitializeChain() error {
 	}
 	chain.indexer.initialize(chain.coinBaseAddress)
 	chain.PreliminaryHead = chain.repo.ReadPreliminaryHead()
+	chain.onlineHeight = chain.Head.Height()
 	go chain.ipfsLoad()
+	go chain.indexBlockTimes(chain.Head.Height())
+	go chain.indexTxHistory(chain.Head.Height())
+	go chain.indexLogBlooms(chain.Head.Height())
 	log.Info("Chain initialized", "block", chain.Head.Hash().Hex(), "height", chain.Head.Height())
 	log.Info("Coinbase address", "addr", chain.coinBaseAddress.Hex())
 	return nil
@@ -365,6 +379,17 @@ func (chain *Blockchain) GenerateGenesis(network types.Network) (*types.Block, e
 }
 
 func (chain *Blockchain) generateEmptyBlock(checkState *appstate.AppState, prevBlock *types.Header, statsCollector collector.StatsCollector) (*types.Block, *blockInsertionResult) {
+	block := chain.emptyBlockHeader(checkState, prevBlock)
+
+	_, _, stateDiff, identityStateDiff := chain.applyEmptyBlockOnState(checkState, block, prevBlock, statsCollector)
+
+	block.Header.EmptyBlockHeader.Root = checkState.State.Root()
+	block.Header.EmptyBlockHeader.IdentityRoot = checkState.IdentityState.Root()
+	return block, &blockInsertionResult{stateDiff: stateDiff, identityStateDiff: identityStateDiff}
+}
+
+// emptyBlockHeader returns the empty block without state roots
+func (chain *Blockchain) emptyBlockHeader(checkState *appstate.AppState, prevBlock *types.Header) *types.Block {
 	prevTimestamp := time.Unix(prevBlock.Time(), 0)
 
 	block := &types.Block{
@@ -380,12 +405,7 @@ func (chain *Blockchain) generateEmptyBlock(checkState *appstate.AppState, prevB
 
 	block.Header.EmptyBlockHeader.BlockSeed = types.Seed(crypto.Keccak256Hash(getSeedData(prevBlock)))
 	block.Header.EmptyBlockHeader.Flags = chain.calculateFlags(checkState, block, prevBlock)
-
-	_, _, stateDiff, identityStateDiff := chain.applyEmptyBlockOnState(checkState, block, statsCollector)
-
-	block.Header.EmptyBlockHeader.Root = checkState.State.Root()
-	block.Header.EmptyBlockHeader.IdentityRoot = checkState.IdentityState.Root()
-	return block, &blockInsertionResult{stateDiff: stateDiff, identityStateDiff: identityStateDiff}
+	return block
 }
 
 func (chain *Blockchain) GenerateEmptyBlock() *types.Block {
@@ -394,15 +414,42 @@ func (chain *Blockchain) GenerateEmptyBlock() *types.Block {
 	return block
 }
 
+// GenerateEmptyBlockOn generates the empty block on top of the block of the local chain, the state of the block
+// should be available, blocks which finish validation can be generated only if the new epoch handler is provided
+func (chain *Blockchain) GenerateEmptyBlockOn(prevBlock *types.Header) (*types.Block, error) {
+	checkState, err := chain.appState.ForCheck(prevBlock.Height())
+	if err != nil {
+		return nil, err
+	}
+	if chain.applyNewEpochFn == nil && chain.emptyBlockHeader(checkState, prevBlock).Header.Flags().HasFlag(types.ValidationFinished) {
+		return nil, errors.New("new epoch handler is not provided")
+	}
+	block, _ := chain.generateEmptyBlock(checkState, prevBlock, nil)
+	return block, nil
+}
+
 func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppState,
 	statsCollector collector.StatsCollector) error {
+	return chain.addBlock(block, checkState, statsCollector, true)
+}
+
+func (chain *Blockchain) addBlock(block *types.Block, checkState *appstate.AppState,
+	statsCollector collector.StatsCollector, publishHead bool) error {
 
 	if err := validateBlockParentHash(block.Header, chain.Head); err != nil {
 		return err
 	}
 	statsCollector.EnableCollecting()
 	defer statsCollector.CompleteCollecting()
-	if blockInsertionResult, err := chain.ValidateBlock(block, checkState, statsCollector); err != nil {
+	blockStats := newBlockStatsCollector(statsCollector)
+	epoch := chain.appState.State.Epoch()
+	trackDuties := chain.shouldTrackDuties()
+	var propose bool
+	var voteSteps []uint8
+	if trackDuties {
+		propose, voteSteps = chain.expectedDuties()
+	}
+	if blockInsertionResult, err := chain.ValidateBlock(block, checkState, blockStats); err != nil {
 		return err
 	} else {
 		chain.appState.State.AddDiff(blockInsertionResult.stateDiff)
@@ -427,6 +474,13 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 			return err
 		}
 
+		chain.updateEpochStats(block, blockStats)
+		if trackDuties {
+			if record := chain.duties.complete(block.Height(), epoch, propose, voteSteps); record != nil {
+				chain.updateMinerPerformance(record)
+			}
+		}
+
 		for _, task := range blockInsertionResult.txTasks {
 			task()
 		}
@@ -435,6 +489,7 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 			chain.txpool.ResetTo(block)
 		}
 		chain.tryUpgrade(block.Header)
+		chain.publishCheckpointIfNeeded(block.Header)
 		if block.Header.Flags().HasFlag(types.NewGenesis) {
 			chain.repo.WriteIntermediateGenesis(nil, block.Header.Height())
 			chain.genesisInfo.OldGenesis = chain.genesisInfo.Genesis
@@ -443,6 +498,11 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 		chain.bus.Publish(&events.NewBlockEvent{
 			Block: block,
 		})
+		if publishHead {
+			chain.bus.Publish(&events.ChainHeadEvent{
+				Block: block,
+			})
+		}
 		chain.RemovePreliminaryHead(nil)
 		return nil
 	}
@@ -451,6 +511,7 @@ func (chain *Blockchain) AddBlock(block *types.Block, checkState *appstate.AppSt
 func (chain *Blockchain) applyBlockOnState(appState *appstate.AppState, block *types.Block, prevBlock *types.Header, totalFee, totalTips *big.Int, usedGas uint64, statsCollector collector.StatsCollector) (root, identityRoot common.Hash, stateDiff []*state.StateTreeDiff, diff *state.IdentityStateDiff) {
 
 	chain.applyStatusSwitch(appState, block)
+	chain.applyContractStorageSizes(appState, prevBlock)
 	chain.applyDelayedOfflinePenalties(appState, block, statsCollector)
 	undelegations := chain.applyDelegationSwitch(appState, block)
 	chain.applyNewEpoch(appState, block, statsCollector)
@@ -468,10 +529,12 @@ func (chain *Blockchain) applyBlockOnState(appState *appstate.AppState, block *t
 func (chain *Blockchain) applyEmptyBlockOnState(
 	appState *appstate.AppState,
 	block *types.Block,
+	prevBlock *types.Header,
 	statsCollector collector.StatsCollector,
 ) (root common.Hash, identityRoot common.Hash, stateDiff []*state.StateTreeDiff, identityStateDiff *state.IdentityStateDiff) {
 
 	chain.applyStatusSwitch(appState, block)
+	chain.applyContractStorageSizes(appState, prevBlock)
 	chain.applyDelayedOfflinePenalties(appState, block, statsCollector)
 	undelegations := chain.applyDelegationSwitch(appState, block)
 	chain.applyNewEpoch(appState, block, statsCollector)
@@ -486,51 +549,14 @@ func (chain *Blockchain) applyEmptyBlockOnState(
 
 func (chain *Blockchain) applyBlockRewards(totalFee *big.Int, totalTips *big.Int, appState *appstate.AppState,
 	block *types.Block, prevBlock *types.Header, statsCollector collector.StatsCollector) {
-
-	// calculate fee reward
-	burnFee := decimal.NewFromBigInt(totalFee, 0)
-	burnFee = burnFee.Mul(decimal.NewFromFloat32(chain.config.Consensus.FeeBurnRate))
-	intBurn := math.ToInt(burnFee)
-	intFeeReward := new(big.Int)
-	intFeeReward.Sub(totalFee, intBurn)
-
-	totalReward := big.NewInt(0).Add(chain.config.Consensus.BlockReward, intFeeReward)
-	totalReward.Add(totalReward, totalTips)
-
-	coinbase := block.Header.Coinbase()
-
-	status := appState.State.GetIdentityState(coinbase)
-	stakeDest := coinbase
-
-	if appState.ValidatorsCache.IsPool(coinbase) {
-		delegationNonce := appState.State.GetIdentity(coinbase).DelegationNonce
-		subIdentity, newNonce := appState.ValidatorsCache.FindSubIdentity(coinbase, delegationNonce)
-		appState.State.SetDelegationNonce(coinbase, newNonce)
-
-		status = appState.State.GetIdentityState(subIdentity)
-		stakeDest = subIdentity
-	}
-
-	reward, stake := splitReward(totalReward, status == state.Newbie, chain.config.Consensus)
-
-	// calculate penalty
-	balanceAdd, stakeAdd, penaltySub := calculatePenalty(reward, stake, appState.State.GetPenalty(coinbase))
-
-	collector.BeginProposerRewardBalanceUpdate(statsCollector, coinbase, stakeDest, appState)
-	// update state
-	appState.State.AddBalance(coinbase, balanceAdd)
-	appState.State.AddStake(stakeDest, stakeAdd)
-	if penaltySub != nil {
-		appState.State.SubPenalty(coinbase, penaltySub)
-	}
-	collector.CompleteBalanceUpdate(statsCollector, appState)
-	collector.AddMintedCoins(statsCollector, chain.config.Consensus.BlockReward)
-	collector.AfterAddStake(statsCollector, stakeDest, stake, appState)
-	collector.AfterSubPenalty(statsCollector, coinbase, penaltySub, appState)
-	collector.AddPenaltyBurntCoins(statsCollector, coinbase, penaltySub)
-	collector.AddProposerReward(statsCollector, coinbase, stakeDest, reward, stake)
-
-	chain.rewardFinalCommittee(appState, block, prevBlock, statsCollector)
+	chain.rewardPolicy().ApplyBlockRewards(&BlockRewardContext{
+		AppState:       appState,
+		Consensus:      chain.config.Consensus,
+		Block:          block,
+		TotalFee:       totalFee,
+		TotalTips:      totalTips,
+		FinalCommittee: chain.finalCommittee(appState, block, prevBlock),
+	}, statsCollector)
 }
 
 func calculatePenalty(balanceAppend *big.Int, stakeAppend *big.Int, currentPenalty *big.Int) (balanceAdd *big.Int, stakeAdd *big.Int, penaltySub *big.Int) {
@@ -574,8 +600,17 @@ func (chain *Blockchain) applyNewEpoch(appState *appstate.AppState, block *types
 		for i := 0; i < epochDurationsLen; i++ {
 			epochDurations = append(epochDurations, uint32(epochBlocks[i+1]-epochBlocks[i]))
 		}
-		rewardValidIdentities(appState, chain.config.Consensus, validationResults, epochDurations, block.Seed(),
-			statsCollector)
+		chain.rewardPolicy().ApplyValidationRewards(&ValidationRewardContext{
+			AppState:          appState,
+			Consensus:         chain.config.Consensus,
+			ValidationResults: validationResults,
+			EpochDurations:    epochDurations,
+			Seed:              block.Seed(),
+		}, statsCollector)
+	}
+
+	if chain.config.Consensus.EnableContractStorageRent {
+		chargeContractStorageRent(appState, chain.config.Consensus.ContractStorageRentPerByte, chain.config.Consensus.ContractStorageRentGraceEpochs, statsCollector)
 	}
 
 	clearDustAccounts(appState, networkSize, statsCollector)
@@ -755,6 +790,82 @@ func clearDustAccounts(appState *appstate.AppState, networkSize int, statsCollec
 	})
 }
 
+// chargeContractStorageRent burns storage rent from contract stakes. A contract which stake doesn't cover the rent
+// gets graceEpochs epochs to top the stake up, the unpaid epochs are shown by contract_storageUsage. After that
+// the contract is reclaimed: its store is removed, the rest of stake and the contract balance, which nobody can
+// spend without the contract code, are burnt
+func chargeContractStorageRent(appState *appstate.AppState, rentPerByte *big.Int, graceEpochs uint16, statsCollector collector.StatsCollector) {
+	if common.ZeroOrNil(rentPerByte) {
+		return
+	}
+	var reclaimed []common.Address
+	appState.State.IterateOverAccounts(func(addr common.Address, account state.Account) {
+		if account.Contract == nil || account.Contract.StorageSize == 0 {
+			return
+		}
+		rent := new(big.Int).Mul(rentPerByte, new(big.Int).SetUint64(account.Contract.StorageSize))
+		stake := account.Contract.Stake
+		if stake == nil || stake.Cmp(rent) < 0 {
+			if account.Contract.UnpaidRentEpochs < graceEpochs {
+				appState.State.SetContractUnpaidRentEpochs(addr, account.Contract.UnpaidRentEpochs+1)
+				return
+			}
+			reclaimed = append(reclaimed, addr)
+			return
+		}
+		appState.State.SetContractStake(addr, new(big.Int).Sub(stake, rent))
+		if account.Contract.UnpaidRentEpochs > 0 {
+			appState.State.SetContractUnpaidRentEpochs(addr, 0)
+		}
+		collector.AddContractStorageRentBurntCoins(statsCollector, addr, rent)
+	})
+	for _, addr := range reclaimed {
+		if stake := appState.State.GetContractStake(addr); !common.ZeroOrNil(stake) {
+			collector.AddContractStorageRentBurntCoins(statsCollector, addr, stake)
+		}
+		if balance := appState.State.GetBalance(addr); balance.Sign() > 0 {
+			collector.AddContractStorageRentBurntCoins(statsCollector, addr, balance)
+			appState.State.SetBalance(addr, big.NewInt(0))
+		}
+		var keys [][]byte
+		appState.State.IterateContractStore(addr, nil, nil, func(key []byte, value []byte) bool {
+			keys = append(keys, common.CopyBytes(key))
+			return false
+		})
+		for _, key := range keys {
+			appState.State.RemoveContractValue(addr, key)
+		}
+		appState.State.DropContract(addr)
+	}
+}
+
+// applyContractStorageSizes accounts the storage which contracts had written before the storage rent was enabled,
+// sizes are computed in the first block of the new consensus version, which follows the upgrade block
+func (chain *Blockchain) applyContractStorageSizes(appState *appstate.AppState, prevBlock *types.Header) {
+	if !chain.config.Consensus.EnableContractStorageRent || prevBlock == nil || prevBlock.ProposedHeader == nil ||
+		prevBlock.ProposedHeader.Upgrade != uint32(chain.config.Consensus.Version) {
+		return
+	}
+	computeContractStorageSizes(appState)
+}
+
+func computeContractStorageSizes(appState *appstate.AppState) {
+	var contracts []common.Address
+	appState.State.IterateOverAccounts(func(addr common.Address, account state.Account) {
+		if account.Contract != nil {
+			contracts = append(contracts, addr)
+		}
+	})
+	for _, addr := range contracts {
+		var size uint64
+		appState.State.IterateContractStore(addr, nil, nil, func(key []byte, value []byte) bool {
+			size += uint64(len(key) + len(value))
+			return false
+		})
+		appState.State.SetContractStorageSize(addr, size)
+	}
+}
+
 func removeLinksWithInviterAndInvitees(stateDB *state.StateDB, addr common.Address) {
 	removeLinkWithInviter(stateDB, addr)
 	removeLinkWithInvitees(stateDB, addr)
@@ -865,6 +976,32 @@ func (chain *Blockchain) applyOfflinePenalty(appState *appstate.AppState, addr c
 	appState.IdentityState.SetOnline(addr, false)
 }
 
+// applyMisbehaviorPenalty burns the configured share of the offender stake and bans the offender from mining,
+// the round is recorded so the offender can't be penalized again for the same round
+func (chain *Blockchain) applyMisbehaviorPenalty(appState *appstate.AppState, offender common.Address, round uint64,
+	statsCollector collector.StatsCollector) {
+	if rate := chain.config.Consensus.MisbehaviorStakeSlashRate; rate > 0 {
+		stake := appState.State.GetStakeBalance(offender)
+		amount := math.ToInt(decimal.NewFromBigInt(stake, 0).Mul(decimal.NewFromFloat32(rate)))
+		if amount.Sign() > 0 {
+			appState.State.SubStake(offender, amount)
+			collector.AddPenaltyBurntCoins(statsCollector, offender, amount)
+		}
+	}
+	if chain.config.Consensus.MisbehaviorMiningBan {
+		appState.IdentityState.SetOnline(offender, false)
+		// a pending switch would bring the offender back online
+		if appState.State.HasStatusSwitchAddresses(offender) {
+			appState.State.ToggleStatusSwitchAddress(offender)
+		}
+	}
+	var minRound uint64
+	if height := uint64(appState.State.Version()) + 1; height > chain.config.Consensus.MisbehaviorEvidenceLifetime {
+		minRound = height - chain.config.Consensus.MisbehaviorEvidenceLifetime
+	}
+	appState.State.AddMisbehavior(offender, round, minRound)
+}
+
 func (chain *Blockchain) applyDelayedOfflinePenalties(appState *appstate.AppState, block *types.Block, statsCollector collector.StatsCollector) {
 	if !chain.config.Consensus.EnableDelayedOfflinePenalty {
 		return
@@ -884,55 +1021,19 @@ func (chain *Blockchain) applyDelayedOfflinePenalties(appState *appstate.AppStat
 	appState.State.ClearDelayedOfflinePenalties()
 }
 
-func (chain *Blockchain) rewardFinalCommittee(appState *appstate.AppState, block *types.Block, prevBlock *types.Header,
-	statsCollector collector.StatsCollector) {
+func (chain *Blockchain) finalCommittee(appState *appstate.AppState, block *types.Block, prevBlock *types.Header) []common.Address {
 	if block.IsEmpty() {
-		return
+		return nil
 	}
 	identities := appState.ValidatorsCache.GetOnlineValidators(prevBlock.Seed(), block.Height(), types.Final, chain.GetCommitteeSize(appState.ValidatorsCache, true))
 	if identities == nil || identities.Addresses.Cardinality() == 0 {
-		return
+		return nil
 	}
-	totalReward := big.NewInt(0)
-	totalReward.Div(chain.config.Consensus.FinalCommitteeReward, big.NewInt(int64(identities.Original.Cardinality())))
-	collector.SetCommitteeRewardShare(statsCollector, totalReward)
-
-	reward, stake := splitReward(totalReward, false, chain.config.Consensus)
-	newbieReward, newbieStake := splitReward(totalReward, true, chain.config.Consensus)
-
+	committee := make([]common.Address, 0, identities.Original.Cardinality())
 	for _, item := range identities.Original.ToSlice() {
-		addr := item.(common.Address)
-
-		identityState := appState.State.GetIdentityState(addr)
-		r, s := reward, stake
-		if identityState == state.Newbie {
-			r, s = newbieReward, newbieStake
-		}
-
-		penaltySource := addr
-		balanceDest := addr
-		if delegator := appState.ValidatorsCache.Delegator(addr); !delegator.IsEmpty() {
-			penaltySource = delegator
-			balanceDest = delegator
-		}
-		// calculate penalty
-		balanceAdd, stakeAdd, penaltySub := calculatePenalty(r, s, appState.State.GetPenalty(penaltySource))
-
-		collector.BeginCommitteeRewardBalanceUpdate(statsCollector, balanceDest, addr, appState)
-		// update state
-		appState.State.AddBalance(balanceDest, balanceAdd)
-		appState.State.AddStake(addr, stakeAdd)
-		if penaltySub != nil {
-			appState.State.SubPenalty(penaltySource, penaltySub)
-		}
-		collector.CompleteBalanceUpdate(statsCollector, appState)
-		collector.AddMintedCoins(statsCollector, r)
-		collector.AddMintedCoins(statsCollector, s)
-		collector.AfterAddStake(statsCollector, addr, s, appState)
-		collector.AfterSubPenalty(statsCollector, penaltySource, penaltySub, appState)
-		collector.AddPenaltyBurntCoins(statsCollector, penaltySource, penaltySub)
-		collector.AddFinalCommitteeReward(statsCollector, balanceDest, addr, r, s)
+		committee = append(committee, item.(common.Address))
 	}
+	return committee
 }
 
 func (chain *Blockchain) processTxs(txs []*types.Transaction, context *txsExecutionContext) (totalFee *big.Int, totalTips *big.Int, receipts types.TxReceipts, tasks []task, usedGas uint64, err error) {
@@ -964,7 +1065,7 @@ func (chain *Blockchain) processTxs(txs []*types.Transaction, context *txsExecut
 				receipts = append(receipts, receipt)
 				gas += receipt.GasUsed
 			}
-			if usedGas+gas > types.MaxBlockGas {
+			if usedGas+gas > header.GasLimit() {
 				return nil, nil, nil, nil, 0, errors.New("block exceeds gas limit")
 			}
 			usedGas += gas
@@ -1060,6 +1161,13 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
 		stateDB.SubBalance(sender, tx.AmountOrZero())
 		stateDB.AddBalance(*tx.To, tx.AmountOrZero())
+	case types.MultiSendTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		stateDB.SubBalance(sender, tx.AmountOrZero())
+		for _, payment := range attachments.ParseMultiSendAttachment(tx).Payments {
+			stateDB.AddBalance(payment.To, payment.Amount)
+		}
 	case types.BurnTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
@@ -1068,20 +1176,26 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 	case types.InviteTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
-		if sender == stateDB.GodAddress() {
+		inviter := sender
+		if god := stateDB.GodAddress(); sender == god {
+			stateDB.SubGodAddressInvite()
+		} else if validation.IsGodTx(appState, tx) {
+			// invitation approved by the god multisig signers
+			inviter = god
 			stateDB.SubGodAddressInvite()
+			embedded.ClearGodTxApprovals(stateDB, god)
 		} else {
 			stateDB.SubInvite(sender, 1)
 		}
 
 		stateDB.SubBalance(sender, tx.AmountOrZero())
-		generation, code := stateDB.GeneticCode(sender)
+		generation, code := stateDB.GeneticCode(inviter)
 
 		stateDB.SetState(*tx.To, state.Invite)
 		stateDB.AddBalance(*tx.To, tx.AmountOrZero())
-		stateDB.SetGeneticCode(*tx.To, generation+1, append(code[1:], sender[0]))
+		stateDB.SetGeneticCode(*tx.To, generation+1, append(code[1:], inviter[0]))
 
-		stateDB.SetInviter(*tx.To, sender, tx.Hash(), 0)
+		stateDB.SetInviter(*tx.To, inviter, tx.Hash(), 0)
 	case types.KillTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
@@ -1144,7 +1258,45 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 	case types.ChangeGodAddressTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		if god := stateDB.GodAddress(); sender != god {
+			embedded.ClearGodTxApprovals(stateDB, god)
+		}
 		appState.State.SetGodAddress(*tx.To)
+	case types.ChangeBlockGasLimitTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		if god := stateDB.GodAddress(); sender != god {
+			embedded.ClearGodTxApprovals(stateDB, god)
+		}
+		attachment := attachments.ParseChangeBlockGasLimitAttachment(tx)
+		stateDB.SetBlockGasLimit(attachment.GasLimit)
+	case types.ChangeProposerThresholdTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		if god := stateDB.GodAddress(); sender != god {
+			embedded.ClearGodTxApprovals(stateDB, god)
+		}
+		attachment := attachments.ParseChangeProposerThresholdAttachment(tx)
+		stateDB.SetMinProposerThreshold(attachment.Threshold)
+	case types.ChangeWordsDictionaryTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		if god := stateDB.GodAddress(); sender != god {
+			embedded.ClearGodTxApprovals(stateDB, god)
+		}
+		attachment := attachments.ParseChangeWordsDictionaryAttachment(tx)
+		stateDB.SetNextWordsDictionary(attachment.Hash, attachment.Size)
+	case types.MisbehaviorEvidenceTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		attachment := attachments.ParseMisbehaviorEvidenceAttachment(tx)
+		chain.applyMisbehaviorPenalty(appState, attachment.Offender(), attachment.Vote1.Header.Round, statsCollector)
+	case types.RegisterBlsKeyTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		attachment := attachments.ParseRegisterBlsKeyAttachment(tx)
+		// the key is used by the validators cache starting from the next identity update
+		appState.IdentityState.SetBlsPubKey(sender, attachment.PubKey)
 	case types.ChangeProfileTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
@@ -1158,7 +1310,16 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 	case types.SubmitAnswersHashTx, types.SubmitShortAnswersTx, types.EvidenceTx, types.SubmitLongAnswersTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
-		stateDB.SetValidationTxBit(sender, tx.Type)
+		candidate, _ := types.CeremonyIdentity(tx)
+		stateDB.SetValidationTxBit(candidate, tx.Type)
+	case types.AuthorizeAnswersSubmitterTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		if *tx.To == sender {
+			stateDB.RemoveAnswersSubmitter(sender)
+		} else {
+			stateDB.SetAnswersSubmitter(sender, *tx.To)
+		}
 	case types.DeployContractTx, types.CallContractTx, types.TerminateContractTx:
 		amount := tx.AmountOrZero()
 		if amount.Sign() > 0 && tx.Type == types.CallContractTx {
@@ -1192,6 +1353,17 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
 		stateDB.ToggleDelegationAddress(sender, common.EmptyAddress)
+	case types.LockStakeTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		attachment := attachments.ParseLockStakeAttachment(tx)
+		amount := tx.AmountOrZero()
+		stateDB.SubBalance(sender, amount)
+		stateDB.AddStakeLock(*tx.To, sender, amount, appState.State.Epoch()+attachment.Delay+1, attachment.Epochs)
+	case types.UnlockStakeTx:
+		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
+		defer collector.CompleteBalanceUpdate(statsCollector, appState)
+		stateDB.ReleaseStake(sender, appState.State.Epoch())
 	case types.StoreToIpfsTx:
 		collector.BeginTxBalanceUpdate(statsCollector, tx, appState)
 		defer collector.CompleteBalanceUpdate(statsCollector, appState)
@@ -1216,6 +1388,18 @@ func (chain *Blockchain) applyTxOnState(tx *types.Transaction, context *txExecut
 	collector.AddTxFee(statsCollector, fee)
 	collector.AddFeeBurntCoins(statsCollector, sender, fee, chain.config.Consensus.FeeBurnRate, tx)
 
+	if chain.config.Consensus.EnableReceiptsForAllTxs {
+		if receipt == nil {
+			receipt = &types.TxReceipt{
+				Success: true,
+				From:    sender,
+				TxHash:  tx.Hash(),
+			}
+		}
+		receipt.Fee = new(big.Int).Set(fee)
+		receipt.FeeBurned = math.ToInt(decimal.NewFromBigInt(fee, 0).Mul(decimal.NewFromFloat32(chain.config.Consensus.FeeBurnRate)))
+	}
+
 	return fee, receipt, task, nil
 }
 
@@ -1248,6 +1432,14 @@ func (chain *Blockchain) applyNextBlockFee(appState *appstate.AppState, block *t
 	appState.State.SetFeePerGas(feePerGas)
 }
 
+// headerGasLimit returns the gas limit which should be recorded in the header of the next block
+func (chain *Blockchain) headerGasLimit(appState *appstate.AppState) uint64 {
+	if !chain.config.Consensus.EnableBlockGasLimitGovernance {
+		return 0
+	}
+	return appState.State.BlockGasLimit()
+}
+
 func (chain *Blockchain) calculateNextBlockFeePerGas(appState *appstate.AppState, block *types.Block, usedGas uint64) *big.Int {
 
 	minFeePerGas := fee.GetFeePerGasForNetwork(appState.ValidatorsCache.NetworkSize())
@@ -1258,7 +1450,7 @@ func (chain *Blockchain) calculateNextBlockFeePerGas(appState *appstate.AppState
 	}
 
 	k := chain.config.Consensus.FeeSensitivityCoef
-	maxBlockGas := types.MaxBlockGas
+	maxBlockGas := block.Header.GasLimit()
 
 	// curBlockFee = prevBlockFee * (1 + k * (prevBlockGas / maxBlockGas - 0.5))
 	newFeePerGasD := decimal.New(int64(usedGas), 0).
@@ -1286,8 +1478,9 @@ func (chain *Blockchain) applyVrfProposerThreshold(appState *appstate.AppState,
 
 	emptyBlocks := appState.State.EmptyBlocksCount()
 
-	minVrf := math2.Max(chain.config.Consensus.MinProposerThreshold, 1.0-5.0/online)
-	maxVrf := math2.Max(chain.config.Consensus.MinProposerThreshold, 1.0-1.0/online)
+	minThreshold := chain.minProposerThreshold(appState)
+	minVrf := math2.Max(minThreshold, 1.0-5.0/online)
+	maxVrf := math2.Max(minThreshold, 1.0-1.0/online)
 
 	step := (maxVrf - minVrf) / 60
 	switch emptyBlocks {
@@ -1303,6 +1496,25 @@ func (chain *Blockchain) applyVrfProposerThreshold(appState *appstate.AppState,
 	appState.State.SetVrfProposerThreshold(currentThreshold)
 }
 
+// minProposerThreshold returns the lower bound of the proposer VRF threshold, the threshold set by the god address
+// takes precedence over the network config on test networks. The public networks always use the threshold
+// of the consensus version, so it can't be changed by the node config.
+func (chain *Blockchain) minProposerThreshold(appState *appstate.AppState) float64 {
+	network := chain.config.Network
+	if network == Mainnet {
+		return chain.config.Consensus.MinProposerThreshold
+	}
+	if chain.config.Consensus.EnableProposerThresholdGovernance {
+		if threshold := appState.State.MinProposerThreshold(); threshold > 0 {
+			return math2.Min(threshold, validation.MaxGovernedProposerThreshold)
+		}
+	}
+	if network == Testnet {
+		return chain.config.Consensus.MinProposerThreshold
+	}
+	return chain.config.MinProposerThreshold()
+}
+
 func (chain *Blockchain) applyStatusSwitch(appState *appstate.AppState, block *types.Block) {
 	if !block.Header.Flags().HasFlag(types.IdentityUpdate) {
 		return
@@ -1401,7 +1613,69 @@ func (chain *Blockchain) GetProposerSortition() (bool, []byte) {
 	return false, nil
 }
 
+// BlockBuildResult describes the block built from mempool txs on top of the current head
+type BlockBuildResult struct {
+	Block        *types.Block
+	TotalFee     *big.Int
+	TotalTips    *big.Int
+	UsedGas      uint64
+	CandidateTxs int
+}
+
 func (chain *Blockchain) ProposeBlock(proof []byte) *types.BlockProposal {
+	block := chain.buildBlock(true).Block
+	proposal := &types.BlockProposal{Block: block, Proof: proof}
+	hash := crypto.SignatureHash(proposal)
+	proposal.Signature = chain.secStore.Sign(hash[:])
+	chain.duties.addProposal(block.Height())
+	return proposal
+}
+
+// BuildBlockDryRun builds the block the node would propose right now without signing and broadcasting it.
+// Offline proposals are not evaluated since they affect the state of the offline detector.
+func (chain *Blockchain) BuildBlockDryRun() *BlockBuildResult {
+	return chain.buildBlock(false)
+}
+
+// ProposalSimulation describes the block the node would propose and the result of its validation by other nodes
+type ProposalSimulation struct {
+	*BlockBuildResult
+	// IsProposer shows whether the node passes the proposer sortition of the current round
+	IsProposer      bool
+	Receipts        types.TxReceipts
+	ValidationError error
+}
+
+// SimulateProposal builds the block the node would propose right now and validates it the way other nodes do,
+// neither the block nor the state is persisted
+func (chain *Blockchain) SimulateProposal() *ProposalSimulation {
+	result := &ProposalSimulation{
+		BlockBuildResult: chain.buildBlock(false),
+	}
+	result.IsProposer, _ = chain.GetProposerSortition()
+	block := result.Block
+	checkState, err := chain.appState.ForCheck(chain.Head.Height())
+	if err != nil {
+		result.ValidationError = err
+		return result
+	}
+	insertionResult, err := chain.validateBlock(checkState, block, chain.Head, nil)
+	if err != nil {
+		result.ValidationError = err
+		return result
+	}
+	result.Receipts = insertionResult.txReceipts
+	if err := chain.offlineDetector.ValidateBlock(chain.Head, block); err != nil {
+		result.ValidationError = err
+		return result
+	}
+	if err := chain.upgrader.ValidateBlock(block); err != nil {
+		result.ValidationError = err
+	}
+	return result
+}
+
+func (chain *Blockchain) buildBlock(proposeOffline bool) *BlockBuildResult {
 	head := chain.Head
 
 	txs := chain.txpool.BuildBlockTransactions()
@@ -1419,13 +1693,16 @@ func (chain *Blockchain) ProposeBlock(proof []byte) *types.BlockProposal {
 		Time:           newBlockTime,
 		ProposerPubKey: chain.pubKey,
 		FeePerGas:      chain.appState.State.FeePerGas(),
+		GasLimit:       chain.headerGasLimit(checkState),
 	}
 
 	header.BlockSeed, header.SeedProof = chain.secStore.VrfEvaluate(getSeedData(head))
-	addr, flag := chain.offlineDetector.ProposeOffline(head)
-	if addr != nil {
-		header.OfflineAddr = addr
-		header.Flags |= flag
+	if proposeOffline {
+		addr, flag := chain.offlineDetector.ProposeOffline(head)
+		if addr != nil {
+			header.OfflineAddr = addr
+			header.Flags |= flag
+		}
 	}
 
 	filteredTxs, totalFee, totalTips, receipts, usedGas := chain.filterTxs(checkState, txs, header)
@@ -1471,10 +1748,13 @@ func (chain *Blockchain) ProposeBlock(proof []byte) *types.BlockProposal {
 
 	block.Header.ProposedHeader.Root, block.Header.ProposedHeader.IdentityRoot, _, _ = chain.applyBlockOnState(checkState, block, chain.Head, totalFee, totalTips, usedGas, nil)
 
-	proposal := &types.BlockProposal{Block: block, Proof: proof}
-	hash := crypto.SignatureHash(proposal)
-	proposal.Signature = chain.secStore.Sign(hash[:])
-	return proposal
+	return &BlockBuildResult{
+		Block:        block,
+		TotalFee:     totalFee,
+		TotalTips:    totalTips,
+		UsedGas:      usedGas,
+		CandidateTxs: len(txs),
+	}
 }
 
 func calculateTxBloom(block *types.Block, receipts types.TxReceipts) []byte {
@@ -1589,7 +1869,7 @@ func (chain *Blockchain) filterTxs(appState *appstate.AppState, txs []*types.Tra
 				receipts = append(receipts, r)
 				gas += r.GasUsed
 			}
-			if usedGas+gas > types.MaxBlockGas {
+			if usedGas+gas > header.BlockGasLimit() {
 				break
 			}
 			usedGas += gas
@@ -1605,6 +1885,7 @@ func (chain *Blockchain) insertHeader(header *types.Header) {
 	chain.repo.WriteBlockHeader(header)
 	chain.repo.WriteHead(nil, header)
 	chain.repo.WriteCanonicalHash(header.Height(), header.Hash())
+	chain.repo.WriteBlockTime(header)
 }
 
 func (chain *Blockchain) insertBlock(block *types.Block, diff *state.IdentityStateDiff, receipts types.TxReceipts) error {
@@ -1625,14 +1906,27 @@ func (chain *Blockchain) insertBlock(block *types.Block, diff *state.IdentitySta
 	chain.WriteTxIndex(block.Hash(), block.Body.Transactions)
 	if receipts != nil {
 		chain.WriteTxReceipts(block.Header.ProposedHeader.TxReceiptsCid, receipts)
+		chain.writeLogBloom(block.Height(), receipts)
 	}
 	chain.indexer.HandleBlockTransactions(block.Header, block.Body.Transactions)
 	chain.setCurrentHead(block.Header)
 	return nil
 }
 
+// validationOnlyAccounts returns accounts which txs are indexed by the validation only node, nil means all txs are indexed
+func (chain *Blockchain) validationOnlyAccounts() map[common.Address]struct{} {
+	if chain.config.Sync == nil || !chain.config.Sync.ValidationOnly {
+		return nil
+	}
+	return chain.indexer.ownAccounts()
+}
+
 func (chain *Blockchain) WriteTxIndex(hash common.Hash, txs types.Transactions) {
+	ownAccounts := chain.validationOnlyAccounts()
 	for i, tx := range txs {
+		if ownAccounts != nil && !isOwnTx(tx, ownAccounts) {
+			continue
+		}
 		idx := &types.TransactionIndex{
 			BlockHash: hash,
 			Idx:       uint32(i),
@@ -1652,12 +1946,15 @@ func (chain *Blockchain) WriteTxReceipts(cid []byte, receipts types.TxReceipts)
 		eventMap[s.Event] = struct{}{}
 	}
 
+	ownAccounts := chain.validationOnlyAccounts()
 	for i, r := range receipts {
 		idx := &types.TxReceiptIndex{
 			Idx:        uint32(i),
 			ReceiptCid: cid,
 		}
-		chain.repo.WriteReceiptIndex(r.TxHash, idx)
+		if _, ok := ownAccounts[r.From]; ownAccounts == nil || ok {
+			chain.repo.WriteReceiptIndex(r.TxHash, idx)
+		}
 		if eventMap, ok := m[r.ContractAddress]; ok {
 			for idx, event := range r.Events {
 				if _, ok := eventMap[event.EventName]; ok {
@@ -1707,12 +2004,20 @@ func (chain *Blockchain) validateBlock(checkState *appstate.AppState, block *typ
 		return nil, errors.New("fee rate is invalid")
 	}
 
+	if block.Header.ProposedHeader.GasLimit != chain.headerGasLimit(checkState) {
+		return nil, errors.New("gas limit is invalid")
+	}
+
 	proposerAddr, _ := crypto.PubKeyBytesToAddress(block.Header.ProposedHeader.ProposerPubKey)
 
 	if !checkIfProposer(proposerAddr, checkState) {
 		return nil, errors.New("proposer is not identity")
 	}
 
+	if err := prevalidateTxs(block.Body.Transactions); err != nil {
+		return nil, err
+	}
+
 	var txs = types.Transactions(block.Body.Transactions)
 
 	if types.DeriveSha(txs) != block.Header.ProposedHeader.TxHash {
@@ -1815,12 +2120,154 @@ func (chain *Blockchain) ValidateBlockCert(prevBlock *types.Header, block *types
 		voters.Add(vote.VoterAddr())
 	}
 
+	if len(cert.AggregatedSignature) > 0 || len(cert.VoterGroups) > 0 {
+		if err := chain.validateAggregatedVotes(prevBlock, block, cert, validators, validatorsCache, voters); err != nil {
+			return err
+		}
+	}
+
 	if voters.Cardinality() < chain.GetCommitteeVotesThreshold(validatorsCache, step == types.Final)-validators.VotesCountSubtrahend(chain.config.Consensus.AgreementThreshold) {
 		return errors.New("not enough votes")
 	}
 	return nil
 }
 
+func (chain *Blockchain) validateAggregatedVotes(prevBlock *types.Header, block *types.Header, cert *types.BlockCert, stepValidators *validators.StepValidators,
+	validatorsCache *validators.ValidatorsCache, voters mapset.Set) error {
+	if !chain.config.Consensus.EnableBlsVoteAggregation {
+		return errors.New("bls vote aggregation is not enabled")
+	}
+	if len(cert.AggregatedSignature) == 0 || len(cert.VoterGroups) == 0 || stepValidators == nil {
+		return errors.New("invalid aggregated signature")
+	}
+	if cert.Round != block.Height() {
+		return errors.New("invalid vote header")
+	}
+	if cert.VotedHash != block.Hash() {
+		return errors.New("invalid voted hash")
+	}
+	committee := stepValidators.SortedAddresses()
+	msgs := make([][]byte, 0, len(cert.VoterGroups))
+	pubKeys := make([][]byte, 0, len(cert.VoterGroups))
+	for _, group := range cert.VoterGroups {
+		bits := new(big.Int).SetBytes(group.Voters)
+		if bits.BitLen() > len(committee) {
+			return errors.New("invalid voter group")
+		}
+		var groupKeys [][]byte
+		for i := 0; i < bits.BitLen(); i++ {
+			if bits.Bit(i) == 0 {
+				continue
+			}
+			voter := committee[i]
+			if voters.Contains(voter) {
+				return errors.New("duplicated voter")
+			}
+			pubKey := validatorsCache.BlsPubKey(voter)
+			if len(pubKey) == 0 {
+				return errors.New("bls key is not registered")
+			}
+			groupKeys = append(groupKeys, pubKey)
+			voters.Add(voter)
+		}
+		if len(groupKeys) == 0 {
+			return errors.New("empty voter group")
+		}
+		pubKey, err := bls.AggregatePublicKeys(groupKeys)
+		if err != nil {
+			return err
+		}
+		vote := types.Vote{
+			Header: &types.VoteHeader{
+				Step:        cert.Step,
+				Round:       cert.Round,
+				TurnOffline: group.TurnOffline,
+				Upgrade:     group.Upgrade,
+				VotedHash:   cert.VotedHash,
+				ParentHash:  prevBlock.Hash(),
+			},
+		}
+		hash := crypto.SignatureHash(&vote)
+		msgs = append(msgs, hash[:])
+		pubKeys = append(pubKeys, pubKey)
+	}
+	if !bls.VerifyAggregate(msgs, pubKeys, cert.AggregatedSignature) {
+		return errors.New("invalid aggregated signature")
+	}
+	return nil
+}
+
+// CompressCertificate builds the certificate from the collected votes, BLS signatures of voters with registered keys
+// are aggregated into a single signature if the aggregation is enabled
+func (chain *Blockchain) CompressCertificate(prevBlock *types.Header, full *types.FullBlockCert, validatorsCache *validators.ValidatorsCache) *types.BlockCert {
+	cert := full.Compress()
+	if !chain.config.Consensus.EnableBlsVoteAggregation || len(full.Votes) == 0 {
+		return cert
+	}
+	stepValidators := validatorsCache.GetOnlineValidators(prevBlock.Seed(), cert.Round, cert.Step, chain.GetCommitteeSize(validatorsCache, cert.Step == types.Final))
+	if stepValidators == nil {
+		return cert
+	}
+	indexes := make(map[common.Address]int)
+	for i, addr := range stepValidators.SortedAddresses() {
+		indexes[addr] = i
+	}
+	type groupKey struct {
+		turnOffline bool
+		upgrade     uint32
+	}
+	groups := make(map[groupKey]*big.Int)
+	var groupKeys []groupKey
+	var signatures [][]byte
+	var legacy []*types.BlockCertSignature
+	aggregated := make(map[common.Address]bool)
+	for _, vote := range full.Votes {
+		voter := vote.VoterAddr()
+		idx, ok := indexes[voter]
+		pubKey := validatorsCache.BlsPubKey(voter)
+		hash := crypto.SignatureHash(vote)
+		key := groupKey{vote.Header.TurnOffline, vote.Header.Upgrade}
+		if !ok || len(pubKey) == 0 || len(vote.BlsSignature) == 0 || !bls.Verify(pubKey, hash[:], vote.BlsSignature) {
+			legacy = append(legacy, &types.BlockCertSignature{
+				Signature:   vote.Signature,
+				Upgrade:     vote.Header.Upgrade,
+				TurnOffline: vote.Header.TurnOffline,
+			})
+			continue
+		}
+		if aggregated[voter] {
+			continue
+		}
+		aggregated[voter] = true
+		bits, ok := groups[key]
+		if !ok {
+			bits = new(big.Int)
+			groups[key] = bits
+			groupKeys = append(groupKeys, key)
+		}
+		bits.SetBit(bits, idx, 1)
+		signatures = append(signatures, vote.BlsSignature)
+	}
+	if len(signatures) == 0 {
+		return cert
+	}
+	aggregatedSignature, err := bls.AggregateSignatures(signatures)
+	if err != nil {
+		chain.log.Warn("cannot aggregate vote signatures", "err", err)
+		return cert
+	}
+	cert.Signatures = legacy
+	cert.AggregatedSignature = aggregatedSignature
+	for _, key := range groupKeys {
+		cert.VoterGroups = append(cert.VoterGroups, &types.BlockCertVoterGroup{
+			TurnOffline: key.turnOffline,
+			Upgrade:     key.upgrade,
+			Voters:      groups[key].Bytes(),
+		})
+	}
+	return cert
+}
+
 func (chain *Blockchain) ValidateBlock(block *types.Block, checkState *appstate.AppState, statsCollector collector.StatsCollector) (*blockInsertionResult, error) {
 	if checkState == nil {
 		var err error
@@ -1829,7 +2276,11 @@ func (chain *Blockchain) ValidateBlock(block *types.Block, checkState *appstate.
 			return nil, err
 		}
 	}
-	return chain.validateBlock(checkState, block, chain.Head, statsCollector)
+	result, err := chain.validateBlock(checkState, block, chain.Head, statsCollector)
+	if err != nil {
+		chain.recordRejectedBlock(block, chain.Head, err)
+	}
+	return result, err
 }
 
 func validateBlockParentHash(block *types.Header, prevBlock *types.Header) error {
@@ -1901,6 +2352,7 @@ func (chain *Blockchain) WriteCertificate(hash common.Hash, cert *types.BlockCer
 	if !persistent {
 		chain.repo.WriteWeakCertificate(hash)
 	}
+	chain.writeFinalityCheckpoint(hash, cert)
 }
 
 func (chain *Blockchain) GetBlock(hash common.Hash) *types.Block {
@@ -1957,6 +2409,10 @@ func (chain *Blockchain) GetBlockHeaderByHeight(height uint64) *types.Header {
 	return chain.repo.ReadBlockHeader(hash)
 }
 
+func (chain *Blockchain) ReadBalanceChanges(address common.Address, fromHeight, toHeight uint64) []*types.BalanceChange {
+	return chain.repo.GetBalanceChanges(address, fromHeight, toHeight)
+}
+
 func (chain *Blockchain) GetTxIndex(hash common.Hash) *types.TransactionIndex {
 	return chain.repo.ReadTxIndex(hash)
 }
@@ -1978,6 +2434,14 @@ func (chain *Blockchain) GetReceipt(hash common.Hash) *types.TxReceipt {
 	return r[idx.Idx]
 }
 
+// GetBlockReceipts returns receipts of txs of the block, nil is returned for blocks without receipts
+func (chain *Blockchain) GetBlockReceipts(header *types.Header) (types.TxReceipts, error) {
+	if header.ProposedHeader == nil || len(header.ProposedHeader.TxReceiptsCid) == 0 {
+		return nil, nil
+	}
+	return chain.readTxReceipts(header.ProposedHeader.TxReceiptsCid)
+}
+
 func (chain *Blockchain) GetTx(hash common.Hash) (*types.Transaction, *types.TransactionIndex) {
 	idx := chain.repo.ReadTxIndex(hash)
 	if idx == nil {
@@ -2055,6 +2519,7 @@ func (chain *Blockchain) ValidateSubChain(startHeight uint64, blocks []types.Blo
 
 	for _, b := range blocks {
 		if _, err := chain.validateBlock(checkState, b.Block, prevBlock, nil); err != nil {
+			chain.recordRejectedBlock(b.Block, prevBlock, err)
 			return err
 		}
 		if b.Block.Header.Flags().HasFlag(types.IdentityUpdate) {
@@ -2080,23 +2545,103 @@ func (chain *Blockchain) ValidateSubChain(startHeight uint64, blocks []types.Blo
 	return nil
 }
 
+// ResetTo removes canonical blocks above the height, blocks of the last finality checkpoint and below can't be removed
 func (chain *Blockchain) ResetTo(height uint64) error {
+	if err := chain.checkNotFinalized(height); err != nil {
+		return err
+	}
+	reverted, err := chain.resetTo(height)
+	if err != nil {
+		return err
+	}
+	chain.publishReorg(height, reverted, nil)
+	return nil
+}
+
+// ApplyFork replaces canonical blocks above the common height with blocks of the fork,
+// forks below the last finality checkpoint are rejected
+func (chain *Blockchain) ApplyFork(commonHeight uint64, fork []types.BlockBundle, statsCollector collector.StatsCollector) error {
+	if err := chain.checkNotFinalized(commonHeight); err != nil {
+		return err
+	}
+	reverted, err := chain.resetTo(commonHeight)
+	if err != nil {
+		return err
+	}
+	var applied []*types.Block
+	defer func() {
+		chain.publishReorg(commonHeight, reverted, applied)
+	}()
+	for _, bundle := range fork {
+		if err := chain.addBlock(bundle.Block, nil, statsCollector, false); err != nil {
+			return err
+		}
+		chain.WriteCertificate(bundle.Block.Hash(), bundle.Cert, false)
+		applied = append(applied, bundle.Block)
+	}
+	return nil
+}
+
+// resetTo returns removed canonical blocks starting from the highest one
+func (chain *Blockchain) resetTo(height uint64) ([]*types.Block, error) {
 	prevHead := chain.Head.Height()
 	if err := chain.appState.ResetTo(height); err != nil {
-		return errors.WithMessage(err, "state is corrupted, try to resync from scratch")
+		return nil, errors.WithMessage(err, "state is corrupted, try to resync from scratch")
 	}
 	chain.setHead(height, nil)
 
-	for h := height + 1; h <= prevHead; h++ {
+	var reverted []*types.Block
+	for h := prevHead; h > height; h-- {
 		hash := chain.repo.ReadCanonicalHash(h)
 		if hash == (common.Hash{}) {
 			continue
 		}
+		if block := chain.GetBlock(hash); block != nil {
+			reverted = append(reverted, block)
+			chain.repo.RemoveBlockTime(block.Header)
+		} else if header := chain.repo.ReadBlockHeader(hash); header != nil {
+			reverted = append(reverted, &types.Block{Header: header, Body: &types.Body{}})
+			chain.repo.RemoveBlockTime(header)
+		}
 		chain.repo.RemoveHeader(hash)
 		chain.repo.RemoveCanonicalHash(h)
+		chain.repo.RemoveLogBloom(h)
+		if chain.isFinalityCheckpoint(h) {
+			chain.repo.RemoveFinalityCheckpoint(h)
+		}
 	}
 
-	return nil
+	return reverted, nil
+}
+
+// publishReorg notifies about blocks removed from the canonical chain and about the new head,
+// ReorgEvent is published after ChainHeadEvent so its subscribers observe the new head
+func (chain *Blockchain) publishReorg(commonHeight uint64, reverted, applied []*types.Block) {
+	if len(reverted) == 0 && len(applied) == 0 {
+		return
+	}
+	for _, block := range reverted {
+		chain.bus.Publish(&events.ChainSideEvent{
+			Block: block,
+		})
+	}
+	var head *types.Block
+	if len(applied) > 0 {
+		head = applied[len(applied)-1]
+	} else {
+		head = chain.GetBlock(chain.Head.Hash())
+	}
+	if head != nil {
+		chain.bus.Publish(&events.ChainHeadEvent{
+			Block: head,
+		})
+	}
+	chain.bus.Publish(&events.ReorgEvent{
+		CommonHeight: commonHeight,
+		Reverted:     reverted,
+		Applied:      applied,
+	})
+	chain.log.Info("Chain is reorganized", "common height", commonHeight, "reverted", len(reverted), "applied", len(applied))
 }
 
 func (chain *Blockchain) EnsureIntegrity() error {
@@ -2114,9 +2659,12 @@ func (chain *Blockchain) EnsureIntegrity() error {
 		if resetTo == 0 {
 			return errors.New("state db is corrupted, try to delete idenachain.db folder from your data directory and sync from scratch")
 		}
-		if err := chain.ResetTo(resetTo); err != nil {
+		// the state of the same canonical blocks is restored, so finality checkpoints are not checked
+		reverted, err := chain.resetTo(resetTo)
+		if err != nil {
 			return err
 		}
+		chain.publishReorg(resetTo, reverted, nil)
 	}
 	if wasReset {
 		chain.log.Warn("Blockchain was reset", "new head", chain.Head.Height())
@@ -2131,6 +2679,7 @@ func (chain *Blockchain) StartSync() {
 
 func (chain *Blockchain) StopSync() {
 	chain.isSyncing = false
+	chain.onlineHeight = chain.Head.Height()
 	chain.txpool.StopSync(chain.GetBlockWithRetry(chain.Head.Hash()))
 }
 
@@ -2151,6 +2700,7 @@ func (chain *Blockchain) AddHeader(header *types.Header) error {
 
 	chain.repo.WriteBlockHeader(header)
 	chain.repo.WriteCanonicalHash(header.Height(), header.Hash())
+	chain.repo.WriteBlockTime(header)
 	chain.repo.WritePreliminaryHead(header)
 	chain.PreliminaryHead = header
 
@@ -2259,11 +2809,12 @@ func (chain *Blockchain) RemovePreliminaryHead(batch dbm.Batch) {
 
 func (chain *Blockchain) IsPermanentCert(header *types.Header) bool {
 	return header.Flags().HasFlag(types.IdentityUpdate|types.Snapshot|types.NewGenesis) ||
-		header.Height()%chain.config.Blockchain.StoreCertRange == 0 || header.ProposedHeader != nil && header.ProposedHeader.Upgrade > 0
+		header.Height()%chain.config.Blockchain.StoreCertRange == 0 || chain.isFinalityCheckpoint(header.Height()) ||
+		header.ProposedHeader != nil && header.ProposedHeader.Upgrade > 0
 }
 
-func (chain *Blockchain) ReadTxs(address common.Address, count int, token []byte) ([]*types.SavedTransaction, []byte) {
-	return chain.repo.GetSavedTxs(address, count, token)
+func (chain *Blockchain) ReadTxs(address common.Address, count int, token []byte, filter *database.TxFilter) ([]*types.SavedTransaction, []byte) {
+	return chain.repo.GetSavedTxs(address, count, token, filter)
 }
 
 func (chain *Blockchain) ReadTotalBurntCoins() []*types.BurntCoins {
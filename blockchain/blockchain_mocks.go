@@ -17,6 +17,7 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/tendermint/tm-db"
+	"io/ioutil"
 )
 
 func NewTestBlockchainWithConfig(withIdentity bool, conf *config.ConsensusConf, valConf *config.ValidationConfig, alloc map[common.Address]config.GenesisAllocation, queueSlots int, executableSlots int, executableLimit int, queueLimit int) (*TestBlockchain, *appstate.AppState, *mempool.TxPool, *ecdsa.PrivateKey) {
@@ -59,7 +60,8 @@ func NewTestBlockchainWithConfig(withIdentity bool, conf *config.ConsensusConf,
 	txPool := mempool.NewTxPool(appState, bus, cfg, collector.NewStatsCollector())
 	offline := NewOfflineDetector(cfg, db, appState, secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
-	subManager, _ := subscriptions.NewManager("./testdata2")
+	subscriptionsDir, _ := ioutil.TempDir("", "subscriptions")
+	subManager, _ := subscriptions.NewManager(subscriptionsDir)
 	upgrader := upgrade.NewUpgrader(cfg, appState, db)
 	chain := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore, subManager, upgrader)
 
@@ -114,7 +116,8 @@ func NewCustomTestBlockchainWithConfig(blocksCount int, emptyBlocksCount int, ke
 	txPool := mempool.NewTxPool(appState, bus, cfg, collector.NewStatsCollector())
 	offline := NewOfflineDetector(cfg, db, appState, secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
-	subManager, _ := subscriptions.NewManager("./testdata2")
+	subscriptionsDir, _ := ioutil.TempDir("", "subscriptions")
+	subManager, _ := subscriptions.NewManager(subscriptionsDir)
 	upgrader := upgrade.NewUpgrader(cfg, appState, db)
 	chain := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), secStore, bus, offline, keyStore, subManager, upgrader)
 	chain.InitializeChain()
@@ -159,7 +162,8 @@ func (chain *TestBlockchain) Copy() (*TestBlockchain, *appstate.AppState) {
 	txPool := mempool.NewTxPool(appState, bus, cfg, collector.NewStatsCollector())
 	offline := NewOfflineDetector(cfg, db, appState, chain.secStore, bus)
 	keyStore := keystore.NewKeyStore("./testdata", keystore.StandardScryptN, keystore.StandardScryptP)
-	subManager, _ := subscriptions.NewManager("./testdata2")
+	subscriptionsDir, _ := ioutil.TempDir("", "subscriptions")
+	subManager, _ := subscriptions.NewManager(subscriptionsDir)
 	upgrader := upgrade.NewUpgrader(cfg, appState, db)
 	copy := NewBlockchain(cfg, db, txPool, appState, ipfs.NewMemoryIpfsProxy(), chain.secStore, bus, offline, keyStore, subManager, upgrader)
 	copy.InitializeChain()
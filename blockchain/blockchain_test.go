@@ -12,12 +12,20 @@ import (
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/upgrade"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/bls"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/tests"
+	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
+	"io/ioutil"
 	"math/big"
+	"os"
 	"testing"
 	"time"
 )
@@ -900,6 +908,119 @@ func Test_ClearDustAccounts(t *testing.T) {
 	require.True(s.State.AccountExists(common.Address{0x8}))
 }
 
+func Test_ChargeContractStorageRent(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	_, s := NewCustomTestBlockchain(1, 0, key)
+
+	paying, abandoned, toppedUp := common.Address{0x1}, common.Address{0x2}, common.Address{0x3}
+	s.State.DeployContract(paying, common.Hash{0x1}, big.NewInt(1000))
+	s.State.SetContractValue(paying, []byte{0x1}, []byte{0x1})
+	s.State.SetContractStorageSize(paying, 2)
+	s.State.DeployContract(abandoned, common.Hash{0x1}, big.NewInt(10))
+	s.State.SetContractValue(abandoned, []byte{0x1}, []byte{0x1, 0x2})
+	s.State.SetContractStorageSize(abandoned, 3)
+	s.State.SetBalance(abandoned, big.NewInt(50))
+	s.State.DeployContract(toppedUp, common.Hash{0x1}, big.NewInt(10))
+	s.State.SetContractValue(toppedUp, []byte{0x1}, []byte{0x1})
+	s.State.SetContractStorageSize(toppedUp, 2)
+	s.State.Commit(true)
+
+	statsCollector := &rentBurnCollector{StatsCollector: collector.NewStatsCollector(), burnt: map[common.Address]*big.Int{}}
+	chargeContractStorageRent(s, big.NewInt(100), 1, statsCollector)
+	s.State.Commit(true)
+
+	require.Equal(0, big.NewInt(800).Cmp(s.State.GetContractStake(paying)))
+	require.Equal([]byte{0x1}, s.State.GetContractValue(paying, []byte{0x1}))
+	require.Equal(0, big.NewInt(200).Cmp(statsCollector.burnt[paying]))
+
+	// contracts which stake doesn't cover the rent are kept during the grace period
+	require.NotNil(s.State.GetCodeHash(abandoned))
+	require.Equal(uint16(1), s.State.GetContractUnpaidRentEpochs(abandoned))
+	require.Nil(statsCollector.burnt[abandoned])
+	require.Equal(uint16(1), s.State.GetContractUnpaidRentEpochs(toppedUp))
+
+	s.State.SetContractStake(toppedUp, big.NewInt(1000))
+	chargeContractStorageRent(s, big.NewInt(100), 1, statsCollector)
+	s.State.Commit(true)
+
+	require.Equal(0, big.NewInt(800).Cmp(s.State.GetContractStake(toppedUp)))
+	require.Zero(s.State.GetContractUnpaidRentEpochs(toppedUp))
+
+	require.Nil(s.State.GetCodeHash(abandoned))
+	require.Nil(s.State.GetContractValue(abandoned, []byte{0x1}))
+	require.Zero(s.State.GetBalance(abandoned).Sign())
+	require.Equal(0, big.NewInt(60).Cmp(statsCollector.burnt[abandoned]))
+}
+
+type rentBurnCollector struct {
+	collector.StatsCollector
+	burnt map[common.Address]*big.Int
+}
+
+func (c *rentBurnCollector) AddContractStorageRentBurntCoins(address common.Address, amount *big.Int) {
+	if c.burnt[address] == nil {
+		c.burnt[address] = new(big.Int)
+	}
+	c.burnt[address].Add(c.burnt[address], amount)
+}
+
+func Test_computeContractStorageSizes(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	_, s := NewCustomTestBlockchain(1, 0, key)
+
+	contract, empty := common.Address{0x1}, common.Address{0x2}
+	s.State.DeployContract(contract, common.Hash{0x1}, big.NewInt(1000))
+	s.State.SetContractValue(contract, []byte{0x1}, []byte{0x1, 0x2})
+	s.State.SetContractValue(contract, []byte{0x2, 0x3}, []byte{0x1})
+	s.State.DeployContract(empty, common.Hash{0x1}, big.NewInt(1000))
+	s.State.Commit(true)
+
+	computeContractStorageSizes(s)
+	s.State.Commit(true)
+
+	require.Equal(uint64(6), s.State.GetContractStorageSize(contract))
+	require.Zero(s.State.GetContractStorageSize(empty))
+}
+
+func TestBlockchain_ContractStorageSizesAfterUpgrade(t *testing.T) {
+	require := require.New(t)
+	prevTarget := upgrade.TargetVersion
+	upgrade.TargetVersion = config.ConsensusV6
+	defer func() {
+		upgrade.TargetVersion = prevTarget
+	}()
+
+	consensusCfg := *config.ConsensusVersions[config.ConsensusV5]
+	consensusCfg.Automine = true
+	chain, appState, _, _ := NewTestBlockchainWithConfig(true, &consensusCfg, &config.ValidationConfig{}, nil, -1, -1, 0, 0)
+
+	appState.State.SetNextValidationTime(time.Date(2099, 01, 01, 00, 00, 00, 0, time.UTC))
+	contract := common.Address{0x1}
+	appState.State.DeployContract(contract, common.Hash{0x1}, big.NewInt(1000))
+	appState.State.SetContractValue(contract, []byte{0x1}, []byte{0x1, 0x2})
+	appState.Commit(nil)
+	chain.CommitState()
+
+	v6 := *config.ConsensusVersions[config.ConsensusV6]
+	v6.StartActivationDate = time.Now().Add(-time.Hour).Unix()
+	v6.EndActivationDate = time.Now().Add(time.Hour).Unix()
+	prevV6 := config.ConsensusVersions[config.ConsensusV6]
+	config.ConsensusVersions[config.ConsensusV6] = &v6
+	defer func() {
+		config.ConsensusVersions[config.ConsensusV6] = prevV6
+	}()
+
+	chain.GenerateBlocks(1)
+	require.Equal(uint32(config.ConsensusV6), chain.Head.ProposedHeader.Upgrade)
+	require.Equal(config.ConsensusV6, chain.config.Consensus.Version)
+	require.Zero(appState.State.GetContractStorageSize(contract))
+
+	chain.GenerateBlocks(1)
+	require.Equal(uint64(3), appState.State.GetContractStorageSize(contract))
+}
+
 func TestBlockchain_applyOfflinePenalty(t *testing.T) {
 	chain := &Blockchain{
 		config: &config.Config{
@@ -912,20 +1033,21 @@ func TestBlockchain_applyOfflinePenalty(t *testing.T) {
 
 	db := dbm.NewMemDB()
 	bus := eventbus.New()
-	appState, _ := appstate.NewAppState(db, bus)
 
 	count := byte(10)
 	pool1 := common.Address{0x11}
 
+	builder := state.NewTestStateBuilder()
 	for i := byte(1); i <= count; i++ {
 		addr := common.Address{i}
-		appState.IdentityState.Add(addr)
-		appState.IdentityState.SetOnline(addr, true)
+		builder.AddIdentity(addr, state.Verified, true)
 		if i%3 == 0 { // 3, 6, 9
-			appState.IdentityState.SetDelegatee(addr, pool1)
+			builder.SetDelegatee(addr, pool1)
 		}
 	}
-	appState.Commit(nil)
+	_, _, err := builder.Build(db)
+	require.NoError(t, err)
+	appState, _ := appstate.NewAppState(db, bus)
 	appState.Initialize(1)
 
 	require.True(t, appState.ValidatorsCache.IsPool(pool1))
@@ -1052,3 +1174,839 @@ func Test_Delegation(t *testing.T) {
 	require.False(t, appState.ValidatorsCache.IsPool(pool2))
 	require.False(t, appState.ValidatorsCache.IsOnlineIdentity(pool2))
 }
+
+func Test_ApplyStakeLockTxs(t *testing.T) {
+	senderKey, _ := crypto.GenerateKey()
+	receiverKey, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(senderKey.PublicKey)
+	receiver := crypto.PubkeyToAddress(receiverKey.PublicKey)
+
+	alloc := make(map[common.Address]config.GenesisAllocation)
+	alloc[sender] = config.GenesisAllocation{
+		Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+	}
+	chain, _, _, _ := NewTestBlockchain(true, alloc)
+	appState := chain.appState
+	context := &txExecutionContext{
+		appState: appState,
+	}
+
+	tx := &types.Transaction{
+		Type:         types.LockStakeTx,
+		AccountNonce: 1,
+		To:           &receiver,
+		Amount:       new(big.Int).Mul(common.DnaBase, big.NewInt(10)),
+		Payload:      attachments.CreateLockStakeAttachment(1, 4),
+	}
+	signedTx, _ := types.SignTx(tx, senderKey)
+	chain.applyTxOnState(signedTx, context)
+
+	epoch := appState.State.Epoch()
+	require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(90)), appState.State.GetBalance(sender))
+	require.Len(t, appState.State.GetStakeLocks(receiver), 1)
+	require.Equal(t, epoch+2, appState.State.GetStakeLocks(receiver)[0].StartEpoch)
+	require.Equal(t, sender, appState.State.GetStakeLocks(receiver)[0].Locker)
+	require.Equal(t, tx.Amount, appState.State.GetLockedStake(receiver))
+	require.Zero(t, appState.State.GetReleasableStake(receiver, epoch+1).Sign())
+
+	appState.State.SetGlobalEpoch(epoch + 3)
+	tx = &types.Transaction{
+		Type:         types.UnlockStakeTx,
+		AccountNonce: 1,
+		Epoch:        epoch + 3,
+	}
+	signedTx, _ = types.SignTx(tx, receiverKey)
+	chain.applyTxOnState(signedTx, context)
+
+	require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(5)), appState.State.GetBalance(receiver))
+	require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(5)), appState.State.GetLockedStake(receiver))
+
+	appState.State.SetGlobalEpoch(epoch + 10)
+	require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(5)), appState.State.ReleaseStake(receiver, epoch+10))
+	require.Empty(t, appState.State.GetStakeLocks(receiver))
+	require.Equal(t, new(big.Int).Mul(common.DnaBase, big.NewInt(10)), appState.State.GetBalance(receiver))
+}
+
+func Test_GodMultisig(t *testing.T) {
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+	chain.config.Consensus.EnableGodMultisig = true
+	validation.SetAppConfig(chain.config)
+	appState := chain.appState
+	context := &txExecutionContext{
+		appState: appState,
+	}
+
+	multisig := common.Address{0xc}
+	var signerKeys []*ecdsa.PrivateKey
+	appState.State.DeployContract(multisig, embedded.MultisigContract, common.DnaBase)
+	appState.State.SetContractValue(multisig, []byte("state"), []byte{2})
+	appState.State.SetContractValue(multisig, []byte("minVotes"), []byte{2})
+	for i := 0; i < 3; i++ {
+		key, _ := crypto.GenerateKey()
+		signerKeys = append(signerKeys, key)
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+		appState.State.SetContractValue(multisig, append([]byte("addr"), signer.Bytes()...), signer.Bytes())
+	}
+	appState.State.SetGodAddress(multisig)
+	appState.State.SetGodAddressInvites(10)
+
+	invitee := common.Address{0x9}
+	tx, _ := types.SignTx(&types.Transaction{
+		Type:         types.InviteTx,
+		AccountNonce: 1,
+		To:           &invitee,
+	}, signerKeys[0])
+
+	approve := func(key *ecdsa.PrivateKey, txType types.TxType, to common.Address) {
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+		appState.State.SetContractValue(multisig, append([]byte("godTx"), signer.Bytes()...), append([]byte{byte(txType)}, to.Bytes()...))
+	}
+
+	approve(signerKeys[0], types.InviteTx, invitee)
+	approve(signerKeys[1], types.InviteTx, common.Address{0x8})
+	require.False(t, validation.IsGodTx(appState, tx))
+
+	approve(signerKeys[2], types.InviteTx, invitee)
+	require.True(t, validation.IsGodTx(appState, tx))
+
+	// a non-signer can't use approvals
+	outsiderKey, _ := crypto.GenerateKey()
+	outsiderTx, _ := types.SignTx(&types.Transaction{
+		Type:         types.InviteTx,
+		AccountNonce: 1,
+		To:           &invitee,
+	}, outsiderKey)
+	require.False(t, validation.IsGodTx(appState, outsiderTx))
+
+	chain.applyTxOnState(tx, context)
+
+	require.Equal(t, state.Invite, appState.State.GetIdentityState(invitee))
+	require.Equal(t, multisig, appState.State.GetInviter(invitee).Address)
+	require.Equal(t, uint16(9), appState.State.GodAddressInvites())
+	// approvals are consumed
+	require.False(t, validation.IsGodTx(appState, tx))
+
+	// approvals of txs with payload are bound to the payload
+	limitTx, _ := types.SignTx(&types.Transaction{
+		Type:         types.ChangeBlockGasLimitTx,
+		AccountNonce: 2,
+		To:           &multisig,
+		Payload:      attachments.CreateChangeBlockGasLimitAttachment(types.MaxBlockGas * 2),
+	}, signerKeys[0])
+	approveLimit := func(key *ecdsa.PrivateKey, gasLimit uint64) {
+		signer := crypto.PubkeyToAddress(key.PublicKey)
+		approval := append(append([]byte{byte(types.ChangeBlockGasLimitTx)}, multisig.Bytes()...), attachments.CreateChangeBlockGasLimitAttachment(gasLimit)...)
+		appState.State.SetContractValue(multisig, append([]byte("godTx"), signer.Bytes()...), approval)
+	}
+	approveLimit(signerKeys[0], types.MaxBlockGas*2)
+	approveLimit(signerKeys[1], types.MaxBlockGas*3)
+	require.False(t, validation.IsGodTx(appState, limitTx))
+	approveLimit(signerKeys[1], types.MaxBlockGas*2)
+	require.True(t, validation.IsGodTx(appState, limitTx))
+
+	chain.applyTxOnState(limitTx, context)
+	require.Equal(t, uint64(types.MaxBlockGas*2), appState.State.BlockGasLimit())
+	require.False(t, validation.IsGodTx(appState, limitTx))
+
+	chain.config.Consensus.EnableGodMultisig = false
+	approve(signerKeys[0], types.InviteTx, invitee)
+	approve(signerKeys[2], types.InviteTx, invitee)
+	require.False(t, validation.IsGodTx(appState, tx))
+}
+
+func TestBlockchain_BuildBlockDryRun(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, _, _ := NewTestBlockchain(true, map[common.Address]config.GenesisAllocation{
+		addr: {Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100))},
+	})
+
+	tx, _ := types.SignTx(BuildTx(appState, addr, &common.Address{0x1}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil), key)
+	require.NoError(chain.txpool.AddInternalTx(tx))
+
+	head := chain.Head
+	root := appState.State.Root()
+	result := chain.BuildBlockDryRun()
+
+	require.Equal(head.Height()+1, result.Block.Height())
+	require.Equal(head.Hash(), result.Block.Header.ParentHash())
+	require.Len(result.Block.Body.Transactions, 1)
+	require.Equal(tx.Hash(), result.Block.Body.Transactions[0].Hash())
+	require.Equal(1, result.CandidateTxs)
+	require.NotZero(result.UsedGas)
+
+	require.Equal(head.Hash(), chain.Head.Hash())
+	require.Equal(root, appState.State.Root())
+	require.Equal(uint32(0), appState.State.GetNonce(addr))
+}
+
+func TestBlockchain_SimulateProposal(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, _, _ := NewTestBlockchain(true, map[common.Address]config.GenesisAllocation{
+		addr: {Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100))},
+	})
+
+	tx, _ := types.SignTx(BuildTx(appState, addr, &common.Address{0x1}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil), key)
+	require.NoError(chain.txpool.AddInternalTx(tx))
+
+	head := chain.Head
+	root := appState.State.Root()
+	result := chain.SimulateProposal()
+
+	require.NoError(result.ValidationError)
+	require.Len(result.Block.Body.Transactions, 1)
+	require.Equal(tx.Hash(), result.Block.Body.Transactions[0].Hash())
+	require.NotEqual(root, result.Block.Root())
+
+	require.Equal(head.Hash(), chain.Head.Hash())
+	require.Equal(root, appState.State.Root())
+	require.Equal(uint32(0), appState.State.GetNonce(addr))
+
+	// the block of a node which is neither an identity nor the god node is rejected
+	chain, appState, _, _ = NewTestBlockchain(false, nil)
+	appState.State.SetGodAddress(common.Address{0x2})
+	require.NoError(appState.Commit(nil))
+	block := chain.GenerateEmptyBlock()
+	chain.Head = block.Header
+	result = chain.SimulateProposal()
+	require.False(result.IsProposer)
+	require.EqualError(result.ValidationError, "proposer is not identity")
+}
+
+func TestBlockchain_RejectedBlocks(t *testing.T) {
+	require := require.New(t)
+	chain, _, _, _ := NewTestBlockchain(true, nil)
+	dir, err := ioutil.TempDir("", "forensics")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	chain.rejectedBlocks = newRejectedBlocks(dir, chain.log)
+
+	block := chain.BuildBlockDryRun().Block
+	block.Header.ProposedHeader.Root = common.Hash{0x1}
+	_, err = chain.ValidateBlock(block, nil, nil)
+	require.Error(err)
+	// the same block is recorded once
+	_, err = chain.ValidateBlock(block, nil, nil)
+	require.Error(err)
+
+	rejected := chain.LastRejectedBlocks()
+	require.Len(rejected, 1)
+	require.Equal(block.Hash(), rejected[0].Block.Hash())
+	require.Equal(err.Error(), rejected[0].Error)
+	require.Equal(chain.Head.Hash(), rejected[0].ParentHash)
+	require.Equal(chain.Head.Root(), rejected[0].StateRoot)
+
+	// blocks of other forks are not recorded
+	forkBlock := chain.BuildBlockDryRun().Block
+	forkBlock.Header.ProposedHeader.ParentHash = common.Hash{0x2}
+	_, err = chain.ValidateBlock(forkBlock, nil, nil)
+	require.Equal(ParentHashIsInvalid, err)
+	require.Len(chain.LastRejectedBlocks(), 1)
+
+	// dumps are loaded on restart and only the latest ones are kept
+	for i := 0; i < maxRejectedBlocks; i++ {
+		block := chain.BuildBlockDryRun().Block
+		block.Header.ProposedHeader.Root = common.Hash{0x1, byte(i)}
+		chain.ValidateBlock(block, nil, nil)
+	}
+	files, _ := ioutil.ReadDir(dir)
+	require.Len(files, maxRejectedBlocks)
+	loaded := newRejectedBlocks(dir, chain.log).list()
+	require.Len(loaded, maxRejectedBlocks)
+	for _, b := range loaded {
+		require.NotEqual(block.Hash(), b.Block.Hash())
+	}
+}
+
+// newTestV6Blockchain creates a chain of blocksCount blocks on a test network with ConsensusV6 rules, the key is
+// the god address and a verified identity with 100 DNA, modify adjusts the config before the chain is created
+func newTestV6Blockchain(key *ecdsa.PrivateKey, blocksCount int, modify func(cfg *config.Config)) (*TestBlockchain, *appstate.AppState, *config.Config) {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	consensusCfg := *config.ConsensusVersions[config.ConsensusV6]
+	consensusCfg.Automine = true
+	cfg := &config.Config{
+		Network:   0x99,
+		Consensus: &consensusCfg,
+		GenesisConf: &config.GenesisConf{
+			Alloc: map[common.Address]config.GenesisAllocation{
+				addr: {
+					State:   uint8(state.Verified),
+					Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100)),
+				},
+			},
+			GodAddress:        addr,
+			FirstCeremonyTime: 4070908800, //01.01.2099
+		},
+		Validation: &config.ValidationConfig{},
+		Blockchain: &config.BlockchainConfig{},
+	}
+	if modify != nil {
+		modify(cfg)
+	}
+	chain, appState := NewCustomTestBlockchainWithConfig(blocksCount, 0, key, cfg)
+	return chain, appState, cfg
+}
+
+func TestBlockchain_TraceBlock(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, _ := newTestV6Blockchain(key, 5, nil)
+
+	recipient := common.Address{0x1}
+	tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &recipient, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(1)
+	height := chain.Head.Height()
+	chain.GenerateBlocks(2)
+
+	trace, err := chain.TraceBlock(height)
+	require.NoError(err)
+	require.Equal(height, trace.Height)
+	require.Len(trace.Txs, 1)
+	require.Len(trace.Rewards, 2)
+	require.Equal(ProposerReward, trace.Rewards[0].Type)
+	require.Equal(FinalCommitteeReward, trace.Rewards[1].Type)
+	require.True(trace.MintedCoins.Sign() > 0)
+
+	txTrace := trace.Txs[0]
+	require.Equal(tx.Hash(), txTrace.Hash)
+	require.True(txTrace.Fee.Sign() > 0)
+	require.True(txTrace.Receipt.Success)
+
+	accounts := make(map[common.Address]*AccountTrace)
+	for _, account := range txTrace.State.Accounts {
+		accounts[account.Address] = account
+	}
+	require.Len(accounts, 2)
+	require.Equal(0, accounts[recipient].Balance.Cmp(big.NewInt(1e+18)))
+	require.Equal(uint32(1), accounts[addr].Nonce)
+	prevState, err := appState.Readonly(height - 1)
+	require.NoError(err)
+	expectedBalance := new(big.Int).Sub(prevState.State.GetBalance(addr), big.NewInt(1e+18))
+	expectedBalance.Sub(expectedBalance, txTrace.Fee)
+	require.Equal(0, accounts[addr].Balance.Cmp(expectedBalance))
+	require.NotEmpty(txTrace.State.Reads)
+
+	traced, err := chain.TraceTx(tx.Hash())
+	require.NoError(err)
+	require.Equal(txTrace, traced)
+
+	_, err = chain.TraceBlock(chain.Head.Height() + 1)
+	require.Error(err)
+	_, err = chain.TraceTx(common.Hash{0x1})
+	require.Error(err)
+}
+
+func TestBlockchain_ValidationOnlyTxIndex(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	otherKey, _ := crypto.GenerateKey()
+	otherAddr := crypto.PubkeyToAddress(otherKey.PublicKey)
+	chain, appState, _ := newTestV6Blockchain(key, 5, func(cfg *config.Config) {
+		cfg.GenesisConf.Alloc[otherAddr] = config.GenesisAllocation{
+			Balance: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(100)),
+		}
+		cfg.Sync = &config.SyncConfig{ValidationOnly: true}
+	})
+
+	ownTx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &common.Address{0x1}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.AddInternalTx(ownTx))
+	incomingTx, _ := types.SignTx(BuildTx(appState, otherAddr, &addr, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 1, 0, nil), otherKey)
+	require.NoError(chain.txpool.AddInternalTx(incomingTx))
+	otherTx, _ := types.SignTx(BuildTx(appState, otherAddr, &common.Address{0x2}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 2, 0, nil), otherKey)
+	require.NoError(chain.txpool.AddInternalTx(otherTx))
+	chain.GenerateBlocks(1)
+
+	require.NotNil(chain.GetTxIndex(ownTx.Hash()))
+	require.NotNil(chain.GetTxIndex(incomingTx.Hash()))
+	require.Nil(chain.GetTxIndex(otherTx.Hash()))
+	require.NotNil(chain.GetReceipt(ownTx.Hash()))
+	require.Nil(chain.GetReceipt(otherTx.Hash()))
+}
+
+func TestBlockchain_ResetTo_publishesReorg(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, _ := newTestV6Blockchain(key, 5, nil)
+	commonHeight := chain.Head.Height()
+
+	tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &common.Address{0x1}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(2)
+	require.Nil(chain.txpool.GetTx(tx.Hash()))
+	require.NotNil(chain.GetTxIndex(tx.Hash()))
+	top := chain.Head.Hash()
+
+	var sideBlocks []common.Hash
+	var head *types.Block
+	var reorg *events.ReorgEvent
+	chain.Bus().Subscribe(events.ChainSideEventID, func(e eventbus.Event) {
+		sideBlocks = append(sideBlocks, e.(*events.ChainSideEvent).Block.Hash())
+	})
+	chain.Bus().Subscribe(events.ChainHeadEventID, func(e eventbus.Event) {
+		head = e.(*events.ChainHeadEvent).Block
+	})
+	chain.Bus().Subscribe(events.ReorgEventID, func(e eventbus.Event) {
+		require.NotNil(head)
+		reorg = e.(*events.ReorgEvent)
+	})
+
+	require.NoError(chain.ResetTo(commonHeight))
+
+	require.Len(sideBlocks, 2)
+	require.Equal(top, sideBlocks[0])
+	require.Equal(commonHeight, head.Height())
+	require.Equal(commonHeight, reorg.CommonHeight)
+	require.Len(reorg.Reverted, 2)
+	require.Empty(reorg.Applied)
+	require.Equal(tx.Hash(), reorg.Reverted[1].Body.Transactions[0].Hash())
+
+	require.Nil(chain.GetTxIndex(tx.Hash()))
+	require.NotNil(chain.txpool.GetTx(tx.Hash()))
+}
+
+func TestBlockchain_ReceiptsForAllTxs(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+
+	tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &common.Address{0x1}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(1)
+
+	block := chain.GetBlockByHeight(chain.Head.Height())
+	require.Len(block.Body.Transactions, 1)
+	require.NotEmpty(block.Header.ProposedHeader.TxReceiptsCid)
+
+	receipt := chain.GetReceipt(tx.Hash())
+	require.NotNil(receipt)
+	require.True(receipt.Success)
+	require.Equal(tx.Hash(), receipt.TxHash)
+	require.Equal(addr, receipt.From)
+	require.Zero(receipt.GasUsed)
+	require.True(receipt.Fee.Sign() > 0)
+	expectedBurned := math.ToInt(decimal.NewFromBigInt(receipt.Fee, 0).Mul(decimal.NewFromFloat32(cfg.Consensus.FeeBurnRate)))
+	require.Equal(0, expectedBurned.Cmp(receipt.FeeBurned))
+}
+
+func TestBlockchain_ChangeBlockGasLimit(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+	require.Equal(uint64(types.MaxBlockGas), chain.Head.GasLimit())
+
+	buildTx := func(to common.Address, gasLimit uint64) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &to, types.ChangeBlockGasLimitTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, attachments.CreateChangeBlockGasLimitAttachment(gasLimit)))
+		return tx
+	}
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(addr, types.MaxBlockGasLimit+1)))
+	require.Equal(validation.InvalidRecipient, chain.txpool.AddInternalTx(buildTx(common.Address{0x1}, types.MaxBlockGas*2)))
+
+	tx := buildTx(addr, types.MaxBlockGas*2)
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(1)
+
+	// the new limit is applied starting from the next block
+	block := chain.GetBlockByHeight(chain.Head.Height())
+	require.Len(block.Body.Transactions, 1)
+	require.Equal(uint64(types.MaxBlockGas), block.Header.GasLimit())
+	require.Equal(uint64(types.MaxBlockGas*2), appState.State.BlockGasLimit())
+
+	chain.GenerateBlocks(1)
+	require.Equal(uint64(types.MaxBlockGas*2), chain.Head.GasLimit())
+
+	// blocks with a limit different from the state one are rejected
+	block = chain.GetBlockByHeight(chain.Head.Height())
+	prevBlock := chain.GetBlockHeaderByHeight(block.Height() - 1)
+	checkState, _ := chain.appState.ForCheck(block.Height() - 1)
+	block.Header.ProposedHeader.GasLimit = types.MaxBlockGas
+	_, err := chain.validateBlock(checkState, block, prevBlock, nil)
+	require.EqualError(err, "gas limit is invalid")
+}
+
+func TestBlockchain_ChangeProposerThreshold(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, func(cfg *config.Config) {
+		cfg.Blockchain.MinProposerThresholds = map[uint32]float64{0x99: 0.3}
+	})
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+	require.Equal(0.3, chain.minProposerThreshold(appState))
+
+	buildTx := func(threshold float64) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &addr, types.ChangeProposerThresholdTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, attachments.CreateChangeProposerThresholdAttachment(threshold)))
+		return tx
+	}
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(1)))
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(validation.MaxGovernedProposerThreshold+0.01)))
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(-0.1)))
+
+	require.NoError(chain.txpool.AddInternalTx(buildTx(0.1)))
+	chain.GenerateBlocks(1)
+	require.Len(chain.GetBlockByHeight(chain.Head.Height()).Body.Transactions, 1)
+	require.Equal(0.1, appState.State.MinProposerThreshold())
+	require.Equal(0.1, chain.minProposerThreshold(appState))
+
+	// zero threshold resets the threshold to the network config
+	require.NoError(chain.txpool.AddInternalTx(buildTx(0)))
+	chain.GenerateBlocks(1)
+	require.Equal(0.3, chain.minProposerThreshold(appState))
+
+	// the public networks ignore thresholds of the node config
+	cfg.Blockchain.MinProposerThresholds[uint32(Testnet)] = 0.3
+	cfg.Network = Testnet
+	require.Equal(cfg.Consensus.MinProposerThreshold, chain.minProposerThreshold(appState))
+
+	// the threshold is not governed on the main network
+	cfg.Blockchain.MinProposerThresholds[uint32(Mainnet)] = 0.3
+	cfg.Network = Mainnet
+	appState.State.SetMinProposerThreshold(0.1)
+	require.Equal(cfg.Consensus.MinProposerThreshold, chain.minProposerThreshold(appState))
+}
+
+func TestBlockchain_ChangeWordsDictionary(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+
+	buildTx := func(hash common.Hash, size uint32) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, &addr, types.ChangeWordsDictionaryTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, attachments.CreateChangeWordsDictionaryAttachment(hash, size)))
+		return tx
+	}
+	hash := common.Hash{0x1}
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(hash, types.MinWordsDictionarySize-1)))
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(common.Hash{}, 500)))
+
+	require.NoError(chain.txpool.AddInternalTx(buildTx(hash, 500)))
+	chain.GenerateBlocks(1)
+	require.Len(chain.GetBlockByHeight(chain.Head.Height()).Body.Transactions, 1)
+
+	// the dictionary is used since the next epoch
+	require.Nil(appState.State.WordsDictionary())
+	require.Equal(common.WordDictionarySize, appState.State.WordsDictionarySize())
+	appState.State.SetGlobalEpoch(appState.State.Epoch() + 1)
+	require.Equal(hash, appState.State.WordsDictionary().Hash)
+	require.Equal(500, appState.State.WordsDictionarySize())
+
+	// zero hash resets the dictionary to the built-in one since the next epoch
+	appState.State.SetNextWordsDictionary(common.Hash{}, 0)
+	require.Equal(500, appState.State.WordsDictionarySize())
+	appState.State.SetGlobalEpoch(appState.State.Epoch() + 1)
+	require.Equal(common.WordDictionarySize, appState.State.WordsDictionarySize())
+}
+
+func TestBlockchain_MultiSendTx(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+
+	buildTx := func(amount decimal.Decimal, payments []*attachments.MultiSendPayment) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, nil, types.MultiSendTx, amount, decimal.New(20, 0), decimal.Zero, 0, 0, attachments.CreateMultiSendAttachment(payments)))
+		return tx
+	}
+	to1, to2 := tests.GetRandAddr(), tests.GetRandAddr()
+	payments := []*attachments.MultiSendPayment{
+		{To: to1, Amount: big.NewInt(1e+18)},
+		{To: to2, Amount: new(big.Int).Mul(big.NewInt(1e+18), big.NewInt(2))},
+	}
+	require.Equal(validation.InvalidAmount, chain.txpool.AddInternalTx(buildTx(decimal.New(2, 0), payments)))
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(decimal.New(2, 0), []*attachments.MultiSendPayment{payments[0], payments[0]})))
+	require.Equal(validation.InvalidPayload, chain.txpool.AddInternalTx(buildTx(decimal.Zero, nil)))
+
+	balance := appState.State.GetBalance(addr)
+	tx := buildTx(decimal.New(3, 0), payments)
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(1)
+	require.Len(chain.GetBlockByHeight(chain.Head.Height()).Body.Transactions, 1)
+
+	require.Equal(payments[0].Amount, appState.State.GetBalance(to1))
+	require.Equal(payments[1].Amount, appState.State.GetBalance(to2))
+	require.True(appState.State.GetBalance(addr).Cmp(new(big.Int).Sub(balance, big.NewInt(3e+18))) < 0)
+}
+
+func TestBlockchain_FinalBlock(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	chain, _, _ := newTestV6Blockchain(key, 5, func(cfg *config.Config) {
+		cfg.Blockchain.FinalityCheckpointRange = 2
+	})
+	chain.GenerateBlocks(5)
+	require.Nil(chain.FinalBlock())
+
+	finalCert := func(height uint64) {
+		hash := chain.GetBlockHeaderByHeight(height).Hash()
+		chain.WriteCertificate(hash, &types.BlockCert{Round: height, Step: types.Final, VotedHash: hash}, true)
+	}
+	// only blocks at checkpoint heights with certificates of the final step are checkpoints
+	finalCert(3)
+	require.Nil(chain.FinalBlock())
+	finalCert(2)
+	require.Equal(uint64(2), chain.FinalBlock().Height())
+	finalCert(8)
+	require.Equal(uint64(8), chain.FinalBlock().Height())
+
+	// blocks of the checkpoint and below can't be reverted
+	head := chain.Head.Height()
+	require.Error(chain.ResetTo(7))
+	require.Error(chain.ApplyFork(7, nil, nil))
+	require.Equal(head, chain.Head.Height())
+	require.NoError(chain.ResetTo(8))
+	require.Equal(uint64(8), chain.FinalBlock().Height())
+}
+
+func TestBlockchain_BlockAtTime(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	chain, _, _ := newTestV6Blockchain(key, 5, nil)
+	chain.GenerateBlocks(5)
+
+	for height := uint64(1); height <= chain.Head.Height(); height++ {
+		header := chain.GetBlockHeaderByHeight(height)
+		require.Equal(height, chain.BlockAtTime(header.Time()).Height())
+		require.Equal(height, chain.BlockAtTime(header.Time()+1).Height())
+	}
+	require.Nil(chain.BlockAtTime(chain.GetBlockHeaderByHeight(1).Time() - 1))
+	head := chain.Head
+	require.Equal(head.Hash(), chain.BlockAtTime(head.Time()+3600).Hash())
+
+	// reverted blocks are removed from the index
+	commonHeight := head.Height() - 2
+	require.NoError(chain.ResetTo(commonHeight))
+	require.Equal(commonHeight, chain.BlockAtTime(head.Time()).Height())
+
+	// blocks imported before the index existed are indexed on start
+	for height := uint64(2); height <= commonHeight; height++ {
+		chain.repo.RemoveBlockTime(chain.GetBlockHeaderByHeight(height))
+	}
+	require.Equal(uint64(1), chain.BlockAtTime(head.Time()).Height())
+	chain.indexBlockTimes(chain.Head.Height())
+	for height := uint64(1); height <= commonHeight; height++ {
+		header := chain.GetBlockHeaderByHeight(height)
+		require.Equal(height, chain.BlockAtTime(header.Time()).Height())
+	}
+}
+
+func TestBlockchain_EpochSummary(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	candidate := common.Address{0x1}
+	chain, app, _ := newTestV6Blockchain(key, 0, func(cfg *config.Config) {
+		cfg.GenesisConf.Alloc[candidate] = config.GenesisAllocation{
+			State: uint8(state.Candidate),
+		}
+	})
+	chain.ProvideApplyNewEpochFunc(func(height uint64, appState *appstate.AppState, statsCollector collector.StatsCollector) (int, *types.ValidationResults, bool) {
+		appState.State.SetState(candidate, state.Newbie)
+		return 2, &types.ValidationResults{
+			BadAuthors:              map[common.Address]types.BadAuthorReason{},
+			GoodAuthors:             map[common.Address]*types.ValidationResult{},
+			AuthorResults:           map[common.Address]*types.AuthorResults{},
+			GoodInviters:            map[common.Address]*types.InviterValidationResult{},
+			ReportersToRewardByFlip: map[int]map[common.Address]*types.Candidate{},
+		}, false
+	})
+
+	// fee per gas is set by the first block
+	chain.GenerateBlocks(1)
+	tx, _ := chain.secStore.SignTx(BuildTx(app, addr, &common.Address{0x2}, types.SendTx, decimal.New(1, 0), decimal.New(20, 0), decimal.Zero, 0, 0, nil))
+	require.NoError(chain.txpool.AddInternalTx(tx))
+	chain.GenerateBlocks(1)
+	stats := chain.repo.ReadEpochStats(chain.Head.Height())
+	require.NotNil(stats)
+	require.True(stats.Minted.Sign() > 0)
+	require.True(stats.FeeBurnt.Sign() > 0)
+
+	app.State.SetValidationPeriod(state.AfterLongSessionPeriod)
+	for i := 0; i < state.AfterLongRequiredBlocks; i++ {
+		app.State.GetOrNewGlobalObject().IncBlocksCntWithoutCeremonialTxs()
+	}
+	app.Commit(nil)
+	block := chain.GenerateEmptyBlock()
+	chain.Head = block.Header
+	chain.txpool.ResetTo(block)
+
+	chain.GenerateBlocks(1)
+	require.True(chain.Head.Flags().HasFlag(types.ValidationFinished))
+
+	summary := chain.GetEpochSummary(0)
+	require.NotNil(summary)
+	require.Equal(chain.Head.Height(), summary.Height)
+	require.True(summary.Minted.Sign() > 0)
+	require.Equal(map[uint8]uint32{uint8(state.Verified): 1, uint8(state.Newbie): 1}, summary.Identities)
+	require.Equal(map[uint8]uint32{uint8(state.Verified): 1, uint8(state.Newbie): 1}, summary.ValidationResults)
+
+	// totals of the next epoch start from the block after the epoch change
+	chain.GenerateBlocks(1)
+	stats = chain.repo.ReadEpochStats(chain.Head.Height())
+	require.Equal(chain.Head.Height(), stats.FirstBlock)
+}
+
+func TestBlockchain_MisbehaviorEvidence(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+
+	signVote := func(round uint64, votedHash common.Hash) *types.Vote {
+		vote := &types.Vote{
+			Header: &types.VoteHeader{
+				Round:      round,
+				Step:       1,
+				ParentHash: common.Hash{0x1},
+				VotedHash:  votedHash,
+			},
+		}
+		hash := crypto.SignatureHash(vote)
+		vote.Signature = chain.secStore.Sign(hash[:])
+		return vote
+	}
+	buildTx := func(vote1, vote2 *types.Vote) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, nil, types.MisbehaviorEvidenceTx, decimal.Zero, decimal.New(50, 0), decimal.Zero, 0, 0, attachments.CreateMisbehaviorEvidenceAttachment(vote1, vote2)))
+		return tx
+	}
+	round := chain.Head.Height()
+
+	require.Error(chain.txpool.AddInternalTx(buildTx(signVote(round, common.Hash{0x2}), signVote(round, common.Hash{0x2}))))
+	require.Error(chain.txpool.AddInternalTx(buildTx(signVote(round, common.Hash{0x2}), signVote(round-1, common.Hash{0x3}))))
+	require.Error(chain.txpool.AddInternalTx(buildTx(signVote(round+1, common.Hash{0x2}), signVote(round+1, common.Hash{0x3}))))
+
+	vote1, vote2 := signVote(round, common.Hash{0x2}), signVote(round, common.Hash{0x3})
+	require.NoError(validation.ValidateMisbehaviorEvidence(appState, vote1, vote2))
+	require.NoError(chain.txpool.AddInternalTx(buildTx(vote1, vote2)))
+	chain.GenerateBlocks(1)
+
+	block := chain.GetBlockByHeight(chain.Head.Height())
+	require.Len(block.Body.Transactions, 1)
+	penalizedRound, ok := appState.State.LastMisbehaviorRound(addr)
+	require.True(ok)
+	require.Equal(round, penalizedRound)
+	require.False(appState.IdentityState.IsOnline(addr))
+
+	// the same misbehavior can't be reported twice
+	require.Error(validation.ValidateMisbehaviorEvidence(appState, vote1, vote2))
+}
+
+func TestBlockchain_BlsVoteAggregation(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	chain, appState, cfg := newTestV6Blockchain(key, 5, func(cfg *config.Config) {
+		cfg.Consensus.EnableBlsVoteAggregation = true
+	})
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+
+	buildTx := func(pubKey, proof []byte) *types.Transaction {
+		tx, _ := chain.secStore.SignTx(BuildTx(appState, addr, nil, types.RegisterBlsKeyTx, decimal.Zero, decimal.New(50, 0), decimal.Zero, 0, 0, attachments.CreateRegisterBlsKeyAttachment(pubKey, proof)))
+		return tx
+	}
+	otherKey := bls.GenerateKey([]byte{0x1})
+	require.Error(chain.txpool.AddInternalTx(buildTx(chain.secStore.BlsPublicKey(), otherKey.Proof())))
+	require.NoError(chain.txpool.AddInternalTx(buildTx(chain.secStore.BlsPublicKey(), chain.secStore.BlsProof())))
+	chain.GenerateBlocks(1)
+	require.Equal(chain.secStore.BlsPublicKey(), appState.IdentityState.BlsPubKey(addr))
+
+	appState.ValidatorsCache.Load()
+	chain.GenerateBlocks(1)
+	prevBlock := chain.GetBlockByHeight(chain.Head.Height() - 1).Header
+	block := chain.Head
+
+	vote := &types.Vote{
+		Header: &types.VoteHeader{
+			Round:      block.Height(),
+			Step:       1,
+			ParentHash: prevBlock.Hash(),
+			VotedHash:  block.Hash(),
+			Upgrade:    1,
+		},
+	}
+	hash := crypto.SignatureHash(vote)
+	vote.Signature = chain.secStore.Sign(hash[:])
+	vote.BlsSignature = chain.secStore.BlsSign(hash[:])
+
+	cert := chain.CompressCertificate(prevBlock, &types.FullBlockCert{Votes: []*types.Vote{vote}}, appState.ValidatorsCache)
+	require.Empty(cert.Signatures)
+	require.Len(cert.VoterGroups, 1)
+
+	restored := new(types.BlockCert)
+	data, _ := cert.ToBytes()
+	require.NoError(restored.FromBytes(data))
+	require.NoError(chain.ValidateBlockCert(prevBlock, block, restored, appState.ValidatorsCache))
+
+	restored.VoterGroups[0].Upgrade = 2
+	require.Error(chain.ValidateBlockCert(prevBlock, block, restored, appState.ValidatorsCache))
+
+	// votes without bls signatures stay in the legacy form
+	vote.BlsSignature = nil
+	cert = chain.CompressCertificate(prevBlock, &types.FullBlockCert{Votes: []*types.Vote{vote}}, appState.ValidatorsCache)
+	require.Len(cert.Signatures, 1)
+	require.Empty(cert.AggregatedSignature)
+	require.NoError(chain.ValidateBlockCert(prevBlock, block, cert, appState.ValidatorsCache))
+}
+
+func TestBlockchain_DelegatedAnswers(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	sessionKey, _ := crypto.GenerateKey()
+	session := crypto.PubkeyToAddress(sessionKey.PublicKey)
+	strangerKey, _ := crypto.GenerateKey()
+	chain, appState, cfg := newTestV6Blockchain(key, 5, nil)
+	validation.SetAppConfig(cfg)
+	defer validation.SetAppConfig(nil)
+
+	authorizeTx, _ := types.SignTx(BuildTx(appState, addr, &session, types.AuthorizeAnswersSubmitterTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, nil), key)
+	require.NoError(chain.txpool.AddInternalTx(authorizeTx))
+	chain.GenerateBlocks(1)
+	require.Equal(&session, appState.State.AnswersSubmitter(addr))
+
+	appState.State.SetValidationPeriod(state.LongSessionPeriod)
+	appState.Commit(nil)
+	block := chain.GenerateEmptyBlock()
+	chain.Head = block.Header
+	chain.txpool.ResetTo(block)
+
+	hashTx := func(key *ecdsa.PrivateKey, to *common.Address) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			Type:         types.SubmitAnswersHashTx,
+			AccountNonce: appState.State.GetNonce(crypto.PubkeyToAddress(key.PublicKey)) + 1,
+			To:           to,
+			Payload:      common.Hash{0x1}.Bytes(),
+		}, key)
+		return tx
+	}
+	require.Equal(validation.InvalidRecipient, errors.Cause(chain.txpool.AddInternalTx(hashTx(strangerKey, &addr))))
+
+	require.NoError(chain.txpool.AddInternalTx(hashTx(sessionKey, &addr)))
+	chain.GenerateBlocks(1)
+	require.True(appState.State.HasValidationTx(addr, types.SubmitAnswersHashTx))
+	require.False(appState.State.HasValidationTx(session, types.SubmitAnswersHashTx))
+
+	// answers of the identity are already submitted by the session key
+	require.Equal(validation.DuplicatedTx, errors.Cause(chain.txpool.AddInternalTx(hashTx(key, nil))))
+
+	// the submitter can't be changed during the ceremony
+	revokeTx, _ := types.SignTx(BuildTx(appState, addr, &addr, types.AuthorizeAnswersSubmitterTx, decimal.Zero, decimal.New(20, 0), decimal.Zero, 0, 0, nil), key)
+	require.Equal(validation.LateTx, errors.Cause(chain.txpool.AddInternalTx(revokeTx)))
+}
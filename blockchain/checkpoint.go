@@ -0,0 +1,116 @@
+package blockchain
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/events"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+func (chain *Blockchain) publishCheckpointIfNeeded(header *types.Header) {
+	if !chain.config.Blockchain.PublishCheckpoints || !header.Flags().HasFlag(types.ValidationFinished) {
+		return
+	}
+	st, err := chain.appState.State.Readonly(int64(header.Height()))
+	if err != nil {
+		chain.log.Error("Cannot load state for checkpoint", "err", err)
+		return
+	}
+	go chain.publishCheckpoint(header, st)
+}
+
+// publishCheckpoint signs the state the new epoch starts with, adds it to ipfs and announces its cid to peers
+func (chain *Blockchain) publishCheckpoint(header *types.Header, st *state.StateDB) {
+	supply, stake := calculateSupply(st)
+	checkpoint, err := chain.secStore.SignEpochCheckpoint(&types.EpochCheckpoint{
+		Epoch:        st.Epoch(),
+		Height:       header.Height(),
+		BlockHash:    header.Hash(),
+		Root:         header.Root(),
+		IdentityRoot: header.IdentityRoot(),
+		Supply:       supply,
+		Stake:        stake,
+	})
+	if err != nil {
+		chain.log.Error("Cannot sign checkpoint", "err", err)
+		return
+	}
+	data, _ := checkpoint.ToBytes()
+	cid, err := chain.ipfs.Add(data, true)
+	if err != nil {
+		chain.log.Error("Cannot add checkpoint to ipfs", "err", err)
+		return
+	}
+	announcement := &types.CheckpointAnnouncement{
+		Cid:        cid.Bytes(),
+		Checkpoint: checkpoint,
+	}
+	chain.repo.WriteCheckpointAnnouncement(chain.coinBaseAddress, announcement)
+	chain.log.Info("Epoch checkpoint published", "epoch", checkpoint.Epoch, "cid", cid.String())
+	chain.bus.Publish(&events.NewCheckpointAnnouncementEvent{
+		Announcement: announcement,
+	})
+}
+
+func calculateSupply(st *state.StateDB) (supply *big.Int, stake *big.Int) {
+	supply, stake = new(big.Int), new(big.Int)
+	st.IterateOverAccounts(func(addr common.Address, account state.Account) {
+		if account.Balance != nil {
+			supply.Add(supply, account.Balance)
+		}
+		if account.Contract != nil && account.Contract.Stake != nil {
+			stake.Add(stake, account.Contract.Stake)
+		}
+		for _, lock := range account.StakeLocks {
+			supply.Add(supply, lock.Locked())
+		}
+	})
+	st.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		if identity.Stake != nil {
+			stake.Add(stake, identity.Stake)
+		}
+	})
+	supply.Add(supply, stake)
+	return supply, stake
+}
+
+// AddCheckpointAnnouncement validates and stores a checkpoint announced by a peer,
+// returns true if the announcement is new and should be relayed further
+func (chain *Blockchain) AddCheckpointAnnouncement(announcement *types.CheckpointAnnouncement) (bool, error) {
+	checkpoint := announcement.Checkpoint
+	if checkpoint.Epoch != chain.appState.State.Epoch() {
+		return false, nil
+	}
+	publisher, err := types.SenderEpochCheckpoint(checkpoint)
+	if err != nil {
+		return false, errors.Wrap(err, "invalid checkpoint signature")
+	}
+	if chain.repo.HasCheckpointAnnouncement(checkpoint.Epoch, publisher) {
+		return false, nil
+	}
+	if !chain.appState.ValidatorsCache.Contains(publisher) {
+		return false, errors.New("checkpoint publisher is not validated")
+	}
+	header := chain.GetBlockHeaderByHeight(checkpoint.Height)
+	if header == nil || header.Hash() != checkpoint.BlockHash || header.Root() != checkpoint.Root ||
+		header.IdentityRoot() != checkpoint.IdentityRoot || !header.Flags().HasFlag(types.ValidationFinished) {
+		return false, errors.New("checkpoint does not match local chain")
+	}
+	data, _ := checkpoint.ToBytes()
+	cid, err := chain.ipfs.Cid(data)
+	if err != nil {
+		return false, err
+	}
+	if !bytes.Equal(cid.Bytes(), announcement.Cid) {
+		return false, errors.New("checkpoint cid mismatch")
+	}
+	chain.repo.WriteCheckpointAnnouncement(publisher, announcement)
+	return true, nil
+}
+
+func (chain *Blockchain) ReadCheckpointAnnouncements(epoch uint16) []*types.CheckpointAnnouncement {
+	return chain.repo.ReadCheckpointAnnouncements(epoch)
+}
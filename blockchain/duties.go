@@ -0,0 +1,176 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"sync"
+)
+
+const (
+	maxDutyRecords = 100
+)
+
+// dutySteps are the steps executed in every round, so members of their committees are always expected to vote
+var dutySteps = []uint8{types.ReductionOne, types.ReductionTwo, 1}
+
+// DutyRecord describes the duties of the node identity in a round and whether they were fulfilled
+type DutyRecord struct {
+	Round      uint64
+	Epoch      uint16
+	Propose    bool
+	Proposed   bool
+	VoteSteps  []uint8
+	VotedSteps []uint8
+}
+
+func (r *DutyRecord) Missed() bool {
+	return r.Propose && !r.Proposed || len(r.VotedSteps) < len(r.VoteSteps)
+}
+
+// dutyTracker collects proposals and votes made by the node and matches them with the expected duties of rounds
+type dutyTracker struct {
+	proposed  map[uint64]struct{}
+	voted     map[uint64]map[uint8]struct{}
+	records   []*DutyRecord
+	lastRound uint64
+	mutex     sync.Mutex
+}
+
+func newDutyTracker() *dutyTracker {
+	return &dutyTracker{
+		proposed: make(map[uint64]struct{}),
+		voted:    make(map[uint64]map[uint8]struct{}),
+	}
+}
+
+func (t *dutyTracker) addProposal(round uint64) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.proposed[round] = struct{}{}
+}
+
+func (t *dutyTracker) addVote(round uint64, step uint8) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	steps, ok := t.voted[round]
+	if !ok {
+		steps = make(map[uint8]struct{})
+		t.voted[round] = steps
+	}
+	steps[step] = struct{}{}
+}
+
+// complete matches the expected duties of the round with the fulfilled ones and forgets proposals and votes
+// of the round and previous ones, nil is returned if the round was already completed or there were no duties
+func (t *dutyTracker) complete(round uint64, epoch uint16, propose bool, voteSteps []uint8) *DutyRecord {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	_, proposed := t.proposed[round]
+	voted := t.voted[round]
+	for r := range t.proposed {
+		if r <= round {
+			delete(t.proposed, r)
+		}
+	}
+	for r := range t.voted {
+		if r <= round {
+			delete(t.voted, r)
+		}
+	}
+	// rounds are completed again when a fork is applied
+	if round <= t.lastRound {
+		return nil
+	}
+	t.lastRound = round
+	if !propose && len(voteSteps) == 0 {
+		return nil
+	}
+	record := &DutyRecord{
+		Round:     round,
+		Epoch:     epoch,
+		Propose:   propose,
+		Proposed:  propose && proposed,
+		VoteSteps: voteSteps,
+	}
+	for _, step := range voteSteps {
+		if _, ok := voted[step]; ok {
+			record.VotedSteps = append(record.VotedSteps, step)
+		}
+	}
+	t.records = append(t.records, record)
+	if len(t.records) > maxDutyRecords {
+		t.records = t.records[len(t.records)-maxDutyRecords:]
+	}
+	return record
+}
+
+// list returns the records starting from the latest one
+func (t *dutyTracker) list() []*DutyRecord {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	result := make([]*DutyRecord, 0, len(t.records))
+	for i := len(t.records) - 1; i >= 0; i-- {
+		result = append(result, t.records[i])
+	}
+	return result
+}
+
+// shouldTrackDuties returns false for blocks which the node hasn't played rounds of: synced blocks and the block
+// of the round which was in progress when the node started or finished the sync
+func (chain *Blockchain) shouldTrackDuties() bool {
+	return !chain.isSyncing && chain.Head.Height() > chain.onlineHeight
+}
+
+// expectedDuties returns the duties of the node identity in the round following the head,
+// it must be called before the block of the round is applied
+func (chain *Blockchain) expectedDuties() (propose bool, voteSteps []uint8) {
+	if !checkIfProposer(chain.coinBaseAddress, chain.appState) {
+		return false, nil
+	}
+	propose, _ = chain.GetProposerSortition()
+	round := chain.Head.Height() + 1
+	committeeSize := chain.GetCommitteeSize(chain.appState.ValidatorsCache, false)
+	for _, step := range dutySteps {
+		stepValidators := chain.appState.ValidatorsCache.GetOnlineValidators(chain.Head.Seed(), round, step, committeeSize)
+		if stepValidators != nil && stepValidators.Contains(chain.coinBaseAddress) {
+			voteSteps = append(voteSteps, step)
+		}
+	}
+	return propose, voteSteps
+}
+
+func (chain *Blockchain) updateMinerPerformance(record *DutyRecord) {
+	performance := chain.repo.ReadMinerPerformance(record.Epoch)
+	if performance == nil {
+		performance = &types.MinerPerformance{
+			Epoch: record.Epoch,
+		}
+	}
+	performance.Rounds++
+	if record.Propose {
+		performance.ExpectedProposals++
+	}
+	if record.Proposed {
+		performance.Proposals++
+	}
+	performance.ExpectedVotes += uint32(len(record.VoteSteps))
+	performance.Votes += uint32(len(record.VotedSteps))
+	if record.Missed() {
+		performance.LastMissedRound = record.Round
+	}
+	chain.repo.WriteMinerPerformance(performance)
+}
+
+// RecordVote marks the vote of the node identity at the step of the round as sent
+func (chain *Blockchain) RecordVote(round uint64, step uint8) {
+	chain.duties.addVote(round, step)
+}
+
+// GetMinerPerformance returns the statistics of duties of the node identity in the epoch
+func (chain *Blockchain) GetMinerPerformance(epoch uint16) *types.MinerPerformance {
+	return chain.repo.ReadMinerPerformance(epoch)
+}
+
+// RecentDuties returns the last rounds the node identity had duties in starting from the latest one
+func (chain *Blockchain) RecentDuties() []*DutyRecord {
+	return chain.duties.list()
+}
@@ -0,0 +1,64 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDutyTracker_Complete(t *testing.T) {
+	tracker := newDutyTracker()
+
+	tracker.addProposal(10)
+	tracker.addVote(10, types.ReductionOne)
+	tracker.addVote(10, 1)
+	tracker.addVote(11, types.ReductionOne)
+
+	record := tracker.complete(10, 3, true, []uint8{types.ReductionOne, types.ReductionTwo, 1})
+	require.NotNil(t, record)
+	require.Equal(t, uint16(3), record.Epoch)
+	require.True(t, record.Proposed)
+	require.Equal(t, []uint8{types.ReductionOne, 1}, record.VotedSteps)
+	require.True(t, record.Missed())
+
+	// the round is completed again after a fork
+	require.Nil(t, tracker.complete(10, 3, true, []uint8{types.ReductionOne}))
+
+	require.Nil(t, tracker.complete(11, 3, false, nil))
+
+	tracker.addVote(12, types.ReductionTwo)
+	record = tracker.complete(12, 3, true, []uint8{types.ReductionTwo})
+	require.False(t, record.Proposed)
+	require.True(t, record.Missed())
+
+	tracker.addVote(13, types.ReductionTwo)
+	record = tracker.complete(13, 3, false, []uint8{types.ReductionTwo})
+	require.False(t, record.Missed())
+
+	require.Empty(t, tracker.proposed)
+	require.Empty(t, tracker.voted)
+
+	list := tracker.list()
+	require.Len(t, list, 3)
+	require.Equal(t, uint64(13), list[0].Round)
+	require.Equal(t, uint64(10), list[2].Round)
+
+	for round := uint64(14); round < 14+maxDutyRecords; round++ {
+		tracker.complete(round, 3, false, []uint8{1})
+	}
+	list = tracker.list()
+	require.Len(t, list, maxDutyRecords)
+	require.Equal(t, uint64(13+maxDutyRecords), list[0].Round)
+}
+
+func TestBlockchain_shouldTrackDuties(t *testing.T) {
+	chain := &Blockchain{
+		Head:         &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 10}},
+		onlineHeight: 10,
+	}
+	require.False(t, chain.shouldTrackDuties())
+	chain.Head = &types.Header{EmptyBlockHeader: &types.EmptyBlockHeader{Height: 11}}
+	require.True(t, chain.shouldTrackDuties())
+	chain.isSyncing = true
+	require.False(t, chain.shouldTrackDuties())
+}
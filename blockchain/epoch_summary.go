@@ -0,0 +1,113 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/shopspring/decimal"
+	"math/big"
+)
+
+// blockStatsCollector counts coins minted and fees burnt by the block and passes all events to the wrapped collector
+type blockStatsCollector struct {
+	collector.StatsCollector
+	minted   *big.Int
+	feeBurnt *big.Int
+}
+
+func newBlockStatsCollector(statsCollector collector.StatsCollector) *blockStatsCollector {
+	if statsCollector == nil {
+		statsCollector = collector.NewStatsCollector()
+	}
+	return &blockStatsCollector{
+		StatsCollector: statsCollector,
+		minted:         new(big.Int),
+		feeBurnt:       new(big.Int),
+	}
+}
+
+func (c *blockStatsCollector) AddMintedCoins(amount *big.Int) {
+	if amount != nil {
+		c.minted.Add(c.minted, amount)
+	}
+	c.StatsCollector.AddMintedCoins(amount)
+}
+
+func (c *blockStatsCollector) AddFeeBurntCoins(addr common.Address, feeAmount *big.Int, burntRate float32, tx *types.Transaction) {
+	if feeAmount != nil {
+		burnt := math.ToInt(decimal.NewFromBigInt(feeAmount, 0).Mul(decimal.NewFromFloat32(burntRate)))
+		c.feeBurnt.Add(c.feeBurnt, burnt)
+	}
+	c.StatsCollector.AddFeeBurntCoins(addr, feeAmount, burntRate, tx)
+}
+
+// updateEpochStats adds totals of the inserted block to totals of its epoch and writes the epoch summary
+// if the block finishes the epoch
+func (chain *Blockchain) updateEpochStats(block *types.Block, blockStats *blockStatsCollector) {
+	height := block.Height()
+	stats := &types.EpochStats{
+		FirstBlock: height,
+		Minted:     new(big.Int),
+		FeeBurnt:   new(big.Int),
+	}
+	if prevBlock := chain.GetBlockHeaderByHeight(height - 1); prevBlock != nil && !prevBlock.Flags().HasFlag(types.ValidationFinished) {
+		if prevStats := chain.repo.ReadEpochStats(height - 1); prevStats != nil {
+			stats.FirstBlock = prevStats.FirstBlock
+			if prevStats.Minted != nil {
+				stats.Minted.Add(stats.Minted, prevStats.Minted)
+			}
+			if prevStats.FeeBurnt != nil {
+				stats.FeeBurnt.Add(stats.FeeBurnt, prevStats.FeeBurnt)
+			}
+		}
+	}
+	stats.Minted.Add(stats.Minted, blockStats.minted)
+	stats.FeeBurnt.Add(stats.FeeBurnt, blockStats.feeBurnt)
+	chain.repo.WriteEpochStats(height, stats)
+
+	if !block.Header.Flags().HasFlag(types.ValidationFinished) {
+		return
+	}
+	summary := chain.buildEpochSummary(block, stats)
+	// stats of the previous epoch are kept until now in case of reorgs around the epoch change
+	if summary.Epoch > 0 {
+		if prevSummary := chain.repo.ReadEpochSummary(summary.Epoch - 1); prevSummary != nil {
+			chain.repo.RemoveEpochStats(prevSummary.FirstBlock, prevSummary.Height)
+		}
+	}
+	chain.repo.WriteEpochSummary(summary)
+}
+
+func (chain *Blockchain) buildEpochSummary(block *types.Block, stats *types.EpochStats) *types.EpochSummary {
+	summary := &types.EpochSummary{
+		Epoch:             chain.appState.State.Epoch() - 1,
+		Height:            block.Height(),
+		FirstBlock:        stats.FirstBlock,
+		Minted:            stats.Minted,
+		FeeBurnt:          stats.FeeBurnt,
+		Identities:        make(map[uint8]uint32),
+		ValidationResults: make(map[uint8]uint32),
+	}
+	chain.appState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		summary.Identities[uint8(identity.State)]++
+	})
+	prevState, err := chain.appState.Readonly(block.Height() - 1)
+	if err != nil {
+		chain.log.Warn("Validation results are not added to the epoch summary", "err", err)
+		return summary
+	}
+	prevState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		switch identity.State {
+		case state.Candidate, state.Newbie, state.Verified, state.Suspended, state.Zombie, state.Human:
+			summary.ValidationResults[uint8(chain.appState.State.GetIdentityState(addr))]++
+		}
+	})
+	return summary
+}
+
+// GetEpochSummary returns the summary of the finished epoch
+func (chain *Blockchain) GetEpochSummary(epoch uint16) *types.EpochSummary {
+	return chain.repo.ReadEpochSummary(epoch)
+}
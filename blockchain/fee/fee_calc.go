@@ -5,6 +5,7 @@ import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/config"
 	"github.com/shopspring/decimal"
 	"math/big"
 )
@@ -17,12 +18,20 @@ const (
 	StoreToIpfsFeeCoef = float32(0.2)
 
 	storeToIpfsAdditionalSize = common.MaxCustomDataSize
+
+	gasPerByte = 10
 )
 
 var (
 	MinFeePerGas = big.NewInt(10)
 )
 
+var appCfg *config.Config
+
+func SetAppConfig(cfg *config.Config) {
+	appCfg = cfg
+}
+
 func GetFeePerGasForNetwork(networkSize int) *big.Int {
 	if networkSize == 0 {
 		networkSize = 1
@@ -50,28 +59,63 @@ func CalculateFee(networkSize int, feePerGas *big.Int, tx *types.Transaction) *b
 }
 
 func CalculateGas(tx *types.Transaction) int {
-	return getTxSizeForFee(tx) * 10
+	return CalculateGasForSize(getTxSizeForFee(tx))
+}
+
+// CalculateGasForSize returns gas of a signed tx of the given size
+func CalculateGasForSize(size int) int {
+	return size * gasPerByte
 }
 
 func getFeePerGasForTx(networkSize int, feePerGas *big.Int, tx *types.Transaction) *big.Int {
+	if tx.Type == types.OnlineStatusTx {
+		attachment := attachments.ParseOnlineStatusAttachment(tx)
+		if attachment == nil || !attachment.Online {
+			return big.NewInt(0)
+		}
+	}
+	return GetFeePerGasForTxType(networkSize, feePerGas, tx.Type)
+}
+
+// GetFeePerGasForTxType returns fee per gas paid by txs of the given type, online status txs are assumed to turn mining on
+func GetFeePerGasForTxType(networkSize int, feePerGas *big.Int, txType types.TxType) *big.Int {
 	if networkSize == 0 || common.ZeroOrNil(feePerGas) {
 		return big.NewInt(0)
 	}
-	if tx.Type == types.SubmitFlipTx || tx.Type == types.SubmitAnswersHashTx || tx.Type == types.SubmitShortAnswersTx ||
-		tx.Type == types.SubmitLongAnswersTx || tx.Type == types.EvidenceTx || tx.Type == types.ActivationTx ||
-		tx.Type == types.InviteTx || tx.Type == types.KillTx {
-		return big.NewInt(0)
+	if appCfg != nil && appCfg.Consensus.TxFeeMultipliers != nil {
+		return getFeePerGasWithMultipliers(appCfg.Consensus.TxFeeMultipliers, feePerGas, txType)
 	}
-	if tx.Type == types.OnlineStatusTx {
-		attachment := attachments.ParseOnlineStatusAttachment(tx)
-		if attachment != nil && attachment.Online {
-			return new(big.Int).Mul(big.NewInt(2), feePerGas)
-		}
+	if txType == types.SubmitFlipTx || txType == types.SubmitAnswersHashTx || txType == types.SubmitShortAnswersTx ||
+		txType == types.SubmitLongAnswersTx || txType == types.EvidenceTx || txType == types.ActivationTx ||
+		txType == types.InviteTx || txType == types.KillTx {
 		return big.NewInt(0)
 	}
+	if txType == types.OnlineStatusTx {
+		return new(big.Int).Mul(big.NewInt(2), feePerGas)
+	}
 	return feePerGas
 }
 
+func getFeePerGasWithMultipliers(multipliers *config.TxFeeMultipliers, feePerGas *big.Int, txType types.TxType) *big.Int {
+	var multiplier float32
+	switch txType {
+	case types.SubmitAnswersHashTx, types.SubmitShortAnswersTx, types.SubmitLongAnswersTx, types.EvidenceTx,
+		types.ActivationTx, types.InviteTx, types.KillTx:
+		return big.NewInt(0)
+	case types.SendTx, types.MultiSendTx:
+		multiplier = multipliers.Payment
+	case types.SubmitFlipTx:
+		multiplier = multipliers.SubmitFlip
+	case types.OnlineStatusTx:
+		multiplier = multipliers.OnlineStatus
+	case types.DelegateTx, types.UndelegateTx, types.AuthorizeAnswersSubmitterTx:
+		multiplier = multipliers.Delegation
+	default:
+		return feePerGas
+	}
+	return math.ToInt(decimal.NewFromBigInt(feePerGas, 0).Mul(decimal.NewFromFloat32(multiplier)))
+}
+
 func getTxSizeForFee(tx *types.Transaction) int {
 	size := tx.Size()
 	if tx.Signature == nil {
@@ -93,6 +137,28 @@ func getTxSizeForFee(tx *types.Transaction) int {
 	return size
 }
 
+// PredictFeePerGas applies the fee per gas adjustment of consecutive blocks with the given gas utilizations (0..1),
+// fee per gas never goes below minFeePerGas
+func PredictFeePerGas(feePerGas *big.Int, minFeePerGas *big.Int, k float32, utilizations []float64) *big.Int {
+	if common.ZeroOrNil(feePerGas) || feePerGas.Cmp(minFeePerGas) == -1 {
+		feePerGas = minFeePerGas
+	}
+	result := decimal.NewFromBigInt(feePerGas, 0)
+	minFeePerGasD := decimal.NewFromBigInt(minFeePerGas, 0)
+	for _, utilization := range utilizations {
+		// curBlockFee = prevBlockFee * (1 + k * (prevBlockGas / maxBlockGas - 0.5))
+		result = decimal.NewFromFloat(utilization).
+			Sub(decimal.NewFromFloat(0.5)).
+			Mul(decimal.NewFromFloat32(k)).
+			Add(decimal.New(1, 0)).
+			Mul(result)
+		if result.LessThan(minFeePerGasD) {
+			result = minFeePerGasD
+		}
+	}
+	return math.ToInt(result)
+}
+
 func CalculateCost(networkSize int, feePerGas *big.Int, tx *types.Transaction) *big.Int {
 	result := big.NewInt(0)
 
@@ -3,6 +3,7 @@ package fee
 import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -67,3 +68,44 @@ func Test_GetFeePerGasForNetwork(t *testing.T) {
 
 	require.Zero(big.NewInt(10).Cmp(GetFeePerGasForNetwork(1e+18)))
 }
+
+func TestPredictFeePerGas(t *testing.T) {
+	minFeePerGas := big.NewInt(100)
+	feePerGas := big.NewInt(1000)
+
+	require.Equal(t, feePerGas, PredictFeePerGas(feePerGas, minFeePerGas, 0.25, nil))
+	require.Equal(t, big.NewInt(1000), PredictFeePerGas(feePerGas, minFeePerGas, 0.25, []float64{0.5, 0.5}))
+	require.Equal(t, big.NewInt(1320), PredictFeePerGas(feePerGas, minFeePerGas, 0.4, []float64{1, 0.75}))
+	require.Equal(t, big.NewInt(100), PredictFeePerGas(feePerGas, minFeePerGas, 1, []float64{0, 0, 0, 0}))
+	require.Equal(t, big.NewInt(105), PredictFeePerGas(nil, minFeePerGas, 0.1, []float64{1}))
+}
+
+func TestGetFeePerGasForTxType_Multipliers(t *testing.T) {
+	require := require.New(t)
+	feePerGas := big.NewInt(1000)
+
+	// legacy rules
+	require.Equal(big.NewInt(0), GetFeePerGasForTxType(100, feePerGas, types.SubmitFlipTx))
+	require.Equal(big.NewInt(2000), GetFeePerGasForTxType(100, feePerGas, types.OnlineStatusTx))
+
+	consensusCfg := *config.ConsensusVersions[config.ConsensusV6]
+	consensusCfg.TxFeeMultipliers = &config.TxFeeMultipliers{
+		Payment:      1.5,
+		SubmitFlip:   0.5,
+		OnlineStatus: 3,
+		Delegation:   0,
+	}
+	SetAppConfig(&config.Config{Consensus: &consensusCfg})
+	defer SetAppConfig(nil)
+
+	require.Equal(big.NewInt(1500), GetFeePerGasForTxType(100, feePerGas, types.SendTx))
+	require.Equal(big.NewInt(500), GetFeePerGasForTxType(100, feePerGas, types.SubmitFlipTx))
+	require.Equal(big.NewInt(3000), GetFeePerGasForTxType(100, feePerGas, types.OnlineStatusTx))
+	require.Equal(big.NewInt(0), GetFeePerGasForTxType(100, feePerGas, types.DelegateTx))
+	require.Equal(big.NewInt(0), GetFeePerGasForTxType(100, feePerGas, types.UndelegateTx))
+	require.Equal(feePerGas, GetFeePerGasForTxType(100, feePerGas, types.CallContractTx))
+	require.Equal(big.NewInt(0), GetFeePerGasForTxType(100, feePerGas, types.InviteTx))
+
+	// turning mining off stays free
+	require.Zero(CalculateFee(100, feePerGas, &types.Transaction{Type: types.OnlineStatusTx}).Sign())
+}
@@ -0,0 +1,48 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+)
+
+func (chain *Blockchain) isFinalityCheckpoint(height uint64) bool {
+	checkpointRange := chain.config.Blockchain.FinalityCheckpointRange
+	return checkpointRange > 0 && height > 0 && height%checkpointRange == 0
+}
+
+// writeFinalityCheckpoint records the block as a finality checkpoint if it is at a checkpoint height
+// and its certificate is signed by a supermajority of the final committee
+func (chain *Blockchain) writeFinalityCheckpoint(hash common.Hash, cert *types.BlockCert) {
+	if cert == nil || cert.Step != types.Final {
+		return
+	}
+	header := chain.repo.ReadBlockHeader(hash)
+	if header == nil || !chain.isFinalityCheckpoint(header.Height()) {
+		return
+	}
+	chain.repo.WriteFinalityCheckpoint(header.Height(), hash)
+}
+
+// FinalBlock returns the header of the latest canonical finality checkpoint,
+// the checkpoint and all blocks below it are considered final
+func (chain *Blockchain) FinalBlock() *types.Header {
+	var result *types.Header
+	chain.repo.IterateFinalityCheckpoints(chain.Head.Height(), func(height uint64, hash common.Hash) bool {
+		// checkpoints of not yet applied headers are skipped
+		if chain.repo.ReadCanonicalHash(height) != hash {
+			return false
+		}
+		result = chain.repo.ReadBlockHeader(hash)
+		return result != nil
+	})
+	return result
+}
+
+// checkNotFinalized returns an error if blocks above the height include the last finality checkpoint
+func (chain *Blockchain) checkNotFinalized(height uint64) error {
+	if final := chain.FinalBlock(); final != nil && height < final.Height() {
+		return errors.Errorf("height %v is below the finality checkpoint %v", height, final.Height())
+	}
+	return nil
+}
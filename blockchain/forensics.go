@@ -0,0 +1,218 @@
+package blockchain
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/log"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	maxRejectedBlocks = 20
+)
+
+// RejectedBlock describes a block which failed validation and the local parent block it was validated on
+type RejectedBlock struct {
+	Block        *types.Block
+	Error        string
+	Time         int64
+	ParentHeight uint64
+	ParentHash   common.Hash
+	// roots of the local state the block was validated against
+	StateRoot    common.Hash
+	IdentityRoot common.Hash
+}
+
+type rejectedBlockDump struct {
+	Height       uint64        `json:"height"`
+	Hash         common.Hash   `json:"hash"`
+	Error        string        `json:"error"`
+	Time         int64         `json:"timestamp"`
+	ParentHeight uint64        `json:"parentHeight"`
+	ParentHash   common.Hash   `json:"parentHash"`
+	StateRoot    common.Hash   `json:"stateRoot"`
+	IdentityRoot common.Hash   `json:"identityRoot"`
+	Block        hexutil.Bytes `json:"block"`
+}
+
+// rejectedBlocks keeps the last rejected blocks and dumps them to the forensics directory, if it's set,
+// so they survive restarts and can be inspected offline
+type rejectedBlocks struct {
+	dir    string
+	blocks []*RejectedBlock
+	log    log.Logger
+	mutex  sync.Mutex
+}
+
+func newRejectedBlocks(dir string, logger log.Logger) *rejectedBlocks {
+	r := &rejectedBlocks{
+		dir: dir,
+		log: logger,
+	}
+	r.load()
+	return r
+}
+
+func (r *rejectedBlocks) add(block *RejectedBlock) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	hash := block.Block.Hash()
+	for _, b := range r.blocks {
+		if b.Block.Hash() == hash {
+			return
+		}
+	}
+	r.blocks = append(r.blocks, block)
+	if len(r.blocks) > maxRejectedBlocks {
+		r.blocks = r.blocks[len(r.blocks)-maxRejectedBlocks:]
+	}
+	if err := r.dump(block); err != nil {
+		r.log.Warn("Failed to dump rejected block", "hash", hash.Hex(), "err", err)
+	}
+}
+
+// list returns rejected blocks starting from the latest one
+func (r *rejectedBlocks) list() []*RejectedBlock {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	result := make([]*RejectedBlock, 0, len(r.blocks))
+	for i := len(r.blocks) - 1; i >= 0; i-- {
+		result = append(result, r.blocks[i])
+	}
+	return result
+}
+
+func (r *rejectedBlocks) dump(block *RejectedBlock) error {
+	if r.dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(r.dir, 0755); err != nil {
+		return err
+	}
+	blockBytes, err := block.Block.ToBytes()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(&rejectedBlockDump{
+		Height:       block.Block.Height(),
+		Hash:         block.Block.Hash(),
+		Error:        block.Error,
+		Time:         block.Time,
+		ParentHeight: block.ParentHeight,
+		ParentHash:   block.ParentHash,
+		StateRoot:    block.StateRoot,
+		IdentityRoot: block.IdentityRoot,
+		Block:        blockBytes,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%020d-%v-%v.json", time.Now().UnixNano(), block.Block.Height(), block.Block.Hash().Hex())
+	if err := ioutil.WriteFile(filepath.Join(r.dir, name), data, 0644); err != nil {
+		return err
+	}
+	return r.prune()
+}
+
+// prune removes dumps except the latest maxRejectedBlocks ones, names of dumps start with the time of writing
+func (r *rejectedBlocks) prune() error {
+	names, err := r.dumpNames()
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(names)-maxRejectedBlocks; i++ {
+		if err := os.Remove(filepath.Join(r.dir, names[i])); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *rejectedBlocks) dumpNames() ([]string, error) {
+	files, err := ioutil.ReadDir(r.dir)
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".json") {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func (r *rejectedBlocks) load() {
+	if r.dir == "" {
+		return
+	}
+	if _, err := os.Stat(r.dir); os.IsNotExist(err) {
+		return
+	}
+	names, err := r.dumpNames()
+	if err != nil {
+		r.log.Warn("Failed to read rejected blocks", "err", err)
+		return
+	}
+	if len(names) > maxRejectedBlocks {
+		names = names[len(names)-maxRejectedBlocks:]
+	}
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join(r.dir, name))
+		if err != nil {
+			r.log.Warn("Failed to read rejected block", "file", name, "err", err)
+			continue
+		}
+		dump := new(rejectedBlockDump)
+		if err := json.Unmarshal(data, dump); err != nil {
+			r.log.Warn("Failed to parse rejected block", "file", name, "err", err)
+			continue
+		}
+		block := new(types.Block)
+		if err := block.FromBytes(dump.Block); err != nil {
+			r.log.Warn("Failed to parse rejected block", "file", name, "err", err)
+			continue
+		}
+		r.blocks = append(r.blocks, &RejectedBlock{
+			Block:        block,
+			Error:        dump.Error,
+			Time:         dump.Time,
+			ParentHeight: dump.ParentHeight,
+			ParentHash:   dump.ParentHash,
+			StateRoot:    dump.StateRoot,
+			IdentityRoot: dump.IdentityRoot,
+		})
+	}
+}
+
+// recordRejectedBlock saves the block which failed validation on top of the prevBlock,
+// blocks of other forks and blocks which don't follow the prevBlock are ignored
+func (chain *Blockchain) recordRejectedBlock(block *types.Block, prevBlock *types.Header, err error) {
+	if err == ParentHashIsInvalid || block.Height() != prevBlock.Height()+1 {
+		return
+	}
+	chain.rejectedBlocks.add(&RejectedBlock{
+		Block:        block,
+		Error:        err.Error(),
+		Time:         time.Now().UTC().Unix(),
+		ParentHeight: prevBlock.Height(),
+		ParentHash:   prevBlock.Hash(),
+		StateRoot:    prevBlock.Root(),
+		IdentityRoot: prevBlock.IdentityRoot(),
+	})
+}
+
+// LastRejectedBlocks returns the last blocks which failed validation starting from the latest one
+func (chain *Blockchain) LastRejectedBlocks() []*RejectedBlock {
+	return chain.rejectedBlocks.list()
+}
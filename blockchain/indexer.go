@@ -23,12 +23,17 @@ type indexer struct {
 }
 
 func newBlockchainIndexer(db dbm.DB, bus eventbus.Bus, cfg *config.Config, keystore *keystore.KeyStore) *indexer {
-	return &indexer{
+	i := &indexer{
 		repo:     database.NewRepo(db),
 		bus:      bus,
 		keystore: keystore,
 		cfg:      cfg,
 	}
+	_ = bus.Subscribe(events.ReorgEventID, func(e eventbus.Event) {
+		reorgEvent := e.(*events.ReorgEvent)
+		i.HandleReorg(reorgEvent.Reverted, reorgEvent.Applied)
+	})
+	return i
 }
 
 func (i *indexer) initialize(coinbase common.Address) {
@@ -39,31 +44,127 @@ func (i *indexer) HandleBlockTransactions(header *types.Header, txs []*types.Tra
 
 	i.repo.DeleteOutdatedBurntCoins(header.Height(), i.cfg.Blockchain.BurnTxRange)
 
+	accountsMap := i.indexedAccounts()
+
+	for _, tx := range txs {
+		sender, _ := types.Sender(tx)
+		i.handleAddressTx(header, sender, tx, accountsMap)
+		i.handleBurnTx(header.Height(), sender, tx)
+		i.handleOwnDeleteFlipTx(sender, tx)
+	}
+}
+
+// HandleReorg removes indexes of txs of reverted blocks and saves address and burn txs of applied blocks again,
+// since their records are removed if they were included into reverted blocks as well
+func (i *indexer) HandleReorg(reverted, applied []*types.Block) {
+	included := make(map[common.Hash]struct{})
+	for _, block := range applied {
+		for _, tx := range block.Body.Transactions {
+			included[tx.Hash()] = struct{}{}
+		}
+	}
+	for _, block := range reverted {
+		for _, tx := range block.Body.Transactions {
+			hash := tx.Hash()
+			if _, ok := included[hash]; !ok {
+				i.repo.RemoveTxIndex(hash)
+				i.repo.RemoveReceiptIndex(hash)
+			}
+			sender, _ := types.Sender(tx)
+			i.repo.RemoveSavedTx(sender, block.Header.Time(), tx)
+			for _, to := range txRecipients(tx) {
+				if to != sender {
+					i.repo.RemoveSavedTx(to, block.Header.Time(), tx)
+				}
+			}
+			if tx.Type == types.BurnTx {
+				i.repo.RemoveBurntCoins(block.Height(), hash)
+			}
+		}
+	}
+	accountsMap := i.indexedAccounts()
+	for _, block := range applied {
+		for _, tx := range block.Body.Transactions {
+			sender, _ := types.Sender(tx)
+			i.handleAddressTx(block.Header, sender, tx, accountsMap)
+			i.handleBurnTx(block.Height(), sender, tx)
+		}
+	}
+}
+
+func (i *indexer) ownAccounts() map[common.Address]struct{} {
 	accounts := i.keystore.Accounts()
 	accountsMap := make(map[common.Address]struct{})
 	for _, item := range accounts {
 		accountsMap[item.Address] = struct{}{}
 	}
 	accountsMap[i.coinbase] = struct{}{}
+	return accountsMap
+}
 
+// indexedAccounts returns accounts which txs are saved to the tx history of addresses, nil means all accounts.
+// Txs of all accounts are saved only if it's enabled by the config and the node is not validation only.
+func (i *indexer) indexedAccounts() map[common.Address]struct{} {
+	if i.cfg.Blockchain.IndexTxHistory && (i.cfg.Sync == nil || !i.cfg.Sync.ValidationOnly) {
+		return nil
+	}
+	return i.ownAccounts()
+}
+
+// indexHistoricalTxs saves txs of an already imported block to the tx history of all addresses
+func (i *indexer) indexHistoricalTxs(header *types.Header, txs []*types.Transaction) {
 	for _, tx := range txs {
 		sender, _ := types.Sender(tx)
-		i.handleOwnTx(header, sender, tx, accountsMap)
-		i.handleBurnTx(header.Height(), sender, tx)
-		i.handleOwnDeleteFlipTx(sender, tx)
+		i.handleAddressTx(header, sender, tx, nil)
 	}
 }
 
-func (i *indexer) handleOwnTx(header *types.Header, sender common.Address, tx *types.Transaction, accountsMap map[common.Address]struct{}) {
+func isOwnTx(tx *types.Transaction, accountsMap map[common.Address]struct{}) bool {
+	sender, _ := types.Sender(tx)
 	if _, ok := accountsMap[sender]; ok {
-		i.repo.SaveTx(sender, header.Hash(), header.Time(), header.FeePerGas(), tx)
+		return true
+	}
+	for _, to := range txRecipients(tx) {
+		if _, ok := accountsMap[to]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// txRecipients returns the recipient of the tx or recipients of payments of the multi send tx
+func txRecipients(tx *types.Transaction) []common.Address {
+	if tx.Type == types.MultiSendTx {
+		attachment := attachments.ParseMultiSendAttachment(tx)
+		if attachment == nil {
+			return nil
+		}
+		recipients := make([]common.Address, 0, len(attachment.Payments))
+		for _, payment := range attachment.Payments {
+			recipients = append(recipients, payment.To)
+		}
+		return recipients
 	}
 	if tx.To != nil {
-		to := *tx.To
-		if sender == to {
-			return
+		return []common.Address{*tx.To}
+	}
+	return nil
+}
+
+// handleAddressTx saves the tx to the tx history of the sender and recipients, nil accountsMap means all accounts
+func (i *indexer) handleAddressTx(header *types.Header, sender common.Address, tx *types.Transaction, accountsMap map[common.Address]struct{}) {
+	indexed := func(addr common.Address) bool {
+		if accountsMap == nil {
+			return true
 		}
-		if _, ok := accountsMap[to]; ok {
+		_, ok := accountsMap[addr]
+		return ok
+	}
+	if indexed(sender) {
+		i.repo.SaveTx(sender, header.Hash(), header.Time(), header.FeePerGas(), tx)
+	}
+	for _, to := range txRecipients(tx) {
+		if to != sender && indexed(to) {
 			i.repo.SaveTx(to, header.Hash(), header.Time(), header.FeePerGas(), tx)
 		}
 	}
@@ -4,7 +4,9 @@ import (
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/tests"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -48,7 +50,7 @@ func TestBlockchain_saveOwnTxs(t *testing.T) {
 		chain.indexer.HandleBlockTransactions(header, []*types.Transaction{item.tx})
 	}
 
-	data, token := chain.ReadTxs(addr, 5, nil)
+	data, token := chain.ReadTxs(addr, 5, nil, nil)
 
 	require.Equal(5, len(data))
 	require.Equal(uint32(2), data[0].Tx.AccountNonce)
@@ -59,7 +61,7 @@ func TestBlockchain_saveOwnTxs(t *testing.T) {
 	require.Equal(int64(456), data[4].Timestamp)
 	require.NotNil(token)
 
-	data, token = chain.ReadTxs(addr, 4, token)
+	data, token = chain.ReadTxs(addr, 4, token, nil)
 
 	require.Equal(4, len(data))
 	require.Equal(uint32(10), data[0].Tx.AccountNonce)
@@ -68,7 +70,7 @@ func TestBlockchain_saveOwnTxs(t *testing.T) {
 	require.Equal(uint32(7), data[3].Tx.AccountNonce)
 	require.NotNil(token)
 
-	data, token = chain.ReadTxs(addr, 10, token)
+	data, token = chain.ReadTxs(addr, 10, token, nil)
 
 	require.Equal(6, len(data))
 	require.Equal(uint32(6), data[0].Tx.AccountNonce)
@@ -124,22 +126,89 @@ func Test_handleOwnTxsWithAccounts(t *testing.T) {
 			},
 		}
 		sender, _ := types.Sender(item.tx)
-		chain.indexer.handleOwnTx(header, sender, item.tx, accountsMap)
+		chain.indexer.handleAddressTx(header, sender, item.tx, accountsMap)
 	}
 
-	data, _ := chain.ReadTxs(addr1, 10, nil)
+	data, _ := chain.ReadTxs(addr1, 10, nil, nil)
 	require.Equal(4, len(data))
 
-	data, _ = chain.ReadTxs(addr2, 10, nil)
+	data, _ = chain.ReadTxs(addr2, 10, nil, nil)
 	require.Equal(0, len(data))
 
-	data, _ = chain.ReadTxs(addr3, 10, nil)
+	data, _ = chain.ReadTxs(addr3, 10, nil, nil)
 	require.Equal(5, len(data))
 
-	data, _ = chain.ReadTxs(addr4, 10, nil)
+	data, _ = chain.ReadTxs(addr4, 10, nil, nil)
 	require.Equal(0, len(data))
 }
 
+func TestBlockchain_readTxsWithFilter(t *testing.T) {
+	require := require.New(t)
+
+	chain, _, _, key := NewTestBlockchain(true, nil)
+	chain.config.Blockchain.IndexTxHistory = true
+	key2, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	addr2 := crypto.PubkeyToAddress(key2.PublicKey)
+
+	txs := []txWithTimestamp{
+		{tx: tests.GetFullTx(1, 1, key, types.SendTx, nil, &addr2, nil), timestamp: 10},
+		{tx: tests.GetFullTx(2, 1, key, types.BurnTx, nil, nil, nil), timestamp: 20},
+		{tx: tests.GetFullTx(3, 1, key, types.SendTx, nil, &addr2, nil), timestamp: 30},
+		{tx: tests.GetFullTx(1, 1, key2, types.SendTx, nil, &addr, nil), timestamp: 40},
+		{tx: tests.GetFullTx(4, 1, key, types.SendTx, nil, &addr2, nil), timestamp: 50},
+	}
+	for _, item := range txs {
+		header := &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Time:      item.timestamp,
+				FeePerGas: big.NewInt(1),
+			},
+		}
+		chain.indexer.HandleBlockTransactions(header, []*types.Transaction{item.tx})
+	}
+
+	// txs of not own addresses are indexed too
+	data, _ := chain.ReadTxs(addr2, 10, nil, nil)
+	require.Equal(4, len(data))
+
+	sendTxs := &database.TxFilter{Types: map[types.TxType]struct{}{types.SendTx: {}}}
+	data, token := chain.ReadTxs(addr, 2, nil, sendTxs)
+	require.Equal(2, len(data))
+	require.Equal(int64(50), data[0].Timestamp)
+	require.Equal(int64(40), data[1].Timestamp)
+	require.NotNil(token)
+
+	data, token = chain.ReadTxs(addr, 2, token, sendTxs)
+	require.Equal(2, len(data))
+	require.Equal(int64(30), data[0].Timestamp)
+	require.Equal(int64(10), data[1].Timestamp)
+	require.Nil(token)
+
+	data, token = chain.ReadTxs(addr, 10, nil, &database.TxFilter{FromTime: 20, ToTime: 40})
+	require.Equal(3, len(data))
+	require.Equal(int64(40), data[0].Timestamp)
+	require.Equal(int64(20), data[2].Timestamp)
+	require.Nil(token)
+
+	data, _ = chain.ReadTxs(addr, 10, nil, &database.TxFilter{FromTime: 40, ToTime: 20})
+	require.Empty(data)
+}
+
+func Test_indexedAccounts(t *testing.T) {
+	require := require.New(t)
+
+	chain, _, _, key := NewTestBlockchain(true, nil)
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	require.Contains(chain.indexer.indexedAccounts(), addr)
+
+	chain.config.Blockchain.IndexTxHistory = true
+	require.Nil(chain.indexer.indexedAccounts())
+
+	chain.config.Sync = &config.SyncConfig{ValidationOnly: true}
+	require.Contains(chain.indexer.indexedAccounts(), addr)
+}
+
 func Test_Blockchain_saveBurntCoins(t *testing.T) {
 	require := require.New(t)
 
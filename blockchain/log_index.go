@@ -0,0 +1,227 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/pkg/errors"
+)
+
+// LogFilter selects contract events of the block range. Events match if they are emitted by one of the addresses and
+// have one of the names, an empty list matches any value. Topics are matched against event args by position,
+// an empty position matches any arg.
+type LogFilter struct {
+	FromBlock uint64
+	ToBlock   uint64
+	Addresses []common.Address
+	Events    []string
+	Topics    [][][]byte
+}
+
+// Log is a contract event with its position in the chain
+type Log struct {
+	BlockHeight uint64
+	BlockHash   common.Hash
+	TxHash      common.Hash
+	Index       uint32
+	Contract    common.Address
+	Event       *types.TxEvent
+}
+
+func (f *LogFilter) testBloom(bloom *types.LogBloom) bool {
+	testAny := func(items [][]byte) bool {
+		if len(items) == 0 {
+			return true
+		}
+		for _, item := range items {
+			if bloom.Test(item) {
+				return true
+			}
+		}
+		return false
+	}
+	addresses := make([][]byte, 0, len(f.Addresses))
+	for _, addr := range f.Addresses {
+		addresses = append(addresses, addr.Bytes())
+	}
+	events := make([][]byte, 0, len(f.Events))
+	for _, e := range f.Events {
+		events = append(events, []byte(e))
+	}
+	if !testAny(addresses) || !testAny(events) {
+		return false
+	}
+	for _, topics := range f.Topics {
+		if !testAny(topics) {
+			return false
+		}
+	}
+	return true
+}
+
+func (f *LogFilter) matches(contract common.Address, event *types.TxEvent) bool {
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addr := range f.Addresses {
+			if addr == contract {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Events) > 0 {
+		found := false
+		for _, name := range f.Events {
+			if name == event.EventName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		if i >= len(event.Data) {
+			return false
+		}
+		found := false
+		for _, topic := range topics {
+			if string(topic) == string(event.Data[i]) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// BlockLogs returns contract events of the block matching the filter, the block range of the filter is ignored
+func (chain *Blockchain) BlockLogs(header *types.Header, filter *LogFilter) ([]*Log, error) {
+	receipts, err := chain.GetBlockReceipts(header)
+	if err != nil {
+		return nil, err
+	}
+	if bloom := types.CreateLogBloom(receipts); bloom == nil || !filter.testBloom(bloom) {
+		return nil, nil
+	}
+	return filterLogs(header, receipts, filter), nil
+}
+
+func filterLogs(header *types.Header, receipts types.TxReceipts, filter *LogFilter) []*Log {
+	var result []*Log
+	for _, r := range receipts {
+		if r == nil {
+			continue
+		}
+		for i, e := range r.Events {
+			if !filter.matches(r.ContractAddress, e) {
+				continue
+			}
+			result = append(result, &Log{
+				BlockHeight: header.Height(),
+				BlockHash:   header.Hash(),
+				TxHash:      r.TxHash,
+				Index:       uint32(i),
+				Contract:    r.ContractAddress,
+				Event:       e,
+			})
+		}
+	}
+	return result
+}
+
+// GetLogs returns contract events of canonical blocks of the filter range matching the filter. Blocks are selected
+// by the log bloom index, so only blocks with events which may match are read. Reading stops as soon as
+// the limit of events is exceeded.
+func (chain *Blockchain) GetLogs(filter *LogFilter, limit int) ([]*Log, error) {
+	fromBlock, toBlock := filter.FromBlock, filter.ToBlock
+	if fromBlock == 0 {
+		// the genesis block has no events
+		fromBlock = 1
+	}
+	if indexedFrom, ok := chain.repo.ReadLogBloomIndexedFrom(); !ok || fromBlock < indexedFrom {
+		return nil, errors.New("log index of the requested blocks is not built yet")
+	}
+	if head := chain.Head.Height(); toBlock > head {
+		toBlock = head
+	}
+	if fromBlock > toBlock {
+		return nil, nil
+	}
+	var result []*Log
+	var err error
+	chain.repo.IterateLogBlooms(fromBlock, toBlock, func(height uint64, bloom *types.LogBloom) bool {
+		if !filter.testBloom(bloom) {
+			return false
+		}
+		header := chain.GetBlockHeaderByHeight(height)
+		if header == nil || header.EmptyBlockHeader != nil {
+			return false
+		}
+		var receipts types.TxReceipts
+		if receipts, err = chain.readTxReceipts(header.ProposedHeader.TxReceiptsCid); err != nil {
+			return true
+		}
+		result = append(result, filterLogs(header, receipts, filter)...)
+		if len(result) > limit {
+			err = errors.Errorf("query returned more than %v events, narrow the block range", limit)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (chain *Blockchain) readTxReceipts(cid []byte) (types.TxReceipts, error) {
+	data, err := chain.ipfs.Get(cid, ipfs.TxReceipt)
+	if err != nil {
+		return nil, err
+	}
+	return types.TxReceipts{}.FromBytes(data), nil
+}
+
+func (chain *Blockchain) writeLogBloom(height uint64, receipts types.TxReceipts) {
+	if bloom := types.CreateLogBloom(receipts); bloom != nil {
+		chain.repo.WriteLogBloom(height, bloom)
+	}
+}
+
+// indexLogBlooms adds blocks imported before the log bloom index was introduced to the index
+func (chain *Blockchain) indexLogBlooms(head uint64) {
+	chain.backfill(head, backfillIndex{
+		name:       "contract events",
+		readFrom:   chain.repo.ReadLogBloomIndexedFrom,
+		writeFrom:  chain.repo.WriteLogBloomIndexedFrom,
+		indexBlock: chain.indexBlockLogBloom,
+	})
+}
+
+func (chain *Blockchain) indexBlockLogBloom(height uint64) (bool, error) {
+	header := chain.GetBlockHeaderByHeight(height)
+	if header == nil || header.ProposedHeader == nil || len(header.ProposedHeader.TxReceiptsCid) == 0 {
+		return false, nil
+	}
+	receipts, err := chain.readTxReceipts(header.ProposedHeader.TxReceiptsCid)
+	if err != nil {
+		return false, err
+	}
+	bloom := types.CreateLogBloom(receipts)
+	if bloom == nil {
+		return false, nil
+	}
+	chain.repo.WriteLogBloom(height, bloom)
+	return true, nil
+}
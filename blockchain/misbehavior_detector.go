@@ -0,0 +1,249 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/fee"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/secstore"
+	"github.com/shopspring/decimal"
+	"math/big"
+	"sync"
+	"time"
+)
+
+const (
+	MisbehaviorRoundsLag  = 5
+	MaxMisbehaviorRecords = 100
+)
+
+type MisbehaviorType string
+
+const (
+	ConflictingVotes     MisbehaviorType = "conflictingVotes"
+	ConflictingProposals MisbehaviorType = "conflictingProposals"
+)
+
+// MisbehaviorEvidence describes two conflicting consensus messages signed by the same identity
+type MisbehaviorEvidence struct {
+	Type     MisbehaviorType
+	Offender common.Address
+	Round    uint64
+	Step     uint8
+	Hashes   [2]common.Hash
+	Votes    [2]*types.Vote
+	Time     time.Time
+	TxHash   *common.Hash
+}
+
+type misbehaviorVoteKey struct {
+	round uint64
+	step  uint8
+	voter common.Address
+}
+
+type misbehaviorProposalKey struct {
+	round    uint64
+	proposer common.Address
+}
+
+// MisbehaviorDetector watches votes and proposals for double signing and broadcasts evidence txs for conflicting votes
+type MisbehaviorDetector struct {
+	config   *config.Config
+	appState *appstate.AppState
+	txpool   mempool.TransactionPool
+	secStore *secstore.SecStore
+	bus      eventbus.Bus
+	log      log.Logger
+
+	votes     map[misbehaviorVoteKey]*types.Vote
+	proposals map[misbehaviorProposalKey]common.Hash
+	evidence  []*MisbehaviorEvidence
+	reported  map[common.Address]uint64
+	head      uint64
+	mutex     sync.Mutex
+}
+
+func NewMisbehaviorDetector(config *config.Config, appState *appstate.AppState, txpool mempool.TransactionPool, secStore *secstore.SecStore, bus eventbus.Bus) *MisbehaviorDetector {
+	return &MisbehaviorDetector{
+		config:    config,
+		appState:  appState,
+		txpool:    txpool,
+		secStore:  secStore,
+		bus:       bus,
+		log:       log.New("component", "misbehavior"),
+		votes:     make(map[misbehaviorVoteKey]*types.Vote),
+		proposals: make(map[misbehaviorProposalKey]common.Hash),
+		reported:  make(map[common.Address]uint64),
+	}
+}
+
+func (md *MisbehaviorDetector) Start(head *types.Header) {
+	md.head = head.Height()
+	_ = md.bus.Subscribe(events.AddBlockEventID,
+		func(e eventbus.Event) {
+			block := e.(*events.NewBlockEvent).Block
+			md.processBlock(block)
+		})
+}
+
+func (md *MisbehaviorDetector) processBlock(block *types.Block) {
+	md.mutex.Lock()
+	defer md.mutex.Unlock()
+	md.head = block.Height()
+	if md.head <= MisbehaviorRoundsLag {
+		return
+	}
+	minRound := md.head - MisbehaviorRoundsLag
+	for key := range md.votes {
+		if key.round < minRound {
+			delete(md.votes, key)
+		}
+	}
+	for key := range md.proposals {
+		if key.round < minRound {
+			delete(md.proposals, key)
+		}
+	}
+}
+
+func (md *MisbehaviorDetector) ProcessVote(vote *types.Vote) {
+	if !md.config.Consensus.EnableMisbehaviorEvidence {
+		return
+	}
+	key := misbehaviorVoteKey{
+		round: vote.Header.Round,
+		step:  vote.Header.Step,
+		voter: vote.VoterAddr(),
+	}
+	md.mutex.Lock()
+	if md.head > MisbehaviorRoundsLag && key.round < md.head-MisbehaviorRoundsLag {
+		md.mutex.Unlock()
+		return
+	}
+	prev, ok := md.votes[key]
+	if !ok {
+		md.votes[key] = vote
+		md.mutex.Unlock()
+		return
+	}
+	if prev.Header.ParentHash != vote.Header.ParentHash || prev.Header.VotedHash == vote.Header.VotedHash {
+		md.mutex.Unlock()
+		return
+	}
+	if round, ok := md.reported[key.voter]; ok && round >= key.round {
+		md.mutex.Unlock()
+		return
+	}
+	md.reported[key.voter] = key.round
+	evidence := &MisbehaviorEvidence{
+		Type:     ConflictingVotes,
+		Offender: key.voter,
+		Round:    key.round,
+		Step:     key.step,
+		Hashes:   [2]common.Hash{prev.Header.VotedHash, vote.Header.VotedHash},
+		Votes:    [2]*types.Vote{prev, vote},
+		Time:     time.Now().UTC(),
+	}
+	md.addEvidence(evidence)
+	md.mutex.Unlock()
+
+	md.log.Warn("Conflicting votes detected", "offender", key.voter.Hex(), "round", key.round, "step", key.step)
+	go md.submit(evidence)
+}
+
+// ProcessProposal records conflicting proposals of the same round, block signatures cover whole blocks,
+// so such evidence is kept locally and is not packaged into a tx
+func (md *MisbehaviorDetector) ProcessProposal(block *types.Block) {
+	if !md.config.Consensus.EnableMisbehaviorEvidence || block.IsEmpty() {
+		return
+	}
+	proposer, err := crypto.PubKeyBytesToAddress(block.Header.ProposedHeader.ProposerPubKey)
+	if err != nil {
+		return
+	}
+	key := misbehaviorProposalKey{
+		round:    block.Height(),
+		proposer: proposer,
+	}
+	md.mutex.Lock()
+	defer md.mutex.Unlock()
+	prev, ok := md.proposals[key]
+	if !ok {
+		md.proposals[key] = block.Hash()
+		return
+	}
+	if prev == block.Hash() {
+		return
+	}
+	md.addEvidence(&MisbehaviorEvidence{
+		Type:     ConflictingProposals,
+		Offender: proposer,
+		Round:    key.round,
+		Hashes:   [2]common.Hash{prev, block.Hash()},
+		Time:     time.Now().UTC(),
+	})
+	md.log.Warn("Conflicting proposals detected", "offender", proposer.Hex(), "round", key.round)
+}
+
+func (md *MisbehaviorDetector) addEvidence(evidence *MisbehaviorEvidence) {
+	md.evidence = append(md.evidence, evidence)
+	if len(md.evidence) > MaxMisbehaviorRecords {
+		md.evidence = md.evidence[len(md.evidence)-MaxMisbehaviorRecords:]
+	}
+}
+
+func (md *MisbehaviorDetector) submit(evidence *MisbehaviorEvidence) {
+	if md.txpool.IsSyncing() {
+		return
+	}
+	appState, err := md.appState.Readonly(uint64(md.appState.State.Version()))
+	if err != nil {
+		md.log.Error("cannot create readonly app state", "err", err)
+		return
+	}
+	if err := validation.ValidateMisbehaviorEvidence(appState, evidence.Votes[0], evidence.Votes[1]); err != nil {
+		md.log.Debug("Misbehavior evidence is not submitted", "err", err)
+		return
+	}
+	payload := attachments.CreateMisbehaviorEvidenceAttachment(evidence.Votes[0], evidence.Votes[1])
+	from := md.secStore.GetAddress()
+	tx := BuildTx(appState, from, nil, types.MisbehaviorEvidenceTx, decimal.Zero, decimal.Zero, decimal.Zero, 0, 0, payload)
+	txFee := fee.CalculateFee(appState.ValidatorsCache.NetworkSize(), appState.State.FeePerGas(), tx)
+	maxFee := ConvertToFloat(new(big.Int).Mul(txFee, big.NewInt(2)))
+	tx = BuildTx(appState, from, nil, types.MisbehaviorEvidenceTx, decimal.Zero, maxFee, decimal.Zero, 0, 0, payload)
+	signedTx, err := md.secStore.SignTx(tx)
+	if err != nil {
+		md.log.Error("cannot sign misbehavior evidence tx", "err", err)
+		return
+	}
+	if err := md.txpool.AddInternalTx(signedTx); err != nil {
+		md.log.Error("cannot send misbehavior evidence tx", "err", err)
+		return
+	}
+	hash := signedTx.Hash()
+	md.mutex.Lock()
+	evidence.TxHash = &hash
+	md.mutex.Unlock()
+	md.log.Info("Broadcast misbehavior evidence tx", "hash", hash.Hex(), "offender", evidence.Offender.Hex())
+}
+
+// Evidence returns the recently detected misbehavior, the most recent first
+func (md *MisbehaviorDetector) Evidence() []*MisbehaviorEvidence {
+	md.mutex.Lock()
+	defer md.mutex.Unlock()
+	result := make([]*MisbehaviorEvidence, 0, len(md.evidence))
+	for i := len(md.evidence) - 1; i >= 0; i-- {
+		e := *md.evidence[i]
+		result = append(result, &e)
+	}
+	return result
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/secstore"
 	dbm "github.com/tendermint/tm-db"
 	"sync"
@@ -38,6 +39,9 @@ type OfflineDetector struct {
 	startTime               time.Time
 	selfAddress             common.Address
 	offlineCommitteeMaxSize int
+	peersCountFn            func() int
+	partition               *partitionDetector
+	partitionGraceEnd       time.Time
 }
 
 type voteList struct {
@@ -58,9 +62,14 @@ func NewOfflineDetector(config *config.Config, db dbm.DB, appState *appstate.App
 		startTime:               time.Now().UTC(),
 		secStore:                secStore,
 		offlineCommitteeMaxSize: config.Consensus.MaxCommitteeSize * 3,
+		partition:               newPartitionDetector(config.OfflineDetection.PartitionPeersDropRate),
 	}
 }
 
+func (dt *OfflineDetector) ProvidePeersCountFunc(fn func() int) {
+	dt.peersCountFn = fn
+}
+
 func (dt *OfflineDetector) Start(head *types.Header) {
 	dt.selfAddress = dt.secStore.GetAddress()
 	dt.lastPersistBlock = head.Height()
@@ -76,6 +85,9 @@ func (dt *OfflineDetector) Start(head *types.Header) {
 		})
 
 	go dt.startListening()
+	if dt.peersCountFn != nil {
+		go dt.watchPeers()
+	}
 }
 
 func (dt *OfflineDetector) ProcessVote(vote *types.Vote) {
@@ -101,6 +113,10 @@ func (dt *OfflineDetector) VoteForOffline(block *types.Block) bool {
 		return false
 	}
 
+	if dt.inGracePeriod() {
+		return false
+	}
+
 	if dt.appState.State.ValidationPeriod() != state.NonePeriod {
 		return false
 	}
@@ -177,6 +193,10 @@ func (dt *OfflineDetector) ProposeOffline(head *types.Header) (*common.Address,
 		return nil, 0
 	}
 
+	if dt.inGracePeriod() {
+		return nil, 0
+	}
+
 	dt.mutex.Lock()
 	defer dt.mutex.Unlock()
 
@@ -218,6 +238,44 @@ func (dt *OfflineDetector) ProposeOffline(head *types.Header) (*common.Address,
 	return nil, 0
 }
 
+// inGracePeriod returns true if the activity of identities may be unknown to the node since it was restarted recently
+// or is partitioned from the network
+func (dt *OfflineDetector) inGracePeriod() bool {
+	now := time.Now().UTC()
+	if now.Sub(dt.startTime) < dt.config.RestartGracePeriod {
+		return true
+	}
+	dt.mutex.Lock()
+	defer dt.mutex.Unlock()
+	return dt.partition.partitioned() || now.Before(dt.partitionGraceEnd)
+}
+
+func (dt *OfflineDetector) watchPeers() {
+	for {
+		time.Sleep(peersCheckInterval)
+		dt.checkPartition(dt.peersCountFn(), time.Now().UTC())
+	}
+}
+
+func (dt *OfflineDetector) checkPartition(peers int, now time.Time) {
+	dt.mutex.Lock()
+	defer dt.mutex.Unlock()
+	wasPartitioned := dt.partition.partitioned()
+	finished, duration := dt.partition.update(peers, now)
+	if !wasPartitioned && dt.partition.partitioned() {
+		log.Warn("Node is partitioned from the network, offline detection is paused", "peers", peers)
+	}
+	if !finished {
+		return
+	}
+	log.Info("Network partition is over", "duration", duration, "peers", peers)
+	// activity of identities wasn't observed during the partition
+	for addr, activityTime := range dt.activityMap {
+		dt.activityMap[addr] = activityTime.Add(duration)
+	}
+	dt.partitionGraceEnd = now.Add(dt.config.PartitionGracePeriod)
+}
+
 func (dt *OfflineDetector) GetActivityMap() map[common.Address]time.Time {
 	dt.mutex.Lock()
 	defer dt.mutex.Unlock()
@@ -0,0 +1,64 @@
+package blockchain
+
+import (
+	"time"
+)
+
+const (
+	peersCheckInterval   = time.Second * 10
+	peersBaselineSamples = 6
+)
+
+// partitionDetector considers the node partitioned from the network when it loses the given share of peers
+// compared to the maximal peers count of the last samples, the partition is over when the peers are back
+type partitionDetector struct {
+	dropRate       float64
+	samples        []int
+	partitionStart time.Time
+}
+
+func newPartitionDetector(dropRate float64) *partitionDetector {
+	return &partitionDetector{
+		dropRate: dropRate,
+	}
+}
+
+func (p *partitionDetector) partitioned() bool {
+	return !p.partitionStart.IsZero()
+}
+
+func (p *partitionDetector) baseline() int {
+	result := 0
+	for _, sample := range p.samples {
+		if sample > result {
+			result = sample
+		}
+	}
+	return result
+}
+
+// update adds the sample of the peers count and returns the duration of the partition if it is over
+func (p *partitionDetector) update(peers int, now time.Time) (finished bool, duration time.Duration) {
+	if p.dropRate <= 0 {
+		return false, 0
+	}
+	baseline := p.baseline()
+	lost := baseline > 0 && (peers == 0 || float64(peers) < float64(baseline)*(1-p.dropRate))
+	if p.partitioned() {
+		if lost {
+			return false, 0
+		}
+		duration = now.Sub(p.partitionStart)
+		p.partitionStart = time.Time{}
+		finished = true
+	} else if lost {
+		p.partitionStart = now
+		return false, 0
+	}
+	// the baseline is frozen during the partition
+	p.samples = append(p.samples, peers)
+	if len(p.samples) > peersBaselineSamples {
+		p.samples = p.samples[len(p.samples)-peersBaselineSamples:]
+	}
+	return finished, duration
+}
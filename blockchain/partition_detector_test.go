@@ -0,0 +1,47 @@
+package blockchain
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestPartitionDetector_Update(t *testing.T) {
+	detector := newPartitionDetector(0.5)
+	now := time.Now()
+
+	for _, peers := range []int{8, 10, 9} {
+		finished, _ := detector.update(peers, now)
+		require.False(t, finished)
+		require.False(t, detector.partitioned())
+	}
+
+	// the drop is compared with the maximal count of the last samples
+	detector.update(6, now)
+	require.False(t, detector.partitioned())
+	detector.update(4, now)
+	require.True(t, detector.partitioned())
+
+	finished, _ := detector.update(3, now.Add(time.Minute))
+	require.False(t, finished)
+	require.True(t, detector.partitioned())
+
+	finished, duration := detector.update(5, now.Add(time.Minute*3))
+	require.True(t, finished)
+	require.Equal(t, time.Minute*3, duration)
+	require.False(t, detector.partitioned())
+
+	// the loss of the only peer is a partition
+	detector = newPartitionDetector(0.5)
+	for i := 0; i < peersBaselineSamples; i++ {
+		detector.update(1, now)
+	}
+	require.Equal(t, 1, detector.baseline())
+	detector.update(0, now)
+	require.True(t, detector.partitioned())
+
+	disabled := newPartitionDetector(0)
+	disabled.update(10, now)
+	disabled.update(0, now)
+	require.False(t, disabled.partitioned())
+}
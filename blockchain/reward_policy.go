@@ -0,0 +1,189 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/shopspring/decimal"
+	"math/big"
+	"sort"
+	"sync"
+)
+
+// BlockRewardContext contains everything a reward policy needs to reward a block
+type BlockRewardContext struct {
+	AppState  *appstate.AppState
+	Consensus *config.ConsensusConf
+	Block     *types.Block
+	TotalFee  *big.Int
+	TotalTips *big.Int
+	// members of the final committee of the block, empty for empty blocks
+	FinalCommittee []common.Address
+}
+
+// ValidationRewardContext contains everything a reward policy needs to reward a finished validation
+type ValidationRewardContext struct {
+	AppState          *appstate.AppState
+	Consensus         *config.ConsensusConf
+	ValidationResults *types.ValidationResults
+	EpochDurations    []uint32
+	Seed              types.Seed
+}
+
+// RewardPolicy calculates and applies rewards minted by the chain. Policies are selected by the consensus version,
+// so a new reward formula is registered for the version which activates it and old blocks keep their rewards.
+type RewardPolicy interface {
+	// ApplyBlockRewards rewards the block proposer and the final committee
+	ApplyBlockRewards(ctx *BlockRewardContext, statsCollector collector.StatsCollector)
+	// ApplyValidationRewards rewards identities for the finished validation
+	ApplyValidationRewards(ctx *ValidationRewardContext, statsCollector collector.StatsCollector)
+}
+
+var (
+	rewardPolicies       = map[config.ConsensusVerson]RewardPolicy{}
+	rewardPolicyVersions []config.ConsensusVerson
+	rewardPoliciesMutex  sync.RWMutex
+)
+
+func init() {
+	RegisterRewardPolicy(config.ConsensusV3, &defaultRewardPolicy{})
+}
+
+// RegisterRewardPolicy sets the policy used since the consensus version until a policy of a newer version is registered
+func RegisterRewardPolicy(version config.ConsensusVerson, policy RewardPolicy) {
+	rewardPoliciesMutex.Lock()
+	defer rewardPoliciesMutex.Unlock()
+	if _, ok := rewardPolicies[version]; !ok {
+		rewardPolicyVersions = append(rewardPolicyVersions, version)
+		sort.Slice(rewardPolicyVersions, func(i, j int) bool {
+			return rewardPolicyVersions[i] < rewardPolicyVersions[j]
+		})
+	}
+	rewardPolicies[version] = policy
+}
+
+// GetRewardPolicy returns the policy of the latest registered version which is not greater than the given one
+func GetRewardPolicy(version config.ConsensusVerson) RewardPolicy {
+	rewardPoliciesMutex.RLock()
+	defer rewardPoliciesMutex.RUnlock()
+	for i := len(rewardPolicyVersions) - 1; i >= 0; i-- {
+		if rewardPolicyVersions[i] <= version {
+			return rewardPolicies[rewardPolicyVersions[i]]
+		}
+	}
+	return rewardPolicies[rewardPolicyVersions[0]]
+}
+
+func (chain *Blockchain) rewardPolicy() RewardPolicy {
+	return GetRewardPolicy(chain.config.Consensus.Version)
+}
+
+// defaultRewardPolicy splits block reward and fees between the proposer and the final committee
+// and distributes the validation reward of the epoch according to the consensus config
+type defaultRewardPolicy struct {
+}
+
+func (p *defaultRewardPolicy) ApplyBlockRewards(ctx *BlockRewardContext, statsCollector collector.StatsCollector) {
+	p.rewardProposer(ctx, statsCollector)
+	p.rewardFinalCommittee(ctx, statsCollector)
+}
+
+func (p *defaultRewardPolicy) rewardProposer(ctx *BlockRewardContext, statsCollector collector.StatsCollector) {
+	appState, conf := ctx.AppState, ctx.Consensus
+
+	// calculate fee reward
+	burnFee := decimal.NewFromBigInt(ctx.TotalFee, 0)
+	burnFee = burnFee.Mul(decimal.NewFromFloat32(conf.FeeBurnRate))
+	intBurn := math.ToInt(burnFee)
+	intFeeReward := new(big.Int)
+	intFeeReward.Sub(ctx.TotalFee, intBurn)
+
+	totalReward := big.NewInt(0).Add(conf.BlockReward, intFeeReward)
+	totalReward.Add(totalReward, ctx.TotalTips)
+
+	coinbase := ctx.Block.Header.Coinbase()
+
+	status := appState.State.GetIdentityState(coinbase)
+	stakeDest := coinbase
+
+	if appState.ValidatorsCache.IsPool(coinbase) {
+		delegationNonce := appState.State.GetIdentity(coinbase).DelegationNonce
+		subIdentity, newNonce := appState.ValidatorsCache.FindSubIdentity(coinbase, delegationNonce)
+		appState.State.SetDelegationNonce(coinbase, newNonce)
+
+		status = appState.State.GetIdentityState(subIdentity)
+		stakeDest = subIdentity
+	}
+
+	reward, stake := splitReward(totalReward, status == state.Newbie, conf)
+
+	// calculate penalty
+	balanceAdd, stakeAdd, penaltySub := calculatePenalty(reward, stake, appState.State.GetPenalty(coinbase))
+
+	collector.BeginProposerRewardBalanceUpdate(statsCollector, coinbase, stakeDest, appState)
+	// update state
+	appState.State.AddBalance(coinbase, balanceAdd)
+	appState.State.AddStake(stakeDest, stakeAdd)
+	if penaltySub != nil {
+		appState.State.SubPenalty(coinbase, penaltySub)
+	}
+	collector.CompleteBalanceUpdate(statsCollector, appState)
+	collector.AddMintedCoins(statsCollector, conf.BlockReward)
+	collector.AfterAddStake(statsCollector, stakeDest, stake, appState)
+	collector.AfterSubPenalty(statsCollector, coinbase, penaltySub, appState)
+	collector.AddPenaltyBurntCoins(statsCollector, coinbase, penaltySub)
+	collector.AddProposerReward(statsCollector, coinbase, stakeDest, reward, stake)
+}
+
+func (p *defaultRewardPolicy) rewardFinalCommittee(ctx *BlockRewardContext, statsCollector collector.StatsCollector) {
+	if len(ctx.FinalCommittee) == 0 {
+		return
+	}
+	appState, conf := ctx.AppState, ctx.Consensus
+	totalReward := big.NewInt(0)
+	totalReward.Div(conf.FinalCommitteeReward, big.NewInt(int64(len(ctx.FinalCommittee))))
+	collector.SetCommitteeRewardShare(statsCollector, totalReward)
+
+	reward, stake := splitReward(totalReward, false, conf)
+	newbieReward, newbieStake := splitReward(totalReward, true, conf)
+
+	for _, addr := range ctx.FinalCommittee {
+		identityState := appState.State.GetIdentityState(addr)
+		r, s := reward, stake
+		if identityState == state.Newbie {
+			r, s = newbieReward, newbieStake
+		}
+
+		penaltySource := addr
+		balanceDest := addr
+		if delegator := appState.ValidatorsCache.Delegator(addr); !delegator.IsEmpty() {
+			penaltySource = delegator
+			balanceDest = delegator
+		}
+		// calculate penalty
+		balanceAdd, stakeAdd, penaltySub := calculatePenalty(r, s, appState.State.GetPenalty(penaltySource))
+
+		collector.BeginCommitteeRewardBalanceUpdate(statsCollector, balanceDest, addr, appState)
+		// update state
+		appState.State.AddBalance(balanceDest, balanceAdd)
+		appState.State.AddStake(addr, stakeAdd)
+		if penaltySub != nil {
+			appState.State.SubPenalty(penaltySource, penaltySub)
+		}
+		collector.CompleteBalanceUpdate(statsCollector, appState)
+		collector.AddMintedCoins(statsCollector, r)
+		collector.AddMintedCoins(statsCollector, s)
+		collector.AfterAddStake(statsCollector, addr, s, appState)
+		collector.AfterSubPenalty(statsCollector, penaltySource, penaltySub, appState)
+		collector.AddPenaltyBurntCoins(statsCollector, penaltySource, penaltySub)
+		collector.AddFinalCommitteeReward(statsCollector, balanceDest, addr, r, s)
+	}
+}
+
+func (p *defaultRewardPolicy) ApplyValidationRewards(ctx *ValidationRewardContext, statsCollector collector.StatsCollector) {
+	rewardValidIdentities(ctx.AppState, ctx.Consensus, ctx.ValidationResults, ctx.EpochDurations, ctx.Seed, statsCollector)
+}
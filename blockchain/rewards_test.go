@@ -8,6 +8,7 @@ import (
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
@@ -243,3 +244,51 @@ func Test_getInvitationRewardCoef(t *testing.T) {
 	coef = getInvitationRewardCoef(3, 1000, []uint32{200, 100, 90}, consensusConf)
 	require.Equal(t, float32(2.0), coef)
 }
+
+type testRewardPolicy struct {
+	defaultRewardPolicy
+	applied int
+}
+
+func (p *testRewardPolicy) ApplyBlockRewards(ctx *BlockRewardContext, statsCollector collector.StatsCollector) {
+	p.applied++
+}
+
+func Test_RewardPolicySelection(t *testing.T) {
+	require := require.New(t)
+	defaultPolicy := GetRewardPolicy(config.ConsensusV3)
+	require.IsType(&defaultRewardPolicy{}, defaultPolicy)
+	require.Equal(defaultPolicy, GetRewardPolicy(config.ConsensusV6))
+
+	version := config.ConsensusV6 + 10
+	policy := &testRewardPolicy{}
+	RegisterRewardPolicy(version, policy)
+	defer func() {
+		delete(rewardPolicies, version)
+		rewardPolicyVersions = rewardPolicyVersions[:len(rewardPolicyVersions)-1]
+	}()
+
+	require.Equal(defaultPolicy, GetRewardPolicy(version-1))
+	require.Equal(policy, GetRewardPolicy(version))
+	require.Equal(policy, GetRewardPolicy(version+1))
+
+	chain, _, _, _ := NewTestBlockchain(false, nil)
+	prevVersion := chain.config.Consensus.Version
+	chain.config.Consensus.Version = version
+	defer func() {
+		chain.config.Consensus.Version = prevVersion
+	}()
+	block := &types.Block{
+		Header: &types.Header{
+			ProposedHeader: &types.ProposedHeader{
+				Height:         2,
+				ParentHash:     chain.Head.Hash(),
+				ProposerPubKey: chain.pubKey,
+			},
+		},
+		Body: &types.Body{},
+	}
+	appState, _ := chain.appState.ForCheck(1)
+	chain.applyBlockRewards(new(big.Int), new(big.Int), appState, block, chain.Head, nil)
+	require.Equal(1, policy.applied)
+}
@@ -0,0 +1,352 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+type RewardType string
+
+const (
+	ProposerReward       RewardType = "proposer"
+	FinalCommitteeReward RewardType = "finalCommittee"
+	ValidationReward     RewardType = "validation"
+	FlipsReward          RewardType = "flips"
+	ReportedFlipsReward  RewardType = "reportedFlips"
+	InvitationsReward    RewardType = "invitations"
+	FoundationPayout     RewardType = "foundationPayout"
+	ZeroWalletFund       RewardType = "zeroWalletFund"
+)
+
+type StateRead struct {
+	Object  state.TracedObject
+	Address common.Address
+	Key     []byte
+}
+
+type AccountTrace struct {
+	Address common.Address
+	Balance *big.Int
+	Nonce   uint32
+	Epoch   uint16
+}
+
+type IdentityTrace struct {
+	Address common.Address
+	State   state.IdentityState
+	Stake   *big.Int
+}
+
+type ContractValueTrace struct {
+	Address common.Address
+	Key     []byte
+	Value   []byte
+	Removed bool
+}
+
+// StateTrace describes state accesses, written accounts and identities are described by their resulting values
+type StateTrace struct {
+	Reads          []*StateRead
+	Accounts       []*AccountTrace
+	Identities     []*IdentityTrace
+	ContractValues []*ContractValueTrace
+}
+
+type TxTrace struct {
+	Hash    common.Hash
+	Fee     *big.Int
+	Receipt *types.TxReceipt
+	State   *StateTrace
+}
+
+type RewardTrace struct {
+	Type        RewardType
+	BalanceDest common.Address
+	StakeDest   common.Address
+	Balance     *big.Int
+	Stake       *big.Int
+}
+
+type BlockTrace struct {
+	Height uint64
+	Hash   common.Hash
+	Txs    []*TxTrace
+	// accesses made outside of txs, e.g. by rewards and penalties
+	State       *StateTrace
+	Rewards     []*RewardTrace
+	MintedCoins *big.Int
+}
+
+type stateAccess struct {
+	object state.TracedObject
+	addr   common.Address
+	key    string
+}
+
+type stateTraceBuilder struct {
+	trace      *StateTrace
+	reads      map[stateAccess]struct{}
+	accounts   []common.Address
+	identities []common.Address
+	written    map[stateAccess]struct{}
+}
+
+func newStateTraceBuilder() *stateTraceBuilder {
+	return &stateTraceBuilder{
+		trace:   &StateTrace{},
+		reads:   make(map[stateAccess]struct{}),
+		written: make(map[stateAccess]struct{}),
+	}
+}
+
+func (b *stateTraceBuilder) read(object state.TracedObject, addr common.Address, key []byte) {
+	access := stateAccess{object, addr, string(key)}
+	if _, ok := b.reads[access]; ok {
+		return
+	}
+	b.reads[access] = struct{}{}
+	b.trace.Reads = append(b.trace.Reads, &StateRead{
+		Object:  object,
+		Address: addr,
+		Key:     common.CopyBytes(key),
+	})
+}
+
+func (b *stateTraceBuilder) write(object state.TracedObject, addr common.Address, key []byte, value []byte) {
+	if object == state.TracedContractValue {
+		b.trace.ContractValues = append(b.trace.ContractValues, &ContractValueTrace{
+			Address: addr,
+			Key:     common.CopyBytes(key),
+			Value:   common.CopyBytes(value),
+			Removed: value == nil,
+		})
+		return
+	}
+	access := stateAccess{object: object, addr: addr}
+	if _, ok := b.written[access]; ok {
+		return
+	}
+	b.written[access] = struct{}{}
+	if object == state.TracedAccount {
+		b.accounts = append(b.accounts, addr)
+	} else {
+		b.identities = append(b.identities, addr)
+	}
+}
+
+func (b *stateTraceBuilder) complete(stateDb *state.StateDB) *StateTrace {
+	for _, addr := range b.accounts {
+		b.trace.Accounts = append(b.trace.Accounts, &AccountTrace{
+			Address: addr,
+			Balance: stateDb.GetBalance(addr),
+			Nonce:   stateDb.GetNonce(addr),
+			Epoch:   stateDb.GetEpoch(addr),
+		})
+	}
+	for _, addr := range b.identities {
+		b.trace.Identities = append(b.trace.Identities, &IdentityTrace{
+			Address: addr,
+			State:   stateDb.GetIdentityState(addr),
+			Stake:   stateDb.GetStakeBalance(addr),
+		})
+	}
+	return b.trace
+}
+
+// blockTracer collects the trace of block application. It is notified by the stats collector hooks about txs,
+// fees and rewards and by the state about reads and writes. Block application is sequential, so no locking is needed.
+type blockTracer struct {
+	collector.StatsCollector
+	trace *BlockTrace
+	block *stateTraceBuilder
+	tx    *stateTraceBuilder
+	// state accesses made by the tracer itself are ignored
+	muted bool
+}
+
+func newBlockTracer(block *types.Block) *blockTracer {
+	return &blockTracer{
+		StatsCollector: collector.NewStatsCollector(),
+		trace: &BlockTrace{
+			Height:      block.Height(),
+			Hash:        block.Hash(),
+			MintedCoins: new(big.Int),
+		},
+		block: newStateTraceBuilder(),
+	}
+}
+
+func (t *blockTracer) current() *stateTraceBuilder {
+	if t.tx != nil {
+		return t.tx
+	}
+	return t.block
+}
+
+func (t *blockTracer) currentTx() *TxTrace {
+	if t.tx == nil || len(t.trace.Txs) == 0 {
+		return nil
+	}
+	return t.trace.Txs[len(t.trace.Txs)-1]
+}
+
+func (t *blockTracer) StateRead(object state.TracedObject, addr common.Address, key []byte) {
+	if t.muted {
+		return
+	}
+	t.current().read(object, addr, key)
+}
+
+func (t *blockTracer) StateWritten(object state.TracedObject, addr common.Address, key []byte, value []byte) {
+	if t.muted {
+		return
+	}
+	t.current().write(object, addr, key, value)
+}
+
+func (t *blockTracer) BeginApplyingTx(tx *types.Transaction, appState *appstate.AppState) {
+	t.tx = newStateTraceBuilder()
+	t.trace.Txs = append(t.trace.Txs, &TxTrace{
+		Hash: tx.Hash(),
+	})
+}
+
+func (t *blockTracer) CompleteApplyingTx(appState *appstate.AppState) {
+	if txTrace := t.currentTx(); txTrace != nil {
+		t.muted = true
+		txTrace.State = t.tx.complete(appState.State)
+		t.muted = false
+	}
+	t.tx = nil
+}
+
+func (t *blockTracer) AddTxFee(feeAmount *big.Int) {
+	if txTrace := t.currentTx(); txTrace != nil {
+		txTrace.Fee = new(big.Int).Set(feeAmount)
+	}
+}
+
+func (t *blockTracer) addReward(rewardType RewardType, balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	t.trace.Rewards = append(t.trace.Rewards, &RewardTrace{
+		Type:        rewardType,
+		BalanceDest: balanceDest,
+		StakeDest:   stakeDest,
+		Balance:     copyOrZero(balance),
+		Stake:       copyOrZero(stake),
+	})
+}
+
+func (t *blockTracer) AddProposerReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	t.addReward(ProposerReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddFinalCommitteeReward(balanceDest, stakeDest common.Address, balance, stake *big.Int) {
+	t.addReward(FinalCommitteeReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddValidationReward(balanceDest, stakeDest common.Address, age uint16, balance, stake *big.Int) {
+	t.addReward(ValidationReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddFlipsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, flipsToReward []*types.FlipToReward) {
+	t.addReward(FlipsReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddReportedFlipsReward(balanceDest, stakeDest common.Address, flipIdx int, balance, stake *big.Int) {
+	t.addReward(ReportedFlipsReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddInvitationsReward(balanceDest, stakeDest common.Address, balance, stake *big.Int, age uint16,
+	txHash *common.Hash, epochHeight uint32, isSavedInviteWinner bool) {
+	t.addReward(InvitationsReward, balanceDest, stakeDest, balance, stake)
+}
+
+func (t *blockTracer) AddFoundationPayout(addr common.Address, balance *big.Int) {
+	t.addReward(FoundationPayout, addr, addr, balance, nil)
+}
+
+func (t *blockTracer) AddZeroWalletFund(addr common.Address, balance *big.Int) {
+	t.addReward(ZeroWalletFund, addr, addr, balance, nil)
+}
+
+func (t *blockTracer) AddMintedCoins(amount *big.Int) {
+	if amount != nil {
+		t.trace.MintedCoins.Add(t.trace.MintedCoins, amount)
+	}
+}
+
+func (t *blockTracer) complete(appState *appstate.AppState) *BlockTrace {
+	t.muted = true
+	t.trace.State = t.block.complete(appState.State)
+	t.muted = false
+	return t.trace
+}
+
+func copyOrZero(value *big.Int) *big.Int {
+	if value == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(value)
+}
+
+// TraceBlock re-executes the block on top of the state of its parent and returns the trace of execution
+func (chain *Blockchain) TraceBlock(height uint64) (*BlockTrace, error) {
+	if height == 0 || height > chain.Head.Height() {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	block := chain.GetBlockByHeight(height)
+	if block == nil {
+		return nil, errors.Errorf("block %v is not found", height)
+	}
+	prevBlock := chain.GetBlockHeaderByHeight(height - 1)
+	if prevBlock == nil {
+		return nil, errors.Errorf("block %v is not found", height-1)
+	}
+	checkState, err := chain.appState.ForCheck(height - 1)
+	if err != nil {
+		return nil, errors.Wrapf(err, "state of block %v is not available", height-1)
+	}
+	tracer := newBlockTracer(block)
+	checkState.State.SetTracer(tracer)
+	defer checkState.State.SetTracer(nil)
+	result, err := chain.validateBlock(checkState, block, prevBlock, tracer)
+	if err != nil {
+		return nil, errors.Wrapf(err, "block %v can't be re-executed", height)
+	}
+	trace := tracer.complete(checkState)
+	receipts := make(map[common.Hash]*types.TxReceipt, len(result.txReceipts))
+	for _, receipt := range result.txReceipts {
+		receipts[receipt.TxHash] = receipt
+	}
+	for _, txTrace := range trace.Txs {
+		txTrace.Receipt = receipts[txTrace.Hash]
+	}
+	return trace, nil
+}
+
+// TraceTx traces the block of the tx and returns the trace of the tx
+func (chain *Blockchain) TraceTx(hash common.Hash) (*TxTrace, error) {
+	idx := chain.GetTxIndex(hash)
+	if idx == nil {
+		return nil, errors.New("tx is not found")
+	}
+	block := chain.GetBlock(idx.BlockHash)
+	if block == nil {
+		return nil, errors.New("block of tx is not found")
+	}
+	trace, err := chain.TraceBlock(block.Height())
+	if err != nil {
+		return nil, err
+	}
+	for _, txTrace := range trace.Txs {
+		if txTrace.Hash == hash {
+			return txTrace, nil
+		}
+	}
+	return nil, errors.New("tx is not found in the trace of its block")
+}
@@ -0,0 +1,36 @@
+package blockchain
+
+import (
+	"github.com/pkg/errors"
+)
+
+// indexTxHistory adds txs of blocks imported before txs of all addresses were indexed to the tx history of addresses.
+// The progress is dropped while indexing of all addresses is disabled, since blocks imported meanwhile are not indexed.
+func (chain *Blockchain) indexTxHistory(head uint64) {
+	if chain.indexer.indexedAccounts() != nil {
+		chain.repo.RemoveTxHistoryIndexedFrom()
+		return
+	}
+	chain.backfill(head, backfillIndex{
+		name:       "tx history",
+		readFrom:   chain.repo.ReadTxHistoryIndexedFrom,
+		writeFrom:  chain.repo.WriteTxHistoryIndexedFrom,
+		indexBlock: chain.indexBlockTxHistory,
+	})
+}
+
+func (chain *Blockchain) indexBlockTxHistory(height uint64) (bool, error) {
+	header := chain.GetBlockHeaderByHeight(height)
+	if header == nil || header.EmptyBlockHeader != nil {
+		return false, nil
+	}
+	block := chain.GetBlock(header.Hash())
+	if block == nil {
+		return false, errors.New("block body is not available")
+	}
+	if len(block.Body.Transactions) == 0 {
+		return false, nil
+	}
+	chain.indexer.indexHistoricalTxs(block.Header, block.Body.Transactions)
+	return true, nil
+}
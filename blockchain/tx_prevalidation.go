@@ -0,0 +1,62 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/pkg/errors"
+	"runtime"
+	"sync"
+)
+
+const (
+	// blocks with fewer txs are prevalidated in the calling goroutine
+	minParallelPrevalidationTxs = 16
+)
+
+// prevalidateTxs recovers senders, calculates hashes and makes stateless checks of txs in parallel,
+// so sequential application of txs uses cached senders and hashes. The error of the first invalid tx is returned.
+func prevalidateTxs(txs []*types.Transaction) error {
+	workers := runtime.NumCPU()
+	if workers > len(txs) {
+		workers = len(txs)
+	}
+	if len(txs) < minParallelPrevalidationTxs || workers < 2 {
+		for i, tx := range txs {
+			if err := prevalidateTx(tx); err != nil {
+				return errors.Wrapf(err, "tx %v", i)
+			}
+		}
+		return nil
+	}
+
+	errs := make([]error, len(txs))
+	indexes := make(chan int, len(txs))
+	for i := range txs {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg := sync.WaitGroup{}
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				errs[i] = prevalidateTx(txs[i])
+			}
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return errors.Wrapf(err, "tx %v", i)
+		}
+	}
+	return nil
+}
+
+func prevalidateTx(tx *types.Transaction) error {
+	tx.Hash()
+	return validation.ValidateTxStateless(tx)
+}
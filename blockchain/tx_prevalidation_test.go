@@ -0,0 +1,68 @@
+package blockchain
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func createPrevalidationTxs(count int) []*types.Transaction {
+	key, _ := crypto.GenerateKey()
+	var txs []*types.Transaction
+	for i := 0; i < count; i++ {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: uint32(i + 1),
+			Type:         types.SendTx,
+			To:           &common.Address{0x1},
+			Amount:       big.NewInt(1),
+		}, key)
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+func Test_prevalidateTxs(t *testing.T) {
+	require.NoError(t, prevalidateTxs(nil))
+	require.NoError(t, prevalidateTxs(createPrevalidationTxs(5)))
+
+	txs := createPrevalidationTxs(100)
+	require.NoError(t, prevalidateTxs(txs))
+	sender, _ := types.Sender(txs[50])
+	require.NotEqual(t, common.Address{}, sender)
+
+	txs = createPrevalidationTxs(100)
+	txs[70].Signature = []byte{0x1}
+	txs[30].Signature = []byte{0x1}
+	err := prevalidateTxs(txs)
+	require.Equal(t, validation.InvalidSignature, errors.Cause(err))
+	require.Contains(t, err.Error(), "tx 30")
+
+	txs = createPrevalidationTxs(3)
+	txs[2].Amount = big.NewInt(-1)
+	require.Error(t, prevalidateTxs(txs))
+}
+
+func Benchmark_prevalidateTxs(b *testing.B) {
+	txs := createPrevalidationTxs(1000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copies := make([]*types.Transaction, len(txs))
+		for j, tx := range txs {
+			copies[j] = &types.Transaction{
+				AccountNonce: tx.AccountNonce,
+				Type:         tx.Type,
+				To:           tx.To,
+				Amount:       tx.Amount,
+				Signature:    tx.Signature,
+			}
+		}
+		b.StartTimer()
+		prevalidateTxs(copies)
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+)
+
+// SignEpochCheckpoint returns epoch checkpoint signed with given private key
+func SignEpochCheckpoint(c *EpochCheckpoint, prv *ecdsa.PrivateKey) (*EpochCheckpoint, error) {
+	h := crypto.SignatureHash(c)
+	sig, err := crypto.Sign(h[:], prv)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EpochCheckpoint{
+		Epoch:        c.Epoch,
+		Height:       c.Height,
+		BlockHash:    c.BlockHash,
+		Root:         c.Root,
+		IdentityRoot: c.IdentityRoot,
+		Supply:       c.Supply,
+		Stake:        c.Stake,
+		Signature:    sig,
+	}, nil
+}
+
+// SenderEpochCheckpoint may cache the address, allowing it to be used regardless of
+// signing method.
+func SenderEpochCheckpoint(c *EpochCheckpoint) (common.Address, error) {
+	if from := c.from.Load(); from != nil {
+		return from.(common.Address), nil
+	}
+
+	addr, err := recoverPlain(crypto.SignatureHash(c), c.Signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	c.from.Store(addr)
+	return addr, nil
+}
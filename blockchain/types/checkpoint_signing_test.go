@@ -0,0 +1,43 @@
+package types
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"math/big"
+	"testing"
+)
+
+func TestSignEpochCheckpoint(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	checkpoint := &EpochCheckpoint{
+		Epoch:        5,
+		Height:       100,
+		BlockHash:    common.Hash{0x1},
+		Root:         common.Hash{0x2},
+		IdentityRoot: common.Hash{0x3},
+		Supply:       big.NewInt(1000),
+		Stake:        big.NewInt(10),
+	}
+	signed, err := SignEpochCheckpoint(checkpoint, key)
+	require.NoError(t, err)
+
+	data, err := (&CheckpointAnnouncement{Cid: []byte{0x1}, Checkpoint: signed}).ToBytes()
+	require.NoError(t, err)
+	announcement := new(CheckpointAnnouncement)
+	require.NoError(t, announcement.FromBytes(data))
+	require.True(t, announcement.IsValid())
+
+	from, err := SenderEpochCheckpoint(announcement.Checkpoint)
+	require.NoError(t, err)
+	require.Equal(t, addr, from)
+	require.Equal(t, checkpoint.Supply, announcement.Checkpoint.Supply)
+
+	tampered := new(CheckpointAnnouncement)
+	require.NoError(t, tampered.FromBytes(data))
+	tampered.Checkpoint.Supply = big.NewInt(1001)
+	from, _ = SenderEpochCheckpoint(tampered.Checkpoint)
+	require.NotEqual(t, addr, from)
+}
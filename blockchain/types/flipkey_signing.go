@@ -45,9 +45,12 @@ func SignFlipKeysPackage(fk *PrivateFlipKeysPackage, prv *ecdsa.PrivateKey) (*Pr
 	}
 
 	return &PrivateFlipKeysPackage{
-		Data:      fk.Data,
-		Epoch:     fk.Epoch,
-		Signature: sig,
+		Data:       fk.Data,
+		Epoch:      fk.Epoch,
+		Shards:     fk.Shards,
+		DataShards: fk.DataShards,
+		Size:       fk.Size,
+		Signature:  sig,
 	}, nil
 }
 
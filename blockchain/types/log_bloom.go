@@ -0,0 +1,61 @@
+package types
+
+import (
+	"github.com/idena-network/idena-go/crypto"
+)
+
+const (
+	// LogBloomLength is the size of the log bloom in bytes
+	LogBloomLength = 256
+	logBloomHashes = 3
+)
+
+// LogBloom is a bloom filter of contract events of a block, it contains addresses of contracts which emitted events,
+// event names and event args. A negative test means the block has no matching events.
+type LogBloom [LogBloomLength]byte
+
+func logBloomBits(data []byte) [logBloomHashes]uint {
+	hash := crypto.Keccak256(data)
+	var bits [logBloomHashes]uint
+	for i := range bits {
+		// every bit index is taken from 11 low bits of a pair of hash bytes
+		bits[i] = (uint(hash[2*i])<<8 | uint(hash[2*i+1])) % (LogBloomLength * 8)
+	}
+	return bits
+}
+
+func (b *LogBloom) Add(data []byte) {
+	for _, bit := range logBloomBits(data) {
+		b[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+func (b *LogBloom) Test(data []byte) bool {
+	for _, bit := range logBloomBits(data) {
+		if b[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// CreateLogBloom returns the bloom of events of the receipts, nil is returned if there are no events
+func CreateLogBloom(receipts TxReceipts) *LogBloom {
+	var bloom *LogBloom
+	for _, r := range receipts {
+		if r == nil || len(r.Events) == 0 {
+			continue
+		}
+		if bloom == nil {
+			bloom = new(LogBloom)
+		}
+		bloom.Add(r.ContractAddress.Bytes())
+		for _, e := range r.Events {
+			bloom.Add([]byte(e.EventName))
+			for _, arg := range e.Data {
+				bloom.Add(arg)
+			}
+		}
+	}
+	return bloom
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateLogBloom(t *testing.T) {
+	require.Nil(t, CreateLogBloom(TxReceipts{{TxHash: common.Hash{0x1}}, nil}))
+
+	contract := common.Address{0x1}
+	bloom := CreateLogBloom(TxReceipts{
+		{TxHash: common.Hash{0x1}},
+		{ContractAddress: contract, Events: []*TxEvent{{EventName: "transfer", Data: [][]byte{{0x2}, {0x3}}}}},
+	})
+	require.NotNil(t, bloom)
+	require.True(t, bloom.Test(contract.Bytes()))
+	require.True(t, bloom.Test([]byte("transfer")))
+	require.True(t, bloom.Test([]byte{0x2}))
+	require.True(t, bloom.Test([]byte{0x3}))
+	require.False(t, bloom.Test(common.Address{0x2}.Bytes()))
+	require.False(t, bloom.Test([]byte("approve")))
+}
@@ -25,6 +25,7 @@ func SignTx(tx *Transaction, prv *ecdsa.PrivateKey) (*Transaction, error) {
 		Payload:      tx.Payload,
 		To:           tx.To,
 		Type:         tx.Type,
+		ExpiryHeight: tx.ExpiryHeight,
 		Signature:    sig,
 	}, nil
 }
@@ -51,6 +52,15 @@ func Sender(tx *Transaction) (common.Address, error) {
 	return addr, nil
 }
 
+// CeremonyIdentity returns the identity the ceremony tx is sent for, the tx with the recipient
+// is sent by the answers submitter authorized by the recipient
+func CeremonyIdentity(tx *Transaction) (common.Address, error) {
+	if tx.To != nil {
+		return *tx.To, nil
+	}
+	return Sender(tx)
+}
+
 // Sender may cache the address, allowing it to be used regardless of
 // signing method.
 func SenderPubKey(tx *Transaction) ([]byte, error) {
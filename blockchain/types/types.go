@@ -8,33 +8,47 @@ import (
 	"github.com/idena-network/idena-go/crypto"
 	models "github.com/idena-network/idena-go/protobuf"
 	"math/big"
+	"sort"
 	"sync/atomic"
 	"time"
 )
 
 const (
-	SendTx               uint16 = 0x0
-	ActivationTx         uint16 = 0x1
-	InviteTx             uint16 = 0x2
-	KillTx               uint16 = 0x3
-	SubmitFlipTx         uint16 = 0x4
-	SubmitAnswersHashTx  uint16 = 0x5
-	SubmitShortAnswersTx uint16 = 0x6
-	SubmitLongAnswersTx  uint16 = 0x7
-	EvidenceTx           uint16 = 0x8
-	OnlineStatusTx       uint16 = 0x9
-	KillInviteeTx        uint16 = 0xA
-	ChangeGodAddressTx   uint16 = 0xB
-	BurnTx               uint16 = 0xC
-	ChangeProfileTx      uint16 = 0xD
-	DeleteFlipTx         uint16 = 0xE
-	DeployContractTx     uint16 = 0xF
-	CallContractTx       uint16 = 0x10
-	TerminateContractTx  uint16 = 0x11
-	DelegateTx           uint16 = 0x12
-	UndelegateTx         uint16 = 0x13
-	KillDelegatorTx      uint16 = 0x14
-	StoreToIpfsTx        uint16 = 0x15
+	SendTx                uint16 = 0x0
+	ActivationTx          uint16 = 0x1
+	InviteTx              uint16 = 0x2
+	KillTx                uint16 = 0x3
+	SubmitFlipTx          uint16 = 0x4
+	SubmitAnswersHashTx   uint16 = 0x5
+	SubmitShortAnswersTx  uint16 = 0x6
+	SubmitLongAnswersTx   uint16 = 0x7
+	EvidenceTx            uint16 = 0x8
+	OnlineStatusTx        uint16 = 0x9
+	KillInviteeTx         uint16 = 0xA
+	ChangeGodAddressTx    uint16 = 0xB
+	BurnTx                uint16 = 0xC
+	ChangeProfileTx       uint16 = 0xD
+	DeleteFlipTx          uint16 = 0xE
+	DeployContractTx      uint16 = 0xF
+	CallContractTx        uint16 = 0x10
+	TerminateContractTx   uint16 = 0x11
+	DelegateTx            uint16 = 0x12
+	UndelegateTx          uint16 = 0x13
+	KillDelegatorTx       uint16 = 0x14
+	StoreToIpfsTx         uint16 = 0x15
+	LockStakeTx           uint16 = 0x16
+	UnlockStakeTx         uint16 = 0x17
+	ChangeBlockGasLimitTx uint16 = 0x18
+	MisbehaviorEvidenceTx uint16 = 0x19
+	RegisterBlsKeyTx      uint16 = 0x1A
+	// ChangeProposerThresholdTx is sent by the god address to set the minimal proposer VRF threshold of test networks
+	ChangeProposerThresholdTx uint16 = 0x1B
+	// AuthorizeAnswersSubmitterTx is sent by the identity to authorize the recipient to submit ceremony answers on its behalf
+	AuthorizeAnswersSubmitterTx uint16 = 0x1C
+	// ChangeWordsDictionaryTx is sent by the god address to pin the hash and size of the flip words dictionary of next epochs
+	ChangeWordsDictionaryTx uint16 = 0x1D
+	// MultiSendTx pays amounts of the payload to several recipients, the tx amount is the sum of the payments
+	MultiSendTx uint16 = 0x1E
 )
 
 const (
@@ -43,6 +57,13 @@ const (
 	Final        = 255
 
 	MaxBlockGas = 3000 * 1024
+	// bounds of the block gas limit which can be set by ChangeBlockGasLimitTx
+	MinBlockGasLimit = 1024 * 1024
+	MaxBlockGasLimit = 4 * MaxBlockGas
+
+	// bounds of the flip words dictionary size which can be set by ChangeWordsDictionaryTx
+	MinWordsDictionarySize = 100
+	MaxWordsDictionarySize = 100000
 )
 
 type BlockFlag uint32
@@ -117,6 +138,8 @@ type ProposedHeader struct {
 	Upgrade        uint32
 	SeedProof      []byte
 	TxReceiptsCid  []byte
+	// GasLimit is the effective gas limit of the block, zero if the block gas limit governance is not enabled
+	GasLimit uint64
 }
 
 type Header struct {
@@ -159,6 +182,8 @@ type Transaction struct {
 	MaxFee       *big.Int
 	Tips         *big.Int
 	Payload      []byte `rlp:"nil"       json:"input"`
+	// ExpiryHeight is the last block height the tx can be included in, zero means the tx doesn't expire
+	ExpiryHeight uint64 `rlp:"-"`
 
 	Signature []byte
 
@@ -180,11 +205,22 @@ type BlockCertSignature struct {
 	Signature   []byte
 }
 
+// BlockCertVoterGroup contains voters of the aggregated signature with the same vote flags
+type BlockCertVoterGroup struct {
+	TurnOffline bool
+	Upgrade     uint32
+	// Voters is a bitmap of voters, the i-th bit stands for the i-th address of the step committee sorted in ascending order
+	Voters []byte
+}
+
 type BlockCert struct {
 	Round      uint64
 	Step       uint8
 	VotedHash  common.Hash
 	Signatures []*BlockCertSignature
+	// AggregatedSignature is the BLS signature aggregated from votes of the voter groups
+	AggregatedSignature []byte
+	VoterGroups         []*BlockCertVoterGroup
 }
 
 type BlockBundle struct {
@@ -244,6 +280,8 @@ type Transactions []*Transaction
 type Vote struct {
 	Header    *VoteHeader
 	Signature []byte
+	// BlsSignature is the optional signature of the vote signature hash used to aggregate votes in certificates
+	BlsSignature []byte
 
 	// caches
 	hash    atomic.Value
@@ -519,6 +557,7 @@ func (h *Header) FromProto(protoHeader *models.ProtoBlockHeader) *Header {
 			Upgrade:        protoHeader.ProposedHeader.Upgrade,
 			SeedProof:      protoHeader.ProposedHeader.SeedProof,
 			TxReceiptsCid:  protoHeader.ProposedHeader.ReceiptsCid,
+			GasLimit:       protoHeader.ProposedHeader.GasLimit,
 		}
 		if len(protoHeader.ProposedHeader.OfflineAddr) > 0 {
 			addr := common.BytesToAddress(protoHeader.ProposedHeader.OfflineAddr)
@@ -592,6 +631,22 @@ func (h *Header) Time() int64 {
 	}
 }
 
+// GasLimit returns the gas limit of txs of the block
+func (h *Header) GasLimit() uint64 {
+	if h.EmptyBlockHeader != nil {
+		return 0
+	}
+	return h.ProposedHeader.BlockGasLimit()
+}
+
+// BlockGasLimit returns the gas limit recorded in the header or MaxBlockGas if the header has no limit
+func (h *ProposedHeader) BlockGasLimit() uint64 {
+	if h.GasLimit == 0 {
+		return MaxBlockGas
+	}
+	return h.GasLimit
+}
+
 func (h *Header) FeePerGas() *big.Int {
 	if h.EmptyBlockHeader != nil {
 		return nil
@@ -657,6 +712,7 @@ func (h *ProposedHeader) ToProto() *models.ProtoBlockHeader_Proposed {
 		Upgrade:        h.Upgrade,
 		SeedProof:      h.SeedProof,
 		ReceiptsCid:    h.TxReceiptsCid,
+		GasLimit:       h.GasLimit,
 	}
 	if h.OfflineAddr != nil {
 		protoProposed.OfflineAddr = (*h.OfflineAddr)[:]
@@ -699,9 +755,10 @@ func (v *Vote) ToSignatureBytes() ([]byte, error) {
 	return proto.Marshal(protoObj)
 }
 
-func (v *Vote) ToBytes() ([]byte, error) {
+func (v *Vote) ToProto() *models.ProtoVote {
 	protoObj := &models.ProtoVote{
-		Signature: v.Signature,
+		Signature:    v.Signature,
+		BlsSignature: v.BlsSignature,
 	}
 	if v.Header != nil {
 		protoObj.Data = &models.ProtoVote_Data{
@@ -713,15 +770,16 @@ func (v *Vote) ToBytes() ([]byte, error) {
 			Upgrade:     v.Header.Upgrade,
 		}
 	}
-	return proto.Marshal(protoObj)
+	return protoObj
 }
 
-func (v *Vote) FromBytes(data []byte) error {
-	protoObj := new(models.ProtoVote)
-	if err := proto.Unmarshal(data, protoObj); err != nil {
-		return err
-	}
+func (v *Vote) ToBytes() ([]byte, error) {
+	return proto.Marshal(v.ToProto())
+}
+
+func (v *Vote) FromProto(protoObj *models.ProtoVote) *Vote {
 	v.Signature = protoObj.Signature
+	v.BlsSignature = protoObj.BlsSignature
 	if protoObj.Data != nil {
 		v.Header = &VoteHeader{
 			Round:       protoObj.Data.Round,
@@ -732,6 +790,15 @@ func (v *Vote) FromBytes(data []byte) error {
 			Upgrade:     protoObj.Data.Upgrade,
 		}
 	}
+	return v
+}
+
+func (v *Vote) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoVote)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	v.FromProto(protoObj)
 	return nil
 }
 
@@ -833,13 +900,14 @@ func (tx *Transaction) Size() int {
 
 func (tx *Transaction) ToSignatureBytes() ([]byte, error) {
 	protoTx := &models.ProtoTransaction_Data{
-		Nonce:   tx.AccountNonce,
-		Epoch:   uint32(tx.Epoch),
-		Type:    uint32(tx.Type),
-		Payload: tx.Payload,
-		Amount:  common.BigIntBytesOrNil(tx.Amount),
-		Tips:    common.BigIntBytesOrNil(tx.Tips),
-		MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+		Nonce:        tx.AccountNonce,
+		Epoch:        uint32(tx.Epoch),
+		Type:         uint32(tx.Type),
+		Payload:      tx.Payload,
+		Amount:       common.BigIntBytesOrNil(tx.Amount),
+		Tips:         common.BigIntBytesOrNil(tx.Tips),
+		MaxFee:       common.BigIntBytesOrNil(tx.MaxFee),
+		ExpiryHeight: tx.ExpiryHeight,
 	}
 	if tx.To != nil {
 		protoTx.To = tx.To.Bytes()
@@ -850,13 +918,14 @@ func (tx *Transaction) ToSignatureBytes() ([]byte, error) {
 func (tx *Transaction) ToProto() *models.ProtoTransaction {
 	protoTx := &models.ProtoTransaction{
 		Data: &models.ProtoTransaction_Data{
-			Nonce:   tx.AccountNonce,
-			Epoch:   uint32(tx.Epoch),
-			Type:    uint32(tx.Type),
-			Payload: tx.Payload,
-			Amount:  common.BigIntBytesOrNil(tx.Amount),
-			Tips:    common.BigIntBytesOrNil(tx.Tips),
-			MaxFee:  common.BigIntBytesOrNil(tx.MaxFee),
+			Nonce:        tx.AccountNonce,
+			Epoch:        uint32(tx.Epoch),
+			Type:         uint32(tx.Type),
+			Payload:      tx.Payload,
+			Amount:       common.BigIntBytesOrNil(tx.Amount),
+			Tips:         common.BigIntBytesOrNil(tx.Tips),
+			MaxFee:       common.BigIntBytesOrNil(tx.MaxFee),
+			ExpiryHeight: tx.ExpiryHeight,
 		},
 		Signature: tx.Signature,
 		UseRlp:    tx.UseRlp,
@@ -886,6 +955,7 @@ func (tx *Transaction) FromProto(protoTx *models.ProtoTransaction) *Transaction
 		tx.Amount = common.BigIntOrNil(protoTx.Data.Amount)
 		tx.Tips = common.BigIntOrNil(protoTx.Data.Tips)
 		tx.MaxFee = common.BigIntOrNil(protoTx.Data.MaxFee)
+		tx.ExpiryHeight = protoTx.Data.GetExpiryHeight()
 	}
 
 	tx.Signature = protoTx.GetSignature()
@@ -915,7 +985,7 @@ func (s Transactions) GetBytes(i int) []byte {
 }
 
 func (s *BlockCert) Empty() bool {
-	return s == nil || len(s.Signatures) == 0
+	return s == nil || len(s.Signatures) == 0 && len(s.AggregatedSignature) == 0
 }
 
 func (s *BlockCert) ToProto() *models.ProtoBlockCert {
@@ -931,6 +1001,14 @@ func (s *BlockCert) ToProto() *models.ProtoBlockCert {
 			Signature:   item.Signature,
 		})
 	}
+	protoObj.AggregatedSignature = s.AggregatedSignature
+	for _, item := range s.VoterGroups {
+		protoObj.VoterGroups = append(protoObj.VoterGroups, &models.ProtoBlockCert_VoterGroup{
+			TurnOffline: item.TurnOffline,
+			Upgrade:     item.Upgrade,
+			Voters:      item.Voters,
+		})
+	}
 	return protoObj
 }
 
@@ -950,6 +1028,14 @@ func (s *BlockCert) FromProto(protoObj *models.ProtoBlockCert) *BlockCert {
 			Signature:   item.Signature,
 		})
 	}
+	s.AggregatedSignature = protoObj.AggregatedSignature
+	for _, item := range protoObj.VoterGroups {
+		s.VoterGroups = append(s.VoterGroups, &BlockCertVoterGroup{
+			TurnOffline: item.TurnOffline,
+			Upgrade:     item.Upgrade,
+			Voters:      item.Voters,
+		})
+	}
 	return s
 }
 
@@ -1136,7 +1222,8 @@ type PublicFlipKey struct {
 	Signature []byte
 	Epoch     uint16
 
-	from atomic.Value
+	from    atomic.Value
+	hash128 atomic.Value
 }
 
 func (k *PublicFlipKey) ToSignatureBytes() ([]byte, error) {
@@ -1176,29 +1263,54 @@ func (k *PublicFlipKey) Hash() common.Hash {
 	return crypto.Hash(b)
 }
 
+func (k *PublicFlipKey) Hash128() common.Hash128 {
+	if hash := k.hash128.Load(); hash != nil {
+		return hash.(common.Hash128)
+	}
+
+	data, _ := k.ToBytes()
+	h := common.Hash128(crypto.Hash128(data))
+
+	k.hash128.Store(h)
+	return h
+}
+
 type PrivateFlipKeysPackage struct {
-	Data      []byte
-	Epoch     uint16
-	Signature []byte
+	Data  []byte
+	Epoch uint16
+	// Shards are cids of erasure coded parts of the data published to ipfs, Data is empty if they are set,
+	// any DataShards of them restore the data of Size bytes
+	Shards     [][]byte
+	DataShards uint16
+	Size       uint32
+	Signature  []byte
 
 	from    atomic.Value
 	hash128 atomic.Value
 }
 
 func (k *PrivateFlipKeysPackage) ToSignatureBytes() ([]byte, error) {
-	protoObj := &models.ProtoPrivateFlipKeysPackage_Data{
-		Package: k.Data,
-		Epoch:   uint32(k.Epoch),
+	return proto.Marshal(k.toProtoData())
+}
+
+func (k *PrivateFlipKeysPackage) toProtoData() *models.ProtoPrivateFlipKeysPackage_Data {
+	return &models.ProtoPrivateFlipKeysPackage_Data{
+		Package:    k.Data,
+		Epoch:      uint32(k.Epoch),
+		Shards:     k.Shards,
+		DataShards: uint32(k.DataShards),
+		Size:       k.Size,
 	}
-	return proto.Marshal(protoObj)
+}
+
+// IsSharded returns true if the package data should be restored from erasure coded shards
+func (k *PrivateFlipKeysPackage) IsSharded() bool {
+	return len(k.Shards) > 0
 }
 
 func (k *PrivateFlipKeysPackage) ToBytes() ([]byte, error) {
 	protoObj := &models.ProtoPrivateFlipKeysPackage{
-		Data: &models.ProtoPrivateFlipKeysPackage_Data{
-			Package: k.Data,
-			Epoch:   uint32(k.Epoch),
-		},
+		Data:      k.toProtoData(),
 		Signature: k.Signature,
 	}
 	return proto.Marshal(protoObj)
@@ -1213,6 +1325,9 @@ func (k *PrivateFlipKeysPackage) FromBytes(data []byte) error {
 	if protoObj.Data != nil {
 		k.Data = protoObj.Data.Package
 		k.Epoch = uint16(protoObj.Data.Epoch)
+		k.Shards = protoObj.Data.Shards
+		k.DataShards = uint16(protoObj.Data.DataShards)
+		k.Size = protoObj.Data.Size
 	}
 	return nil
 }
@@ -1411,6 +1526,9 @@ type TxReceipt struct {
 	Error           error
 	Events          []*TxEvent
 	Method          string
+	// Fee and FeeBurned are set since receipts are generated for all txs
+	Fee       *big.Int
+	FeeBurned *big.Int
 }
 
 type TxReceipts []*TxReceipt
@@ -1456,6 +1574,12 @@ func (r *TxReceipt) ToProto() *models.ProtoTxReceipts_ProtoTxReceipt {
 	if r.GasCost != nil {
 		protoObj.GasCost = r.GasCost.Bytes()
 	}
+	if r.Fee != nil {
+		protoObj.Fee = r.Fee.Bytes()
+	}
+	if r.FeeBurned != nil {
+		protoObj.FeeBurned = r.FeeBurned.Bytes()
+	}
 	for idx := range r.Events {
 		e := r.Events[idx]
 		protoObj.Events = append(protoObj.Events, &models.ProtoTxReceipts_ProtoEvent{
@@ -1486,6 +1610,12 @@ func (r *TxReceipt) FromProto(protoObj *models.ProtoTxReceipts_ProtoTxReceipt) {
 	if protoObj.Error != "" {
 		r.Error = errors.New(protoObj.Error)
 	}
+	if len(protoObj.Fee) > 0 {
+		r.Fee = new(big.Int).SetBytes(protoObj.Fee)
+	}
+	if len(protoObj.FeeBurned) > 0 {
+		r.FeeBurned = new(big.Int).SetBytes(protoObj.FeeBurned)
+	}
 	r.Success = protoObj.Success
 	r.GasUsed = protoObj.GasUsed
 	r.Method = protoObj.Method
@@ -1561,6 +1691,156 @@ func (i *SavedEvent) FromBytes(data []byte) error {
 	return nil
 }
 
+type BalanceChangeReason = byte
+
+const (
+	TxReason               BalanceChangeReason = 0
+	FeeReason              BalanceChangeReason = 1
+	BlockRewardReason      BalanceChangeReason = 2
+	ValidationRewardReason BalanceChangeReason = 3
+	StakingReason          BalanceChangeReason = 4
+	PenaltyReason          BalanceChangeReason = 5
+	DustClearingReason     BalanceChangeReason = 6
+)
+
+type BalanceChange struct {
+	Height     uint64
+	Reason     BalanceChangeReason
+	TxHash     *common.Hash
+	BalanceOld *big.Int
+	BalanceNew *big.Int
+	StakeOld   *big.Int
+	StakeNew   *big.Int
+}
+
+func (c *BalanceChange) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoBalanceChange{
+		Height:     c.Height,
+		Reason:     uint32(c.Reason),
+		BalanceOld: common.BigIntBytesOrNil(c.BalanceOld),
+		BalanceNew: common.BigIntBytesOrNil(c.BalanceNew),
+		StakeOld:   common.BigIntBytesOrNil(c.StakeOld),
+		StakeNew:   common.BigIntBytesOrNil(c.StakeNew),
+	}
+	if c.TxHash != nil {
+		protoObj.TxHash = c.TxHash.Bytes()
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (c *BalanceChange) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoBalanceChange)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	c.Height = protoObj.Height
+	c.Reason = BalanceChangeReason(protoObj.Reason)
+	if len(protoObj.TxHash) > 0 {
+		hash := common.BytesToHash(protoObj.TxHash)
+		c.TxHash = &hash
+	}
+	c.BalanceOld = common.BigIntOrNil(protoObj.BalanceOld)
+	c.BalanceNew = common.BigIntOrNil(protoObj.BalanceNew)
+	c.StakeOld = common.BigIntOrNil(protoObj.StakeOld)
+	c.StakeNew = common.BigIntOrNil(protoObj.StakeNew)
+	return nil
+}
+
+type EpochCheckpoint struct {
+	Epoch        uint16
+	Height       uint64
+	BlockHash    common.Hash
+	Root         common.Hash
+	IdentityRoot common.Hash
+	Supply       *big.Int
+	Stake        *big.Int
+	Signature    []byte
+
+	from atomic.Value
+}
+
+func (c *EpochCheckpoint) dataProto() *models.ProtoEpochCheckpoint_Data {
+	return &models.ProtoEpochCheckpoint_Data{
+		Epoch:        uint32(c.Epoch),
+		Height:       c.Height,
+		BlockHash:    c.BlockHash[:],
+		Root:         c.Root[:],
+		IdentityRoot: c.IdentityRoot[:],
+		Supply:       common.BigIntBytesOrNil(c.Supply),
+		Stake:        common.BigIntBytesOrNil(c.Stake),
+	}
+}
+
+func (c *EpochCheckpoint) ToSignatureBytes() ([]byte, error) {
+	return proto.Marshal(c.dataProto())
+}
+
+func (c *EpochCheckpoint) ToProto() *models.ProtoEpochCheckpoint {
+	return &models.ProtoEpochCheckpoint{
+		Data:      c.dataProto(),
+		Signature: c.Signature,
+	}
+}
+
+func (c *EpochCheckpoint) FromProto(protoObj *models.ProtoEpochCheckpoint) *EpochCheckpoint {
+	c.Signature = protoObj.Signature
+	if protoObj.Data != nil {
+		c.Epoch = uint16(protoObj.Data.Epoch)
+		c.Height = protoObj.Data.Height
+		c.BlockHash = common.BytesToHash(protoObj.Data.BlockHash)
+		c.Root = common.BytesToHash(protoObj.Data.Root)
+		c.IdentityRoot = common.BytesToHash(protoObj.Data.IdentityRoot)
+		c.Supply = common.BigIntOrNil(protoObj.Data.Supply)
+		c.Stake = common.BigIntOrNil(protoObj.Data.Stake)
+	}
+	return c
+}
+
+func (c *EpochCheckpoint) ToBytes() ([]byte, error) {
+	return proto.Marshal(c.ToProto())
+}
+
+func (c *EpochCheckpoint) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoEpochCheckpoint)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	c.FromProto(protoObj)
+	return nil
+}
+
+// CheckpointAnnouncement links a signed epoch checkpoint with the cid of its ipfs copy
+type CheckpointAnnouncement struct {
+	Cid        []byte
+	Checkpoint *EpochCheckpoint
+}
+
+func (a *CheckpointAnnouncement) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoCheckpointAnnouncement{
+		Cid: a.Cid,
+	}
+	if a.Checkpoint != nil {
+		protoObj.Checkpoint = a.Checkpoint.ToProto()
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (a *CheckpointAnnouncement) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoCheckpointAnnouncement)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	a.Cid = protoObj.Cid
+	if protoObj.Checkpoint != nil {
+		a.Checkpoint = new(EpochCheckpoint).FromProto(protoObj.Checkpoint)
+	}
+	return nil
+}
+
+func (a *CheckpointAnnouncement) IsValid() bool {
+	return len(a.Cid) > 0 && a.Checkpoint != nil && len(a.Checkpoint.Signature) > 0
+}
+
 type GenesisInfo struct {
 	// the genesis with highest height
 	Genesis *Header
@@ -1632,3 +1912,322 @@ func (uv *UpgradeVotes) FromBytes(data []byte) error {
 	}
 	return nil
 }
+
+// EpochStats contains totals of the current epoch accumulated block by block
+type EpochStats struct {
+	// FirstBlock is the first block the totals are accumulated from
+	FirstBlock uint64
+	Minted     *big.Int
+	FeeBurnt   *big.Int
+}
+
+func (s *EpochStats) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoEpochStats{
+		FirstBlock: s.FirstBlock,
+		Minted:     common.BigIntBytesOrNil(s.Minted),
+		FeeBurnt:   common.BigIntBytesOrNil(s.FeeBurnt),
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (s *EpochStats) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoEpochStats)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	s.FirstBlock = protoObj.FirstBlock
+	s.Minted = common.BigIntOrNil(protoObj.Minted)
+	s.FeeBurnt = common.BigIntOrNil(protoObj.FeeBurnt)
+	return nil
+}
+
+// EpochSummary describes the finished epoch and results of its validation
+type EpochSummary struct {
+	Epoch uint16
+	// Height is the height of the block which finished the epoch
+	Height uint64
+	// FirstBlock is the first block minted coins and burnt fees are counted from,
+	// it differs from the first block of the epoch if the node didn't import the whole epoch
+	FirstBlock uint64
+	Minted     *big.Int
+	FeeBurnt   *big.Int
+	// Identities contains counts of identities by state after the validation
+	Identities map[uint8]uint32
+	// ValidationResults contains counts of new states of identities which took part in the validation
+	ValidationResults map[uint8]uint32
+}
+
+func stateCountsToProto(counts map[uint8]uint32) []*models.ProtoEpochSummary_ProtoStateCount {
+	var result []*models.ProtoEpochSummary_ProtoStateCount
+	for s, count := range counts {
+		result = append(result, &models.ProtoEpochSummary_ProtoStateCount{
+			State: uint32(s),
+			Count: count,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].State < result[j].State
+	})
+	return result
+}
+
+func stateCountsFromProto(protoCounts []*models.ProtoEpochSummary_ProtoStateCount) map[uint8]uint32 {
+	result := make(map[uint8]uint32, len(protoCounts))
+	for _, item := range protoCounts {
+		result[uint8(item.State)] = item.Count
+	}
+	return result
+}
+
+func (s *EpochSummary) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoEpochSummary{
+		Epoch:             uint32(s.Epoch),
+		Height:            s.Height,
+		FirstBlock:        s.FirstBlock,
+		Minted:            common.BigIntBytesOrNil(s.Minted),
+		FeeBurnt:          common.BigIntBytesOrNil(s.FeeBurnt),
+		Identities:        stateCountsToProto(s.Identities),
+		ValidationResults: stateCountsToProto(s.ValidationResults),
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (s *EpochSummary) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoEpochSummary)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	s.Epoch = uint16(protoObj.Epoch)
+	s.Height = protoObj.Height
+	s.FirstBlock = protoObj.FirstBlock
+	s.Minted = common.BigIntOrNil(protoObj.Minted)
+	s.FeeBurnt = common.BigIntOrNil(protoObj.FeeBurnt)
+	s.Identities = stateCountsFromProto(protoObj.Identities)
+	s.ValidationResults = stateCountsFromProto(protoObj.ValidationResults)
+	return nil
+}
+
+// MinerPerformance contains the duties of the node identity in the epoch and how many of them were fulfilled
+type MinerPerformance struct {
+	Epoch uint16
+	// Rounds is the number of rounds the identity had any duty in
+	Rounds            uint32
+	ExpectedProposals uint32
+	Proposals         uint32
+	ExpectedVotes     uint32
+	Votes             uint32
+	LastMissedRound   uint64
+}
+
+func (p *MinerPerformance) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoMinerPerformance{
+		Epoch:             uint32(p.Epoch),
+		Rounds:            p.Rounds,
+		ExpectedProposals: p.ExpectedProposals,
+		Proposals:         p.Proposals,
+		ExpectedVotes:     p.ExpectedVotes,
+		Votes:             p.Votes,
+		LastMissedRound:   p.LastMissedRound,
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (p *MinerPerformance) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoMinerPerformance)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	p.Epoch = uint16(protoObj.Epoch)
+	p.Rounds = protoObj.Rounds
+	p.ExpectedProposals = protoObj.ExpectedProposals
+	p.Proposals = protoObj.Proposals
+	p.ExpectedVotes = protoObj.ExpectedVotes
+	p.Votes = protoObj.Votes
+	p.LastMissedRound = protoObj.LastMissedRound
+	return nil
+}
+
+// CeremonyPhase is the moment the node reached the phase of the validation ceremony,
+// timestamps are unix times in milliseconds, Scheduled is 0 if the phase has no planned time
+type CeremonyPhase struct {
+	Name      string
+	Height    uint64
+	Timestamp int64
+	Scheduled int64
+}
+
+// CeremonyTimeline contains the phases of the validation ceremony of the epoch in the order they were reached
+type CeremonyTimeline struct {
+	Epoch  uint16
+	Phases []*CeremonyPhase
+}
+
+func (t *CeremonyTimeline) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoCeremonyTimeline{
+		Epoch: uint32(t.Epoch),
+	}
+	for _, phase := range t.Phases {
+		protoObj.Phases = append(protoObj.Phases, &models.ProtoCeremonyTimeline_Phase{
+			Name:      phase.Name,
+			Height:    phase.Height,
+			Timestamp: phase.Timestamp,
+			Scheduled: phase.Scheduled,
+		})
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (t *CeremonyTimeline) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoCeremonyTimeline)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	t.Epoch = uint16(protoObj.Epoch)
+	t.Phases = nil
+	for _, phase := range protoObj.Phases {
+		t.Phases = append(t.Phases, &CeremonyPhase{
+			Name:      phase.Name,
+			Height:    phase.Height,
+			Timestamp: phase.Timestamp,
+			Scheduled: phase.Scheduled,
+		})
+	}
+	return nil
+}
+
+// SessionExplanation contains the results of the identity in the short or long validation session
+type SessionExplanation struct {
+	FlipsToSolve   uint32
+	MissedAnswers  uint32
+	QualifiedFlips uint32
+	Point          float32
+	Score          float32
+	// NoAnswers is true if the identity didn't submit answers in the session
+	NoAnswers        bool
+	NoQualifiedFlips bool
+}
+
+// ValidationExplanation contains the data used to determine the new state of the identity by the validation
+type ValidationExplanation struct {
+	Epoch            uint16
+	PrevState        uint8
+	NewState         uint8
+	Reason           string
+	Approved         bool
+	Missed           bool
+	ValidationFailed bool
+	RequiredFlips    uint32
+	MadeFlips        uint32
+	// ReportedFlips is the number of flips of the identity reported by qualification committees
+	ReportedFlips       uint32
+	BadAuthor           bool
+	BadAuthorReason     BadAuthorReason
+	Short               SessionExplanation
+	Long                SessionExplanation
+	TotalQualifiedFlips uint32
+	TotalScore          float32
+}
+
+func (s *SessionExplanation) toProto() *models.ProtoValidationExplanation_Session {
+	return &models.ProtoValidationExplanation_Session{
+		FlipsToSolve:     s.FlipsToSolve,
+		MissedAnswers:    s.MissedAnswers,
+		QualifiedFlips:   s.QualifiedFlips,
+		Point:            s.Point,
+		Score:            s.Score,
+		NoAnswers:        s.NoAnswers,
+		NoQualifiedFlips: s.NoQualifiedFlips,
+	}
+}
+
+func (s *SessionExplanation) fromProto(protoObj *models.ProtoValidationExplanation_Session) {
+	if protoObj == nil {
+		return
+	}
+	s.FlipsToSolve = protoObj.FlipsToSolve
+	s.MissedAnswers = protoObj.MissedAnswers
+	s.QualifiedFlips = protoObj.QualifiedFlips
+	s.Point = protoObj.Point
+	s.Score = protoObj.Score
+	s.NoAnswers = protoObj.NoAnswers
+	s.NoQualifiedFlips = protoObj.NoQualifiedFlips
+}
+
+func (e *ValidationExplanation) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoValidationExplanation{
+		Epoch:               uint32(e.Epoch),
+		PrevState:           uint32(e.PrevState),
+		NewState:            uint32(e.NewState),
+		Reason:              e.Reason,
+		Approved:            e.Approved,
+		Missed:              e.Missed,
+		ValidationFailed:    e.ValidationFailed,
+		RequiredFlips:       e.RequiredFlips,
+		MadeFlips:           e.MadeFlips,
+		ReportedFlips:       e.ReportedFlips,
+		BadAuthor:           e.BadAuthor,
+		BadAuthorReason:     uint32(e.BadAuthorReason),
+		Short:               e.Short.toProto(),
+		Long:                e.Long.toProto(),
+		TotalQualifiedFlips: e.TotalQualifiedFlips,
+		TotalScore:          e.TotalScore,
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (e *ValidationExplanation) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoValidationExplanation)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	e.Epoch = uint16(protoObj.Epoch)
+	e.PrevState = uint8(protoObj.PrevState)
+	e.NewState = uint8(protoObj.NewState)
+	e.Reason = protoObj.Reason
+	e.Approved = protoObj.Approved
+	e.Missed = protoObj.Missed
+	e.ValidationFailed = protoObj.ValidationFailed
+	e.RequiredFlips = protoObj.RequiredFlips
+	e.MadeFlips = protoObj.MadeFlips
+	e.ReportedFlips = protoObj.ReportedFlips
+	e.BadAuthor = protoObj.BadAuthor
+	e.BadAuthorReason = BadAuthorReason(protoObj.BadAuthorReason)
+	e.Short.fromProto(protoObj.Short)
+	e.Long.fromProto(protoObj.Long)
+	e.TotalQualifiedFlips = protoObj.TotalQualifiedFlips
+	e.TotalScore = protoObj.TotalScore
+	return nil
+}
+
+// TrainingFlip is the decrypted flip of the finished validation with its consensus answer, it is used to practice short sessions
+type TrainingFlip struct {
+	Epoch       uint16
+	PublicPart  []byte
+	PrivatePart []byte
+	Answer      Answer
+	Grade       Grade
+}
+
+func (f *TrainingFlip) ToBytes() ([]byte, error) {
+	protoObj := &models.ProtoTrainingFlip{
+		Epoch:       uint32(f.Epoch),
+		PublicPart:  f.PublicPart,
+		PrivatePart: f.PrivatePart,
+		Answer:      uint32(f.Answer),
+		Grade:       uint32(f.Grade),
+	}
+	return proto.Marshal(protoObj)
+}
+
+func (f *TrainingFlip) FromBytes(data []byte) error {
+	protoObj := new(models.ProtoTrainingFlip)
+	if err := proto.Unmarshal(data, protoObj); err != nil {
+		return err
+	}
+	f.Epoch = uint16(protoObj.Epoch)
+	f.PublicPart = protoObj.PublicPart
+	f.PrivatePart = protoObj.PrivatePart
+	f.Answer = Answer(protoObj.Answer)
+	f.Grade = Grade(protoObj.Grade)
+	return nil
+}
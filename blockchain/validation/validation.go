@@ -10,18 +10,32 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/bls"
 	"github.com/idena-network/idena-go/crypto/vrf/p256"
 	"github.com/idena-network/idena-go/vm/embedded"
 	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
+	"math"
 	"math/big"
 )
 
 const (
 	MaxPayloadSize           = 3 * 1024
 	GodValidUntilNetworkSize = 10
+	// MaxStakeLocks is the number of not released locks one recipient can have
+	MaxStakeLocks      = 10
+	MaxStakeLockEpochs = 1000
+	// MaxGovernedProposerThreshold bounds the threshold set by the god address, higher thresholds leave rounds
+	// without proposers on small networks
+	MaxGovernedProposerThreshold = 0.5
 )
 
+// MinStakeLockAmount makes taking all lock slots of a recipient cost the sender coins which end up in the recipient stake
+var MinStakeLockAmount = common.DnaBase
+
+// mainnetNetwork is the id of the main network, it duplicates blockchain.Mainnet which can't be imported here
+const mainnetNetwork types.Network = 0x0
+
 type TxType int
 
 const (
@@ -46,6 +60,7 @@ var (
 	InvalidRecipient     = errors.New("invalid recipient")
 	EarlyTx              = errors.New("tx can't be accepted due to wrong period")
 	LateTx               = errors.New("tx can't be accepted due to validation ceremony")
+	NoValidationSession  = errors.New("tx can't be accepted outside of validation session")
 	NotCandidate         = errors.New("user is not a candidate")
 	NotIdentity          = errors.New("user is not identity")
 	InsufficientFlips    = errors.New("insufficient flips")
@@ -62,6 +77,12 @@ var (
 	SenderHasDelegatee   = errors.New("sender has delegatee already")
 	SenderHasNoDelegatee = errors.New("sender has no delegatee")
 	WrongEpoch           = errors.New("wrong epoch")
+	TooManyStakeLocks    = errors.New("recipient has too many stake locks")
+	LowStakeLockAmount   = errors.New("stake lock amount is below the minimum")
+	NothingToUnlock      = errors.New("there is no unlocked stake")
+	TxExpired            = errors.New("tx is expired")
+	InvalidExpiry        = errors.New("invalid expiry height")
+	InvalidEvidence      = errors.New("invalid misbehavior evidence")
 
 	validators map[types.TxType]validator
 )
@@ -69,8 +90,11 @@ var (
 var (
 	nonCeremonialTxs = map[types.TxType]bool{
 		types.SendTx:          true,
+		types.MultiSendTx:     true,
 		types.BurnTx:          true,
 		types.ChangeProfileTx: true,
+		types.LockStakeTx:     true,
+		types.UnlockStakeTx:   true,
 	}
 
 	contractTxs = map[types.TxType]struct{}{
@@ -109,6 +133,8 @@ func init() {
 }
 func SetAppConfig(cfg *config.Config) {
 	appCfg = cfg
+	// the new config may enable other features with the same consensus version
+	cfgInitVersion = 0
 }
 
 func getValidator(txType types.TxType) (validator, bool) {
@@ -128,6 +154,49 @@ func getValidator(txType types.TxType) (validator, bool) {
 		} else {
 			delete(validators, types.StoreToIpfsTx)
 		}
+		if appCfg.Consensus.EnableBlockGasLimitGovernance {
+			validators[types.ChangeBlockGasLimitTx] = validateChangeBlockGasLimitTx
+		} else {
+			delete(validators, types.ChangeBlockGasLimitTx)
+		}
+		if appCfg.Consensus.EnableMisbehaviorEvidence {
+			validators[types.MisbehaviorEvidenceTx] = validateMisbehaviorEvidenceTx
+		} else {
+			delete(validators, types.MisbehaviorEvidenceTx)
+		}
+		// the proposer threshold of the main network is not governed
+		if appCfg.Consensus.EnableProposerThresholdGovernance && appCfg.Network != mainnetNetwork {
+			validators[types.ChangeProposerThresholdTx] = validateChangeProposerThresholdTx
+		} else {
+			delete(validators, types.ChangeProposerThresholdTx)
+		}
+		if appCfg.Consensus.EnableWordsDictionaryGovernance {
+			validators[types.ChangeWordsDictionaryTx] = validateChangeWordsDictionaryTx
+		} else {
+			delete(validators, types.ChangeWordsDictionaryTx)
+		}
+		if appCfg.Consensus.EnableMultiSendTx {
+			validators[types.MultiSendTx] = validateMultiSendTx
+		} else {
+			delete(validators, types.MultiSendTx)
+		}
+		if appCfg.Consensus.EnableBlsVoteAggregation {
+			validators[types.RegisterBlsKeyTx] = validateRegisterBlsKeyTx
+		} else {
+			delete(validators, types.RegisterBlsKeyTx)
+		}
+		if appCfg.Consensus.EnableDelegatedAnswers {
+			validators[types.AuthorizeAnswersSubmitterTx] = validateAuthorizeAnswersSubmitterTx
+		} else {
+			delete(validators, types.AuthorizeAnswersSubmitterTx)
+		}
+		if appCfg.Consensus.EnableStakeLocks {
+			validators[types.LockStakeTx] = validateLockStakeTx
+			validators[types.UnlockStakeTx] = validateUnlockStakeTx
+		} else {
+			delete(validators, types.LockStakeTx)
+			delete(validators, types.UnlockStakeTx)
+		}
 	}
 	v, ok := validators[txType]
 	return v, ok
@@ -144,27 +213,10 @@ func checkIfNonNegative(value *big.Int) error {
 }
 
 func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerGas *big.Int, txType TxType) error {
-	sender, _ := types.Sender(tx)
-
-	if sender == (common.Address{}) {
-		return InvalidSignature
-	}
-
-	if len(tx.Payload) > MaxPayloadSize {
-		return InvalidPayload
-	}
-
-	if err := checkIfNonNegative(tx.Amount); err != nil {
-		return errors.Wrap(err, "amount")
-	}
-
-	if err := checkIfNonNegative(tx.MaxFee); err != nil {
-		return errors.Wrap(err, "maxFee")
-	}
-
-	if err := checkIfNonNegative(tx.Tips); err != nil {
-		return errors.Wrap(err, "tips")
+	if err := ValidateTxStateless(tx); err != nil {
+		return err
 	}
+	sender, _ := types.Sender(tx)
 
 	globalEpoch := appState.State.Epoch()
 
@@ -172,6 +224,10 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerGas
 		return InvalidEpoch
 	}
 
+	if err := validateExpiry(appState, tx); err != nil {
+		return err
+	}
+
 	nonce, epoch := appState.State.GetNonce(sender), appState.State.GetEpoch(sender)
 
 	if nonce >= tx.AccountNonce && epoch == globalEpoch && tx.Epoch == globalEpoch {
@@ -206,6 +262,51 @@ func ValidateTx(appState *appstate.AppState, tx *types.Transaction, minFeePerGas
 	return nil
 }
 
+// ValidateTxStateless makes checks which don't depend on state, the sender is recovered and cached by the tx
+func ValidateTxStateless(tx *types.Transaction) error {
+	sender, _ := types.Sender(tx)
+
+	if sender == (common.Address{}) {
+		return InvalidSignature
+	}
+
+	if len(tx.Payload) > MaxPayloadSize {
+		return InvalidPayload
+	}
+
+	if err := checkIfNonNegative(tx.Amount); err != nil {
+		return errors.Wrap(err, "amount")
+	}
+
+	if err := checkIfNonNegative(tx.MaxFee); err != nil {
+		return errors.Wrap(err, "maxFee")
+	}
+
+	if err := checkIfNonNegative(tx.Tips); err != nil {
+		return errors.Wrap(err, "tips")
+	}
+	return nil
+}
+
+// validateExpiry rejects txs which can't be included in the next block due to expiry height,
+// the next block height is the version of the state the tx is validated against plus one
+func validateExpiry(appState *appstate.AppState, tx *types.Transaction) error {
+	if tx.ExpiryHeight == 0 {
+		return nil
+	}
+	if appCfg == nil || !appCfg.Consensus.EnableTxExpiry {
+		return errors.Wrap(InvalidExpiry, "tx expiry is not enabled")
+	}
+	// expiry height is not covered by rlp signature
+	if tx.UseRlp {
+		return errors.Wrap(InvalidExpiry, "tx expiry is not supported for rlp txs")
+	}
+	if height := uint64(appState.State.Version()) + 1; height > tx.ExpiryHeight {
+		return errors.Wrapf(TxExpired, "expiry height: %v, block height: %v", tx.ExpiryHeight, height)
+	}
+	return nil
+}
+
 func validateTotalCost(sender common.Address, appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
 	var cost *big.Int
 	if txType != InBlockTx {
@@ -222,6 +323,22 @@ func validateTotalCost(sender common.Address, appState *appstate.AppState, tx *t
 	return nil
 }
 
+// ceremonyTxIdentity returns the candidate the ceremony tx is sent for, the tx with the recipient is valid
+// only if its sender is authorized by the recipient to submit answers on its behalf
+func ceremonyTxIdentity(appState *appstate.AppState, tx *types.Transaction) (common.Address, error) {
+	sender, _ := types.Sender(tx)
+	if tx.To == nil {
+		return sender, nil
+	}
+	if appCfg == nil || !appCfg.Consensus.EnableDelegatedAnswers {
+		return common.Address{}, InvalidRecipient
+	}
+	if submitter := appState.State.AnswersSubmitter(*tx.To); submitter == nil || *submitter != sender {
+		return common.Address{}, errors.Wrap(InvalidRecipient, "sender is not authorized to submit answers")
+	}
+	return *tx.To, nil
+}
+
 func validateCeremonyTx(sender common.Address, appState *appstate.AppState, tx *types.Transaction) error {
 	if appState.State.HasValidationTx(sender, tx.Type) {
 		return DuplicatedTx
@@ -255,6 +372,33 @@ func validateSendTx(appState *appstate.AppState, tx *types.Transaction, txType T
 	return nil
 }
 
+func validateMultiSendTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	attachment := attachments.ParseMultiSendAttachment(tx)
+	if attachment == nil || len(attachment.Payments) == 0 || len(attachment.Payments) > appCfg.Consensus.MaxMultiSendPayments {
+		return InvalidPayload
+	}
+	total := new(big.Int)
+	recipients := make(map[common.Address]struct{}, len(attachment.Payments))
+	for _, payment := range attachment.Payments {
+		if payment.To == (common.Address{}) || payment.Amount.Sign() == 0 {
+			return InvalidPayload
+		}
+		// a recipient is paid once, so every payment has its own balance change
+		if _, ok := recipients[payment.To]; ok {
+			return InvalidPayload
+		}
+		recipients[payment.To] = struct{}{}
+		total.Add(total, payment.Amount)
+	}
+	if total.Cmp(tx.AmountOrZero()) != 0 {
+		return InvalidAmount
+	}
+	return nil
+}
+
 // specific validation for approving tx
 func validateActivationTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
 	sender, _ := types.Sender(tx)
@@ -303,10 +447,11 @@ func validateSendInviteTx(appState *appstate.AppState, tx *types.Transaction, tx
 		return RecipientRequired
 	}
 	godAddress := appState.State.GodAddress()
-	if sender != godAddress && appState.State.GetInvites(sender) == 0 {
+	isGodTx := IsGodTx(appState, tx)
+	if !isGodTx && appState.State.GetInvites(sender) == 0 {
 		return InsufficientInvites
 	}
-	if sender == godAddress && appState.State.GodAddressInvites() == 0 {
+	if isGodTx && appState.State.GodAddressInvites() == 0 {
 		return InsufficientInvites
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
@@ -373,10 +518,9 @@ func validateSubmitFlipTx(appState *appstate.AppState, tx *types.Transaction, tx
 }
 
 func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
-	sender, _ := types.Sender(tx)
-
-	if tx.To != nil {
-		return InvalidRecipient
+	candidate, err := ceremonyTxIdentity(appState, tx)
+	if err != nil {
+		return err
 	}
 	if !common.ZeroOrNil(tx.AmountOrZero()) {
 		return InvalidAmount
@@ -387,10 +531,13 @@ func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transact
 	if appState.State.ValidationPeriod() < state.ShortSessionPeriod && txType == InBlockTx {
 		return EarlyTx
 	}
-	if !state.IsCeremonyCandidate(appState.State.GetIdentity(sender)) {
+	if txType != InBlockTx && appState.State.ValidationPeriod() < state.FlipLotteryPeriod {
+		return NoValidationSession
+	}
+	if !state.IsCeremonyCandidate(appState.State.GetIdentity(candidate)) {
 		return NotCandidate
 	}
-	if err := validateCeremonyTx(sender, appState, tx); err != nil {
+	if err := validateCeremonyTx(candidate, appState, tx); err != nil {
 		return err
 	}
 
@@ -398,10 +545,9 @@ func validateSubmitAnswersHashTx(appState *appstate.AppState, tx *types.Transact
 }
 
 func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
-	sender, _ := types.Sender(tx)
-
-	if tx.To != nil {
-		return InvalidRecipient
+	candidate, err := ceremonyTxIdentity(appState, tx)
+	if err != nil {
+		return err
 	}
 	if !common.ZeroOrNil(tx.AmountOrZero()) {
 		return InvalidAmount
@@ -412,13 +558,16 @@ func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transac
 	if appState.State.ValidationPeriod() < state.LongSessionPeriod && txType == InBlockTx {
 		return EarlyTx
 	}
+	if txType != InBlockTx && appState.State.ValidationPeriod() < state.FlipLotteryPeriod {
+		return NoValidationSession
+	}
 
-	identity := appState.State.GetIdentity(sender)
+	identity := appState.State.GetIdentity(candidate)
 	if !state.IsCeremonyCandidate(identity) {
 		return NotCandidate
 	}
 
-	if err := validateCeremonyTx(sender, appState, tx); err != nil {
+	if err := validateCeremonyTx(candidate, appState, tx); err != nil {
 		return err
 	}
 
@@ -432,10 +581,9 @@ func validateSubmitShortAnswersTx(appState *appstate.AppState, tx *types.Transac
 }
 
 func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
-	sender, _ := types.Sender(tx)
-
-	if tx.To != nil {
-		return InvalidRecipient
+	candidate, err := ceremonyTxIdentity(appState, tx)
+	if err != nil {
+		return err
 	}
 	if !common.ZeroOrNil(tx.AmountOrZero()) {
 		return InvalidAmount
@@ -446,11 +594,14 @@ func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transact
 	if appState.State.ValidationPeriod() < state.ShortSessionPeriod && txType == InBlockTx {
 		return EarlyTx
 	}
-	if !state.IsCeremonyCandidate(appState.State.GetIdentity(sender)) {
+	if txType != InBlockTx && appState.State.ValidationPeriod() < state.FlipLotteryPeriod {
+		return NoValidationSession
+	}
+	if !state.IsCeremonyCandidate(appState.State.GetIdentity(candidate)) {
 		return NotCandidate
 	}
 
-	if err := validateCeremonyTx(sender, appState, tx); err != nil {
+	if err := validateCeremonyTx(candidate, appState, tx); err != nil {
 		return err
 	}
 
@@ -467,6 +618,10 @@ func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transact
 
 	seed := appState.State.FlipWordsSeed()
 	rawPubKey, _ := types.SenderPubKey(tx)
+	if tx.To != nil {
+		// the proof of the delegated answers is generated by the identity, not by the answers submitter
+		rawPubKey = appState.State.GetIdentity(candidate).PubKey
+	}
 	pubKey, err := crypto.UnmarshalPubkey(rawPubKey)
 	if err != nil {
 		return err
@@ -484,10 +639,9 @@ func validateSubmitLongAnswersTx(appState *appstate.AppState, tx *types.Transact
 }
 
 func validateEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
-	sender, _ := types.Sender(tx)
-
-	if tx.To != nil {
-		return InvalidRecipient
+	candidate, err := ceremonyTxIdentity(appState, tx)
+	if err != nil {
+		return err
 	}
 	if !common.ZeroOrNil(tx.AmountOrZero()) {
 		return InvalidAmount
@@ -495,10 +649,13 @@ func validateEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txTy
 	if appState.State.ValidationPeriod() < state.LongSessionPeriod && txType == InBlockTx {
 		return EarlyTx
 	}
-	if !state.IsCeremonyCandidate(appState.State.GetIdentity(sender)) {
+	if txType != InBlockTx && appState.State.ValidationPeriod() < state.FlipLotteryPeriod {
+		return NoValidationSession
+	}
+	if !state.IsCeremonyCandidate(appState.State.GetIdentity(candidate)) {
 		return NotCandidate
 	}
-	if err := validateCeremonyTx(sender, appState, tx); err != nil {
+	if err := validateCeremonyTx(candidate, appState, tx); err != nil {
 		return err
 	}
 	return nil
@@ -584,20 +741,45 @@ func validateKillInviteeTx(appState *appstate.AppState, tx *types.Transaction, t
 }
 
 func validateChangeGodAddressTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
-	sender, _ := types.Sender(tx)
 	if tx.To == nil || *tx.To == (common.Address{}) {
 		return RecipientRequired
 	}
 	if !common.ZeroOrNil(tx.AmountOrZero()) {
 		return InvalidAmount
 	}
-	if sender != appState.State.GodAddress() {
+	if !IsGodTx(appState, tx) {
 		return InvalidSender
 	}
 	if appState.State.ValidationPeriod() >= state.FlipLotteryPeriod {
 		return LateTx
 	}
+	if appCfg != nil && appCfg.Consensus.EnableGodMultisig && appState.State.GetCodeHash(*tx.To) != nil &&
+		!embedded.IsInitializedMultisig(appState.State, *tx.To) {
+		return InvalidRecipient
+	}
+
+	return nil
+}
+
+// IsGodTx checks that tx is sent by the god address or, if the god address is a multisig contract,
+// by its signer with the tx approved by the required number of signers
+func IsGodTx(appState *appstate.AppState, tx *types.Transaction) bool {
+	sender, _ := types.Sender(tx)
+	god := appState.State.GodAddress()
+	if sender == god {
+		return true
+	}
+	if appCfg == nil || !appCfg.Consensus.EnableGodMultisig || tx.To == nil {
+		return false
+	}
+	return embedded.GodTxApproved(appState.State, god, sender, tx.Type, *tx.To, godTxApprovalData(tx))
+}
 
+// godTxApprovalData returns the part of god tx which should be approved besides its type and recipient
+func godTxApprovalData(tx *types.Transaction) []byte {
+	if tx.Type == types.ChangeBlockGasLimitTx || tx.Type == types.ChangeProposerThresholdTx || tx.Type == types.ChangeWordsDictionaryTx {
+		return tx.Payload
+	}
 	return nil
 }
 
@@ -834,3 +1016,189 @@ func validateStoreToIpfsTx(appState *appstate.AppState, tx *types.Transaction, t
 	}
 	return nil
 }
+
+func validateLockStakeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	if tx.To == nil || *tx.To == (common.Address{}) {
+		return RecipientRequired
+	}
+	if tx.AmountOrZero().Sign() == 0 {
+		return InvalidAmount
+	}
+	if tx.AmountOrZero().Cmp(MinStakeLockAmount) < 0 {
+		return LowStakeLockAmount
+	}
+	attachment := attachments.ParseLockStakeAttachment(tx)
+	if attachment == nil || attachment.Epochs == 0 || attachment.Epochs > MaxStakeLockEpochs || attachment.Delay > MaxStakeLockEpochs {
+		return InvalidPayload
+	}
+	if uint32(appState.State.Epoch())+uint32(attachment.Delay)+uint32(attachment.Epochs) > math.MaxUint16 {
+		return InvalidPayload
+	}
+	if len(appState.State.GetStakeLocks(*tx.To)) >= MaxStakeLocks {
+		return TooManyStakeLocks
+	}
+	return nil
+}
+
+func validateUnlockStakeTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	sender, _ := types.Sender(tx)
+	if appState.State.GetReleasableStake(sender, appState.State.Epoch()).Sign() == 0 {
+		return NothingToUnlock
+	}
+	return nil
+}
+
+func validateChangeBlockGasLimitTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	// the recipient is the god address, so the tx can be approved by the god multisig signers
+	if tx.To == nil || *tx.To != appState.State.GodAddress() {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	attachment := attachments.ParseChangeBlockGasLimitAttachment(tx)
+	if attachment == nil || attachment.GasLimit < types.MinBlockGasLimit || attachment.GasLimit > types.MaxBlockGasLimit {
+		return InvalidPayload
+	}
+	if !IsGodTx(appState, tx) {
+		return InvalidSender
+	}
+	return nil
+}
+
+func validateChangeProposerThresholdTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	// the recipient is the god address, so the tx can be approved by the god multisig signers
+	if tx.To == nil || *tx.To != appState.State.GodAddress() {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	// zero threshold resets the threshold to the one of the network config
+	attachment := attachments.ParseChangeProposerThresholdAttachment(tx)
+	if attachment == nil || !(attachment.Threshold >= 0 && attachment.Threshold <= MaxGovernedProposerThreshold) {
+		return InvalidPayload
+	}
+	if !IsGodTx(appState, tx) {
+		return InvalidSender
+	}
+	return nil
+}
+
+func validateChangeWordsDictionaryTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	// the recipient is the god address, so the tx can be approved by the god multisig signers
+	if tx.To == nil || *tx.To != appState.State.GodAddress() {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	// zero hash with zero size resets the dictionary to the built-in one
+	attachment := attachments.ParseChangeWordsDictionaryAttachment(tx)
+	if attachment == nil {
+		return InvalidPayload
+	}
+	if attachment.Hash == (common.Hash{}) {
+		if attachment.Size != 0 {
+			return InvalidPayload
+		}
+	} else if attachment.Size < types.MinWordsDictionarySize || attachment.Size > types.MaxWordsDictionarySize {
+		return InvalidPayload
+	}
+	if !IsGodTx(appState, tx) {
+		return InvalidSender
+	}
+	return nil
+}
+
+func validateMisbehaviorEvidenceTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	attachment := attachments.ParseMisbehaviorEvidenceAttachment(tx)
+	if attachment == nil {
+		return InvalidPayload
+	}
+	return ValidateMisbehaviorEvidence(appState, attachment.Vote1, attachment.Vote2)
+}
+
+// ValidateMisbehaviorEvidence checks that the votes are signed by the same validator for the same step of a recent round
+// and parent block, but for different blocks, and the validator is not penalized for this or a later round yet
+func ValidateMisbehaviorEvidence(appState *appstate.AppState, vote1, vote2 *types.Vote) error {
+	if appCfg == nil || !appCfg.Consensus.EnableMisbehaviorEvidence {
+		return errors.Wrap(InvalidEvidence, "misbehavior evidence is not enabled")
+	}
+	if !vote1.IsValid() || !vote2.IsValid() {
+		return InvalidEvidence
+	}
+	header1, header2 := vote1.Header, vote2.Header
+	if header1.Round != header2.Round || header1.Step != header2.Step || header1.ParentHash != header2.ParentHash ||
+		header1.VotedHash == header2.VotedHash {
+		return errors.Wrap(InvalidEvidence, "votes are not conflicting")
+	}
+	offender := vote1.VoterAddr()
+	if offender.IsEmpty() || offender != vote2.VoterAddr() {
+		return errors.Wrap(InvalidEvidence, "votes are signed by different identities")
+	}
+	height := uint64(appState.State.Version()) + 1
+	if header1.Round >= height || header1.Round+appCfg.Consensus.MisbehaviorEvidenceLifetime < height {
+		return errors.Wrapf(InvalidEvidence, "round: %v, block height: %v", header1.Round, height)
+	}
+	if !appState.ValidatorsCache.Contains(offender) && !appState.ValidatorsCache.IsPool(offender) {
+		return errors.Wrap(InvalidEvidence, "offender is not a validator")
+	}
+	if round, ok := appState.State.LastMisbehaviorRound(offender); ok && round >= header1.Round {
+		return errors.Wrap(InvalidEvidence, "offender is already penalized")
+	}
+	return nil
+}
+
+func validateRegisterBlsKeyTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	if tx.To != nil {
+		return InvalidRecipient
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	attachment := attachments.ParseRegisterBlsKeyAttachment(tx)
+	if attachment == nil || bls.ValidatePublicKey(attachment.PubKey) != nil {
+		return InvalidPayload
+	}
+	if !bls.VerifyProof(attachment.PubKey, attachment.Proof) {
+		return errors.Wrap(InvalidPayload, "invalid proof of possession")
+	}
+	return nil
+}
+
+// validateAuthorizeAnswersSubmitterTx checks the tx which authorizes the recipient to submit ceremony answers
+// of the sender, the tx sent to the sender address revokes the authorization
+func validateAuthorizeAnswersSubmitterTx(appState *appstate.AppState, tx *types.Transaction, txType TxType) error {
+	sender, _ := types.Sender(tx)
+
+	if tx.To == nil {
+		return RecipientRequired
+	}
+	if !common.ZeroOrNil(tx.AmountOrZero()) {
+		return InvalidAmount
+	}
+	if len(tx.Payload) > 0 {
+		return InvalidPayload
+	}
+	if identityState := appState.State.GetIdentityState(sender); identityState == state.Undefined || identityState == state.Killed {
+		return NotIdentity
+	}
+	// the submitter can't be changed once answers of the ceremony are accepted
+	if appState.State.ValidationPeriod() >= state.ShortSessionPeriod {
+		return LateTx
+	}
+	return nil
+}
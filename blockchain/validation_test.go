@@ -4,6 +4,8 @@ import (
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/stretchr/testify/require"
 	"math/big"
@@ -38,3 +40,38 @@ func Test_ValidateDeleteFlipTx(t *testing.T) {
 	err = validation.ValidateTx(appState, tx, minFeePerGas, validation.InBlockTx)
 	require.Equal(t, nil, err)
 }
+
+func Test_ValidateLockStakeTx(t *testing.T) {
+	_, appState, _, key := NewTestBlockchain(true, nil)
+	validation.SetAppConfig(&config.Config{Consensus: config.ConsensusVersions[config.ConsensusV6]})
+	defer validation.SetAppConfig(nil)
+	minFeePerGas := big.NewInt(1)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	receiver := common.Address{0x1}
+	appState.State.SetBalance(sender, new(big.Int).Mul(common.DnaBase, big.NewInt(10)))
+
+	createTx := func(amount *big.Int) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: 1,
+			Type:         types.LockStakeTx,
+			To:           &receiver,
+			Amount:       amount,
+			Payload:      attachments.CreateLockStakeAttachment(0, 1),
+			MaxFee:       big.NewInt(700_000_0),
+		}, key)
+		return tx
+	}
+
+	tx := createTx(new(big.Int).Sub(validation.MinStakeLockAmount, big.NewInt(1)))
+	require.Equal(t, validation.LowStakeLockAmount, validation.ValidateTx(appState, tx, minFeePerGas, validation.InBlockTx))
+
+	tx = createTx(validation.MinStakeLockAmount)
+	for i := 0; i < validation.MaxStakeLocks-1; i++ {
+		appState.State.AddStakeLock(receiver, common.Address{0x2}, validation.MinStakeLockAmount, 1, 1)
+	}
+	require.NoError(t, validation.ValidateTx(appState, tx, minFeePerGas, validation.InBlockTx))
+
+	// locks of all senders take slots of the recipient
+	appState.State.AddStakeLock(receiver, common.Address{0x3}, validation.MinStakeLockAmount, 1, 1)
+	require.Equal(t, validation.TooManyStakeLocks, validation.ValidateTx(appState, tx, minFeePerGas, validation.InBlockTx))
+}
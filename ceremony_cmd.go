@@ -0,0 +1,136 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/ceremony"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/idena-network/idena-go/node"
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"io/ioutil"
+)
+
+var (
+	auditBlockFlag = cli.Uint64Flag{
+		Name:  "block",
+		Usage: "Height of the block which finished the validation",
+	}
+	auditCandidatesFlag = cli.StringFlag{
+		Name:  "candidates",
+		Usage: "Json file with candidates and their flips at the start of the flip lottery: [{\"address\":\"0x..\",\"flips\":[\"cid\",..]},..]",
+	}
+	auditSeedFlag = cli.StringFlag{
+		Name:  "seed",
+		Usage: "Expected lottery seed in hex, the seed of the chain is used if not set",
+	}
+
+	ceremonyCommand = cli.Command{
+		Name:  "ceremony",
+		Usage: "Validation ceremony tools",
+		Subcommands: []cli.Command{
+			{
+				Name:   "audit-lottery",
+				Usage:  "Recompute the flip lottery for the given candidates and verify submitted answers against it, the node should be stopped",
+				Flags:  []cli.Flag{auditBlockFlag, auditCandidatesFlag, auditSeedFlag},
+				Action: auditFlipLottery,
+			},
+		},
+	}
+)
+
+type auditedCandidate struct {
+	Address common.Address `json:"address"`
+	Flips   []string       `json:"flips"`
+}
+
+func readLotteryCandidates(file string) ([]*ceremony.LotteryCandidate, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var items []auditedCandidate
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, errors.Wrap(err, "cannot parse candidates")
+	}
+	result := make([]*ceremony.LotteryCandidate, 0, len(items))
+	for _, item := range items {
+		candidate := &ceremony.LotteryCandidate{
+			Address: item.Address,
+		}
+		for _, hash := range item.Flips {
+			c, err := cid.Decode(hash)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid flip %v of %v", hash, item.Address.Hex())
+			}
+			candidate.Flips = append(candidate.Flips, c.Bytes())
+		}
+		result = append(result, candidate)
+	}
+	return result, nil
+}
+
+func auditFlipLottery(ctx *cli.Context) error {
+	if !ctx.IsSet(auditBlockFlag.Name) || !ctx.IsSet(auditCandidatesFlag.Name) {
+		return errors.New("block and candidates should be specified")
+	}
+	candidates, err := readLotteryCandidates(ctx.String(auditCandidatesFlag.Name))
+	if err != nil {
+		return err
+	}
+	var seed []byte
+	if ctx.IsSet(auditSeedFlag.Name) {
+		if seed, err = hexutil.Decode(ctx.String(auditSeedFlag.Name)); err != nil {
+			return errors.Wrap(err, "invalid seed")
+		}
+	}
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("head block is not found")
+	}
+	bus := eventbus.New()
+	appState, err := appstate.NewAppState(db, bus)
+	if err != nil {
+		return err
+	}
+	// block bodies are stored in ipfs
+	ipfsProxy, err := ipfs.NewIpfsProxy(cfg.IpfsConf, bus)
+	if err != nil {
+		return err
+	}
+	chain := blockchain.NewBlockchain(cfg, db, nil, appState, ipfsProxy, nil, bus, nil, nil, nil, nil)
+	genesisHeight := repo.ReadIntermediateGenesis()
+
+	audit, err := ceremony.AuditFlipLottery(chain, genesisHeight, ctx.Uint64(auditBlockFlag.Name), seed, candidates)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Flip lottery started at block %v, seed %v of block %v\n", audit.LotteryBlock, hexutil.Encode(audit.Seed), audit.SeedBlock)
+	fmt.Printf("Candidates: %v, short answers: %v, long answers: %v\n", len(audit.Assignments), audit.ShortAnswers, audit.LongAnswers)
+	for _, mismatch := range audit.Mismatches {
+		fmt.Printf("MISMATCH %v answers %v of %v: %v\n", mismatch.Session, mismatch.TxHash.Hex(), mismatch.Address.Hex(), mismatch.Reason)
+	}
+	if len(audit.Mismatches) > 0 {
+		return errors.Errorf("%v answers don't match the flip lottery", len(audit.Mismatches))
+	}
+	fmt.Println("All answers match the flip lottery")
+	return nil
+}
@@ -67,6 +67,7 @@ func main() {
 		}
 
 		globalObject := appState.State.GetOrNewGlobalObject()
+		wordsDictionary, nextWordsDictionary := globalObject.WordsDictionaries()
 
 		snapshot.Global = &models.ProtoPredefinedState_Global{
 			LastSnapshot:                  globalObject.LastSnapshot(),
@@ -82,6 +83,11 @@ func main() {
 			EmptyBlocksBits:               common.BigIntBytesOrNil(globalObject.EmptyBlocksBits()),
 			GodAddressInvites:             uint32(globalObject.GodAddressInvites()),
 			BlocksCntWithoutCeremonialTxs: uint32(globalObject.BlocksCntWithoutCeremonialTxs()),
+			BlockGasLimit:                 globalObject.BlockGasLimitRaw(),
+			Misbehaviors:                  state.MisbehaviorsToProto(globalObject.Misbehaviors()),
+			MinProposerThreshold:          globalObject.MinProposerThresholdRaw(),
+			WordsDictionary:               state.WordsDictionaryToProto(wordsDictionary),
+			NextWordsDictionary:           state.WordsDictionaryToProto(nextWordsDictionary),
 		}
 
 		snapshot.StatusSwitch = &models.ProtoPredefinedState_StatusSwitch{
@@ -168,6 +174,9 @@ func main() {
 			if data.Delegatee != nil {
 				identity.Delegatee = data.Delegatee.Bytes()
 			}
+			if data.AnswersSubmitter != nil {
+				identity.AnswersSubmitter = data.AnswersSubmitter.Bytes()
+			}
 			for idx := range data.Invitees {
 				identity.Invitees = append(identity.Invitees, &models.ProtoPredefinedState_Identity_TxAddr{
 					Hash:    data.Invitees[idx].TxHash[:],
@@ -200,9 +209,10 @@ func main() {
 				return false
 			}
 			identity := &models.ProtoPredefinedState_ApprovedIdentity{
-				Address:  addr[:],
-				Approved: data.Approved,
-				Online:   false,
+				Address:   addr[:],
+				Approved:  data.Approved,
+				Online:    false,
+				BlsPubKey: data.BlsPubKey,
 			}
 			if data.Delegatee != nil {
 				identity.Delegatee = data.Delegatee.Bytes()
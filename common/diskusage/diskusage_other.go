@@ -0,0 +1,10 @@
+// +build !linux,!darwin,!freebsd,!windows
+
+package diskusage
+
+import "errors"
+
+// Free returns the number of bytes available to the process on the file system of the path
+func Free(path string) (uint64, error) {
+	return 0, errors.New("free disk space is not supported on this platform")
+}
@@ -0,0 +1,16 @@
+package diskusage
+
+import (
+	"os"
+	"testing"
+)
+
+func TestFree(t *testing.T) {
+	free, err := Free(os.TempDir())
+	if err != nil {
+		t.Fatalf("failed to retrieve free disk space: %v", err)
+	}
+	if free == 0 {
+		t.Fatal("free disk space of the temp dir is zero")
+	}
+}
@@ -0,0 +1,16 @@
+package diskusage
+
+import "golang.org/x/sys/windows"
+
+// Free returns the number of bytes available to the process on the file system of the path
+func Free(path string) (uint64, error) {
+	dir, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var free uint64
+	if err := windows.GetDiskFreeSpaceEx(dir, &free, nil, nil); err != nil {
+		return 0, err
+	}
+	return free, nil
+}
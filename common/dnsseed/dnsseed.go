@@ -0,0 +1,102 @@
+package dnsseed
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+)
+
+// A bootstrap node is published as a TXT record of the seed domain:
+//
+//	idena-boot=<multiaddr>;sig=<hex signature of the multiaddr hash>
+//
+// so the set of bootstrap nodes can be changed by updating DNS records without client releases
+const (
+	recordPrefix    = "idena-boot="
+	signatureMarker = ";sig="
+
+	lookupTimeout = 10 * time.Second
+)
+
+// LookupTXT resolves TXT records of the domain
+type LookupTXT func(ctx context.Context, name string) ([]string, error)
+
+// SignRecord creates the TXT record of the bootstrap node signed by the key
+func SignRecord(addr string, key *ecdsa.PrivateKey) (string, error) {
+	hash := crypto.Hash([]byte(addr))
+	sig, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		return "", err
+	}
+	return recordPrefix + addr + signatureMarker + hexutil.Encode(sig), nil
+}
+
+// ParseRecord returns the multiaddr of the bootstrap node and the address which signed the record
+func ParseRecord(record string) (addr string, signer common.Address, err error) {
+	if !strings.HasPrefix(record, recordPrefix) {
+		return "", common.Address{}, errors.New("not a bootstrap record")
+	}
+	body := strings.TrimPrefix(record, recordPrefix)
+	idx := strings.LastIndex(body, signatureMarker)
+	if idx <= 0 {
+		return "", common.Address{}, errors.New("record is not signed")
+	}
+	addr = body[:idx]
+	sig, err := hexutil.Decode(body[idx+len(signatureMarker):])
+	if err != nil {
+		return "", common.Address{}, errors.Wrap(err, "invalid record signature")
+	}
+	hash := crypto.Hash([]byte(addr))
+	pubKey, err := crypto.SigToPub(hash[:], sig)
+	if err != nil {
+		return "", common.Address{}, errors.Wrap(err, "invalid record signature")
+	}
+	return addr, crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// Resolve returns multiaddrs of bootstrap nodes published by the domains and signed by the signer,
+// records with other signers and domains which cannot be resolved are skipped
+func Resolve(names []string, signer common.Address, lookup LookupTXT) []string {
+	if lookup == nil {
+		lookup = net.DefaultResolver.LookupTXT
+	}
+	var result []string
+	seen := make(map[string]struct{})
+	for _, name := range names {
+		ctx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+		records, err := lookup(ctx, name)
+		cancel()
+		if err != nil {
+			log.Warn("Failed to resolve bootstrap DNS records", "name", name, "err", err)
+			continue
+		}
+		for _, record := range records {
+			if !strings.HasPrefix(record, recordPrefix) {
+				continue
+			}
+			addr, recordSigner, err := ParseRecord(record)
+			if err != nil {
+				log.Warn("Invalid bootstrap DNS record", "name", name, "err", err)
+				continue
+			}
+			if recordSigner != signer {
+				log.Warn("Bootstrap DNS record is signed by unknown address", "name", name, "signer", recordSigner.Hex())
+				continue
+			}
+			if _, ok := seen[addr]; ok {
+				continue
+			}
+			seen[addr] = struct{}{}
+			result = append(result, addr)
+		}
+	}
+	return result
+}
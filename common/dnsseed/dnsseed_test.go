@@ -0,0 +1,46 @@
+package dnsseed
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	otherKey, _ := crypto.GenerateKey()
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	addr1 := "/ip4/127.0.0.1/tcp/40405/ipfs/QmfJktBd2jf37Jx3eCYyn1fofbW511U5XvYiMp7233mLZM"
+	addr2 := "/ip4/127.0.0.2/tcp/40405/ipfs/QmNYWtiwM1UfeCmHfWSdefrMuQdg6nycY5yS64HYqWCUhD"
+	record1, err := SignRecord(addr1, key)
+	require.NoError(t, err)
+	record2, _ := SignRecord(addr2, key)
+	foreignRecord, _ := SignRecord("/ip4/127.0.0.3/tcp/40405/ipfs/QmZ9VnVZsokXEttRYiHbHmCUBSdzSQywjj5wM3Me96XoVD", otherKey)
+
+	parsedAddr, parsedSigner, err := ParseRecord(record1)
+	require.NoError(t, err)
+	require.Equal(t, addr1, parsedAddr)
+	require.Equal(t, signer, parsedSigner)
+
+	_, _, err = ParseRecord("idena-boot=" + addr1)
+	require.Error(t, err)
+
+	records := map[string][]string{
+		"seed1.example": {"v=spf1 -all", record1, foreignRecord, "idena-boot=broken;sig=0x01"},
+		"seed2.example": {record2, record1},
+	}
+	lookup := func(ctx context.Context, name string) ([]string, error) {
+		if r, ok := records[name]; ok {
+			return r, nil
+		}
+		return nil, errors.New("no such host")
+	}
+	require.Equal(t, []string{addr1, addr2}, Resolve([]string{"seed1.example", "missing.example", "seed2.example"}, signer, lookup))
+	require.Empty(t, Resolve([]string{"seed1.example"}, crypto.PubkeyToAddress(otherKey.PublicKey), func(ctx context.Context, name string) ([]string, error) {
+		return []string{record1}, nil
+	}))
+}
@@ -0,0 +1,189 @@
+// Package erasure implements the systematic Reed-Solomon erasure code over GF(2^8),
+// data split into k data shards and m parity shards can be recovered from any k of them
+package erasure
+
+import (
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxShards is the maximal total number of data and parity shards
+	MaxShards = 256
+	// generator polynomial x^8 + x^4 + x^3 + x^2 + 1
+	fieldPolynomial = 0x11d
+)
+
+var (
+	ErrInvalidShardsCount = errors.New("invalid number of shards")
+	ErrTooFewShards       = errors.New("too few shards to reconstruct data")
+	ErrShardSize          = errors.New("shards have different sizes")
+)
+
+var (
+	expTable [2 * 255]byte
+	logTable [256]byte
+)
+
+func init() {
+	x := 1
+	for i := 0; i < 255; i++ {
+		expTable[i] = byte(x)
+		expTable[i+255] = byte(x)
+		logTable[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= fieldPolynomial
+		}
+	}
+}
+
+func mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return expTable[int(logTable[a])+int(logTable[b])]
+}
+
+func inv(a byte) byte {
+	return expTable[255-int(logTable[a])]
+}
+
+// matrixRow returns coefficients of the shard by data shards: rows of data shards form the identity matrix
+// and rows of parity shards form the Cauchy matrix, so any dataShards rows are linearly independent
+func matrixRow(shard int, dataShards int) []byte {
+	row := make([]byte, dataShards)
+	if shard < dataShards {
+		row[shard] = 1
+		return row
+	}
+	for j := range row {
+		row[j] = inv(byte(shard) ^ byte(j))
+	}
+	return row
+}
+
+func validateCounts(dataShards, parityShards int) error {
+	if dataShards <= 0 || parityShards < 0 || dataShards+parityShards > MaxShards {
+		return ErrInvalidShardsCount
+	}
+	return nil
+}
+
+// Encode splits data into dataShards equal shards padding the last one with zeros and appends parityShards parity shards
+func Encode(data []byte, dataShards, parityShards int) ([][]byte, error) {
+	if err := validateCounts(dataShards, parityShards); err != nil {
+		return nil, err
+	}
+	shardSize := (len(data) + dataShards - 1) / dataShards
+	if shardSize == 0 {
+		shardSize = 1
+	}
+	padded := make([]byte, shardSize*dataShards)
+	copy(padded, data)
+
+	shards := make([][]byte, dataShards+parityShards)
+	for i := 0; i < dataShards; i++ {
+		shards[i] = padded[i*shardSize : (i+1)*shardSize]
+	}
+	for i := dataShards; i < len(shards); i++ {
+		shards[i] = combine(matrixRow(i, dataShards), shards[:dataShards], shardSize)
+	}
+	return shards, nil
+}
+
+// Reconstruct restores data of the given size from shards produced by Encode, missing shards should be nil
+func Reconstruct(shards [][]byte, dataShards, parityShards int, size int) ([]byte, error) {
+	if err := validateCounts(dataShards, parityShards); err != nil {
+		return nil, err
+	}
+	if len(shards) != dataShards+parityShards {
+		return nil, ErrInvalidShardsCount
+	}
+	shardSize := -1
+	rows := make([][]byte, 0, dataShards)
+	present := make([][]byte, 0, dataShards)
+	for i, shard := range shards {
+		if shard == nil {
+			continue
+		}
+		if shardSize < 0 {
+			shardSize = len(shard)
+		} else if len(shard) != shardSize {
+			return nil, ErrShardSize
+		}
+		if len(rows) < dataShards {
+			rows = append(rows, matrixRow(i, dataShards))
+			present = append(present, shard)
+		}
+	}
+	if len(rows) < dataShards {
+		return nil, ErrTooFewShards
+	}
+	if size < 0 || size > shardSize*dataShards {
+		return nil, errors.Errorf("invalid data size %v", size)
+	}
+	decoding, err := invertMatrix(rows)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, shardSize*dataShards)
+	for i := 0; i < dataShards; i++ {
+		data = append(data, combine(decoding[i], present, shardSize)...)
+	}
+	return data[:size], nil
+}
+
+// combine calculates the linear combination of shards with the given coefficients
+func combine(coefficients []byte, shards [][]byte, shardSize int) []byte {
+	result := make([]byte, shardSize)
+	for j, c := range coefficients {
+		if c == 0 {
+			continue
+		}
+		for b, value := range shards[j] {
+			result[b] ^= mul(c, value)
+		}
+	}
+	return result
+}
+
+// invertMatrix inverts the square matrix with Gauss-Jordan elimination
+func invertMatrix(matrix [][]byte) ([][]byte, error) {
+	n := len(matrix)
+	work := make([][]byte, n)
+	for i := range matrix {
+		work[i] = make([]byte, 2*n)
+		copy(work[i], matrix[i])
+		work[i][n+i] = 1
+	}
+	for col := 0; col < n; col++ {
+		pivot := col
+		for pivot < n && work[pivot][col] == 0 {
+			pivot++
+		}
+		if pivot == n {
+			return nil, errors.New("matrix is singular")
+		}
+		work[col], work[pivot] = work[pivot], work[col]
+		if c := work[col][col]; c != 1 {
+			factor := inv(c)
+			for j := range work[col] {
+				work[col][j] = mul(work[col][j], factor)
+			}
+		}
+		for row := 0; row < n; row++ {
+			if row == col || work[row][col] == 0 {
+				continue
+			}
+			factor := work[row][col]
+			for j := range work[row] {
+				work[row][j] ^= mul(factor, work[col][j])
+			}
+		}
+	}
+	result := make([][]byte, n)
+	for i := range work {
+		result[i] = work[i][n:]
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package erasure
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconstruct(t *testing.T) {
+	const dataShards, parityShards = 4, 3
+	data := make([]byte, 1001)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	shards, err := Encode(data, dataShards, parityShards)
+	require.NoError(t, err)
+	require.Len(t, shards, dataShards+parityShards)
+	for _, shard := range shards {
+		require.Len(t, shard, 251)
+	}
+
+	// every combination of parityShards missing shards
+	for a := 0; a < len(shards); a++ {
+		for b := a + 1; b < len(shards); b++ {
+			for c := b + 1; c < len(shards); c++ {
+				received := make([][]byte, len(shards))
+				copy(received, shards)
+				received[a], received[b], received[c] = nil, nil, nil
+				restored, err := Reconstruct(received, dataShards, parityShards, len(data))
+				require.NoError(t, err)
+				require.Equal(t, data, restored)
+			}
+		}
+	}
+}
+
+func TestReconstruct_Errors(t *testing.T) {
+	shards, err := Encode([]byte{1, 2, 3, 4, 5}, 2, 2)
+	require.NoError(t, err)
+
+	_, err = Reconstruct([][]byte{shards[0], nil, nil, nil}, 2, 2, 5)
+	require.Equal(t, ErrTooFewShards, err)
+
+	_, err = Reconstruct([][]byte{shards[0], shards[1][:1], nil, nil}, 2, 2, 5)
+	require.Equal(t, ErrShardSize, err)
+
+	_, err = Reconstruct(shards[:3], 2, 2, 5)
+	require.Equal(t, ErrInvalidShardsCount, err)
+
+	_, err = Encode([]byte{1}, 0, 2)
+	require.Equal(t, ErrInvalidShardsCount, err)
+}
+
+func TestEncode_EmptyData(t *testing.T) {
+	shards, err := Encode(nil, 3, 1)
+	require.NoError(t, err)
+	restored, err := Reconstruct([][]byte{nil, shards[1], shards[2], shards[3]}, 3, 1, 0)
+	require.NoError(t, err)
+	require.Empty(t, restored)
+}
@@ -3,5 +3,16 @@ package config
 type BlockchainConfig struct {
 	// distance between blocks with permanent certificates
 	StoreCertRange uint64
-	BurnTxRange    uint64
+	// distance between finality checkpoints, blocks at these heights keep permanent certificates, see bcn_finalBlock
+	FinalityCheckpointRange uint64
+	BurnTxRange             uint64
+	// record balance changes with reasons, see bcn_balanceChanges
+	WriteBalanceJournal bool
+	// save txs of all addresses to the tx history, see bcn_transactions, only txs of own accounts are saved otherwise
+	IndexTxHistory bool
+	// sign state checkpoints at the beginning of each epoch, add them to ipfs and announce to peers
+	PublishCheckpoints bool
+	// lower bounds of the proposer VRF threshold of private networks by network id, all nodes of the network must
+	// use the same value, the main and test networks ignore it
+	MinProposerThresholds map[uint32]float64
 }
@@ -22,6 +22,8 @@ const (
 	datadirPrivateKey = "nodekey" // Path within the datadir to the node's private key
 	apiKeyFileName    = "api.key"
 	LowPowerProfile   = "lowpower"
+	// ValidationOnlyProfile is the low power profile of the node which keeps only the data needed to pass validation
+	ValidationOnlyProfile = "validationonly"
 )
 
 type Config struct {
@@ -37,6 +39,11 @@ type Config struct {
 	OfflineDetection *OfflineDetectionConfig
 	Blockchain       *BlockchainConfig
 	Mempool          *Mempool
+	GraphQL          *GraphQLConfig
+	ConsensusArchive *ConsensusArchiveConfig
+	StepTimeouts     *StepTimeoutsConfig
+	Metrics          *MetricsConfig
+	Grpc             *GrpcConfig
 }
 
 func (c *Config) ProvideNodeKey(key string, password string, withBackup bool) error {
@@ -121,6 +128,25 @@ func (c *Config) NodeDB() string {
 	return filepath.Join(c.DataDir, "nodes")
 }
 
+// ForensicsDir returns the path to the directory with dumps of rejected blocks, blocks are not dumped if no datadir is being used
+func (c *Config) ForensicsDir() string {
+	if c.DataDir == "" {
+		return ""
+	}
+	return filepath.Join(c.DataDir, "forensics")
+}
+
+// MinProposerThreshold returns the lower bound of the proposer VRF threshold of a private network,
+// networks without the threshold in the blockchain config use the one of the consensus version
+func (c *Config) MinProposerThreshold() float64 {
+	if c.Blockchain != nil {
+		if threshold, ok := c.Blockchain.MinProposerThresholds[c.Network]; ok {
+			return threshold
+		}
+	}
+	return c.Consensus.MinProposerThreshold
+}
+
 func (c *Config) KeyStoreDataDir() (string, error) {
 	instanceDir := filepath.Join(c.DataDir, "keystore")
 	if err := os.MkdirAll(instanceDir, 0700); err != nil {
@@ -137,8 +163,7 @@ func (c *Config) SetApiKey() error {
 		data, _ := ioutil.ReadFile(apiKeyFile)
 		key := strings.TrimSpace(string(data))
 		if key == "" {
-			randomKey, _ := crypto.GenerateKey()
-			key = hex.EncodeToString(crypto.FromECDSA(randomKey)[:16])
+			key = generateApiKey()
 		} else {
 			shouldSaveKey = false
 		}
@@ -157,6 +182,17 @@ func (c *Config) SetApiKey() error {
 	return nil
 }
 
+// RotateApiKey replaces the API key by a random one and saves it to the data dir
+func (c *Config) RotateApiKey() (string, error) {
+	c.RPC.APIKey = generateApiKey()
+	return c.RPC.APIKey, c.SetApiKey()
+}
+
+func generateApiKey() string {
+	randomKey, _ := crypto.GenerateKey()
+	return hex.EncodeToString(crypto.FromECDSA(randomKey)[:16])
+}
+
 func MakeMobileConfig(path string, cfg string) (*Config, error) {
 	conf := getDefaultConfig(filepath.Join(path, DefaultDataDir))
 
@@ -170,6 +206,7 @@ func MakeMobileConfig(path string, cfg string) (*Config, error) {
 	} else {
 		log.Info("using default config")
 	}
+	applyValidationOnlyMode(conf)
 
 	return conf, nil
 }
@@ -184,11 +221,15 @@ func MakeConfig(ctx *cli.Context, cfgTransform func(cfg *Config)) (*Config, erro
 	}
 	cfgTransform(cfg)
 	applyFlags(ctx, cfg)
+	if err := cfg.Validation.validateTimings(cfg.Network); err != nil {
+		return nil, err
+	}
 	return cfg, nil
 }
 
 func applyProfile(ctx *cli.Context, cfg *Config) {
-	if ctx.IsSet(ProfileFlag.Name) && ctx.String(ProfileFlag.Name) == LowPowerProfile {
+	profile := ctx.String(ProfileFlag.Name)
+	if ctx.IsSet(ProfileFlag.Name) && (profile == LowPowerProfile || profile == ValidationOnlyProfile) {
 		cfg.P2P.MaxInboundPeers = LowPowerMaxInboundPeers
 		cfg.P2P.MaxOutboundPeers = LowPowerMaxOutboundPeers
 		cfg.IpfsConf.LowWater = 8
@@ -213,6 +254,9 @@ func applyProfile(ctx *cli.Context, cfg *Config) {
 			cfg.IpfsConf.Routing = "dht"
 		}
 	}
+	if ctx.IsSet(ProfileFlag.Name) && profile == ValidationOnlyProfile {
+		cfg.Sync.ValidationOnly = true
+	}
 }
 
 func MakeConfigFromFile(file string) (*Config, error) {
@@ -241,9 +285,12 @@ func getDefaultConfig(dataDir string) *Config {
 			MaxInboundPeers:  DefaultMaxInboundPeers,
 			MaxOutboundPeers: DefaultMaxOutboundPeers,
 			DisableMetrics:   false,
+			SubnetPeersRatio: DefaultSubnetPeersRatio,
+			AsnPeersRatio:    DefaultAsnPeersRatio,
+			ReplayWindowSize: DefaultReplayWindowSize,
 		},
 		Consensus: GetDefaultConsensusConfig(),
-		RPC:       rpc.GetDefaultRPCConfig(DefaultRpcHost, DefaultRpcPort),
+		RPC:       getDefaultRpcConfig(),
 		GenesisConf: &GenesisConf{
 			FirstCeremonyTime: DefaultCeremonyTime,
 			GodAddress:        common.HexToAddress(DefaultGodAddress),
@@ -251,18 +298,32 @@ func getDefaultConfig(dataDir string) *Config {
 		IpfsConf:   ipfsConfig,
 		Validation: &ValidationConfig{},
 		Sync: &SyncConfig{
-			FastSync:      true,
-			ForceFullSync: DefaultForceFullSync,
+			FastSync:         true,
+			ForceFullSync:    DefaultForceFullSync,
+			StaleHeadTimeout: DefaultStaleHeadTimeout,
+			StaleHeadLag:     DefaultStaleHeadLag,
 		},
 		OfflineDetection: GetDefaultOfflineDetectionConfig(),
 		Blockchain: &BlockchainConfig{
-			StoreCertRange: DefaultStoreCertRange,
-			BurnTxRange:    DefaultBurntTxRange,
+			StoreCertRange:          DefaultStoreCertRange,
+			FinalityCheckpointRange: DefaultFinalityRange,
+			BurnTxRange:             DefaultBurntTxRange,
 		},
-		Mempool: GetDefaultMempoolConfig(),
+		Mempool:          GetDefaultMempoolConfig(),
+		GraphQL:          GetDefaultGraphQLConfig(),
+		ConsensusArchive: GetDefaultConsensusArchiveConfig(),
+		StepTimeouts:     GetDefaultStepTimeoutsConfig(),
+		Metrics:          GetDefaultMetricsConfig(),
+		Grpc:             GetDefaultGrpcConfig(),
 	}
 }
 
+func getDefaultRpcConfig() *rpc.Config {
+	rpcConfig := rpc.GetDefaultRPCConfig(DefaultRpcHost, DefaultRpcPort)
+	rpcConfig.WSPort = DefaultWsPort
+	return rpcConfig
+}
+
 func applyFlags(ctx *cli.Context, cfg *Config) {
 	applyProfile(ctx, cfg)
 	applyP2PFlags(ctx, cfg)
@@ -272,6 +333,14 @@ func applyFlags(ctx *cli.Context, cfg *Config) {
 	applyIpfsFlags(ctx, cfg)
 	applyValidationFlags(ctx, cfg)
 	applySyncFlags(ctx, cfg)
+	applyBlockchainFlags(ctx, cfg)
+	applyValidationOnlyMode(cfg)
+}
+
+func applyBlockchainFlags(ctx *cli.Context, cfg *Config) {
+	if ctx.IsSet(IndexTxHistoryFlag.Name) {
+		cfg.Blockchain.IndexTxHistory = ctx.Bool(IndexTxHistoryFlag.Name)
+	}
 }
 
 func applySyncFlags(ctx *cli.Context, cfg *Config) {
@@ -287,6 +356,33 @@ func applyP2PFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(MaxNetworkDelayFlag.Name) {
 		cfg.P2P.MaxDelay = ctx.Int(MaxNetworkDelayFlag.Name)
 	}
+	if ctx.IsSet(MaxPeerUploadRateFlag.Name) {
+		cfg.P2P.MaxPeerUploadRate = ctx.Int(MaxPeerUploadRateFlag.Name)
+	}
+	if ctx.IsSet(MaxPeerDownloadRateFlag.Name) {
+		cfg.P2P.MaxPeerDownloadRate = ctx.Int(MaxPeerDownloadRateFlag.Name)
+	}
+	if ctx.IsSet(SubnetPeersRatioFlag.Name) {
+		cfg.P2P.SubnetPeersRatio = ctx.Float64(SubnetPeersRatioFlag.Name)
+	}
+	if ctx.IsSet(AsnPeersRatioFlag.Name) {
+		cfg.P2P.AsnPeersRatio = ctx.Float64(AsnPeersRatioFlag.Name)
+	}
+	if ctx.IsSet(AsnDatabaseFlag.Name) {
+		cfg.P2P.AsnDatabase = ctx.String(AsnDatabaseFlag.Name)
+	}
+	if ctx.IsSet(NoSnapshotServingFlag.Name) {
+		cfg.P2P.DisableSnapshotServing = ctx.Bool(NoSnapshotServingFlag.Name)
+	}
+	if ctx.IsSet(ReplayWindowSizeFlag.Name) {
+		cfg.P2P.ReplayWindowSize = ctx.Int(ReplayWindowSizeFlag.Name)
+	}
+	if ctx.IsSet(NoPeerExchangeFlag.Name) {
+		cfg.P2P.DisablePeerExchange = ctx.Bool(NoPeerExchangeFlag.Name)
+	}
+	if ctx.IsSet(TrustedPeersFlag.Name) {
+		cfg.P2P.TrustedPeers = strings.Split(ctx.String(TrustedPeersFlag.Name), ",")
+	}
 }
 
 func applyConsensusFlags(ctx *cli.Context, cfg *Config) {
@@ -305,6 +401,57 @@ func applyRpcFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(ApiKeyFlag.Name) {
 		cfg.RPC.APIKey = ctx.String(ApiKeyFlag.Name)
 	}
+	if ctx.IsSet(AdminKeyFlag.Name) {
+		cfg.RPC.AdminKey = ctx.String(AdminKeyFlag.Name)
+	}
+	if ctx.IsSet(RpcReadOnlyFlag.Name) {
+		cfg.RPC.ReadOnly = ctx.Bool(RpcReadOnlyFlag.Name)
+	}
+	if ctx.IsSet(RpcCorsFlag.Name) {
+		cfg.RPC.HTTPCors = strings.Split(ctx.String(RpcCorsFlag.Name), ",")
+	}
+	if ctx.IsSet(RpcVHostsFlag.Name) {
+		cfg.RPC.HTTPVirtualHosts = strings.Split(ctx.String(RpcVHostsFlag.Name), ",")
+	}
+	if ctx.IsSet(RpcTlsCertFlag.Name) {
+		cfg.RPC.HTTPTLSCertFile = ctx.String(RpcTlsCertFlag.Name)
+	}
+	if ctx.IsSet(RpcTlsKeyFlag.Name) {
+		cfg.RPC.HTTPTLSKeyFile = ctx.String(RpcTlsKeyFlag.Name)
+	}
+	if ctx.IsSet(RpcRateLimitFlag.Name) {
+		cfg.RPC.HTTPRateLimit = ctx.Float64(RpcRateLimitFlag.Name)
+	}
+	if ctx.IsSet(RpcRateBurstFlag.Name) {
+		cfg.RPC.HTTPRateBurst = ctx.Int(RpcRateBurstFlag.Name)
+	}
+	if ctx.IsSet(RpcExpensiveRateLimitFlag.Name) {
+		cfg.RPC.ExpensiveRateLimit = ctx.Float64(RpcExpensiveRateLimitFlag.Name)
+	}
+	if ctx.IsSet(RpcTrustedProxiesFlag.Name) {
+		cfg.RPC.HTTPTrustedProxies = strings.Split(ctx.String(RpcTrustedProxiesFlag.Name), ",")
+	}
+	if ctx.IsSet(WsHostFlag.Name) {
+		cfg.RPC.WSHost = ctx.String(WsHostFlag.Name)
+	}
+	if ctx.IsSet(WsPortFlag.Name) {
+		cfg.RPC.WSPort = ctx.Int(WsPortFlag.Name)
+	}
+	if ctx.IsSet(GraphQLFlag.Name) {
+		cfg.GraphQL.Enabled = ctx.Bool(GraphQLFlag.Name)
+	}
+	if ctx.IsSet(MetricsHostFlag.Name) {
+		cfg.Metrics.HTTPHost = ctx.String(MetricsHostFlag.Name)
+	}
+	if ctx.IsSet(MetricsPortFlag.Name) {
+		cfg.Metrics.HTTPPort = ctx.Int(MetricsPortFlag.Name)
+	}
+	if ctx.IsSet(GrpcHostFlag.Name) {
+		cfg.Grpc.Host = ctx.String(GrpcHostFlag.Name)
+	}
+	if ctx.IsSet(GrpcPortFlag.Name) {
+		cfg.Grpc.Port = ctx.Int(GrpcPortFlag.Name)
+	}
 }
 
 func applyGenesisFlags(ctx *cli.Context, cfg *Config) {
@@ -328,10 +475,66 @@ func applyIpfsFlags(ctx *cli.Context, cfg *Config) {
 	if ctx.IsSet(IpfsBootNodeFlag.Name) {
 		cfg.IpfsConf.BootNodes = []string{ctx.String(IpfsBootNodeFlag.Name)}
 	}
+	if ctx.IsSet(IpfsListenHostsFlag.Name) {
+		cfg.IpfsConf.ListenHosts = strings.Split(ctx.String(IpfsListenHostsFlag.Name), ",")
+	}
+	if ctx.IsSet(IpfsAnnounceFlag.Name) {
+		cfg.IpfsConf.AnnounceAddrs = strings.Split(ctx.String(IpfsAnnounceFlag.Name), ",")
+	}
+	if ctx.IsSet(IpfsDatastoreFlag.Name) {
+		cfg.IpfsConf.Datastore = ctx.String(IpfsDatastoreFlag.Name)
+	}
+	if ctx.IsSet(IpfsDatastorePathFlag.Name) {
+		cfg.IpfsConf.DatastorePath = ctx.String(IpfsDatastorePathFlag.Name)
+	}
+	if ctx.IsSet(IpfsSocks5ProxyFlag.Name) {
+		cfg.IpfsConf.Socks5Proxy = ctx.String(IpfsSocks5ProxyFlag.Name)
+	}
+	if ctx.IsSet(IpfsNoInboundFlag.Name) {
+		cfg.IpfsConf.DisableInbound = ctx.Bool(IpfsNoInboundFlag.Name)
+	}
+	if ctx.IsSet(IpfsSecurityFlag.Name) {
+		cfg.IpfsConf.Security = ctx.String(IpfsSecurityFlag.Name)
+	}
+	if ctx.IsSet(IpfsFlipGatewaysFlag.Name) {
+		cfg.IpfsConf.FlipGateways = strings.Split(ctx.String(IpfsFlipGatewaysFlag.Name), ",")
+	}
+	if ctx.IsSet(IpfsBootDnsFlag.Name) {
+		cfg.IpfsConf.BootDnsNames = strings.Split(ctx.String(IpfsBootDnsFlag.Name), ",")
+	}
+	if ctx.IsSet(IpfsBootDnsSignerFlag.Name) {
+		cfg.IpfsConf.BootDnsSigner = ctx.String(IpfsBootDnsSignerFlag.Name)
+	}
+	if len(cfg.IpfsConf.BootDnsNames) > 0 && cfg.IpfsConf.BootDnsSigner == "" {
+		cfg.IpfsConf.BootDnsSigner = cfg.GenesisConf.GodAddress.Hex()
+	}
+	if ctx.IsSet(FlipsRetentionFlag.Name) {
+		cfg.IpfsConf.FlipsRetentionEpochs = uint16(ctx.Uint(FlipsRetentionFlag.Name))
+	}
+	if ctx.IsSet(NoFlipsGcFlag.Name) {
+		cfg.IpfsConf.DisableFlipsGc = ctx.Bool(NoFlipsGcFlag.Name)
+	}
 }
 
 func applyValidationFlags(ctx *cli.Context, cfg *Config) {
-
+	if ctx.IsSet(PrescreenFlipsFlag.Name) {
+		cfg.Validation.PrescreenFlips = ctx.Bool(PrescreenFlipsFlag.Name)
+	}
+	if ctx.IsSet(WordsDictionaryFlag.Name) {
+		cfg.Validation.WordsDictionaryFiles = append(cfg.Validation.WordsDictionaryFiles, ctx.String(WordsDictionaryFlag.Name))
+	}
+	if ctx.IsSet(ValidationIntervalFlag.Name) {
+		cfg.Validation.ValidationInterval = ctx.Duration(ValidationIntervalFlag.Name)
+	}
+	if ctx.IsSet(FlipLotteryDurationFlag.Name) {
+		cfg.Validation.FlipLotteryDuration = ctx.Duration(FlipLotteryDurationFlag.Name)
+	}
+	if ctx.IsSet(ShortSessionDurationFlag.Name) {
+		cfg.Validation.ShortSessionDuration = ctx.Duration(ShortSessionDurationFlag.Name)
+	}
+	if ctx.IsSet(LongSessionDurationFlag.Name) {
+		cfg.Validation.LongSessionDuration = ctx.Duration(LongSessionDurationFlag.Name)
+	}
 }
 
 func loadConfig(configPath string, conf *Config) error {
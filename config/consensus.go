@@ -55,7 +55,42 @@ type ConsensusConf struct {
 	EncourageEarlyInvitations         bool
 	EnableDelayedOfflinePenalty       bool
 	BurnInviteeStake                  bool
+	EnableStakeLocks                  bool
+	EnableContractStorageRent         bool
+	ContractStorageRentPerByte        *big.Int
+	ContractStorageRentGraceEpochs    uint16
+	EnableGodMultisig                 bool
+	EnableTxExpiry                    bool
+	EnableReceiptsForAllTxs           bool
+	EnableBlockGasLimitGovernance     bool
+	EnableMisbehaviorEvidence         bool
+	// EnableBlsVoteAggregation is not set by any consensus version until the bls package gets a reviewed implementation
+	EnableBlsVoteAggregation          bool
+	EnableProposerThresholdGovernance bool
+	EnableDelegatedAnswers            bool
+	EnableShardedFlipKeysPackages     bool
+	EnableWordsDictionaryGovernance   bool
+	EnableMultiSendTx                 bool
 	ReductionOneDelay                 time.Duration
+	// nil keeps the legacy fee rules, otherwise fees of the tx types are scaled by the multipliers
+	TxFeeMultipliers *TxFeeMultipliers
+	// share of the stake which is burnt for signing conflicting votes
+	MisbehaviorStakeSlashRate float32
+	// identity which signed conflicting votes is switched offline
+	MisbehaviorMiningBan bool
+	// evidence of votes older than this number of blocks is rejected
+	MisbehaviorEvidenceLifetime uint64
+	// maximal number of payments of MultiSendTx
+	MaxMultiSendPayments int
+}
+
+// TxFeeMultipliers are multipliers of the fee per gas paid by txs of the corresponding types,
+// zero multiplier makes txs of the type free
+type TxFeeMultipliers struct {
+	Payment      float32
+	SubmitFlip   float32
+	OnlineStatus float32
+	Delegation   float32
 }
 
 type ConsensusVerson uint16
@@ -68,12 +103,19 @@ const (
 
 	// Enables events sorting
 	ConsensusV5 ConsensusVerson = 5
+
+	// Enables stake locks, contract storage rent, god multisig, tx expiry, receipts for all txs,
+	// block gas limit governance, misbehavior evidence slashing, proposer threshold governance,
+	// delegated answers, sharded flip keys packages, words dictionary governance, multi-send txs
+	// and tx fee multipliers
+	ConsensusV6 ConsensusVerson = 6
 )
 
 var (
 	v3                ConsensusConf
 	v4                ConsensusConf
 	v5                ConsensusConf
+	v6                ConsensusConf
 	ConsensusVersions map[ConsensusVerson]*ConsensusConf
 )
 
@@ -125,6 +167,10 @@ func init() {
 	v5 = v4
 	ApplyConsensusVersion(ConsensusV5, &v5)
 	ConsensusVersions[ConsensusV5] = &v5
+
+	v6 = v5
+	ApplyConsensusVersion(ConsensusV6, &v6)
+	ConsensusVersions[ConsensusV6] = &v6
 }
 
 func ApplyConsensusVersion(ver ConsensusVerson, cfg *ConsensusConf) {
@@ -151,6 +197,34 @@ func ApplyConsensusVersion(ver ConsensusVerson, cfg *ConsensusConf) {
 		cfg.GenerateGenesisAfterUpgrade = true
 		cfg.StartActivationDate = time.Date(2021, 05, 11, 8, 0, 0, 0, time.UTC).Unix()
 		cfg.EndActivationDate = time.Date(2021, 05, 18, 0, 0, 0, 0, time.UTC).Unix()
+	case ConsensusV6:
+		cfg.EnableStakeLocks = true
+		cfg.EnableContractStorageRent = true
+		cfg.ContractStorageRentPerByte = big.NewInt(1e+12)
+		cfg.ContractStorageRentGraceEpochs = 3
+		cfg.EnableGodMultisig = true
+		cfg.EnableTxExpiry = true
+		cfg.EnableReceiptsForAllTxs = true
+		cfg.EnableBlockGasLimitGovernance = true
+		cfg.EnableMisbehaviorEvidence = true
+		cfg.MisbehaviorStakeSlashRate = 0
+		cfg.MisbehaviorMiningBan = true
+		cfg.MisbehaviorEvidenceLifetime = 100
+		cfg.EnableProposerThresholdGovernance = true
+		cfg.EnableDelegatedAnswers = true
+		cfg.EnableShardedFlipKeysPackages = true
+		cfg.EnableWordsDictionaryGovernance = true
+		cfg.EnableMultiSendTx = true
+		cfg.MaxMultiSendPayments = 500
+		cfg.TxFeeMultipliers = &TxFeeMultipliers{
+			Payment:      1,
+			SubmitFlip:   0,
+			OnlineStatus: 2,
+			Delegation:   1,
+		}
+		cfg.Version = ConsensusV6
+		cfg.MigrationTimeout = 0
+		cfg.GenerateGenesisAfterUpgrade = false
 	}
 }
 
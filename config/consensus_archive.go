@@ -0,0 +1,18 @@
+package config
+
+type ConsensusArchiveConfig struct {
+	// Enabled turns on archiving of proposals and votes on disk
+	Enabled bool
+	// Rounds is the number of last rounds kept in the archive
+	Rounds uint64
+	// MaxRoundSize is the max size of archived messages of one round in bytes, messages exceeding it are dropped
+	MaxRoundSize int64
+}
+
+func GetDefaultConsensusArchiveConfig() *ConsensusArchiveConfig {
+	return &ConsensusArchiveConfig{
+		Enabled:      false,
+		Rounds:       1000,
+		MaxRoundSize: 1024 * 1024,
+	}
+}
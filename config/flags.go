@@ -1,6 +1,9 @@
 package config
 
-import "github.com/urfave/cli"
+import (
+	"github.com/urfave/cli"
+	"time"
+)
 
 const (
 	DefaultDataDir          = "datadir"
@@ -14,9 +17,18 @@ const (
 	DefaultSwarmKey         = "00d6f96bb2b02a7308ad87938d6139a974b555cc029ce416641a60c46db2f531"
 	DefaultForceFullSync    = 100
 	DefaultStoreCertRange   = 2000
+	DefaultFinalityRange    = 100
 	DefaultMaxInboundPeers  = 12
+	DefaultSubnetPeersRatio = 0.25
+	DefaultAsnPeersRatio    = 0.5
+	DefaultReplayWindowSize = 50000
 	DefaultMaxOutboundPeers = 6
 	DefaultBurntTxRange     = 180
+	DefaultWsPort           = 9011
+	DefaultMetricsPort      = 9012
+	DefaultGrpcPort         = 9013
+	DefaultStaleHeadTimeout = 5 * time.Minute
+	DefaultStaleHeadLag     = 3
 
 	LowPowerMaxInboundPeers  = 6
 	LowPowerMaxOutboundPeers = 3
@@ -49,6 +61,34 @@ var (
 		Name:  "rpcport",
 		Usage: "RPC listening port",
 	}
+	GraphQLFlag = cli.BoolFlag{
+		Name:  "graphql",
+		Usage: "Serve GraphQL queries at /graphql of the RPC endpoint",
+	}
+	MetricsHostFlag = cli.StringFlag{
+		Name:  "metricsaddr",
+		Usage: "Prometheus metrics listening address, metrics endpoint is disabled if not set",
+	}
+	MetricsPortFlag = cli.IntFlag{
+		Name:  "metricsport",
+		Usage: "Prometheus metrics listening port",
+	}
+	GrpcHostFlag = cli.StringFlag{
+		Name:  "grpcaddr",
+		Usage: "gRPC listening address, gRPC endpoint is disabled if not set",
+	}
+	GrpcPortFlag = cli.IntFlag{
+		Name:  "grpcport",
+		Usage: "gRPC listening port",
+	}
+	WsHostFlag = cli.StringFlag{
+		Name:  "wsaddr",
+		Usage: "WebSocket RPC listening address, WebSocket endpoint is disabled if not set",
+	}
+	WsPortFlag = cli.IntFlag{
+		Name:  "wsport",
+		Usage: "WebSocket RPC listening port",
+	}
 	BootNodeFlag = cli.StringFlag{
 		Name:  "bootnode",
 		Usage: "Bootstrap node url",
@@ -61,6 +101,46 @@ var (
 		Name:  "ipfsbootnode",
 		Usage: "Ipfs bootstrap node (overrides existing)",
 	}
+	IpfsBootDnsFlag = cli.StringFlag{
+		Name:  "ipfsbootdns",
+		Usage: "Comma separated domains with signed bootstrap nodes in TXT records",
+	}
+	IpfsBootDnsSignerFlag = cli.StringFlag{
+		Name:  "ipfsbootdnssigner",
+		Usage: "Address which signs bootstrap DNS records (god address by default)",
+	}
+	IpfsFlipGatewaysFlag = cli.StringFlag{
+		Name:  "ipfsflipgateways",
+		Usage: "Comma separated urls of HTTP gateways to fetch flips missed by the ipfs node from",
+	}
+	IpfsDatastoreFlag = cli.StringFlag{
+		Name:  "ipfsdatastore",
+		Usage: "Ipfs datastore backend: badger, flatfs or leveldb",
+	}
+	IpfsDatastorePathFlag = cli.StringFlag{
+		Name:  "ipfsdatastorepath",
+		Usage: "Directory of the ipfs datastore, absolute or relative to the ipfs data dir",
+	}
+	IpfsSocks5ProxyFlag = cli.StringFlag{
+		Name:  "ipfsproxy",
+		Usage: "host:port of the SOCKS5 proxy (e.g. Tor) for outbound p2p connections",
+	}
+	IpfsNoInboundFlag = cli.BoolFlag{
+		Name:  "ipfsnoinbound",
+		Usage: "Do not accept incoming p2p connections",
+	}
+	IpfsSecurityFlag = cli.StringFlag{
+		Name:  "ipfssecurity",
+		Usage: "Secure channel of p2p connections: noise (prefer Noise, fallback to TLS) or noise-only",
+	}
+	IpfsListenHostsFlag = cli.StringFlag{
+		Name:  "ipfslisten",
+		Usage: "Comma separated IPv4 and IPv6 addresses of interfaces to listen on",
+	}
+	IpfsAnnounceFlag = cli.StringFlag{
+		Name:  "ipfsannounce",
+		Usage: "Comma separated multiaddrs advertised to peers",
+	}
 	IpfsPortFlag = cli.IntFlag{
 		Name:  "ipfsport",
 		Usage: "Ipfs port",
@@ -86,6 +166,42 @@ var (
 		Name:  "maxnetdelay",
 		Usage: "Max network delay for broadcasting",
 	}
+	MaxPeerUploadRateFlag = cli.IntFlag{
+		Name:  "peeruploadrate",
+		Usage: "Max upload rate to a single peer in KiB/s, 0 means no limit",
+	}
+	MaxPeerDownloadRateFlag = cli.IntFlag{
+		Name:  "peerdownloadrate",
+		Usage: "Max download rate from a single peer in KiB/s, 0 means no limit",
+	}
+	TrustedPeersFlag = cli.StringFlag{
+		Name:  "trustedpeers",
+		Usage: "Comma separated multiaddrs of static trusted peers",
+	}
+	SubnetPeersRatioFlag = cli.Float64Flag{
+		Name:  "subnetpeersratio",
+		Usage: "Max fraction of peers from the same subnet, 0 disables the limit",
+	}
+	AsnPeersRatioFlag = cli.Float64Flag{
+		Name:  "asnpeersratio",
+		Usage: "Max fraction of peers from the same autonomous system, 0 disables the limit",
+	}
+	AsnDatabaseFlag = cli.StringFlag{
+		Name:  "asndb",
+		Usage: "Path to the csv file mapping ip ranges (cidr) to autonomous system numbers",
+	}
+	NoSnapshotServingFlag = cli.BoolFlag{
+		Name:  "nosnapshotserving",
+		Usage: "Do not announce snapshot manifests to peers",
+	}
+	NoPeerExchangeFlag = cli.BoolFlag{
+		Name:  "nopeerexchange",
+		Usage: "Do not share and dial addresses of peers received from other peers",
+	}
+	ReplayWindowSizeFlag = cli.IntFlag{
+		Name:  "replaywindow",
+		Usage: "Number of recently seen gossip messages per type remembered to drop replays, 0 disables the window",
+	}
 	FastSyncFlag = cli.BoolFlag{
 		Name:  "fast",
 		Usage: "Enable fast sync",
@@ -102,10 +218,50 @@ var (
 		Name:  "ipfsportstatic",
 		Usage: "Enable static ipfs port",
 	}
+	RpcCorsFlag = cli.StringFlag{
+		Name:  "rpccors",
+		Usage: "Comma separated origins allowed to send cross-origin RPC requests (* allows all)",
+	}
+	RpcVHostsFlag = cli.StringFlag{
+		Name:  "rpcvhosts",
+		Usage: "Comma separated virtual hostnames accepted in the Host header of RPC requests (* allows all)",
+	}
+	RpcTlsCertFlag = cli.StringFlag{
+		Name:  "rpctlscert",
+		Usage: "TLS certificate file (PEM) of the RPC endpoint, RPC is served over HTTPS if the key is set too",
+	}
+	RpcTlsKeyFlag = cli.StringFlag{
+		Name:  "rpctlskey",
+		Usage: "TLS private key file (PEM) of the RPC endpoint",
+	}
+	RpcRateLimitFlag = cli.Float64Flag{
+		Name:  "rpcratelimit",
+		Usage: "Max RPC requests per second from a client IP, 0 disables the limit",
+	}
+	RpcRateBurstFlag = cli.IntFlag{
+		Name:  "rpcrateburst",
+		Usage: "Max RPC requests a client IP can send at once",
+	}
+	RpcExpensiveRateLimitFlag = cli.Float64Flag{
+		Name:  "rpcexpensiveratelimit",
+		Usage: "Max calls of expensive RPC methods per second from a client, 0 disables the limit",
+	}
+	RpcTrustedProxiesFlag = cli.StringFlag{
+		Name:  "rpctrustedproxies",
+		Usage: "Comma separated IPs or CIDRs of reverse proxies whose X-Forwarded-For header identifies RPC clients",
+	}
+	RpcReadOnlyFlag = cli.BoolFlag{
+		Name:  "rpc.readonly",
+		Usage: "Disable signing, keystore and admin RPC methods whatever the API key is",
+	}
 	ApiKeyFlag = cli.StringFlag{
 		Name:  "apikey",
 		Usage: "Set RPC api key",
 	}
+	AdminKeyFlag = cli.StringFlag{
+		Name:  "adminkey",
+		Usage: "Set the key of admin RPC methods, admin methods are disabled if it is not set",
+	}
 	LogFileSizeFlag = cli.IntFlag{
 		Name:  "logfilesize",
 		Usage: "Set log file size in KB",
@@ -115,4 +271,44 @@ var (
 		Name:  "logcoloring",
 		Usage: "Use log coloring",
 	}
+	ReplayFlag = cli.StringFlag{
+		Name:  "replay",
+		Usage: "Replay archived consensus rounds of the range (e.g. 1000-2000) against the local chain and exit",
+	}
+	IndexTxHistoryFlag = cli.BoolFlag{
+		Name:  "indextxhistory",
+		Usage: "Save txs of all addresses to the tx history, only txs of own accounts are saved otherwise",
+	}
+	PrescreenFlipsFlag = cli.BoolFlag{
+		Name:  "prescreenflips",
+		Usage: "Reject own flips with undecodable, oversized, blank or reused images before submission",
+	}
+	FlipsRetentionFlag = cli.UintFlag{
+		Name:  "flipsretention",
+		Usage: "Number of past epochs whose flips are kept in the local IPFS store",
+	}
+	NoFlipsGcFlag = cli.BoolFlag{
+		Name:  "noflipsgc",
+		Usage: "Keep flips of all epochs in the local IPFS store (archive nodes)",
+	}
+	WordsDictionaryFlag = cli.StringFlag{
+		Name:  "wordsdictionary",
+		Usage: "Path to the signed flip words dictionary file",
+	}
+	ValidationIntervalFlag = cli.DurationFlag{
+		Name:  "validationinterval",
+		Usage: "Interval between validation ceremonies (private networks only)",
+	}
+	FlipLotteryDurationFlag = cli.DurationFlag{
+		Name:  "fliplottery",
+		Usage: "Duration of the flip lottery (private networks only)",
+	}
+	ShortSessionDurationFlag = cli.DurationFlag{
+		Name:  "shortsession",
+		Usage: "Duration of the short session (private networks only)",
+	}
+	LongSessionDurationFlag = cli.DurationFlag{
+		Name:  "longsession",
+		Usage: "Duration of the long session (private networks only)",
+	}
 )
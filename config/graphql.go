@@ -0,0 +1,17 @@
+package config
+
+type GraphQLConfig struct {
+	// Enabled serves GraphQL queries at /graphql of the RPC endpoint, queries are authorized by RPC API keys
+	Enabled bool
+	// MaxDepth limits nesting of a query
+	MaxDepth int
+	// MaxComplexity limits the total cost of fields resolved by a query
+	MaxComplexity int
+}
+
+func GetDefaultGraphQLConfig() *GraphQLConfig {
+	return &GraphQLConfig{
+		MaxDepth:      8,
+		MaxComplexity: 2000,
+	}
+}
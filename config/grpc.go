@@ -0,0 +1,22 @@
+package config
+
+import "fmt"
+
+type GrpcConfig struct {
+	// Host is the host interface on which to start the gRPC endpoint, the endpoint is disabled if empty
+	Host string
+	Port int
+}
+
+func (c *GrpcConfig) Endpoint() string {
+	if c.Host == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.Host, c.Port)
+}
+
+func GetDefaultGrpcConfig() *GrpcConfig {
+	return &GrpcConfig{
+		Port: DefaultGrpcPort,
+	}
+}
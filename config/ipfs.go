@@ -14,12 +14,46 @@ type IpfsConfig struct {
 	Profile            string
 	BlockPinThreshold  float32
 	FlipPinThreshold   float32
+	// FlipsRetentionEpochs is the number of past epochs whose flips are kept pinned, zero unpins flips right after the validation
+	FlipsRetentionEpochs uint16
+	// KeepOwnFlips keeps flips of the node identity pinned regardless of the retention
+	KeepOwnFlips bool
+	// DisableFlipsGc keeps flips of all epochs pinned, it is intended for archive nodes
+	DisableFlipsGc bool
+	// BootDnsNames are domains whose TXT records list signed bootstrap nodes in addition to BootNodes
+	BootDnsNames []string
+	// BootDnsSigner is the address which signs bootstrap DNS records, the god address is used if it is empty
+	BootDnsSigner string
+	// ListenHosts are IPv4 and IPv6 addresses of interfaces to listen on with IpfsPort, all interfaces are used if it is empty
+	ListenHosts []string
+	// AnnounceAddrs are multiaddrs advertised to peers instead of the listened ones, e.g. public addresses behind NAT
+	AnnounceAddrs []string
+	// NoAnnounceAddrs are multiaddrs or ipcidr ranges which are never advertised to peers
+	NoAnnounceAddrs []string
+	// DialFilters are ipcidr multiaddrs (e.g. /ip4/10.0.0.0/ipcidr/8) of addresses which are never dialed
+	DialFilters []string
+	// FlipGateways are base urls of trusted HTTP gateways (e.g. https://ipfs.io) used to fetch flips which the embedded
+	// node fails to fetch in time, the data is accepted only if its cid matches the requested one
+	FlipGateways []string
+	// Datastore is the backend of the ipfs datastore (badger, flatfs or leveldb) applied on the repo initialization,
+	// changing it for the existing repo requires `idena ipfs migrate-datastore`
+	Datastore string
+	// DatastorePath is the directory of the datastore blocks, absolute or relative to DataDir, the default one is used if it is empty
+	DatastorePath string
+	// Socks5Proxy is the host:port of the SOCKS5 proxy (e.g. Tor) used for all outbound p2p connections
+	Socks5Proxy string
+	// DisableInbound stops listening for incoming p2p connections
+	DisableInbound bool
+	// Security selects secure channels of p2p connections: empty (TLS preferred, Noise as fallback), noise (Noise
+	// preferred, TLS as fallback) or noise-only
+	Security string
 }
 
 func GetDefaultIpfsConfig() *IpfsConfig {
 	return &IpfsConfig{
 		BlockPinThreshold: 0.3,
 		FlipPinThreshold:  0.5,
-		Profile: "server",
+		Profile:           "server",
+		KeepOwnFlips:      true,
 	}
 }
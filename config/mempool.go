@@ -9,6 +9,37 @@ type Mempool struct {
 	TxPoolAddrQueueLimit      int
 	TxPoolAddrExecutableLimit int
 	TxLifetime                time.Duration
+
+	// CeremonyLaneSlots limits the number of ceremony txs (answers and evidence) which are kept apart from regular txs,
+	// zero or negative value means no limit
+	CeremonyLaneSlots int
+
+	// ReplacementFeeBump is the minimal increase of max fee plus tips in percents required to replace a pooled tx
+	// with the same nonce, negative value disables replacement
+	ReplacementFeeBump int
+
+	// MaxNonceGap is the max difference between the nonce of a queued tx and the account nonce, txs with larger nonces
+	// are rejected, zero or negative value means no limit
+	MaxNonceGap int
+
+	// PersistTxs enables saving of pending txs on shutdown and loading them on start
+	PersistTxs bool
+
+	// SenderQuota limits the number of regular txs of one sender in the pool, zero or negative value means no limit
+	SenderQuota int
+	// SpamScoreThreshold is the sender spam score above which the sender is rate limited, zero or negative value disables scoring.
+	// The score rises by one for txs over the sender quota or too far nonce and by two for underpriced txs.
+	SpamScoreThreshold float64
+	// SpamScoreHalfLife is the time the spam score halves in
+	SpamScoreHalfLife time.Duration
+	// SpamRateLimitInterval is the min interval between txs of a rate limited sender
+	SpamRateLimitInterval time.Duration
+
+	// OrphanSlots limits the number of txs of unknown senders kept until the senders are funded,
+	// zero or negative value disables keeping of orphans
+	OrphanSlots int
+	// OrphanLifetime is the number of blocks an orphan tx is kept for
+	OrphanLifetime uint64
 }
 
 func GetDefaultMempoolConfig() *Mempool {
@@ -19,5 +50,18 @@ func GetDefaultMempoolConfig() *Mempool {
 		TxPoolAddrQueueLimit:      32,
 		TxPoolAddrExecutableLimit: 32,
 		TxLifetime:                time.Hour * 3,
+
+		CeremonyLaneSlots:  8192,
+		ReplacementFeeBump: 10,
+		MaxNonceGap:        64,
+		PersistTxs:         true,
+
+		SenderQuota:           48,
+		SpamScoreThreshold:    20,
+		SpamScoreHalfLife:     10 * time.Minute,
+		SpamRateLimitInterval: 30 * time.Second,
+
+		OrphanSlots:    256,
+		OrphanLifetime: 30,
 	}
 }
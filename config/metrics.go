@@ -0,0 +1,22 @@
+package config
+
+import "fmt"
+
+type MetricsConfig struct {
+	// HTTPHost is the host interface on which to serve metrics in the Prometheus format, the endpoint is disabled if empty
+	HTTPHost string
+	HTTPPort int
+}
+
+func (c *MetricsConfig) HTTPEndpoint() string {
+	if c.HTTPHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.HTTPHost, c.HTTPPort)
+}
+
+func GetDefaultMetricsConfig() *MetricsConfig {
+	return &MetricsConfig{
+		HTTPPort: DefaultMetricsPort,
+	}
+}
@@ -8,6 +8,13 @@ type OfflineDetectionConfig struct {
 	OfflineProposeInterval      time.Duration
 	OfflineVoteInterval         time.Duration
 	IntervalBetweenOfflineRetry time.Duration
+	// node doesn't propose and vote to turn identities offline until the period passes since its start
+	RestartGracePeriod time.Duration
+	// node doesn't propose and vote to turn identities offline while it is partitioned from the network
+	// and until the period passes since the partition is over
+	PartitionGracePeriod time.Duration
+	// share of peers which should be lost at once to consider the node partitioned
+	PartitionPeersDropRate float64
 }
 
 func GetDefaultOfflineDetectionConfig() *OfflineDetectionConfig {
@@ -17,5 +24,8 @@ func GetDefaultOfflineDetectionConfig() *OfflineDetectionConfig {
 		OfflineProposeInterval:      1 * time.Hour,
 		OfflineVoteInterval:         45 * time.Minute,
 		IntervalBetweenOfflineRetry: 5 * time.Minute,
+		RestartGracePeriod:          1 * time.Hour,
+		PartitionGracePeriod:        10 * time.Minute,
+		PartitionPeersDropRate:      0.5,
 	}
 }
@@ -5,4 +5,22 @@ type P2P struct {
 	MaxOutboundPeers int
 	MaxDelay         int
 	DisableMetrics   bool
+	// Per-peer limits of the traffic in KiB/s, 0 means no limit
+	MaxPeerUploadRate   int
+	MaxPeerDownloadRate int
+	// TrustedPeers are multiaddrs of static peers which are always dialed, never evicted and exempt from the limits
+	TrustedPeers []string
+	// SubnetPeersRatio and AsnPeersRatio limit the fraction of peers from the same /16 (/32 for IPv6) subnet
+	// and autonomous system, 0 disables the limit
+	SubnetPeersRatio float64
+	AsnPeersRatio    float64
+	// AsnDatabase is the path to the csv file with `cidr,asn` lines used to determine autonomous systems of peers
+	AsnDatabase string
+	// DisableSnapshotServing stops announcing snapshot manifests to peers
+	DisableSnapshotServing bool
+	// DisablePeerExchange stops sharing addresses of known peers and dialing peers shared by others
+	DisablePeerExchange bool
+	// ReplayWindowSize is the number of recently seen consensus and flip key messages remembered per message type
+	// to drop their replays, 0 disables the window
+	ReplayWindowSize int
 }
@@ -0,0 +1,21 @@
+package config
+
+import "time"
+
+type StepTimeoutsConfig struct {
+	// Adaptive turns on deriving of consensus step timeouts from recent step durations and peer latency,
+	// fixed timeouts of the consensus config are used otherwise. All nodes of the network should use the same mode.
+	Adaptive bool
+	// MinStepDelay is the lower bound of the adaptive step timeout
+	MinStepDelay time.Duration
+	// MaxStepDelay is the upper bound of the adaptive step timeout
+	MaxStepDelay time.Duration
+}
+
+func GetDefaultStepTimeoutsConfig() *StepTimeoutsConfig {
+	return &StepTimeoutsConfig{
+		Adaptive:     false,
+		MinStepDelay: time.Second * 5,
+		MaxStepDelay: time.Second * 40,
+	}
+}
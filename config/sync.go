@@ -1,7 +1,27 @@
 package config
 
+import "time"
+
 type SyncConfig struct {
 	FastSync      bool
 	ForceFullSync uint64
 	LoadAllFlips  bool
+	// StaleHeadTimeout is the time without head progress after which the node starts recovery resync if most peers are ahead, zero disables detection
+	StaleHeadTimeout time.Duration
+	// StaleHeadLag is the minimal number of blocks peers should be ahead to consider own head as stale
+	StaleHeadLag uint64
+	// ValidationOnly keeps only the data needed to pass validation: the node always syncs from the latest snapshot,
+	// doesn't pin blocks and flips of other identities and doesn't index txs of other accounts
+	ValidationOnly bool
+}
+
+// applyValidationOnlyMode overrides settings which make the node keep the full history
+func applyValidationOnlyMode(cfg *Config) {
+	if !cfg.Sync.ValidationOnly {
+		return
+	}
+	cfg.Sync.FastSync = true
+	cfg.Sync.LoadAllFlips = false
+	cfg.IpfsConf.BlockPinThreshold = 0
+	cfg.IpfsConf.FlipPinThreshold = 0
 }
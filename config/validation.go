@@ -2,6 +2,7 @@ package config
 
 import (
 	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
 	"time"
 )
 
@@ -9,6 +10,14 @@ const (
 	FlipLottery      = 5 * time.Minute
 	ShortSession     = 2 * time.Minute
 	AfterLongSession = 1 * time.Minute
+
+	FlipKeysPackageBroadcastDelay = 2 * time.Minute
+	ShortAnswersBroadcastDelay    = 1 * time.Minute
+	// flip keys stop syncing with peers in FlipKeysSyncTimeFrame after short session start
+	FlipKeysSyncTimeFrame = 4 * time.Minute
+
+	// mainnetNetwork is the id of the main network, it duplicates blockchain.Mainnet which can't be imported here
+	mainnetNetwork = 0x0
 )
 
 type ValidationConfig struct {
@@ -20,6 +29,17 @@ type ValidationConfig struct {
 	ShortSessionDuration time.Duration
 	// Do not use directly
 	LongSessionDuration time.Duration
+	// Do not use directly
+	FlipKeysPackageBroadcastDelay time.Duration
+	// Do not use directly
+	ShortAnswersBroadcastDelay time.Duration
+	// Do not use directly
+	FlipKeysSyncTimeFrame time.Duration
+	// PrescreenFlips rejects own flips which fail local checks of images before submission
+	PrescreenFlips bool
+	// WordsDictionaryFiles are paths of flip words dictionaries signed by the god address, a dictionary is used
+	// since the epoch its hash is pinned on-chain for
+	WordsDictionaryFiles []string
 }
 
 func (cfg *ValidationConfig) GetNextValidationTime(validationTime time.Time, networkSize int) time.Time {
@@ -49,3 +69,39 @@ func (cfg *ValidationConfig) GetLongSessionDuration(networkSize int) time.Durati
 	}
 	return time.Minute * time.Duration(common.LongSessionFlipsCount(networkSize))
 }
+
+func (cfg *ValidationConfig) GetFlipKeysPackageBroadcastDelay() time.Duration {
+	if cfg.FlipKeysPackageBroadcastDelay > 0 {
+		return cfg.FlipKeysPackageBroadcastDelay
+	}
+	return FlipKeysPackageBroadcastDelay
+}
+
+func (cfg *ValidationConfig) GetShortAnswersBroadcastDelay() time.Duration {
+	if cfg.ShortAnswersBroadcastDelay > 0 {
+		return cfg.ShortAnswersBroadcastDelay
+	}
+	return ShortAnswersBroadcastDelay
+}
+
+func (cfg *ValidationConfig) GetFlipKeysSyncTimeFrame() time.Duration {
+	if cfg.FlipKeysSyncTimeFrame > 0 {
+		return cfg.FlipKeysSyncTimeFrame
+	}
+	return FlipKeysSyncTimeFrame
+}
+
+// HasCustomTimings returns true if any duration of the ceremony differs from the protocol one
+func (cfg *ValidationConfig) HasCustomTimings() bool {
+	return cfg.ValidationInterval > 0 || cfg.FlipLotteryDuration > 0 || cfg.ShortSessionDuration > 0 ||
+		cfg.LongSessionDuration > 0 || cfg.FlipKeysPackageBroadcastDelay > 0 || cfg.ShortAnswersBroadcastDelay > 0 ||
+		cfg.FlipKeysSyncTimeFrame > 0
+}
+
+// validateTimings forbids custom ceremony durations in the main network, they are intended for private networks only
+func (cfg *ValidationConfig) validateTimings(network uint32) error {
+	if network == mainnetNetwork && cfg.HasCustomTimings() {
+		return errors.New("custom validation ceremony durations are allowed in private networks only")
+	}
+	return nil
+}
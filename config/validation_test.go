@@ -19,3 +19,14 @@ func TestGetNextValidationTime(t *testing.T) {
 	require.Equal(t, time.Date(2020, 1, 1, 2, 3, 0, 0, time.UTC),
 		nextValidationTime)
 }
+
+func TestValidationConfig_validateTimings(t *testing.T) {
+	conf := &ValidationConfig{}
+	require.Equal(t, FlipKeysSyncTimeFrame, conf.GetFlipKeysSyncTimeFrame())
+	require.NoError(t, conf.validateTimings(mainnetNetwork))
+
+	conf.ShortAnswersBroadcastDelay = 5 * time.Second
+	require.Equal(t, 5*time.Second, conf.GetShortAnswersBroadcastDelay())
+	require.Error(t, conf.validateTimings(mainnetNetwork))
+	require.NoError(t, conf.validateTimings(0x1))
+}
@@ -0,0 +1,168 @@
+package consensus
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/validators"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+)
+
+// stepVoter votes and counts votes of the agreement steps of a round, the engine works with gossiped votes
+// while the replay counts archived ones
+type stepVoter interface {
+	vote(step uint8, hash common.Hash)
+	countVotes(step uint8) (common.Hash, *types.FullBlockCert, error)
+	// interrupt is checked after every pair of BA steps, the agreement is stopped if an error is returned
+	interrupt() error
+	completeBA()
+	setProcess(process string)
+}
+
+type agreementResult struct {
+	BlockHash common.Hash
+	Cert      *types.FullBlockCert
+	Final     bool
+}
+
+// agreement runs the steps of the agreement protocol of one round: reduction, binary BA and final votes counting
+type agreement struct {
+	emptyBlockHash common.Hash
+	maxSteps       uint8
+	voter          stepVoter
+	log            log.Logger
+}
+
+func (a *agreement) run(blockHash common.Hash) (*agreementResult, error) {
+	blockHash = a.reduction(blockHash)
+	blockHash, cert, err := a.binaryBa(blockHash)
+	if err != nil {
+		return nil, err
+	}
+	a.voter.setProcess("Count final votes")
+	var hash common.Hash
+	var finalCert *types.FullBlockCert
+	if blockHash != a.emptyBlockHash {
+		hash, finalCert, err = a.voter.countVotes(types.Final)
+		if err == nil && hash != blockHash {
+			a.log.Info("Switched to final", "prev", blockHash.Hex(), "final", hash.Hex())
+			blockHash = hash
+		}
+	}
+	result := &agreementResult{
+		BlockHash: blockHash,
+		Cert:      cert,
+	}
+	if blockHash != a.emptyBlockHash && hash == blockHash {
+		result.Cert = finalCert
+		result.Final = true
+	}
+	return result, nil
+}
+
+func (a *agreement) reduction(blockHash common.Hash) common.Hash {
+	a.voter.setProcess("Reduction started")
+	a.log.Info("Reduction started", "block", blockHash.Hex())
+
+	a.voter.vote(types.ReductionOne, blockHash)
+	a.voter.setProcess(fmt.Sprintf("Reduction %v vote commited", types.ReductionOne))
+
+	hash, _, err := a.voter.countVotes(types.ReductionOne)
+	a.voter.setProcess(fmt.Sprintf("Reduction %v votes counted", types.ReductionOne))
+
+	if err != nil {
+		hash = a.emptyBlockHash
+	}
+	a.voter.vote(types.ReductionTwo, hash)
+
+	a.voter.setProcess(fmt.Sprintf("Reduction %v vote commited", types.ReductionTwo))
+	hash, _, err = a.voter.countVotes(types.ReductionTwo)
+	a.voter.setProcess(fmt.Sprintf("Reduction %v votes counted", types.ReductionTwo))
+
+	if err != nil {
+		hash = a.emptyBlockHash
+	}
+	a.log.Info("Reduction completed", "block", hash.Hex(), "isEmpty", hash == a.emptyBlockHash)
+	return hash
+}
+
+func (a *agreement) binaryBa(blockHash common.Hash) (common.Hash, *types.FullBlockCert, error) {
+	defer a.voter.completeBA()
+	a.log.Info("binaryBa started", "block", blockHash.Hex())
+
+	hash := blockHash
+
+	for step := uint8(1); step < a.maxSteps; {
+		a.voter.setProcess(fmt.Sprintf("BA step %v", step))
+
+		a.voter.vote(step, hash)
+
+		hash, cert, err := a.voter.countVotes(step)
+		if err != nil {
+			hash = blockHash
+		} else if hash != a.emptyBlockHash {
+			for i := uint8(1); i <= 2; i++ {
+				a.voter.vote(step+i, hash)
+			}
+			if step == 1 {
+				a.voter.vote(types.Final, hash)
+			}
+			return hash, cert, nil
+		}
+		step++
+
+		a.voter.setProcess(fmt.Sprintf("BA step %v", step))
+
+		a.voter.vote(step, hash)
+
+		hash, cert, err = a.voter.countVotes(step)
+
+		if err != nil {
+			hash = a.emptyBlockHash
+		} else if hash == a.emptyBlockHash {
+			for i := uint8(1); i <= 2; i++ {
+				a.voter.vote(step+i, hash)
+			}
+			return hash, cert, nil
+		}
+
+		step++
+
+		if err := a.voter.interrupt(); err != nil {
+			return common.Hash{}, nil, err
+		}
+	}
+	return common.Hash{}, nil, errors.New("No consensus")
+}
+
+// countStepVotes returns the hash which got the necessary number of votes of the step from the committee,
+// votes are processed in the given order, so the result is deterministic for the same list of votes
+func countStepVotes(votes []*types.Vote, step uint8, parentHash common.Hash, validators *validators.StepValidators, necessaryVotesCount int) (common.Hash, *types.FullBlockCert, bool) {
+	byBlock := make(map[common.Hash][]*types.Vote)
+	voted := make(map[common.Hash]map[common.Address]struct{})
+	for _, vote := range votes {
+		if vote.Header.ParentHash != parentHash || vote.Header.Step != step {
+			continue
+		}
+		voter := vote.VoterAddr()
+		if !validators.Contains(voter) {
+			continue
+		}
+		blockVoters, ok := voted[vote.Header.VotedHash]
+		if !ok {
+			blockVoters = make(map[common.Address]struct{})
+			voted[vote.Header.VotedHash] = blockVoters
+		}
+		if _, ok := blockVoters[voter]; ok {
+			continue
+		}
+		blockVoters[voter] = struct{}{}
+		list := append(byBlock[vote.Header.VotedHash], vote)
+		byBlock[vote.Header.VotedHash] = list
+		if len(list) >= necessaryVotesCount {
+			return vote.Header.VotedHash, &types.FullBlockCert{Votes: list}, true
+		}
+	}
+	return common.Hash{}, nil, false
+}
@@ -0,0 +1,133 @@
+package consensus
+
+import (
+	"github.com/deckarep/golang-set"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/validators"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type testStepVoter struct {
+	results map[uint8]common.Hash
+	votes   map[uint8]common.Hash
+}
+
+func (v *testStepVoter) vote(step uint8, hash common.Hash) {
+	v.votes[step] = hash
+}
+
+func (v *testStepVoter) countVotes(step uint8) (common.Hash, *types.FullBlockCert, error) {
+	if hash, ok := v.results[step]; ok {
+		return hash, &types.FullBlockCert{}, nil
+	}
+	return common.Hash{}, nil, errors.New("votes for step is not received")
+}
+
+func (v *testStepVoter) interrupt() error {
+	return nil
+}
+
+func (v *testStepVoter) completeBA() {
+}
+
+func (v *testStepVoter) setProcess(process string) {
+}
+
+func Test_agreement(t *testing.T) {
+	require := require.New(t)
+	emptyBlockHash := common.Hash{0x1}
+	blockHash := common.Hash{0x2}
+
+	run := func(results map[uint8]common.Hash) (*agreementResult, *testStepVoter, error) {
+		voter := &testStepVoter{results: results, votes: make(map[uint8]common.Hash)}
+		a := &agreement{emptyBlockHash: emptyBlockHash, maxSteps: 10, voter: voter, log: log.New()}
+		result, err := a.run(blockHash)
+		return result, voter, err
+	}
+
+	result, voter, err := run(map[uint8]common.Hash{
+		types.ReductionOne: blockHash,
+		types.ReductionTwo: blockHash,
+		1:                  blockHash,
+		types.Final:        blockHash,
+	})
+	require.NoError(err)
+	require.Equal(blockHash, result.BlockHash)
+	require.True(result.Final)
+	require.Equal(blockHash, voter.votes[types.Final])
+
+	// failed reduction leads to the empty block
+	result, voter, err = run(map[uint8]common.Hash{
+		2: emptyBlockHash,
+	})
+	require.NoError(err)
+	require.Equal(emptyBlockHash, result.BlockHash)
+	require.False(result.Final)
+	require.Equal(emptyBlockHash, voter.votes[types.ReductionTwo])
+	require.Equal(emptyBlockHash, voter.votes[1])
+
+	// tentative block without final votes
+	result, _, err = run(map[uint8]common.Hash{
+		types.ReductionOne: blockHash,
+		types.ReductionTwo: blockHash,
+		1:                  blockHash,
+	})
+	require.NoError(err)
+	require.Equal(blockHash, result.BlockHash)
+	require.False(result.Final)
+
+	_, _, err = run(map[uint8]common.Hash{})
+	require.Error(err)
+}
+
+func Test_countStepVotes(t *testing.T) {
+	require := require.New(t)
+	parentHash := common.Hash{0x1}
+	committee := mapset.NewSet()
+	var votes []*types.Vote
+	sign := func(voted common.Hash, step uint8) *types.Vote {
+		key, _ := crypto.GenerateKey()
+		vote := &types.Vote{
+			Header: &types.VoteHeader{
+				Round:      2,
+				Step:       step,
+				ParentHash: parentHash,
+				VotedHash:  voted,
+			},
+		}
+		hash := crypto.SignatureHash(vote)
+		vote.Signature, _ = crypto.Sign(hash[:], key)
+		return vote
+	}
+	for i := 0; i < 3; i++ {
+		votes = append(votes, sign(common.Hash{0x2}, 1), sign(common.Hash{0x3}, 1))
+	}
+	// votes of other steps and outside of the committee are ignored
+	votes = append(votes, sign(common.Hash{0x3}, 2))
+	for _, vote := range votes {
+		committee.Add(vote.VoterAddr())
+	}
+	votes = append(votes, sign(common.Hash{0x3}, 1))
+	stepValidators := &validators.StepValidators{Original: committee, Addresses: committee, Size: committee.Cardinality()}
+
+	_, _, found := countStepVotes(votes, 1, parentHash, stepValidators, 4)
+	require.False(found)
+
+	hash, cert, found := countStepVotes(votes, 1, parentHash, stepValidators, 3)
+	require.True(found)
+	require.Equal(common.Hash{0x2}, hash)
+	require.Len(cert.Votes, 3)
+
+	// the hash which gets enough votes first wins
+	hash, _, _ = countStepVotes(votes[1:], 1, parentHash, stepValidators, 3)
+	require.Equal(common.Hash{0x3}, hash)
+
+	// duplicated votes are counted once
+	_, _, found = countStepVotes(append(votes[:2:2], votes[0], votes[0]), 1, parentHash, stepValidators, 2)
+	require.False(found)
+}
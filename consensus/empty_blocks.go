@@ -0,0 +1,101 @@
+package consensus
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/rcrowley/go-metrics"
+	"sync"
+)
+
+const (
+	maxEmptyBlockRecords = 100
+)
+
+type EmptyBlockReason string
+
+const (
+	// NoProposer means that no proposer proofs were received for the round
+	NoProposer EmptyBlockReason = "noProposer"
+	// NoProposal means that the block of the highest-priority proposer was not received
+	NoProposal EmptyBlockReason = "noProposal"
+	// InvalidProposal means that the block of the highest-priority proposer failed validation
+	InvalidProposal EmptyBlockReason = "invalidProposal"
+	// InsufficientVotes means that the proposed block was received but didn't get enough votes
+	InsufficientVotes EmptyBlockReason = "insufficientVotes"
+)
+
+var emptyBlockReasons = []EmptyBlockReason{NoProposer, NoProposal, InvalidProposal, InsufficientVotes}
+
+// EmptyBlockRecord describes the empty block reached by the consensus of the node
+type EmptyBlockRecord struct {
+	Height         uint64
+	Hash           common.Hash
+	Reason         EmptyBlockReason
+	ProposerPubKey []byte
+	Time           int64
+}
+
+// emptyBlocks keeps the last empty blocks reached by the node with their reasons and counts them by reason
+type emptyBlocks struct {
+	records  []*EmptyBlockRecord
+	counters map[EmptyBlockReason]metrics.Counter
+	mutex    sync.Mutex
+}
+
+func newEmptyBlocks() *emptyBlocks {
+	counters := make(map[EmptyBlockReason]metrics.Counter, len(emptyBlockReasons))
+	for _, reason := range emptyBlockReasons {
+		counters[reason] = metrics.GetOrRegisterCounter("consensus.emptyBlocks."+string(reason), metrics.DefaultRegistry)
+	}
+	return &emptyBlocks{
+		counters: counters,
+	}
+}
+
+func (e *emptyBlocks) add(record *EmptyBlockRecord) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.records = append(e.records, record)
+	if len(e.records) > maxEmptyBlockRecords {
+		e.records = e.records[len(e.records)-maxEmptyBlockRecords:]
+	}
+	if counter, ok := e.counters[record.Reason]; ok {
+		counter.Inc(1)
+	}
+}
+
+// list returns the records starting from the latest one
+func (e *emptyBlocks) list() []*EmptyBlockRecord {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	result := make([]*EmptyBlockRecord, 0, len(e.records))
+	for i := len(e.records) - 1; i >= 0; i-- {
+		result = append(result, e.records[i])
+	}
+	return result
+}
+
+// counts returns the numbers of empty blocks by reason since the start of the node
+func (e *emptyBlocks) counts() map[EmptyBlockReason]int64 {
+	result := make(map[EmptyBlockReason]int64, len(e.counters))
+	for reason, counter := range e.counters {
+		result[reason] = counter.Count()
+	}
+	return result
+}
+
+// proposalFailureReason returns the reason of the missing block of the proposer,
+// the block is considered invalid if it was rejected by the chain at the round
+func proposalFailureReason(rejected []*blockchain.RejectedBlock, round uint64, proposerPubKey []byte) EmptyBlockReason {
+	for _, item := range rejected {
+		header := item.Block.Header
+		if item.Block.Height() != round || header.ProposedHeader == nil {
+			continue
+		}
+		if bytes.Equal(header.ProposedHeader.ProposerPubKey, proposerPubKey) {
+			return InvalidProposal
+		}
+	}
+	return NoProposal
+}
@@ -0,0 +1,36 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_emptyBlocks(t *testing.T) {
+	require := require.New(t)
+	e := newEmptyBlocks()
+	before := e.counts()
+	for i := 0; i < maxEmptyBlockRecords+5; i++ {
+		e.add(&EmptyBlockRecord{Height: uint64(i), Reason: NoProposer})
+	}
+	e.add(&EmptyBlockRecord{Height: 1000, Reason: InsufficientVotes})
+
+	list := e.list()
+	require.Len(list, maxEmptyBlockRecords)
+	require.Equal(uint64(1000), list[0].Height)
+	counts := e.counts()
+	require.Equal(int64(maxEmptyBlockRecords+5), counts[NoProposer]-before[NoProposer])
+	require.Equal(int64(1), counts[InsufficientVotes]-before[InsufficientVotes])
+}
+
+func Test_proposalFailureReason(t *testing.T) {
+	rejected := []*blockchain.RejectedBlock{
+		{
+			Block: &types.Block{Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 10, ProposerPubKey: []byte{0x1}}}},
+		},
+	}
+	require.Equal(t, InvalidProposal, proposalFailureReason(rejected, 10, []byte{0x1}))
+	require.Equal(t, NoProposal, proposalFailureReason(rejected, 10, []byte{0x2}))
+	require.Equal(t, NoProposal, proposalFailureReason(rejected, 11, []byte{0x1}))
+}
@@ -66,6 +66,11 @@ type Engine struct {
 
 	appStateCache      *appStateCache
 	appStateCacheMutex sync.Mutex
+
+	staleHeadDetector *staleHeadDetector
+	stepTimeouts      *stepTimeouts
+	roundState        *roundState
+	emptyBlocks       *emptyBlocks
 }
 
 func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossipHandler, proposals *pengings.Proposals, config *config.Config,
@@ -91,6 +96,10 @@ func NewEngine(chain *blockchain.Blockchain, gossipHandler *protocol.IdenaGossip
 		nextBlockDetector: newNextBlockDetector(gossipHandler, downloader, chain),
 		upgrader:          upgrader,
 		statsCollector:    statsCollector,
+		staleHeadDetector: newStaleHeadDetector(config.Sync.StaleHeadTimeout, config.Sync.StaleHeadLag),
+		stepTimeouts:      newStepTimeouts(config.StepTimeouts),
+		roundState:        newRoundState(),
+		emptyBlocks:       newEmptyBlocks(),
 	}
 }
 
@@ -107,6 +116,36 @@ func (engine *Engine) GetProcess() string {
 	return engine.process
 }
 
+// RoundInfo returns the state of the current round with votes collected per step
+func (engine *Engine) RoundInfo() *RoundInfo {
+	info := engine.roundState.snapshot()
+	info.Process = engine.process
+	steps := make(map[uint8]*StepInfo, len(info.Steps))
+	for _, step := range info.Steps {
+		steps[step.Step] = step
+	}
+	if m := engine.votes.GetVotesOfRound(info.Round); m != nil {
+		m.Range(func(key, value interface{}) bool {
+			vote := value.(*types.Vote)
+			step, ok := steps[vote.Header.Step]
+			if !ok {
+				step = &StepInfo{Step: vote.Header.Step, Votes: make(map[common.Hash]int)}
+				steps[step.Step] = step
+				info.Steps = append(info.Steps, step)
+			}
+			step.Votes[vote.Header.VotedHash]++
+			return true
+		})
+	}
+	return info
+}
+
+// EmptyBlocks returns the last empty blocks reached by the node starting from the latest one
+// and the numbers of empty blocks by reason since the start of the node
+func (engine *Engine) EmptyBlocks() ([]*EmptyBlockRecord, map[EmptyBlockReason]int64) {
+	return engine.emptyBlocks.list(), engine.emptyBlocks.counts()
+}
+
 func (engine *Engine) ReadonlyAppState() (*appstate.AppState, error) {
 	currentBlock := engine.chain.Head.Height()
 	if engine.appStateCache != nil && engine.appStateCache.block == currentBlock {
@@ -163,6 +202,18 @@ func (engine *Engine) alignTime() {
 	}
 }
 
+func (engine *Engine) checkStaleHead() {
+	if engine.forkResolver.HasLoadedFork() {
+		return
+	}
+	peers, top := engine.staleHeadDetector.check(engine.chain.Head.Height(), engine.pm.GetKnownHeights(), time.Now().UTC())
+	if len(peers) == 0 {
+		return
+	}
+	engine.forkResolver.ResetTriedPeers()
+	engine.downloader.StartRecovery(peers, top)
+}
+
 func (engine *Engine) calculateTimeDiff(round uint64, roundStart time.Time) {
 	engine.avgTimeDiffs = append(engine.avgTimeDiffs, engine.proposals.AvgTimeDiff(round, roundStart.Unix()))
 	if len(engine.avgTimeDiffs) > MaxStoredAvgTimeDiffs {
@@ -177,6 +228,7 @@ func (engine *Engine) loop() {
 			time.Sleep(time.Second * 30)
 			continue
 		}
+		engine.checkStaleHead()
 		if err := engine.downloader.SyncBlockchain(engine.forkResolver); err != nil {
 			engine.synced = false
 			if engine.forkResolver.HasLoadedFork() {
@@ -203,6 +255,7 @@ func (engine *Engine) loop() {
 
 		engine.prevRoundDuration = 0
 		roundStart := time.Now().UTC()
+		engine.roundState.startRound(round, roundStart)
 
 		engine.log.Info("Start loop", "round", round, "head", head.Hash().Hex(), "peers",
 			engine.pm.PeersCount(), "online-nodes", engine.appState.ValidatorsCache.OnlineSize(),
@@ -229,20 +282,29 @@ func (engine *Engine) loop() {
 
 		engine.log.Info("Selected proposer", "proposer", proposer)
 		emptyBlock := engine.chain.GenerateEmptyBlock()
+		emptyBlockReason := InsufficientVotes
 		if proposerPubKey == nil {
 			block = emptyBlock
+			emptyBlockReason = NoProposer
 		} else {
 
 			engine.process = "Waiting for block from proposer"
 			block = engine.waitForBlock(proposerPubKey)
 
+			engine.roundState.setProposal(proposerPubKey, block)
 			if block == nil {
 				block = emptyBlock
+				emptyBlockReason = proposalFailureReason(engine.chain.LastRejectedBlocks(), round, proposerPubKey)
 			}
 		}
 
-		blockHash := engine.reduction(round, block)
-		blockHash, cert, err := engine.binaryBa(blockHash)
+		agreement := &agreement{
+			emptyBlockHash: emptyBlock.Hash(),
+			maxSteps:       engine.cfg.Consensus.MaxSteps,
+			voter:          &engineStepVoter{engine: engine, round: round, parentHash: head.Hash(), emptyBlockHash: emptyBlock.Hash()},
+			log:            engine.log,
+		}
+		result, err := agreement.run(block.Hash())
 		if err != nil {
 			engine.log.Info("Binary Ba is failed", "err", err)
 
@@ -253,24 +315,24 @@ func (engine *Engine) loop() {
 			}
 			continue
 		}
-		engine.process = "Count final votes"
-		var hash common.Hash
-		var finalCert *types.FullBlockCert
-		if blockHash != emptyBlock.Hash() {
-			hash, finalCert, err = engine.countVotes(round, types.Final, block.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, true), engine.cfg.Consensus.WaitForStepDelay)
-			if err == nil && hash != blockHash {
-				engine.log.Info("Switched to final", "prev", blockHash.Hex(), "final", hash.Hex())
-				blockHash = hash
-			}
-		}
+		blockHash, cert := result.BlockHash, result.Cert
+		// the certificate is compressed before the block is added since the validators cache may change with the block
+		compressedCert := engine.chain.CompressCertificate(engine.chain.Head, cert, engine.appState.ValidatorsCache)
 		if blockHash == emptyBlock.Hash() {
 			if err := engine.chain.AddBlock(emptyBlock, nil, engine.statsCollector); err != nil {
 				engine.log.Error("Add empty block", "err", err)
 				continue
 			}
 
-			engine.chain.WriteCertificate(blockHash, cert.Compress(), engine.chain.IsPermanentCert(emptyBlock.Header))
-			engine.log.Info("Reached consensus on empty block")
+			engine.chain.WriteCertificate(blockHash, compressedCert, engine.chain.IsPermanentCert(emptyBlock.Header))
+			engine.emptyBlocks.add(&EmptyBlockRecord{
+				Height:         round,
+				Hash:           blockHash,
+				Reason:         emptyBlockReason,
+				ProposerPubKey: proposerPubKey,
+				Time:           emptyBlock.Header.Time(),
+			})
+			engine.log.Info("Reached consensus on empty block", "reason", emptyBlockReason)
 		} else {
 			block, err := engine.getBlockByHash(round, blockHash)
 			if err == nil {
@@ -278,14 +340,13 @@ func (engine *Engine) loop() {
 					engine.log.Error("Add block", "err", err)
 					continue
 				}
-				if hash == blockHash {
+				if result.Final {
 					engine.log.Info("Reached FINAL", "block", blockHash.Hex(), "txs", len(block.Body.Transactions))
 					engine.chain.WriteFinalConsensus(blockHash)
-					cert = finalCert
 				} else {
 					engine.log.Info("Reached TENTATIVE", "block", blockHash.Hex(), "txs", len(block.Body.Transactions))
 				}
-				engine.chain.WriteCertificate(blockHash, cert.Compress(), engine.chain.IsPermanentCert(block.Header))
+				engine.chain.WriteCertificate(blockHash, compressedCert, engine.chain.IsPermanentCert(block.Header))
 			} else {
 				engine.log.Warn("Confirmed block is not found", "block", blockHash.Hex())
 			}
@@ -319,6 +380,11 @@ func (engine *Engine) completeRound(round uint64) {
 	engine.log.Debug("Pending blocks processed")
 
 	engine.votes.CompleteRound(round)
+
+	for _, vote := range engine.votes.ProcessPendingVotes() {
+		engine.pm.SendVote(vote)
+	}
+	engine.log.Debug("Pending votes processed")
 }
 
 func (engine *Engine) proposeBlock(proof []byte) *types.Block {
@@ -358,92 +424,43 @@ func (engine *Engine) waitForBlock(proposerPubKey []byte) *types.Block {
 	return block
 }
 
-func (engine *Engine) reduction(round uint64, block *types.Block) common.Hash {
-	engine.process = "Reduction started"
-	engine.log.Info("Reduction started", "block", block.Hash().Hex())
-
-	engine.vote(round, types.ReductionOne, block.Hash())
-	engine.process = fmt.Sprintf("Reduction %v vote commited", types.ReductionOne)
-
-	hash, _, err := engine.countVotes(round, types.ReductionOne, block.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.ReductionOneDelay)
-	engine.process = fmt.Sprintf("Reduction %v votes counted", types.ReductionOne)
+// engineStepVoter votes and counts gossiped votes of the round
+type engineStepVoter struct {
+	engine         *Engine
+	round          uint64
+	parentHash     common.Hash
+	emptyBlockHash common.Hash
+}
 
-	emptyBlock := engine.chain.GenerateEmptyBlock()
+func (v *engineStepVoter) vote(step uint8, hash common.Hash) {
+	v.engine.vote(v.round, step, hash)
+}
 
-	if err != nil {
-		hash = emptyBlock.Hash()
+func (v *engineStepVoter) countVotes(step uint8) (common.Hash, *types.FullBlockCert, error) {
+	timeout := v.engine.cfg.Consensus.WaitForStepDelay
+	if step == types.ReductionOne {
+		timeout = v.engine.cfg.Consensus.ReductionOneDelay
 	}
-	engine.vote(round, types.ReductionTwo, hash)
-
-	engine.process = fmt.Sprintf("Reduction %v vote commited", types.ReductionTwo)
-	hash, _, err = engine.countVotes(round, types.ReductionTwo, block.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.WaitForStepDelay)
-	engine.process = fmt.Sprintf("Reduction %v votes counted", types.ReductionTwo)
+	necessaryVotesCount := v.engine.chain.GetCommitteeVotesThreshold(v.engine.appState.ValidatorsCache, step == types.Final)
+	return v.engine.countVotes(v.round, step, v.parentHash, necessaryVotesCount, v.engine.stepTimeout(timeout))
+}
 
-	if err != nil {
-		hash = emptyBlock.Hash()
+func (v *engineStepVoter) interrupt() error {
+	if v.engine.nextBlockDetector.nextBlockExist(v.round, v.emptyBlockHash) {
+		return errors.New("Detected future block")
+	}
+	if v.engine.forkResolver.HasLoadedFork() {
+		return ForkDetected
 	}
-	engine.log.Info("Reduction completed", "block", hash.Hex(), "isEmpty", hash == emptyBlock.Hash())
-	return hash
+	return nil
 }
 
-func (engine *Engine) completeBA() {
-	engine.nextBlockDetector.complete()
+func (v *engineStepVoter) completeBA() {
+	v.engine.nextBlockDetector.complete()
 }
 
-func (engine *Engine) binaryBa(blockHash common.Hash) (common.Hash, *types.FullBlockCert, error) {
-	defer engine.completeBA()
-	engine.log.Info("binaryBa started", "block", blockHash.Hex())
-	emptyBlock := engine.chain.GenerateEmptyBlock()
-
-	emptyBlockHash := emptyBlock.Hash()
-
-	round := emptyBlock.Height()
-	hash := blockHash
-
-	for step := uint8(1); step < engine.cfg.Consensus.MaxSteps; {
-		engine.process = fmt.Sprintf("BA step %v", step)
-
-		engine.vote(round, step, hash)
-
-		hash, cert, err := engine.countVotes(round, step, emptyBlock.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.WaitForStepDelay)
-		if err != nil {
-			hash = blockHash
-		} else if hash != emptyBlockHash {
-			for i := uint8(1); i <= 2; i++ {
-				engine.vote(round, step+i, hash)
-			}
-			if step == 1 {
-				engine.vote(round, types.Final, hash)
-			}
-			return hash, cert, nil
-		}
-		step++
-
-		engine.process = fmt.Sprintf("BA step %v", step)
-
-		engine.vote(round, step, hash)
-
-		hash, cert, err = engine.countVotes(round, step, emptyBlock.Header.ParentHash(), engine.chain.GetCommitteeVotesThreshold(engine.appState.ValidatorsCache, false), engine.cfg.Consensus.WaitForStepDelay)
-
-		if err != nil {
-			hash = emptyBlockHash
-		} else if hash == emptyBlockHash {
-			for i := uint8(1); i <= 2; i++ {
-				engine.vote(round, step+i, hash)
-			}
-			return hash, cert, nil
-		}
-
-		step++
-
-		if engine.nextBlockDetector.nextBlockExist(round, emptyBlockHash) {
-			return common.Hash{}, nil, errors.New("Detected future block")
-		}
-		if engine.forkResolver.HasLoadedFork() {
-			return common.Hash{}, nil, ForkDetected
-		}
-	}
-	return common.Hash{}, nil, errors.New("No consensus")
+func (v *engineStepVoter) setProcess(process string) {
+	v.engine.process = process
 }
 
 func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
@@ -453,6 +470,7 @@ func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
 		return
 	}
 	if stepValidators.Contains(engine.addr) {
+		engine.roundState.vote(round, step, block)
 		vote := types.Vote{
 			Header: &types.VoteHeader{
 				Round:      round,
@@ -467,7 +485,11 @@ func (engine *Engine) vote(round uint64, step uint8, block common.Hash) {
 		}
 		hash := crypto.SignatureHash(&vote)
 		vote.Signature = engine.secStore.Sign(hash[:])
+		if engine.cfg.Consensus.EnableBlsVoteAggregation && engine.appState.ValidatorsCache.BlsPubKey(engine.addr) != nil {
+			vote.BlsSignature = engine.secStore.BlsSign(hash[:])
+		}
 		engine.pm.SendVote(&vote)
+		engine.chain.RecordVote(round, step)
 
 		engine.log.Info("Voted for", "step", step, "block", block.Hex())
 
@@ -482,7 +504,6 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 	engine.log.Debug("Start count votes", "step", step, "min-votes", necessaryVotesCount)
 	defer engine.log.Debug("Finish count votes", "step", step)
 
-	byBlock := make(map[common.Hash]map[common.Address]*types.Vote)
 	validators := engine.appState.ValidatorsCache.GetOnlineValidators(engine.chain.Head.Seed(), round, step, engine.chain.GetCommitteeSize(engine.appState.ValidatorsCache, step == types.Final))
 	if validators == nil {
 		return common.Hash{}, nil, errors.Errorf("validators were not setup, step=%v", step)
@@ -490,62 +511,34 @@ func (engine *Engine) countVotes(round uint64, step uint8, parentHash common.Has
 
 	necessaryVotesCount -= validators.VotesCountSubtrahend(engine.cfg.Consensus.AgreementThreshold)
 
-	for start := time.Now(); time.Since(start) < timeout; {
-		m := engine.votes.GetVotesOfRound(round)
-		if m != nil {
-
-			found := false
-			var bestHash common.Hash
-			var cert types.FullBlockCert
+	start := time.Now()
+	engine.roundState.startStep(round, step, necessaryVotesCount, start.UTC(), timeout)
+	for time.Since(start) < timeout {
+		if m := engine.votes.GetVotesOfRound(round); m != nil {
+			var votes []*types.Vote
 			m.Range(func(key, value interface{}) bool {
-				vote := value.(*types.Vote)
-
-				roundVotes, ok := byBlock[vote.Header.VotedHash]
-				if !ok {
-					roundVotes = make(map[common.Address]*types.Vote)
-					byBlock[vote.Header.VotedHash] = roundVotes
-				}
-
-				if _, ok := roundVotes[vote.VoterAddr()]; !ok {
-					if vote.Header.ParentHash != parentHash {
-						return true
-					}
-					if !validators.Addresses.Contains(vote.VoterAddr()) {
-						return true
-					}
-					if vote.Header.Step != step {
-						return true
-					}
-					roundVotes[vote.VoterAddr()] = vote
-
-					if len(roundVotes) >= necessaryVotesCount {
-						list := make([]*types.Vote, 0, necessaryVotesCount)
-
-						for _, v := range roundVotes {
-							list = append(list, v)
-							if len(list) >= necessaryVotesCount {
-								break
-							}
-						}
-						cert = types.FullBlockCert{Votes: list}
-						bestHash = vote.Header.VotedHash
-						found = len(cert.Votes) >= necessaryVotesCount
-						engine.log.Debug("Has votes", "cnt", len(roundVotes), "need", necessaryVotesCount, "step", step, "hash", bestHash.Hex())
-						return !found
-					}
-				}
+				votes = append(votes, value.(*types.Vote))
 				return true
 			})
-
-			if found {
-				return bestHash, &cert, nil
+			if hash, cert, found := countStepVotes(votes, step, parentHash, validators, necessaryVotesCount); found {
+				engine.log.Debug("Has votes", "cnt", len(cert.Votes), "need", necessaryVotesCount, "step", step, "hash", hash.Hex())
+				engine.stepTimeouts.observe(time.Since(start))
+				engine.roundState.completeStep(round, step, &hash)
+				return hash, cert, nil
 			}
 		}
 		time.Sleep(100 * time.Millisecond)
 	}
+	engine.stepTimeouts.observe(timeout)
+	engine.roundState.completeStep(round, step, nil)
 	return common.Hash{}, nil, errors.New(fmt.Sprintf("votes for step is not received, step=%v", step))
 }
 
+// stepTimeout returns the timeout of collecting votes of a step, the fixed one is adjusted if adaptive timeouts are enabled
+func (engine *Engine) stepTimeout(fixed time.Duration) time.Duration {
+	return engine.stepTimeouts.timeout(fixed, engine.cfg.Consensus.WaitForStepDelay, engine.pm.PeersLatency())
+}
+
 func (engine *Engine) getBlockByHash(round uint64, hash common.Hash) (*types.Block, error) {
 	block, err := engine.proposals.GetBlockByHash(round, hash)
 	if err == nil {
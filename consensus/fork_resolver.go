@@ -155,17 +155,7 @@ func (resolver *ForkResolver) applyFork(commonHeight uint64, fork []types.BlockB
 		}
 	}()
 
-	if err := resolver.chain.ResetTo(commonHeight); err != nil {
-		return err
-	}
-	for _, bundle := range fork {
-		if err := resolver.chain.AddBlock(bundle.Block, nil, resolver.statsCollector); err != nil {
-			return err
-		}
-		resolver.chain.WriteCertificate(bundle.Block.Hash(), bundle.Cert, false)
-	}
-
-	return nil
+	return resolver.chain.ApplyFork(commonHeight, fork, resolver.statsCollector)
 }
 
 func (resolver *ForkResolver) ApplyFork() error {
@@ -194,6 +184,11 @@ func (resolver *ForkResolver) Start() {
 	}()
 }
 
+// ResetTriedPeers allows to request forks from already tried peers again
+func (resolver *ForkResolver) ResetTriedPeers() {
+	resolver.triedPeers.Clear()
+}
+
 func (resolver *ForkResolver) HasLoadedFork() bool {
 	return resolver.applicableFork != nil
 }
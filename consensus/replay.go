@@ -0,0 +1,165 @@
+package consensus
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/validators"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/vrf"
+	"github.com/idena-network/idena-go/log"
+	"github.com/idena-network/idena-go/pengings"
+	"github.com/pkg/errors"
+	"math/big"
+)
+
+// ReplayedRound is the decision of the agreement protocol reached by the replay of an archived round
+type ReplayedRound struct {
+	Round          uint64
+	ProposerPubKey []byte
+	ProposedBlock  common.Hash
+	BlockHash      common.Hash
+	Final          bool
+	Steps          []*ReplayedStep
+}
+
+type ReplayedStep struct {
+	Step           uint8
+	NecessaryVotes int
+	Result         *common.Hash
+}
+
+// ReplayRound re-runs the agreement protocol of the round on top of the parent block with archived proposals and votes.
+// Committees of steps are selected with the validators cache of the parent block, messages are processed in the order
+// of archiving and proposer proofs are trusted since only validated proofs are archived.
+func ReplayRound(chain *blockchain.Blockchain, parent *types.Header, emptyBlockHash common.Hash,
+	validatorsCache *validators.ValidatorsCache, messages []*pengings.ArchivedMessage) (*ReplayedRound, error) {
+	round := parent.Height() + 1
+	result := &ReplayedRound{
+		Round:         round,
+		ProposedBlock: emptyBlockHash,
+	}
+	var votes []*types.Vote
+	var blocks []*types.BlockProposal
+	var bestProof *big.Float
+	for _, msg := range messages {
+		if msg.Round != round {
+			continue
+		}
+		switch msg.Type {
+		case pengings.ArchivedProof:
+			proof := new(types.ProofProposal)
+			if err := proof.FromBytes(msg.Data); err != nil {
+				return nil, errors.Wrap(err, "invalid archived proof")
+			}
+			h, err := vrf.HashFromProof(proof.Proof)
+			if err != nil {
+				continue
+			}
+			pubKey, err := types.ProofProposalPubKey(proof)
+			if err != nil {
+				continue
+			}
+			addr, err := crypto.PubKeyBytesToAddress(pubKey)
+			if err != nil {
+				continue
+			}
+			modifier := 1
+			if validatorsCache.IsPool(addr) {
+				modifier = validatorsCache.PoolSize(addr)
+			}
+			q := common.HashToFloat(common.Hash(h), int64(modifier))
+			if bestProof == nil || q.Cmp(bestProof) >= 0 {
+				bestProof = q
+				result.ProposerPubKey = pubKey
+			}
+		case pengings.ArchivedBlock:
+			proposal := new(types.BlockProposal)
+			if err := proposal.FromBytes(msg.Data); err != nil {
+				return nil, errors.Wrap(err, "invalid archived block")
+			}
+			if proposal.Block != nil && proposal.Header != nil && proposal.Header.ProposedHeader != nil && proposal.Header.ParentHash() == parent.Hash() {
+				blocks = append(blocks, proposal)
+			}
+		case pengings.ArchivedVote:
+			vote := new(types.Vote)
+			if err := vote.FromBytes(msg.Data); err != nil {
+				return nil, errors.Wrap(err, "invalid archived vote")
+			}
+			votes = append(votes, vote)
+		}
+	}
+	if result.ProposerPubKey != nil {
+		for _, proposal := range blocks {
+			if bytes.Equal(proposal.Header.ProposedHeader.ProposerPubKey, result.ProposerPubKey) {
+				result.ProposedBlock = proposal.Hash()
+				break
+			}
+		}
+	}
+
+	agreement := &agreement{
+		emptyBlockHash: emptyBlockHash,
+		maxSteps:       chain.Config().Consensus.MaxSteps,
+		voter: &replayStepVoter{
+			chain:           chain,
+			round:           round,
+			parent:          parent,
+			validatorsCache: validatorsCache,
+			votes:           votes,
+			result:          result,
+		},
+		log: log.New("component", "replay", "round", round),
+	}
+	agreementResult, err := agreement.run(result.ProposedBlock)
+	if err != nil {
+		return result, err
+	}
+	result.BlockHash = agreementResult.BlockHash
+	result.Final = agreementResult.Final
+	return result, nil
+}
+
+// replayStepVoter counts archived votes, votes of the node are archived as well, so it doesn't vote itself
+type replayStepVoter struct {
+	chain           *blockchain.Blockchain
+	round           uint64
+	parent          *types.Header
+	validatorsCache *validators.ValidatorsCache
+	votes           []*types.Vote
+	result          *ReplayedRound
+}
+
+func (v *replayStepVoter) vote(step uint8, hash common.Hash) {
+}
+
+func (v *replayStepVoter) countVotes(step uint8) (common.Hash, *types.FullBlockCert, error) {
+	final := step == types.Final
+	committee := v.validatorsCache.GetOnlineValidators(v.parent.Seed(), v.round, step, v.chain.GetCommitteeSize(v.validatorsCache, final))
+	if committee == nil {
+		return common.Hash{}, nil, errors.Errorf("validators were not setup, step=%v", step)
+	}
+	necessaryVotesCount := v.chain.GetCommitteeVotesThreshold(v.validatorsCache, final) - committee.VotesCountSubtrahend(v.chain.Config().Consensus.AgreementThreshold)
+	replayed := &ReplayedStep{
+		Step:           step,
+		NecessaryVotes: necessaryVotesCount,
+	}
+	v.result.Steps = append(v.result.Steps, replayed)
+	hash, cert, found := countStepVotes(v.votes, step, v.parent.Hash(), committee, necessaryVotesCount)
+	if !found {
+		return common.Hash{}, nil, errors.Errorf("votes for step is not received, step=%v", step)
+	}
+	replayed.Result = &hash
+	return hash, cert, nil
+}
+
+func (v *replayStepVoter) interrupt() error {
+	return nil
+}
+
+func (v *replayStepVoter) completeBA() {
+}
+
+func (v *replayStepVoter) setProcess(process string) {
+}
@@ -0,0 +1,133 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"sync"
+	"time"
+)
+
+// RoundInfo is the snapshot of the agreement protocol state of the current round
+type RoundInfo struct {
+	Round          uint64
+	Step           uint8
+	Process        string
+	Start          time.Time
+	ProposerPubKey []byte
+	ProposedBlock  *common.Hash
+	Steps          []*StepInfo
+}
+
+// StepInfo describes the votes of a step, Votes are collected votes by the voted hash
+type StepInfo struct {
+	Step           uint8
+	Start          time.Time
+	Deadline       time.Time
+	NecessaryVotes int
+	VotedHash      *common.Hash
+	Result         *common.Hash
+	Completed      bool
+	Votes          map[common.Hash]int
+}
+
+// roundState tracks the progress of the current round for introspection, it doesn't affect the protocol
+type roundState struct {
+	round          uint64
+	step           uint8
+	start          time.Time
+	proposerPubKey []byte
+	proposedBlock  *common.Hash
+	steps          map[uint8]*StepInfo
+	stepsOrder     []uint8
+	mutex          sync.Mutex
+}
+
+func newRoundState() *roundState {
+	return &roundState{
+		steps: make(map[uint8]*StepInfo),
+	}
+}
+
+func (s *roundState) startRound(round uint64, start time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.round = round
+	s.step = 0
+	s.start = start
+	s.proposerPubKey = nil
+	s.proposedBlock = nil
+	s.steps = make(map[uint8]*StepInfo)
+	s.stepsOrder = nil
+}
+
+func (s *roundState) setProposal(proposerPubKey []byte, block *types.Block) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.proposerPubKey = proposerPubKey
+	if block != nil {
+		hash := block.Hash()
+		s.proposedBlock = &hash
+	}
+}
+
+func (s *roundState) getStep(step uint8) *StepInfo {
+	info, ok := s.steps[step]
+	if !ok {
+		info = &StepInfo{Step: step}
+		s.steps[step] = info
+		s.stepsOrder = append(s.stepsOrder, step)
+	}
+	return info
+}
+
+func (s *roundState) vote(round uint64, step uint8, hash common.Hash) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.round != round {
+		return
+	}
+	s.getStep(step).VotedHash = &hash
+}
+
+func (s *roundState) startStep(round uint64, step uint8, necessaryVotes int, start time.Time, timeout time.Duration) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.round != round {
+		return
+	}
+	s.step = step
+	info := s.getStep(step)
+	info.Start = start
+	info.Deadline = start.Add(timeout)
+	info.NecessaryVotes = necessaryVotes
+}
+
+func (s *roundState) completeStep(round uint64, step uint8, result *common.Hash) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.round != round {
+		return
+	}
+	info := s.getStep(step)
+	info.Completed = true
+	info.Result = result
+}
+
+// snapshot returns the copy of the round state, votes of steps are filled by the caller
+func (s *roundState) snapshot() *RoundInfo {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	result := &RoundInfo{
+		Round:          s.round,
+		Step:           s.step,
+		Start:          s.start,
+		ProposerPubKey: s.proposerPubKey,
+		ProposedBlock:  s.proposedBlock,
+	}
+	for _, step := range s.stepsOrder {
+		info := *s.steps[step]
+		info.Votes = make(map[common.Hash]int)
+		result.Steps = append(result.Steps, &info)
+	}
+	return result
+}
@@ -0,0 +1,34 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_roundState(t *testing.T) {
+	require := require.New(t)
+	state := newRoundState()
+	start := time.Now().UTC()
+	state.startRound(10, start)
+
+	state.vote(10, 1, common.Hash{0x1})
+	state.startStep(10, 1, 5, start, time.Second*20)
+	state.completeStep(10, 1, &common.Hash{0x1})
+	state.startStep(10, 2, 5, start, time.Second*20)
+	// previous round is ignored
+	state.vote(9, 3, common.Hash{0x2})
+
+	info := state.snapshot()
+	require.Equal(uint64(10), info.Round)
+	require.Equal(uint8(2), info.Step)
+	require.Len(info.Steps, 2)
+	require.Equal(common.Hash{0x1}, *info.Steps[0].VotedHash)
+	require.True(info.Steps[0].Completed)
+	require.Equal(start.Add(time.Second*20), info.Steps[1].Deadline)
+	require.False(info.Steps[1].Completed)
+
+	state.startRound(11, start)
+	require.Empty(state.snapshot().Steps)
+}
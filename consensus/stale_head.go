@@ -0,0 +1,60 @@
+package consensus
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"time"
+)
+
+const (
+	minPeersForStaleHead = 3
+)
+
+// staleHeadDetector tracks own head progress and reports peers to resync from
+// when the head is not changed for a long time while the majority of peers are ahead
+type staleHeadDetector struct {
+	timeout      time.Duration
+	lag          uint64
+	lastHeight   uint64
+	lastProgress time.Time
+	lastRecovery time.Time
+}
+
+func newStaleHeadDetector(timeout time.Duration, lag uint64) *staleHeadDetector {
+	return &staleHeadDetector{
+		timeout: timeout,
+		lag:     lag,
+	}
+}
+
+// check returns peers which are ahead of the stale head and the top known height, nil if recovery is not needed
+func (d *staleHeadDetector) check(head uint64, heights map[peer.ID]uint64, now time.Time) ([]peer.ID, uint64) {
+	if d.timeout <= 0 {
+		return nil, 0
+	}
+	if head != d.lastHeight || d.lastProgress.IsZero() {
+		d.lastHeight = head
+		d.lastProgress = now
+		return nil, 0
+	}
+	if now.Sub(d.lastProgress) < d.timeout || now.Sub(d.lastRecovery) < d.timeout {
+		return nil, 0
+	}
+	if len(heights) < minPeersForStaleHead {
+		return nil, 0
+	}
+	var aheadPeers []peer.ID
+	var top uint64
+	for p, height := range heights {
+		if height >= head+d.lag {
+			aheadPeers = append(aheadPeers, p)
+		}
+		if height > top {
+			top = height
+		}
+	}
+	if len(aheadPeers)*2 <= len(heights) {
+		return nil, 0
+	}
+	d.lastRecovery = now
+	return aheadPeers, top
+}
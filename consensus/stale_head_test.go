@@ -0,0 +1,56 @@
+package consensus
+
+import (
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_staleHeadDetector(t *testing.T) {
+	require := require.New(t)
+	detector := newStaleHeadDetector(time.Minute, 3)
+	now := time.Now().UTC()
+
+	heights := map[peer.ID]uint64{
+		"1": 15,
+		"2": 14,
+		"3": 10,
+	}
+
+	peers, _ := detector.check(10, heights, now)
+	require.Nil(peers)
+
+	// head is stuck but the timeout is not reached
+	peers, _ = detector.check(10, heights, now.Add(time.Second*30))
+	require.Nil(peers)
+
+	// head progress resets the timer
+	peers, _ = detector.check(11, heights, now.Add(time.Second*50))
+	require.Nil(peers)
+	peers, _ = detector.check(11, heights, now.Add(time.Second*90))
+	require.Nil(peers)
+
+	peers, top := detector.check(11, heights, now.Add(time.Second*111))
+	require.ElementsMatch([]peer.ID{"1", "2"}, peers)
+	require.Equal(uint64(15), top)
+
+	// recovery is not repeated until the timeout is reached again
+	peers, _ = detector.check(11, heights, now.Add(time.Second*150))
+	require.Nil(peers)
+	peers, _ = detector.check(11, heights, now.Add(time.Second*172))
+	require.Len(peers, 2)
+
+	// minority of peers is ahead
+	heights["3"] = 11
+	heights["4"] = 12
+	heights["5"] = 11
+	peers, _ = detector.check(11, heights, now.Add(time.Second*300))
+	require.Nil(peers)
+
+	// detection is disabled
+	detector = newStaleHeadDetector(0, 3)
+	detector.check(11, heights, now)
+	peers, _ = detector.check(11, heights, now.Add(time.Hour))
+	require.Nil(peers)
+}
@@ -0,0 +1,92 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	maxStepDurationSamples = 50
+	minStepDurationSamples = 5
+	// stepDurationPercentile is the percentile of recent step durations the timeout is based on,
+	// rare slow steps don't affect it while frequently failed steps increase it
+	stepDurationPercentile = 0.9
+	stepDelayMultiplier    = 2
+)
+
+// stepTimeouts derives consensus step timeouts from the durations of recent steps and peer latency,
+// bounded by the configured min and max step delays
+type stepTimeouts struct {
+	cfg     *config.StepTimeoutsConfig
+	samples []time.Duration
+	mutex   sync.Mutex
+}
+
+func newStepTimeouts(cfg *config.StepTimeoutsConfig) *stepTimeouts {
+	return &stepTimeouts{
+		cfg: cfg,
+	}
+}
+
+func (t *stepTimeouts) enabled() bool {
+	return t.cfg != nil && t.cfg.Adaptive
+}
+
+// observe records the time spent to collect votes of a step, a failed step is recorded with its full timeout
+func (t *stepTimeouts) observe(duration time.Duration) {
+	if !t.enabled() {
+		return
+	}
+	if duration > t.cfg.MaxStepDelay {
+		duration = t.cfg.MaxStepDelay
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.samples = append(t.samples, duration)
+	if len(t.samples) > maxStepDurationSamples {
+		t.samples = t.samples[1:]
+	}
+}
+
+// stepDelayEstimate returns the adaptive step delay, false if there are not enough samples yet
+func (t *stepTimeouts) stepDelayEstimate(latency time.Duration) (time.Duration, bool) {
+	t.mutex.Lock()
+	if len(t.samples) < minStepDurationSamples {
+		t.mutex.Unlock()
+		return 0, false
+	}
+	sorted := make([]time.Duration, len(t.samples))
+	copy(sorted, t.samples)
+	t.mutex.Unlock()
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+	percentile := sorted[int(float64(len(sorted)-1)*stepDurationPercentile)]
+	delay := (percentile + latency) * stepDelayMultiplier
+	if delay < t.cfg.MinStepDelay {
+		delay = t.cfg.MinStepDelay
+	}
+	if delay > t.cfg.MaxStepDelay {
+		delay = t.cfg.MaxStepDelay
+	}
+	return delay, true
+}
+
+// timeout adjusts the fixed timeout of a step, the part exceeding the fixed step delay (e.g. waiting for the proposed block)
+// is kept as is
+func (t *stepTimeouts) timeout(fixed time.Duration, stepDelay time.Duration, latency time.Duration) time.Duration {
+	if !t.enabled() {
+		return fixed
+	}
+	delay, ok := t.stepDelayEstimate(latency)
+	if !ok {
+		return fixed
+	}
+	if fixed > stepDelay {
+		return fixed - stepDelay + delay
+	}
+	return delay
+}
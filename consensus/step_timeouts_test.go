@@ -0,0 +1,55 @@
+package consensus
+
+import (
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func Test_stepTimeouts(t *testing.T) {
+	require := require.New(t)
+	stepDelay := time.Second * 20
+	reductionOneDelay := time.Second * 40
+	timeouts := newStepTimeouts(&config.StepTimeoutsConfig{
+		Adaptive:     true,
+		MinStepDelay: time.Second * 5,
+		MaxStepDelay: time.Second * 40,
+	})
+
+	// not enough samples
+	for i := 0; i < minStepDurationSamples-1; i++ {
+		timeouts.observe(time.Second)
+	}
+	require.Equal(stepDelay, timeouts.timeout(stepDelay, stepDelay, 0))
+
+	// fast network, bounded by min delay
+	timeouts.observe(time.Second)
+	require.Equal(time.Second*5, timeouts.timeout(stepDelay, stepDelay, 0))
+	require.Equal(time.Second*25, timeouts.timeout(reductionOneDelay, stepDelay, 0))
+
+	// peer latency is taken into account
+	require.Equal(time.Second*6, timeouts.timeout(stepDelay, stepDelay, time.Second*2))
+
+	// rare slow steps don't affect the timeout
+	timeouts.observe(time.Second * 15)
+	require.Equal(time.Second*5, timeouts.timeout(stepDelay, stepDelay, 0))
+
+	// frequently slow steps increase the timeout, bounded by max delay
+	for i := 0; i < maxStepDurationSamples; i++ {
+		timeouts.observe(time.Second * 8)
+	}
+	require.Equal(time.Second*16, timeouts.timeout(stepDelay, stepDelay, 0))
+	for i := 0; i < maxStepDurationSamples; i++ {
+		timeouts.observe(time.Minute)
+	}
+	require.Equal(time.Second*40, timeouts.timeout(stepDelay, stepDelay, 0))
+
+	// fixed timeouts are used if adaptive ones are disabled
+	timeouts = newStepTimeouts(&config.StepTimeoutsConfig{})
+	for i := 0; i < maxStepDurationSamples; i++ {
+		timeouts.observe(time.Second)
+	}
+	require.Equal(stepDelay, timeouts.timeout(stepDelay, stepDelay, 0))
+	require.Equal(stepDelay, newStepTimeouts(nil).timeout(stepDelay, stepDelay, 0))
+}
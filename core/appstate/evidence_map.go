@@ -44,8 +44,8 @@ func (m *EvidenceMap) newTx(tx *types.Transaction) {
 	}
 
 	if time.Now().UTC().Sub(m.shortSessionTime) < m.shortSessionDuration {
-		sender, _ := types.Sender(tx)
-		m.answersSet.Add(sender)
+		candidate, _ := types.CeremonyIdentity(tx)
+		m.answersSet.Add(candidate)
 	}
 }
 
@@ -0,0 +1,212 @@
+package ceremony
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/pkg/errors"
+	"sort"
+)
+
+const (
+	ShortSession = "short"
+	LongSession  = "long"
+
+	// answers encode left/right bits of each flip and 3 bits of its grade
+	answerBitsPerFlip = 5
+)
+
+// LotteryCandidate is the ceremony candidate with flips it had at the start of the flip lottery
+type LotteryCandidate struct {
+	Address common.Address
+	Flips   [][]byte
+}
+
+// LotteryAssignment contains the flips the candidate had to solve in short and long sessions
+type LotteryAssignment struct {
+	Address    common.Address
+	ShortFlips [][]byte
+	LongFlips  [][]byte
+}
+
+// AnswersMismatch describes the answers tx which doesn't match the recomputed flip distribution
+type AnswersMismatch struct {
+	Address common.Address
+	TxHash  common.Hash
+	Session string
+	Reason  string
+}
+
+// LotteryAudit is the result of the recomputation of the flip lottery of the validation
+type LotteryAudit struct {
+	LotteryBlock    uint64
+	SeedBlock       uint64
+	ValidationBlock uint64
+	Seed            []byte
+	Assignments     []*LotteryAssignment
+	ShortAnswers    int
+	LongAnswers     int
+	Mismatches      []*AnswersMismatch
+}
+
+type lotteryChain interface {
+	GetBlockByHeight(height uint64) *types.Block
+}
+
+// lotterySeedHeight returns the height of the block which seed is used by the flip lottery started at the given height
+func lotterySeedHeight(lotteryHeight uint64, genesisHeight uint64) uint64 {
+	seedHeight := uint64(0)
+	if lotteryHeight > LotterySeedLag {
+		seedHeight = lotteryHeight - LotterySeedLag
+	}
+	return math.Max(genesisHeight+1, seedHeight)
+}
+
+// ComputeLotteryAssignments recomputes the flips distribution of the lottery with the given seed,
+// candidates are ordered by address as identities are iterated in the state
+func ComputeLotteryAssignments(seed []byte, lotteryCandidates []*LotteryCandidate) []*LotteryAssignment {
+	sorted := make([]*LotteryCandidate, len(lotteryCandidates))
+	copy(sorted, lotteryCandidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address.Bytes(), sorted[j].Address.Bytes()) < 0
+	})
+
+	candidates := make([]*candidate, 0, len(sorted))
+	var allFlips [][]byte
+	flipsPerAuthor := make(map[int][][]byte)
+	for idx, c := range sorted {
+		for _, cid := range c.Flips {
+			allFlips = append(allFlips, cid)
+			flipsPerAuthor[idx] = append(flipsPerAuthor[idx], cid)
+		}
+		candidates = append(candidates, &candidate{
+			Address:  c.Address,
+			IsAuthor: len(c.Flips) > 0,
+		})
+	}
+
+	shortFlipsCount := int(common.ShortSessionFlipsCount() + common.ShortSessionExtraFlipsCount())
+	authorsPerCandidate, _ := GetAuthorsDistribution(candidates, seed, shortFlipsCount)
+	shortFlipsPerCandidate, longFlipsPerCandidate := GetFlipsDistribution(len(candidates), authorsPerCandidate, flipsPerAuthor, allFlips, seed, shortFlipsCount)
+
+	result := make([]*LotteryAssignment, 0, len(candidates))
+	for _, c := range candidates {
+		result = append(result, &LotteryAssignment{
+			Address:    c.Address,
+			ShortFlips: getFlipsToSolve(c.Address, candidates, shortFlipsPerCandidate, allFlips),
+			LongFlips:  getFlipsToSolve(c.Address, candidates, longFlipsPerCandidate, allFlips),
+		})
+	}
+	return result
+}
+
+// AuditFlipLottery finds the flip lottery preceding the block which finished the validation, recomputes the flips
+// distribution for the given candidates and verifies answers submitted during the validation against it.
+// The seed of the chain is used if no seed is given.
+func AuditFlipLottery(chain lotteryChain, genesisHeight uint64, validationHeight uint64, seed []byte, candidates []*LotteryCandidate) (*LotteryAudit, error) {
+	validationBlock := chain.GetBlockByHeight(validationHeight)
+	if validationBlock == nil {
+		return nil, errors.Errorf("block %v is not found", validationHeight)
+	}
+	if !validationBlock.Header.Flags().HasFlag(types.ValidationFinished) {
+		return nil, errors.Errorf("block %v doesn't finish the validation", validationHeight)
+	}
+	var txs []*types.Transaction
+	lotteryHeight := uint64(0)
+	for height := validationHeight; height > genesisHeight; height-- {
+		block := chain.GetBlockByHeight(height)
+		if block == nil {
+			return nil, errors.Errorf("block %v is not found", height)
+		}
+		if height != validationHeight && block.Header.Flags().HasFlag(types.ValidationFinished) {
+			break
+		}
+		for _, tx := range block.Body.Transactions {
+			if tx.Type == types.SubmitShortAnswersTx || tx.Type == types.SubmitLongAnswersTx {
+				txs = append(txs, tx)
+			}
+		}
+		if block.Header.Flags().HasFlag(types.FlipLotteryStarted) {
+			lotteryHeight = height
+			break
+		}
+	}
+	if lotteryHeight == 0 {
+		return nil, errors.New("flip lottery block is not found")
+	}
+
+	seedHeight := lotterySeedHeight(lotteryHeight, genesisHeight)
+	seedBlock := chain.GetBlockByHeight(seedHeight)
+	if seedBlock == nil {
+		return nil, errors.Errorf("seed block %v is not found", seedHeight)
+	}
+	chainSeed := seedBlock.Seed().Bytes()
+	if seed == nil {
+		seed = chainSeed
+	} else if !bytes.Equal(seed, chainSeed) {
+		return nil, errors.Errorf("seed doesn't match the seed of block %v", seedHeight)
+	}
+
+	audit := &LotteryAudit{
+		LotteryBlock:    lotteryHeight,
+		SeedBlock:       seedHeight,
+		ValidationBlock: validationHeight,
+		Seed:            seed,
+		Assignments:     ComputeLotteryAssignments(seed, candidates),
+	}
+	audit.ShortAnswers, audit.LongAnswers, audit.Mismatches = verifyAnswers(audit.Assignments, txs)
+	return audit, nil
+}
+
+// verifyAnswers checks that answers txs are sent by or on behalf of candidates and answer no more flips than were assigned
+func verifyAnswers(assignments []*LotteryAssignment, txs []*types.Transaction) (shortAnswers, longAnswers int, mismatches []*AnswersMismatch) {
+	byAddress := make(map[common.Address]*LotteryAssignment, len(assignments))
+	for _, assignment := range assignments {
+		byAddress[assignment.Address] = assignment
+	}
+	for _, tx := range txs {
+		candidate, _ := types.CeremonyIdentity(tx)
+		session := ShortSession
+		var answers []byte
+		var parsed bool
+		if tx.Type == types.SubmitShortAnswersTx {
+			shortAnswers++
+			if attachment := attachments.ParseShortAnswerAttachment(tx); attachment != nil {
+				answers, parsed = attachment.Answers, true
+			}
+		} else {
+			session = LongSession
+			longAnswers++
+			if attachment := attachments.ParseLongAnswerAttachment(tx); attachment != nil {
+				answers, parsed = attachment.Answers, true
+			}
+		}
+		mismatch := func(reason string) {
+			mismatches = append(mismatches, &AnswersMismatch{
+				Address: candidate,
+				TxHash:  tx.Hash(),
+				Session: session,
+				Reason:  reason,
+			})
+		}
+		assignment, ok := byAddress[candidate]
+		if !ok {
+			mismatch("sender is not a candidate")
+			continue
+		}
+		if !parsed {
+			mismatch("answers cannot be parsed")
+			continue
+		}
+		flips := assignment.ShortFlips
+		if session == LongSession {
+			flips = assignment.LongFlips
+		}
+		if types.NewAnswersFromBits(uint(len(flips)), answers).Bits.BitLen() > len(flips)*answerBitsPerFlip {
+			mismatch("answers exceed assigned flips")
+		}
+	}
+	return shortAnswers, longAnswers, mismatches
+}
@@ -0,0 +1,103 @@
+package ceremony
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/ecies"
+	"github.com/stretchr/testify/require"
+	"math/rand"
+	"testing"
+)
+
+func Test_ComputeLotteryAssignments(t *testing.T) {
+	require := require.New(t)
+
+	var candidates []*LotteryCandidate
+	for i := 0; i < 20; i++ {
+		key, _ := crypto.GenerateKey()
+		candidate := &LotteryCandidate{
+			Address: crypto.PubkeyToAddress(key.PublicKey),
+		}
+		if i%4 != 0 {
+			for j := 0; j < 3; j++ {
+				candidate.Flips = append(candidate.Flips, []byte{byte(i), byte(j)})
+			}
+		}
+		candidates = append(candidates, candidate)
+	}
+	seed := common.Hash{0x1, 0x2, 0x3}.Bytes()
+
+	assignments := ComputeLotteryAssignments(seed, candidates)
+	require.Len(assignments, len(candidates))
+
+	shuffled := make([]*LotteryCandidate, len(candidates))
+	copy(shuffled, candidates)
+	rand.New(rand.NewSource(1)).Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	require.Equal(assignments, ComputeLotteryAssignments(seed, shuffled))
+
+	for _, assignment := range assignments {
+		require.NotEmpty(assignment.ShortFlips)
+		require.NotEmpty(assignment.LongFlips)
+	}
+
+	require.NotEqual(assignments, ComputeLotteryAssignments(common.Hash{0x4}.Bytes(), candidates))
+}
+
+func Test_verifyAnswers(t *testing.T) {
+	require := require.New(t)
+
+	candidateKey, _ := crypto.GenerateKey()
+	strangerKey, _ := crypto.GenerateKey()
+	assignments := []*LotteryAssignment{
+		{
+			Address:    crypto.PubkeyToAddress(candidateKey.PublicKey),
+			ShortFlips: [][]byte{{0x1}, {0x2}},
+			LongFlips:  [][]byte{{0x1}, {0x2}, {0x3}},
+		},
+	}
+
+	signedTx := func(txType types.TxType, payload []byte, nonce uint32, key *ecdsa.PrivateKey) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			Type:         txType,
+			Payload:      payload,
+		}
+		signed, err := types.SignTx(tx, key)
+		require.NoError(err)
+		return signed
+	}
+
+	validShort := types.NewAnswers(2)
+	validShort.Right(1)
+	validShort.Grade(1, types.GradeA)
+	exceedingLong := types.NewAnswers(5)
+	exceedingLong.Grade(4, types.GradeA)
+	longKey := ecies.ImportECDSA(candidateKey)
+
+	txs := []*types.Transaction{
+		signedTx(types.SubmitShortAnswersTx, attachments.CreateShortAnswerAttachment(validShort.Bytes(), 1), 1, candidateKey),
+		signedTx(types.SubmitLongAnswersTx, attachments.CreateLongAnswerAttachment(exceedingLong.Bytes(), nil, nil, longKey), 2, candidateKey),
+		signedTx(types.SubmitShortAnswersTx, attachments.CreateShortAnswerAttachment(validShort.Bytes(), 1), 1, strangerKey),
+		signedTx(types.SubmitLongAnswersTx, nil, 3, candidateKey),
+	}
+
+	shortAnswers, longAnswers, mismatches := verifyAnswers(assignments, txs)
+	require.Equal(2, shortAnswers)
+	require.Equal(2, longAnswers)
+	require.Len(mismatches, 3)
+
+	require.Equal(LongSession, mismatches[0].Session)
+	require.Equal(txs[1].Hash(), mismatches[0].TxHash)
+	require.Equal("answers exceed assigned flips", mismatches[0].Reason)
+
+	require.Equal(crypto.PubkeyToAddress(strangerKey.PublicKey), mismatches[1].Address)
+	require.Equal("sender is not a candidate", mismatches[1].Reason)
+
+	require.Equal(txs[3].Hash(), mismatches[2].TxHash)
+	require.Equal("answers cannot be parsed", mismatches[2].Reason)
+}
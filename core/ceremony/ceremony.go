@@ -38,12 +38,16 @@ import (
 )
 
 const (
-	LotterySeedLag                      = 100
-	MaxFlipKeysPackageBroadcastDelaySec = 120
-	MaxShortAnswersBroadcastDelaySec    = 60
-	// Flip keys will stop syncing with peers in FlipKeysSyncTimeFrame seconds after short session start
-	FlipKeysSyncTimeFrame = 60 * 4 // seconds
-	AllFlipsLoadingTime   = time.Hour * 2
+	LotterySeedLag      = 100
+	AllFlipsLoadingTime = time.Hour * 2
+)
+
+// ceremony progress flags are persisted to resume the ceremony after restart without repeated broadcasting
+const (
+	publicKeySentProgress byte = 1 << iota
+	privateKeysSentProgress
+	shortAnswersSentProgress
+	evidenceSentProgress
 )
 
 type ValidationCeremony struct {
@@ -83,6 +87,9 @@ type ValidationCeremony struct {
 	lottery                  *lottery
 	flipsData                *flipsData
 	allFlipsIsLoading        bool
+	flipsDecryption          *flipsDecryption
+	delegatedAnswers         *delegatedAnswers
+	timeline                 *ceremonyTimeline
 }
 
 type flipWordsInfo struct {
@@ -95,6 +102,8 @@ type epochApplyingCache struct {
 	epochApplyingResult map[common.Address]cacheValue
 	validationFailed    bool
 	validationResults   *types.ValidationResults
+	explanations        map[common.Address]*types.ValidationExplanation
+	flipQualifications  []FlipQualification
 }
 
 type cacheValue struct {
@@ -143,6 +152,9 @@ func NewValidationCeremony(appState *appstate.AppState, bus eventbus.Bus, flippe
 		newTxQueue:         make(chan *types.Transaction, 10000),
 		flipWordsInfo:      &flipWordsInfo{pool: &sync.Map{}},
 		lottery:            &lottery{},
+		flipsDecryption:    newFlipsDecryption(),
+		delegatedAnswers:   newDelegatedAnswers(),
+		timeline:           newCeremonyTimeline(database.NewRepo(db)),
 		flipsData: &flipsData{
 			shortFlipsToSolve: make(map[common.Address][][]byte),
 			longFlipsToSolve:  make(map[common.Address][][]byte),
@@ -199,6 +211,11 @@ func (vc *ValidationCeremony) addBlock(block *types.Block) {
 
 	// completeEpoch if finished
 	if block.Header.Flags().HasFlag(types.ValidationFinished) {
+		if vc.epoch != vc.appState.State.Epoch() {
+			vc.recordPhase(ValidationFinishedPhase, block.Height(), time.Time{})
+			vc.persistValidationExplanations(block.Height())
+			vc.persistTrainingFlips(block.Height())
+		}
 		vc.completeEpoch()
 		vc.startValidationShortSessionTimer()
 		vc.generateFlipKeyWordPairs(vc.appState.State.FlipWordsSeed().Bytes())
@@ -277,7 +294,52 @@ func (vc *ValidationCeremony) SubmitLongAnswers(answers *types.Answers) (common.
 	return hash, err
 }
 
+// LongAnswer is the answer to the long session flip saved before the submission of long answers
+type LongAnswer struct {
+	Flip   []byte
+	Answer types.Answer
+	Grade  types.Grade
+}
+
+// SaveLongAnswer saves the answer to the own long session flip, so answers given before a restart of the client
+// can be restored and submitted with long answers
+func (vc *ValidationCeremony) SaveLongAnswer(flip []byte, answer types.Answer, grade types.Grade) error {
+	if period := vc.appState.State.ValidationPeriod(); period != state.ShortSessionPeriod && period != state.LongSessionPeriod {
+		return errors.New("long session answers are not accepted in the current period")
+	}
+	if answer > types.Right || grade > types.GradeA {
+		return errors.New("invalid answer")
+	}
+	found := false
+	for _, key := range vc.GetLongFlipsToSolve(vc.secStore.GetAddress()) {
+		if bytes.Equal(key, flip) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return errors.New("flip is not assigned to the node identity in the long session")
+	}
+	vc.epochDb.WriteOwnLongAnswer(flip, answer, grade)
+	return nil
+}
+
+// SavedLongAnswers returns saved answers to own long session flips
+func (vc *ValidationCeremony) SavedLongAnswers() []*LongAnswer {
+	var result []*LongAnswer
+	vc.epochDb.IterateOverOwnLongAnswers(func(cid []byte, answer types.Answer, grade types.Grade) {
+		result = append(result, &LongAnswer{
+			Flip:   append([]byte{}, cid...),
+			Answer: answer,
+			Grade:  grade,
+		})
+	})
+	return result
+}
+
 func (vc *ValidationCeremony) restoreState() {
+	vc.restoreProgress()
+	vc.timeline.reset(vc.epoch)
 	vc.generateFlipKeyWordPairs(vc.appState.State.FlipWordsSeed().Bytes())
 	vc.appState.EvidenceMap.SetShortSessionTime(vc.appState.State.NextValidationTime(), vc.config.Validation.GetShortSessionDuration())
 	vc.qualification.restore()
@@ -286,12 +348,41 @@ func (vc *ValidationCeremony) restoreState() {
 		vc.calculateCeremonyCandidates()
 		vc.lottery.finished = true
 	}
-	stopFlipKeysStopTime := vc.appState.State.NextValidationTime().Add(FlipKeysSyncTimeFrame * time.Second)
+	stopFlipKeysStopTime := vc.appState.State.NextValidationTime().Add(vc.config.Validation.GetFlipKeysSyncTimeFrame())
 	if stopFlipKeysStopTime.Before(time.Now().UTC()) {
 		vc.stopFlipKeysSync()
 	}
 }
 
+func (vc *ValidationCeremony) persistProgress() {
+	var progress byte
+	if vc.publicKeySent {
+		progress |= publicKeySentProgress
+	}
+	if vc.privateKeysSent {
+		progress |= privateKeysSentProgress
+	}
+	if vc.shortAnswersSent {
+		progress |= shortAnswersSentProgress
+	}
+	if vc.evidenceSent {
+		progress |= evidenceSentProgress
+	}
+	vc.epochDb.WriteCeremonyProgress(progress)
+}
+
+func (vc *ValidationCeremony) restoreProgress() {
+	progress := vc.epochDb.ReadCeremonyProgress()
+	vc.publicKeySent = progress&publicKeySentProgress != 0
+	vc.privateKeysSent = progress&privateKeysSentProgress != 0
+	vc.shortAnswersSent = progress&shortAnswersSentProgress != 0
+	vc.evidenceSent = progress&evidenceSentProgress != 0
+	if progress != 0 {
+		vc.log.Info("Ceremony progress restored", "publicKey", vc.publicKeySent, "privateKeys", vc.privateKeysSent,
+			"shortAnswers", vc.shortAnswersSent, "evidence", vc.evidenceSent)
+	}
+}
+
 func (vc *ValidationCeremony) startValidationShortSessionTimer() {
 	if vc.validationStartCtxCancel != nil {
 		return
@@ -332,9 +423,9 @@ func (vc *ValidationCeremony) startValidationShortSessionTimer() {
 
 func (vc *ValidationCeremony) completeEpoch() {
 	if vc.epoch != vc.appState.State.Epoch() {
-		edb := vc.epochDb
+		edb, prevEpoch, epoch := vc.epochDb, vc.epoch, vc.appState.State.Epoch()
 		go func() {
-			vc.dropFlips(edb)
+			vc.flipper.CollectFlips(edb, prevEpoch, epoch)
 			edb.Clear()
 		}()
 	}
@@ -343,6 +434,9 @@ func (vc *ValidationCeremony) completeEpoch() {
 
 	vc.qualification = NewQualification(vc.epochDb)
 	vc.flipper.Clear()
+	vc.flipsDecryption.stop()
+	vc.delegatedAnswers.clear()
+	vc.timeline.reset(vc.epoch)
 	vc.keysPool.Clear()
 	vc.appState.EvidenceMap.Clear()
 	vc.appState.EvidenceMap.SetShortSessionTime(vc.appState.State.NextValidationTime(), vc.config.Validation.GetShortSessionDuration())
@@ -377,13 +471,9 @@ func (vc *ValidationCeremony) handleBlock(block *types.Block) {
 func (vc *ValidationCeremony) handleFlipLotteryPeriod(block *types.Block) {
 	if block.Header.Flags().HasFlag(types.FlipLotteryStarted) {
 		vc.logInfoWithInteraction("Flip lottery started")
+		vc.recordPhase(FlipLotteryStartedPhase, block.Height(), vc.appState.State.NextValidationTime().Add(-vc.config.Validation.GetFlipLotteryDuration()))
 
-		seedHeight := uint64(0)
-		if block.Height() > LotterySeedLag {
-			seedHeight = block.Height() - LotterySeedLag
-		}
-
-		seedHeight = math.Max(vc.chain.GenesisInfo().Genesis.Height()+1, seedHeight)
+		seedHeight := lotterySeedHeight(block.Height(), vc.chain.GenesisInfo().Genesis.Height())
 		seedBlock := vc.chain.GetBlockHeaderByHeight(seedHeight)
 
 		vc.epochDb.WriteLotterySeed(seedBlock.Seed().Bytes())
@@ -397,8 +487,8 @@ func (vc *ValidationCeremony) handleFlipLotteryPeriod(block *types.Block) {
 }
 
 func (vc *ValidationCeremony) tryToBroadcastFlipKeysPackage() {
-	// attempt to broadcast own flip key package since MaxFlipKeysPackageBroadcastDelaySec seconds after flip lottery has started
-	shift := vc.config.Validation.GetFlipLotteryDuration() - MaxFlipKeysPackageBroadcastDelaySec*time.Second
+	// attempt to broadcast own flip key package since the max broadcast delay after flip lottery has started
+	shift := vc.config.Validation.GetFlipLotteryDuration() - vc.config.Validation.GetFlipKeysPackageBroadcastDelay()
 	if shift < 0 || vc.appState.State.NextValidationTime().Sub(time.Now().UTC()) < shift {
 		vc.broadcastPrivateFlipKeysPackage(vc.appState)
 	}
@@ -408,6 +498,7 @@ func (vc *ValidationCeremony) asyncFlipLotteryCalculations() {
 	vc.logInfoWithInteraction("Flip lottery calculations started")
 	vc.calculateCeremonyCandidates()
 	vc.logInfoWithInteraction("Flip lottery calculations finished")
+	vc.recordPhase(FlipLotteryCalculatedPhase, vc.chain.Head.Height(), time.Time{})
 	vc.lottery.finished = true
 	vc.lottery.wg.Done()
 
@@ -425,6 +516,7 @@ func (vc *ValidationCeremony) handleShortSessionPeriod(block *types.Block) {
 	vc.broadcastPrivateFlipKeysPackage(vc.appState)
 	vc.broadcastPublicFipKey(vc.appState)
 	vc.processCeremonyTxs(block)
+	vc.submitDelegatedAnswers(false)
 }
 
 func (vc *ValidationCeremony) startShortSession(appState *appstate.AppState) {
@@ -439,6 +531,7 @@ func (vc *ValidationCeremony) startShortSession(appState *appstate.AppState) {
 	}
 
 	vc.logInfoWithInteraction("Short session started", "at", vc.appState.State.NextValidationTime().String())
+	vc.recordPhase(ShortSessionOpenedPhase, vc.chain.Head.Height(), vc.appState.State.NextValidationTime())
 	vc.broadcastPublicFipKey(appState)
 	vc.shortSessionStarted = true
 }
@@ -446,19 +539,21 @@ func (vc *ValidationCeremony) startShortSession(appState *appstate.AppState) {
 func (vc *ValidationCeremony) handleLongSessionPeriod(block *types.Block) {
 	if block.Header.Flags().HasFlag(types.LongSessionStarted) {
 		vc.logInfoWithInteraction("Long session started")
+		vc.recordPhase(ShortSessionClosedPhase, block.Height(), vc.appState.State.NextValidationTime().Add(vc.config.Validation.GetShortSessionDuration()))
 		go vc.delayedShortAnswersTxBroadcast()
 	}
 
 	vc.processCeremonyTxs(block)
 	vc.broadcastPublicFipKey(vc.appState)
 
-	// attempt to broadcast short answers since MaxShortAnswersBroadcastDelaySec seconds after long session has started
-	shortAnswersBroadcastTime := vc.appState.State.NextValidationTime().Add(vc.config.Validation.GetShortSessionDuration()).Add(MaxShortAnswersBroadcastDelaySec * time.Second)
+	// attempt to broadcast short answers since the max broadcast delay after long session has started
+	shortAnswersBroadcastTime := vc.appState.State.NextValidationTime().Add(vc.config.Validation.GetShortSessionDuration()).Add(vc.config.Validation.GetShortAnswersBroadcastDelay())
 	if shortAnswersBroadcastTime.Before(time.Now().UTC()) {
 		vc.broadcastShortAnswersTx()
 	}
+	vc.submitDelegatedAnswers(shortAnswersBroadcastTime.Before(time.Now().UTC()))
 
-	stopFlipKeysStopTime := vc.appState.State.NextValidationTime().Add(FlipKeysSyncTimeFrame * time.Second)
+	stopFlipKeysStopTime := vc.appState.State.NextValidationTime().Add(vc.config.Validation.GetFlipKeysSyncTimeFrame())
 	if stopFlipKeysStopTime.Before(time.Now().UTC()) {
 		vc.stopFlipKeysSync()
 	}
@@ -469,12 +564,25 @@ func (vc *ValidationCeremony) handleLongSessionPeriod(block *types.Block) {
 func (vc *ValidationCeremony) handleAfterLongSessionPeriod(block *types.Block) {
 	if block.Header.Flags().HasFlag(types.AfterLongSessionStarted) {
 		vc.logInfoWithInteraction("After long session started")
+		networkSize := vc.appState.ValidatorsCache.NetworkSize()
+		vc.recordPhase(LongSessionClosedPhase, block.Height(), vc.appState.State.NextValidationTime().
+			Add(vc.config.Validation.GetShortSessionDuration()).Add(vc.config.Validation.GetLongSessionDuration(networkSize)))
+		if vc.config.Validation != nil && vc.config.Validation.PrescreenFlips {
+			go vc.rememberSolvedFlipImages()
+		}
 	}
 	vc.processCeremonyTxs(block)
 	vc.stopFlipKeysSync()
 	vc.log.Info("After long blocks without ceremonial txs", "cnt", vc.appState.State.BlocksCntWithoutCeremonialTxs())
 }
 
+// rememberSolvedFlipImages saves hashes of images of solved flips, so the node author doesn't reuse them in own flips
+func (vc *ValidationCeremony) rememberSolvedFlipImages() {
+	for _, flip := range vc.flipsDecryption.all() {
+		vc.flipper.RememberFlipImages(flip.publicPart, flip.privatePart)
+	}
+}
+
 func (vc *ValidationCeremony) calculateCeremonyCandidates() {
 	if vc.candidates != nil {
 		return
@@ -502,8 +610,8 @@ func (vc *ValidationCeremony) calculateCeremonyCandidates() {
 	longToSolve := vc.GetLongFlipsToSolve(vc.secStore.GetAddress())
 
 	if vc.shouldInteractWithNetwork() {
-		go vc.flipper.LoadInMemory(shortToSolve)
-		go vc.flipper.LoadInMemory(longToSolve)
+		go vc.flipper.LoadInMemory(shortToSolve, longToSolve)
+		vc.flipsDecryption.start(append(append([][]byte{}, shortToSolve...), longToSolve...), vc.decryptFlip)
 	}
 
 	vc.logInfoWithInteraction("Ceremony candidates", "cnt", len(vc.candidates))
@@ -560,16 +668,18 @@ func (vc *ValidationCeremony) broadcastPublicFipKey(appState *appstate.AppState)
 	if err := vc.keysPool.AddPublicFlipKey(signedMsg, true); err == mempool.KeyIsAlreadyPublished {
 		vc.log.Info("public flip key broadcasting skipped")
 		vc.publicKeySent = true
+		vc.persistProgress()
 	} else if err != nil {
 		vc.log.Error("failed to broadcast public flip key", "epoch", epoch, "err", err)
 	} else {
 		vc.publicKeySent = true
+		vc.persistProgress()
 	}
 }
 
 func (vc *ValidationCeremony) delayedFlipPackageBroadcast() {
 	if vc.shouldInteractWithNetwork() {
-		time.Sleep(time.Duration(rand.Intn(MaxFlipKeysPackageBroadcastDelaySec)) * time.Second)
+		time.Sleep(time.Duration(rand.Int63n(int64(vc.config.Validation.GetFlipKeysPackageBroadcastDelay()))))
 		vc.broadcastPrivateFlipKeysPackage(vc.appState)
 	}
 }
@@ -589,12 +699,19 @@ func (vc *ValidationCeremony) broadcastPrivateFlipKeysPackage(appState *appstate
 
 	publicFlipKey, privateFlipKey := vc.flipper.GetFlipPublicEncryptionKey(), vc.flipper.GetFlipPrivateEncryptionKey()
 
-	msg := types.PrivateFlipKeysPackage{
+	msg := &types.PrivateFlipKeysPackage{
 		Data:  mempool.EncryptPrivateKeysPackage(publicFlipKey, privateFlipKey, pubKeys),
 		Epoch: epoch,
 	}
+	if vc.config.Consensus.EnableShardedFlipKeysPackages {
+		if shardedMsg, err := vc.keysPool.ShardPrivateKeysPackage(msg); err == nil {
+			msg = shardedMsg
+		} else {
+			vc.log.Warn("cannot shard private flip keys package, full package will be broadcast", "err", err)
+		}
+	}
 
-	signedMsg, err := vc.secStore.SignFlipKeysPackage(&msg)
+	signedMsg, err := vc.secStore.SignFlipKeysPackage(msg)
 
 	if err != nil {
 		vc.log.Error("cannot sign private flip keys package", "epoch", epoch, "err", err)
@@ -604,11 +721,13 @@ func (vc *ValidationCeremony) broadcastPrivateFlipKeysPackage(appState *appstate
 	if err := vc.keysPool.AddPrivateKeysPackage(signedMsg, true); err == mempool.KeyIsAlreadyPublished {
 		vc.log.Info("private flip keys package broadcasting skipped")
 		vc.privateKeysSent = true
+		vc.persistProgress()
 	} else if err != nil {
 		vc.log.Error("failed to add key package", "epoch", epoch, "err", err)
 	} else {
 		vc.log.Info("private flip keys package has been broadcast")
 		vc.privateKeysSent = true
+		vc.persistProgress()
 	}
 }
 
@@ -688,20 +807,20 @@ func getFlipsToSolve(self common.Address, participants []*candidate, flipsPerCan
 
 func (vc *ValidationCeremony) processCeremonyTxs(block *types.Block) {
 	for _, tx := range block.Body.Transactions {
-		sender, _ := types.Sender(tx)
+		candidate, _ := types.CeremonyIdentity(tx)
 
 		switch tx.Type {
 		case types.SubmitAnswersHashTx:
-			if !vc.epochDb.HasAnswerHash(sender) {
-				vc.epochDb.WriteAnswerHash(sender, common.BytesToHash(tx.Payload), time.Now().UTC())
+			if !vc.epochDb.HasAnswerHash(candidate) {
+				vc.epochDb.WriteAnswerHash(candidate, common.BytesToHash(tx.Payload), time.Now().UTC())
 			}
 		case types.SubmitShortAnswersTx:
-			vc.qualification.addAnswers(true, sender, tx.Payload)
+			vc.qualification.addAnswers(true, candidate, tx.Payload)
 		case types.SubmitLongAnswersTx:
-			vc.qualification.addAnswers(false, sender, tx.Payload)
+			vc.qualification.addAnswers(false, candidate, tx.Payload)
 		case types.EvidenceTx:
-			if !vc.epochDb.HasEvidenceMap(sender) {
-				vc.epochDb.WriteEvidenceMap(sender, tx.Payload)
+			if !vc.epochDb.HasEvidenceMap(candidate) {
+				vc.epochDb.WriteEvidenceMap(candidate, tx.Payload)
 			}
 		}
 	}
@@ -709,7 +828,7 @@ func (vc *ValidationCeremony) processCeremonyTxs(block *types.Block) {
 
 func (vc *ValidationCeremony) delayedShortAnswersTxBroadcast() {
 	if vc.shouldInteractWithNetwork() {
-		time.Sleep(time.Duration(rand.Intn(MaxShortAnswersBroadcastDelaySec)) * time.Second)
+		time.Sleep(time.Duration(rand.Int63n(int64(vc.config.Validation.GetShortAnswersBroadcastDelay()))))
 		vc.broadcastShortAnswersTx()
 	}
 }
@@ -732,6 +851,7 @@ func (vc *ValidationCeremony) broadcastShortAnswersTx() {
 
 	if _, err := vc.sendTx(types.SubmitShortAnswersTx, attachments.CreateShortAnswerAttachment(answers, getWordsRnd(h))); err == nil || err == validation.DuplicatedTx || err == mempool.DuplicateTxError {
 		vc.shortAnswersSent = true
+		vc.persistProgress()
 	} else {
 		vc.log.Error("cannot send short answers tx", "err", err)
 	}
@@ -779,6 +899,8 @@ func (vc *ValidationCeremony) broadcastEvidenceMap() {
 
 	if _, err := vc.sendTx(types.EvidenceTx, buf.Bytes()); err == nil {
 		vc.evidenceSent = true
+		vc.recordPhase(EvidenceSentPhase, vc.chain.Head.Height(), time.Time{})
+		vc.persistProgress()
 	} else {
 		vc.log.Error("cannot send evidence tx", "err", err)
 	}
@@ -912,6 +1034,7 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 
 	intermediateIdentitiesCount := 0
 	epochApplyingValues := make(map[common.Address]cacheValue)
+	explanations := make(map[common.Address]*types.ValidationExplanation)
 
 	for idx, candidate := range vc.candidates {
 		addr := candidate.Address
@@ -947,6 +1070,22 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 			totalFlips, missed, noQualShort, noQualLong)
 		identityBirthday := determineIdentityBirthday(vc.epoch, identity, newIdentityState)
 
+		explanation := &types.ValidationExplanation{
+			Epoch:               vc.epoch,
+			PrevState:           uint8(identity.State),
+			NewState:            uint8(newIdentityState),
+			Approved:            approved,
+			Missed:              missed,
+			RequiredFlips:       uint32(identity.RequiredFlips),
+			Short:               newSessionExplanation(shortFlipsToSolveCount(shortFlipsToSolve), shortFlipAnswers, shortQualifiedFlipsCount, shortFlipPoint, shortScore, noAnswersShort, noQualShort),
+			Long:                newSessionExplanation(len(longFlipsToSolve), longFlipAnswers, longQualifiedFlipsCount, longFlipPoint, longScore, noAnswersLong, noQualLong),
+			TotalQualifiedFlips: totalFlips,
+			TotalScore:          totalScore,
+		}
+		explanation.Reason = explainNewIdentityState(identity, newIdentityState, approved, &explanation.Short, &explanation.Long, totalScore, totalFlips)
+		setAuthorExplanation(explanation, addr, flipsByAuthor[addr], flipQualification, validationResults)
+		explanations[addr] = explanation
+
 		incSuccessfulInvites(validationResults, god, identity, identityBirthday, newIdentityState, vc.epoch)
 		setValidationResultToGoodAuthor(addr, newIdentityState, missed, validationResults)
 		setValidationResultToGoodInviter(validationResults, addr, newIdentityState, identity.Invites)
@@ -984,10 +1123,17 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 	if intermediateIdentitiesCount == 0 {
 		vc.log.Warn("validation failed, nobody is validated, identities remains the same")
 		stats.Failed = true
+		for _, explanation := range explanations {
+			explanation.NewState = explanation.PrevState
+			explanation.ValidationFailed = true
+			explanation.Reason = ValidationFailedReason
+		}
 		vc.epochApplyingCache[height] = epochApplyingCache{
 			epochApplyingResult: epochApplyingValues,
 			validationResults:   validationResults,
 			validationFailed:    true,
+			explanations:        explanations,
+			flipQualifications:  flipQualification,
 		}
 		return vc.appState.ValidatorsCache.NetworkSize(), validationResults, true
 	}
@@ -1015,6 +1161,20 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 			delegatee:                identity.Delegatee,
 		}
 		epochApplyingValues[addr] = value
+
+		explanation := &types.ValidationExplanation{
+			Epoch:         vc.epoch,
+			PrevState:     uint8(identity.State),
+			NewState:      uint8(newIdentityState),
+			Missed:        true,
+			RequiredFlips: uint32(identity.RequiredFlips),
+			MadeFlips:     uint32(len(identity.Flips)),
+			Reason:        NotCandidateReason,
+		}
+		if !identity.HasDoneAllRequiredFlips() {
+			explanation.Reason = NotAllRequiredFlipsReason
+		}
+		explanations[addr] = explanation
 		identitiesCount += applyOnState(appState, statsCollector, addr, value)
 	}
 
@@ -1022,6 +1182,8 @@ func (vc *ValidationCeremony) ApplyNewEpoch(height uint64, appState *appstate.Ap
 		epochApplyingResult: epochApplyingValues,
 		validationResults:   validationResults,
 		validationFailed:    false,
+		explanations:        explanations,
+		flipQualifications:  flipQualification,
 	}
 
 	return identitiesCount, validationResults, false
@@ -1198,12 +1360,6 @@ func flipPos(flips [][]byte, flip []byte) int {
 	return -1
 }
 
-func (vc *ValidationCeremony) dropFlips(db *database.EpochDb) {
-	db.IterateOverFlipCids(func(cid []byte) {
-		vc.flipper.UnpinFlip(cid)
-	})
-}
-
 func (vc *ValidationCeremony) dropFlip(cid []byte) {
 	vc.epochDb.DeleteFlipCid(cid)
 	vc.flipper.UnpinFlip(cid)
@@ -1351,7 +1507,7 @@ func (vc *ValidationCeremony) FlipKeyWordPairs() []int {
 
 func (vc *ValidationCeremony) generateFlipKeyWordPairs(seed []byte) {
 	identity := vc.appState.State.GetIdentity(vc.secStore.GetAddress())
-	vc.flipWordsInfo.pairs, vc.flipWordsInfo.proof = vc.GeneratePairs(seed, common.WordDictionarySize,
+	vc.flipWordsInfo.pairs, vc.flipWordsInfo.proof = vc.GeneratePairs(seed, vc.appState.State.WordsDictionarySize(),
 		identity.GetTotalWordPairsCount())
 }
 
@@ -1383,7 +1539,7 @@ func (vc *ValidationCeremony) GetFlipWords(cid []byte) (word1, word2 int, err er
 		}
 	}
 
-	return GetWords(rnd, common.WordDictionarySize, identity.GetTotalWordPairsCount(), pairId)
+	return GetWords(rnd, vc.appState.State.WordsDictionarySize(), identity.GetTotalWordPairsCount(), pairId)
 }
 
 func (vc *ValidationCeremony) getCandidateIndex(addr common.Address) int {
@@ -1416,10 +1572,10 @@ func (vc *ValidationCeremony) newTxLoop() {
 	for {
 		tx := <-vc.newTxQueue
 		if tx.Type == types.SubmitShortAnswersTx {
-			sender, _ := types.Sender(tx)
+			candidate, _ := types.CeremonyIdentity(tx)
 			attachment := attachments.ParseShortAnswerAttachment(tx)
 			if attachment != nil {
-				vc.flipWordsInfo.pool.Store(sender, attachment.Rnd)
+				vc.flipWordsInfo.pool.Store(candidate, attachment.Rnd)
 			}
 		}
 	}
@@ -1431,7 +1587,7 @@ func (vc *ValidationCeremony) stopFlipKeysSync() {
 
 func (vc *ValidationCeremony) delayedStopFlipKeysSync() {
 	if vc.shouldInteractWithNetwork() {
-		time.Sleep(FlipKeysSyncTimeFrame * time.Second)
+		time.Sleep(vc.config.Validation.GetFlipKeysSyncTimeFrame())
 		vc.stopFlipKeysSync()
 	}
 }
@@ -1463,23 +1619,41 @@ func (vc *ValidationCeremony) GetFlipKeys(addr common.Address, cidBytes []byte)
 }
 
 func (vc *ValidationCeremony) GetDecryptedFlip(key []byte) (publicPart []byte, privatePart []byte, err error) {
-	encryptedPublicPart, encryptedPrivatePart, err := vc.flipper.GetFlipFromMemory(key)
+	if flip := vc.flipsDecryption.get(key); flip != nil {
+		return flip.publicPart, flip.privatePart, nil
+	}
+	flip, err := vc.decryptFlip(key)
 	if err != nil {
 		return nil, nil, err
 	}
+	return flip.publicPart, flip.privatePart, nil
+}
+
+func (vc *ValidationCeremony) decryptFlip(key []byte) (*decryptedFlip, error) {
+	encryptedPublicPart, encryptedPrivatePart, err := vc.flipper.GetFlipFromMemory(key)
+	if err != nil {
+		return nil, err
+	}
 
 	publicKey, encryptedPrivateKey, err := vc.GetFlipKeys(vc.secStore.GetAddress(), key)
 
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	decryptedPrivateKey, err := vc.secStore.DecryptMessage(encryptedPrivateKey)
 	if err != nil {
-		return nil, nil, errors.Errorf("invalid private key, encrypted: %x, err: %v", encryptedPrivateKey, err)
+		return nil, errors.Errorf("invalid private key, encrypted: %x, err: %v", encryptedPrivateKey, err)
 	}
 
-	return decryptFlip(encryptedPublicPart, encryptedPrivatePart, publicKey, decryptedPrivateKey)
+	publicPart, privatePart, err := decryptFlip(encryptedPublicPart, encryptedPrivatePart, publicKey, decryptedPrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &decryptedFlip{
+		publicPart:  publicPart,
+		privatePart: privatePart,
+	}, nil
 }
 
 func (vc *ValidationCeremony) IsFlipReadyToSolve(key []byte) bool {
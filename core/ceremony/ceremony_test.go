@@ -11,6 +11,8 @@ import (
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
@@ -762,3 +764,29 @@ func Test_calculateNewTotalScore(t *testing.T) {
 	require.Equal(t, float32(48.5)/57, a)
 	require.Equal(t, uint32(57), b)
 }
+
+func Test_restoreProgress(t *testing.T) {
+	require := require.New(t)
+
+	epochDb := database.NewEpochDb(dbm.NewMemDB(), 1)
+	vc := &ValidationCeremony{
+		epochDb: epochDb,
+		log:     log.New(),
+	}
+	vc.restoreProgress()
+	require.False(vc.publicKeySent || vc.privateKeysSent || vc.shortAnswersSent || vc.evidenceSent)
+
+	vc.privateKeysSent = true
+	vc.shortAnswersSent = true
+	vc.persistProgress()
+
+	restored := &ValidationCeremony{
+		epochDb: epochDb,
+		log:     log.New(),
+	}
+	restored.restoreProgress()
+	require.False(restored.publicKeySent)
+	require.True(restored.privateKeysSent)
+	require.True(restored.shortAnswersSent)
+	require.False(restored.evidenceSent)
+}
@@ -0,0 +1,130 @@
+package ceremony
+
+import (
+	"context"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"sync"
+	"time"
+)
+
+const (
+	// flipDecryptionWorkers limits the number of flips decrypted at the same time
+	flipDecryptionWorkers       = 4
+	flipDecryptionRetryInterval = time.Second * 2
+)
+
+type decryptedFlip struct {
+	publicPart  []byte
+	privatePart []byte
+}
+
+// flipsDecryption decrypts flips to solve in parallel as soon as they are loaded and their keys are received,
+// short session flips are decrypted first, only flips to solve of the node identity are kept
+type flipsDecryption struct {
+	flips         map[common.Hash]*decryptedFlip
+	cancel        context.CancelFunc
+	workers       int
+	retryInterval time.Duration
+	mutex         sync.RWMutex
+}
+
+func newFlipsDecryption() *flipsDecryption {
+	return &flipsDecryption{
+		flips:         make(map[common.Hash]*decryptedFlip),
+		workers:       flipDecryptionWorkers,
+		retryInterval: flipDecryptionRetryInterval,
+	}
+}
+
+func (d *flipsDecryption) get(key []byte) *decryptedFlip {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	return d.flips[common.Hash(crypto.Hash(key))]
+}
+
+func (d *flipsDecryption) set(ctx context.Context, key []byte, flip *decryptedFlip) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	// the decryption is stopped under the same lock, so flips of the previous ceremony are not kept
+	if ctx.Err() != nil {
+		return
+	}
+	d.flips[common.Hash(crypto.Hash(key))] = flip
+}
+
+func (d *flipsDecryption) all() []*decryptedFlip {
+	d.mutex.RLock()
+	defer d.mutex.RUnlock()
+	result := make([]*decryptedFlip, 0, len(d.flips))
+	for _, flip := range d.flips {
+		result = append(result, flip)
+	}
+	return result
+}
+
+// start stops the previous decryption and decrypts the given flips in the background in their order
+func (d *flipsDecryption) start(keys [][]byte, decrypt func(key []byte) (*decryptedFlip, error)) {
+	d.stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	d.mutex.Lock()
+	d.cancel = cancel
+	d.mutex.Unlock()
+	go d.run(ctx, keys, decrypt)
+}
+
+// stop cancels the decryption and drops decrypted flips
+func (d *flipsDecryption) stop() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.cancel != nil {
+		d.cancel()
+		d.cancel = nil
+	}
+	d.flips = make(map[common.Hash]*decryptedFlip)
+}
+
+// run decrypts flips until all of them are decrypted or the context is cancelled,
+// flips which are not loaded yet or miss keys are retried after the interval
+func (d *flipsDecryption) run(ctx context.Context, keys [][]byte, decrypt func(key []byte) (*decryptedFlip, error)) {
+	pending := keys
+	for len(pending) > 0 {
+		semaphore := make(chan struct{}, d.workers)
+		wg := sync.WaitGroup{}
+		for _, key := range pending {
+			select {
+			case <-ctx.Done():
+				wg.Wait()
+				return
+			case semaphore <- struct{}{}:
+			}
+			wg.Add(1)
+			go func(key []byte) {
+				defer func() {
+					<-semaphore
+					wg.Done()
+				}()
+				if flip, err := decrypt(key); err == nil {
+					d.set(ctx, key, flip)
+				}
+			}(key)
+		}
+		wg.Wait()
+
+		var failed [][]byte
+		for _, key := range pending {
+			if d.get(key) == nil {
+				failed = append(failed, key)
+			}
+		}
+		pending = failed
+		if len(pending) == 0 {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(d.retryInterval):
+		}
+	}
+}
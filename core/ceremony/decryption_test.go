@@ -0,0 +1,57 @@
+package ceremony
+
+import (
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func Test_flipsDecryption(t *testing.T) {
+	require := require.New(t)
+
+	keys := [][]byte{{0x1}, {0x2}, {0x3}, {0x4}}
+
+	d := newFlipsDecryption()
+	d.workers = 1
+	d.retryInterval = time.Millisecond
+
+	var order []byte
+	attempts := make(map[byte]int)
+	mutex := sync.Mutex{}
+	done := make(chan struct{})
+	d.start(keys, func(key []byte) (*decryptedFlip, error) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		order = append(order, key[0])
+		attempts[key[0]]++
+		// the key of the third flip is received later
+		if key[0] == 0x3 && attempts[key[0]] < 3 {
+			return nil, errors.New("keys are missing")
+		}
+		if key[0] == 0x3 {
+			close(done)
+		}
+		return &decryptedFlip{publicPart: key}, nil
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		require.Fail("flips are not decrypted")
+	}
+	require.Eventually(func() bool {
+		return d.get([]byte{0x3}) != nil
+	}, time.Second, time.Millisecond)
+
+	mutex.Lock()
+	require.Equal([]byte{0x1, 0x2, 0x3, 0x4, 0x3, 0x3}, order)
+	mutex.Unlock()
+	for _, key := range keys {
+		require.Equal(key, d.get(key).publicPart)
+	}
+
+	d.stop()
+	require.Nil(d.get([]byte{0x1}))
+}
@@ -0,0 +1,188 @@
+package ceremony
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/ecies"
+	"github.com/idena-network/idena-go/crypto/vrf"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+	"sync"
+)
+
+// DelegatedAnswers are ceremony answers prepared by the node of the pool delegator, the pool node submits them
+// with the session key which the delegator authorized by AuthorizeAnswersSubmitterTx
+type DelegatedAnswers struct {
+	Delegator    common.Address
+	Epoch        uint16
+	ShortAnswers []byte
+	LongAnswers  []byte
+	// Salt hides short answers until they are revealed
+	Salt []byte
+	// Proof is the VRF proof of the flip words seed generated by the delegator
+	Proof []byte
+	// FlipKey is the public flip encryption key of the delegator published with long answers
+	FlipKey []byte
+}
+
+type delegatedSubmission struct {
+	answers    *DelegatedAnswers
+	sessionKey *ecdsa.PrivateKey
+	txs        map[types.TxType]*types.Transaction
+}
+
+type delegatedAnswers struct {
+	submissions map[common.Address]*delegatedSubmission
+	mutex       sync.Mutex
+}
+
+func newDelegatedAnswers() *delegatedAnswers {
+	return &delegatedAnswers{
+		submissions: make(map[common.Address]*delegatedSubmission),
+	}
+}
+
+func (d *delegatedAnswers) clear() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	d.submissions = make(map[common.Address]*delegatedSubmission)
+}
+
+// PrepareDelegatedAnswers collects answers of the node identity with the data required to submit them
+// on its behalf, nil answers are not included
+func (vc *ValidationCeremony) PrepareDelegatedAnswers(shortAnswers, longAnswers *types.Answers) (*DelegatedAnswers, error) {
+	if len(vc.flipWordsInfo.proof) == 0 {
+		return nil, errors.New("flip words proof is not generated yet")
+	}
+	result := &DelegatedAnswers{
+		Delegator: vc.secStore.GetAddress(),
+		Epoch:     vc.epoch,
+		Salt:      getShortAnswersSalt(vc.epoch, vc.secStore),
+		Proof:     vc.flipWordsInfo.proof,
+	}
+	if shortAnswers != nil {
+		result.ShortAnswers = shortAnswers.Bytes()
+	}
+	if longAnswers != nil {
+		result.LongAnswers = longAnswers.Bytes()
+		result.FlipKey = crypto.FromECDSA(vc.flipper.GetFlipPublicEncryptionKey().ExportECDSA())
+	}
+	return result, nil
+}
+
+// AddDelegatedAnswers schedules submission of the delegator answers signed by the session key,
+// answers received earlier are kept unless they are replaced by new ones
+func (vc *ValidationCeremony) AddDelegatedAnswers(answers *DelegatedAnswers, sessionKey *ecdsa.PrivateKey) error {
+	if answers.Epoch != vc.appState.State.Epoch() {
+		return errors.New("answers are prepared for another epoch")
+	}
+	if !state.IsCeremonyCandidate(vc.appState.State.GetIdentity(answers.Delegator)) {
+		return errors.New("delegator is not a ceremony candidate")
+	}
+	submitter := vc.appState.State.AnswersSubmitter(answers.Delegator)
+	if submitter == nil || *submitter != crypto.PubkeyToAddress(sessionKey.PublicKey) {
+		return errors.New("session key is not authorized by the delegator")
+	}
+	if len(answers.LongAnswers) > 0 {
+		if _, err := crypto.ToECDSA(answers.FlipKey); err != nil {
+			return errors.Wrap(err, "invalid flip key")
+		}
+	}
+	if _, err := vrf.HashFromProof(answers.Proof); err != nil {
+		return errors.Wrap(err, "invalid flip words proof")
+	}
+
+	d := vc.delegatedAnswers
+	d.mutex.Lock()
+	submission, ok := d.submissions[answers.Delegator]
+	if !ok {
+		submission = &delegatedSubmission{
+			answers:    answers,
+			sessionKey: sessionKey,
+			txs:        make(map[types.TxType]*types.Transaction),
+		}
+		d.submissions[answers.Delegator] = submission
+	} else {
+		submission.sessionKey = sessionKey
+		if len(answers.ShortAnswers) > 0 {
+			submission.answers.ShortAnswers = answers.ShortAnswers
+			submission.answers.Salt = answers.Salt
+		}
+		if len(answers.LongAnswers) > 0 {
+			submission.answers.LongAnswers = answers.LongAnswers
+			submission.answers.FlipKey = answers.FlipKey
+		}
+		submission.answers.Proof = answers.Proof
+	}
+	d.mutex.Unlock()
+
+	vc.log.Info("Delegated answers received", "delegator", answers.Delegator.Hex())
+	period := vc.appState.State.ValidationPeriod()
+	if period == state.ShortSessionPeriod || period == state.LongSessionPeriod {
+		vc.submitDelegatedAnswers(false)
+	}
+	return nil
+}
+
+// submitDelegatedAnswers sends txs with delegated answers which are expected in the current period
+// and are not mined yet, short answers are revealed only if revealShortAnswers is set
+func (vc *ValidationCeremony) submitDelegatedAnswers(revealShortAnswers bool) {
+	d := vc.delegatedAnswers
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	period := vc.appState.State.ValidationPeriod()
+	for delegator, submission := range d.submissions {
+		answers := submission.answers
+		hasTx := func(txType types.TxType) bool {
+			return vc.appState.State.HasValidationTx(delegator, txType)
+		}
+		if len(answers.ShortAnswers) > 0 && !hasTx(types.SubmitAnswersHashTx) && period == state.ShortSessionPeriod {
+			hash := crypto.Hash(append(answers.ShortAnswers, answers.Salt...))
+			vc.sendDelegatedTx(submission, types.SubmitAnswersHashTx, hash[:])
+		}
+		if period != state.LongSessionPeriod {
+			continue
+		}
+		if len(answers.ShortAnswers) > 0 && revealShortAnswers && hasTx(types.SubmitAnswersHashTx) && !hasTx(types.SubmitShortAnswersTx) {
+			h, _ := vrf.HashFromProof(answers.Proof)
+			vc.sendDelegatedTx(submission, types.SubmitShortAnswersTx, attachments.CreateShortAnswerAttachment(answers.ShortAnswers, getWordsRnd(h)))
+		}
+		if len(answers.LongAnswers) > 0 && !hasTx(types.SubmitLongAnswersTx) {
+			flipKey, _ := crypto.ToECDSA(answers.FlipKey)
+			vc.sendDelegatedTx(submission, types.SubmitLongAnswersTx,
+				attachments.CreateLongAnswerAttachment(answers.LongAnswers, answers.Proof, answers.Salt, ecies.ImportECDSA(flipKey)))
+		}
+	}
+}
+
+// sendDelegatedTx adds the tx sent on behalf of the delegator to the mempool, the same tx is resent
+// while it is not mined to keep the nonce of the session key
+func (vc *ValidationCeremony) sendDelegatedTx(submission *delegatedSubmission, txType types.TxType, payload []byte) {
+	delegator := submission.answers.Delegator
+	tx, ok := submission.txs[txType]
+	if ok && vc.mempool.GetTx(tx.Hash()) != nil {
+		return
+	}
+	if !ok {
+		from := crypto.PubkeyToAddress(submission.sessionKey.PublicKey)
+		var err error
+		tx, err = types.SignTx(blockchain.BuildTx(vc.appState, from, &delegator, txType, decimal.Zero, decimal.Zero, decimal.Zero, 0, 0, payload), submission.sessionKey)
+		if err != nil {
+			vc.log.Error("cannot sign delegated ceremony tx", "delegator", delegator.Hex(), "err", err)
+			return
+		}
+	}
+	if err := vc.mempool.AddInternalTx(tx); err != nil {
+		delete(submission.txs, txType)
+		vc.log.Warn("cannot send delegated ceremony tx", "delegator", delegator.Hex(), "type", txType, "err", err)
+		return
+	}
+	submission.txs[txType] = tx
+	vc.logInfoWithInteraction("Broadcast delegated ceremony tx", "delegator", delegator.Hex(), "type", txType, "hash", tx.Hash().Hex())
+}
@@ -0,0 +1,140 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/math"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	statsTypes "github.com/idena-network/idena-go/stats/types"
+)
+
+// reasons of the new identity state, the first failed check of the validation is reported
+const (
+	PassedReason                  = "passed"
+	ValidationFailedReason        = "validationFailed"
+	NotCandidateReason            = "notCandidate"
+	NotAllRequiredFlipsReason     = "notAllRequiredFlips"
+	NotApprovedReason             = "notApproved"
+	NoShortAnswersReason          = "noShortAnswers"
+	NoLongAnswersReason           = "noLongAnswers"
+	NoQualifiedShortFlipsReason   = "noQualifiedShortFlips"
+	LowShortScoreReason           = "lowShortScore"
+	NoQualifiedLongFlipsReason    = "noQualifiedLongFlips"
+	LowLongScoreReason            = "lowLongScore"
+	LowTotalScoreReason           = "lowTotalScore"
+	NotEnoughQualifiedFlipsReason = "notEnoughQualifiedFlips"
+)
+
+// isValidationPassed returns true if the identity kept or improved its state by the validation
+func isValidationPassed(prevState, newState state.IdentityState) bool {
+	switch prevState {
+	case state.Candidate:
+		return newState == state.Newbie
+	case state.Newbie:
+		return newState == state.Newbie || newState == state.Verified
+	case state.Verified, state.Suspended, state.Zombie:
+		return newState == state.Verified || newState == state.Human
+	case state.Human:
+		return newState == state.Human
+	}
+	return false
+}
+
+// explainNewIdentityState returns the reason of the new state determined by determineNewIdentityState,
+// the state kept due to not qualified flips is explained by them
+func explainNewIdentityState(identity state.Identity, newState state.IdentityState, approved bool, short, long *types.SessionExplanation, totalScore float32, totalQualifiedFlips uint32) string {
+	if isValidationPassed(identity.State, newState) && !short.NoQualifiedFlips && !long.NoQualifiedFlips {
+		return PassedReason
+	}
+	minTotalScore := float32(common.MinTotalScore)
+	if identity.State == state.Human {
+		minTotalScore = common.MinHumanTotalScore
+	}
+	switch {
+	case !identity.HasDoneAllRequiredFlips():
+		return NotAllRequiredFlipsReason
+	case identity.State == state.Undefined || identity.State == state.Invite || identity.State == state.Killed:
+		return NotCandidateReason
+	case !approved:
+		return NotApprovedReason
+	case short.NoAnswers:
+		return NoShortAnswersReason
+	case long.NoAnswers:
+		return NoLongAnswersReason
+	case short.NoQualifiedFlips:
+		return NoQualifiedShortFlipsReason
+	case short.Score < common.MinShortScore:
+		return LowShortScoreReason
+	case long.NoQualifiedFlips:
+		return NoQualifiedLongFlipsReason
+	case long.Score < common.MinLongScore:
+		return LowLongScoreReason
+	case identity.State != state.Candidate && totalScore < minTotalScore &&
+		(identity.State != state.Newbie || totalQualifiedFlips >= common.MinFlipsForVerified):
+		return LowTotalScoreReason
+	case identity.State == state.Verified && totalQualifiedFlips < common.MinFlipsForVerified:
+		return NotEnoughQualifiedFlipsReason
+	default:
+		return ValidationFailedReason
+	}
+}
+
+func newSessionExplanation(flipsToSolve int, answers map[int]statsTypes.FlipAnswerStats, qualifiedFlips uint32, point float32, score float32, noAnswers, noQual bool) types.SessionExplanation {
+	result := types.SessionExplanation{
+		FlipsToSolve:     uint32(flipsToSolve),
+		QualifiedFlips:   qualifiedFlips,
+		Point:            point,
+		Score:            score,
+		NoAnswers:        noAnswers,
+		NoQualifiedFlips: noQual,
+	}
+	if noAnswers {
+		result.MissedAnswers = result.FlipsToSolve
+		return result
+	}
+	for _, answer := range answers {
+		if answer.Answer == types.None {
+			result.MissedAnswers++
+		}
+	}
+	return result
+}
+
+func shortFlipsToSolveCount(flipsToSolve []int) int {
+	return math.MinInt(int(common.ShortSessionFlipsCount()), len(flipsToSolve))
+}
+
+// setAuthorExplanation adds results of flips made by the identity
+func setAuthorExplanation(explanation *types.ValidationExplanation, addr common.Address, madeFlips []int,
+	qualifications []FlipQualification, validationResults *types.ValidationResults) {
+	explanation.MadeFlips = uint32(len(madeFlips))
+	for _, flipIdx := range madeFlips {
+		if qualifications[flipIdx].grade == types.GradeReported {
+			explanation.ReportedFlips++
+		}
+	}
+	if reason, ok := validationResults.BadAuthors[addr]; ok {
+		explanation.BadAuthor = true
+		explanation.BadAuthorReason = reason
+	}
+}
+
+// persistValidationExplanations saves explanations of the validation applied by the block
+func (vc *ValidationCeremony) persistValidationExplanations(height uint64) {
+	vc.applyEpochMutex.Lock()
+	applyingCache, ok := vc.epochApplyingCache[height]
+	vc.applyEpochMutex.Unlock()
+	if !ok {
+		return
+	}
+	repo := database.NewRepo(vc.db)
+	for addr, explanation := range applyingCache.explanations {
+		repo.WriteValidationExplanation(addr, explanation)
+	}
+}
+
+// ValidationExplanation returns the data used to determine the new state of the identity by the validation of the epoch
+func (vc *ValidationCeremony) ValidationExplanation(epoch uint16, addr common.Address) *types.ValidationExplanation {
+	return database.NewRepo(vc.db).ReadValidationExplanation(epoch, addr)
+}
@@ -0,0 +1,71 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	statsTypes "github.com/idena-network/idena-go/stats/types"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_explainNewIdentityState(t *testing.T) {
+	passedSession := types.SessionExplanation{Score: 1}
+
+	type data struct {
+		identity            state.Identity
+		approved            bool
+		short               types.SessionExplanation
+		long                types.SessionExplanation
+		totalScore          float32
+		totalQualifiedFlips uint32
+		expected            string
+	}
+
+	cases := []data{
+		{state.Identity{State: state.Verified, RequiredFlips: 3}, true, passedSession, passedSession, 1, 30, NotAllRequiredFlipsReason},
+		{state.Identity{State: state.Verified}, false, passedSession, passedSession, 1, 30, NotApprovedReason},
+		{state.Identity{State: state.Verified}, true, types.SessionExplanation{NoAnswers: true}, passedSession, 1, 30, NoShortAnswersReason},
+		{state.Identity{State: state.Verified}, true, passedSession, types.SessionExplanation{NoAnswers: true}, 1, 30, NoLongAnswersReason},
+		{state.Identity{State: state.Verified}, true, types.SessionExplanation{NoQualifiedFlips: true}, passedSession, 1, 30, NoQualifiedShortFlipsReason},
+		{state.Identity{State: state.Verified}, true, types.SessionExplanation{Score: 0.5}, passedSession, 1, 30, LowShortScoreReason},
+		{state.Identity{State: state.Verified}, true, passedSession, types.SessionExplanation{NoQualifiedFlips: true}, 1, 30, NoQualifiedLongFlipsReason},
+		{state.Identity{State: state.Verified}, true, passedSession, types.SessionExplanation{Score: 0.7}, 1, 30, LowLongScoreReason},
+		{state.Identity{State: state.Verified}, true, passedSession, passedSession, 0.7, 30, LowTotalScoreReason},
+		{state.Identity{State: state.Newbie}, true, passedSession, passedSession, 0.7, common.MinFlipsForVerified - 1, PassedReason},
+		{state.Identity{State: state.Newbie}, true, passedSession, passedSession, 0.7, common.MinFlipsForVerified, LowTotalScoreReason},
+		{state.Identity{State: state.Candidate}, true, passedSession, passedSession, 0, 6, PassedReason},
+		{state.Identity{State: state.Candidate}, true, types.SessionExplanation{NoQualifiedFlips: true}, passedSession, 0, 0, NoQualifiedShortFlipsReason},
+		{state.Identity{State: state.Invite}, true, passedSession, passedSession, 1, 6, NotCandidateReason},
+		{state.Identity{State: state.Verified}, true, passedSession, passedSession, 1, common.MinFlipsForVerified - 1, NotEnoughQualifiedFlipsReason},
+		{state.Identity{State: state.Verified}, true, passedSession, passedSession, 0.8, common.MinFlipsForVerified, PassedReason},
+		{state.Identity{State: state.Human}, true, passedSession, passedSession, 0.9, 30, LowTotalScoreReason},
+		{state.Identity{State: state.Human}, true, passedSession, passedSession, 0.7, 30, LowTotalScoreReason},
+		{state.Identity{State: state.Human}, true, passedSession, passedSession, 1, 30, PassedReason},
+		{state.Identity{State: state.Suspended}, true, types.SessionExplanation{NoQualifiedFlips: true}, passedSession, 1, 30, NoQualifiedShortFlipsReason},
+		{state.Identity{State: state.Suspended}, true, passedSession, passedSession, 0.8, 30, PassedReason},
+	}
+
+	for i, c := range cases {
+		missed := !c.approved || c.short.NoAnswers || c.long.NoAnswers
+		newState := determineNewIdentityState(c.identity, c.short.Score, c.long.Score, c.totalScore, c.totalQualifiedFlips,
+			missed, c.short.NoQualifiedFlips, c.long.NoQualifiedFlips)
+		require.Equal(t, c.expected, explainNewIdentityState(c.identity, newState, c.approved, &c.short, &c.long, c.totalScore, c.totalQualifiedFlips), "index = %v", i)
+	}
+}
+
+func Test_newSessionExplanation(t *testing.T) {
+	answers := map[int]statsTypes.FlipAnswerStats{
+		0: {Answer: types.Left},
+		1: {Answer: types.None},
+		2: {Answer: types.Right},
+		3: {Answer: types.None},
+	}
+	explanation := newSessionExplanation(4, answers, 3, 1.5, 0.5, false, false)
+	require.Equal(t, uint32(2), explanation.MissedAnswers)
+	require.Equal(t, uint32(3), explanation.QualifiedFlips)
+
+	explanation = newSessionExplanation(4, nil, 0, 0, 0, true, false)
+	require.Equal(t, uint32(4), explanation.MissedAnswers)
+	require.True(t, explanation.NoAnswers)
+}
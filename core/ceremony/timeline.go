@@ -0,0 +1,122 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/database"
+	"github.com/rcrowley/go-metrics"
+	"sync"
+	"time"
+)
+
+const (
+	FlipLotteryStartedPhase    = "flipLotteryStarted"
+	FlipLotteryCalculatedPhase = "flipLotteryCalculated"
+	ShortSessionOpenedPhase    = "shortSessionOpened"
+	ShortSessionClosedPhase    = "shortSessionClosed"
+	EvidenceSentPhase          = "evidenceSent"
+	LongSessionClosedPhase     = "longSessionClosed"
+	ValidationFinishedPhase    = "validationFinished"
+)
+
+var ceremonyPhases = []string{
+	FlipLotteryStartedPhase,
+	FlipLotteryCalculatedPhase,
+	ShortSessionOpenedPhase,
+	ShortSessionClosedPhase,
+	EvidenceSentPhase,
+	LongSessionClosedPhase,
+	ValidationFinishedPhase,
+}
+
+type phaseMetrics struct {
+	timestamp metrics.Gauge
+	delay     metrics.Gauge
+}
+
+// ceremonyTimeline records the moments the node reaches ceremony phases, only the first occurrence of each phase is kept
+type ceremonyTimeline struct {
+	repo     *database.Repo
+	timeline *types.CeremonyTimeline
+	metrics  map[string]phaseMetrics
+	mutex    sync.Mutex
+}
+
+func newCeremonyTimeline(repo *database.Repo) *ceremonyTimeline {
+	m := make(map[string]phaseMetrics, len(ceremonyPhases))
+	for _, phase := range ceremonyPhases {
+		m[phase] = phaseMetrics{
+			timestamp: metrics.GetOrRegisterGauge("ceremony."+phase+".timestamp", metrics.DefaultRegistry),
+			delay:     metrics.GetOrRegisterGauge("ceremony."+phase+".delay", metrics.DefaultRegistry),
+		}
+	}
+	return &ceremonyTimeline{
+		repo:     repo,
+		timeline: &types.CeremonyTimeline{},
+		metrics:  m,
+	}
+}
+
+// reset starts the timeline of the epoch restoring phases recorded before the node restart
+func (t *ceremonyTimeline) reset(epoch uint16) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.timeline.Epoch == epoch && len(t.timeline.Phases) > 0 {
+		return
+	}
+	t.timeline = t.repo.ReadCeremonyTimeline(epoch)
+	if t.timeline == nil {
+		t.timeline = &types.CeremonyTimeline{Epoch: epoch}
+	}
+}
+
+// record saves the moment of the phase, scheduled is the zero time if the phase has no planned time
+func (t *ceremonyTimeline) record(phase string, height uint64, scheduled time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	for _, item := range t.timeline.Phases {
+		if item.Name == phase {
+			return
+		}
+	}
+	item := &types.CeremonyPhase{
+		Name:      phase,
+		Height:    height,
+		Timestamp: toMilliseconds(time.Now()),
+	}
+	if !scheduled.IsZero() {
+		item.Scheduled = toMilliseconds(scheduled)
+	}
+	t.timeline.Phases = append(t.timeline.Phases, item)
+	t.repo.WriteCeremonyTimeline(t.timeline)
+
+	if m, ok := t.metrics[phase]; ok {
+		m.timestamp.Update(item.Timestamp)
+		if item.Scheduled > 0 {
+			m.delay.Update(item.Timestamp - item.Scheduled)
+		}
+	}
+}
+
+func (t *ceremonyTimeline) get(epoch uint16) *types.CeremonyTimeline {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	if t.timeline.Epoch == epoch {
+		phases := make([]*types.CeremonyPhase, len(t.timeline.Phases))
+		copy(phases, t.timeline.Phases)
+		return &types.CeremonyTimeline{Epoch: epoch, Phases: phases}
+	}
+	return t.repo.ReadCeremonyTimeline(epoch)
+}
+
+func toMilliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+// CeremonyTimeline returns phases of the validation ceremony of the epoch reached by the node
+func (vc *ValidationCeremony) CeremonyTimeline(epoch uint16) *types.CeremonyTimeline {
+	return vc.timeline.get(epoch)
+}
+
+func (vc *ValidationCeremony) recordPhase(phase string, height uint64, scheduled time.Time) {
+	vc.timeline.record(phase, height, scheduled)
+}
@@ -0,0 +1,50 @@
+package ceremony
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/database"
+)
+
+// MaxTrainingFlips limits the number of flips kept to practice short sessions
+const MaxTrainingFlips = 100
+
+// persistTrainingFlips replaces the stored training flips with the decrypted flips of the validation applied by the block
+// which got a consensus answer
+func (vc *ValidationCeremony) persistTrainingFlips(height uint64) {
+	vc.applyEpochMutex.Lock()
+	applyingCache, ok := vc.epochApplyingCache[height]
+	vc.applyEpochMutex.Unlock()
+	if !ok || vc.flipsData == nil {
+		return
+	}
+	repo := database.NewRepo(vc.db)
+	repo.DeleteTrainingFlips()
+	count := 0
+	for i, qualification := range applyingCache.flipQualifications {
+		if count >= MaxTrainingFlips || i >= len(vc.flipsData.allFlips) {
+			break
+		}
+		if !isTrainingFlip(qualification) {
+			continue
+		}
+		cid := vc.flipsData.allFlips[i]
+		publicPart, privatePart, err := vc.GetDecryptedFlip(cid)
+		if err != nil {
+			continue
+		}
+		repo.WriteTrainingFlip(cid, &types.TrainingFlip{
+			Epoch:       vc.epoch,
+			PublicPart:  publicPart,
+			PrivatePart: privatePart,
+			Answer:      qualification.answer,
+			Grade:       qualification.grade,
+		})
+		count++
+	}
+	vc.log.Info("Training flips saved", "count", count)
+}
+
+func isTrainingFlip(qualification FlipQualification) bool {
+	return (qualification.status == Qualified || qualification.status == WeaklyQualified) &&
+		qualification.answer != types.None && qualification.grade != types.GradeReported
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/crypto"
@@ -49,6 +50,10 @@ type Flipper struct {
 	flipsQueue       chan *types.Flip
 	flipPublicKey    *ecies.PrivateKey
 	flipPrivateKey   *ecies.PrivateKey
+	loadingStatuses  map[common.Hash]*FlipLoadingStatus
+	imageHashesMutex sync.Mutex
+	validationConfig *config.ValidationConfig
+	ipfsConfig       *config.IpfsConfig
 }
 
 type IpfsFlip struct {
@@ -76,7 +81,7 @@ func (f *IpfsFlip) FromBytes(data []byte) error {
 	return nil
 }
 
-func NewFlipper(db dbm.DB, ipfsProxy ipfs.Proxy, keyspool *mempool.KeysPool, txpool *mempool.TxPool, secStore *secstore.SecStore, appState *appstate.AppState, bus eventbus.Bus) *Flipper {
+func NewFlipper(db dbm.DB, ipfsProxy ipfs.Proxy, keyspool *mempool.KeysPool, txpool *mempool.TxPool, secStore *secstore.SecStore, appState *appstate.AppState, bus eventbus.Bus, validationConfig *config.ValidationConfig, ipfsConfig *config.IpfsConfig) *Flipper {
 	ctx, cancel := context.WithCancel(context.Background())
 	fp := &Flipper{
 		db:               db,
@@ -87,11 +92,14 @@ func NewFlipper(db dbm.DB, ipfsProxy ipfs.Proxy, keyspool *mempool.KeysPool, txp
 		secStore:         secStore,
 		flips:            make(map[common.Hash]*IpfsFlip),
 		flipReadiness:    make(map[common.Hash]bool),
+		loadingStatuses:  make(map[common.Hash]*FlipLoadingStatus),
 		appState:         appState,
 		loadingCtx:       ctx,
 		cancelLoadingCtx: cancel,
 		bus:              bus,
 		flipsQueue:       make(chan *types.Flip, 1000),
+		validationConfig: validationConfig,
+		ipfsConfig:       ipfsConfig,
 	}
 	go fp.writeLoop()
 	return fp
@@ -161,7 +169,8 @@ func (fp *Flipper) addNewFlip(flip *types.Flip, local bool) error {
 		return err
 	}
 
-	_, err = fp.ipfsProxy.Add(data, fp.ipfsProxy.ShouldPin(ipfs.Flip) || local)
+	pin := fp.ipfsProxy.ShouldPin(ipfs.Flip) || local
+	_, err = fp.ipfsProxy.Add(data, pin)
 
 	if err != nil {
 		return err
@@ -169,7 +178,11 @@ func (fp *Flipper) addNewFlip(flip *types.Flip, local bool) error {
 
 	fp.bus.Publish(&events.NewFlipEvent{Flip: flip})
 
-	fp.epochDb.WriteFlipCid(c.Bytes())
+	pinnedSize := 0
+	if pin {
+		pinnedSize = len(data)
+	}
+	fp.epochDb.WriteFlipCid(c.Bytes(), pinnedSize, local)
 
 	if local {
 		log.Info("Sending new flip tx", "hash", flip.Tx.Hash().Hex(), "nonce", flip.Tx.AccountNonce, "epoch", flip.Tx.Epoch)
@@ -197,6 +210,11 @@ func (fp *Flipper) AddNewFlip(flip *types.Flip, local bool) error {
 }
 
 func (fp *Flipper) PrepareFlip(flipPublicPart []byte, flipPrivatePart []byte) (cid.Cid, []byte, []byte, error) {
+	if fp.validationConfig != nil && fp.validationConfig.PrescreenFlips {
+		if report := fp.PrescreenFlip(flipPublicPart, flipPrivatePart); !report.Passed() {
+			return cid.Cid{}, nil, nil, report
+		}
+	}
 
 	publicEncryptionKey, privateEncryptionKey := fp.GetFlipPublicEncryptionKey(), fp.GetFlipPrivateEncryptionKey()
 
@@ -285,19 +303,42 @@ func (fp *Flipper) generateFlipEncryptionKey(public bool) *ecies.PrivateKey {
 	return ecies.ImportECDSA(flipKey)
 }
 
-func (fp *Flipper) LoadInMemory(cids [][]byte) {
+// LoadInMemory downloads and decodes flips by several workers, short session flips are loaded first
+func (fp *Flipper) LoadInMemory(shortCids [][]byte, longCids [][]byte) {
 	ctx := fp.loadingCtx
+	queue := newFlipsQueue(append(append([][]byte{}, shortCids...), longCids...))
+	fp.mutex.Lock()
+	for _, key := range queue.cids {
+		fp.loadingStatuses[common.Hash(crypto.Hash(key))] = &FlipLoadingStatus{}
+	}
+	fp.mutex.Unlock()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < flipLoadingWorkers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			fp.loadFlips(ctx, queue)
+		}()
+	}
+	wg.Wait()
+	if ctx.Err() == nil {
+		fp.log.Info("All flips were loaded")
+	}
+}
 
-	for len(cids) > 0 {
-
+func (fp *Flipper) loadFlips(ctx context.Context, queue *flipsQueue) {
+	for {
 		select {
 		case <-ctx.Done():
 			return
 		default:
 		}
 
-		key := cids[0]
-		cids = cids[1:]
+		key, ok := queue.pop()
+		if !ok {
+			return
+		}
 
 		cid, _ := cid.Cast(key)
 
@@ -305,20 +346,54 @@ func (fp *Flipper) LoadInMemory(cids [][]byte) {
 
 		if err != nil {
 			fp.log.Warn("Can't get flip by cid", "cid", cid.String(), "err", err)
-			cids = append(cids, key)
+			// the flip may be missing at connected peers, so its providers are searched before the next attempt
+			providers, providersErr := fp.ipfsProxy.ConnectProviders(key, flipProvidersCount)
+			if providersErr != nil {
+				fp.log.Warn("Can't connect flip providers", "cid", cid.String(), "err", providersErr)
+			}
+			fp.updateLoadingStatus(key, err, providers)
+			queue.push(key)
 			continue
 		}
 
 		ipfsFlip := new(IpfsFlip)
 		if err := ipfsFlip.FromBytes(data); err != nil {
 			fp.log.Warn("Can't decode flip", "cid", cid.String(), "err", err)
+			fp.updateLoadingStatus(key, err, 0)
 			continue
 		}
+		fp.updateLoadingStatus(key, nil, 0)
 		fp.mutex.Lock()
 		fp.flips[common.Hash(crypto.Hash(key))] = ipfsFlip
 		fp.mutex.Unlock()
 	}
-	fp.log.Info("All flips were loaded")
+}
+
+func (fp *Flipper) updateLoadingStatus(key []byte, err error, providers int) {
+	fp.mutex.Lock()
+	defer fp.mutex.Unlock()
+	status, ok := fp.loadingStatuses[common.Hash(crypto.Hash(key))]
+	if !ok {
+		return
+	}
+	status.Attempts++
+	status.LastError = ""
+	if err != nil {
+		status.LastError = err.Error()
+		status.Providers = providers
+	}
+}
+
+// GetLoadingStatus returns the progress of loading of the flip to solve, nil is returned if the flip is not being loaded
+func (fp *Flipper) GetLoadingStatus(key []byte) *FlipLoadingStatus {
+	fp.mutex.RLock()
+	defer fp.mutex.RUnlock()
+	status, ok := fp.loadingStatuses[common.Hash(crypto.Hash(key))]
+	if !ok {
+		return nil
+	}
+	result := *status
+	return &result
 }
 
 func (fp *Flipper) Clear() {
@@ -328,6 +403,7 @@ func (fp *Flipper) Clear() {
 	fp.cancelLoadingCtx()
 	fp.flips = make(map[common.Hash]*IpfsFlip)
 	fp.flipReadiness = make(map[common.Hash]bool)
+	fp.loadingStatuses = make(map[common.Hash]*FlipLoadingStatus)
 	fp.Initialize()
 	fp.flipPrivateKey = nil
 	fp.flipPublicKey = nil
@@ -0,0 +1,83 @@
+package flip
+
+import (
+	"github.com/idena-network/idena-go/database"
+)
+
+// EpochFlipsUsage describes flips of the epoch pinned in the local ipfs store
+type EpochFlipsUsage struct {
+	Epoch    uint16
+	Flips    int
+	OwnFlips int
+	Size     uint64
+}
+
+type retainedFlip struct {
+	epoch uint16
+	cid   []byte
+}
+
+// CollectFlips applies the retention policy to flips of the finished epoch and unpins flips of past epochs
+// which are not retained anymore
+func (fp *Flipper) CollectFlips(db *database.EpochDb, epoch uint16, currentEpoch uint16) {
+	repo := database.NewRepo(fp.db)
+	db.IterateOverFlips(func(cid []byte, size uint32, own bool) {
+		// size is zero for flips which are not pinned locally
+		if size > 0 && fp.shouldRetainFlip(epoch, currentEpoch, own) {
+			repo.WriteRetainedFlip(epoch, cid, size, own)
+			return
+		}
+		fp.UnpinFlip(cid)
+	})
+
+	var expired []retainedFlip
+	repo.IterateOverRetainedFlips(func(flipEpoch uint16, cid []byte, size uint32, own bool) {
+		if !fp.shouldRetainFlip(flipEpoch, currentEpoch, own) {
+			expired = append(expired, retainedFlip{flipEpoch, cid})
+		}
+	})
+	for _, flip := range expired {
+		fp.UnpinFlip(flip.cid)
+		repo.DeleteRetainedFlip(flip.epoch, flip.cid)
+	}
+	if len(expired) > 0 {
+		fp.log.Info("Unpinned flips of past epochs", "cnt", len(expired))
+	}
+}
+
+func (fp *Flipper) shouldRetainFlip(epoch uint16, currentEpoch uint16, own bool) bool {
+	if fp.ipfsConfig.DisableFlipsGc || own && fp.ipfsConfig.KeepOwnFlips {
+		return true
+	}
+	return epoch <= currentEpoch && currentEpoch-epoch <= fp.ipfsConfig.FlipsRetentionEpochs
+}
+
+// FlipsStorageUsage returns pinned flips of the current epoch and retained flips of past epochs ordered by epoch
+func (fp *Flipper) FlipsStorageUsage() []EpochFlipsUsage {
+	var result []EpochFlipsUsage
+	add := func(epoch uint16, size uint32, own bool) {
+		if len(result) == 0 || result[len(result)-1].Epoch != epoch {
+			result = append(result, EpochFlipsUsage{Epoch: epoch})
+		}
+		usage := &result[len(result)-1]
+		usage.Flips++
+		usage.Size += uint64(size)
+		if own {
+			usage.OwnFlips++
+		}
+	}
+	database.NewRepo(fp.db).IterateOverRetainedFlips(func(epoch uint16, cid []byte, size uint32, own bool) {
+		add(epoch, size, own)
+	})
+
+	fp.mutex.RLock()
+	epochDb := fp.epochDb
+	fp.mutex.RUnlock()
+	epoch := fp.appState.State.Epoch()
+	epochDb.IterateOverFlips(func(cid []byte, size uint32, own bool) {
+		if size > 0 {
+			add(epoch, size, own)
+		}
+	})
+	return result
+}
@@ -0,0 +1,250 @@
+package flip
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/rlp"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"math/bits"
+	"strings"
+)
+
+const (
+	flipImagesCount = 4
+	// encrypted flip parts are wrapped into the ipfs flip together with the author public key
+	flipEncryptionOverhead = 1024
+	maxFlipImageSize       = 200 * 1024
+	minFlipImageDimension  = 64
+	maxFlipImageDimension  = 2048
+	// images which perceptual hashes differ in no more bits are considered duplicates
+	duplicateImageDistance = 6
+	// images with lower contrast of the downscaled grayscale copy are considered blank
+	minImageContrast = 8
+	// image hashes of flips seen in this number of previous epochs are checked for duplicates
+	imageHashesEpochs = 3
+)
+
+const (
+	PrescreenFormatIssue    = "format"
+	PrescreenSizeIssue      = "size"
+	PrescreenImageIssue     = "image"
+	PrescreenBlankIssue     = "blank"
+	PrescreenDuplicateIssue = "duplicate"
+)
+
+// PrescreenIssue is the problem of the flip which is likely to make validators report it,
+// image is the index of the problem image or -1 if the issue concerns the whole flip
+type PrescreenIssue struct {
+	Kind    string
+	Image   int
+	Message string
+}
+
+// PrescreenReport is the result of the local flip pre-screening
+type PrescreenReport struct {
+	Issues      []*PrescreenIssue
+	ImageHashes []uint64
+}
+
+func (r *PrescreenReport) Passed() bool {
+	return len(r.Issues) == 0
+}
+
+func (r *PrescreenReport) Error() string {
+	var messages []string
+	for _, issue := range r.Issues {
+		if issue.Image >= 0 {
+			messages = append(messages, fmt.Sprintf("image %v: %v", issue.Image, issue.Message))
+		} else {
+			messages = append(messages, issue.Message)
+		}
+	}
+	return fmt.Sprintf("flip failed pre-screening: %v", strings.Join(messages, "; "))
+}
+
+func (r *PrescreenReport) addIssue(kind string, image int, format string, args ...interface{}) {
+	r.Issues = append(r.Issues, &PrescreenIssue{
+		Kind:    kind,
+		Image:   image,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+type flipImages struct {
+	Images [][]byte
+	Rest   []rlp.RawValue `rlp:"tail"`
+}
+
+// decodeFlipImages extracts images from the raw flip parts, the public part contains first images
+// and the private part contains the rest of them followed by orders, the legacy flip has all images in the public part
+func decodeFlipImages(publicPart []byte, privatePart []byte) ([][]byte, error) {
+	public := new(flipImages)
+	if err := rlp.DecodeBytes(publicPart, public); err != nil {
+		return nil, fmt.Errorf("public part cannot be decoded: %v", err)
+	}
+	images := public.Images
+	if len(privatePart) > 0 {
+		private := new(flipImages)
+		if err := rlp.DecodeBytes(privatePart, private); err != nil {
+			return nil, fmt.Errorf("private part cannot be decoded: %v", err)
+		}
+		images = append(images, private.Images...)
+	}
+	return images, nil
+}
+
+// PrescreenFlip checks the raw flip with local heuristics before it is submitted: images should be decodable,
+// fit size limits, be not blank and not duplicate each other or images of flips seen in recent epochs
+func (fp *Flipper) PrescreenFlip(publicPart []byte, privatePart []byte) *PrescreenReport {
+	report := &PrescreenReport{}
+	if size := len(publicPart) + len(privatePart); size > common.MaxFlipSize-flipEncryptionOverhead {
+		report.addIssue(PrescreenSizeIssue, -1, "flip is too big, max expected size %v, actual %v", common.MaxFlipSize-flipEncryptionOverhead, size)
+	}
+	images, err := decodeFlipImages(publicPart, privatePart)
+	if err != nil {
+		report.addIssue(PrescreenFormatIssue, -1, "%v", err)
+		return report
+	}
+	if len(images) != flipImagesCount {
+		report.addIssue(PrescreenFormatIssue, -1, "flip should have %v images, actual %v", flipImagesCount, len(images))
+	}
+
+	hashedImages := make(map[uint64]int)
+	for idx, data := range images {
+		if len(data) > maxFlipImageSize {
+			report.addIssue(PrescreenSizeIssue, idx, "image is too big, max expected size %v, actual %v", maxFlipImageSize, len(data))
+		}
+		img, err := decodeFlipImage(data)
+		if err != nil {
+			report.addIssue(PrescreenImageIssue, idx, "%v", err)
+			continue
+		}
+		hash, contrast := perceptualHash(img)
+		if contrast < minImageContrast {
+			report.addIssue(PrescreenBlankIssue, idx, "image is blank")
+			continue
+		}
+		for prevHash, prevIdx := range hashedImages {
+			if bits.OnesCount64(hash^prevHash) <= duplicateImageDistance {
+				report.addIssue(PrescreenDuplicateIssue, idx, "image duplicates image %v", prevIdx)
+			}
+		}
+		hashedImages[hash] = idx
+		report.ImageHashes = append(report.ImageHashes, hash)
+	}
+
+	if len(report.ImageHashes) == 0 {
+		return report
+	}
+	repo := database.NewRepo(fp.db)
+	epoch := fp.appState.State.Epoch()
+	reported := make(map[int]bool)
+	for e := int(epoch); e >= 0 && e >= int(epoch)-imageHashesEpochs; e-- {
+		for _, prevHash := range repo.ReadFlipImageHashes(uint16(e)) {
+			for hash, idx := range hashedImages {
+				if !reported[idx] && bits.OnesCount64(hash^prevHash) <= duplicateImageDistance {
+					report.addIssue(PrescreenDuplicateIssue, idx, "image duplicates an image of a flip of epoch %v", e)
+					reported[idx] = true
+				}
+			}
+		}
+	}
+	return report
+}
+
+// RememberFlipImages saves perceptual hashes of flip images of the current epoch to detect their reuse
+// in future flips, hashes of epochs which are not checked anymore are dropped
+func (fp *Flipper) RememberFlipImages(publicPart []byte, privatePart []byte) {
+	images, err := decodeFlipImages(publicPart, privatePart)
+	if err != nil {
+		return
+	}
+	var hashes []uint64
+	for _, data := range images {
+		img, err := decodeFlipImage(data)
+		if err != nil {
+			continue
+		}
+		if hash, contrast := perceptualHash(img); contrast >= minImageContrast {
+			hashes = append(hashes, hash)
+		}
+	}
+	if len(hashes) == 0 {
+		return
+	}
+	fp.imageHashesMutex.Lock()
+	defer fp.imageHashesMutex.Unlock()
+	repo := database.NewRepo(fp.db)
+	epoch := fp.appState.State.Epoch()
+	repo.WriteFlipImageHashes(epoch, append(repo.ReadFlipImageHashes(epoch), hashes...))
+	if epoch > imageHashesEpochs {
+		repo.DeleteFlipImageHashes(epoch - imageHashesEpochs - 1)
+	}
+}
+
+// decodeFlipImage decodes the image only if its header declares dimensions within the flip image bounds,
+// so a small compressed image can't make the node allocate a huge bitmap
+func decodeFlipImage(data []byte) (image.Image, error) {
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("image cannot be decoded: %v", err)
+	}
+	if cfg.Width < minFlipImageDimension || cfg.Height < minFlipImageDimension || cfg.Width > maxFlipImageDimension || cfg.Height > maxFlipImageDimension {
+		return nil, fmt.Errorf("image size %vx%v is out of bounds [%v, %v]", cfg.Width, cfg.Height, minFlipImageDimension, maxFlipImageDimension)
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("image cannot be decoded: %v", err)
+	}
+	return img, nil
+}
+
+// perceptualHash calculates the difference hash of the image downscaled to 9x8 grayscale pixels,
+// each bit tells whether the pixel is brighter than its right neighbour, the contrast is the luminance range of the pixels
+func perceptualHash(img image.Image) (hash uint64, contrast uint8) {
+	const width, height = 9, 8
+	bounds := img.Bounds()
+	var pixels [height][width]uint8
+	minLum, maxLum := uint8(255), uint8(0)
+	for y := 0; y < height; y++ {
+		y0 := bounds.Min.Y + y*bounds.Dy()/height
+		y1 := bounds.Min.Y + (y+1)*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			x0 := bounds.Min.X + x*bounds.Dx()/width
+			x1 := bounds.Min.X + (x+1)*bounds.Dx()/width
+			var sum, count uint64
+			for py := y0; py < y1; py++ {
+				for px := x0; px < x1; px++ {
+					r, g, b, _ := img.At(px, py).RGBA()
+					sum += ((299*uint64(r) + 587*uint64(g) + 114*uint64(b)) / 1000) >> 8
+					count++
+				}
+			}
+			var lum uint8
+			if count > 0 {
+				lum = uint8(sum / count)
+			}
+			pixels[y][x] = lum
+			if lum < minLum {
+				minLum = lum
+			}
+			if lum > maxLum {
+				maxLum = lum
+			}
+		}
+	}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width-1; x++ {
+			hash <<= 1
+			if pixels[y][x] > pixels[y][x+1] {
+				hash |= 1
+			}
+		}
+	}
+	return hash, maxLum - minLum
+}
@@ -0,0 +1,85 @@
+package flip
+
+import (
+	"bytes"
+	"github.com/idena-network/idena-go/rlp"
+	"github.com/stretchr/testify/require"
+	"image"
+	"image/color"
+	"image/png"
+	"math/bits"
+	"testing"
+)
+
+func encodeTestImage(t *testing.T, width, height int, pixel func(x, y int) uint8) []byte {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.SetGray(x, y, color.Gray{Y: pixel(x, y)})
+		}
+	}
+	buf := new(bytes.Buffer)
+	require.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func Test_decodeFlipImages(t *testing.T) {
+	require := require.New(t)
+	images := [][]byte{{0x1}, {0x2}, {0x3}, {0x4}}
+	orders := [][]uint{{0, 1, 2, 3}, {3, 2, 1, 0}}
+
+	publicPart, _ := rlp.EncodeToBytes([]interface{}{images[:2]})
+	privatePart, _ := rlp.EncodeToBytes([]interface{}{images[2:], orders})
+	decoded, err := decodeFlipImages(publicPart, privatePart)
+	require.NoError(err)
+	require.Equal(images, decoded)
+
+	legacy, _ := rlp.EncodeToBytes([]interface{}{images, orders})
+	decoded, err = decodeFlipImages(legacy, nil)
+	require.NoError(err)
+	require.Equal(images, decoded)
+
+	_, err = decodeFlipImages([]byte{0x1, 0x2}, nil)
+	require.Error(err)
+}
+
+func Test_decodeFlipImage(t *testing.T) {
+	require := require.New(t)
+	pixel := func(x, y int) uint8 { return uint8(x + y) }
+
+	img, err := decodeFlipImage(encodeTestImage(t, 440, 330, pixel))
+	require.NoError(err)
+	require.Equal(440, img.Bounds().Dx())
+
+	_, err = decodeFlipImage(encodeTestImage(t, maxFlipImageDimension+1, minFlipImageDimension, pixel))
+	require.EqualError(err, "image size 2049x64 is out of bounds [64, 2048]")
+
+	_, err = decodeFlipImage(encodeTestImage(t, minFlipImageDimension, minFlipImageDimension-1, pixel))
+	require.Error(err)
+
+	_, err = decodeFlipImage([]byte{0x1, 0x2, 0x3})
+	require.Error(err)
+}
+
+func Test_perceptualHash(t *testing.T) {
+	require := require.New(t)
+	decode := func(data []byte) image.Image {
+		img, _, err := image.Decode(bytes.NewReader(data))
+		require.NoError(err)
+		return img
+	}
+	gradient := decode(encodeTestImage(t, 440, 330, func(x, y int) uint8 { return uint8((x*7 + y*3) % 256) }))
+	scaled := decode(encodeTestImage(t, 220, 165, func(x, y int) uint8 { return uint8((x*14 + y*6) % 256) }))
+	other := decode(encodeTestImage(t, 440, 330, func(x, y int) uint8 { return uint8((x*y/13 + y*11) % 256) }))
+	blank := decode(encodeTestImage(t, 440, 330, func(x, y int) uint8 { return 200 }))
+
+	hash, contrast := perceptualHash(gradient)
+	require.True(contrast >= minImageContrast)
+	scaledHash, _ := perceptualHash(scaled)
+	require.True(bits.OnesCount64(hash^scaledHash) <= duplicateImageDistance)
+	otherHash, _ := perceptualHash(other)
+	require.True(bits.OnesCount64(hash^otherHash) > duplicateImageDistance)
+
+	_, contrast = perceptualHash(blank)
+	require.True(contrast < minImageContrast)
+}
@@ -0,0 +1,49 @@
+package flip
+
+import (
+	"sync"
+)
+
+const (
+	// flipLoadingWorkers limits the number of flips downloaded at the same time
+	flipLoadingWorkers = 4
+	// flipProvidersCount is the number of providers searched for the flip which cannot be loaded
+	flipProvidersCount = 5
+)
+
+// FlipLoadingStatus describes attempts to load the flip to solve
+type FlipLoadingStatus struct {
+	Attempts int
+	// Providers is the number of providers connected after the last failed attempt
+	Providers int
+	LastError string
+}
+
+// flipsQueue keeps cids of flips to load in the order of priority, failed flips are pushed back to the end
+type flipsQueue struct {
+	cids  [][]byte
+	mutex sync.Mutex
+}
+
+func newFlipsQueue(cids [][]byte) *flipsQueue {
+	return &flipsQueue{
+		cids: cids,
+	}
+}
+
+func (q *flipsQueue) pop() ([]byte, bool) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if len(q.cids) == 0 {
+		return nil, false
+	}
+	key := q.cids[0]
+	q.cids = q.cids[1:]
+	return key, true
+}
+
+func (q *flipsQueue) push(key []byte) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.cids = append(q.cids, key)
+}
@@ -0,0 +1,171 @@
+package mempool
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/events"
+	"github.com/pkg/errors"
+)
+
+const (
+	MaxTxBatchSize = 1000
+)
+
+var (
+	BatchNonceGapError = errors.New("tx nonce doesn't follow the previous nonce of the sender")
+	BatchRejectedError = errors.New("tx is rejected along with the batch")
+)
+
+type batchSender struct {
+	epoch      uint16
+	nonce      uint32
+	cacheNonce uint32
+}
+
+// AddTxBatch adds txs to the pool atomically, either all txs are added or none of them.
+// Txs of every sender should have consecutive nonces of the current epoch following the last nonce of the sender
+// known to the pool. Returned errors correspond to the txs, all of them are nil if the batch is added,
+// otherwise the failed tx gets its own error and the rest ones get BatchRejectedError.
+func (pool *TxPool) AddTxBatch(txs []*types.Transaction) ([]error, error) {
+	if len(txs) == 0 {
+		return nil, errors.New("batch is empty")
+	}
+	if len(txs) > MaxTxBatchSize {
+		return nil, errors.Errorf("batch size exceeds %v", MaxTxBatchSize)
+	}
+	if pool.IsSyncing() {
+		return nil, errors.New("batch can't be added while syncing")
+	}
+	appState, err := pool.appState.Readonly(pool.head.Height())
+	if err != nil {
+		return nil, errors.WithMessage(err, "batch can't be validated")
+	}
+
+	results := make([]error, len(txs))
+	senders := make(map[common.Address]*batchSender)
+	added := make([]*types.Transaction, 0, len(txs))
+	failed := -1
+
+	pool.mutex.Lock()
+	for i, tx := range txs {
+		if err := pool.addBatchTx(tx, appState, senders); err != nil {
+			results[i] = err
+			failed = i
+			break
+		}
+		added = append(added, tx)
+	}
+	if failed >= 0 {
+		for i := len(added) - 1; i >= 0; i-- {
+			pool.unput(added[i])
+		}
+		for sender, s := range senders {
+			pool.appState.NonceCache.ResetNonce(sender, s.epoch, s.cacheNonce)
+		}
+		pool.mutex.Unlock()
+		for i := range results {
+			if i != failed {
+				results[i] = BatchRejectedError
+			}
+		}
+		pool.log.Warn("Tx batch is rejected", "size", len(txs), "failed", txs[failed].Hash().Hex(), "err", results[failed])
+		return results, nil
+	}
+	pool.mutex.Unlock()
+
+	for _, tx := range txs {
+		if pool.txKeeper != nil {
+			pool.txKeeper.AddTx(tx)
+		}
+		sender, _ := types.Sender(tx)
+		pool.bus.Publish(&events.NewTxEvent{
+			Tx:  tx,
+			Own: sender == pool.coinbase,
+		})
+	}
+	pool.log.Info("Tx batch is added", "size", len(txs))
+	return results, nil
+}
+
+func (pool *TxPool) addBatchTx(tx *types.Transaction, appState *appstate.AppState, senders map[common.Address]*batchSender) error {
+	if _, ok := pool.all.Get(tx.Hash()); ok {
+		return DuplicateTxError
+	}
+	if err := pool.checkDisabledTx(tx); err != nil {
+		return err
+	}
+	sender, _ := types.Sender(tx)
+	if sender == (common.Address{}) {
+		return validation.InvalidSignature
+	}
+	s, ok := senders[sender]
+	if !ok {
+		s = pool.newBatchSender(sender)
+		senders[sender] = s
+	}
+	if tx.Epoch != s.epoch || tx.AccountNonce != s.nonce+1 {
+		return errors.Wrapf(BatchNonceGapError, "expected epoch: %v, expected nonce: %v, tx epoch: %v, tx nonce: %v",
+			s.epoch, s.nonce+1, tx.Epoch, tx.AccountNonce)
+	}
+	if err := pool.checkLimits(tx); err != nil {
+		return err
+	}
+	if err := pool.validate(tx, appState, validation.InboundTx); err != nil {
+		return err
+	}
+	if err := pool.put(tx); err != nil {
+		return err
+	}
+	s.nonce = tx.AccountNonce
+	return nil
+}
+
+// newBatchSender returns the last nonce of the sender in the current epoch taking pooled txs into account
+func (pool *TxPool) newBatchSender(sender common.Address) *batchSender {
+	globalEpoch := pool.appState.State.Epoch()
+	nonce := pool.appState.State.GetNonce(sender)
+	if pool.appState.State.GetEpoch(sender) < globalEpoch {
+		nonce = 0
+	}
+	check := func(tx *types.Transaction) {
+		if tx.Epoch == globalEpoch && tx.AccountNonce > nonce {
+			nonce = tx.AccountNonce
+		}
+	}
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, tx := range executable.txs {
+			check(tx)
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		for _, tx := range pending.txs {
+			check(tx)
+		}
+	}
+	return &batchSender{
+		epoch:      globalEpoch,
+		nonce:      nonce,
+		cacheNonce: pool.appState.NonceCache.GetNonce(sender, globalEpoch),
+	}
+}
+
+// unput reverts put of the last added tx of the sender
+func (pool *TxPool) unput(tx *types.Transaction) {
+	sender, _ := types.Sender(tx)
+	if executable, ok := pool.executableTxs[sender]; ok {
+		executable.Remove(tx)
+		if executable.Empty() {
+			delete(pool.executableTxs, sender)
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		pending.Remove(tx.Hash())
+		if pending.Empty() {
+			delete(pool.pendingTxs, sender)
+		}
+	}
+	pool.all.Remove(tx.Hash())
+	pool.laneCounters[laneOf(tx)].add(-1)
+}
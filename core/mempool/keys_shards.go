@@ -0,0 +1,112 @@
+package mempool
+
+import (
+	"context"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/erasure"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/pkg/errors"
+	"time"
+)
+
+const (
+	// FlipKeysPackageDataShards of FlipKeysPackageDataShards+FlipKeysPackageParityShards shards restore the package
+	FlipKeysPackageDataShards   = 4
+	FlipKeysPackageParityShards = 4
+	maxFlipKeysPackageShards    = 16
+	shardsLoadingRetryDelay     = 5 * time.Second
+)
+
+type loadedShard struct {
+	index int
+	data  []byte
+	err   error
+}
+
+// ShardPrivateKeysPackage publishes the erasure coded data of the own keys package to ipfs and returns
+// the unsigned package which refers to the shards instead of containing the data
+func (p *KeysPool) ShardPrivateKeysPackage(keysPackage *types.PrivateFlipKeysPackage) (*types.PrivateFlipKeysPackage, error) {
+	shards, err := erasure.Encode(keysPackage.Data, FlipKeysPackageDataShards, FlipKeysPackageParityShards)
+	if err != nil {
+		return nil, err
+	}
+	cids := make([][]byte, 0, len(shards))
+	for _, shard := range shards {
+		c, err := p.ipfsProxy.Add(shard, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot publish flip keys package shard")
+		}
+		cids = append(cids, c.Bytes())
+	}
+
+	p.privateKeysMutex.Lock()
+	p.packagesData[p.self] = keysPackage.Data
+	p.ownShards = append(p.ownShards, cids...)
+	p.privateKeysMutex.Unlock()
+
+	return &types.PrivateFlipKeysPackage{
+		Epoch:      keysPackage.Epoch,
+		Shards:     cids,
+		DataShards: FlipKeysPackageDataShards,
+		Size:       uint32(len(keysPackage.Data)),
+	}, nil
+}
+
+// loadPackageShards loads shards of the package from ipfs until enough of them are received to restore the data,
+// unavailable shards are requested again after the delay
+func (p *KeysPool) loadPackageShards(ctx context.Context, sender common.Address, keysPackage *types.PrivateFlipKeysPackage) {
+	dataShards := int(keysPackage.DataShards)
+	shardSize := (int64(keysPackage.Size) + int64(dataShards) - 1) / int64(dataShards)
+	if shardSize == 0 {
+		shardSize = 1
+	}
+	shards := make([][]byte, len(keysPackage.Shards))
+	loaded := 0
+	for loaded < dataShards {
+		results := make(chan loadedShard, len(keysPackage.Shards))
+		pending := 0
+		for i, key := range keysPackage.Shards {
+			if shards[i] != nil {
+				continue
+			}
+			pending++
+			go func(index int, key []byte) {
+				data, err := p.ipfsProxy.GetWithSizeLimit(key, ipfs.FlipKeysShard, shardSize)
+				results <- loadedShard{index, data, err}
+			}(i, key)
+		}
+		for ; pending > 0 && loaded < dataShards; pending-- {
+			select {
+			case result := <-results:
+				if result.err == nil {
+					shards[result.index] = result.data
+					loaded++
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+		if loaded >= dataShards {
+			break
+		}
+		p.log.Debug("Not enough flip keys package shards loaded", "sender", sender.Hex(), "loaded", loaded, "required", dataShards)
+		select {
+		case <-time.After(shardsLoadingRetryDelay):
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	data, err := erasure.Reconstruct(shards, dataShards, len(shards)-dataShards, int(keysPackage.Size))
+	if err != nil {
+		p.log.Warn("Cannot restore flip keys package from shards", "sender", sender.Hex(), "err", err)
+		return
+	}
+	p.privateKeysMutex.Lock()
+	defer p.privateKeysMutex.Unlock()
+	if p.flipKeyPackages[sender] == keysPackage {
+		p.packagesData[sender] = data
+		delete(p.privateKeysArrayCache, sender)
+	}
+}
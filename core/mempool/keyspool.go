@@ -10,14 +10,17 @@ import (
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/hexutil"
 	"github.com/idena-network/idena-go/common/pushpull"
+	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/crypto/ecies"
 	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/log"
 	models "github.com/idena-network/idena-go/protobuf"
 	"github.com/idena-network/idena-go/secstore"
+	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
 	dbm "github.com/tendermint/tm-db"
 	"math/big"
@@ -72,9 +75,14 @@ type KeysPool struct {
 	self                      common.Address
 	pushTracker               pushpull.PendingPushTracker
 	stopSync                  bool
+	ipfsProxy                 ipfs.Proxy
+	consensusCfg              *config.ConsensusConf
+	// packagesData contains data of sharded packages restored from shards
+	packagesData map[common.Address][]byte
+	ownShards    [][]byte
 }
 
-func NewKeysPool(db dbm.DB, appState *appstate.AppState, bus eventbus.Bus, secStore *secstore.SecStore) *KeysPool {
+func NewKeysPool(db dbm.DB, appState *appstate.AppState, bus eventbus.Bus, secStore *secstore.SecStore, ipfsProxy ipfs.Proxy, consensusCfg *config.ConsensusConf) *KeysPool {
 	ctx, cancel := context.WithCancel(context.Background())
 	pool := &KeysPool{
 		db:                        db,
@@ -91,6 +99,9 @@ func NewKeysPool(db dbm.DB, appState *appstate.AppState, bus eventbus.Bus, secSt
 		packagesLoadingCtx:        ctx,
 		cancelLoadingCtx:          cancel,
 		pushTracker:               pushpull.NewDefaultPushTracker(time.Second * 5),
+		ipfsProxy:                 ipfsProxy,
+		consensusCfg:              consensusCfg,
+		packagesData:              make(map[common.Address][]byte),
 	}
 	pool.pushTracker.SetHolder(pool)
 	return pool
@@ -221,7 +232,7 @@ func (p *KeysPool) putPrivateFlipKeysPackage(keysPackage *types.PrivateFlipKeysP
 		return KeyIsAlreadyPublished
 	}
 
-	if err := validateFlipKeysPackage(appState, keysPackage); err != nil {
+	if err := validateFlipKeysPackage(appState, keysPackage, p.consensusCfg); err != nil {
 		p.privateKeysMutex.Unlock()
 		log.Trace("PrivateFLipKeysPackage is not valid", "sender", sender.Hex(), "err", err)
 		return err
@@ -235,6 +246,10 @@ func (p *KeysPool) putPrivateFlipKeysPackage(keysPackage *types.PrivateFlipKeysP
 
 	p.epochDb.WritePrivateFlipKey(keysPackage)
 
+	if _, ok := p.packagesData[sender]; keysPackage.IsSharded() && !ok {
+		go p.loadPackageShards(p.packagesLoadingCtx, sender, keysPackage)
+	}
+
 	p.privateKeysMutex.Unlock()
 
 	p.bus.Publish(&events.NewFlipKeysPackageEvent{
@@ -327,7 +342,15 @@ func (p *KeysPool) GetEncryptedPrivateFlipKey(indexInPackage int, address common
 		return nil
 	}
 
-	decryptedPackage, err := publicFlipKey.Decrypt(keysPackage.Data, nil, nil)
+	data := keysPackage.Data
+	if keysPackage.IsSharded() {
+		if data, ok = p.packagesData[address]; !ok {
+			log.Warn("GetEncryptedPrivateFlipKey: package shards are not loaded", "address", address.Hex())
+			return nil
+		}
+	}
+
+	decryptedPackage, err := publicFlipKey.Decrypt(data, nil, nil)
 	if err != nil {
 		log.Warn("GetEncryptedPrivateFlipKey: cannot decrypt package", "address", address.Hex())
 		return nil
@@ -353,6 +376,11 @@ func (p *KeysPool) Clear() {
 	defer p.publicKeyMutex.Unlock()
 
 	p.cancelLoadingCtx()
+	for _, shard := range p.ownShards {
+		p.ipfsProxy.Unpin(shard)
+	}
+	p.ownShards = nil
+	p.packagesData = make(map[common.Address][]byte)
 	p.flipKeys = make(map[common.Address]*types.PublicFlipKey)
 	p.flipKeyPackagesSyncCounts = map[common.Hash128]int{}
 	p.flipKeyPackages = make(map[common.Address]*types.PrivateFlipKeysPackage)
@@ -402,10 +430,29 @@ func validateFlipKey(appState *appstate.AppState, key *types.PublicFlipKey) erro
 	return validateKey(sender, key.Epoch, appState)
 }
 
-func validateFlipKeysPackage(appState *appstate.AppState, keysPackage *types.PrivateFlipKeysPackage) error {
+func validateFlipKeysPackage(appState *appstate.AppState, keysPackage *types.PrivateFlipKeysPackage, consensusCfg *config.ConsensusConf) error {
 	if len(keysPackage.Data) > maxPrivateKeysPackageDataSize {
 		return errors.Errorf("too big flip keys package, size: %d", len(keysPackage.Data))
 	}
+	if keysPackage.IsSharded() {
+		if !consensusCfg.EnableShardedFlipKeysPackages {
+			return errors.New("sharded flip keys packages are not enabled")
+		}
+		if len(keysPackage.Data) > 0 {
+			return errors.New("sharded flip keys package should not contain data")
+		}
+		if keysPackage.DataShards == 0 || int(keysPackage.DataShards) > len(keysPackage.Shards) || len(keysPackage.Shards) > maxFlipKeysPackageShards {
+			return errors.Errorf("invalid flip keys package shards, data shards: %d, total: %d", keysPackage.DataShards, len(keysPackage.Shards))
+		}
+		if keysPackage.Size > maxPrivateKeysPackageDataSize {
+			return errors.Errorf("too big flip keys package, size: %d", keysPackage.Size)
+		}
+		for _, shard := range keysPackage.Shards {
+			if _, err := cid.Cast(shard); err != nil {
+				return errors.Wrap(err, "invalid flip keys package shard cid")
+			}
+		}
+	}
 	sender, _ := types.SenderFlipKeysPackage(keysPackage)
 	return validateKey(sender, keysPackage.Epoch, appState)
 }
@@ -0,0 +1,120 @@
+package mempool
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
+)
+
+type txLane byte
+
+const (
+	regularLane txLane = iota
+	// ceremonyLane keeps ceremony-critical txs (answers and evidence), they are not limited by regular slots
+	// and can't be displaced by regular txs. Flip keys are kept separately by KeysPool.
+	ceremonyLane
+)
+
+var (
+	CeremonyLaneFullError = errors.New("ceremony lane is full")
+
+	lanes = []txLane{regularLane, ceremonyLane}
+)
+
+func (l txLane) String() string {
+	switch l {
+	case regularLane:
+		return "regular"
+	case ceremonyLane:
+		return "ceremony"
+	}
+	return fmt.Sprintf("lane%d", byte(l))
+}
+
+func laneOf(tx *types.Transaction) txLane {
+	if priorityTypes[tx.Type] {
+		return ceremonyLane
+	}
+	return regularLane
+}
+
+type LaneInfo struct {
+	Name     string `json:"name"`
+	Size     int    `json:"size"`
+	Limit    int    `json:"limit"`
+	Rejected int64  `json:"rejected"`
+}
+
+type laneMetrics struct {
+	size     metrics.Gauge
+	rejected metrics.Counter
+}
+
+type laneCounter struct {
+	size     int
+	rejected int64
+	metrics  *laneMetrics
+}
+
+func newLaneCounters() map[txLane]*laneCounter {
+	result := make(map[txLane]*laneCounter, len(lanes))
+	for _, lane := range lanes {
+		result[lane] = &laneCounter{
+			metrics: &laneMetrics{
+				size:     metrics.GetOrRegisterGauge("mempool."+lane.String()+".size", metrics.DefaultRegistry),
+				rejected: metrics.GetOrRegisterCounter("mempool."+lane.String()+".rejected", metrics.DefaultRegistry),
+			},
+		}
+	}
+	return result
+}
+
+func (c *laneCounter) add(delta int) {
+	c.size += delta
+	c.metrics.size.Update(int64(c.size))
+}
+
+func (c *laneCounter) reject() {
+	c.rejected++
+	c.metrics.rejected.Inc(1)
+}
+
+// laneLimit returns max number of txs in the lane, -1 if the lane is not limited
+func (pool *TxPool) laneLimit(lane txLane) int {
+	switch lane {
+	case ceremonyLane:
+		if pool.mempoolCfg.CeremonyLaneSlots <= 0 {
+			return -1
+		}
+		return pool.mempoolCfg.CeremonyLaneSlots
+	default:
+		if pool.mempoolCfg.TxPoolExecutableSlots < 0 || pool.mempoolCfg.TxPoolQueueSlots < 0 {
+			return -1
+		}
+		return pool.mempoolCfg.TxPoolExecutableSlots*pool.mempoolCfg.TxPoolAddrExecutableLimit +
+			pool.mempoolCfg.TxPoolQueueSlots*pool.mempoolCfg.TxPoolAddrQueueLimit
+	}
+}
+
+func (pool *TxPool) laneIsFull(lane txLane) bool {
+	limit := pool.laneLimit(lane)
+	return limit > 0 && pool.laneCounters[lane].size >= limit
+}
+
+// Lanes returns current sizes and limits of mempool lanes
+func (pool *TxPool) Lanes() []LaneInfo {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	result := make([]LaneInfo, 0, len(lanes))
+	for _, lane := range lanes {
+		counter := pool.laneCounters[lane]
+		result = append(result, LaneInfo{
+			Name:     lane.String(),
+			Size:     counter.size,
+			Limit:    pool.laneLimit(lane),
+			Rejected: counter.rejected,
+		})
+	}
+	return result
+}
@@ -0,0 +1,116 @@
+package mempool
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/rcrowley/go-metrics"
+	"sync"
+)
+
+const (
+	maxOrphansPerSender = 16
+)
+
+type orphanTx struct {
+	tx     *types.Transaction
+	sender common.Address
+	height uint64
+}
+
+// orphanPool keeps txs of unknown senders, e.g. senders funded by txs which are not mined yet.
+// Orphans are revalidated once their senders appear in the state and dropped after lifetime blocks.
+// If the pool is full, the oldest orphan is evicted.
+type orphanPool struct {
+	slots    int
+	lifetime uint64
+	txs      map[common.Hash]*orphanTx
+	order    []common.Hash
+	senders  map[common.Address]int
+	size     metrics.Gauge
+	mutex    sync.Mutex
+}
+
+func newOrphanPool(slots int, lifetime uint64) *orphanPool {
+	return &orphanPool{
+		slots:    slots,
+		lifetime: lifetime,
+		txs:      make(map[common.Hash]*orphanTx),
+		senders:  make(map[common.Address]int),
+		size:     metrics.GetOrRegisterGauge("mempool.orphans.size", metrics.DefaultRegistry),
+	}
+}
+
+func (p *orphanPool) enabled() bool {
+	return p.slots > 0
+}
+
+func (p *orphanPool) has(hash common.Hash) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	_, ok := p.txs[hash]
+	return ok
+}
+
+// add returns false if the tx can't be kept as an orphan
+func (p *orphanPool) add(tx *types.Transaction, sender common.Address, height uint64) bool {
+	if !p.enabled() {
+		return false
+	}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	if _, ok := p.txs[tx.Hash()]; ok || p.senders[sender] >= maxOrphansPerSender {
+		return false
+	}
+	for len(p.order) >= p.slots {
+		p.remove(p.order[0])
+	}
+	p.txs[tx.Hash()] = &orphanTx{tx: tx, sender: sender, height: height}
+	p.order = append(p.order, tx.Hash())
+	p.senders[sender]++
+	p.size.Update(int64(len(p.txs)))
+	return true
+}
+
+// resolve removes expired orphans and returns orphans of senders which exist in the state now
+func (p *orphanPool) resolve(appState *appstate.AppState, height uint64) []*types.Transaction {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var result []*types.Transaction
+	for _, hash := range append([]common.Hash(nil), p.order...) {
+		orphan := p.txs[hash]
+		if appState.State.AccountExists(orphan.sender) {
+			result = append(result, orphan.tx)
+			p.remove(hash)
+		} else if orphan.height+p.lifetime <= height {
+			p.remove(hash)
+		}
+	}
+	return result
+}
+
+func (p *orphanPool) remove(hash common.Hash) {
+	orphan, ok := p.txs[hash]
+	if !ok {
+		return
+	}
+	delete(p.txs, hash)
+	for i, h := range p.order {
+		if h == hash {
+			p.order = append(p.order[:i], p.order[i+1:]...)
+			break
+		}
+	}
+	if p.senders[orphan.sender] <= 1 {
+		delete(p.senders, orphan.sender)
+	} else {
+		p.senders[orphan.sender]--
+	}
+	p.size.Update(int64(len(p.txs)))
+}
+
+func (p *orphanPool) len() int {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	return len(p.txs)
+}
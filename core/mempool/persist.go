@@ -0,0 +1,93 @@
+package mempool
+
+import (
+	"encoding/json"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+const (
+	PersistedTxsFile = "mempool-txs.json"
+)
+
+func (pool *TxPool) persistedTxsPath() string {
+	return filepath.Join(pool.cfg.DataDir, PersistedTxsFile)
+}
+
+// Persist saves all pending txs to the data dir, they are revalidated and added back to the pool on next start
+func (pool *TxPool) Persist() error {
+	txs := pool.all.List()
+	list := make([]hexutil.Bytes, 0, len(txs))
+	for _, tx := range txs {
+		data, err := tx.ToBytes()
+		if err != nil {
+			return err
+		}
+		list = append(list, data)
+	}
+	data, err := json.Marshal(list)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(pool.cfg.DataDir, os.ModePerm); err != nil {
+		return err
+	}
+	tmpPath := pool.persistedTxsPath() + ".tmp"
+	if err := ioutil.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpPath, pool.persistedTxsPath()); err != nil {
+		return err
+	}
+	pool.log.Info("Mempool txs are persisted", "count", len(list))
+	return nil
+}
+
+// loadPersistedTxs adds txs saved by Persist to the pool, invalid txs are dropped, the file is removed after loading
+func (pool *TxPool) loadPersistedTxs() {
+	data, err := ioutil.ReadFile(pool.persistedTxsPath())
+	if err != nil {
+		if !os.IsNotExist(err) {
+			pool.log.Warn("Cannot read persisted mempool txs", "err", err)
+		}
+		return
+	}
+	defer os.Remove(pool.persistedTxsPath())
+
+	var list []hexutil.Bytes
+	if err := json.Unmarshal(data, &list); err != nil {
+		pool.log.Warn("Cannot parse persisted mempool txs", "err", err)
+		return
+	}
+	txs := make([]*types.Transaction, 0, len(list))
+	for _, item := range list {
+		tx := new(types.Transaction)
+		if err := tx.FromBytes(item); err != nil {
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	// txs of the same sender should be added in nonce order to become executable
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Epoch != txs[j].Epoch {
+			return txs[i].Epoch < txs[j].Epoch
+		}
+		return txs[i].AccountNonce < txs[j].AccountNonce
+	})
+	appState, err := pool.appState.Readonly(pool.head.Height())
+	if err != nil {
+		pool.log.Warn("Cannot load persisted mempool txs", "err", err)
+		return
+	}
+	loaded := 0
+	for _, tx := range txs {
+		if err := pool.add(tx, appState); err == nil || err == DuplicateTxError {
+			loaded++
+		}
+	}
+	pool.log.Info("Persisted mempool txs are loaded", "loaded", loaded, "dropped", len(txs)-loaded)
+}
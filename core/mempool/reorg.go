@@ -0,0 +1,55 @@
+package mempool
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/events"
+	"sort"
+)
+
+// handleReorg returns txs of reverted blocks which are not included into applied blocks back to the pool.
+// If the chain is just reset, pooled txs are revalidated against the new head since no block is applied.
+func (pool *TxPool) handleReorg(e *events.ReorgEvent) {
+	if pool.head == nil || pool.IsSyncing() {
+		return
+	}
+	if len(e.Applied) == 0 {
+		pool.revalidate()
+	}
+
+	included := make(map[common.Hash]struct{})
+	for _, block := range e.Applied {
+		for _, tx := range block.Body.Transactions {
+			included[tx.Hash()] = struct{}{}
+		}
+	}
+	var txs []*types.Transaction
+	for _, block := range e.Reverted {
+		for _, tx := range block.Body.Transactions {
+			if _, ok := included[tx.Hash()]; !ok {
+				txs = append(txs, tx)
+			}
+		}
+	}
+	if len(txs) == 0 {
+		return
+	}
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].AccountNonce < txs[j].AccountNonce
+	})
+
+	appState, err := pool.appState.Readonly(pool.head.Height())
+	if err != nil {
+		pool.log.Warn("Txs of reverted blocks can't be validated", "err", err)
+		return
+	}
+	returned := 0
+	for _, tx := range txs {
+		if err := pool.add(tx, appState); err != nil {
+			pool.log.Debug("Tx of reverted block is dropped", "hash", tx.Hash().Hex(), "err", err)
+			continue
+		}
+		returned++
+	}
+	pool.log.Info("Txs of reverted blocks are returned to the pool", "reverted", len(txs), "returned", returned)
+}
@@ -0,0 +1,159 @@
+package mempool
+
+import (
+	"github.com/idena-network/idena-go/blockchain/validation"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	maxScoredSenders = 10000
+	// scores below this value are forgotten on pruning
+	minTrackedSpamScore = 1
+
+	nonceAbusePenalty  = 1
+	underpricedPenalty = 2
+)
+
+var (
+	SenderQuotaExceededError = errors.New("sender pending tx quota is exceeded")
+	SenderRateLimitedError   = errors.New("sender is rate limited due to high spam score")
+)
+
+type spamScore struct {
+	value       float64
+	updated     time.Time
+	lastAllowed time.Time
+}
+
+// spamScorer tracks scores of senders which rise with submissions rejected due to the sender's fee or nonce abuse
+// and decay exponentially over time. Senders with score above the threshold may submit at most one tx per rate limit interval.
+// When the table is full, the sender with the lowest score is evicted in favor of a sender with a higher one.
+type spamScorer struct {
+	threshold float64
+	halfLife  time.Duration
+	interval  time.Duration
+	scores    map[common.Address]*spamScore
+	mutex     sync.Mutex
+}
+
+func newSpamScorer(threshold float64, halfLife time.Duration, interval time.Duration) *spamScorer {
+	return &spamScorer{
+		threshold: threshold,
+		halfLife:  halfLife,
+		interval:  interval,
+		scores:    make(map[common.Address]*spamScore),
+	}
+}
+
+func (s *spamScorer) enabled() bool {
+	return s.threshold > 0
+}
+
+func (s *spamScorer) decay(score *spamScore, now time.Time) {
+	if s.halfLife > 0 && now.After(score.updated) {
+		score.value *= math.Pow(0.5, float64(now.Sub(score.updated))/float64(s.halfLife))
+	}
+	score.updated = now
+}
+
+// check returns SenderRateLimitedError if the sender is above the threshold and has already submitted a tx within the interval
+func (s *spamScorer) check(sender common.Address, now time.Time) error {
+	if !s.enabled() {
+		return nil
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	score, ok := s.scores[sender]
+	if !ok {
+		return nil
+	}
+	s.decay(score, now)
+	if score.value < s.threshold {
+		return nil
+	}
+	if now.Sub(score.lastAllowed) < s.interval {
+		return SenderRateLimitedError
+	}
+	score.lastAllowed = now
+	return nil
+}
+
+// update raises the sender's score if the submission is rejected due to the sender's abuse
+func (s *spamScorer) update(sender common.Address, err error, now time.Time) {
+	if !s.enabled() || err == nil {
+		return
+	}
+	penalty := spamPenalty(err)
+	if penalty == 0 {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	score, ok := s.scores[sender]
+	if !ok {
+		if len(s.scores) >= maxScoredSenders && !s.evictLowest(penalty, now) {
+			return
+		}
+		score = &spamScore{updated: now}
+		s.scores[sender] = score
+	}
+	s.decay(score, now)
+	score.value += penalty
+}
+
+// evictLowest removes the sender with the lowest score if it is below the given one
+func (s *spamScorer) evictLowest(than float64, now time.Time) bool {
+	var lowest *common.Address
+	lowestValue := than
+	for sender, score := range s.scores {
+		s.decay(score, now)
+		if score.value < lowestValue {
+			sender := sender
+			lowest, lowestValue = &sender, score.value
+		}
+	}
+	if lowest == nil {
+		return false
+	}
+	delete(s.scores, *lowest)
+	return true
+}
+
+func (s *spamScorer) score(sender common.Address, now time.Time) float64 {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	score, ok := s.scores[sender]
+	if !ok {
+		return 0
+	}
+	s.decay(score, now)
+	return score.value
+}
+
+// prune forgets senders whose scores have decayed
+func (s *spamScorer) prune(now time.Time) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for sender, score := range s.scores {
+		s.decay(score, now)
+		if score.value < minTrackedSpamScore {
+			delete(s.scores, sender)
+		}
+	}
+}
+
+// spamPenalty returns the penalty for the rejection, only rejections caused by the sender's fee or nonce abuse are penalized,
+// duplicates and stale nonces are usual for gossip
+func spamPenalty(err error) float64 {
+	switch errors.Cause(err) {
+	case ReplacementUnderpriced, validation.BigFee, validation.InvalidMaxFee:
+		return underpricedPenalty
+	case NonceTooFarError, SenderQuotaExceededError:
+		return nonceAbusePenalty
+	}
+	return 0
+}
@@ -16,6 +16,7 @@ type buildingContext struct {
 	curNoncesPerSender map[common.Address]uint32
 	blockTxs           []*types.Transaction
 	blockGas           int
+	blockGasLimit      int
 }
 
 func newBuildingContext(
@@ -32,6 +33,7 @@ func newBuildingContext(
 		sortedPriorityTxs:  sortedPriorityTxs,
 		sortedTxsPerSender: sortedTxsPerSender,
 		curNoncesPerSender: curNoncesPerSender,
+		blockGasLimit:      int(appState.State.BlockGasLimit()),
 	}
 	return ctx
 }
@@ -62,7 +64,7 @@ func (ctx *buildingContext) addNextPriorityTxToBlock() {
 			break
 		}
 		gasToAdd += fee.CalculateGas(tx)
-		if ctx.blockGas+gasToAdd > types.MaxBlockGas {
+		if ctx.blockGas+gasToAdd > ctx.blockGasLimit {
 			break
 		}
 		txsToAdd = append(txsToAdd, tx)
@@ -91,7 +93,7 @@ func (ctx *buildingContext) addTxsToBlock() {
 		if ctx.curNoncesPerSender[sender]+1 != tx.AccountNonce {
 			continue
 		}
-		if ctx.blockGas+fee.CalculateGas(tx) > types.MaxBlockGas {
+		if ctx.blockGas+fee.CalculateGas(tx) > ctx.blockGasLimit {
 			return
 		}
 		ctx.blockTxs = append(ctx.blockTxs, tx)
@@ -1,6 +1,7 @@
 package mempool
 
 import (
+	"bytes"
 	"github.com/deckarep/golang-set"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/fee"
@@ -15,8 +16,10 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/vm/embedded"
 	"github.com/pkg/errors"
+	"math/big"
 	"sort"
 	"sync"
+	"time"
 )
 
 const (
@@ -25,9 +28,14 @@ const (
 )
 
 var (
-	DuplicateTxError = errors.New("tx with same hash already exists")
-	MempoolFullError = errors.New("mempool is full")
-	priorityTypes    = map[types.TxType]bool{
+	DuplicateTxError       = errors.New("tx with same hash already exists")
+	MempoolFullError       = errors.New("mempool is full")
+	DuplicateFlipError     = errors.New("flip with same cid is already in mempool")
+	DuplicateFlipPairError = errors.New("flip with same pair index is already in mempool")
+	SameNonceTxError       = errors.New("tx with same nonce already exists")
+	ReplacementUnderpriced = errors.New("replacement tx fee is too low")
+	NonceTooFarError       = errors.New("tx nonce is too far ahead of account nonce")
+	priorityTypes          = map[types.TxType]bool{
 		types.SubmitAnswersHashTx:  true,
 		types.SubmitShortAnswersTx: true,
 		types.SubmitLongAnswersTx:  true,
@@ -62,6 +70,9 @@ type TxPool struct {
 	coinbase         common.Address
 	statsCollector   collector.StatsCollector
 	txKeeper         *txKeeper
+	laneCounters     map[txLane]*laneCounter
+	spamScorer       *spamScorer
+	orphans          *orphanPool
 }
 
 func (pool *TxPool) IsSyncing() bool {
@@ -85,12 +96,19 @@ func NewTxPool(appState *appstate.AppState, bus eventbus.Bus, cfg *config.Config
 		bus:              bus,
 		statsCollector:   statsCollector,
 		deferredTxs:      make(chan *types.Transaction, MaxDeferredTxs),
+		laneCounters:     newLaneCounters(),
+		spamScorer:       newSpamScorer(cfg.Mempool.SpamScoreThreshold, cfg.Mempool.SpamScoreHalfLife, cfg.Mempool.SpamRateLimitInterval),
+		orphans:          newOrphanPool(cfg.Mempool.OrphanSlots, cfg.Mempool.OrphanLifetime),
 	}
 
-	_ = pool.bus.Subscribe(events.AddBlockEventID,
+	_ = pool.bus.Subscribe(events.ChainHeadEventID,
 		func(e eventbus.Event) {
-			newBlockEvent := e.(*events.NewBlockEvent)
-			pool.head = newBlockEvent.Block.Header
+			chainHeadEvent := e.(*events.ChainHeadEvent)
+			pool.head = chainHeadEvent.Block.Header
+		})
+	_ = pool.bus.Subscribe(events.ReorgEventID,
+		func(e eventbus.Event) {
+			pool.handleReorg(e.(*events.ReorgEvent))
 		})
 	_ = pool.bus.Subscribe(events.FastSyncCompleted, func(event eventbus.Event) {
 		pool.appState.NonceCache.Lock()
@@ -112,6 +130,9 @@ func (pool *TxPool) Initialize(head *types.Header, coinbase common.Address, useT
 			}
 		}
 	}
+	if pool.mempoolCfg.PersistTxs {
+		pool.loadPersistedTxs()
+	}
 }
 
 func (pool *TxPool) addDeferredTx(tx *types.Transaction) {
@@ -141,7 +162,11 @@ func (pool *TxPool) Validate(tx *types.Transaction) error {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
 
-	if err := pool.checkLimits(tx); err != nil {
+	if replaced := pool.findSameNonceTx(tx); replaced != nil {
+		if err := pool.checkReplacement(replaced, tx); err != nil {
+			return err
+		}
+	} else if err := pool.checkLimits(tx); err != nil {
 		return err
 	}
 	appState, err := pool.appState.Readonly(pool.head.Height())
@@ -153,13 +178,83 @@ func (pool *TxPool) Validate(tx *types.Transaction) error {
 }
 
 func (pool *TxPool) checkLimits(tx *types.Transaction) error {
-	if priorityTypes[tx.Type] {
-		return pool.checkPriorityTxLimits(tx)
+	err := pool.checkNonceGap(tx)
+	if err == nil {
+		if laneOf(tx) == ceremonyLane {
+			err = pool.checkPriorityTxLimits(tx)
+		} else {
+			err = pool.checkRegularTxLimits(tx)
+		}
+	}
+	if err != nil {
+		pool.laneCounters[laneOf(tx)].reject()
+	}
+	return err
+}
+
+// checkNonceGap rejects txs of the current epoch with nonce more than MaxNonceGap ahead of the account nonce,
+// txs with smaller gaps are queued until the gap is filled
+func (pool *TxPool) checkNonceGap(tx *types.Transaction) error {
+	maxGap := pool.mempoolCfg.MaxNonceGap
+	globalEpoch := pool.appState.State.Epoch()
+	if maxGap <= 0 || tx.Epoch != globalEpoch {
+		return nil
+	}
+	sender, _ := types.Sender(tx)
+	nonce := pool.appState.State.GetNonce(sender)
+	if pool.appState.State.GetEpoch(sender) < globalEpoch {
+		nonce = 0
+	}
+	if tx.AccountNonce > nonce+uint32(maxGap) {
+		return errors.Wrapf(NonceTooFarError, "account nonce: %v, tx nonce: %v, max gap: %v", nonce, tx.AccountNonce, maxGap)
+	}
+	return nil
+}
+
+// checkFlipTxDuplicates rejects flip submission if the sender already has a queued flip with the same cid or pair index
+func (pool *TxPool) checkFlipTxDuplicates(tx *types.Transaction) error {
+	attachment := attachments.ParseFlipSubmitAttachment(tx)
+	if attachment == nil {
+		return validation.InvalidPayload
 	}
-	return pool.checkRegularTxLimits(tx)
+	check := func(existingTx *types.Transaction) error {
+		if existingTx.Type != types.SubmitFlipTx {
+			return nil
+		}
+		existing := attachments.ParseFlipSubmitAttachment(existingTx)
+		if existing == nil {
+			return nil
+		}
+		if bytes.Equal(existing.Cid, attachment.Cid) {
+			return DuplicateFlipError
+		}
+		if existing.Pair == attachment.Pair {
+			return DuplicateFlipPairError
+		}
+		return nil
+	}
+	sender, _ := types.Sender(tx)
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, existingTx := range executable.txs {
+			if err := check(existingTx); err != nil {
+				return err
+			}
+		}
+	}
+	if txs, ok := pool.pendingTxs[sender]; ok {
+		for _, existingTx := range txs.txs {
+			if err := check(existingTx); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
 }
 
 func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction) error {
+	if pool.laneIsFull(ceremonyLane) {
+		return CeremonyLaneFullError
+	}
 	sender, _ := types.Sender(tx)
 	if executable, ok := pool.executableTxs[sender]; ok {
 		for _, existingTx := range executable.txs {
@@ -179,18 +274,20 @@ func (pool *TxPool) checkPriorityTxLimits(tx *types.Transaction) error {
 }
 
 func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
-	var totalLimit = 0
-	if pool.mempoolCfg.TxPoolExecutableSlots < 0 || pool.mempoolCfg.TxPoolQueueSlots < 0 {
-		totalLimit = -1
-	} else {
-		totalLimit = pool.mempoolCfg.TxPoolExecutableSlots*pool.mempoolCfg.TxPoolAddrExecutableLimit +
-			pool.mempoolCfg.TxPoolQueueSlots*pool.mempoolCfg.TxPoolAddrQueueLimit
+	if tx.Type == types.SubmitFlipTx {
+		if err := pool.checkFlipTxDuplicates(tx); err != nil {
+			return err
+		}
 	}
-	if totalLimit > 0 && len(pool.all.txs) >= totalLimit {
+	if pool.laneIsFull(regularLane) {
 		return errors.New("tx queue max size reached")
 	}
 	sender, _ := types.Sender(tx)
 
+	if quota := pool.mempoolCfg.SenderQuota; quota > 0 && pool.senderTxsCount(sender) >= quota {
+		return SenderQuotaExceededError
+	}
+
 	if byAddr, ok := pool.executableTxs[sender]; ok {
 		if byAddr.Full() {
 			if pending, ok := pool.pendingTxs[sender]; ok {
@@ -207,6 +304,17 @@ func (pool *TxPool) checkRegularTxLimits(tx *types.Transaction) error {
 	return nil
 }
 
+func (pool *TxPool) senderTxsCount(sender common.Address) int {
+	count := 0
+	if executable, ok := pool.executableTxs[sender]; ok {
+		count += len(executable.txs)
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		count += len(pending.txs)
+	}
+	return count
+}
+
 func (pool *TxPool) validate(tx *types.Transaction, appState *appstate.AppState, txType validation.TxType) error {
 	minFeePerGas := fee.GetFeePerGasForNetwork(appState.ValidatorsCache.NetworkSize())
 	return validation.ValidateTx(appState, tx, minFeePerGas, txType)
@@ -246,11 +354,8 @@ func (pool *TxPool) AddExternalTxs(txs ...*types.Transaction) error {
 
 func (pool *TxPool) AddInternalTx(tx *types.Transaction) error {
 
-	if !pool.cfg.Consensus.FixPoolRewardEvents && tx.Type == types.CallContractTx {
-		attachment := attachments.ParseCallContractAttachment(tx)
-		if attachment != nil && attachment.Method == embedded.FinishVotingMethod {
-			return errors.New("finishVoting is temporary disabled")
-		}
+	if err := pool.checkDisabledTx(tx); err != nil {
+		return err
 	}
 
 	if pool.IsSyncing() {
@@ -285,14 +390,45 @@ func (pool *TxPool) AddInternalTx(tx *types.Transaction) error {
 	return err
 }
 
-func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState) error {
+func (pool *TxPool) checkDisabledTx(tx *types.Transaction) error {
+	if !pool.cfg.Consensus.FixPoolRewardEvents && tx.Type == types.CallContractTx {
+		attachment := attachments.ParseCallContractAttachment(tx)
+		if attachment != nil && attachment.Method == embedded.FinishVotingMethod {
+			return errors.New("finishVoting is temporary disabled")
+		}
+	}
+	return nil
+}
+
+func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState) (err error) {
 	if _, ok := pool.all.Get(tx.Hash()); ok {
 		return DuplicateTxError
 	}
+	if pool.orphans.has(tx.Hash()) {
+		return DuplicateTxError
+	}
+
+	// ceremony txs are not rate limited to not block validation of spamming identities
+	if laneOf(tx) == regularLane {
+		sender, _ := types.Sender(tx)
+		if err := pool.spamScorer.check(sender, time.Now()); err != nil {
+			pool.laneCounters[regularLane].reject()
+			return err
+		}
+		defer func() {
+			pool.spamScorer.update(sender, err, time.Now())
+		}()
+	}
 
 	pool.mutex.Lock()
 
-	if err := pool.checkLimits(tx); err != nil {
+	replaced := pool.findSameNonceTx(tx)
+	if replaced != nil {
+		if err := pool.checkReplacement(replaced, tx); err != nil {
+			pool.mutex.Unlock()
+			return err
+		}
+	} else if err := pool.checkLimits(tx); err != nil {
 		pool.mutex.Unlock()
 		log.Warn("Tx limits", "hash", tx.Hash().Hex(), "err", err)
 		return err
@@ -302,20 +438,34 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState) erro
 
 	if err := pool.validate(tx, appState, validation.InboundTx); err != nil {
 		pool.mutex.Unlock()
+		if errors.Cause(err) == validation.InsufficientFunds && !appState.State.AccountExists(sender) &&
+			pool.orphans.add(tx, sender, pool.head.Height()) {
+			pool.log.Debug("Tx of unknown sender is kept as orphan", "hash", tx.Hash().Hex())
+			return nil
+		}
 		if sender == pool.coinbase {
 			log.Warn("Tx is not valid", "hash", tx.Hash().Hex(), "err", err)
 		}
 		return err
 	}
 
-	err := pool.put(tx)
-	if err != nil {
+	if replaced != nil {
+		pool.replace(replaced, tx)
+	} else if err := pool.put(tx); err != nil {
 		pool.mutex.Unlock()
 		return err
 	}
 
 	pool.mutex.Unlock()
 
+	if replaced != nil {
+		if pool.txKeeper != nil {
+			pool.txKeeper.RemoveTx(replaced.Hash())
+		}
+		pool.statsCollector.RemoveMemPoolTx(replaced)
+		pool.log.Info("Tx replaced", "old", replaced.Hash().Hex(), "new", tx.Hash().Hex())
+	}
+
 	pool.bus.Publish(&events.NewTxEvent{
 		Tx:  tx,
 		Own: sender == pool.coinbase,
@@ -323,11 +473,74 @@ func (pool *TxPool) add(tx *types.Transaction, appState *appstate.AppState) erro
 	return nil
 }
 
+// findSameNonceTx returns pooled tx of the same sender with the same epoch and nonce
+func (pool *TxPool) findSameNonceTx(tx *types.Transaction) *types.Transaction {
+	sender, _ := types.Sender(tx)
+	sameNonce := func(existing *types.Transaction) bool {
+		return existing.Epoch == tx.Epoch && existing.AccountNonce == tx.AccountNonce
+	}
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for _, existing := range executable.txs {
+			if sameNonce(existing) {
+				return existing
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		for _, existing := range pending.txs {
+			if sameNonce(existing) {
+				return existing
+			}
+		}
+	}
+	return nil
+}
+
+// checkReplacement checks that tx pays enough to replace the pooled tx with the same nonce
+func (pool *TxPool) checkReplacement(replaced *types.Transaction, tx *types.Transaction) error {
+	bump := pool.mempoolCfg.ReplacementFeeBump
+	if bump < 0 {
+		return SameNonceTxError
+	}
+	oldFee := new(big.Int).Add(replaced.MaxFeeOrZero(), replaced.TipsOrZero())
+	newFee := new(big.Int).Add(tx.MaxFeeOrZero(), tx.TipsOrZero())
+	minFee := new(big.Int).Mul(oldFee, big.NewInt(int64(100+bump)))
+	minFee.Div(minFee, big.NewInt(100))
+	if newFee.Cmp(oldFee) <= 0 || newFee.Cmp(minFee) < 0 {
+		return errors.Wrapf(ReplacementUnderpriced, "min fee: %v, tx fee: %v", minFee, newFee)
+	}
+	return nil
+}
+
+// replace puts tx in place of the pooled tx with the same nonce
+func (pool *TxPool) replace(replaced *types.Transaction, tx *types.Transaction) {
+	sender, _ := types.Sender(tx)
+	if executable, ok := pool.executableTxs[sender]; ok {
+		for i, existing := range executable.txs {
+			if existing.Hash() == replaced.Hash() {
+				executable.txs[i] = tx
+			}
+		}
+	}
+	if pending, ok := pool.pendingTxs[sender]; ok {
+		if _, ok := pending.Get(replaced.Hash()); ok {
+			pending.Remove(replaced.Hash())
+			pending.Add(tx)
+		}
+	}
+	pool.all.Remove(replaced.Hash())
+	pool.all.Add(tx)
+	delete(pool.txSyncCounts, replaced.Hash())
+	pool.laneCounters[laneOf(replaced)].add(-1)
+	pool.laneCounters[laneOf(tx)].add(1)
+}
+
 func (pool *TxPool) putToPending(tx *types.Transaction) error {
 	sender, _ := types.Sender(tx)
 	set, ok := pool.pendingTxs[sender]
 	if !ok {
-		if pool.mempoolCfg.TxPoolQueueSlots > 0 && len(pool.pendingTxs) >= pool.mempoolCfg.TxPoolQueueSlots {
+		// queue slots may be occupied by regular txs, they shouldn't block ceremony txs
+		if laneOf(tx) == regularLane && pool.mempoolCfg.TxPoolQueueSlots > 0 && len(pool.pendingTxs) >= pool.mempoolCfg.TxPoolQueueSlots {
 			return MempoolFullError
 		}
 		set = newTxMap(pool.mempoolCfg.TxPoolAddrQueueLimit)
@@ -374,16 +587,28 @@ func (pool *TxPool) put(tx *types.Transaction) error {
 	}
 
 	if err != nil {
+		pool.laneCounters[laneOf(tx)].reject()
 		return err
 	}
 
 	pool.all.Add(tx)
+	pool.laneCounters[laneOf(tx)].add(1)
+
+	// the tx may fill the gap before queued txs
+	if isExecutable {
+		pool.promotePendingTxs(sender)
+	}
 
 	pool.appState.NonceCache.SetNonce(sender, tx.Epoch, tx.AccountNonce)
 
 	return nil
 }
 
+// SpamScore returns the current spam score of the sender, senders with score above the threshold are rate limited
+func (pool *TxPool) SpamScore(sender common.Address) float64 {
+	return pool.spamScorer.score(sender, time.Now())
+}
+
 func (pool *TxPool) GetPendingTransaction(noFilter bool, count bool) []*types.Transaction {
 	all := pool.all.List()
 	pool.mutex.Lock()
@@ -443,9 +668,28 @@ func (pool *TxPool) BuildBlockTransactions() []*types.Transaction {
 	return ctx.blockTxs
 }
 
+// ExecutableGas returns total gas of executable txs of the current epoch, i.e. gas of txs waiting for inclusion into blocks
+func (pool *TxPool) ExecutableGas() int {
+	pool.mutex.Lock()
+	defer pool.mutex.Unlock()
+	globalEpoch := pool.appState.State.Epoch()
+	gas := 0
+	for _, executable := range pool.executableTxs {
+		for _, tx := range executable.txs {
+			if tx.Epoch == globalEpoch {
+				gas += fee.CalculateGas(tx)
+			}
+		}
+	}
+	return gas
+}
+
 func (pool *TxPool) Remove(transaction *types.Transaction) {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
+	if _, ok := pool.all.Get(transaction.Hash()); ok {
+		pool.laneCounters[laneOf(transaction)].add(-1)
+	}
 	pool.all.Remove(transaction.Hash())
 	delete(pool.txSyncCounts, transaction.Hash())
 	if pool.txKeeper != nil {
@@ -473,35 +717,44 @@ func (pool *TxPool) Remove(transaction *types.Transaction) {
 func (pool *TxPool) movePendingTxsToExecutable() {
 	pool.mutex.Lock()
 	defer pool.mutex.Unlock()
-	for sender, pending := range pool.pendingTxs {
-		executable, ok := pool.executableTxs[sender]
-		if !ok {
-			executable = newSortedTxs(pool.mempoolCfg.TxPoolAddrExecutableLimit)
-		}
-
-		for _, tx := range pending.Sorted() {
-			if executable.Empty() {
-				epoch := pool.appState.State.Epoch()
-				nonce := pool.appState.State.GetNonce(sender)
-				accountEpoch := pool.appState.State.GetEpoch(sender)
-				if accountEpoch < epoch {
-					nonce = 0
-				}
-				if epoch != tx.Epoch || tx.AccountNonce != nonce+1 {
-					break
-				}
+	for sender := range pool.pendingTxs {
+		pool.promotePendingTxs(sender)
+	}
+}
+
+// promotePendingTxs moves queued txs of the sender to executable ones while their nonces have no gaps
+func (pool *TxPool) promotePendingTxs(sender common.Address) {
+	pending, ok := pool.pendingTxs[sender]
+	if !ok {
+		return
+	}
+	executable, ok := pool.executableTxs[sender]
+	if !ok {
+		executable = newSortedTxs(pool.mempoolCfg.TxPoolAddrExecutableLimit)
+	}
+
+	for _, tx := range pending.Sorted() {
+		if executable.Empty() {
+			epoch := pool.appState.State.Epoch()
+			nonce := pool.appState.State.GetNonce(sender)
+			accountEpoch := pool.appState.State.GetEpoch(sender)
+			if accountEpoch < epoch {
+				nonce = 0
 			}
-			if executable.Add(tx) == nil {
-				pool.executableTxs[sender] = executable
-				pending.Remove(tx.Hash())
-			} else {
+			if epoch != tx.Epoch || tx.AccountNonce != nonce+1 {
 				break
 			}
 		}
-		if pending.Empty() {
-			delete(pool.pendingTxs, sender)
+		if executable.Add(tx) == nil {
+			pool.executableTxs[sender] = executable
+			pending.Remove(tx.Hash())
+		} else {
+			break
 		}
 	}
+	if pending.Empty() {
+		delete(pool.pendingTxs, sender)
+	}
 }
 
 func (pool *TxPool) ResetTo(block *types.Block) {
@@ -512,6 +765,14 @@ func (pool *TxPool) ResetTo(block *types.Block) {
 		pool.Remove(tx)
 	}
 
+	pool.revalidate()
+}
+
+// revalidate reloads the nonce cache and drops pooled txs which became invalid at the current head
+func (pool *TxPool) revalidate() {
+
+	pool.spamScorer.prune(time.Now())
+
 	pool.movePendingTxsToExecutable()
 
 	globalEpoch := pool.appState.State.Epoch()
@@ -589,6 +850,25 @@ func (pool *TxPool) ResetTo(block *types.Block) {
 	for _, tx := range removingTxs {
 		pool.Remove(tx)
 	}
+
+	pool.resolveOrphans()
+}
+
+// resolveOrphans adds orphans of senders funded by the last block to the pool
+func (pool *TxPool) resolveOrphans() {
+	appState, err := pool.appState.Readonly(pool.head.Height())
+	if err != nil {
+		return
+	}
+	txs := pool.orphans.resolve(appState, pool.head.Height())
+	sort.SliceStable(txs, func(i, j int) bool {
+		return txs[i].AccountNonce < txs[j].AccountNonce
+	})
+	for _, tx := range txs {
+		if err := pool.add(tx, appState); err != nil {
+			pool.log.Debug("Orphan tx is dropped", "hash", tx.Hash().Hex(), "err", err)
+		}
+	}
 }
 
 func (pool *TxPool) createBuildingContext() *buildingContext {
@@ -3,6 +3,7 @@ package mempool
 import (
 	"crypto/ecdsa"
 	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/config"
@@ -10,10 +11,16 @@ import (
 	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
+	"io/ioutil"
+	"math"
 	"math/big"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestTxPool_addDeferredTx(t *testing.T) {
@@ -267,7 +274,9 @@ func TestTxMap_Sorted(t *testing.T) {
 }
 
 func TestTxPool_AddWithTxKeeper(t *testing.T) {
+	dir := t.TempDir()
 	pool := getPool()
+	pool.cfg.DataDir = dir
 
 	keys := make([]*ecdsa.PrivateKey, 0)
 
@@ -310,6 +319,7 @@ func TestTxPool_AddWithTxKeeper(t *testing.T) {
 	prevPool := pool
 
 	pool = getPool()
+	pool.cfg.DataDir = dir
 	pool.appState = prevPool.appState
 	pool.Initialize(&types.Header{
 		EmptyBlockHeader: &types.EmptyBlockHeader{
@@ -329,3 +339,489 @@ func TestTxPool_AddWithTxKeeper(t *testing.T) {
 	txKeeper.Load()
 	require.Len(t, pool.txKeeper.txs, 0)
 }
+
+func TestTxPool_Lanes(t *testing.T) {
+	pool := getPool()
+	pool.mempoolCfg = &config.Mempool{
+		TxPoolQueueSlots:          2,
+		TxPoolExecutableSlots:     2,
+		TxPoolAddrQueueLimit:      1,
+		TxPoolAddrExecutableLimit: 1,
+		CeremonyLaneSlots:         3,
+	}
+	pool.appState.Initialize(0)
+
+	createTx := func(txType types.TxType) *types.Transaction {
+		key, _ := crypto.GenerateKey()
+		tx := &types.Transaction{
+			// nonce gap puts the tx to the pending queue
+			AccountNonce: 2,
+			Type:         txType,
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+	add := func(tx *types.Transaction) error {
+		pool.mutex.Lock()
+		defer pool.mutex.Unlock()
+		if err := pool.checkLimits(tx); err != nil {
+			return err
+		}
+		return pool.put(tx)
+	}
+
+	// regular txs occupy all queue slots
+	require.NoError(t, add(createTx(types.SendTx)))
+	require.NoError(t, add(createTx(types.SendTx)))
+	require.Equal(t, MempoolFullError, add(createTx(types.SendTx)))
+
+	// ceremony txs are not limited by regular slots
+	var ceremonyTxs []*types.Transaction
+	for _, txType := range []types.TxType{types.SubmitShortAnswersTx, types.EvidenceTx, types.SubmitLongAnswersTx} {
+		tx := createTx(txType)
+		require.NoError(t, add(tx))
+		ceremonyTxs = append(ceremonyTxs, tx)
+	}
+	require.Equal(t, CeremonyLaneFullError, add(createTx(types.SubmitAnswersHashTx)))
+
+	require.Equal(t, []LaneInfo{
+		{Name: "regular", Size: 2, Limit: 4, Rejected: 1},
+		{Name: "ceremony", Size: 3, Limit: 3, Rejected: 1},
+	}, pool.Lanes())
+
+	pool.Remove(ceremonyTxs[0])
+	pool.Remove(ceremonyTxs[0])
+	require.NoError(t, add(createTx(types.SubmitAnswersHashTx)))
+	require.Equal(t, 3, pool.Lanes()[1].Size)
+}
+
+func TestTxPool_ReplaceByFee(t *testing.T) {
+	pool := getPool()
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(nonce uint32, maxFee int64) *types.Transaction {
+		tx := &types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			MaxFee:       new(big.Int).Mul(big.NewInt(maxFee), big.NewInt(1e+16)),
+		}
+		tx, _ = types.SignTx(tx, key)
+		return tx
+	}
+
+	tx := createTx(1, 100)
+	require.NoError(t, pool.AddInternalTx(tx))
+	require.True(t, errors.Is(pool.AddInternalTx(createTx(1, 105)), ReplacementUnderpriced))
+
+	replacement := createTx(1, 110)
+	require.NoError(t, pool.AddInternalTx(replacement))
+	require.Nil(t, pool.GetTx(tx.Hash()))
+	require.Equal(t, []*types.Transaction{replacement}, pool.GetPendingByAddress(address))
+
+	// tx with nonce gap is kept in the pending queue
+	pendingTx := createTx(3, 100)
+	require.NoError(t, pool.AddInternalTx(pendingTx))
+	pendingReplacement := createTx(3, 200)
+	require.NoError(t, pool.AddInternalTx(pendingReplacement))
+	require.Len(t, pool.pendingTxs[address].txs, 1)
+	require.Nil(t, pool.GetTx(pendingTx.Hash()))
+	require.Len(t, pool.all.txs, 2)
+	require.Equal(t, 2, pool.Lanes()[0].Size)
+
+	pool.mempoolCfg.ReplacementFeeBump = -1
+	require.Equal(t, SameNonceTxError, pool.AddInternalTx(createTx(1, 1000)))
+}
+
+func TestTxPool_Persist(t *testing.T) {
+	dir, err := ioutil.TempDir("", "mempool")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	pool := getPool()
+	pool.cfg.DataDir = dir
+	pool.mempoolCfg.PersistTxs = true
+
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	header := &types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}
+	pool.Initialize(header, common.Address{0x1}, false)
+
+	createTx := func(nonce uint32) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+		}, key)
+		return tx
+	}
+	for nonce := uint32(1); nonce <= 3; nonce++ {
+		require.NoError(t, pool.AddInternalTx(createTx(nonce)))
+	}
+	require.NoError(t, pool.Persist())
+
+	// the first tx is mined while the node is stopped
+	pool.appState.State.SetNonce(address, 1)
+	pool.appState.Commit(nil)
+
+	restarted := getPool()
+	restarted.appState = pool.appState
+	restarted.cfg.DataDir = dir
+	restarted.mempoolCfg.PersistTxs = true
+	restarted.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 2,
+		},
+	}, common.Address{0x1}, false)
+
+	require.Nil(t, restarted.GetTx(createTx(1).Hash()))
+	require.NotNil(t, restarted.GetTx(createTx(2).Hash()))
+	require.NotNil(t, restarted.GetTx(createTx(3).Hash()))
+	require.Len(t, restarted.executableTxs[address].txs, 2)
+
+	_, err = os.Stat(filepath.Join(dir, PersistedTxsFile))
+	require.True(t, os.IsNotExist(err))
+}
+
+func TestTxPool_SpamScore(t *testing.T) {
+	pool := getPool()
+	pool.mempoolCfg.SenderQuota = 2
+	pool.spamScorer = newSpamScorer(2.5, time.Hour, time.Hour)
+
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(nonce uint32, amount int64) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       new(big.Int).Mul(big.NewInt(amount), common.DnaBase),
+		}, key)
+		return tx
+	}
+
+	require.NoError(t, pool.AddInternalTx(createTx(1, 1)))
+	require.NoError(t, pool.AddInternalTx(createTx(2, 1)))
+	require.Equal(t, SenderQuotaExceededError, pool.AddInternalTx(createTx(3, 1)))
+	require.Equal(t, float64(1), math.Round(pool.SpamScore(address)))
+
+	// not enough balance and stale nonces are not penalized
+	pool.mempoolCfg.SenderQuota = 0
+	require.Equal(t, validation.InsufficientFunds, errors.Cause(pool.AddInternalTx(createTx(3, 1000))))
+	require.Equal(t, validation.InvalidNonce, errors.Cause(pool.AddInternalTx(createTx(0, 1))))
+	require.Equal(t, float64(1), math.Round(pool.SpamScore(address)))
+
+	pool.mempoolCfg.SenderQuota = 2
+	require.Equal(t, SenderQuotaExceededError, pool.AddInternalTx(createTx(3, 1)))
+	require.Equal(t, SenderQuotaExceededError, pool.AddInternalTx(createTx(4, 1)))
+	require.Equal(t, float64(3), math.Round(pool.SpamScore(address)))
+
+	// the sender above the threshold may add one tx per interval
+	pool.mempoolCfg.SenderQuota = 0
+	require.NoError(t, pool.AddInternalTx(createTx(3, 1)))
+	require.Equal(t, SenderRateLimitedError, pool.AddInternalTx(createTx(4, 1)))
+	require.Equal(t, SenderRateLimitedError, pool.AddExternalTxs(createTx(4, 1)))
+}
+
+func Test_spamScorer(t *testing.T) {
+	scorer := newSpamScorer(4, time.Minute, time.Second*10)
+	sender := common.Address{0x1}
+	now := time.Unix(1000, 0)
+
+	for i := 0; i < 3; i++ {
+		scorer.update(sender, errors.Wrap(NonceTooFarError, "gap"), now)
+	}
+	scorer.update(sender, DuplicateTxError, now)
+	scorer.update(sender, errors.Wrap(validation.InvalidNonce, "stale"), now)
+	scorer.update(sender, validation.InsufficientFunds, now)
+	require.Equal(t, float64(3), scorer.score(sender, now))
+	require.NoError(t, scorer.check(sender, now))
+
+	scorer.update(sender, ReplacementUnderpriced, now)
+	require.Equal(t, float64(5), scorer.score(sender, now))
+	require.NoError(t, scorer.check(sender, now))
+	require.Equal(t, SenderRateLimitedError, scorer.check(sender, now.Add(time.Second*5)))
+	require.NoError(t, scorer.check(sender, now.Add(time.Second*10)))
+
+	require.Equal(t, 2.5, scorer.score(sender, now.Add(time.Minute)))
+	require.NoError(t, scorer.check(sender, now.Add(time.Minute)))
+
+	scorer.prune(now.Add(time.Minute * 2))
+	require.Len(t, scorer.scores, 1)
+	scorer.prune(now.Add(time.Minute * 3))
+	require.Len(t, scorer.scores, 0)
+}
+
+func Test_spamScorerEviction(t *testing.T) {
+	scorer := newSpamScorer(4, time.Minute, time.Second*10)
+	now := time.Unix(1000, 0)
+	for i := 0; i < maxScoredSenders; i++ {
+		sender := common.Address{}
+		sender.SetBytes(big.NewInt(int64(i + 1)).Bytes())
+		scorer.update(sender, ReplacementUnderpriced, now)
+	}
+	lowest := common.BytesToAddress([]byte{0x1})
+	scorer.scores[lowest].value = 1
+
+	newSender := common.Address{0x2}
+	scorer.update(newSender, NonceTooFarError, now)
+	require.Len(t, scorer.scores, maxScoredSenders)
+	require.Zero(t, scorer.score(newSender, now))
+
+	scorer.update(newSender, ReplacementUnderpriced, now)
+	require.Len(t, scorer.scores, maxScoredSenders)
+	require.Equal(t, float64(underpricedPenalty), scorer.score(newSender, now))
+	require.Zero(t, scorer.score(lowest, now))
+}
+
+func TestTxPool_FutureNonce(t *testing.T) {
+	pool := getPool()
+	pool.mempoolCfg.MaxNonceGap = 3
+
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(nonce uint32) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+		}, key)
+		return tx
+	}
+
+	require.NoError(t, pool.AddInternalTx(createTx(3)))
+	require.NoError(t, pool.AddInternalTx(createTx(2)))
+	require.Equal(t, NonceTooFarError, errors.Cause(pool.AddInternalTx(createTx(4))))
+	require.Len(t, pool.pendingTxs[address].txs, 2)
+	require.Nil(t, pool.executableTxs[address])
+
+	// the gap is filled, queued txs become executable at once
+	require.NoError(t, pool.AddInternalTx(createTx(1)))
+	require.Nil(t, pool.pendingTxs[address])
+	executable := pool.executableTxs[address].txs
+	require.Len(t, executable, 3)
+	for i, tx := range executable {
+		require.Equal(t, uint32(i+1), tx.AccountNonce)
+	}
+	require.Len(t, pool.BuildBlockTransactions(), 3)
+}
+
+func TestTxPool_TxExpiry(t *testing.T) {
+	pool := getPool()
+	defer validation.SetAppConfig(nil)
+
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(nonce uint32, expiryHeight uint64) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+			ExpiryHeight: expiryHeight,
+		}, key)
+		return tx
+	}
+
+	validation.SetAppConfig(pool.cfg)
+	pool.cfg.Consensus.EnableTxExpiry = false
+	require.Equal(t, validation.InvalidExpiry, errors.Cause(pool.AddInternalTx(createTx(1, 2))))
+
+	pool.cfg.Consensus.EnableTxExpiry = true
+	require.Equal(t, validation.TxExpired, errors.Cause(pool.AddInternalTx(createTx(1, 1))))
+	require.NoError(t, pool.AddInternalTx(createTx(1, 2)))
+
+	// tx is not included into block 2 and can't be included after
+	pool.appState.Commit(nil)
+	pool.ResetTo(&types.Block{
+		Header: &types.Header{
+			EmptyBlockHeader: &types.EmptyBlockHeader{
+				Height: 2,
+			},
+		},
+		Body: &types.Body{},
+	})
+	require.Nil(t, pool.GetTx(createTx(1, 2).Hash()))
+	require.Empty(t, pool.BuildBlockTransactions())
+}
+
+func TestTxPool_AddTxBatch(t *testing.T) {
+	pool := getPool()
+
+	key1, _ := crypto.GenerateKey()
+	key2, _ := crypto.GenerateKey()
+	address1 := crypto.PubkeyToAddress(key1.PublicKey)
+	address2 := crypto.PubkeyToAddress(key2.PublicKey)
+	pool.appState.State.SetBalance(address1, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.State.SetBalance(address2, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(key *ecdsa.PrivateKey, nonce uint32) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: nonce,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+		}, key)
+		return tx
+	}
+
+	results, err := pool.AddTxBatch([]*types.Transaction{
+		createTx(key1, 1), createTx(key1, 2), createTx(key2, 1), createTx(key2, 3),
+	})
+	require.NoError(t, err)
+	require.Equal(t, BatchRejectedError, results[0])
+	require.Equal(t, BatchRejectedError, results[2])
+	require.Equal(t, BatchNonceGapError, errors.Cause(results[3]))
+	require.Empty(t, pool.all.List())
+	require.Empty(t, pool.executableTxs)
+	require.Equal(t, 0, pool.laneCounters[regularLane].size)
+	require.Equal(t, uint32(0), pool.appState.NonceCache.GetNonce(address1, 0))
+
+	results, err = pool.AddTxBatch([]*types.Transaction{
+		createTx(key1, 1), createTx(key1, 2), createTx(key2, 1), createTx(key2, 2),
+	})
+	require.NoError(t, err)
+	for _, err := range results {
+		require.NoError(t, err)
+	}
+	require.Len(t, pool.executableTxs[address1].txs, 2)
+	require.Len(t, pool.executableTxs[address2].txs, 2)
+	require.Equal(t, uint32(2), pool.appState.NonceCache.GetNonce(address1, 0))
+
+	results, err = pool.AddTxBatch([]*types.Transaction{createTx(key1, 3), createTx(key1, 5)})
+	require.NoError(t, err)
+	require.Equal(t, BatchNonceGapError, errors.Cause(results[1]))
+	require.Len(t, pool.executableTxs[address1].txs, 2)
+	require.Equal(t, uint32(2), pool.appState.NonceCache.GetNonce(address1, 0))
+}
+
+func TestTxPool_Orphans(t *testing.T) {
+	pool := getPool()
+	pool.orphans.lifetime = 2
+
+	key, _ := crypto.GenerateKey()
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	expiringKey, _ := crypto.GenerateKey()
+	pool.appState.Commit(nil)
+	pool.appState.Initialize(1)
+	pool.Initialize(&types.Header{
+		EmptyBlockHeader: &types.EmptyBlockHeader{
+			Height: 1,
+		},
+	}, common.Address{0x1}, false)
+
+	createTx := func(key *ecdsa.PrivateKey) *types.Transaction {
+		tx, _ := types.SignTx(&types.Transaction{
+			AccountNonce: 1,
+			To:           &common.Address{0x2},
+			Type:         types.SendTx,
+			Amount:       common.DnaBase,
+		}, key)
+		return tx
+	}
+	resetTo := func(height uint64) {
+		pool.appState.Commit(nil)
+		pool.ResetTo(&types.Block{
+			Header: &types.Header{
+				EmptyBlockHeader: &types.EmptyBlockHeader{
+					Height: height,
+				},
+			},
+			Body: &types.Body{},
+		})
+	}
+
+	tx := createTx(key)
+	require.NoError(t, pool.AddInternalTx(tx))
+	require.NoError(t, pool.AddInternalTx(createTx(expiringKey)))
+	require.Equal(t, DuplicateTxError, pool.AddInternalTx(tx))
+	require.Nil(t, pool.GetTx(tx.Hash()))
+	require.Equal(t, 2, pool.orphans.len())
+
+	resetTo(2)
+	require.Equal(t, 2, pool.orphans.len())
+
+	// the sender is funded, the expiring orphan reaches its lifetime
+	pool.appState.State.SetBalance(address, new(big.Int).Mul(big.NewInt(100), common.DnaBase))
+	resetTo(3)
+	require.Equal(t, 0, pool.orphans.len())
+	require.NotNil(t, pool.GetTx(tx.Hash()))
+	require.Len(t, pool.executableTxs[address].txs, 1)
+}
+
+func Test_orphanPool(t *testing.T) {
+	pool := newOrphanPool(2, 10)
+	var txs []*types.Transaction
+	for i := 0; i < 3; i++ {
+		key, _ := crypto.GenerateKey()
+		tx, _ := types.SignTx(&types.Transaction{AccountNonce: 1, Type: types.SendTx}, key)
+		txs = append(txs, tx)
+		require.True(t, pool.add(tx, crypto.PubkeyToAddress(key.PublicKey), 1))
+	}
+	require.Equal(t, 2, pool.len())
+	require.False(t, pool.has(txs[0].Hash()))
+	require.True(t, pool.has(txs[2].Hash()))
+
+	key, _ := crypto.GenerateKey()
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	pool = newOrphanPool(100, 10)
+	for i := uint32(1); i <= maxOrphansPerSender; i++ {
+		tx, _ := types.SignTx(&types.Transaction{AccountNonce: i, Type: types.SendTx}, key)
+		require.True(t, pool.add(tx, sender, 1))
+	}
+	tx, _ := types.SignTx(&types.Transaction{AccountNonce: maxOrphansPerSender + 1, Type: types.SendTx}, key)
+	require.False(t, pool.add(tx, sender, 1))
+}
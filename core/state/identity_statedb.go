@@ -299,6 +299,11 @@ func (s *IdentityStateDB) deleteStateIdentityObject(stateObject *stateApprovedId
 	s.tree.Remove(StateDbKeys.IdentityKey(addr))
 }
 
+// VerifyTree checks integrity of the identity state tree saved at the given height
+func (s *IdentityStateDB) VerifyTree(height uint64, root common.Hash) (*TreeReport, error) {
+	return verifyTree(s.db, height, root)
+}
+
 func (s *IdentityStateDB) Root() common.Hash {
 	return s.tree.WorkingHash()
 }
@@ -327,6 +332,18 @@ func (s *IdentityStateDB) Delegatee(addr common.Address) *common.Address {
 	return nil
 }
 
+func (s *IdentityStateDB) BlsPubKey(addr common.Address) []byte {
+	stateObject := s.getStateIdentity(addr)
+	if stateObject != nil {
+		return stateObject.data.BlsPubKey
+	}
+	return nil
+}
+
+func (s *IdentityStateDB) SetBlsPubKey(addr common.Address, pubKey []byte) {
+	s.GetOrNewIdentityObject(addr).SetBlsPubKey(pubKey)
+}
+
 func (s *IdentityStateDB) SetOnline(addr common.Address, online bool) {
 	s.GetOrNewIdentityObject(addr).SetOnline(online)
 }
@@ -444,6 +461,7 @@ func (s *IdentityStateDB) SetPredefinedIdentities(state *models.ProtoPredefinedS
 			d := common.BytesToAddress(identity.Delegatee)
 			stateObj.data.Delegatee = &d
 		}
+		stateObj.data.BlsPubKey = identity.BlsPubKey
 		stateObj.touch()
 	}
 }
@@ -70,6 +70,14 @@ func (ns *NonceCache) UnsafeSetNonce(addr common.Address, txEpoch uint16, nonce
 	}
 }
 
+// ResetNonce sets the nonce even if it is lower than the tracked one, it is used to revert nonces of txs which are not added
+func (ns *NonceCache) ResetNonce(addr common.Address, txEpoch uint16, nonce uint32) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	ns.getAccount(addr, txEpoch).nonce = nonce
+}
+
 // populate the managed state
 func (ns *NonceCache) getAccount(addr common.Address, epoch uint16) *account {
 	if epochs, ok := ns.accounts[addr]; !ok {
@@ -42,6 +42,30 @@ func (s IdentityState) VerifiedOrBetter() bool {
 	return s == Verified || s == Human
 }
 
+// Name returns the name of the state used by RPC
+func (s IdentityState) Name() string {
+	switch s {
+	case Invite:
+		return "Invite"
+	case Candidate:
+		return "Candidate"
+	case Newbie:
+		return "Newbie"
+	case Verified:
+		return "Verified"
+	case Suspended:
+		return "Suspended"
+	case Zombie:
+		return "Zombie"
+	case Killed:
+		return "Killed"
+	case Human:
+		return "Human"
+	default:
+		return "Undefined"
+	}
+}
+
 // stateAccount represents an Idena account which is being modified.
 //
 // The usage pattern is as follows:
@@ -206,6 +230,72 @@ type Global struct {
 	EmptyBlocksBits               *big.Int
 	GodAddressInvites             uint16
 	BlocksCntWithoutCeremonialTxs byte
+	// BlockGasLimit is set by ChangeBlockGasLimitTx, zero value means types.MaxBlockGas
+	BlockGasLimit uint64
+	// Misbehaviors are the last penalized rounds of identities which signed conflicting votes
+	Misbehaviors []*Misbehavior
+	// MinProposerThreshold is set by ChangeProposerThresholdTx, zero value means the threshold of the network config
+	MinProposerThreshold uint64
+	// WordsDictionary is the flip words dictionary pinned by ChangeWordsDictionaryTx, nil means the built-in one
+	WordsDictionary *WordsDictionary
+	// NextWordsDictionary is the dictionary pinned for the following epochs, it replaces WordsDictionary since its epoch
+	NextWordsDictionary *WordsDictionary
+}
+
+type WordsDictionary struct {
+	Hash common.Hash
+	Size uint32
+	// Epoch is the first epoch which uses the dictionary
+	Epoch uint16
+}
+
+func WordsDictionaryToProto(dictionary *WordsDictionary) *models.ProtoWordsDictionary {
+	if dictionary == nil {
+		return nil
+	}
+	return &models.ProtoWordsDictionary{
+		Hash:  dictionary.Hash.Bytes(),
+		Size:  dictionary.Size,
+		Epoch: uint32(dictionary.Epoch),
+	}
+}
+
+func wordsDictionaryFromProto(protoDictionary *models.ProtoWordsDictionary) *WordsDictionary {
+	if protoDictionary == nil {
+		return nil
+	}
+	return &WordsDictionary{
+		Hash:  common.BytesToHash(protoDictionary.Hash),
+		Size:  protoDictionary.Size,
+		Epoch: uint16(protoDictionary.Epoch),
+	}
+}
+
+type Misbehavior struct {
+	Address common.Address
+	Round   uint64
+}
+
+func MisbehaviorsToProto(misbehaviors []*Misbehavior) []*models.ProtoStateGlobal_ProtoMisbehavior {
+	var result []*models.ProtoStateGlobal_ProtoMisbehavior
+	for _, m := range misbehaviors {
+		result = append(result, &models.ProtoStateGlobal_ProtoMisbehavior{
+			Address: m.Address.Bytes(),
+			Round:   m.Round,
+		})
+	}
+	return result
+}
+
+func misbehaviorsFromProto(protoMisbehaviors []*models.ProtoStateGlobal_ProtoMisbehavior) []*Misbehavior {
+	var result []*Misbehavior
+	for _, m := range protoMisbehaviors {
+		result = append(result, &Misbehavior{
+			Address: common.BytesToAddress(m.Address),
+			Round:   m.Round,
+		})
+	}
+	return result
 }
 
 func (s *Global) ToBytes() ([]byte, error) {
@@ -223,6 +313,11 @@ func (s *Global) ToBytes() ([]byte, error) {
 		EmptyBlocksBits:               common.BigIntBytesOrNil(s.EmptyBlocksBits),
 		GodAddressInvites:             uint32(s.GodAddressInvites),
 		BlocksCntWithoutCeremonialTxs: uint32(s.BlocksCntWithoutCeremonialTxs),
+		BlockGasLimit:                 s.BlockGasLimit,
+		Misbehaviors:                  MisbehaviorsToProto(s.Misbehaviors),
+		MinProposerThreshold:          s.MinProposerThreshold,
+		WordsDictionary:               WordsDictionaryToProto(s.WordsDictionary),
+		NextWordsDictionary:           WordsDictionaryToProto(s.NextWordsDictionary),
 	}
 	return proto.Marshal(protoAnswer)
 }
@@ -246,21 +341,79 @@ func (s *Global) FromBytes(data []byte) error {
 	s.EmptyBlocksBits = common.BigIntOrNil(protoGlobal.EmptyBlocksBits)
 	s.GodAddressInvites = uint16(protoGlobal.GodAddressInvites)
 	s.BlocksCntWithoutCeremonialTxs = byte(protoGlobal.BlocksCntWithoutCeremonialTxs)
+	s.BlockGasLimit = protoGlobal.BlockGasLimit
+	s.Misbehaviors = misbehaviorsFromProto(protoGlobal.Misbehaviors)
+	s.MinProposerThreshold = protoGlobal.MinProposerThreshold
+	s.WordsDictionary = wordsDictionaryFromProto(protoGlobal.WordsDictionary)
+	s.NextWordsDictionary = wordsDictionaryFromProto(protoGlobal.NextWordsDictionary)
 	return nil
 }
 
 // Account is the Idena consensus representation of accounts.
 // These objects are stored in the main account trie.
 type Account struct {
-	Nonce    uint32
-	Epoch    uint16
-	Balance  *big.Int
-	Contract *ContractData
+	Nonce      uint32
+	Epoch      uint16
+	Balance    *big.Int
+	Contract   *ContractData
+	StakeLocks []*StakeLock
 }
 
 type ContractData struct {
 	CodeHash common.Hash
 	Stake    *big.Int
+	// StorageSize is the total size of contract store keys and values in bytes
+	StorageSize uint64
+	// UnpaidRentEpochs is the number of epochs in a row the stake didn't cover the storage rent
+	UnpaidRentEpochs uint16
+}
+
+// StakeLock holds coins which are released to the account balance by equal portions during Epochs epochs,
+// the first portion becomes available when StartEpoch begins
+type StakeLock struct {
+	Amount     *big.Int
+	Unlocked   *big.Int
+	StartEpoch uint16
+	Epochs     uint16
+	// Locker is the address which sent the coins
+	Locker common.Address
+}
+
+// UnlockedAt returns the total amount of the lock which is available in the given epoch, including already released coins
+func (l *StakeLock) UnlockedAt(epoch uint16) *big.Int {
+	if epoch < l.StartEpoch || l.Epochs == 0 {
+		return big.NewInt(0)
+	}
+	passed := uint32(epoch-l.StartEpoch) + 1
+	if passed >= uint32(l.Epochs) {
+		return new(big.Int).Set(l.Amount)
+	}
+	unlocked := new(big.Int).Mul(l.Amount, big.NewInt(int64(passed)))
+	return unlocked.Div(unlocked, big.NewInt(int64(l.Epochs)))
+}
+
+// Releasable returns the amount which can be moved to the balance in the given epoch
+func (l *StakeLock) Releasable(epoch uint16) *big.Int {
+	return new(big.Int).Sub(l.UnlockedAt(epoch), l.UnlockedOrZero())
+}
+
+func (l *StakeLock) Locked() *big.Int {
+	return new(big.Int).Sub(l.Amount, l.UnlockedOrZero())
+}
+
+func (l *StakeLock) UnlockedOrZero() *big.Int {
+	if l.Unlocked == nil {
+		return big.NewInt(0)
+	}
+	return l.Unlocked
+}
+
+// lockerBytesOrNil keeps encoding of locks without a locker unchanged
+func lockerBytesOrNil(locker common.Address) []byte {
+	if locker == (common.Address{}) {
+		return nil
+	}
+	return locker.Bytes()
 }
 
 func (a *Account) ToBytes() ([]byte, error) {
@@ -271,10 +424,21 @@ func (a *Account) ToBytes() ([]byte, error) {
 	}
 	if a.Contract != nil {
 		protoAcc.ContractData = &models.ProtoStateAccount_ProtoContractData{
-			CodeHash: a.Contract.CodeHash.Bytes(),
-			Stake:    common.BigIntBytesOrNil(a.Contract.Stake),
+			CodeHash:         a.Contract.CodeHash.Bytes(),
+			Stake:            common.BigIntBytesOrNil(a.Contract.Stake),
+			StorageSize:      a.Contract.StorageSize,
+			UnpaidRentEpochs: uint32(a.Contract.UnpaidRentEpochs),
 		}
 	}
+	for _, lock := range a.StakeLocks {
+		protoAcc.StakeLocks = append(protoAcc.StakeLocks, &models.ProtoStateAccount_ProtoStakeLock{
+			Amount:     common.BigIntBytesOrNil(lock.Amount),
+			Unlocked:   common.BigIntBytesOrNil(lock.Unlocked),
+			StartEpoch: uint32(lock.StartEpoch),
+			Epochs:     uint32(lock.Epochs),
+			Locker:     lockerBytesOrNil(lock.Locker),
+		})
+	}
 	return proto.Marshal(protoAcc)
 }
 
@@ -290,10 +454,22 @@ func (a *Account) FromBytes(data []byte) error {
 		hash := common.Hash{}
 		hash.SetBytes(protoAcc.ContractData.CodeHash)
 		a.Contract = &ContractData{
-			CodeHash: hash,
-			Stake:    common.BigIntOrNil(protoAcc.ContractData.Stake),
+			CodeHash:         hash,
+			Stake:            common.BigIntOrNil(protoAcc.ContractData.Stake),
+			StorageSize:      protoAcc.ContractData.StorageSize,
+			UnpaidRentEpochs: uint16(protoAcc.ContractData.UnpaidRentEpochs),
 		}
 	}
+	for _, lock := range protoAcc.StakeLocks {
+		stakeLock := &StakeLock{
+			Amount:     common.BigIntOrNil(lock.Amount),
+			Unlocked:   common.BigIntOrNil(lock.Unlocked),
+			StartEpoch: uint16(lock.StartEpoch),
+			Epochs:     uint16(lock.Epochs),
+		}
+		stakeLock.Locker.SetBytes(lock.Locker)
+		a.StakeLocks = append(a.StakeLocks, stakeLock)
+	}
 	return nil
 }
 
@@ -333,6 +509,8 @@ type Identity struct {
 	Delegatee            *common.Address
 	DelegationNonce      uint32
 	DelegationEpoch      uint16
+	// AnswersSubmitter is the session key address authorized to submit ceremony answers on behalf of the identity
+	AnswersSubmitter *common.Address
 }
 
 type TxAddr struct {
@@ -369,6 +547,9 @@ func (i *Identity) ToBytes() ([]byte, error) {
 	if i.Delegatee != nil {
 		protoIdentity.Delegatee = i.Delegatee.Bytes()
 	}
+	if i.AnswersSubmitter != nil {
+		protoIdentity.AnswersSubmitter = i.AnswersSubmitter.Bytes()
+	}
 	for idx := range i.Flips {
 		protoIdentity.Flips = append(protoIdentity.Flips, &models.ProtoStateIdentity_Flip{
 			Cid:  i.Flips[idx].Cid,
@@ -438,6 +619,10 @@ func (i *Identity) FromBytes(data []byte) error {
 		addr := common.BytesToAddress(protoIdentity.Delegatee)
 		i.Delegatee = &addr
 	}
+	if protoIdentity.AnswersSubmitter != nil {
+		addr := common.BytesToAddress(protoIdentity.AnswersSubmitter)
+		i.AnswersSubmitter = &addr
+	}
 
 	return nil
 }
@@ -468,12 +653,14 @@ type ApprovedIdentity struct {
 	Approved  bool
 	Online    bool
 	Delegatee *common.Address
+	BlsPubKey []byte
 }
 
 func (s *ApprovedIdentity) ToBytes() ([]byte, error) {
 	protoAnswer := &models.ProtoStateApprovedIdentity{
-		Approved: s.Approved,
-		Online:   s.Online,
+		Approved:  s.Approved,
+		Online:    s.Online,
+		BlsPubKey: s.BlsPubKey,
 	}
 	if s.Delegatee != nil {
 		protoAnswer.Delegatee = s.Delegatee.Bytes()
@@ -488,6 +675,7 @@ func (s *ApprovedIdentity) FromBytes(data []byte) error {
 	}
 	s.Approved = protoIdentity.Approved
 	s.Online = protoIdentity.Online
+	s.BlsPubKey = protoIdentity.BlsPubKey
 	if protoIdentity.Delegatee != nil {
 		d := common.BytesToAddress(protoIdentity.Delegatee)
 		s.Delegatee = &d
@@ -601,7 +789,7 @@ func (s *stateAccount) deepCopy(db *StateDB, onDirty func(addr common.Address))
 
 // empty returns whether the account is considered empty.
 func (s *stateAccount) empty() bool {
-	return s.Balance().Sign() == 0 && s.data.Nonce == 0 && s.data.Contract == nil
+	return s.Balance().Sign() == 0 && s.data.Nonce == 0 && s.data.Contract == nil && len(s.data.StakeLocks) == 0
 }
 
 // Returns the address of the contract/account
@@ -658,6 +846,28 @@ func (s *stateAccount) SetContractStake(stake *big.Int) {
 	s.touch()
 }
 
+func (s *stateAccount) SetContractStorageSize(size uint64) {
+	if s.data.Contract == nil {
+		s.data.Contract = &ContractData{}
+	}
+	s.data.Contract.StorageSize = size
+	s.touch()
+}
+
+func (s *stateAccount) SetContractUnpaidRentEpochs(epochs uint16) {
+	if s.data.Contract == nil {
+		s.data.Contract = &ContractData{}
+	}
+	s.data.Contract.UnpaidRentEpochs = epochs
+	s.touch()
+}
+
+// setStakeLocks replaces the whole list since account copies share lock objects
+func (s *stateAccount) setStakeLocks(locks []*StakeLock) {
+	s.data.StakeLocks = locks
+	s.touch()
+}
+
 // Returns the address of the contract/account
 func (s *stateIdentity) Address() common.Address {
 	return s.address
@@ -946,6 +1156,20 @@ func (s *stateIdentity) Delegatee() *common.Address {
 	return s.data.Delegatee
 }
 
+func (s *stateIdentity) SetAnswersSubmitter(submitter common.Address) {
+	s.data.AnswersSubmitter = &submitter
+	s.touch()
+}
+
+func (s *stateIdentity) RemoveAnswersSubmitter() {
+	s.data.AnswersSubmitter = nil
+	s.touch()
+}
+
+func (s *stateIdentity) AnswersSubmitter() *common.Address {
+	return s.data.AnswersSubmitter
+}
+
 func (s *stateIdentity) SetDelegationEpoch(epoch uint16) {
 	s.data.DelegationEpoch = epoch
 	s.touch()
@@ -1097,6 +1321,65 @@ func (s *stateGlobal) FeePerGas() *big.Int {
 	return s.data.FeePerGas
 }
 
+func (s *stateGlobal) BlockGasLimit() uint64 {
+	if s.data.BlockGasLimit == 0 {
+		return types.MaxBlockGas
+	}
+	return s.data.BlockGasLimit
+}
+
+func (s *stateGlobal) BlockGasLimitRaw() uint64 {
+	return s.data.BlockGasLimit
+}
+
+func (s *stateGlobal) SetBlockGasLimit(limit uint64) {
+	s.data.BlockGasLimit = limit
+	s.touch()
+}
+
+func (s *stateGlobal) MinProposerThreshold() float64 {
+	return math2.Float64frombits(s.data.MinProposerThreshold)
+}
+
+func (s *stateGlobal) MinProposerThresholdRaw() uint64 {
+	return s.data.MinProposerThreshold
+}
+
+func (s *stateGlobal) SetMinProposerThreshold(value float64) {
+	s.data.MinProposerThreshold = math2.Float64bits(value)
+	s.touch()
+}
+
+// WordsDictionary returns the dictionary used in the epoch, nil means the built-in one
+func (s *stateGlobal) WordsDictionary(epoch uint16) *WordsDictionary {
+	if next := s.data.NextWordsDictionary; next != nil && epoch >= next.Epoch {
+		return next
+	}
+	return s.data.WordsDictionary
+}
+
+func (s *stateGlobal) WordsDictionaries() (current *WordsDictionary, next *WordsDictionary) {
+	return s.data.WordsDictionary, s.data.NextWordsDictionary
+}
+
+// SetNextWordsDictionary pins the dictionary for epochs since the given one, the previously pinned dictionary
+// becomes the current one if it is already in use
+func (s *stateGlobal) SetNextWordsDictionary(dictionary *WordsDictionary, currentEpoch uint16) {
+	s.data.WordsDictionary = s.WordsDictionary(currentEpoch)
+	s.data.NextWordsDictionary = dictionary
+	s.touch()
+}
+
+func (s *stateGlobal) Misbehaviors() []*Misbehavior {
+	return s.data.Misbehaviors
+}
+
+// setMisbehaviors replaces the whole list since global copies share records
+func (s *stateGlobal) setMisbehaviors(misbehaviors []*Misbehavior) {
+	s.data.Misbehaviors = misbehaviors
+	s.touch()
+}
+
 func (s *stateGlobal) SubGodAddressInvite() {
 	s.data.GodAddressInvites -= 1
 	s.touch()
@@ -1135,7 +1418,7 @@ func (s *stateApprovedIdentity) Online() bool {
 
 // empty returns whether the account is considered empty.
 func (s *stateApprovedIdentity) empty() bool {
-	return !s.data.Approved && !s.data.Online
+	return !s.data.Approved && !s.data.Online && len(s.data.BlsPubKey) == 0
 }
 
 func (s *stateApprovedIdentity) touch() {
@@ -1165,6 +1448,11 @@ func (s *stateApprovedIdentity) RemoveDelegatee() {
 	s.touch()
 }
 
+func (s *stateApprovedIdentity) SetBlsPubKey(pubKey []byte) {
+	s.data.BlsPubKey = pubKey
+	s.touch()
+}
+
 func IsCeremonyCandidate(identity Identity) bool {
 	state := identity.State
 	return (state == Candidate || state.NewbieOrBetter() || state == Suspended ||
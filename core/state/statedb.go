@@ -77,6 +77,8 @@ type StateDB struct {
 	stateDelayedOfflinePenalties      *stateDelayedOfflinePenalties
 	stateDelayedOfflinePenaltiesDirty bool
 
+	tracer StateTracer
+
 	log  log.Logger
 	lock sync.Mutex
 }
@@ -497,6 +499,69 @@ func (s *StateDB) FeePerGas() *big.Int {
 	return s.GetOrNewGlobalObject().FeePerGas()
 }
 
+func (s *StateDB) SetBlockGasLimit(limit uint64) {
+	s.GetOrNewGlobalObject().SetBlockGasLimit(limit)
+}
+
+// BlockGasLimit returns the effective gas limit of blocks
+func (s *StateDB) BlockGasLimit() uint64 {
+	return s.GetOrNewGlobalObject().BlockGasLimit()
+}
+
+func (s *StateDB) SetMinProposerThreshold(value float64) {
+	s.GetOrNewGlobalObject().SetMinProposerThreshold(value)
+}
+
+// MinProposerThreshold returns the minimal proposer threshold set by governance, zero value means it wasn't set
+func (s *StateDB) MinProposerThreshold() float64 {
+	return s.GetOrNewGlobalObject().MinProposerThreshold()
+}
+
+// SetNextWordsDictionary pins the flip words dictionary which is used since the next epoch
+func (s *StateDB) SetNextWordsDictionary(hash common.Hash, size uint32) {
+	s.GetOrNewGlobalObject().SetNextWordsDictionary(&WordsDictionary{
+		Hash:  hash,
+		Size:  size,
+		Epoch: s.Epoch() + 1,
+	}, s.Epoch())
+}
+
+// WordsDictionary returns the flip words dictionary of the current epoch, nil or zero hash means the built-in one
+func (s *StateDB) WordsDictionary() *WordsDictionary {
+	return s.GetOrNewGlobalObject().WordsDictionary(s.Epoch())
+}
+
+// WordsDictionarySize returns the number of words which are used to generate flip word pairs in the current epoch
+func (s *StateDB) WordsDictionarySize() int {
+	if dictionary := s.WordsDictionary(); dictionary != nil && dictionary.Hash != (common.Hash{}) {
+		return int(dictionary.Size)
+	}
+	return common.WordDictionarySize
+}
+
+// LastMisbehaviorRound returns the last round the identity was penalized for signing conflicting votes
+func (s *StateDB) LastMisbehaviorRound(addr common.Address) (uint64, bool) {
+	for _, m := range s.GetOrNewGlobalObject().Misbehaviors() {
+		if m.Address == addr {
+			return m.Round, true
+		}
+	}
+	return 0, false
+}
+
+// AddMisbehavior records the penalized round of the identity, records of rounds before minRound are dropped
+func (s *StateDB) AddMisbehavior(addr common.Address, round uint64, minRound uint64) {
+	globalObject := s.GetOrNewGlobalObject()
+	var misbehaviors []*Misbehavior
+	for _, m := range globalObject.Misbehaviors() {
+		if m.Address != addr && m.Round >= minRound {
+			misbehaviors = append(misbehaviors, m)
+		}
+	}
+	misbehaviors = append(misbehaviors, &Misbehavior{Address: addr, Round: round})
+	globalObject.setMisbehaviors(misbehaviors)
+}
+
 func (s *StateDB) GodAddressInvites() uint16 {
 	return s.GetOrNewGlobalObject().GodAddressInvites()
 }
@@ -632,6 +697,9 @@ func (s *StateDB) deleteStateDelayedOfflinePenaltyObject(stateObject *stateDelay
 
 // Retrieve a state account given my the address. Returns nil if not found.
 func (s *StateDB) getStateAccount(addr common.Address) (stateObject *stateAccount) {
+	if s.tracer != nil {
+		s.tracer.StateRead(TracedAccount, addr, nil)
+	}
 	// Prefer 'live' objects.
 	s.lock.Lock()
 	if obj := s.stateAccounts[addr]; obj != nil {
@@ -660,6 +728,9 @@ func (s *StateDB) getStateAccount(addr common.Address) (stateObject *stateAccoun
 
 // Retrieve a state account given my the address. Returns nil if not found.
 func (s *StateDB) getStateIdentity(addr common.Address) (stateObject *stateIdentity) {
+	if s.tracer != nil {
+		s.tracer.StateRead(TracedIdentity, addr, nil)
+	}
 	// Prefer 'live' objects.
 	s.lock.Lock()
 	if obj := s.stateIdentities[addr]; obj != nil {
@@ -882,18 +953,24 @@ func (s *StateDB) GetOrNewDelayedOfflinePenaltyObject() *stateDelayedOfflinePena
 // state object cache iteration to find a handful of modified ones.
 func (s *StateDB) MarkStateAccountObjectDirty(addr common.Address) {
 	s.lock.Lock()
-	defer s.lock.Unlock()
-
 	s.stateAccountsDirty[addr] = struct{}{}
+	s.lock.Unlock()
+
+	if s.tracer != nil {
+		s.tracer.StateWritten(TracedAccount, addr, nil, nil)
+	}
 }
 
 // MarkStateAccountObjectDirty adds the specified object to the dirty map to avoid costly
 // state object cache iteration to find a handful of modified ones.
 func (s *StateDB) MarkStateIdentityObjectDirty(addr common.Address) {
 	s.lock.Lock()
-	defer s.lock.Unlock()
-
 	s.stateIdentitiesDirty[addr] = struct{}{}
+	s.lock.Unlock()
+
+	if s.tracer != nil {
+		s.tracer.StateWritten(TracedIdentity, addr, nil, nil)
+	}
 }
 
 // MarkStateAccountObjectDirty adds the specified object to the dirty map to avoid costly
@@ -1116,12 +1193,22 @@ func (s *StateDB) AccountExists(address common.Address) bool {
 	return s.getStateAccount(address) != nil
 }
 
+// VerifyTree checks integrity of the state tree saved at the given height
+func (s *StateDB) VerifyTree(height uint64, root common.Hash) (*TreeReport, error) {
+	return verifyTree(s.db, height, root)
+}
+
 func (s *StateDB) Root() common.Hash {
 	return s.tree.WorkingHash()
 }
 
 func (s *StateDB) IterateIdentities(fn func(key []byte, value []byte) bool) bool {
-	start := StateDbKeys.IdentityKey(common.MinAddr)
+	return s.IterateIdentitiesFrom(common.MinAddr, fn)
+}
+
+// IterateIdentitiesFrom iterates identities in the order of addresses starting from the given address inclusive
+func (s *StateDB) IterateIdentitiesFrom(from common.Address, fn func(key []byte, value []byte) bool) bool {
+	start := StateDbKeys.IdentityKey(from)
 	end := StateDbKeys.IdentityKey(common.MaxAddr)
 	return s.tree.GetImmutable().IterateRange(start, end, true, fn)
 }
@@ -1293,6 +1380,11 @@ func (s *StateDB) SetPredefinedGlobal(state *models.ProtoPredefinedState) {
 	stateObject.data.EmptyBlocksBits = common.BigIntOrNil(state.Global.EmptyBlocksBits)
 	stateObject.data.GodAddressInvites = uint16(state.Global.GodAddressInvites)
 	stateObject.data.BlocksCntWithoutCeremonialTxs = byte(state.Global.BlocksCntWithoutCeremonialTxs)
+	stateObject.data.BlockGasLimit = state.Global.BlockGasLimit
+	stateObject.data.Misbehaviors = misbehaviorsFromProto(state.Global.Misbehaviors)
+	stateObject.data.MinProposerThreshold = state.Global.MinProposerThreshold
+	stateObject.data.WordsDictionary = wordsDictionaryFromProto(state.Global.WordsDictionary)
+	stateObject.data.NextWordsDictionary = wordsDictionaryFromProto(state.Global.NextWordsDictionary)
 }
 
 func (s *StateDB) SetPredefinedStatusSwitch(state *models.ProtoPredefinedState) {
@@ -1359,6 +1451,10 @@ func (s *StateDB) SetPredefinedIdentities(state *models.ProtoPredefinedState) {
 			addr := common.BytesToAddress(identity.Delegatee)
 			stateObject.data.Delegatee = &addr
 		}
+		if identity.AnswersSubmitter != nil {
+			addr := common.BytesToAddress(identity.AnswersSubmitter)
+			stateObject.data.AnswersSubmitter = &addr
+		}
 		for _, item := range identity.Invitees {
 			stateObject.data.Invitees = append(stateObject.data.Invitees, TxAddr{
 				TxHash:  common.BytesToHash(item.Hash),
@@ -1416,9 +1512,15 @@ func (s *StateDB) SetContractValue(addr common.Address, key []byte, value []byte
 		value:   value,
 		removed: false,
 	}
+	if s.tracer != nil {
+		s.tracer.StateWritten(TracedContractValue, addr, key, value)
+	}
 }
 
 func (s *StateDB) GetContractValue(addr common.Address, key []byte) []byte {
+	if s.tracer != nil {
+		s.tracer.StateRead(TracedContractValue, addr, key)
+	}
 
 	storeKey := StateDbKeys.ContractStoreKey(addr, key)
 
@@ -1437,6 +1539,9 @@ func (s *StateDB) RemoveContractValue(addr common.Address, key []byte) {
 		value:   nil,
 		removed: true,
 	}
+	if s.tracer != nil {
+		s.tracer.StateWritten(TracedContractValue, addr, key, nil)
+	}
 }
 
 func (s *StateDB) IterateContractStore(addr common.Address, minKey []byte, maxKey []byte, f func(key []byte, value []byte) bool) {
@@ -1512,6 +1617,101 @@ func (s *StateDB) SetContractStake(addr common.Address, stake *big.Int) {
 	contract.SetContractStake(stake)
 }
 
+func (s *StateDB) GetContractStorageSize(addr common.Address) uint64 {
+	stateObject := s.getStateAccount(addr)
+	if stateObject != nil && stateObject.data.Contract != nil {
+		return stateObject.data.Contract.StorageSize
+	}
+	return 0
+}
+
+func (s *StateDB) SetContractStorageSize(addr common.Address, size uint64) {
+	contract := s.GetOrNewAccountObject(addr)
+	contract.SetContractStorageSize(size)
+}
+
+func (s *StateDB) GetContractUnpaidRentEpochs(addr common.Address) uint16 {
+	stateObject := s.getStateAccount(addr)
+	if stateObject != nil && stateObject.data.Contract != nil {
+		return stateObject.data.Contract.UnpaidRentEpochs
+	}
+	return 0
+}
+
+func (s *StateDB) SetContractUnpaidRentEpochs(addr common.Address, epochs uint16) {
+	contract := s.GetOrNewAccountObject(addr)
+	contract.SetContractUnpaidRentEpochs(epochs)
+}
+
+// AddStakeLock locks amount of the locker on the account, the amount is expected to be already withdrawn from the locker
+func (s *StateDB) AddStakeLock(addr common.Address, locker common.Address, amount *big.Int, startEpoch uint16, epochs uint16) {
+	stateObject := s.GetOrNewAccountObject(addr)
+	locks := make([]*StakeLock, 0, len(stateObject.data.StakeLocks)+1)
+	locks = append(locks, stateObject.data.StakeLocks...)
+	locks = append(locks, &StakeLock{
+		Amount:     new(big.Int).Set(amount),
+		StartEpoch: startEpoch,
+		Epochs:     epochs,
+		Locker:     locker,
+	})
+	stateObject.setStakeLocks(locks)
+}
+
+func (s *StateDB) GetStakeLocks(addr common.Address) []*StakeLock {
+	stateObject := s.getStateAccount(addr)
+	if stateObject != nil {
+		return stateObject.data.StakeLocks
+	}
+	return nil
+}
+
+// GetLockedStake returns the total amount of account locks which is not released yet
+func (s *StateDB) GetLockedStake(addr common.Address) *big.Int {
+	locked := big.NewInt(0)
+	for _, lock := range s.GetStakeLocks(addr) {
+		locked.Add(locked, lock.Locked())
+	}
+	return locked
+}
+
+// GetReleasableStake returns the amount of account locks which can be released in the given epoch
+func (s *StateDB) GetReleasableStake(addr common.Address, epoch uint16) *big.Int {
+	releasable := big.NewInt(0)
+	for _, lock := range s.GetStakeLocks(addr) {
+		releasable.Add(releasable, lock.Releasable(epoch))
+	}
+	return releasable
+}
+
+// ReleaseStake moves unlocked portions of account locks to the balance and drops fully released locks,
+// returns the released amount
+func (s *StateDB) ReleaseStake(addr common.Address, epoch uint16) *big.Int {
+	released := big.NewInt(0)
+	stateObject := s.getStateAccount(addr)
+	if stateObject == nil || len(stateObject.data.StakeLocks) == 0 {
+		return released
+	}
+	var locks []*StakeLock
+	for _, lock := range stateObject.data.StakeLocks {
+		releasable := lock.Releasable(epoch)
+		released.Add(released, releasable)
+		unlocked := new(big.Int).Add(lock.UnlockedOrZero(), releasable)
+		if unlocked.Cmp(lock.Amount) >= 0 {
+			continue
+		}
+		locks = append(locks, &StakeLock{
+			Amount:     lock.Amount,
+			Unlocked:   unlocked,
+			StartEpoch: lock.StartEpoch,
+			Epochs:     lock.Epochs,
+			Locker:     lock.Locker,
+		})
+	}
+	stateObject.setStakeLocks(locks)
+	stateObject.AddBalance(released)
+	return released
+}
+
 func (s *StateDB) Delegations() []*Delegation {
 	return s.GetOrNewDelegationSwitchObject().data.Delegations
 }
@@ -1532,6 +1732,18 @@ func (s *StateDB) Delegatee(addr common.Address) *common.Address {
 	return s.GetOrNewIdentityObject(addr).Delegatee()
 }
 
+func (s *StateDB) SetAnswersSubmitter(addr common.Address, submitter common.Address) {
+	s.GetOrNewIdentityObject(addr).SetAnswersSubmitter(submitter)
+}
+
+func (s *StateDB) RemoveAnswersSubmitter(addr common.Address) {
+	s.GetOrNewIdentityObject(addr).RemoveAnswersSubmitter()
+}
+
+func (s *StateDB) AnswersSubmitter(addr common.Address) *common.Address {
+	return s.GetOrNewIdentityObject(addr).AnswersSubmitter()
+}
+
 func (s *StateDB) SetDelegationEpoch(addr common.Address, epoch uint16) {
 	s.GetOrNewIdentityObject(addr).SetDelegationEpoch(epoch)
 }
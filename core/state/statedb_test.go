@@ -15,8 +15,8 @@ import (
 	"time"
 )
 
-func createDb(name string) *database.BackedMemDb {
-	db, _ := db.NewGoLevelDB(name, "datadir")
+func createDb(t *testing.T, name string) *database.BackedMemDb {
+	db, _ := db.NewGoLevelDB(name, t.TempDir())
 	return database.NewBackedMemDb(db)
 }
 
@@ -37,8 +37,8 @@ func TestStateDB_Version(t *testing.T) {
 func TestStateDB_CheckForkValidation(t *testing.T) {
 
 	require := require.New(t)
-	db := createDb("CheckForkValidation")
-	db2 := createDb("CheckForkValidation2")
+	db := createDb(t, "CheckForkValidation")
+	db2 := createDb(t, "CheckForkValidation2")
 
 	stateDb, _ := NewLazy(db)
 	stateDb2, _ := NewLazy(db2)
@@ -177,6 +177,44 @@ func TestStateDB_AddBalance(t *testing.T) {
 	require.Equal(t, balance, fromDb.Balance())
 }
 
+func TestStateDB_StakeLocks(t *testing.T) {
+	database := db.NewMemDB()
+	stateDb, _ := NewLazy(database)
+
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	stateDb.AddStakeLock(addr, common.Address{0x1}, big.NewInt(100), 5, 3)
+	stateDb.AddStakeLock(addr, common.Address{0x2}, big.NewInt(10), 6, 1)
+	stateDb.Commit(false)
+	stateDb.Clear()
+
+	locks := stateDb.GetStakeLocks(addr)
+	require.Len(t, locks, 2)
+	require.Equal(t, common.Address{0x1}, locks[0].Locker)
+	require.Equal(t, big.NewInt(0), locks[0].UnlockedAt(4))
+	require.Equal(t, big.NewInt(33), locks[0].UnlockedAt(5))
+	require.Equal(t, big.NewInt(66), locks[0].UnlockedAt(6))
+	require.Equal(t, big.NewInt(100), locks[0].UnlockedAt(7))
+	require.Equal(t, big.NewInt(100), locks[0].UnlockedAt(100))
+
+	require.Equal(t, big.NewInt(33), stateDb.ReleaseStake(addr, 5))
+	require.Equal(t, big.NewInt(43), stateDb.GetReleasableStake(addr, 6))
+	require.Equal(t, big.NewInt(77), stateDb.GetLockedStake(addr))
+	require.Equal(t, big.NewInt(43), stateDb.ReleaseStake(addr, 6))
+	require.Len(t, stateDb.GetStakeLocks(addr), 1)
+	require.Equal(t, common.Address{0x1}, stateDb.GetStakeLocks(addr)[0].Locker)
+
+	stateDb.Commit(false)
+	stateDb.Clear()
+
+	require.Equal(t, big.NewInt(76), stateDb.GetBalance(addr))
+	require.Equal(t, big.NewInt(34), stateDb.GetLockedStake(addr))
+	require.Equal(t, big.NewInt(34), stateDb.ReleaseStake(addr, 7))
+	require.Empty(t, stateDb.GetStakeLocks(addr))
+	require.Equal(t, big.NewInt(110), stateDb.GetBalance(addr))
+}
+
 func TestStateDB_GetOrNewIdentityObject(t *testing.T) {
 	database := db.NewMemDB()
 	stateDb, _ := NewLazy(database)
@@ -486,3 +524,36 @@ func TestStateDB_IterateContractStore(t *testing.T) {
 	require.Equal(t, len(addr1Values), len(iterated))
 	require.Equal(t, addr1Values, iterated)
 }
+
+func TestStateDB_AddMisbehavior(t *testing.T) {
+	require := require.New(t)
+	database := db.NewMemDB()
+	stateDb, _ := NewLazy(database)
+
+	addr1, addr2 := common.Address{0x1}, common.Address{0x2}
+
+	_, ok := stateDb.LastMisbehaviorRound(addr1)
+	require.False(ok)
+
+	stateDb.AddMisbehavior(addr1, 10, 0)
+	stateDb.AddMisbehavior(addr2, 15, 0)
+	stateDb.AddMisbehavior(addr1, 12, 0)
+	stateDb.Commit(true)
+
+	stateDb2, _ := NewLazy(database)
+	require.NoError(stateDb2.Load(1))
+	round, ok := stateDb2.LastMisbehaviorRound(addr1)
+	require.True(ok)
+	require.Equal(uint64(12), round)
+	require.Len(stateDb2.GetOrNewGlobalObject().Misbehaviors(), 2)
+
+	// outdated records are dropped
+	stateDb2.AddMisbehavior(addr1, 20, 13)
+	_, ok = stateDb2.LastMisbehaviorRound(addr2)
+	require.True(ok)
+	stateDb2.AddMisbehavior(addr1, 21, 16)
+	_, ok = stateDb2.LastMisbehaviorRound(addr2)
+	require.False(ok)
+	round, _ = stateDb2.LastMisbehaviorRound(addr1)
+	require.Equal(uint64(21), round)
+}
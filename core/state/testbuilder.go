@@ -0,0 +1,151 @@
+package state
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+)
+
+type testIdentity struct {
+	state     IdentityState
+	online    bool
+	delegatee *common.Address
+	stake     *big.Int
+}
+
+// TestStateBuilder constructs consistent account and identity states with arbitrary identity
+// distributions (newbies, verified, suspended, zombies, pools) for integration tests
+type TestStateBuilder struct {
+	godAddress common.Address
+	epoch      uint16
+	addresses  []common.Address
+	identities map[common.Address]*testIdentity
+	keys       map[common.Address]*ecdsa.PrivateKey
+}
+
+func NewTestStateBuilder() *TestStateBuilder {
+	return &TestStateBuilder{
+		identities: make(map[common.Address]*testIdentity),
+		keys:       make(map[common.Address]*ecdsa.PrivateKey),
+	}
+}
+
+func (b *TestStateBuilder) SetGodAddress(godAddress common.Address) *TestStateBuilder {
+	b.godAddress = godAddress
+	return b
+}
+
+func (b *TestStateBuilder) SetEpoch(epoch uint16) *TestStateBuilder {
+	b.epoch = epoch
+	return b
+}
+
+func (b *TestStateBuilder) getOrNew(addr common.Address) *testIdentity {
+	identity, ok := b.identities[addr]
+	if !ok {
+		identity = &testIdentity{}
+		b.identities[addr] = identity
+		b.addresses = append(b.addresses, addr)
+	}
+	return identity
+}
+
+// AddIdentity adds identity with given address and state, the address is not required to have a private key
+func (b *TestStateBuilder) AddIdentity(addr common.Address, state IdentityState, online bool) *TestStateBuilder {
+	identity := b.getOrNew(addr)
+	identity.state = state
+	identity.online = online
+	return b
+}
+
+// AddIdentities generates count identities with given state and returns their addresses,
+// private keys are available via Key
+func (b *TestStateBuilder) AddIdentities(state IdentityState, count int, online bool) []common.Address {
+	var result []common.Address
+	for i := 0; i < count; i++ {
+		key, _ := crypto.GenerateKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		b.keys[addr] = key
+		b.AddIdentity(addr, state, online)
+		result = append(result, addr)
+	}
+	return result
+}
+
+// AddPool generates count offline identities with given state delegated to the pool and marks the pool online,
+// the pool itself is not added as identity
+func (b *TestStateBuilder) AddPool(pool common.Address, state IdentityState, count int) []common.Address {
+	delegators := b.AddIdentities(state, count, false)
+	for _, addr := range delegators {
+		b.SetDelegatee(addr, pool)
+	}
+	b.SetOnline(pool, true)
+	return delegators
+}
+
+func (b *TestStateBuilder) SetDelegatee(addr common.Address, delegatee common.Address) *TestStateBuilder {
+	b.getOrNew(addr).delegatee = &delegatee
+	return b
+}
+
+func (b *TestStateBuilder) SetOnline(addr common.Address, online bool) *TestStateBuilder {
+	b.getOrNew(addr).online = online
+	return b
+}
+
+func (b *TestStateBuilder) SetStake(addr common.Address, stake *big.Int) *TestStateBuilder {
+	b.getOrNew(addr).stake = stake
+	return b
+}
+
+func (b *TestStateBuilder) Key(addr common.Address) *ecdsa.PrivateKey {
+	return b.keys[addr]
+}
+
+// Build writes the distribution into db and commits both trees at version 1.
+// Only newbies, verified and humans are added to the identity state, like it is done during epoch switching.
+func (b *TestStateBuilder) Build(db dbm.DB) (*StateDB, *IdentityStateDB, error) {
+	stateDb, err := NewLazy(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	identityStateDb, err := NewLazyIdentityState(db)
+	if err != nil {
+		return nil, nil, err
+	}
+	stateDb.SetGodAddress(b.godAddress)
+	stateDb.SetGlobalEpoch(b.epoch)
+
+	for _, addr := range b.addresses {
+		identity := b.identities[addr]
+		if identity.state != Undefined {
+			stateDb.SetState(addr, identity.state)
+		}
+		if identity.stake != nil {
+			stateDb.AddStake(addr, identity.stake)
+		}
+		approved := identity.state.NewbieOrBetter()
+		if approved {
+			identityStateDb.Add(addr)
+		}
+		if identity.online {
+			identityStateDb.SetOnline(addr, true)
+		}
+		if identity.delegatee != nil {
+			stateDb.SetDelegatee(addr, *identity.delegatee)
+			if approved {
+				identityStateDb.SetDelegatee(addr, *identity.delegatee)
+			}
+		}
+	}
+	if _, _, _, err := stateDb.Commit(true); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to commit state")
+	}
+	if _, _, _, err := identityStateDb.Commit(false); err != nil {
+		return nil, nil, errors.Wrap(err, "failed to commit identity state")
+	}
+	return stateDb, identityStateDb, nil
+}
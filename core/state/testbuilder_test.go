@@ -0,0 +1,50 @@
+package state
+
+import (
+	"github.com/idena-network/idena-go/common"
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+	"math/big"
+	"testing"
+)
+
+func TestTestStateBuilder_Build(t *testing.T) {
+	require := require.New(t)
+	god := common.Address{0x1}
+	pool := common.Address{0x2}
+
+	builder := NewTestStateBuilder().SetGodAddress(god).SetEpoch(3)
+	newbies := builder.AddIdentities(Newbie, 2, true)
+	verified := builder.AddIdentities(Verified, 3, false)
+	suspended := builder.AddIdentities(Suspended, 2, false)
+	zombies := builder.AddIdentities(Zombie, 1, false)
+	delegators := builder.AddPool(pool, Human, 2)
+	builder.SetStake(verified[0], big.NewInt(100))
+
+	stateDb, identityStateDb, err := builder.Build(db.NewMemDB())
+	require.NoError(err)
+
+	require.Equal(god, stateDb.GodAddress())
+	require.Equal(uint16(3), stateDb.Epoch())
+	require.Equal(big.NewInt(100), stateDb.GetStakeBalance(verified[0]))
+	require.NotNil(builder.Key(newbies[0]))
+
+	for _, addr := range append(newbies, verified...) {
+		require.True(identityStateDb.IsApproved(addr))
+	}
+	require.True(identityStateDb.IsOnline(newbies[0]))
+	require.False(identityStateDb.IsOnline(verified[0]))
+
+	for _, addr := range append(suspended, zombies...) {
+		require.False(identityStateDb.IsApproved(addr))
+		require.True(stateDb.GetIdentityState(addr) == Suspended || stateDb.GetIdentityState(addr) == Zombie)
+	}
+
+	require.False(identityStateDb.IsApproved(pool))
+	require.True(identityStateDb.IsOnline(pool))
+	for _, addr := range delegators {
+		require.Equal(Human, stateDb.GetIdentityState(addr))
+		require.Equal(pool, *identityStateDb.Delegatee(addr))
+		require.Equal(pool, *stateDb.Delegatee(addr))
+	}
+}
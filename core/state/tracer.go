@@ -0,0 +1,35 @@
+package state
+
+import "github.com/idena-network/idena-go/common"
+
+type TracedObject byte
+
+const (
+	TracedAccount       TracedObject = 1
+	TracedIdentity      TracedObject = 2
+	TracedContractValue TracedObject = 3
+)
+
+func (o TracedObject) String() string {
+	switch o {
+	case TracedAccount:
+		return "account"
+	case TracedIdentity:
+		return "identity"
+	case TracedContractValue:
+		return "contractValue"
+	}
+	return "unknown"
+}
+
+// StateTracer is notified about reads and writes of state objects, key and value are set for contract values only.
+// It is used for block tracing and must not access the state it is set to.
+type StateTracer interface {
+	StateRead(object TracedObject, addr common.Address, key []byte)
+	StateWritten(object TracedObject, addr common.Address, key []byte, value []byte)
+}
+
+// SetTracer sets the tracer of state accesses, nil disables tracing
+func (s *StateDB) SetTracer(tracer StateTracer) {
+	s.tracer = tracer
+}
@@ -106,3 +106,50 @@ func TestMutableTree_LoadVersion(t *testing.T) {
 
 	require.Equal(t, hash, tree.WorkingHash())
 }
+
+func TestVerifyTree(t *testing.T) {
+	db := dbm.NewMemDB()
+	tree := NewMutableTree(db)
+	for i := byte(0); i < 10; i++ {
+		tree.Set([]byte{i}, []byte{i})
+	}
+	tree.SaveVersion()
+	tree.Set([]byte{0x1}, []byte{0x2})
+	tree.Remove([]byte{0x5})
+	tree.SaveVersion()
+	root := tree.WorkingHash()
+
+	report, err := verifyTree(db, 2, root)
+	require.NoError(t, err)
+	require.True(t, report.Valid)
+	require.Equal(t, 9, report.Leaves)
+	require.Empty(t, report.MissingNodes)
+	require.Empty(t, report.OrphanNodes)
+	require.Zero(t, report.DanglingOrphanRecords)
+	require.NotZero(t, report.OrphanRecords)
+
+	report, err = verifyTree(db, 2, common.Hash{0x1})
+	require.NoError(t, err)
+	require.False(t, report.Valid)
+
+	unreferenced := common.Hash{0x1}
+	require.NoError(t, db.Set(append([]byte{'n'}, unreferenced.Bytes()...), []byte{0x1}))
+	report, err = verifyTree(db, 2, root)
+	require.NoError(t, err)
+	require.True(t, report.Valid)
+	require.Equal(t, [][]byte{unreferenced.Bytes()}, report.OrphanNodes)
+
+	// a node which is used by the first version only
+	it, err := dbm.IteratePrefix(db, []byte{'o'})
+	require.NoError(t, err)
+	require.True(t, it.Valid())
+	orphaned := common.CopyBytes(it.Key()[17:])
+	it.Close()
+	require.NoError(t, db.Delete(append([]byte{'n'}, orphaned...)))
+
+	report, err = verifyTree(db, 2, root)
+	require.NoError(t, err)
+	require.False(t, report.Valid)
+	require.Equal(t, [][]byte{orphaned}, report.MissingNodes)
+	require.Equal(t, 1, report.DanglingOrphanRecords)
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"encoding/binary"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
@@ -138,3 +139,162 @@ func ReadTreeFrom(pdb dbm.DB, height uint64, root common.Hash, from io.Reader) e
 	}
 	return nil
 }
+
+type TreeReport struct {
+	Version       int64
+	Root          common.Hash
+	Leaves        int
+	StoredNodes   int
+	OrphanRecords int
+	// MissingNodes are nodes referenced by a saved version root or by a parent node but absent in db
+	MissingNodes [][]byte
+	// OrphanNodes are stored nodes which are not reachable from any saved version root
+	OrphanNodes [][]byte
+	// DanglingOrphanRecords are iavl orphan records which point to absent nodes
+	DanglingOrphanRecords int
+	Valid                 bool
+}
+
+var (
+	treeNodePrefix   = []byte{'n'}
+	treeOrphanPrefix = []byte{'o'}
+	treeRootPrefix   = []byte{'r'}
+)
+
+// verifyTree loads a tree version from db and checks its root and all node hashes.
+// Then it counts references to every stored node starting from roots of all saved versions,
+// a tree is valid only if none of the referenced nodes is missing.
+func verifyTree(db dbm.DB, height uint64, root common.Hash) (*TreeReport, error) {
+	report := &TreeReport{Version: int64(height)}
+	tree := NewMutableTree(db)
+	if _, err := tree.LoadVersion(int64(height)); err != nil {
+		return report, errors.Wrapf(err, "failed to load tree version %v", height)
+	}
+	report.Root = tree.WorkingHash()
+	report.Valid = report.Root == root && tree.ValidateTree()
+	tree.GetImmutable().Iterate(func(key []byte, value []byte) bool {
+		report.Leaves++
+		return false
+	})
+	if err := countTreeReferences(db, report); err != nil {
+		return report, err
+	}
+	report.Valid = report.Valid && len(report.MissingNodes) == 0
+	return report, nil
+}
+
+// countTreeReferences walks nodes of all saved versions and fills missing and orphan nodes of the report.
+// Subtrees shared between versions are walked once, so memory usage is proportional to the number of stored nodes.
+func countTreeReferences(db dbm.DB, report *TreeReport) error {
+	refs := make(map[string]int)
+	var stack [][]byte
+
+	it, err := dbm.IteratePrefix(db, treeRootPrefix)
+	if err != nil {
+		return err
+	}
+	for ; it.Valid(); it.Next() {
+		// empty trees are saved with an empty root hash
+		if len(it.Value()) > 0 {
+			stack = append(stack, common.CopyBytes(it.Value()))
+		}
+	}
+	it.Close()
+
+	for len(stack) > 0 {
+		hash := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		refs[string(hash)]++
+		if refs[string(hash)] > 1 {
+			continue
+		}
+		data, err := db.Get(append(common.CopyBytes(treeNodePrefix), hash...))
+		if err != nil {
+			return err
+		}
+		if data == nil {
+			report.MissingNodes = append(report.MissingNodes, hash)
+			continue
+		}
+		left, right, err := decodeTreeNodeChildren(data)
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode node %x", hash)
+		}
+		if left != nil {
+			stack = append(stack, left, right)
+		}
+	}
+
+	if it, err = dbm.IteratePrefix(db, treeNodePrefix); err != nil {
+		return err
+	}
+	for ; it.Valid(); it.Next() {
+		report.StoredNodes++
+		hash := it.Key()[len(treeNodePrefix):]
+		if refs[string(hash)] == 0 {
+			report.OrphanNodes = append(report.OrphanNodes, common.CopyBytes(hash))
+		}
+	}
+	it.Close()
+
+	if it, err = dbm.IteratePrefix(db, treeOrphanPrefix); err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		report.OrphanRecords++
+		// o<last-version><first-version><hash>
+		key := it.Key()
+		if len(key) < len(treeOrphanPrefix)+16 {
+			report.DanglingOrphanRecords++
+			continue
+		}
+		has, err := db.Has(append(common.CopyBytes(treeNodePrefix), key[len(treeOrphanPrefix)+16:]...))
+		if err != nil {
+			return err
+		}
+		if !has {
+			report.DanglingOrphanRecords++
+		}
+	}
+	return nil
+}
+
+// decodeTreeNodeChildren returns hashes of child nodes of an encoded iavl node, leaf nodes have no children
+func decodeTreeNodeChildren(buf []byte) (left []byte, right []byte, err error) {
+	// height, size and version
+	var height int64
+	for i := 0; i < 3; i++ {
+		v, n := binary.Varint(buf)
+		if n <= 0 {
+			return nil, nil, errors.New("invalid varint")
+		}
+		if i == 0 {
+			height = v
+		}
+		buf = buf[n:]
+	}
+	// key
+	if _, buf, err = decodeTreeNodeBytes(buf); err != nil {
+		return nil, nil, err
+	}
+	if height == 0 {
+		return nil, nil, nil
+	}
+	if left, buf, err = decodeTreeNodeBytes(buf); err != nil {
+		return nil, nil, err
+	}
+	if right, _, err = decodeTreeNodeBytes(buf); err != nil {
+		return nil, nil, err
+	}
+	return common.CopyBytes(left), common.CopyBytes(right), nil
+}
+
+func decodeTreeNodeBytes(buf []byte) ([]byte, []byte, error) {
+	size, n := binary.Uvarint(buf)
+	if n <= 0 || size > uint64(len(buf)-n) {
+		return nil, nil, errors.New("invalid bytes length")
+	}
+	end := n + int(size)
+	return buf[n:end], buf[end:], nil
+}
@@ -0,0 +1,173 @@
+package training
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/pkg/errors"
+)
+
+var (
+	NoTrainingFlips   = errors.New("there are no flips of the previous validation to practice")
+	SessionNotStarted = errors.New("training session is not started")
+	SessionIsOver     = errors.New("training session is over")
+	UnknownFlip       = errors.New("flip does not belong to the training session")
+	AnswersSubmitted  = errors.New("answers have been already submitted")
+)
+
+// FlipSource provides the stored flips of the previous validation
+type FlipSource interface {
+	ReadTrainingFlipCids() [][]byte
+	ReadTrainingFlip(cid []byte) *types.TrainingFlip
+}
+
+// Simulator runs offline short sessions on flips of the previous validation, it does not affect the node state
+type Simulator struct {
+	source   FlipSource
+	duration func() time.Duration
+	mutex    sync.Mutex
+	session  *session
+	now      func() time.Time
+}
+
+type session struct {
+	startTime time.Time
+	cids      [][]byte
+	flips     map[string]*types.TrainingFlip
+	submitted bool
+}
+
+type SessionInfo struct {
+	StartTime  time.Time
+	Duration   time.Duration
+	FlipsCount int
+}
+
+type FlipResult struct {
+	Cid           []byte
+	Answer        types.Answer
+	CorrectAnswer types.Answer
+	Grade         types.Grade
+	Epoch         uint16
+}
+
+type SessionResult struct {
+	Correct int
+	Total   int
+	Score   float32
+	Flips   []FlipResult
+}
+
+func NewSimulator(source FlipSource, duration func() time.Duration) *Simulator {
+	return &Simulator{
+		source:   source,
+		duration: duration,
+		now:      time.Now,
+	}
+}
+
+// Start begins a new training session with up to count random flips, the previous session is discarded
+func (s *Simulator) Start(count int) (*SessionInfo, error) {
+	if count <= 0 {
+		count = int(common.ShortSessionFlipsCount())
+	}
+	cids := s.source.ReadTrainingFlipCids()
+	rand.Shuffle(len(cids), func(i, j int) {
+		cids[i], cids[j] = cids[j], cids[i]
+	})
+	sess := &session{
+		startTime: s.now(),
+		flips:     make(map[string]*types.TrainingFlip),
+	}
+	for _, cid := range cids {
+		if len(sess.cids) >= count {
+			break
+		}
+		flip := s.source.ReadTrainingFlip(cid)
+		if flip == nil {
+			continue
+		}
+		sess.cids = append(sess.cids, cid)
+		sess.flips[string(cid)] = flip
+	}
+	if len(sess.cids) == 0 {
+		return nil, NoTrainingFlips
+	}
+	s.mutex.Lock()
+	s.session = sess
+	s.mutex.Unlock()
+	return &SessionInfo{
+		StartTime:  sess.startTime,
+		Duration:   s.duration(),
+		FlipsCount: len(sess.cids),
+	}, nil
+}
+
+// Hashes returns cids of flips of the active training session
+func (s *Simulator) Hashes() ([][]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.checkActiveSession(); err != nil {
+		return nil, err
+	}
+	return s.session.cids, nil
+}
+
+// Get returns the flip of the active training session
+func (s *Simulator) Get(cid []byte) (*types.TrainingFlip, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.checkActiveSession(); err != nil {
+		return nil, err
+	}
+	flip, ok := s.session.flips[string(cid)]
+	if !ok {
+		return nil, UnknownFlip
+	}
+	return flip, nil
+}
+
+// SubmitAnswers finishes the active training session and compares answers with the consensus ones
+func (s *Simulator) SubmitAnswers(answers map[string]types.Answer) (*SessionResult, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err := s.checkActiveSession(); err != nil {
+		return nil, err
+	}
+	s.session.submitted = true
+	result := &SessionResult{
+		Total: len(s.session.cids),
+	}
+	for _, cid := range s.session.cids {
+		flip := s.session.flips[string(cid)]
+		answer := answers[string(cid)]
+		if answer == flip.Answer {
+			result.Correct++
+		}
+		result.Flips = append(result.Flips, FlipResult{
+			Cid:           cid,
+			Answer:        answer,
+			CorrectAnswer: flip.Answer,
+			Grade:         flip.Grade,
+			Epoch:         flip.Epoch,
+		})
+	}
+	result.Score = float32(result.Correct) / float32(result.Total)
+	return result, nil
+}
+
+func (s *Simulator) checkActiveSession() error {
+	if s.session == nil {
+		return SessionNotStarted
+	}
+	if s.session.submitted {
+		return AnswersSubmitted
+	}
+	if s.now().After(s.session.startTime.Add(s.duration())) {
+		return SessionIsOver
+	}
+	return nil
+}
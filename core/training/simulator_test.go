@@ -0,0 +1,83 @@
+package training
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+type memorySource map[string]*types.TrainingFlip
+
+func (m memorySource) ReadTrainingFlipCids() [][]byte {
+	var cids [][]byte
+	for cid := range m {
+		cids = append(cids, []byte(cid))
+	}
+	return cids
+}
+
+func (m memorySource) ReadTrainingFlip(cid []byte) *types.TrainingFlip {
+	return m[string(cid)]
+}
+
+func TestSimulator(t *testing.T) {
+	source := memorySource{
+		"cid1": {Epoch: 5, Answer: types.Left, Grade: types.GradeA},
+		"cid2": {Epoch: 5, Answer: types.Right, Grade: types.GradeB},
+		"cid3": {Epoch: 5, Answer: types.Left, Grade: types.GradeC},
+	}
+	now := time.Unix(1000, 0)
+	simulator := NewSimulator(source, func() time.Duration { return time.Minute })
+	simulator.now = func() time.Time { return now }
+
+	_, err := simulator.Hashes()
+	require.Equal(t, SessionNotStarted, err)
+
+	info, err := simulator.Start(2)
+	require.NoError(t, err)
+	require.Equal(t, 2, info.FlipsCount)
+	require.Equal(t, time.Minute, info.Duration)
+
+	cids, err := simulator.Hashes()
+	require.NoError(t, err)
+	require.Len(t, cids, 2)
+
+	flip, err := simulator.Get(cids[0])
+	require.NoError(t, err)
+	require.Equal(t, source[string(cids[0])], flip)
+
+	_, err = simulator.Get([]byte("unknown"))
+	require.Equal(t, UnknownFlip, err)
+
+	result, err := simulator.SubmitAnswers(map[string]types.Answer{
+		string(cids[0]): source[string(cids[0])].Answer,
+		string(cids[1]): types.None,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Total)
+	require.Equal(t, 1, result.Correct)
+	require.Equal(t, float32(0.5), result.Score)
+	require.Equal(t, types.None, result.Flips[1].Answer)
+	require.Equal(t, source[string(cids[1])].Answer, result.Flips[1].CorrectAnswer)
+
+	_, err = simulator.SubmitAnswers(nil)
+	require.Equal(t, AnswersSubmitted, err)
+
+	_, err = simulator.Start(0)
+	require.NoError(t, err)
+	cids, err = simulator.Hashes()
+	require.NoError(t, err)
+	require.Len(t, cids, 3)
+
+	now = now.Add(time.Minute + time.Second)
+	_, err = simulator.Hashes()
+	require.Equal(t, SessionIsOver, err)
+}
+
+func TestSimulator_NoFlips(t *testing.T) {
+	simulator := NewSimulator(memorySource{}, func() time.Duration { return time.Minute })
+	_, err := simulator.Start(5)
+	require.Equal(t, NoTrainingFlips, err)
+}
@@ -13,7 +13,7 @@ import (
 	"time"
 )
 
-const TargetVersion = config.ConsensusV5
+var TargetVersion = config.ConsensusV5
 
 type Upgrader struct {
 	config    *config.Config
@@ -22,6 +22,7 @@ type ValidatorsCache struct {
 
 	pools       map[common.Address]*sortedAddresses
 	delegations map[common.Address]common.Address
+	blsKeys     map[common.Address][]byte
 
 	nodesSet       mapset.Set
 	onlineNodesSet mapset.Set
@@ -40,6 +41,7 @@ func NewValidatorsCache(identityState *state.IdentityStateDB, godAddress common.
 		god:            godAddress,
 		pools:          map[common.Address]*sortedAddresses{},
 		delegations:    map[common.Address]common.Address{},
+		blsKeys:        map[common.Address][]byte{},
 	}
 }
 
@@ -151,6 +153,7 @@ func (v *ValidatorsCache) loadValidNodes() {
 	v.onlineNodesSet.Clear()
 	v.delegations = map[common.Address]common.Address{}
 	v.pools = map[common.Address]*sortedAddresses{}
+	v.blsKeys = map[common.Address][]byte{}
 	var delegators []common.Address
 
 	v.identityState.IterateIdentities(func(key []byte, value []byte) bool {
@@ -182,6 +185,9 @@ func (v *ValidatorsCache) loadValidNodes() {
 		if data.Approved {
 			v.nodesSet.Add(addr)
 		}
+		if len(data.BlsPubKey) > 0 {
+			v.blsKeys[addr] = data.BlsPubKey
+		}
 
 		return false
 	})
@@ -214,6 +220,7 @@ func (v *ValidatorsCache) Clone() *ValidatorsCache {
 		onlineNodesSet:   v.onlineNodesSet.Clone(),
 		pools:            clonePools(v.pools),
 		delegations:      cloneDelegations(v.delegations),
+		blsKeys:          v.blsKeys,
 	}
 }
 
@@ -268,6 +275,11 @@ func (v *ValidatorsCache) Delegator(addr common.Address) common.Address {
 	return v.delegations[addr]
 }
 
+// BlsPubKey returns the registered BLS public key of the validator, nil if the key is not registered
+func (v *ValidatorsCache) BlsPubKey(addr common.Address) []byte {
+	return v.blsKeys[addr]
+}
+
 func sortValidNodes(nodes []common.Address) []common.Address {
 	sort.SliceStable(nodes, func(i, j int) bool {
 		return bytes.Compare(nodes[i][:], nodes[j][:]) > 0
@@ -301,6 +313,18 @@ func (sv *StepValidators) Contains(addr common.Address) bool {
 	return sv.Addresses.Contains(addr)
 }
 
+// SortedAddresses returns the step committee sorted in ascending order
+func (sv *StepValidators) SortedAddresses() []common.Address {
+	result := make([]common.Address, 0, sv.Addresses.Cardinality())
+	for _, item := range sv.Addresses.ToSlice() {
+		result = append(result, item.(common.Address))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return bytes.Compare(result[i][:], result[j][:]) < 0
+	})
+	return result
+}
+
 func (sv *StepValidators) VotesCountSubtrahend(agreementThreshold float64) int {
 	v := sv.Original.Cardinality() - sv.Size
 	return int(math2.Round(float64(v) * agreementThreshold))
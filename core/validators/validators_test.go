@@ -51,38 +51,20 @@ func TestValidatorsCache_Contains(t *testing.T) {
 func TestValidatorsCache_Load(t *testing.T) {
 	require := require.New(t)
 	database := db.NewMemDB()
-	identityStateDB, _ := state.NewLazyIdentityState(database)
-
-	countAll := 12
 
 	pool1 := common.Address{1}
 	pool2 := common.Address{2}
 	pool3 := common.Address{3}
 
-	for j := 0; j < countAll; j++ {
-		key, _ := crypto.GenerateKey()
-		addr := crypto.PubkeyToAddress(key.PublicKey)
-
-		obj := identityStateDB.GetOrNewIdentityObject(addr)
-		obj.SetState(true)
-		obj.SetOnline(true)
-		if j%3 == 0 { // identities 0,3,6,9
-			obj.SetDelegatee(pool1)
-			obj.SetOnline(false)
-		} else if j%4 == 0 { // identities 4,8
-			obj.SetDelegatee(pool2)
-			obj.SetOnline(false)
-		} else if j%11 == 0 { // identities 11
-			obj.SetDelegatee(pool3)
-			obj.SetOnline(false)
-		}
-	}
-	identityStateDB.SetOnline(pool1, true)
-	identityStateDB.SetOnline(pool2, true)
-	identityStateDB.Add(pool2)
-	identityStateDB.SetOnline(pool3, false)
-
-	identityStateDB.Commit(false)
+	builder := state.NewTestStateBuilder()
+	builder.AddIdentities(state.Verified, 5, true)
+	builder.AddPool(pool1, state.Verified, 4)
+	builder.AddPool(pool2, state.Verified, 2)
+	builder.AddIdentity(pool2, state.Verified, true)
+	builder.AddPool(pool3, state.Verified, 1)
+	builder.SetOnline(pool3, false)
+	_, identityStateDB, err := builder.Build(database)
+	require.NoError(err)
 
 	vCache := NewValidatorsCache(identityStateDB, common.Address{0x11})
 	vCache.Load()
@@ -0,0 +1,217 @@
+// Package bls implements BLS signatures over the bn256 curve with signatures in G1 and public keys in G2,
+// signatures of the same or different messages can be aggregated into a single one.
+//
+// The implementation is not reviewed and is not enabled by any consensus version, it is to be replaced
+// by a vetted BLS12-381 library with the standard hash-to-curve before block certificates rely on it.
+package bls
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto/bn256/cloudflare"
+	"github.com/idena-network/idena-go/crypto/sha3"
+	"math/big"
+)
+
+const (
+	SignatureLength = 64
+	PublicKeyLength = 128
+)
+
+var (
+	signatureDomain = []byte("idena-bls-sig")
+	proofDomain     = []byte("idena-bls-pop")
+	keyDomain       = []byte("idena-bls-key")
+
+	ErrInvalidPublicKey = errors.New("invalid bls public key")
+	ErrInvalidSignature = errors.New("invalid bls signature")
+
+	g2Generator = new(bn256.G2).ScalarBaseMult(big.NewInt(1))
+	curveB      = big.NewInt(3)
+	sqrtExp     = new(big.Int).Div(new(big.Int).Add(bn256.P, big.NewInt(1)), big.NewInt(4))
+)
+
+type PrivateKey struct {
+	x *big.Int
+}
+
+// GenerateKey derives the private key from the seed, the same seed always gives the same key
+func GenerateKey(seed []byte) *PrivateKey {
+	for counter := uint32(0); ; counter++ {
+		x := new(big.Int).SetBytes(hash(keyDomain, seed, counter))
+		x.Mod(x, bn256.Order)
+		if x.Sign() > 0 {
+			return &PrivateKey{x: x}
+		}
+	}
+}
+
+// PublicKey returns the marshalled public key
+func (k *PrivateKey) PublicKey() []byte {
+	return new(bn256.G2).ScalarBaseMult(k.x).Marshal()
+}
+
+func (k *PrivateKey) Sign(msg []byte) []byte {
+	return new(bn256.G1).ScalarMult(hashToG1(signatureDomain, msg), k.x).Marshal()
+}
+
+// Proof returns the proof of possession of the private key which prevents rogue key attacks on aggregated signatures
+func (k *PrivateKey) Proof() []byte {
+	return new(bn256.G1).ScalarMult(hashToG1(proofDomain, k.PublicKey()), k.x).Marshal()
+}
+
+func parsePublicKey(data []byte) (*bn256.G2, error) {
+	if len(data) != PublicKeyLength || bytes.Equal(data, make([]byte, PublicKeyLength)) {
+		return nil, ErrInvalidPublicKey
+	}
+	key := new(bn256.G2)
+	if _, err := key.Unmarshal(data); err != nil {
+		return nil, ErrInvalidPublicKey
+	}
+	// the twist has a non-trivial cofactor, only points of the prime order subgroup are accepted
+	if !bytes.Equal(new(bn256.G2).ScalarMult(key, bn256.Order).Marshal(), make([]byte, PublicKeyLength)) {
+		return nil, ErrInvalidPublicKey
+	}
+	return key, nil
+}
+
+func parseSignature(data []byte) (*bn256.G1, error) {
+	if len(data) != SignatureLength || bytes.Equal(data, make([]byte, SignatureLength)) {
+		return nil, ErrInvalidSignature
+	}
+	sig := new(bn256.G1)
+	if _, err := sig.Unmarshal(data); err != nil {
+		return nil, ErrInvalidSignature
+	}
+	return sig, nil
+}
+
+// ValidatePublicKey checks that the data is a valid public key
+func ValidatePublicKey(pubKey []byte) error {
+	_, err := parsePublicKey(pubKey)
+	return err
+}
+
+func Verify(pubKey []byte, msg []byte, signature []byte) bool {
+	return VerifyAggregate([][]byte{msg}, [][]byte{pubKey}, signature)
+}
+
+// VerifyProof checks the proof of possession of the private key of the public key
+func VerifyProof(pubKey []byte, proof []byte) bool {
+	key, err := parsePublicKey(pubKey)
+	if err != nil {
+		return false
+	}
+	sig, err := parseSignature(proof)
+	if err != nil {
+		return false
+	}
+	return verify(sig, []*bn256.G1{hashToG1(proofDomain, pubKey)}, []*bn256.G2{key})
+}
+
+// AggregateSignatures returns the signature which is valid for all messages signed by the aggregated signatures
+func AggregateSignatures(signatures [][]byte) ([]byte, error) {
+	if len(signatures) == 0 {
+		return nil, ErrInvalidSignature
+	}
+	var result *bn256.G1
+	for _, item := range signatures {
+		sig, err := parseSignature(item)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = sig
+		} else {
+			result.Add(result, sig)
+		}
+	}
+	return result.Marshal(), nil
+}
+
+// AggregatePublicKeys returns the public key which verifies the aggregated signature of the same message
+func AggregatePublicKeys(pubKeys [][]byte) ([]byte, error) {
+	if len(pubKeys) == 0 {
+		return nil, ErrInvalidPublicKey
+	}
+	var result *bn256.G2
+	for _, item := range pubKeys {
+		key, err := parsePublicKey(item)
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = key
+		} else {
+			result.Add(result, key)
+		}
+	}
+	return result.Marshal(), nil
+}
+
+// VerifyAggregate checks the aggregated signature of the distinct messages, the i-th message is verified
+// with the i-th (aggregated) public key, public keys must have proofs of possession checked
+func VerifyAggregate(msgs [][]byte, pubKeys [][]byte, signature []byte) bool {
+	if len(msgs) == 0 || len(msgs) != len(pubKeys) {
+		return false
+	}
+	sig, err := parseSignature(signature)
+	if err != nil {
+		return false
+	}
+	hashes := make([]*bn256.G1, 0, len(msgs))
+	keys := make([]*bn256.G2, 0, len(pubKeys))
+	for i := range msgs {
+		key, err := parsePublicKey(pubKeys[i])
+		if err != nil {
+			return false
+		}
+		hashes = append(hashes, hashToG1(signatureDomain, msgs[i]))
+		keys = append(keys, key)
+	}
+	return verify(sig, hashes, keys)
+}
+
+// verify checks e(sig, g2) == e(h1, k1) * ... * e(hn, kn)
+func verify(sig *bn256.G1, hashes []*bn256.G1, keys []*bn256.G2) bool {
+	a := []*bn256.G1{new(bn256.G1).Neg(sig)}
+	b := []*bn256.G2{g2Generator}
+	a = append(a, hashes...)
+	b = append(b, keys...)
+	return bn256.PairingCheck(a, b)
+}
+
+// hashToG1 maps the message to a curve point with the try-and-increment method, G1 has cofactor 1,
+// so every curve point belongs to the group. The method is not constant-time, it is only applied to public data:
+// vote hashes and public keys
+func hashToG1(domain []byte, msg []byte) *bn256.G1 {
+	for counter := uint32(0); ; counter++ {
+		x := new(big.Int).SetBytes(hash(domain, msg, counter))
+		x.Mod(x, bn256.P)
+		// y^2 = x^3 + 3
+		y2 := new(big.Int).Exp(x, big.NewInt(3), bn256.P)
+		y2.Add(y2, curveB).Mod(y2, bn256.P)
+		y := new(big.Int).Exp(y2, sqrtExp, bn256.P)
+		if new(big.Int).Exp(y, big.NewInt(2), bn256.P).Cmp(y2) != 0 {
+			continue
+		}
+		data := append(common.LeftPadBytes(x.Bytes(), SignatureLength/2), common.LeftPadBytes(y.Bytes(), SignatureLength/2)...)
+		point := new(bn256.G1)
+		if _, err := point.Unmarshal(data); err != nil {
+			continue
+		}
+		return point
+	}
+}
+
+func hash(domain []byte, msg []byte, counter uint32) []byte {
+	h := sha3.NewKeccak256()
+	h.Write(domain)
+	h.Write(msg)
+	var c [4]byte
+	binary.BigEndian.PutUint32(c[:], counter)
+	h.Write(c[:])
+	return h.Sum(nil)
+}
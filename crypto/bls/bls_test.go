@@ -0,0 +1,77 @@
+package bls
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	require := require.New(t)
+	key := GenerateKey([]byte{0x1})
+	require.Equal(key.PublicKey(), GenerateKey([]byte{0x1}).PublicKey())
+	require.NotEqual(key.PublicKey(), GenerateKey([]byte{0x2}).PublicKey())
+
+	pubKey := key.PublicKey()
+	require.Len(pubKey, PublicKeyLength)
+	require.NoError(ValidatePublicKey(pubKey))
+	require.Error(ValidatePublicKey(make([]byte, PublicKeyLength)))
+	require.Error(ValidatePublicKey(pubKey[1:]))
+
+	msg := []byte("message")
+	sig := key.Sign(msg)
+	require.Len(sig, SignatureLength)
+	require.True(Verify(pubKey, msg, sig))
+	require.False(Verify(pubKey, []byte("another message"), sig))
+	require.False(Verify(GenerateKey([]byte{0x2}).PublicKey(), msg, sig))
+	require.False(Verify(pubKey, msg, make([]byte, SignatureLength)))
+}
+
+func TestProof(t *testing.T) {
+	require := require.New(t)
+	key1, key2 := GenerateKey([]byte{0x1}), GenerateKey([]byte{0x2})
+
+	require.True(VerifyProof(key1.PublicKey(), key1.Proof()))
+	require.False(VerifyProof(key2.PublicKey(), key1.Proof()))
+	// a signature of the public key is not a proof
+	require.False(VerifyProof(key1.PublicKey(), key1.Sign(key1.PublicKey())))
+}
+
+func TestVerifyAggregate(t *testing.T) {
+	require := require.New(t)
+	msg1, msg2 := []byte("message1"), []byte("message2")
+	var keys []*PrivateKey
+	for i := byte(1); i <= 5; i++ {
+		keys = append(keys, GenerateKey([]byte{i}))
+	}
+
+	// keys 0-2 sign the first message, keys 3-4 sign the second one
+	var sigs, pubKeys1, pubKeys2 [][]byte
+	for i, key := range keys {
+		if i < 3 {
+			sigs = append(sigs, key.Sign(msg1))
+			pubKeys1 = append(pubKeys1, key.PublicKey())
+		} else {
+			sigs = append(sigs, key.Sign(msg2))
+			pubKeys2 = append(pubKeys2, key.PublicKey())
+		}
+	}
+	sig, err := AggregateSignatures(sigs)
+	require.NoError(err)
+	pubKey1, err := AggregatePublicKeys(pubKeys1)
+	require.NoError(err)
+	pubKey2, err := AggregatePublicKeys(pubKeys2)
+	require.NoError(err)
+
+	require.True(VerifyAggregate([][]byte{msg1, msg2}, [][]byte{pubKey1, pubKey2}, sig))
+	require.False(VerifyAggregate([][]byte{msg2, msg1}, [][]byte{pubKey1, pubKey2}, sig))
+	require.False(VerifyAggregate([][]byte{msg1}, [][]byte{pubKey1}, sig))
+
+	// a missing signature breaks the aggregate
+	partial, _ := AggregateSignatures(sigs[1:])
+	require.False(VerifyAggregate([][]byte{msg1, msg2}, [][]byte{pubKey1, pubKey2}, partial))
+
+	_, err = AggregateSignatures(nil)
+	require.Error(err)
+	_, err = AggregatePublicKeys([][]byte{{0x1}})
+	require.Error(err)
+}
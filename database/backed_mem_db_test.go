@@ -6,15 +6,15 @@ import (
 	"testing"
 )
 
-func createDb(name string) *BackedMemDb {
+func createDb(t *testing.T, name string) *BackedMemDb {
 
-	db, _ := db.NewGoLevelDB(name, "datadir")
+	db, _ := db.NewGoLevelDB(name, t.TempDir())
 	return NewBackedMemDb(db)
 }
 
 func TestBackedMemDb_Get(t *testing.T) {
 	require := require.New(t)
-	db := createDb("get.db")
+	db := createDb(t, "get.db")
 	db.permanent.Set([]byte{0x1}, []byte{0x2})
 
 	v, _ := db.Get([]byte{0x1})
@@ -33,7 +33,7 @@ func TestBackedMemDb_Get(t *testing.T) {
 
 func TestBackedMemDb_Delete(t *testing.T) {
 	require := require.New(t)
-	db := createDb("delete.db")
+	db := createDb(t, "delete.db")
 	db.permanent.Set([]byte{0x1}, []byte{0x2})
 
 	db.Delete([]byte{0x1})
@@ -50,7 +50,7 @@ func TestBackedMemDb_Delete(t *testing.T) {
 
 func TestBackedMemDb_Iterator(t *testing.T) {
 	require := require.New(t)
-	db := createDb("iterator.db")
+	db := createDb(t, "iterator.db")
 
 	db.permanent.Set([]byte{0x1}, []byte{0x5})
 	db.permanent.Set([]byte{0x2}, []byte{0x3})
@@ -74,7 +74,7 @@ func TestBackedMemDb_Iterator(t *testing.T) {
 
 func TestBackedMemDb_Iterator2(t *testing.T) {
 	require := require.New(t)
-	db := createDb("iterator2.db")
+	db := createDb(t, "iterator2.db")
 
 	db.permanent.Set([]byte{0x1}, []byte{0x1})
 	db.permanent.Set([]byte{0x2}, []byte{0x2})
@@ -102,7 +102,7 @@ func TestBackedMemDb_Iterator2(t *testing.T) {
 
 func TestBackedMemDb_NewBatch(t *testing.T) {
 	require := require.New(t)
-	db := createDb("newBatch.db")
+	db := createDb(t, "newBatch.db")
 	db.permanent.Set([]byte{0x1}, []byte{0x1})
 	db.permanent.Set([]byte{0x2}, []byte{0x2})
 	db.permanent.Set([]byte{0x3}, []byte{0x3})
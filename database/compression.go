@@ -0,0 +1,42 @@
+package database
+
+import (
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+// compressedValueMarker starts compressed values, protobuf messages never start with a zero byte,
+// so values written before compression was introduced are read as is
+const compressedValueMarker = 0x0
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// compressValue returns the compressed value or the value itself if compression doesn't make it smaller
+func compressValue(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+	compressed := zstdEncoder.EncodeAll(data, []byte{compressedValueMarker})
+	if len(compressed) >= len(data) {
+		return data
+	}
+	return compressed
+}
+
+func decompressValue(data []byte) ([]byte, error) {
+	if !isCompressedValue(data) {
+		return data, nil
+	}
+	result, err := zstdDecoder.DecodeAll(data[1:], nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decompress value")
+	}
+	return result, nil
+}
+
+func isCompressedValue(data []byte) bool {
+	return len(data) > 0 && data[0] == compressedValueMarker
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"encoding/binary"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
@@ -23,6 +24,8 @@ var (
 	FlipCidPrefix         = []byte("cid")
 	PublicFlipKeyPrefix   = []byte("pubk")
 	PrivateFlipKeyPrefix  = []byte("pk")
+	CeremonyProgressKey   = []byte("progress")
+	OwnLongAnswerPrefix   = []byte("own-long")
 )
 
 type EpochDb struct {
@@ -137,6 +140,21 @@ func (edb *EpochDb) ReadOwnShortAnswersBits() []byte {
 	return data
 }
 
+func (edb *EpochDb) WriteOwnLongAnswer(cid []byte, answer types.Answer, grade types.Grade) {
+	assertNoError(edb.db.Set(append(OwnLongAnswerPrefix, cid...), []byte{byte(answer), byte(grade)}))
+}
+
+func (edb *EpochDb) IterateOverOwnLongAnswers(callback func(cid []byte, answer types.Answer, grade types.Grade)) {
+	it, err := edb.db.Iterator(append(OwnLongAnswerPrefix, ipfs.MinCid[:]...), append(OwnLongAnswerPrefix, ipfs.MaxCid[:]...))
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if value := it.Value(); len(value) == 2 {
+			callback(it.Key()[len(OwnLongAnswerPrefix):], types.Answer(value[0]), types.Grade(value[1]))
+		}
+	}
+}
+
 func (edb *EpochDb) WriteAnswers(short []DbAnswer, long []DbAnswer) {
 
 	toBytes := func(a []DbAnswer) ([]byte, error) {
@@ -246,8 +264,23 @@ func (edb *EpochDb) ReadLotterySeed() []byte {
 	return data
 }
 
-func (edb *EpochDb) WriteFlipCid(cid []byte) {
-	assertNoError(edb.db.Set(append(FlipCidPrefix, cid...), []byte{}))
+func (edb *EpochDb) WriteCeremonyProgress(progress byte) {
+	assertNoError(edb.db.Set(CeremonyProgressKey, []byte{progress}))
+}
+
+func (edb *EpochDb) ReadCeremonyProgress() byte {
+	data, err := edb.db.Get(CeremonyProgressKey)
+	assertNoError(err)
+	if len(data) == 0 {
+		return 0
+	}
+	return data[0]
+}
+
+// WriteFlipCid saves the flip received in the epoch with the size of its pinned data (zero if the flip is not pinned)
+// and whether it is own
+func (edb *EpochDb) WriteFlipCid(cid []byte, size int, own bool) {
+	assertNoError(edb.db.Set(append(FlipCidPrefix, cid...), encodeFlipInfo(uint32(size), own)))
 }
 
 func (edb *EpochDb) HasFlipCid(cid []byte) bool {
@@ -269,6 +302,33 @@ func (edb *EpochDb) IterateOverFlipCids(callback func(cid []byte)) {
 	}
 }
 
+// IterateOverFlips iterates over flips received in the epoch, size and ownership are zero for flips saved by older versions
+func (edb *EpochDb) IterateOverFlips(callback func(cid []byte, size uint32, own bool)) {
+	it, err := edb.db.Iterator(append(FlipCidPrefix, ipfs.MinCid[:]...), append(FlipCidPrefix, ipfs.MaxCid[:]...))
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		size, own := decodeFlipInfo(it.Value())
+		callback(it.Key()[len(FlipCidPrefix):], size, own)
+	}
+}
+
+func encodeFlipInfo(size uint32, own bool) []byte {
+	data := make([]byte, 5)
+	binary.BigEndian.PutUint32(data, size)
+	if own {
+		data[4] = 1
+	}
+	return data
+}
+
+func decodeFlipInfo(data []byte) (size uint32, own bool) {
+	if len(data) < 5 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint32(data), data[4] == 1
+}
+
 func (edb *EpochDb) HasEvidenceMap(addr common.Address) bool {
 	key := append(EvidencePrefix, addr[:]...)
 	has, err := edb.db.Has(key)
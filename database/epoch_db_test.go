@@ -1,6 +1,7 @@
 package database
 
 import (
+	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/tests"
 	"github.com/stretchr/testify/require"
@@ -42,8 +43,8 @@ func TestEpochDb_IterateOverFlipCids(t *testing.T) {
 
 	edb := NewEpochDb(mdb, 1)
 
-	edb.WriteFlipCid([]byte{0x1})
-	edb.WriteFlipCid([]byte{0x2})
+	edb.WriteFlipCid([]byte{0x1}, 0, false)
+	edb.WriteFlipCid([]byte{0x2}, 0, false)
 
 	//write trash
 	edb.WriteLotterySeed([]byte{0x3})
@@ -68,9 +69,9 @@ func TestEpochDb_Write_Read_FlipPairs(t *testing.T) {
 
 	edb := NewEpochDb(mdb, 1)
 
-	edb.WriteFlipCid([]byte{0x1})
-	edb.WriteFlipCid([]byte{0x2})
-	edb.WriteFlipCid([]byte{0x3})
+	edb.WriteFlipCid([]byte{0x1}, 0, false)
+	edb.WriteFlipCid([]byte{0x2}, 0, false)
+	edb.WriteFlipCid([]byte{0x3}, 0, false)
 
 	require.True(edb.HasFlipCid([]byte{0x1}))
 	require.True(edb.HasFlipCid([]byte{0x2}))
@@ -135,3 +136,40 @@ func TestEpochDb_HasSuccessfulOwnTx(t *testing.T) {
 	require.True(edb.HasSuccessfulOwnTx(common.Hash{0x1}))
 	require.False(edb.HasSuccessfulOwnTx(common.Hash{0x2}))
 }
+
+func TestEpochDb_CeremonyProgress(t *testing.T) {
+	require := require.New(t)
+
+	mdb := db.NewMemDB()
+
+	edb := NewEpochDb(mdb, 1)
+	require.Zero(edb.ReadCeremonyProgress())
+
+	edb.WriteCeremonyProgress(0x5)
+	require.Equal(byte(0x5), edb.ReadCeremonyProgress())
+	require.Zero(NewEpochDb(mdb, 2).ReadCeremonyProgress())
+}
+
+func TestEpochDb_OwnLongAnswers(t *testing.T) {
+	require := require.New(t)
+
+	edb := NewEpochDb(db.NewMemDB(), 1)
+	edb.WriteFlipCid([]byte{0x1}, 0, false)
+
+	read := func() map[string][2]byte {
+		result := make(map[string][2]byte)
+		edb.IterateOverOwnLongAnswers(func(cid []byte, answer types.Answer, grade types.Grade) {
+			result[string(cid)] = [2]byte{byte(answer), byte(grade)}
+		})
+		return result
+	}
+	require.Empty(read())
+
+	edb.WriteOwnLongAnswer([]byte{0x1}, types.Left, types.GradeA)
+	edb.WriteOwnLongAnswer([]byte{0x2}, types.Right, types.GradeNone)
+	edb.WriteOwnLongAnswer([]byte{0x1}, types.Right, types.GradeReported)
+	require.Equal(map[string][2]byte{
+		string([]byte{0x1}): {byte(types.Right), byte(types.GradeReported)},
+		string([]byte{0x2}): {byte(types.Right), byte(types.GradeNone)},
+	}, read())
+}
@@ -1,6 +1,7 @@
 package database
 
 import (
+	"bytes"
 	"encoding/binary"
 	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain/types"
@@ -29,6 +30,12 @@ func NewRepo(db dbm.DB) *Repo {
 	}
 }
 
+func encodeUint16Number(number uint16) []byte {
+	enc := make([]byte, 2)
+	binary.BigEndian.PutUint16(enc, number)
+	return enc
+}
+
 func encodeUint32Number(number uint32) []byte {
 	enc := make([]byte, 4)
 	binary.BigEndian.PutUint32(enc, number)
@@ -67,7 +74,7 @@ func receiptIndexKey(hash common.Hash) []byte {
 }
 
 func savedTxKey(sender common.Address, timestamp int64, nonce uint32, hash common.Hash) []byte {
-	key := append(ownTransactionIndexPrefix, sender[:]...)
+	key := append(addressTxIndexPrefix, sender[:]...)
 	key = append(key, encodeUint64Number(uint64(timestamp))...)
 	key = append(key, encodeUint32Number(nonce)...)
 	return append(key, hash[:]...)
@@ -90,6 +97,91 @@ func burntCoinsMinKey() []byte {
 	return burntCoinsKey(0, common.BytesToHash(common.MinHash[:]))
 }
 
+func balanceChangeKey(address common.Address, height uint64, idx uint32) []byte {
+	key := make([]byte, 0, len(balanceChangePrefix)+common.AddressLength+12)
+	key = append(key, balanceChangePrefix...)
+	key = append(key, address.Bytes()...)
+	key = append(key, encodeUint64Number(height)...)
+	return append(key, encodeUint32Number(idx)...)
+}
+
+func checkpointKey(epoch uint16, publisher common.Address) []byte {
+	key := append(checkpointPrefix, encodeUint16Number(epoch)...)
+	return append(key, publisher.Bytes()...)
+}
+
+func blockTimeKey(timestamp uint64, height uint64) []byte {
+	// the index is written concurrently by block import and backfilling, so the prefix is copied
+	key := make([]byte, 0, len(blockTimePrefix)+16)
+	key = append(key, blockTimePrefix...)
+	key = append(key, encodeUint64Number(timestamp)...)
+	return append(key, encodeUint64Number(height)...)
+}
+
+func epochStatsKey(height uint64) []byte {
+	return append(epochStatsPrefix, encodeUint64Number(height)...)
+}
+
+func epochSummaryKey(epoch uint16) []byte {
+	return append(epochSummaryPrefix, encodeUint16Number(epoch)...)
+}
+
+func minerPerformanceKey(epoch uint16) []byte {
+	key := make([]byte, 0, len(minerPerformancePrefix)+2)
+	key = append(key, minerPerformancePrefix...)
+	return append(key, encodeUint16Number(epoch)...)
+}
+
+func flipImageHashesKey(epoch uint16) []byte {
+	key := make([]byte, 0, len(flipImageHashesPrefix)+2)
+	key = append(key, flipImageHashesPrefix...)
+	return append(key, encodeUint16Number(epoch)...)
+}
+
+func ceremonyTimelineKey(epoch uint16) []byte {
+	key := make([]byte, 0, len(ceremonyTimelinePrefix)+2)
+	key = append(key, ceremonyTimelinePrefix...)
+	return append(key, encodeUint16Number(epoch)...)
+}
+
+func validationExplanationKey(epoch uint16, addr common.Address) []byte {
+	key := make([]byte, 0, len(validationExplanationPrefix)+2+common.AddressLength)
+	key = append(key, validationExplanationPrefix...)
+	key = append(key, encodeUint16Number(epoch)...)
+	return append(key, addr.Bytes()...)
+}
+
+func retainedFlipKey(epoch uint16, cid []byte) []byte {
+	key := make([]byte, 0, len(retainedFlipPrefix)+2+len(cid))
+	key = append(key, retainedFlipPrefix...)
+	key = append(key, encodeUint16Number(epoch)...)
+	return append(key, cid...)
+}
+
+func peerScoreKey(id []byte) []byte {
+	key := make([]byte, 0, len(peerScorePrefix)+len(id))
+	key = append(key, peerScorePrefix...)
+	return append(key, id...)
+}
+
+func trainingFlipKey(cid []byte) []byte {
+	key := make([]byte, 0, len(trainingFlipPrefix)+len(cid))
+	key = append(key, trainingFlipPrefix...)
+	return append(key, cid...)
+}
+
+func finalityCheckpointKey(height uint64) []byte {
+	key := make([]byte, 0, len(finalityCheckpointPrefix)+8)
+	key = append(key, finalityCheckpointPrefix...)
+	return append(key, encodeUint64Number(height)...)
+}
+
+func logBloomKey(height uint64) []byte {
+	key := make([]byte, 0, len(logBloomPrefix)+8)
+	key = append(key, logBloomPrefix...)
+	return append(key, encodeUint64Number(height)...)
+}
+
 func identityStateDiffKey(height uint64) []byte {
 	return append(identityStateDiffPrefix, encodeUint64Number(height)...)
 }
@@ -100,6 +192,10 @@ func (r *Repo) ReadBlockHeader(hash common.Hash) *types.Header {
 	if data == nil {
 		return nil
 	}
+	if data, err = decompressValue(data); err != nil {
+		log.Error("Invalid block header", "hash", hash, "err", err)
+		return nil
+	}
 	header := new(types.Header)
 	if err := header.FromBytes(data); err != nil {
 		log.Error("Invalid block header proto", "hash", hash, "err", err)
@@ -142,7 +238,7 @@ func (r *Repo) WriteBlockHeader(header *types.Header) {
 		log.Crit("Failed to proto encode header", "err", err)
 	}
 
-	r.db.Set(headerKey(header.Hash()), data)
+	r.db.Set(headerKey(header.Hash()), compressValue(data))
 }
 
 func (r *Repo) RemoveHeader(hash common.Hash) {
@@ -154,7 +250,7 @@ func (r *Repo) WriteCertificate(hash common.Hash, cert *types.BlockCert) {
 	if err != nil {
 		log.Crit("failed to proto encode block cert", "err", err)
 	}
-	r.db.Set(certKey(hash), data)
+	r.db.Set(certKey(hash), compressValue(data))
 }
 
 func (r *Repo) WriteCanonicalHash(height uint64, hash common.Hash) {
@@ -167,6 +263,49 @@ func (r *Repo) RemoveCanonicalHash(height uint64) {
 	r.db.Delete(key)
 }
 
+// WriteBlockTime adds the header to the timestamp index of blocks
+func (r *Repo) WriteBlockTime(header *types.Header) {
+	if header.Time() < 0 {
+		return
+	}
+	r.db.Set(blockTimeKey(uint64(header.Time()), header.Height()), header.Hash().Bytes())
+}
+
+func (r *Repo) RemoveBlockTime(header *types.Header) {
+	if header.Time() < 0 {
+		return
+	}
+	r.db.Delete(blockTimeKey(uint64(header.Time()), header.Height()))
+}
+
+func (r *Repo) HasBlockTime(header *types.Header) bool {
+	if header.Time() < 0 {
+		return false
+	}
+	has, err := r.db.Has(blockTimeKey(uint64(header.Time()), header.Height()))
+	assertNoError(err)
+	return has
+}
+
+// IterateBlocksBeforeTime iterates over indexed blocks with timestamp not greater than the given one
+// starting from the latest block until f returns true
+func (r *Repo) IterateBlocksBeforeTime(timestamp int64, f func(height uint64, hash common.Hash) bool) {
+	if timestamp < 0 {
+		return
+	}
+	// the end key is exclusive, so it is the first key of the next second
+	it, err := r.db.ReverseIterator(blockTimeKey(0, 0), blockTimeKey(uint64(timestamp)+1, 0))
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		height := binary.BigEndian.Uint64(key[len(key)-8:])
+		if f(height, common.BytesToHash(it.Value())) {
+			return
+		}
+	}
+}
+
 func (r *Repo) ReadCanonicalHash(height uint64) common.Hash {
 	key := headerHashKey(height)
 	data, err := r.db.Get(key)
@@ -216,6 +355,10 @@ func (r *Repo) ReadTxIndex(hash common.Hash) *types.TransactionIndex {
 	return index
 }
 
+func (r *Repo) RemoveTxIndex(hash common.Hash) {
+	r.db.Delete(txIndexKey(hash))
+}
+
 func (r *Repo) WriteReceiptIndex(hash common.Hash, idx *types.TxReceiptIndex) {
 	data, err := idx.ToBytes()
 	if err != nil {
@@ -240,12 +383,20 @@ func (r *Repo) ReadReceiptIndex(hash common.Hash) *types.TxReceiptIndex {
 	return index
 }
 
+func (r *Repo) RemoveReceiptIndex(hash common.Hash) {
+	r.db.Delete(receiptIndexKey(hash))
+}
+
 func (r *Repo) ReadCertificate(hash common.Hash) *types.BlockCert {
 	data, err := r.db.Get(certKey(hash))
 	assertNoError(err)
 	if data == nil {
 		return nil
 	}
+	if data, err = decompressValue(data); err != nil {
+		log.Error("Invalid block cert", "err", err)
+		return nil
+	}
 	cert := new(types.BlockCert)
 	if err := cert.FromBytes(data); err != nil {
 		log.Error("Invalid block cert proto", "err", err)
@@ -254,6 +405,22 @@ func (r *Repo) ReadCertificate(hash common.Hash) *types.BlockCert {
 	return cert
 }
 
+// CompressBlockData rewrites the header and the certificate of the block compressed if they are stored uncompressed,
+// total sizes of the records before and after are returned
+func (r *Repo) CompressBlockData(hash common.Hash) (before, after int) {
+	for _, key := range [][]byte{headerKey(hash), certKey(hash)} {
+		data, err := r.db.Get(key)
+		assertNoError(err)
+		before += len(data)
+		if len(data) > 0 && !isCompressedValue(data) {
+			data = compressValue(data)
+			r.db.Set(key, data)
+		}
+		after += len(data)
+	}
+	return before, after
+}
+
 func (r *Repo) readWeakCertificates() *models.ProtoWeakCertificates {
 	data, err := r.db.Get(weakCertificatesKey)
 	assertNoError(err)
@@ -405,35 +572,118 @@ func (r *Repo) SaveTx(address common.Address, blockHash common.Hash, timestamp i
 	r.db.Set(savedTxKey(address, timestamp, transaction.AccountNonce, transaction.Hash()), data)
 }
 
-func (r *Repo) GetSavedTxs(address common.Address, count int, token []byte) (txs []*types.SavedTransaction, nextToken []byte) {
+func (r *Repo) RemoveSavedTx(address common.Address, timestamp int64, transaction *types.Transaction) {
+	r.db.Delete(savedTxKey(address, timestamp, transaction.AccountNonce, transaction.Hash()))
+}
 
-	if token == nil {
-		token = savedTxKey(address, math.MaxInt64, uint32(math.MaxUint32), common.BytesToHash(common.MaxHash))
-	} else {
-		token = append(token, common.MaxHash...)
+// TxFilter restricts saved txs of an address, zero fields don't restrict anything
+type TxFilter struct {
+	Types map[types.TxType]struct{}
+	// FromTime and ToTime are the range of block timestamps, both bounds are inclusive
+	FromTime int64
+	ToTime   int64
+}
+
+func (f *TxFilter) matches(tx *types.Transaction) bool {
+	if f == nil || len(f.Types) == 0 {
+		return true
+	}
+	_, ok := f.Types[tx.Type]
+	return ok
+}
+
+// GetSavedTxs returns saved txs of the address from the newest to the oldest, the token is the continuation
+// returned by the previous call
+func (r *Repo) GetSavedTxs(address common.Address, count int, token []byte, filter *TxFilter) (txs []*types.SavedTransaction, nextToken []byte) {
+	start := savedTxKey(address, 0, 0, common.BytesToHash(common.MinHash[:]))
+	end := savedTxKey(address, math.MaxInt64, uint32(math.MaxUint32), common.BytesToHash(common.MaxHash))
+	if filter != nil && filter.FromTime > 0 {
+		start = savedTxKey(address, filter.FromTime, 0, common.BytesToHash(common.MinHash[:]))
+	}
+	if filter != nil && filter.ToTime > 0 {
+		end = savedTxKey(address, filter.ToTime, uint32(math.MaxUint32), common.BytesToHash(common.MaxHash))
+	}
+	if token != nil {
+		tokenEnd := append(append([]byte{}, token...), common.MaxHash...)
+		if bytes.Compare(tokenEnd, end) < 0 {
+			end = tokenEnd
+		}
+	}
+	if bytes.Compare(start, end) >= 0 {
+		return nil, nil
 	}
 
-	it, err := r.db.ReverseIterator(savedTxKey(address, 0, 0, common.BytesToHash(common.MinHash[:])), token)
+	it, err := r.db.ReverseIterator(start, end)
 	assertNoError(err)
 	defer it.Close()
 	for ; it.Valid(); it.Next() {
 		key, value := it.Key(), it.Value()
-		if len(txs) == count {
-			continuationToken := make([]byte, len(key)-common.HashLength)
-			copy(continuationToken, key[:len(key)-common.HashLength])
-			return txs, continuationToken
-		}
 		tx := new(types.SavedTransaction)
 		if err := tx.FromBytes(value); err != nil {
 			log.Error("cannot parse tx", "key", key)
 			continue
 		}
+		if !filter.matches(tx.Tx) {
+			continue
+		}
+		if len(txs) == count {
+			continuationToken := make([]byte, len(key)-common.HashLength)
+			copy(continuationToken, key[:len(key)-common.HashLength])
+			return txs, continuationToken
+		}
 		txs = append(txs, tx)
 	}
 
 	return txs, nil
 }
 
+// MigrateSavedTxs moves own txs saved under the legacy prefix to the address tx index and returns the number of moved records
+func (r *Repo) MigrateSavedTxs() int {
+	legacyKeyLength := len(legacyAddressTxIndexPrefix) + common.AddressLength + 8 + 4 + common.HashLength
+	it, err := dbm.IteratePrefix(r.db, legacyAddressTxIndexPrefix)
+	assertNoError(err)
+	var keys, values [][]byte
+	for ; it.Valid(); it.Next() {
+		if len(it.Key()) != legacyKeyLength {
+			continue
+		}
+		keys = append(keys, common.CopyBytes(it.Key()))
+		values = append(values, common.CopyBytes(it.Value()))
+	}
+	it.Close()
+	if len(keys) == 0 {
+		return 0
+	}
+	batch := r.db.NewBatch()
+	defer batch.Close()
+	for i, key := range keys {
+		newKey := append(common.CopyBytes(addressTxIndexPrefix), key[len(legacyAddressTxIndexPrefix):]...)
+		batch.Set(newKey, values[i])
+		batch.Delete(key)
+	}
+	assertNoError(batch.WriteSync())
+	return len(keys)
+}
+
+// ReadTxHistoryIndexedFrom returns the lowest height from which txs of all addresses are saved,
+// false is returned if indexing of all addresses has never been started
+func (r *Repo) ReadTxHistoryIndexedFrom() (uint64, bool) {
+	data, err := r.db.Get(txHistoryIndexedFromKey)
+	assertNoError(err)
+	if len(data) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(data), true
+}
+
+func (r *Repo) WriteTxHistoryIndexedFrom(height uint64) {
+	r.db.Set(txHistoryIndexedFromKey, encodeUint64Number(height))
+}
+
+func (r *Repo) RemoveTxHistoryIndexedFrom() {
+	r.db.Delete(txHistoryIndexedFromKey)
+}
+
 func (r *Repo) DeleteOutdatedBurntCoins(blockHeight uint64, blockRange uint64) {
 	if blockHeight <= blockRange {
 		return
@@ -461,6 +711,10 @@ func (r *Repo) SaveBurntCoins(blockHeight uint64, txHash common.Hash, address co
 	r.db.Set(burntCoinsKey(blockHeight, txHash), data)
 }
 
+func (r *Repo) RemoveBurntCoins(blockHeight uint64, txHash common.Hash) {
+	r.db.Delete(burntCoinsKey(blockHeight, txHash))
+}
+
 func (r *Repo) GetTotalBurntCoins() []*types.BurntCoins {
 	it, err := r.db.Iterator(burntCoinsMinKey(), burntCoinsKey(math.MaxUint64, common.BytesToHash(common.MaxHash[:])))
 	assertNoError(err)
@@ -531,6 +785,67 @@ func (r *Repo) GetSavedEvents(contract common.Address) (events []*types.SavedEve
 	return events
 }
 
+func (r *Repo) WriteBalanceChange(batch dbm.Batch, address common.Address, idx uint32, change *types.BalanceChange) {
+	data, err := change.ToBytes()
+	if err != nil {
+		log.Crit("failed to proto encode balance change", "err", err)
+		return
+	}
+	key := balanceChangeKey(address, change.Height, idx)
+	if batch != nil {
+		batch.Set(key, data)
+	} else {
+		r.db.Set(key, data)
+	}
+}
+
+func (r *Repo) GetBalanceChanges(address common.Address, fromHeight, toHeight uint64) []*types.BalanceChange {
+	it, err := r.db.Iterator(balanceChangeKey(address, fromHeight, 0), balanceChangeKey(address, toHeight, math2.MaxUint32))
+	assertNoError(err)
+	defer it.Close()
+	var changes []*types.BalanceChange
+	for ; it.Valid(); it.Next() {
+		change := new(types.BalanceChange)
+		if err := change.FromBytes(it.Value()); err != nil {
+			log.Error("cannot parse balance change", "key", it.Key())
+			continue
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func (r *Repo) WriteCheckpointAnnouncement(publisher common.Address, announcement *types.CheckpointAnnouncement) {
+	data, err := announcement.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode checkpoint announcement", "err", err)
+		return
+	}
+	r.db.Set(checkpointKey(announcement.Checkpoint.Epoch, publisher), data)
+}
+
+func (r *Repo) HasCheckpointAnnouncement(epoch uint16, publisher common.Address) bool {
+	has, err := r.db.Has(checkpointKey(epoch, publisher))
+	assertNoError(err)
+	return has
+}
+
+func (r *Repo) ReadCheckpointAnnouncements(epoch uint16) []*types.CheckpointAnnouncement {
+	it, err := dbm.IteratePrefix(r.db, append(checkpointPrefix, encodeUint16Number(epoch)...))
+	assertNoError(err)
+	defer it.Close()
+	var result []*types.CheckpointAnnouncement
+	for ; it.Valid(); it.Next() {
+		announcement := new(types.CheckpointAnnouncement)
+		if err := announcement.FromBytes(it.Value()); err != nil || !announcement.IsValid() {
+			log.Error("cannot parse checkpoint announcement", "key", it.Key())
+			continue
+		}
+		result = append(result, announcement)
+	}
+	return result
+}
+
 func (r *Repo) WriteIntermediateGenesis(batch dbm.Batch, height uint64) {
 	if batch != nil {
 		batch.Set(intermediateGenesisKey, common.ToBytes(height))
@@ -617,3 +932,303 @@ func (r *Repo) RemovePreliminaryIntermediateGenesis(batch dbm.Batch) {
 		r.db.Delete(preliminaryIntermediateGenesisKey)
 	}
 }
+
+func (r *Repo) WriteEpochStats(height uint64, stats *types.EpochStats) {
+	data, err := stats.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode epoch stats", "err", err)
+	}
+	r.db.Set(epochStatsKey(height), data)
+}
+
+func (r *Repo) ReadEpochStats(height uint64) *types.EpochStats {
+	data, err := r.db.Get(epochStatsKey(height))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	stats := new(types.EpochStats)
+	if err := stats.FromBytes(data); err != nil {
+		log.Error("invalid epoch stats", "err", err)
+		return nil
+	}
+	return stats
+}
+
+// RemoveEpochStats removes epoch stats of blocks in range [fromHeight;toHeight]
+func (r *Repo) RemoveEpochStats(fromHeight, toHeight uint64) {
+	for height := fromHeight; height <= toHeight && height >= fromHeight; height++ {
+		r.db.Delete(epochStatsKey(height))
+	}
+}
+
+func (r *Repo) WriteEpochSummary(summary *types.EpochSummary) {
+	data, err := summary.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode epoch summary", "err", err)
+	}
+	r.db.Set(epochSummaryKey(summary.Epoch), data)
+}
+
+func (r *Repo) ReadEpochSummary(epoch uint16) *types.EpochSummary {
+	data, err := r.db.Get(epochSummaryKey(epoch))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	summary := new(types.EpochSummary)
+	if err := summary.FromBytes(data); err != nil {
+		log.Error("invalid epoch summary", "err", err)
+		return nil
+	}
+	return summary
+}
+
+func (r *Repo) WriteMinerPerformance(performance *types.MinerPerformance) {
+	data, err := performance.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode miner performance", "err", err)
+	}
+	r.db.Set(minerPerformanceKey(performance.Epoch), data)
+}
+
+func (r *Repo) ReadMinerPerformance(epoch uint16) *types.MinerPerformance {
+	data, err := r.db.Get(minerPerformanceKey(epoch))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	performance := new(types.MinerPerformance)
+	if err := performance.FromBytes(data); err != nil {
+		log.Error("invalid miner performance", "err", err)
+		return nil
+	}
+	return performance
+}
+
+func (r *Repo) WriteFlipImageHashes(epoch uint16, hashes []uint64) {
+	data := make([]byte, 0, len(hashes)*8)
+	for _, hash := range hashes {
+		data = append(data, encodeUint64Number(hash)...)
+	}
+	r.db.Set(flipImageHashesKey(epoch), data)
+}
+
+func (r *Repo) ReadFlipImageHashes(epoch uint16) []uint64 {
+	data, err := r.db.Get(flipImageHashesKey(epoch))
+	assertNoError(err)
+	var hashes []uint64
+	for i := 0; i+8 <= len(data); i += 8 {
+		hashes = append(hashes, binary.BigEndian.Uint64(data[i:i+8]))
+	}
+	return hashes
+}
+
+func (r *Repo) DeleteFlipImageHashes(epoch uint16) {
+	r.db.Delete(flipImageHashesKey(epoch))
+}
+
+func (r *Repo) WriteCeremonyTimeline(timeline *types.CeremonyTimeline) {
+	data, err := timeline.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode ceremony timeline", "err", err)
+	}
+	r.db.Set(ceremonyTimelineKey(timeline.Epoch), data)
+}
+
+func (r *Repo) ReadCeremonyTimeline(epoch uint16) *types.CeremonyTimeline {
+	data, err := r.db.Get(ceremonyTimelineKey(epoch))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	timeline := new(types.CeremonyTimeline)
+	if err := timeline.FromBytes(data); err != nil {
+		log.Error("invalid ceremony timeline", "err", err)
+		return nil
+	}
+	return timeline
+}
+
+func (r *Repo) WriteValidationExplanation(addr common.Address, explanation *types.ValidationExplanation) {
+	data, err := explanation.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode validation explanation", "err", err)
+	}
+	r.db.Set(validationExplanationKey(explanation.Epoch, addr), data)
+}
+
+func (r *Repo) ReadValidationExplanation(epoch uint16, addr common.Address) *types.ValidationExplanation {
+	data, err := r.db.Get(validationExplanationKey(epoch, addr))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	explanation := new(types.ValidationExplanation)
+	if err := explanation.FromBytes(data); err != nil {
+		log.Error("invalid validation explanation", "err", err)
+		return nil
+	}
+	return explanation
+}
+
+func (r *Repo) WriteFinalityCheckpoint(height uint64, hash common.Hash) {
+	r.db.Set(finalityCheckpointKey(height), hash.Bytes())
+}
+
+func (r *Repo) RemoveFinalityCheckpoint(height uint64) {
+	r.db.Delete(finalityCheckpointKey(height))
+}
+
+// IterateFinalityCheckpoints iterates over finality checkpoints with height not greater than the given one
+// starting from the highest checkpoint until f returns true
+func (r *Repo) IterateFinalityCheckpoints(maxHeight uint64, f func(height uint64, hash common.Hash) bool) {
+	// the end key is exclusive
+	it, err := r.db.ReverseIterator(finalityCheckpointKey(0), append(finalityCheckpointKey(maxHeight), 0x0))
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		height := binary.BigEndian.Uint64(key[len(key)-8:])
+		if f(height, common.BytesToHash(it.Value())) {
+			return
+		}
+	}
+}
+
+func (r *Repo) WriteLogBloom(height uint64, bloom *types.LogBloom) {
+	r.db.Set(logBloomKey(height), bloom[:])
+}
+
+func (r *Repo) RemoveLogBloom(height uint64) {
+	r.db.Delete(logBloomKey(height))
+}
+
+// IterateLogBlooms iterates over blooms of blocks with contract events in the given height range (inclusive)
+// in ascending order until f returns true
+func (r *Repo) IterateLogBlooms(fromHeight, toHeight uint64, f func(height uint64, bloom *types.LogBloom) bool) {
+	// the end key is exclusive
+	it, err := r.db.Iterator(logBloomKey(fromHeight), append(logBloomKey(toHeight), 0x0))
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(value) != types.LogBloomLength {
+			continue
+		}
+		bloom := new(types.LogBloom)
+		copy(bloom[:], value)
+		if f(binary.BigEndian.Uint64(key[len(key)-8:]), bloom) {
+			return
+		}
+	}
+}
+
+// ReadLogBloomIndexedFrom returns the lowest height from which blooms of blocks are saved,
+// false is returned if the index has never been built
+func (r *Repo) ReadLogBloomIndexedFrom() (uint64, bool) {
+	data, err := r.db.Get(logBloomIndexedFromKey)
+	assertNoError(err)
+	if len(data) != 8 {
+		return 0, false
+	}
+	return binary.BigEndian.Uint64(data), true
+}
+
+func (r *Repo) WriteLogBloomIndexedFrom(height uint64) {
+	r.db.Set(logBloomIndexedFromKey, encodeUint64Number(height))
+}
+
+func (r *Repo) WriteRetainedFlip(epoch uint16, cid []byte, size uint32, own bool) {
+	r.db.Set(retainedFlipKey(epoch, cid), encodeFlipInfo(size, own))
+}
+
+func (r *Repo) DeleteRetainedFlip(epoch uint16, cid []byte) {
+	r.db.Delete(retainedFlipKey(epoch, cid))
+}
+
+// IterateOverRetainedFlips iterates over flips of past epochs kept pinned ordered by epoch
+func (r *Repo) IterateOverRetainedFlips(callback func(epoch uint16, cid []byte, size uint32, own bool)) {
+	it, err := dbm.IteratePrefix(r.db, retainedFlipPrefix)
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		key := it.Key()[len(retainedFlipPrefix):]
+		if len(key) < 2 {
+			continue
+		}
+		size, own := decodeFlipInfo(it.Value())
+		cid := make([]byte, len(key)-2)
+		copy(cid, key[2:])
+		callback(binary.BigEndian.Uint16(key[:2]), cid, size, own)
+	}
+}
+
+func (r *Repo) WriteTrainingFlip(cid []byte, flip *types.TrainingFlip) {
+	data, err := flip.ToBytes()
+	if err != nil {
+		log.Crit("failed to encode training flip", "err", err)
+	}
+	r.db.Set(trainingFlipKey(cid), data)
+}
+
+func (r *Repo) ReadTrainingFlip(cid []byte) *types.TrainingFlip {
+	data, err := r.db.Get(trainingFlipKey(cid))
+	assertNoError(err)
+	if data == nil {
+		return nil
+	}
+	flip := new(types.TrainingFlip)
+	if err := flip.FromBytes(data); err != nil {
+		log.Error("invalid training flip", "err", err)
+		return nil
+	}
+	return flip
+}
+
+func (r *Repo) ReadTrainingFlipCids() [][]byte {
+	it, err := dbm.IteratePrefix(r.db, trainingFlipPrefix)
+	assertNoError(err)
+	defer it.Close()
+	var cids [][]byte
+	for ; it.Valid(); it.Next() {
+		key := it.Key()
+		cid := make([]byte, len(key)-len(trainingFlipPrefix))
+		copy(cid, key[len(trainingFlipPrefix):])
+		cids = append(cids, cid)
+	}
+	return cids
+}
+
+func (r *Repo) DeleteTrainingFlips() {
+	for _, cid := range r.ReadTrainingFlipCids() {
+		r.db.Delete(trainingFlipKey(cid))
+	}
+}
+
+func (r *Repo) WritePeerScore(id []byte, score int32, bannedUntil int64) {
+	data := make([]byte, 12)
+	binary.BigEndian.PutUint32(data, uint32(score))
+	binary.BigEndian.PutUint64(data[4:], uint64(bannedUntil))
+	r.db.Set(peerScoreKey(id), data)
+}
+
+func (r *Repo) DeletePeerScore(id []byte) {
+	r.db.Delete(peerScoreKey(id))
+}
+
+func (r *Repo) IterateOverPeerScores(callback func(id []byte, score int32, bannedUntil int64)) {
+	it, err := dbm.IteratePrefix(r.db, peerScorePrefix)
+	assertNoError(err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		value := it.Value()
+		if len(value) != 12 {
+			continue
+		}
+		key := it.Key()
+		id := make([]byte, len(key)-len(peerScorePrefix))
+		copy(id, key[len(peerScorePrefix):])
+		callback(id, int32(binary.BigEndian.Uint32(value)), int64(binary.BigEndian.Uint64(value[4:])))
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/idena-network/idena-go/common"
 	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tm-db"
+	"math"
+	"math/big"
 	"testing"
 	"time"
 )
@@ -122,3 +124,243 @@ func TestRepo_GetSavedEvents(t *testing.T) {
 	require.Equal(t, "ZZZZZZZZZZZZZZZ ZZZZZZZZZZZZZZZZZZ", events2[2].Event)
 
 }
+
+func TestRepo_GetBalanceChanges(t *testing.T) {
+	database := db.NewMemDB()
+	repo := NewRepo(database)
+
+	addr := common.Address{0x1}
+	other := common.Address{0x2}
+	txHash := getRandHash()
+
+	for height := uint64(1); height <= 5; height++ {
+		repo.WriteBalanceChange(nil, addr, 0, &types.BalanceChange{
+			Height:     height,
+			Reason:     types.TxReason,
+			TxHash:     &txHash,
+			BalanceOld: big.NewInt(int64(height)),
+			BalanceNew: big.NewInt(int64(height + 1)),
+		})
+		repo.WriteBalanceChange(nil, addr, 1, &types.BalanceChange{
+			Height: height,
+			Reason: types.FeeReason,
+		})
+		repo.WriteBalanceChange(nil, other, 0, &types.BalanceChange{
+			Height: height,
+			Reason: types.BlockRewardReason,
+		})
+	}
+
+	require := require.New(t)
+	changes := repo.GetBalanceChanges(addr, 2, 3)
+	require.Len(changes, 4)
+	require.Equal(uint64(2), changes[0].Height)
+	require.Equal(types.TxReason, changes[0].Reason)
+	require.Equal(txHash, *changes[0].TxHash)
+	require.Equal(big.NewInt(3), changes[0].BalanceNew)
+	require.Equal(types.FeeReason, changes[1].Reason)
+	require.Nil(changes[1].TxHash)
+	require.Equal(uint64(3), changes[3].Height)
+
+	require.Len(repo.GetBalanceChanges(other, 0, 10), 5)
+	require.Empty(repo.GetBalanceChanges(common.Address{0x3}, 0, 10))
+}
+
+func TestRepo_MigrateSavedTxs(t *testing.T) {
+	database := db.NewMemDB()
+	repo := NewRepo(database)
+
+	addr := common.Address{0x1}
+	tx := &types.Transaction{AccountNonce: 2, Type: types.SendTx, To: &addr}
+	savedTx := &types.SavedTransaction{Tx: tx, Timestamp: 10}
+	data, _ := savedTx.ToBytes()
+	hash := tx.Hash()
+	legacyKey := append(common.CopyBytes(legacyAddressTxIndexPrefix), addr.Bytes()...)
+	legacyKey = append(legacyKey, encodeUint64Number(10)...)
+	legacyKey = append(legacyKey, encodeUint32Number(2)...)
+	legacyKey = append(legacyKey, hash[:]...)
+	database.Set(legacyKey, data)
+
+	require := require.New(t)
+	require.Equal(1, repo.MigrateSavedTxs())
+	require.Zero(repo.MigrateSavedTxs())
+
+	txs, _ := repo.GetSavedTxs(addr, 10, nil, nil)
+	require.Len(txs, 1)
+	require.Equal(hash, txs[0].Tx.Hash())
+}
+
+func TestRepo_IterateBlocksBeforeTime(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	for height := uint64(1); height <= 5; height++ {
+		repo.WriteBlockTime(&types.Header{
+			ProposedHeader: &types.ProposedHeader{Height: height, Time: int64(height * 20)},
+		})
+	}
+
+	blocksBefore := func(timestamp int64) []uint64 {
+		var heights []uint64
+		repo.IterateBlocksBeforeTime(timestamp, func(height uint64, hash common.Hash) bool {
+			heights = append(heights, height)
+			return false
+		})
+		return heights
+	}
+	require.Equal(t, []uint64{3, 2, 1}, blocksBefore(60))
+	require.Equal(t, []uint64{3, 2, 1}, blocksBefore(79))
+	require.Equal(t, []uint64{5, 4, 3, 2, 1}, blocksBefore(1000))
+	require.Empty(t, blocksBefore(19))
+	require.Empty(t, blocksBefore(-1))
+
+	header := &types.Header{ProposedHeader: &types.ProposedHeader{Height: 3, Time: 60}}
+	require.True(t, repo.HasBlockTime(header))
+	repo.RemoveBlockTime(header)
+	require.False(t, repo.HasBlockTime(header))
+	require.Equal(t, []uint64{2, 1}, blocksBefore(60))
+}
+
+func TestRepo_CompressBlockData(t *testing.T) {
+	require := require.New(t)
+	database := db.NewMemDB()
+	repo := NewRepo(database)
+
+	header := &types.Header{
+		ProposedHeader: &types.ProposedHeader{Height: 2, Time: 40, TxBloom: make([]byte, 1000)},
+	}
+	cert := &types.BlockCert{Round: 2, VotedHash: header.Hash()}
+	for i := 0; i < 10; i++ {
+		cert.Signatures = append(cert.Signatures, &types.BlockCertSignature{Signature: make([]byte, 65)})
+	}
+
+	// records written before compression was introduced are read as is
+	headerData, _ := header.ToBytes()
+	certData, _ := cert.ToBytes()
+	database.Set(headerKey(header.Hash()), headerData)
+	database.Set(certKey(header.Hash()), certData)
+	require.Equal(header.Hash(), repo.ReadBlockHeader(header.Hash()).Hash())
+	require.Len(repo.ReadCertificate(header.Hash()).Signatures, 10)
+
+	before, after := repo.CompressBlockData(header.Hash())
+	require.Equal(len(headerData)+len(certData), before)
+	require.True(after < before)
+	stored, _ := database.Get(headerKey(header.Hash()))
+	require.True(isCompressedValue(stored))
+	require.Equal(header.Hash(), repo.ReadBlockHeader(header.Hash()).Hash())
+	require.Len(repo.ReadCertificate(header.Hash()).Signatures, 10)
+
+	// compressed records are not rewritten
+	before2, after2 := repo.CompressBlockData(header.Hash())
+	require.Equal(after, before2)
+	require.Equal(after, after2)
+
+	// new records are written compressed
+	header2 := &types.Header{
+		ProposedHeader: &types.ProposedHeader{Height: 3, Time: 60, TxBloom: make([]byte, 1000)},
+	}
+	repo.WriteBlockHeader(header2)
+	stored, _ = database.Get(headerKey(header2.Hash()))
+	require.True(isCompressedValue(stored))
+	require.Equal(header2.Hash(), repo.ReadBlockHeader(header2.Hash()).Hash())
+}
+
+func TestRepo_IterateFinalityCheckpoints(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	for height := uint64(100); height <= 500; height += 100 {
+		repo.WriteFinalityCheckpoint(height, common.Hash{byte(height / 100)})
+	}
+
+	checkpointsBefore := func(maxHeight uint64) []uint64 {
+		var heights []uint64
+		repo.IterateFinalityCheckpoints(maxHeight, func(height uint64, hash common.Hash) bool {
+			require.Equal(t, common.Hash{byte(height / 100)}, hash)
+			heights = append(heights, height)
+			return false
+		})
+		return heights
+	}
+	require.Equal(t, []uint64{300, 200, 100}, checkpointsBefore(300))
+	require.Equal(t, []uint64{300, 200, 100}, checkpointsBefore(399))
+	require.Equal(t, []uint64{500, 400, 300, 200, 100}, checkpointsBefore(math.MaxUint64))
+	require.Empty(t, checkpointsBefore(99))
+
+	repo.RemoveFinalityCheckpoint(300)
+	require.Equal(t, []uint64{200, 100}, checkpointsBefore(300))
+}
+
+func TestRepo_IterateLogBlooms(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	for height := uint64(10); height <= 50; height += 10 {
+		bloom := new(types.LogBloom)
+		bloom.Add([]byte{byte(height)})
+		repo.WriteLogBloom(height, bloom)
+	}
+
+	bloomsInRange := func(from, to uint64) []uint64 {
+		var heights []uint64
+		repo.IterateLogBlooms(from, to, func(height uint64, bloom *types.LogBloom) bool {
+			require.True(t, bloom.Test([]byte{byte(height)}))
+			heights = append(heights, height)
+			return false
+		})
+		return heights
+	}
+	require.Equal(t, []uint64{20, 30, 40}, bloomsInRange(20, 40))
+	require.Equal(t, []uint64{20, 30, 40}, bloomsInRange(11, 49))
+	require.Equal(t, []uint64{10, 20, 30, 40, 50}, bloomsInRange(0, math.MaxUint64))
+	require.Empty(t, bloomsInRange(51, 100))
+
+	repo.RemoveLogBloom(30)
+	require.Equal(t, []uint64{20, 40}, bloomsInRange(20, 40))
+
+	_, ok := repo.ReadLogBloomIndexedFrom()
+	require.False(t, ok)
+	repo.WriteLogBloomIndexedFrom(5)
+	from, ok := repo.ReadLogBloomIndexedFrom()
+	require.True(t, ok)
+	require.Equal(t, uint64(5), from)
+}
+
+func TestRepo_IterateOverRetainedFlips(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	repo.WriteRetainedFlip(3, []byte{0x1, 0x2}, 100, true)
+	repo.WriteRetainedFlip(2, []byte{0x3}, 200, false)
+	repo.WriteRetainedFlip(2, []byte{0x4}, 300, false)
+	repo.DeleteRetainedFlip(2, []byte{0x4})
+
+	type flip struct {
+		epoch uint16
+		cid   []byte
+		size  uint32
+		own   bool
+	}
+	var flips []flip
+	repo.IterateOverRetainedFlips(func(epoch uint16, cid []byte, size uint32, own bool) {
+		flips = append(flips, flip{epoch, cid, size, own})
+	})
+	require.Equal(t, []flip{
+		{2, []byte{0x3}, 200, false},
+		{3, []byte{0x1, 0x2}, 100, true},
+	}, flips)
+}
+
+func TestRepo_IterateOverPeerScores(t *testing.T) {
+	repo := NewRepo(db.NewMemDB())
+	repo.WritePeerScore([]byte("peer1"), -120, 1600000000)
+	repo.WritePeerScore([]byte("peer2"), 15, 0)
+	repo.WritePeerScore([]byte("peer3"), 5, 0)
+	repo.DeletePeerScore([]byte("peer3"))
+
+	type score struct {
+		id          string
+		score       int32
+		bannedUntil int64
+	}
+	var scores []score
+	repo.IterateOverPeerScores(func(id []byte, value int32, bannedUntil int64) {
+		scores = append(scores, score{string(id), value, bannedUntil})
+	})
+	require.Equal(t, []score{
+		{"peer1", -120, 1600000000},
+		{"peer2", 15, 0},
+	}, scores)
+}
@@ -16,7 +16,11 @@ var (
 
 	receiptIndexPrefix = []byte("ri")
 
-	ownTransactionIndexPrefix = []byte("oti")
+	addressTxIndexPrefix = []byte("addr-tx") // addressTxIndexPrefix + address + timestamp (uint64 big endian) + nonce (uint32 big endian) + hash -> saved tx
+
+	legacyAddressTxIndexPrefix = []byte("oti") // own txs saved before the tx history of all addresses, records are moved to addressTxIndexPrefix on start
+
+	txHistoryIndexedFromKey = []byte("tx-history-from") // lowest height from which txs of all addresses are in the address tx index
 
 	burntCoinsPrefix = []byte("bc")
 
@@ -45,4 +49,34 @@ var (
 	consensusVersionKey = []byte("v")
 
 	preliminaryConsVersionKey = []byte("pv")
+
+	balanceChangePrefix = []byte("balance-change") // balanceChangePrefix + address + num (uint64 big endian) + idx (uint32 big endian) -> change
+
+	checkpointPrefix = []byte("cpt") // checkpointPrefix + epoch (uint16 big endian) + publisher -> announcement
+
+	blockTimePrefix = []byte("bt") // blockTimePrefix + timestamp (uint64 big endian) + num (uint64 big endian) -> hash
+
+	epochStatsPrefix = []byte("epoch-stats") // epochStatsPrefix + num (uint64 big endian) -> totals of the epoch up to the block
+
+	epochSummaryPrefix = []byte("epoch-summary") // epochSummaryPrefix + epoch (uint16 big endian) -> summary
+
+	finalityCheckpointPrefix = []byte("finality") // finalityCheckpointPrefix + num (uint64 big endian) -> hash
+
+	minerPerformancePrefix = []byte("miner-perf") // minerPerformancePrefix + epoch (uint16 big endian) -> duties of the node identity
+
+	flipImageHashesPrefix = []byte("flip-img") // flipImageHashesPrefix + epoch (uint16 big endian) -> perceptual hashes of flip images seen in the epoch
+
+	ceremonyTimelinePrefix = []byte("ceremony-tl") // ceremonyTimelinePrefix + epoch (uint16 big endian) -> phases of the validation ceremony reached by the node
+
+	validationExplanationPrefix = []byte("val-expl") // validationExplanationPrefix + epoch (uint16 big endian) + address -> data used to determine the new state of the identity
+
+	peerScorePrefix = []byte("peer-score") // peerScorePrefix + peer id -> score (int32 big endian) + ban expiration (unix seconds, int64 big endian)
+
+	trainingFlipPrefix = []byte("train-flip") // trainingFlipPrefix + cid -> decrypted flip of the last validation with its answer
+
+	retainedFlipPrefix = []byte("ret-flip") // retainedFlipPrefix + epoch (uint16 big endian) + cid -> size and ownership of the flip kept pinned after its epoch
+
+	logBloomPrefix = []byte("log-bloom") // logBloomPrefix + num (uint64 big endian) -> bloom of contract events of the block
+
+	logBloomIndexedFromKey = []byte("log-bloom-from") // lowest height from which blocks with contract events are in the log bloom index
 )
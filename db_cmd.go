@@ -0,0 +1,182 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/node"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+var (
+	// repairFlag does not fetch missing tree nodes from peers, state nodes are not requested by hash in the protocol.
+	// The chain is reset to the latest saved state which passes verification instead, so the blocks above it are
+	// downloaded and applied again during the regular sync on next start.
+	repairFlag = cli.BoolFlag{
+		Name:  "repair",
+		Usage: "Reset chain to the latest valid saved state, blocks above it will be synced from peers on next start",
+	}
+
+	dbCommand = cli.Command{
+		Name:  "db",
+		Usage: "Low level database operations",
+		Subcommands: []cli.Command{
+			{
+				Name:   "verify-state",
+				Usage:  "Verify roots, node hashes and node references of account and identity state trees at the head block",
+				Flags:  []cli.Flag{repairFlag},
+				Action: verifyState,
+			},
+			{
+				Name:   "compress-blocks",
+				Usage:  "Compress block headers and certificates written by previous versions of the node",
+				Action: compressBlocks,
+			},
+		},
+	}
+)
+
+func readCommandConfig(ctx *cli.Context) (*config.Config, error) {
+	cfg, err := config.MakeConfigFromFile(ctx.GlobalString(config.CfgFileFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	if ctx.GlobalIsSet(config.DataDirFlag.Name) {
+		cfg.DataDir = ctx.GlobalString(config.DataDirFlag.Name)
+	}
+	return cfg, nil
+}
+
+func verifyState(ctx *cli.Context) error {
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("head block is not found")
+	}
+	appState, err := appstate.NewAppState(db, eventbus.New())
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Checking state at height %v\n", head.Height())
+	if checkStateAt(appState, head, true) {
+		fmt.Println("State is valid")
+		return nil
+	}
+	if !ctx.Bool(repairFlag.Name) {
+		return errors.New("state is corrupted, run with --repair to reset to the latest valid state")
+	}
+
+	for h, tryCnt := head.Height()-1, 0; h >= 1 && tryCnt < state.MaxSavedStatesCount; h, tryCnt = h-1, tryCnt+1 {
+		header := readHeaderByHeight(repo, h)
+		if header == nil || !appState.IdentityState.HasVersion(h) {
+			continue
+		}
+		if !checkStateAt(appState, header, false) {
+			continue
+		}
+		if err := appState.Initialize(h); err != nil {
+			return err
+		}
+		if err := appState.ResetTo(h); err != nil {
+			return err
+		}
+		repo.SetHead(nil, h)
+		for i := h + 1; i <= head.Height(); i++ {
+			hash := repo.ReadCanonicalHash(i)
+			if hash == (common.Hash{}) {
+				continue
+			}
+			if header := repo.ReadBlockHeader(hash); header != nil {
+				repo.RemoveBlockTime(header)
+			}
+			repo.RemoveHeader(hash)
+			repo.RemoveCanonicalHash(i)
+		}
+		fmt.Printf("Chain was reset to height %v, missing blocks will be synced from peers\n", h)
+		return nil
+	}
+	return errors.New("valid state is not found, try to delete idenachain.db folder from your data directory and sync from scratch")
+}
+
+func compressBlocks(ctx *cli.Context) error {
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("head block is not found")
+	}
+	var before, after int64
+	for h := uint64(1); h <= head.Height(); h++ {
+		hash := repo.ReadCanonicalHash(h)
+		if hash == (common.Hash{}) {
+			continue
+		}
+		b, a := repo.CompressBlockData(hash)
+		before, after = before+int64(b), after+int64(a)
+		if h%100000 == 0 {
+			fmt.Printf("Compressed blocks up to height %v\n", h)
+		}
+	}
+	fmt.Printf("Blocks are compressed, size before: %v bytes, size after: %v bytes\n", before, after)
+	return nil
+}
+
+func checkStateAt(appState *appstate.AppState, header *types.Header, verbose bool) bool {
+	stateReport, stateErr := appState.State.VerifyTree(header.Height(), header.Root())
+	identityReport, identityErr := appState.IdentityState.VerifyTree(header.Height(), header.IdentityRoot())
+	if verbose {
+		printTreeReport("accounts", stateReport, header.Root(), stateErr)
+		printTreeReport("identities", identityReport, header.IdentityRoot(), identityErr)
+	}
+	return stateErr == nil && identityErr == nil && stateReport.Valid && identityReport.Valid
+}
+
+func printTreeReport(name string, report *state.TreeReport, expectedRoot common.Hash, err error) {
+	if err != nil {
+		fmt.Printf("%v: %v\n", name, err)
+		return
+	}
+	fmt.Printf("%v: version=%v root=%v expected=%v leaves=%v nodes=%v missingNodes=%v orphanNodes=%v orphanRecords=%v danglingOrphanRecords=%v valid=%v\n",
+		name, report.Version, report.Root.Hex(), expectedRoot.Hex(), report.Leaves, report.StoredNodes, len(report.MissingNodes),
+		len(report.OrphanNodes), report.OrphanRecords, report.DanglingOrphanRecords, report.Valid)
+	for _, hash := range report.MissingNodes {
+		fmt.Printf("%v: missing node %x\n", name, hash)
+	}
+	for _, hash := range report.OrphanNodes {
+		fmt.Printf("%v: orphan node %x\n", name, hash)
+	}
+}
+
+func readHeaderByHeight(repo *database.Repo, height uint64) *types.Header {
+	hash := repo.ReadCanonicalHash(height)
+	if hash == (common.Hash{}) {
+		return nil
+	}
+	return repo.ReadBlockHeader(hash)
+}
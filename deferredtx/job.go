@@ -9,6 +9,7 @@ import (
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/mempool"
+	"github.com/idena-network/idena-go/crypto"
 	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/keystore"
 	"github.com/idena-network/idena-go/log"
@@ -16,12 +17,14 @@ import (
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/vm"
 	"github.com/idena-network/idena-go/vm/embedded"
+	"github.com/pkg/errors"
 	"github.com/shopspring/decimal"
 	"io/ioutil"
 	"math/big"
 	"os"
 	"path/filepath"
 	"sync"
+	"time"
 )
 
 const (
@@ -86,8 +89,9 @@ func (j *Job) broadcast() {
 		return
 	}
 	newTxs := new(DeferredTxs)
+	epoch := j.appState.State.Epoch()
 	for _, tx := range j.txs.Txs {
-		if tx.BroadcastBlock <= j.head.Height() {
+		if tx.ready(j.head.Height(), epoch) {
 			err := j.sendTx(tx)
 			if err != nil {
 				log.Error("error while sending deferred tx", "err", err)
@@ -96,7 +100,11 @@ func (j *Job) broadcast() {
 				tryLater := false
 				if ok && contractErr.TryLater() {
 					tryLater = true
-					tx.BroadcastBlock = calculateBroadcastBlock(tx.BroadcastBlock, tx.sendTry)
+					prevBlock := tx.BroadcastBlock
+					if prevBlock < j.head.Height() {
+						prevBlock = j.head.Height()
+					}
+					tx.BroadcastBlock = calculateBroadcastBlock(prevBlock, tx.sendTry)
 				}
 				if !tryLater && tx.sendTry > 3 {
 					tx.removed = true
@@ -120,19 +128,61 @@ func (j *Job) broadcast() {
 }
 
 func (j *Job) AddDeferredTx(from common.Address, to *common.Address, amount *big.Int, payload []byte, tips *big.Int, broadcastBlock uint64) error {
-	tx := &DeferredTx{
+	_, err := j.ScheduleTx(&DeferredTx{
+		Type:           types.CallContractTx,
 		From:           from,
 		To:             to,
 		Amount:         amount,
 		Payload:        payload,
 		Tips:           tips,
 		BroadcastBlock: broadcastBlock,
+	})
+	return err
+}
+
+// ScheduleTx schedules the tx to be signed and broadcast once the head reaches BroadcastBlock and the epoch reaches BroadcastEpoch,
+// the tx is signed by the node key or by an unlocked keystore account of the sender at the broadcast time
+func (j *Job) ScheduleTx(tx *DeferredTx) (common.Hash, error) {
+	if tx.From != j.secStore.GetAddress() {
+		if j.ks == nil {
+			return common.Hash{}, errors.New("sender key is not found")
+		}
+		if _, err := j.ks.Find(keystore.Account{Address: tx.From}); err != nil {
+			return common.Hash{}, err
+		}
 	}
+	tx.Id = newDeferredTxId(tx)
 	j.mutex.Lock()
 	defer j.mutex.Unlock()
 	j.txs.Txs = append(j.txs.Txs, tx)
 
-	return j.persist()
+	return tx.Id, j.persist()
+}
+
+// ScheduledTxs returns txs waiting for broadcast
+func (j *Job) ScheduledTxs() []DeferredTx {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	result := make([]DeferredTx, 0, len(j.txs.Txs))
+	for _, tx := range j.txs.Txs {
+		result = append(result, *tx)
+	}
+	return result
+}
+
+// CancelTx removes the scheduled tx, the tx can't be cancelled once it is broadcast
+func (j *Job) CancelTx(id common.Hash) error {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	for i, tx := range j.txs.Txs {
+		if tx.Id == id {
+			txs := make([]*DeferredTx, 0, len(j.txs.Txs)-1)
+			txs = append(txs, j.txs.Txs[:i]...)
+			j.txs.Txs = append(txs, j.txs.Txs[i+1:]...)
+			return j.persist()
+		}
+	}
+	return errors.New("scheduled tx is not found")
 }
 
 func (j *Job) persist() error {
@@ -166,6 +216,14 @@ func (j *Job) openFile() (file *os.File, err error) {
 
 func (j *Job) sendTx(dtx *DeferredTx) error {
 
+	if dtx.MaxFee != nil && dtx.MaxFee.Sign() > 0 {
+		tx, err := j.getSignedTx(dtx, blockchain.ConvertToFloat(dtx.MaxFee))
+		if err != nil {
+			return err
+		}
+		return j.txpool.AddInternalTx(tx)
+	}
+
 	tx, err := j.getSignedTx(dtx, decimal.Zero)
 	if err != nil {
 		return err
@@ -176,6 +234,15 @@ func (j *Job) sendTx(dtx *DeferredTx) error {
 		return err
 	}
 
+	if dtx.Type != types.CallContractTx {
+		fee := fee.CalculateFee(readonlyAppState.ValidatorsCache.NetworkSize(), readonlyAppState.State.FeePerGas(), tx)
+		tx, err = j.getSignedTx(dtx, blockchain.ConvertToFloat(new(big.Int).Mul(fee, big.NewInt(2))))
+		if err != nil {
+			return err
+		}
+		return j.txpool.AddInternalTx(tx)
+	}
+
 	vm := j.vmCreator(readonlyAppState, j.head, j.secStore, nil, j.bc.Config())
 	r := vm.Run(tx, -1)
 	if r.Error != nil {
@@ -195,7 +262,7 @@ func (j *Job) sendTx(dtx *DeferredTx) error {
 }
 
 func (j *Job) getSignedTx(dtx *DeferredTx, maxFee decimal.Decimal) (*types.Transaction, error) {
-	tx := blockchain.BuildTx(j.appState, dtx.From, dtx.To, types.CallContractTx, blockchain.ConvertToFloat(dtx.Amount), maxFee, blockchain.ConvertToFloat(dtx.Tips),
+	tx := blockchain.BuildTx(j.appState, dtx.From, dtx.To, dtx.Type, blockchain.ConvertToFloat(dtx.Amount), maxFee, blockchain.ConvertToFloat(dtx.Tips),
 		0, 0, dtx.Payload)
 	var signedTx *types.Transaction
 	var err error
@@ -218,16 +285,32 @@ func (j *Job) getSignedTx(dtx *DeferredTx, maxFee decimal.Decimal) (*types.Trans
 }
 
 type DeferredTx struct {
+	Id             common.Hash
+	Type           types.TxType
 	From           common.Address
 	To             *common.Address
 	Amount         *big.Int
 	Payload        []byte
 	Tips           *big.Int
+	MaxFee         *big.Int
 	BroadcastBlock uint64
+	// BroadcastEpoch is the epoch the tx is broadcast at, e.g. the next epoch to broadcast right after validation
+	BroadcastEpoch uint16
 	sendTry        int
 	removed        bool
 }
 
+// ready returns true if the tx reached both broadcast block and broadcast epoch
+func (d *DeferredTx) ready(height uint64, epoch uint16) bool {
+	return d.BroadcastBlock <= height && d.BroadcastEpoch <= epoch
+}
+
+func newDeferredTxId(tx *DeferredTx) common.Hash {
+	data, _ := proto.Marshal(tx.ToProto())
+	data = append(data, common.ToBytes(time.Now().UnixNano())...)
+	return crypto.Hash(data)
+}
+
 func (d *DeferredTx) ToProto() *models.ProtoDeferredTxs_ProtoDeferredTx {
 	protoObj := &models.ProtoDeferredTxs_ProtoDeferredTx{}
 	protoObj.From = d.From.Bytes()
@@ -242,6 +325,14 @@ func (d *DeferredTx) ToProto() *models.ProtoDeferredTxs_ProtoDeferredTx {
 		protoObj.Tips = d.Tips.Bytes()
 	}
 	protoObj.Block = d.BroadcastBlock
+	if d.Id != (common.Hash{}) {
+		protoObj.Id = d.Id.Bytes()
+	}
+	protoObj.Type = uint32(d.Type)
+	protoObj.Epoch = uint32(d.BroadcastEpoch)
+	if d.MaxFee != nil {
+		protoObj.MaxFee = d.MaxFee.Bytes()
+	}
 
 	return protoObj
 }
@@ -262,6 +353,19 @@ func (d *DeferredTx) FromProto(protoObj *models.ProtoDeferredTxs_ProtoDeferredTx
 		d.Tips.SetBytes(protoObj.Tips)
 	}
 	d.BroadcastBlock = protoObj.Block
+	d.Type = types.TxType(protoObj.Type)
+	d.BroadcastEpoch = uint16(protoObj.Epoch)
+	if protoObj.MaxFee != nil {
+		d.MaxFee = new(big.Int)
+		d.MaxFee.SetBytes(protoObj.MaxFee)
+	}
+	if len(protoObj.Id) > 0 {
+		d.Id.SetBytes(protoObj.Id)
+	} else {
+		// txs persisted before scheduling of arbitrary txs are contract calls
+		d.Type = types.CallContractTx
+		d.Id = newDeferredTxId(d)
+	}
 }
 
 type DeferredTxs struct {
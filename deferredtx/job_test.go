@@ -1,11 +1,13 @@
 package deferredtx
 
 import (
+	"github.com/golang/protobuf/proto"
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
+	models "github.com/idena-network/idena-go/protobuf"
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/vm"
@@ -102,3 +104,59 @@ func TestJob_tryLater(t *testing.T) {
 	require.Len(t, job.txs.Txs, 0)
 	require.Equal(t, 1, txPool.counter)
 }
+
+func TestJob_ScheduleTx(t *testing.T) {
+	fakeVmError = nil
+	chain, appState, _, _ := blockchain.NewTestBlockchain(false, nil)
+	os.RemoveAll("test")
+	defer os.RemoveAll("test")
+
+	txPool := &fakeTxPool{}
+	vmCreator := func(appState *appstate.AppState, block *types.Header, store *secstore.SecStore, statsCollector collector.StatsCollector, cfg *config.Config) vm.VM {
+		return &fakeVm{}
+	}
+	job, _ := NewJob(chain.Bus(), "test", appState, chain.Blockchain, txPool, nil, chain.SecStore(), vmCreator)
+	coinbase := chain.SecStore().GetAddress()
+
+	_, err := job.ScheduleTx(&DeferredTx{Type: types.SendTx, From: common.Address{0x1}, To: &common.Address{0x2}, BroadcastBlock: 3})
+	require.Error(t, err)
+
+	id1, err := job.ScheduleTx(&DeferredTx{Type: types.KillDelegatorTx, From: coinbase, To: &common.Address{0x2}, BroadcastBlock: 3})
+	require.NoError(t, err)
+	id2, err := job.ScheduleTx(&DeferredTx{Type: types.SendTx, From: coinbase, To: &common.Address{0x2}, Amount: common.DnaBase, BroadcastBlock: 3})
+	require.NoError(t, err)
+	_, err = job.ScheduleTx(&DeferredTx{Type: types.SendTx, From: coinbase, To: &common.Address{0x2}, BroadcastEpoch: appState.State.Epoch() + 1})
+	require.NoError(t, err)
+	require.NotEqual(t, id1, id2)
+
+	require.NoError(t, job.CancelTx(id1))
+	require.Error(t, job.CancelTx(id1))
+
+	restored, _ := NewJob(chain.Bus(), "test", appState, chain.Blockchain, txPool, nil, chain.SecStore(), vmCreator)
+	scheduled := restored.ScheduledTxs()
+	require.Len(t, scheduled, 2)
+	require.Equal(t, id2, scheduled[0].Id)
+	require.Equal(t, types.SendTx, scheduled[0].Type)
+	require.Equal(t, common.DnaBase, scheduled[0].Amount)
+	require.Equal(t, appState.State.Epoch()+1, scheduled[1].BroadcastEpoch)
+
+	// both jobs are subscribed to new blocks
+	chain.GenerateEmptyBlocks(3)
+	require.Equal(t, 2, txPool.counter)
+	require.Len(t, job.ScheduledTxs(), 1)
+	require.Len(t, restored.ScheduledTxs(), 1)
+}
+
+func TestDeferredTxs_FromBytes_legacy(t *testing.T) {
+	data, _ := proto.Marshal(&models.ProtoDeferredTxs{
+		Txs: []*models.ProtoDeferredTxs_ProtoDeferredTx{
+			{From: common.Address{0x1}.Bytes(), To: common.Address{0x2}.Bytes(), Block: 10},
+		},
+	})
+	txs := new(DeferredTxs)
+	require.NoError(t, txs.FromBytes(data))
+	require.Len(t, txs.Txs, 1)
+	require.Equal(t, types.CallContractTx, txs.Txs[0].Type)
+	require.NotEqual(t, common.Hash{}, txs.Txs[0].Id)
+	require.Equal(t, uint64(10), txs.Txs[0].BroadcastBlock)
+}
@@ -7,14 +7,19 @@ import (
 )
 
 const (
-	NewTxEventID           = eventbus.EventID("transaction-new")
-	AddBlockEventID        = eventbus.EventID("block-add")
-	NewFlipKeyID           = eventbus.EventID("flip-key-new")
-	FastSyncCompleted      = eventbus.EventID("fast-sync-completed")
-	NewFlipEventID         = eventbus.EventID("flip-new")
-	NewFlipKeysPackageID   = eventbus.EventID("flip-keys-package-new")
-	IpfsPortChangedEventId = eventbus.EventID("ipfs-port-changed")
-	DeleteFlipEventID      = eventbus.EventID("flip-delete")
+	NewTxEventID                     = eventbus.EventID("transaction-new")
+	AddBlockEventID                  = eventbus.EventID("block-add")
+	NewFlipKeyID                     = eventbus.EventID("flip-key-new")
+	FastSyncCompleted                = eventbus.EventID("fast-sync-completed")
+	NewFlipEventID                   = eventbus.EventID("flip-new")
+	NewFlipKeysPackageID             = eventbus.EventID("flip-keys-package-new")
+	IpfsPortChangedEventId           = eventbus.EventID("ipfs-port-changed")
+	DeleteFlipEventID                = eventbus.EventID("flip-delete")
+	NewCheckpointAnnouncementEventID = eventbus.EventID("checkpoint-announcement-new")
+	StaleHeadResyncEventID           = eventbus.EventID("stale-head-resync")
+	ChainHeadEventID                 = eventbus.EventID("chain-head")
+	ChainSideEventID                 = eventbus.EventID("chain-side")
+	ReorgEventID                     = eventbus.EventID("chain-reorg")
 )
 
 type NewTxEvent struct {
@@ -83,3 +88,52 @@ type DeleteFlipEvent struct {
 func (DeleteFlipEvent) EventID() eventbus.EventID {
 	return DeleteFlipEventID
 }
+
+type NewCheckpointAnnouncementEvent struct {
+	Announcement *types.CheckpointAnnouncement
+}
+
+func (e *NewCheckpointAnnouncementEvent) EventID() eventbus.EventID {
+	return NewCheckpointAnnouncementEventID
+}
+
+// StaleHeadResyncEvent is published when own head stopped progressing while most peers are ahead and recovery resync is started
+type StaleHeadResyncEvent struct {
+	Head  uint64
+	Top   uint64
+	Peers int
+}
+
+func (e *StaleHeadResyncEvent) EventID() eventbus.EventID {
+	return StaleHeadResyncEventID
+}
+
+// ChainHeadEvent is published when the canonical head changes, it is published once for the resulting head of a reorg
+type ChainHeadEvent struct {
+	Block *types.Block
+}
+
+func (e *ChainHeadEvent) EventID() eventbus.EventID {
+	return ChainHeadEventID
+}
+
+// ChainSideEvent is published for every block removed from the canonical chain
+type ChainSideEvent struct {
+	Block *types.Block
+}
+
+func (e *ChainSideEvent) EventID() eventbus.EventID {
+	return ChainSideEventID
+}
+
+// ReorgEvent is published when canonical blocks above the common height are replaced. Reverted blocks are ordered
+// from the highest one, applied blocks are ordered by height and are empty if the chain is just reset.
+type ReorgEvent struct {
+	CommonHeight uint64
+	Reverted     []*types.Block
+	Applied      []*types.Block
+}
+
+func (e *ReorgEvent) EventID() eventbus.EventID {
+	return ReorgEventID
+}
@@ -0,0 +1,339 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/node"
+	"github.com/idena-network/idena-go/pengings"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"io"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	exportFormatCsv  = "csv"
+	exportFormatJson = "json"
+)
+
+var (
+	anonymizeFlag = cli.BoolFlag{
+		Name:  "anonymize",
+		Usage: "Replace addresses with their hashes under a random salt generated for this export",
+	}
+	exportOutFlag = cli.StringFlag{
+		Name:  "out",
+		Usage: "Output file, stdout if not set",
+	}
+	exportFromRoundFlag = cli.Uint64Flag{
+		Name:  "from",
+		Usage: "First round to export",
+	}
+	exportToRoundFlag = cli.Uint64Flag{
+		Name:  "to",
+		Usage: "Last round to export, the last archived round if not set",
+	}
+	exportFormatFlag = cli.StringFlag{
+		Name:  "format",
+		Usage: "Output format: csv or json",
+		Value: exportFormatCsv,
+	}
+
+	exportCommand = cli.Command{
+		Name:  "export",
+		Usage: "Export node state datasets",
+		Subcommands: []cli.Command{
+			{
+				Name:   "identities",
+				Usage:  "Export identity states, ages, stakes and delegation structure at the head block",
+				Flags:  []cli.Flag{anonymizeFlag, exportOutFlag, exportFormatFlag},
+				Action: exportIdentities,
+			},
+			{
+				Name:   "consensus",
+				Usage:  "Export archived proposals and votes as json lines, requires enabled consensus archive",
+				Flags:  []cli.Flag{exportOutFlag, exportFromRoundFlag, exportToRoundFlag},
+				Action: exportConsensusMessages,
+			},
+		},
+	}
+
+	identityStateNames = map[state.IdentityState]string{
+		state.Undefined: "Undefined",
+		state.Invite:    "Invite",
+		state.Candidate: "Candidate",
+		state.Newbie:    "Newbie",
+		state.Verified:  "Verified",
+		state.Suspended: "Suspended",
+		state.Zombie:    "Zombie",
+		state.Killed:    "Killed",
+		state.Human:     "Human",
+	}
+)
+
+type exportedIdentity struct {
+	Address    string `json:"address"`
+	State      string `json:"state"`
+	Age        uint16 `json:"age"`
+	Stake      string `json:"stake"`
+	Online     bool   `json:"online"`
+	IsPool     bool   `json:"isPool"`
+	Delegatee  string `json:"delegatee,omitempty"`
+	Inviter    string `json:"inviter,omitempty"`
+	Generation uint32 `json:"generation"`
+	Invitees   int    `json:"invitees"`
+}
+
+type exportedConsensusMessage struct {
+	Round      uint64    `json:"round"`
+	Type       string    `json:"type"`
+	Time       time.Time `json:"time"`
+	Sender     string    `json:"sender,omitempty"`
+	Step       *uint8    `json:"step,omitempty"`
+	ParentHash string    `json:"parentHash,omitempty"`
+	VotedHash  string    `json:"votedHash,omitempty"`
+	BlockHash  string    `json:"blockHash,omitempty"`
+	Data       string    `json:"data"`
+}
+
+// addressMapper converts addresses to exported ids, anonymized ids are stable within one export only
+type addressMapper struct {
+	salt []byte
+}
+
+func newAddressMapper(anonymize bool) (*addressMapper, error) {
+	if !anonymize {
+		return &addressMapper{}, nil
+	}
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return &addressMapper{salt: salt}, nil
+}
+
+func (m *addressMapper) id(addr common.Address) string {
+	if m.salt == nil {
+		return addr.Hex()
+	}
+	return crypto.Keccak256Hash(m.salt, addr.Bytes()).Hex()
+}
+
+func (m *addressMapper) optionalId(addr *common.Address) string {
+	if addr == nil {
+		return ""
+	}
+	return m.id(*addr)
+}
+
+func exportIdentities(ctx *cli.Context) error {
+	format := ctx.String(exportFormatFlag.Name)
+	if format != exportFormatCsv && format != exportFormatJson {
+		return errors.Errorf("unknown format %v", format)
+	}
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	head := database.NewRepo(db).ReadHead()
+	if head == nil {
+		return errors.New("head block is not found")
+	}
+	appState, err := appstate.NewAppState(db, eventbus.New())
+	if err != nil {
+		return err
+	}
+	if err := appState.Initialize(head.Height()); err != nil {
+		return err
+	}
+	mapper, err := newAddressMapper(ctx.Bool(anonymizeFlag.Name))
+	if err != nil {
+		return err
+	}
+
+	epoch := appState.State.Epoch()
+	var identities []*exportedIdentity
+	appState.State.IterateOverIdentities(func(addr common.Address, identity state.Identity) {
+		exported := &exportedIdentity{
+			Address:    mapper.id(addr),
+			State:      identityStateNames[identity.State],
+			Stake:      blockchain.ConvertToFloat(identity.Stake).String(),
+			Online:     appState.ValidatorsCache.IsOnlineIdentity(addr),
+			IsPool:     appState.ValidatorsCache.IsPool(addr),
+			Delegatee:  mapper.optionalId(identity.Delegatee),
+			Generation: identity.Generation,
+			Invitees:   len(identity.Invitees),
+		}
+		if identity.State != state.Undefined && identity.State != state.Invite && epoch >= identity.Birthday {
+			exported.Age = epoch - identity.Birthday
+		}
+		if identity.Inviter != nil {
+			exported.Inviter = mapper.id(identity.Inviter.Address)
+		}
+		identities = append(identities, exported)
+	})
+	// iteration order is defined by addresses, sort by exported ids to not leak it
+	sort.Slice(identities, func(i, j int) bool {
+		return identities[i].Address < identities[j].Address
+	})
+
+	var out io.Writer = os.Stdout
+	if path := ctx.String(exportOutFlag.Name); path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	if format == exportFormatJson {
+		err = writeIdentitiesJson(out, identities)
+	} else {
+		err = writeIdentitiesCsv(out, identities)
+	}
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %v identities at height %v, epoch %v\n", len(identities), head.Height(), epoch)
+	return nil
+}
+
+func writeIdentitiesJson(out io.Writer, identities []*exportedIdentity) error {
+	encoder := json.NewEncoder(out)
+	for _, identity := range identities {
+		if err := encoder.Encode(identity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeIdentitiesCsv(out io.Writer, identities []*exportedIdentity) error {
+	w := csv.NewWriter(out)
+	if err := w.Write([]string{"address", "state", "age", "stake", "online", "isPool", "delegatee", "inviter", "generation", "invitees"}); err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		record := []string{
+			identity.Address,
+			identity.State,
+			strconv.Itoa(int(identity.Age)),
+			identity.Stake,
+			strconv.FormatBool(identity.Online),
+			strconv.FormatBool(identity.IsPool),
+			identity.Delegatee,
+			identity.Inviter,
+			strconv.FormatUint(uint64(identity.Generation), 10),
+			strconv.Itoa(identity.Invitees),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func exportConsensusMessages(ctx *cli.Context) error {
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	to := uint64(math.MaxUint64)
+	if ctx.IsSet(exportToRoundFlag.Name) {
+		to = ctx.Uint64(exportToRoundFlag.Name)
+	}
+	var out io.Writer = os.Stdout
+	if path := ctx.String(exportOutFlag.Name); path != "" {
+		file, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+	encoder := json.NewEncoder(out)
+	count := 0
+	err = pengings.ReadMessageArchive(cfg.DataDir, ctx.Uint64(exportFromRoundFlag.Name), to, func(msg *pengings.ArchivedMessage) error {
+		exported, err := convertArchivedMessage(msg)
+		if err != nil {
+			return err
+		}
+		count++
+		return encoder.Encode(exported)
+	})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(os.Stderr, "Exported %v consensus messages\n", count)
+	return nil
+}
+
+func convertArchivedMessage(msg *pengings.ArchivedMessage) (*exportedConsensusMessage, error) {
+	exported := &exportedConsensusMessage{
+		Round: msg.Round,
+		Type:  msg.Type.String(),
+		Time:  msg.Time,
+		Data:  hexutil.Encode(msg.Data),
+	}
+	switch msg.Type {
+	case pengings.ArchivedProof:
+		proposal := new(types.ProofProposal)
+		if err := proposal.FromBytes(msg.Data); err != nil {
+			return nil, err
+		}
+		if pubKey, err := types.ProofProposalPubKey(proposal); err == nil {
+			if addr, err := crypto.PubKeyBytesToAddress(pubKey); err == nil {
+				exported.Sender = addr.Hex()
+			}
+		}
+	case pengings.ArchivedBlock:
+		proposal := new(types.BlockProposal)
+		if err := proposal.FromBytes(msg.Data); err != nil {
+			return nil, err
+		}
+		if proposal.Block != nil && proposal.Header != nil {
+			exported.BlockHash = proposal.Hash().Hex()
+			exported.ParentHash = proposal.Header.ParentHash().Hex()
+			if proposal.Header.ProposedHeader != nil {
+				if addr, err := crypto.PubKeyBytesToAddress(proposal.Header.ProposedHeader.ProposerPubKey); err == nil {
+					exported.Sender = addr.Hex()
+				}
+			}
+		}
+	case pengings.ArchivedVote:
+		vote := new(types.Vote)
+		if err := vote.FromBytes(msg.Data); err != nil {
+			return nil, err
+		}
+		exported.Sender = vote.VoterAddr().Hex()
+		if vote.Header != nil {
+			step := vote.Header.Step
+			exported.Step = &step
+			exported.ParentHash = vote.Header.ParentHash.Hex()
+			exported.VotedHash = vote.Header.VotedHash.Hex()
+		}
+	}
+	return exported, nil
+}
@@ -0,0 +1,395 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+const typeNameField = "__typename"
+
+var (
+	ComplexityLimitExceeded = errors.New("query complexity limit exceeded")
+)
+
+type Params struct {
+	Source interface{}
+	Args   map[string]interface{}
+}
+
+type ResolveFn func(p Params) (interface{}, error)
+
+// Field describes a field of an object type. Fields with nil Type are scalars, their values are serialized as JSON as is.
+// Fields of a list type (see NewList) resolve to a slice, every element is resolved as the element type then.
+type Field struct {
+	Type    *Object
+	Cost    int
+	Resolve ResolveFn
+}
+
+type Object struct {
+	Name   string
+	Fields map[string]*Field
+	ofType *Object
+}
+
+func NewObject(name string) *Object {
+	return &Object{
+		Name:   name,
+		Fields: make(map[string]*Field),
+	}
+}
+
+// NewList creates a type of lists of elements of the given type
+func NewList(ofType *Object) *Object {
+	return &Object{
+		Name:   "[" + ofType.Name + "]",
+		ofType: ofType,
+	}
+}
+
+// elem returns the element type of a list type and the type itself otherwise
+func (o *Object) elem() *Object {
+	if o.ofType != nil {
+		return o.ofType
+	}
+	return o
+}
+
+type Schema struct {
+	Query *Object
+
+	// MaxDepth limits nesting of selection sets, zero means no limit
+	MaxDepth int
+	// MaxComplexity limits the total cost of resolved fields, every element of a list is counted separately, zero means no limit
+	MaxComplexity int
+}
+
+type Error struct {
+	Message string        `json:"message"`
+	Path    []interface{} `json:"path,omitempty"`
+}
+
+type Response struct {
+	Data   interface{} `json:"data"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+// orderedMap keeps response fields in the order they were requested
+type orderedMap struct {
+	keys   []string
+	values map[string]interface{}
+}
+
+func newOrderedMap() *orderedMap {
+	return &orderedMap{values: make(map[string]interface{})}
+}
+
+func (m *orderedMap) set(key string, value interface{}) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *orderedMap) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range m.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, _ := json.Marshal(key)
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+type execution struct {
+	schema    *Schema
+	variables map[string]interface{}
+	cost      int
+	errors    []*Error
+}
+
+// Execute runs the query operation with given name (may be empty if the document contains a single operation).
+// Depth is checked before any resolver is called, complexity is accounted during execution and aborts the whole query.
+func (s *Schema) Execute(query string, operationName string, variables map[string]interface{}) *Response {
+	op, err := selectOperation(query, operationName)
+	if err != nil {
+		return errorResponse(err)
+	}
+	if err := s.validate(s.Query, op.Selection, 1); err != nil {
+		return errorResponse(err)
+	}
+	vars := make(map[string]interface{})
+	for k, v := range op.Defaults {
+		vars[k] = v
+	}
+	for k, v := range variables {
+		vars[k] = v
+	}
+	e := &execution{
+		schema:    s,
+		variables: vars,
+	}
+	data, err := e.executeSelection(s.Query, nil, op.Selection, nil)
+	if err != nil {
+		return errorResponse(err)
+	}
+	return &Response{
+		Data:   data,
+		Errors: e.errors,
+	}
+}
+
+func errorResponse(err error) *Response {
+	return &Response{
+		Errors: []*Error{{Message: err.Error()}},
+	}
+}
+
+func selectOperation(query string, operationName string) (*operation, error) {
+	ops, err := parse(query)
+	if err != nil {
+		return nil, err
+	}
+	if operationName == "" {
+		if len(ops) > 1 {
+			return nil, errors.New("operation name is required for documents with several operations")
+		}
+		return ops[0], nil
+	}
+	for _, op := range ops {
+		if op.Name == operationName {
+			return op, nil
+		}
+	}
+	return nil, errors.Errorf("unknown operation %q", operationName)
+}
+
+func (s *Schema) validate(obj *Object, selection []*selection, depth int) error {
+	if s.MaxDepth > 0 && depth > s.MaxDepth {
+		return errors.Errorf("query depth exceeds limit %v", s.MaxDepth)
+	}
+	for _, sel := range selection {
+		if sel.Name == typeNameField {
+			if sel.Selection != nil {
+				return errors.Errorf("field %q must not have a selection", sel.Name)
+			}
+			continue
+		}
+		field, ok := obj.Fields[sel.Name]
+		if !ok {
+			return errors.Errorf("cannot query field %q on type %q (position %d)", sel.Name, obj.Name, sel.pos)
+		}
+		if field.Type == nil {
+			if sel.Selection != nil {
+				return errors.Errorf("field %q of type %q must not have a selection (position %d)", sel.Name, obj.Name, sel.pos)
+			}
+			continue
+		}
+		if sel.Selection == nil {
+			return errors.Errorf("field %q of type %q must have a selection (position %d)", sel.Name, obj.Name, sel.pos)
+		}
+		if err := s.validate(field.Type.elem(), sel.Selection, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *execution) executeSelection(obj *Object, source interface{}, selection []*selection, path []interface{}) (*orderedMap, error) {
+	result := newOrderedMap()
+	for _, sel := range selection {
+		if sel.Name == typeNameField {
+			result.set(sel.key(), obj.Name)
+			continue
+		}
+		field := obj.Fields[sel.Name]
+		fieldPath := appendPath(path, sel.key())
+		if err := e.spend(field.Cost); err != nil {
+			return nil, err
+		}
+		args, err := e.resolveArgs(sel.Args)
+		if err != nil {
+			e.addError(err, fieldPath)
+			result.set(sel.key(), nil)
+			continue
+		}
+		value, err := field.Resolve(Params{Source: source, Args: args})
+		if err != nil {
+			e.addError(err, fieldPath)
+			result.set(sel.key(), nil)
+			continue
+		}
+		if field.Type == nil || isNil(value) {
+			result.set(sel.key(), value)
+			continue
+		}
+		completed, err := e.completeValue(field.Type, value, sel.Selection, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		result.set(sel.key(), completed)
+	}
+	return result, nil
+}
+
+func (e *execution) completeValue(obj *Object, value interface{}, selection []*selection, path []interface{}) (interface{}, error) {
+	v := reflect.ValueOf(value)
+	if obj.ofType == nil {
+		if v.Kind() == reflect.Slice {
+			return nil, errors.Errorf("%v: type %q resolved to a list", path, obj.Name)
+		}
+		return e.executeSelection(obj, value, selection, path)
+	}
+	if v.Kind() != reflect.Slice {
+		return nil, errors.Errorf("%v: list type %q resolved to %T", path, obj.Name, value)
+	}
+	list := make([]interface{}, 0, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		item := v.Index(i).Interface()
+		if isNil(item) {
+			list = append(list, nil)
+			continue
+		}
+		completed, err := e.executeSelection(obj.ofType, item, selection, appendPath(path, i))
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, completed)
+	}
+	return list, nil
+}
+
+func (e *execution) spend(cost int) error {
+	if cost <= 0 {
+		cost = 1
+	}
+	e.cost += cost
+	if e.schema.MaxComplexity > 0 && e.cost > e.schema.MaxComplexity {
+		return ComplexityLimitExceeded
+	}
+	return nil
+}
+
+func (e *execution) addError(err error, path []interface{}) {
+	e.errors = append(e.errors, &Error{
+		Message: err.Error(),
+		Path:    path,
+	})
+}
+
+func (e *execution) resolveArgs(args map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{}, len(args))
+	for name, value := range args {
+		v, err := e.resolveValue(value)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = v
+	}
+	return result, nil
+}
+
+func (e *execution) resolveValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case variable:
+		resolved, ok := e.variables[string(v)]
+		if !ok {
+			return nil, errors.Errorf("variable $%v is not provided", v)
+		}
+		return resolved, nil
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			resolved, err := e.resolveValue(item)
+			if err != nil {
+				return nil, err
+			}
+			list[i] = resolved
+		}
+		return list, nil
+	case map[string]interface{}:
+		obj := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			resolved, err := e.resolveValue(item)
+			if err != nil {
+				return nil, err
+			}
+			obj[k] = resolved
+		}
+		return obj, nil
+	default:
+		return v, nil
+	}
+}
+
+func appendPath(path []interface{}, item interface{}) []interface{} {
+	result := make([]interface{}, len(path), len(path)+1)
+	copy(result, path)
+	return append(result, item)
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// IntArg returns integer argument, literals are parsed as int64 while JSON variables are decoded as float64
+func IntArg(args map[string]interface{}, name string, defaultValue int64) (int64, error) {
+	value, ok := args[name]
+	if !ok || value == nil {
+		return defaultValue, nil
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		if v != math.Trunc(v) || v > math.MaxInt64 || v < math.MinInt64 {
+			return 0, errors.Errorf("argument %q must be an integer", name)
+		}
+		return int64(v), nil
+	case json.Number:
+		return v.Int64()
+	}
+	return 0, errors.Errorf("argument %q must be an integer", name)
+}
+
+func StringArg(args map[string]interface{}, name string) (string, bool, error) {
+	value, ok := args[name]
+	if !ok || value == nil {
+		return "", false, nil
+	}
+	s, ok := value.(string)
+	if !ok {
+		return "", false, errors.Errorf("argument %q must be a string", name)
+	}
+	return s, true, nil
+}
+
+func (e *Error) Error() string {
+	if len(e.Path) == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("%v: %v", e.Path, e.Message)
+}
@@ -0,0 +1,145 @@
+package graphql
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+type testNode struct {
+	Id       int64
+	Children []*testNode
+}
+
+func testSchema(maxDepth, maxComplexity int) *Schema {
+	node := NewObject("Node")
+	node.Fields = map[string]*Field{
+		"id": {
+			Resolve: func(p Params) (interface{}, error) {
+				return p.Source.(*testNode).Id, nil
+			},
+		},
+		"children": {
+			Type: NewList(node),
+			Cost: 2,
+			Resolve: func(p Params) (interface{}, error) {
+				return p.Source.(*testNode).Children, nil
+			},
+		},
+		"broken": {
+			Resolve: func(p Params) (interface{}, error) {
+				return nil, errors.New("broken field")
+			},
+		},
+		"firstChild": {
+			Type: node,
+			Resolve: func(p Params) (interface{}, error) {
+				// resolves to a list by mistake
+				return p.Source.(*testNode).Children, nil
+			},
+		},
+	}
+	query := NewObject("Query")
+	query.Fields = map[string]*Field{
+		"node": {
+			Type: node,
+			Resolve: func(p Params) (interface{}, error) {
+				id, err := IntArg(p.Args, "id", 0)
+				if err != nil {
+					return nil, err
+				}
+				return &testNode{
+					Id: id,
+					Children: []*testNode{
+						{Id: id + 1, Children: []*testNode{{Id: id + 3}}},
+						{Id: id + 2},
+					},
+				}, nil
+			},
+		},
+	}
+	return &Schema{
+		Query:         query,
+		MaxDepth:      maxDepth,
+		MaxComplexity: maxComplexity,
+	}
+}
+
+func marshal(t *testing.T, resp *Response) string {
+	data, err := json.Marshal(resp)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestSchema_Execute(t *testing.T) {
+	schema := testSchema(0, 0)
+
+	resp := schema.Execute(`
+		# comment
+		query Nodes($id: Int = 1) {
+			root: node(id: $id) { __typename id children { id children { id } } }
+			other: node(id: 10) { id }
+		}`, "", nil)
+	require.Equal(t, `{"data":{"root":{"__typename":"Node","id":1,"children":[{"id":2,"children":[{"id":4}]},{"id":3,"children":null}]},"other":{"id":10}}}`, marshal(t, resp))
+
+	resp = schema.Execute(`query Nodes($id: Int) { node(id: $id) { id } }`, "Nodes", map[string]interface{}{"id": float64(5)})
+	require.Equal(t, `{"data":{"node":{"id":5}}}`, marshal(t, resp))
+
+	resp = schema.Execute(`{ node { id broken } }`, "", nil)
+	require.Equal(t, `{"data":{"node":{"id":0,"broken":null}},"errors":[{"message":"broken field","path":["node","broken"]}]}`, marshal(t, resp))
+
+	resp = schema.Execute(`{ node { firstChild { id } } }`, "", nil)
+	require.Nil(t, resp.Data)
+	require.Equal(t, `[node firstChild]: type "Node" resolved to a list`, resp.Errors[0].Message)
+
+	resp = schema.Execute(`{ node(id: "x") { id } }`, "", nil)
+	require.Equal(t, `{"data":{"node":null},"errors":[{"message":"argument \"id\" must be an integer","path":["node"]}]}`, marshal(t, resp))
+}
+
+func TestSchema_Execute_InvalidQueries(t *testing.T) {
+	schema := testSchema(0, 0)
+
+	queries := map[string]string{
+		`{ node { unknown } }`:     `cannot query field "unknown" on type "Node" (position 9)`,
+		`{ node }`:                 `field "node" of type "Query" must have a selection (position 2)`,
+		`{ node { id { x } } }`:    `field "id" of type "Node" must not have a selection (position 9)`,
+		`{ node { ...Fields } }`:   `fragments are not supported (position 9)`,
+		`mutation { node { id } }`: `mutation operations are not supported`,
+		`{ node { id }`:            `unexpected end of query`,
+		`query A { node { id } } query B { node { id } }`: `operation name is required for documents with several operations`,
+		`{ node(id: $missing) { id } }`:                   ``,
+		``:                                                `query document is empty`,
+	}
+	for query, expected := range queries {
+		resp := schema.Execute(query, "", nil)
+		if expected == "" {
+			require.Len(t, resp.Errors, 1, query)
+			require.Equal(t, "variable $missing is not provided", resp.Errors[0].Message)
+			continue
+		}
+		require.Nil(t, resp.Data, query)
+		require.Len(t, resp.Errors, 1, query)
+		require.Equal(t, expected, resp.Errors[0].Message, query)
+	}
+}
+
+func TestSchema_Execute_Limits(t *testing.T) {
+	query := `{ node { children { children { id } } } }`
+
+	resp := testSchema(4, 0).Execute(query, "", nil)
+	require.Nil(t, resp.Errors)
+
+	resp = testSchema(3, 0).Execute(query, "", nil)
+	require.Nil(t, resp.Data)
+	require.Equal(t, "query depth exceeds limit 3", resp.Errors[0].Message)
+
+	// node(1) + children(2) + 2 x children(2) + 1 x id(1)
+	resp = testSchema(0, 8).Execute(query, "", nil)
+	require.Nil(t, resp.Errors)
+
+	resp = testSchema(0, 7).Execute(query, "", nil)
+	require.Nil(t, resp.Data)
+	require.Equal(t, ComplexityLimitExceeded.Error(), resp.Errors[0].Message)
+}
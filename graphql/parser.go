@@ -0,0 +1,502 @@
+package graphql
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Only the subset of GraphQL used by read-only explorer queries is supported:
+// query operations with fields, aliases, arguments and variables.
+// Fragments, directives, mutations and subscriptions are rejected.
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+type lexer struct {
+	src string
+	pos int
+	tok token
+}
+
+func newLexer(src string) (*lexer, error) {
+	l := &lexer{src: src}
+	if err := l.next(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			l.pos++
+		case c == '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+		case c == 0xEF && strings.HasPrefix(l.src[l.pos:], "\ufeff"):
+			l.pos += 3
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) next() error {
+	l.skipIgnored()
+	start := l.pos
+	if l.pos >= len(l.src) {
+		l.tok = token{kind: tokenEOF, pos: start}
+		return nil
+	}
+	c := l.src[l.pos]
+	switch {
+	case strings.IndexByte("{}()[]:$!=@", c) >= 0:
+		l.pos++
+		l.tok = token{kind: tokenPunct, value: string(c), pos: start}
+	case c == '.':
+		if strings.HasPrefix(l.src[l.pos:], "...") {
+			return fmt.Errorf("fragments are not supported (position %d)", start)
+		}
+		return fmt.Errorf("unexpected character %q (position %d)", c, start)
+	case c == '_' || isLetter(c):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || isLetter(l.src[l.pos]) || isDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		l.tok = token{kind: tokenName, value: l.src[start:l.pos], pos: start}
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case c == '"':
+		return l.readString()
+	default:
+		return fmt.Errorf("unexpected character %q (position %d)", c, start)
+	}
+	return nil
+}
+
+func (l *lexer) readNumber() error {
+	start := l.pos
+	kind := tokenInt
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if isDigit(c) {
+			l.pos++
+			continue
+		}
+		if c == '.' || c == 'e' || c == 'E' || ((c == '+' || c == '-') && kind == tokenFloat) {
+			kind = tokenFloat
+			l.pos++
+			continue
+		}
+		break
+	}
+	l.tok = token{kind: kind, value: l.src[start:l.pos], pos: start}
+	return nil
+}
+
+func (l *lexer) readString() error {
+	start := l.pos
+	l.pos++
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch c {
+		case '"':
+			l.pos++
+			l.tok = token{kind: tokenString, value: sb.String(), pos: start}
+			return nil
+		case '\n', '\r':
+			return fmt.Errorf("unterminated string (position %d)", start)
+		case '\\':
+			if l.pos+1 >= len(l.src) {
+				return fmt.Errorf("unterminated string (position %d)", start)
+			}
+			l.pos++
+			switch esc := l.src[l.pos]; esc {
+			case '"', '\\', '/':
+				sb.WriteByte(esc)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if l.pos+4 >= len(l.src) {
+					return fmt.Errorf("invalid unicode escape (position %d)", l.pos)
+				}
+				r, err := strconv.ParseUint(l.src[l.pos+1:l.pos+5], 16, 32)
+				if err != nil {
+					return fmt.Errorf("invalid unicode escape (position %d)", l.pos)
+				}
+				sb.WriteRune(rune(r))
+				l.pos += 4
+			default:
+				return fmt.Errorf("invalid escape sequence (position %d)", l.pos)
+			}
+			l.pos++
+		default:
+			r, size := utf8.DecodeRuneInString(l.src[l.pos:])
+			sb.WriteRune(r)
+			l.pos += size
+		}
+	}
+	return fmt.Errorf("unterminated string (position %d)", start)
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// variable is an argument value which refers to an operation variable
+type variable string
+
+type selection struct {
+	Alias     string
+	Name      string
+	Args      map[string]interface{}
+	Selection []*selection
+	pos       int
+}
+
+func (s *selection) key() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.Name
+}
+
+type operation struct {
+	Name      string
+	Defaults  map[string]interface{}
+	Selection []*selection
+}
+
+type parser struct {
+	lex *lexer
+}
+
+// parse parses a query document into the list of its operations
+func parse(query string) ([]*operation, error) {
+	lex, err := newLexer(query)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{lex: lex}
+	var ops []*operation
+	for p.lex.tok.kind != tokenEOF {
+		op, err := p.parseOperation()
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("query document is empty")
+	}
+	return ops, nil
+}
+
+func (p *parser) peek(kind tokenKind, value string) bool {
+	return p.lex.tok.kind == kind && p.lex.tok.value == value
+}
+
+func (p *parser) expect(kind tokenKind, value string) error {
+	if !p.peek(kind, value) {
+		return p.unexpected()
+	}
+	return p.lex.next()
+}
+
+func (p *parser) unexpected() error {
+	if p.lex.tok.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of query")
+	}
+	return fmt.Errorf("unexpected %q (position %d)", p.lex.tok.value, p.lex.tok.pos)
+}
+
+func (p *parser) name() (string, error) {
+	if p.lex.tok.kind != tokenName {
+		return "", p.unexpected()
+	}
+	name := p.lex.tok.value
+	return name, p.lex.next()
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := &operation{}
+	if p.lex.tok.kind == tokenName {
+		switch p.lex.tok.value {
+		case "query":
+		case "fragment":
+			return nil, fmt.Errorf("fragments are not supported")
+		case "mutation", "subscription":
+			return nil, fmt.Errorf("%s operations are not supported", p.lex.tok.value)
+		default:
+			return nil, p.unexpected()
+		}
+		if err := p.lex.next(); err != nil {
+			return nil, err
+		}
+		if p.lex.tok.kind == tokenName {
+			op.Name = p.lex.tok.value
+			if err := p.lex.next(); err != nil {
+				return nil, err
+			}
+		}
+		if p.peek(tokenPunct, "(") {
+			defaults, err := p.parseVariableDefinitions()
+			if err != nil {
+				return nil, err
+			}
+			op.Defaults = defaults
+		}
+	}
+	if p.peek(tokenPunct, "@") {
+		return nil, fmt.Errorf("directives are not supported")
+	}
+	selection, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.Selection = selection
+	return op, nil
+}
+
+// parseVariableDefinitions skips variable types since arguments are coerced by resolvers, only default values are kept
+func (p *parser) parseVariableDefinitions() (map[string]interface{}, error) {
+	defaults := make(map[string]interface{})
+	if err := p.lex.next(); err != nil {
+		return nil, err
+	}
+	for !p.peek(tokenPunct, ")") {
+		if err := p.expect(tokenPunct, "$"); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenPunct, ":"); err != nil {
+			return nil, err
+		}
+		if err := p.skipType(); err != nil {
+			return nil, err
+		}
+		if p.peek(tokenPunct, "=") {
+			if err := p.lex.next(); err != nil {
+				return nil, err
+			}
+			value, err := p.parseValue(true)
+			if err != nil {
+				return nil, err
+			}
+			defaults[name] = value
+		}
+	}
+	return defaults, p.lex.next()
+}
+
+func (p *parser) skipType() error {
+	if p.peek(tokenPunct, "[") {
+		if err := p.lex.next(); err != nil {
+			return err
+		}
+		if err := p.skipType(); err != nil {
+			return err
+		}
+		if err := p.expect(tokenPunct, "]"); err != nil {
+			return err
+		}
+	} else if _, err := p.name(); err != nil {
+		return err
+	}
+	if p.peek(tokenPunct, "!") {
+		return p.lex.next()
+	}
+	return nil
+}
+
+func (p *parser) parseSelectionSet() ([]*selection, error) {
+	if err := p.expect(tokenPunct, "{"); err != nil {
+		return nil, err
+	}
+	var result []*selection
+	for !p.peek(tokenPunct, "}") {
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, field)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("empty selection set (position %d)", p.lex.tok.pos)
+	}
+	return result, p.lex.next()
+}
+
+func (p *parser) parseField() (*selection, error) {
+	field := &selection{pos: p.lex.tok.pos}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek(tokenPunct, ":") {
+		if err := p.lex.next(); err != nil {
+			return nil, err
+		}
+		field.Alias = name
+		if name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	field.Name = name
+	if p.peek(tokenPunct, "(") {
+		if field.Args, err = p.parseArguments(); err != nil {
+			return nil, err
+		}
+	}
+	if p.peek(tokenPunct, "@") {
+		return nil, fmt.Errorf("directives are not supported")
+	}
+	if p.peek(tokenPunct, "{") {
+		if field.Selection, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return field, nil
+}
+
+func (p *parser) parseArguments() (map[string]interface{}, error) {
+	args := make(map[string]interface{})
+	if err := p.lex.next(); err != nil {
+		return nil, err
+	}
+	for !p.peek(tokenPunct, ")") {
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenPunct, ":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+		args[name] = value
+	}
+	return args, p.lex.next()
+}
+
+func (p *parser) parseValue(constant bool) (interface{}, error) {
+	tok := p.lex.tok
+	switch tok.kind {
+	case tokenInt:
+		v, err := strconv.ParseInt(tok.value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid int %q (position %d)", tok.value, tok.pos)
+		}
+		return v, p.lex.next()
+	case tokenFloat:
+		v, err := strconv.ParseFloat(tok.value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %q (position %d)", tok.value, tok.pos)
+		}
+		return v, p.lex.next()
+	case tokenString:
+		return tok.value, p.lex.next()
+	case tokenName:
+		var v interface{}
+		switch tok.value {
+		case "true":
+			v = true
+		case "false":
+			v = false
+		case "null":
+			v = nil
+		default:
+			// enum values are passed to resolvers as strings
+			v = tok.value
+		}
+		return v, p.lex.next()
+	case tokenPunct:
+		switch tok.value {
+		case "$":
+			if constant {
+				return nil, p.unexpected()
+			}
+			if err := p.lex.next(); err != nil {
+				return nil, err
+			}
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			return variable(name), nil
+		case "[":
+			if err := p.lex.next(); err != nil {
+				return nil, err
+			}
+			list := make([]interface{}, 0)
+			for !p.peek(tokenPunct, "]") {
+				v, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, v)
+			}
+			return list, p.lex.next()
+		case "{":
+			if err := p.lex.next(); err != nil {
+				return nil, err
+			}
+			obj := make(map[string]interface{})
+			for !p.peek(tokenPunct, "}") {
+				name, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expect(tokenPunct, ":"); err != nil {
+					return nil, err
+				}
+				v, err := p.parseValue(constant)
+				if err != nil {
+					return nil, err
+				}
+				obj[name] = v
+			}
+			return obj, p.lex.next()
+		}
+	}
+	return nil, p.unexpected()
+}
@@ -0,0 +1,88 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/idena-network/idena-go/log"
+)
+
+const (
+	maxRequestContentLength = 1024 * 128
+	contentType             = "application/json"
+)
+
+// AuthorizeFn authorizes a query made by the request with the API key, a positive retryAfter means
+// the client exceeded its quota
+type AuthorizeFn func(r *http.Request, key string) (retryAfter time.Duration, err error)
+
+type request struct {
+	Key           string                 `json:"key"`
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
+type handler struct {
+	schema    *Schema
+	authorize AuthorizeFn
+}
+
+// ServeHTTP accepts POST requests with JSON body {key, query, operationName, variables} and GET requests with
+// the same query parameters
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var req request
+	switch r.Method {
+	case http.MethodGet:
+		req.Key = r.URL.Query().Get("key")
+		req.Query = r.URL.Query().Get("query")
+		req.OperationName = r.URL.Query().Get("operationName")
+		if vars := r.URL.Query().Get("variables"); vars != "" {
+			if err := json.Unmarshal([]byte(vars), &req.Variables); err != nil {
+				http.Error(w, "invalid variables", http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		if r.ContentLength > maxRequestContentLength {
+			http.Error(w, "content length too large", http.StatusRequestEntityTooLarge)
+			return
+		}
+		if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestContentLength)).Decode(&req); err != nil {
+			http.Error(w, "invalid request body", http.StatusBadRequest)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if h.authorize != nil {
+		if retryAfter, err := h.authorize(r, req.Key); err != nil {
+			code := http.StatusForbidden
+			if retryAfter > 0 {
+				w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+				code = http.StatusTooManyRequests
+			}
+			writeResponse(w, code, errorResponse(err))
+			return
+		}
+	}
+	writeResponse(w, http.StatusOK, h.schema.Execute(req.Query, req.OperationName, req.Variables))
+}
+
+func writeResponse(w http.ResponseWriter, code int, resp *Response) {
+	w.Header().Set("content-type", contentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Debug("Failed to write graphql response", "err", err)
+	}
+}
+
+// NewHandler creates an HTTP handler of the schema, every request is authorized by authorize unless it is nil
+func NewHandler(schema *Schema, authorize AuthorizeFn) http.Handler {
+	return &handler{schema, authorize}
+}
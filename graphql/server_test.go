@@ -0,0 +1,49 @@
+package graphql
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler_authorize(t *testing.T) {
+	handler := NewHandler(testSchema(0, 0), func(r *http.Request, key string) (time.Duration, error) {
+		switch key {
+		case "valid":
+			return 0, nil
+		case "limited":
+			return 1500 * time.Millisecond, errors.New("rate limit exceeded")
+		}
+		return 0, errors.New("invalid key")
+	})
+
+	post := func(key string) *httptest.ResponseRecorder {
+		body, _ := json.Marshal(&request{Key: key, Query: `{ node(id: 1) { id } }`})
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body))))
+		return w
+	}
+
+	w := post("valid")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.Equal(t, `{"data":{"node":{"id":1}}}`, strings.TrimSpace(w.Body.String()))
+
+	w = post("other")
+	require.Equal(t, http.StatusForbidden, w.Code)
+	require.Equal(t, `{"data":null,"errors":[{"message":"invalid key"}]}`, strings.TrimSpace(w.Body.String()))
+
+	w = post("limited")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/graphql?key=valid&query="+url.QueryEscape(`{ node { id } }`), nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
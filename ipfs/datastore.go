@@ -0,0 +1,334 @@
+package ipfs
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/idena-network/idena-go/config"
+	"github.com/ipfs/go-datastore"
+	"github.com/ipfs/go-datastore/query"
+	ipfsConf "github.com/ipfs/go-ipfs-config"
+	serialize "github.com/ipfs/go-ipfs-config/serialize"
+	"github.com/ipfs/go-ipfs/repo"
+	"github.com/ipfs/go-ipfs/repo/fsrepo"
+	"github.com/pkg/errors"
+)
+
+const (
+	DatastoreBadger  = "badger"
+	DatastoreFlatfs  = "flatfs"
+	DatastoreLeveldb = "leveldb"
+	DatastorePebble  = "pebble"
+
+	datastoreSpecFile       = "datastore_spec"
+	datastoreMigrationDir   = "datastore-migration"
+	datastoreBackupDir      = "datastore-backup"
+	datastoreMigrationBatch = 1000
+)
+
+// datastoreSpec builds the spec of the ipfs datastore with the given backend, path is the directory of the blocks
+// either absolute or relative to the ipfs data dir, the default one is used if it is empty
+func datastoreSpec(backend string, path string) (map[string]interface{}, error) {
+	withPath := func(defaultPath string) string {
+		if path == "" {
+			return defaultPath
+		}
+		return path
+	}
+	switch backend {
+	case DatastoreBadger:
+		return map[string]interface{}{
+			"type":   "measure",
+			"prefix": "badger.datastore",
+			"child": map[string]interface{}{
+				"type":       "badgerds",
+				"path":       withPath("badgerds"),
+				"syncWrites": false,
+				"truncate":   true,
+			},
+		}, nil
+	case DatastoreFlatfs:
+		return map[string]interface{}{
+			"type": "mount",
+			"mounts": []interface{}{
+				map[string]interface{}{
+					"mountpoint": "/blocks",
+					"type":       "measure",
+					"prefix":     "flatfs.datastore",
+					"child": map[string]interface{}{
+						"type":      "flatfs",
+						"path":      withPath("blocks"),
+						"sync":      true,
+						"shardFunc": "/repo/flatfs/shard/v1/next-to-last/2",
+					},
+				},
+				map[string]interface{}{
+					"mountpoint": "/",
+					"type":       "measure",
+					"prefix":     "leveldb.datastore",
+					"child": map[string]interface{}{
+						"type":        "levelds",
+						"path":        "datastore",
+						"compression": "none",
+					},
+				},
+			},
+		}, nil
+	case DatastoreLeveldb:
+		return map[string]interface{}{
+			"type":   "measure",
+			"prefix": "leveldb.datastore",
+			"child": map[string]interface{}{
+				"type":        "levelds",
+				"path":        withPath("datastore"),
+				"compression": "none",
+			},
+		}, nil
+	case DatastorePebble:
+		return nil, errors.New("pebble datastore is not supported by the embedded ipfs node")
+	default:
+		return nil, errors.Errorf("unknown ipfs datastore %v", backend)
+	}
+}
+
+func diskSpec(spec map[string]interface{}) (string, error) {
+	dsc, err := fsrepo.AnyDatastoreConfig(spec)
+	if err != nil {
+		return "", err
+	}
+	return dsc.DiskSpec().String(), nil
+}
+
+func readDiskSpec(dataDir string) (string, error) {
+	b, err := ioutil.ReadFile(filepath.Join(dataDir, datastoreSpecFile))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
+
+// datastorePaths collects directories of the leaf datastores of the spec
+func datastorePaths(spec map[string]interface{}) []string {
+	var result []string
+	if path, ok := spec["path"].(string); ok {
+		result = append(result, path)
+	}
+	if child, ok := spec["child"].(map[string]interface{}); ok {
+		result = append(result, datastorePaths(child)...)
+	}
+	if mounts, ok := spec["mounts"].([]interface{}); ok {
+		for _, mount := range mounts {
+			if m, ok := mount.(map[string]interface{}); ok {
+				result = append(result, datastorePaths(m)...)
+			}
+		}
+	}
+	return result
+}
+
+// checkDatastore fails if the configured datastore differs from the one of the existing repo, the repo has to be
+// migrated with MigrateDatastore in this case
+func checkDatastore(cfg *config.IpfsConfig, dataDir string) error {
+	if cfg.Datastore == "" {
+		return nil
+	}
+	spec, err := datastoreSpec(cfg.Datastore, cfg.DatastorePath)
+	if err != nil {
+		return err
+	}
+	expected, err := diskSpec(spec)
+	if err != nil {
+		return err
+	}
+	actual, err := readDiskSpec(dataDir)
+	if err != nil {
+		return err
+	}
+	if expected != actual {
+		return errors.Errorf("ipfs datastore %v doesn't match the configured one %v, stop the node and run `idena ipfs migrate-datastore`", actual, expected)
+	}
+	return nil
+}
+
+// MigrateDatastore copies all data of the ipfs repo into the datastore configured by Datastore and DatastorePath
+// and switches the repo to it, the node must be stopped
+func MigrateDatastore(cfg *config.IpfsConfig) (copied int, err error) {
+	if cfg.Datastore == "" {
+		return 0, errors.New("ipfs datastore is not configured")
+	}
+	dataDir, _ := filepath.Abs(cfg.DataDir)
+	if !fsrepo.IsInitialized(dataDir) {
+		return 0, errors.Errorf("ipfs repo is not initialized in %v", dataDir)
+	}
+	if err := loadPlugins(cfg); err != nil {
+		return 0, err
+	}
+	newSpec, err := datastoreSpec(cfg.Datastore, cfg.DatastorePath)
+	if err != nil {
+		return 0, err
+	}
+	newDiskSpec, err := diskSpec(newSpec)
+	if err != nil {
+		return 0, err
+	}
+	oldDiskSpec, err := readDiskSpec(dataDir)
+	if err != nil {
+		return 0, err
+	}
+	if newDiskSpec == oldDiskSpec {
+		return 0, errors.New("ipfs repo already uses the configured datastore")
+	}
+
+	r, err := fsrepo.Open(dataDir)
+	if err != nil {
+		return 0, err
+	}
+	ipfsConfig, err := r.Config()
+	if err != nil {
+		r.Close()
+		return 0, err
+	}
+	oldPaths := datastorePaths(ipfsConfig.Datastore.Spec)
+	newPaths := datastorePaths(newSpec)
+	for _, path := range newPaths {
+		if !filepath.IsAbs(path) {
+			continue
+		}
+		if entries, _ := ioutil.ReadDir(path); len(entries) > 0 {
+			r.Close()
+			return 0, errors.Errorf("datastore directory %v is not empty", path)
+		}
+	}
+
+	migrationDir := filepath.Join(dataDir, datastoreMigrationDir)
+	if err := os.RemoveAll(migrationDir); err != nil {
+		r.Close()
+		return 0, err
+	}
+	if err := os.MkdirAll(migrationDir, 0755); err != nil {
+		r.Close()
+		return 0, err
+	}
+	dsc, err := fsrepo.AnyDatastoreConfig(newSpec)
+	if err != nil {
+		r.Close()
+		return 0, err
+	}
+	newDs, err := dsc.Create(migrationDir)
+	if err != nil {
+		r.Close()
+		return 0, err
+	}
+
+	copied, err = copyDatastore(r.Datastore(), newDs)
+	newDs.Close()
+	r.Close()
+	if err != nil {
+		return 0, errors.Wrap(err, "cannot copy datastore")
+	}
+
+	// old data which occupies paths of the new datastore is moved aside, it's restored if the switch fails
+	backupDir := filepath.Join(dataDir, datastoreBackupDir)
+	if err := os.RemoveAll(backupDir); err != nil {
+		return 0, err
+	}
+	var moved [][2]string
+	move := func(from, to string) error {
+		if err := os.MkdirAll(filepath.Dir(to), 0755); err != nil {
+			return err
+		}
+		if err := os.Rename(from, to); err != nil {
+			return err
+		}
+		moved = append(moved, [2]string{from, to})
+		return nil
+	}
+	rollback := func(err error) (int, error) {
+		for i := len(moved) - 1; i >= 0; i-- {
+			os.Rename(moved[i][1], moved[i][0])
+		}
+		return 0, err
+	}
+	newRelativePaths := make(map[string]struct{})
+	for _, path := range newPaths {
+		if filepath.IsAbs(path) {
+			continue
+		}
+		newRelativePaths[filepath.Clean(path)] = struct{}{}
+		if _, err := os.Stat(filepath.Join(dataDir, path)); err == nil {
+			if err := move(filepath.Join(dataDir, path), filepath.Join(backupDir, path)); err != nil {
+				return rollback(err)
+			}
+		}
+	}
+	for path := range newRelativePaths {
+		if err := move(filepath.Join(migrationDir, path), filepath.Join(dataDir, path)); err != nil {
+			return rollback(err)
+		}
+	}
+
+	configFilename, err := ipfsConf.Filename(dataDir)
+	if err != nil {
+		return rollback(err)
+	}
+	oldSpec := ipfsConfig.Datastore.Spec
+	ipfsConfig.Datastore.Spec = newSpec
+	if err := serialize.WriteConfigFile(configFilename, ipfsConfig); err != nil {
+		return rollback(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dataDir, datastoreSpecFile), []byte(newDiskSpec), 0600); err != nil {
+		ipfsConfig.Datastore.Spec = oldSpec
+		serialize.WriteConfigFile(configFilename, ipfsConfig)
+		return rollback(err)
+	}
+
+	// the repo is switched, so the old data can be removed
+	for _, path := range oldPaths {
+		if !filepath.IsAbs(path) {
+			if _, ok := newRelativePaths[filepath.Clean(path)]; ok {
+				continue
+			}
+			path = filepath.Join(dataDir, path)
+		}
+		if err := os.RemoveAll(path); err != nil {
+			return copied, err
+		}
+	}
+	if err := os.RemoveAll(backupDir); err != nil {
+		return copied, err
+	}
+	return copied, os.RemoveAll(migrationDir)
+}
+
+func copyDatastore(from repo.Datastore, to repo.Datastore) (int, error) {
+	results, err := from.Query(query.Query{})
+	if err != nil {
+		return 0, err
+	}
+	defer results.Close()
+	batch, err := to.Batch()
+	if err != nil {
+		return 0, err
+	}
+	var copied int
+	for result := range results.Next() {
+		if result.Error != nil {
+			return copied, result.Error
+		}
+		if err := batch.Put(datastore.NewKey(result.Key), result.Value); err != nil {
+			return copied, err
+		}
+		copied++
+		if copied%datastoreMigrationBatch == 0 {
+			if err := batch.Commit(); err != nil {
+				return copied, err
+			}
+			if batch, err = to.Batch(); err != nil {
+				return copied, err
+			}
+		}
+	}
+	return copied, batch.Commit()
+}
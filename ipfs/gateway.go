@@ -0,0 +1,80 @@
+package ipfs
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ipfs/go-cid"
+	"github.com/pkg/errors"
+)
+
+const gatewayTimeout = time.Second * 20
+
+var (
+	NoGatewaysErr         = errors.New("no gateways configured")
+	GatewayCidMismatchErr = errors.New("gateway returned data with another cid")
+)
+
+// fetchFromGateways downloads the data from trusted HTTP gateways in random order, the data is accepted only if
+// its cid computed locally matches the requested one, so gateways don't have to be trusted for the content
+func fetchFromGateways(gateways []string, c cid.Cid, maxSize int64, computeCid func(data []byte) (cid.Cid, error)) ([]byte, string, error) {
+	if len(gateways) == 0 {
+		return nil, "", NoGatewaysErr
+	}
+	order := rand.Perm(len(gateways))
+	var lastErr error
+	for _, idx := range order {
+		gateway := gateways[idx]
+		data, err := fetchFromGateway(gateway, c, maxSize)
+		if err == nil {
+			var actual cid.Cid
+			if actual, err = computeCid(data); err == nil && !actual.Equals(c) {
+				err = GatewayCidMismatchErr
+			}
+		}
+		if err == nil {
+			return data, gateway, nil
+		}
+		lastErr = errors.Wrapf(err, "gateway %v", gateway)
+	}
+	return nil, "", lastErr
+}
+
+func fetchFromGateway(gateway string, c cid.Cid, maxSize int64) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), gatewayTimeout)
+	defer cancel()
+	url := fmt.Sprintf("%v/ipfs/%v", strings.TrimRight(gateway, "/"), c.String())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %v", resp.StatusCode)
+	}
+	var reader io.Reader = resp.Body
+	if maxSize > 0 {
+		if resp.ContentLength > maxSize {
+			return nil, TooBigErr
+		}
+		reader = io.LimitReader(resp.Body, maxSize+1)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	if maxSize > 0 && int64(len(data)) > maxSize {
+		return nil, TooBigErr
+	}
+	return data, nil
+}
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/dnsseed"
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/common/math"
 	"github.com/idena-network/idena-go/config"
@@ -19,6 +20,7 @@ import (
 	"github.com/ipfs/go-ipfs/core"
 	"github.com/ipfs/go-ipfs/core/coreapi"
 	"github.com/ipfs/go-ipfs/core/corerepo"
+	"github.com/ipfs/go-ipfs/core/node/libp2p"
 	"github.com/ipfs/go-ipfs/core/coreunix"
 	"github.com/ipfs/go-ipfs/plugin/loader"
 	"github.com/ipfs/go-ipfs/repo/fsrepo"
@@ -59,6 +61,15 @@ const (
 	Profile    DataType = 3
 	TxReceipt  DataType = 4
 	CustomData DataType = 5
+	// FlipKeysShard is the erasure coded part of the private flip keys package
+	FlipKeysShard DataType = 6
+)
+
+const (
+	// SecurityNoise prefers Noise secure channels and falls back to TLS for peers without Noise
+	SecurityNoise = "noise"
+	// SecurityNoiseOnly accepts Noise secure channels only
+	SecurityNoiseOnly = "noise-only"
 )
 
 var (
@@ -90,6 +101,9 @@ type Proxy interface {
 	Host() core2.Host
 	ShouldPin(dataType DataType) bool
 	GetWithSizeLimit(key []byte, dataType DataType, size int64) ([]byte, error)
+	ConnectProviders(key []byte, count int) (int, error)
+	// RepoSize returns the size of the local store and its limit in bytes
+	RepoSize() (size uint64, storageMax uint64, err error)
 }
 type ipfsProxy struct {
 	node                 *core.IpfsNode
@@ -170,7 +184,12 @@ func createNode(cfg *config.IpfsConfig) (*core.IpfsNode, context.Context, contex
 
 	ctx, cancelCtx := context.WithCancel(context.Background())
 
-	node, err := core.NewNode(ctx, getNodeConfig(dataDir))
+	nodeConfig, err := getNodeConfig(dataDir, cfg)
+	if err != nil {
+		cancelCtx()
+		return nil, nil, func() {}, err
+	}
+	node, err := core.NewNode(ctx, nodeConfig)
 	if err != nil {
 		cancelCtx()
 		return nil, nil, func() {}, err
@@ -297,6 +316,18 @@ func (p *ipfsProxy) maxSize(dataType DataType) int64 {
 	}
 }
 
+// sizeLimit combines the requested limit with the limit of the data type
+func (p *ipfsProxy) sizeLimit(dataType DataType, maxSize int64) int64 {
+	if maxSize == 0 {
+		return p.maxSize(dataType)
+	}
+	dataTypeMaxSize := p.maxSize(dataType)
+	if dataTypeMaxSize > 0 {
+		return int64(math.MinInt(int(maxSize), int(dataTypeMaxSize)))
+	}
+	return maxSize
+}
+
 func (p *ipfsProxy) Add(data []byte, pin bool) (cid.Cid, error) {
 	if len(data) == 0 {
 		return EmptyCid, nil
@@ -378,7 +409,7 @@ func (p *ipfsProxy) Get(key []byte, dataType DataType) ([]byte, error) {
 	if c == EmptyCid {
 		return []byte{}, nil
 	}
-	return p.get(path.IpfsPath(c), dataType, 0)
+	return p.getWithFallback(c, dataType, 0)
 }
 
 func (p *ipfsProxy) GetWithSizeLimit(key []byte, dataType DataType, maxSize int64) ([]byte, error) {
@@ -393,7 +424,26 @@ func (p *ipfsProxy) GetWithSizeLimit(key []byte, dataType DataType, maxSize int6
 		return []byte{}, nil
 	}
 
-	return p.get(path.IpfsPath(c), dataType, maxSize)
+	return p.getWithFallback(c, dataType, maxSize)
+}
+
+// getWithFallback fetches flips missed by the embedded node from trusted HTTP gateways, the fetched data is added
+// to the local store to be provided to other peers
+func (p *ipfsProxy) getWithFallback(c cid.Cid, dataType DataType, maxSize int64) ([]byte, error) {
+	data, err := p.get(path.IpfsPath(c), dataType, maxSize)
+	if err == nil || err == TooBigErr || dataType != Flip || len(p.cfg.FlipGateways) == 0 {
+		return data, err
+	}
+	data, gateway, gatewayErr := fetchFromGateways(p.cfg.FlipGateways, c, p.sizeLimit(dataType, maxSize), p.Cid)
+	if gatewayErr != nil {
+		p.log.Warn("fail to fetch flip from gateways", "cid", c.String(), "err", gatewayErr)
+		return nil, err
+	}
+	p.log.Info("flip fetched from gateway", "cid", c.String(), "gateway", gateway)
+	if _, err := p.Add(data, false); err != nil {
+		p.log.Warn("fail to add flip fetched from gateway", "cid", c.String(), "err", err)
+	}
+	return data, nil
 }
 
 func (p *ipfsProxy) get(path path.Path, dataType DataType, maxSize int64) ([]byte, error) {
@@ -420,14 +470,7 @@ func (p *ipfsProxy) get(path path.Path, dataType DataType, maxSize int64) ([]byt
 	file := files.ToFile(f)
 	defer file.Close()
 
-	if maxSize == 0 {
-		maxSize = p.maxSize(dataType)
-	} else {
-		dataTypeMaxSize := p.maxSize(dataType)
-		if dataTypeMaxSize > 0 {
-			maxSize = int64(math.MinInt(int(maxSize), int(dataTypeMaxSize)))
-		}
-	}
+	maxSize = p.sizeLimit(dataType, maxSize)
 
 	if maxSize > 0 {
 		size, err := file.Size()
@@ -449,6 +492,37 @@ func (p *ipfsProxy) get(path path.Path, dataType DataType, maxSize int64) ([]byt
 	return buf.Bytes(), nil
 }
 
+// ConnectProviders finds peers providing the data in the DHT and connects to them, so the data can be fetched from them on the next attempt
+func (p *ipfsProxy) ConnectProviders(key []byte, count int) (int, error) {
+	c, err := cid.Cast(key)
+	if err != nil {
+		return 0, err
+	}
+
+	p.rwLock.RLock()
+	defer p.rwLock.RUnlock()
+
+	api, _ := coreapi.NewCoreAPI(p.node)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*20)
+	defer cancel()
+	providers, err := api.Dht().FindProviders(ctx, path.IpfsPath(c), options.Dht.NumProviders(count))
+	if err != nil {
+		return 0, err
+	}
+	connected := 0
+	for provider := range providers {
+		if provider.ID == p.node.Identity {
+			continue
+		}
+		if err := api.Swarm().Connect(ctx, provider); err != nil {
+			p.log.Debug("cannot connect to ipfs provider", "cid", c.String(), "peer", provider.ID.String(), "err", err)
+			continue
+		}
+		connected++
+	}
+	return connected, nil
+}
+
 func (p *ipfsProxy) LoadTo(key []byte, to io.Writer, ctx context.Context, onLoading func(size, loaded int64)) error {
 	if len(key) == 0 {
 		return nil
@@ -539,6 +613,18 @@ func (p *ipfsProxy) Unpin(key []byte) error {
 	return err
 }
 
+func (p *ipfsProxy) RepoSize() (uint64, uint64, error) {
+	p.rwLock.RLock()
+	defer p.rwLock.RUnlock()
+	ctx, cancel := context.WithTimeout(p.nodeCtx, time.Minute)
+	defer cancel()
+	stat, err := corerepo.RepoSize(ctx, p.node)
+	if err != nil {
+		return 0, 0, err
+	}
+	return stat.RepoSize, stat.StorageMax, nil
+}
+
 func (p *ipfsProxy) Port() int {
 	return p.cfg.IpfsPort
 }
@@ -601,14 +687,58 @@ func (p *ipfsProxy) Cid(data []byte) (cid.Cid, error) {
 	return nd.Cid(), nil
 }
 
+// listenAddrs returns tcp multiaddrs of the hosts, all IPv4 and IPv6 interfaces are used if hosts are not set
+func listenAddrs(hosts []string, port int) ([]string, error) {
+	if len(hosts) == 0 {
+		hosts = []string{"0.0.0.0", "::"}
+	}
+	var result []string
+	for _, host := range hosts {
+		ip := net.ParseIP(host)
+		if ip == nil {
+			return nil, errors.Errorf("invalid listen host %v", host)
+		}
+		if ip.To4() != nil {
+			result = append(result, fmt.Sprintf("/ip4/%v/tcp/%d", ip, port))
+		} else {
+			result = append(result, fmt.Sprintf("/ip6/%v/tcp/%d", ip, port))
+		}
+	}
+	return result, nil
+}
+
 func configureIpfs(cfg *config.IpfsConfig) (*ipfsConf.Config, error) {
 	updateIpfsConfig := func(ipfsConfig *ipfsConf.Config) error {
-		ipfsConfig.Addresses.Swarm = []string{
-			fmt.Sprintf("/ip4/0.0.0.0/tcp/%d", cfg.IpfsPort),
-			fmt.Sprintf("/ip6/::/tcp/%d", cfg.IpfsPort),
+		swarmAddrs, err := listenAddrs(cfg.ListenHosts, cfg.IpfsPort)
+		if err != nil {
+			return err
+		}
+		ipfsConfig.Addresses.Swarm = swarmAddrs
+		ipfsConfig.Addresses.Announce = cfg.AnnounceAddrs
+		ipfsConfig.Addresses.NoAnnounce = cfg.NoAnnounceAddrs
+		ipfsConfig.Swarm.AddrFilters = cfg.DialFilters
+		if cfg.DisableInbound {
+			ipfsConfig.Addresses.Swarm = []string{}
+		}
+		// the socks transport replaces the default ones, so all dials go through the proxy
+		defaultTransports := ipfsConf.Default
+		if cfg.Socks5Proxy != "" {
+			defaultTransports = ipfsConf.False
+		}
+		ipfsConfig.Swarm.Transports.Network.TCP = defaultTransports
+		ipfsConfig.Swarm.Transports.Network.Websocket = defaultTransports
+		ipfsConfig.Swarm.Transports.Network.QUIC = defaultTransports
+		if err := applySecurity(ipfsConfig, cfg.Security); err != nil {
+			return err
 		}
 
-		bps, err := ipfsConf.ParseBootstrapPeers(cfg.BootNodes)
+		bootNodes := cfg.BootNodes
+		if len(cfg.BootDnsNames) > 0 {
+			dnsNodes := dnsseed.Resolve(cfg.BootDnsNames, common.HexToAddress(cfg.BootDnsSigner), nil)
+			log.Info("Bootstrap nodes resolved from DNS", "cnt", len(dnsNodes))
+			bootNodes = append(append([]string{}, bootNodes...), dnsNodes...)
+		}
+		bps, err := ipfsConf.ParseBootstrapPeers(bootNodes)
 		if err != nil {
 			return err
 		}
@@ -646,10 +776,18 @@ func configureIpfs(cfg *config.IpfsConfig) (*ipfsConf.Config, error) {
 		ipfsConfig.Swarm.EnableRelayHop = false
 		ipfsConfig.Experimental.FilestoreEnabled = true
 
-		transformer, _ := config2.Profiles["badgerds"]
+		if cfg.Datastore != "" {
+			spec, err := datastoreSpec(cfg.Datastore, cfg.DatastorePath)
+			if err != nil {
+				return nil, err
+			}
+			ipfsConfig.Datastore.Spec = spec
+		} else {
+			transformer, _ := config2.Profiles["badgerds"]
 
-		if err := transformer.Transform(ipfsConfig); err != nil {
-			return nil, err
+			if err := transformer.Transform(ipfsConfig); err != nil {
+				return nil, err
+			}
 		}
 		err = updateIpfsConfig(ipfsConfig)
 		if err != nil {
@@ -677,6 +815,9 @@ func configureIpfs(cfg *config.IpfsConfig) (*ipfsConf.Config, error) {
 		if err != nil {
 			return nil, err
 		}
+		if err := checkDatastore(cfg, datadir); err != nil {
+			return nil, err
+		}
 
 		repo, err := fsrepo.Open(datadir)
 
@@ -699,6 +840,26 @@ func configureIpfs(cfg *config.IpfsConfig) (*ipfsConf.Config, error) {
 	return ipfsConfig, nil
 }
 
+// applySecurity orders secure channels offered to peers, the first one supported by both sides is negotiated
+// when the connection is established
+func applySecurity(ipfsConfig *ipfsConf.Config, security string) error {
+	switch security {
+	case "":
+		ipfsConfig.Swarm.Transports.Security.TLS = ipfsConf.DefaultPriority
+		ipfsConfig.Swarm.Transports.Security.Noise = ipfsConf.DefaultPriority
+	case SecurityNoise:
+		ipfsConfig.Swarm.Transports.Security.Noise = 50
+		ipfsConfig.Swarm.Transports.Security.TLS = ipfsConf.DefaultPriority
+	case SecurityNoiseOnly:
+		ipfsConfig.Swarm.Transports.Security.Noise = ipfsConf.DefaultPriority
+		ipfsConfig.Swarm.Transports.Security.TLS = ipfsConf.Disabled
+	default:
+		return errors.Errorf("unknown p2p security %v", security)
+	}
+	ipfsConfig.Swarm.Transports.Security.SECIO = ipfsConf.Disabled
+	return nil
+}
+
 func writeSwarmKey(dataDir string, swarmKey string) {
 	swarmPath := filepath.Join(dataDir, "swarm.key")
 	err := ioutil.WriteFile(swarmPath, []byte(fmt.Sprintf("/key/swarm/psk/1.0.0/\n/base16/\n%v", swarmKey)), 0644)
@@ -707,10 +868,19 @@ func writeSwarmKey(dataDir string, swarmKey string) {
 	}
 }
 
-func getNodeConfig(dataDir string) *core.BuildCfg {
+func getNodeConfig(dataDir string, cfg *config.IpfsConfig) (*core.BuildCfg, error) {
 	repo, _ := fsrepo.Open(dataDir)
 
+	var hostOption libp2p.HostOption
+	if cfg.Socks5Proxy != "" {
+		var err error
+		if hostOption, err = socksHostOption(cfg.Socks5Proxy, !cfg.DisableInbound); err != nil {
+			return nil, err
+		}
+	}
+
 	return &core.BuildCfg{
+		Host:                        hostOption,
 		Repo:                        repo,
 		Permanent:                   true,
 		Online:                      true,
@@ -720,8 +890,7 @@ func getNodeConfig(dataDir string) *core.BuildCfg {
 			"ipnsps": false,
 			"mplex":  false,
 		},
-	}
-
+	}, nil
 }
 
 func loadPlugins(cfg *config.IpfsConfig) error {
@@ -758,6 +927,14 @@ type memoryIpfs struct {
 
 
 
+func (i *memoryIpfs) RepoSize() (uint64, uint64, error) {
+	var size uint64
+	for _, value := range i.values {
+		size += uint64(len(value))
+	}
+	return size, 0, nil
+}
+
 func (i *memoryIpfs) ShouldPin(dataType DataType) bool {
 	return true
 }
@@ -798,6 +975,10 @@ func (i *memoryIpfs) Get(key []byte, dataType DataType) ([]byte, error) {
 	return nil, errors.New("not found")
 }
 
+func (i *memoryIpfs) ConnectProviders(key []byte, count int) (int, error) {
+	return 0, nil
+}
+
 func (i *memoryIpfs) GetWithSizeLimit(key []byte, dataType DataType, size int64) ([]byte, error) {
 	panic("implement me")
 }
@@ -1,28 +1,42 @@
 package ipfs
 
 import (
+	"bytes"
 	"github.com/google/tink/go/subtle/random"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/common/eventbus"
 	"github.com/idena-network/idena-go/config"
+	"github.com/ipfs/go-cid"
+	ipfsConf "github.com/ipfs/go-ipfs-config"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
 	"testing"
 )
 
 var proxy Proxy
 
-func init() {
-	var err error
+func TestMain(m *testing.M) {
+	dir, err := ioutil.TempDir("", "datadir-ipfs")
+	if err != nil {
+		panic(err)
+	}
 	proxy, err = NewIpfsProxy(&config.IpfsConfig{
 		SwarmKey:    "9ad6f96bb2b02a7308ad87938d6139a974b550cc029ce416641a60c46db2f530",
 		BootNodes:   []string{},
 		IpfsPort:    4012,
-		DataDir:     "./datadir-ipfs",
+		DataDir:     dir,
 		GracePeriod: "20s",
 	}, eventbus.New())
 	if err != nil {
 		panic(err)
 	}
+	code := m.Run()
+	os.RemoveAll(dir)
+	os.Exit(code)
 }
 
 func TestIpfsProxy_Cid(t *testing.T) {
@@ -106,3 +120,88 @@ func TestIpfsProxy_Get_Limit(t *testing.T) {
 	_, err = proxy.Get(cid.Bytes(), Block)
 	require.NoError(err)
 }
+
+func Test_listenAddrs(t *testing.T) {
+	addrs, err := listenAddrs(nil, 40405)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/ip4/0.0.0.0/tcp/40405", "/ip6/::/tcp/40405"}, addrs)
+
+	addrs, err = listenAddrs([]string{"192.168.1.5", "2001:db8::1"}, 40404)
+	require.NoError(t, err)
+	require.Equal(t, []string{"/ip4/192.168.1.5/tcp/40404", "/ip6/2001:db8::1/tcp/40404"}, addrs)
+
+	_, err = listenAddrs([]string{"localhost"}, 40404)
+	require.Error(t, err)
+}
+
+func Test_fetchFromGateways(t *testing.T) {
+	data := []byte("flip data")
+	expected, err := cid.Decode("bafkreihdwdcefgh4dqkjv67uzcmw7ojee6xedzdetojuzjevtenxquvyku")
+	require.NoError(t, err)
+	computeCid := func(d []byte) (cid.Cid, error) {
+		if bytes.Equal(d, data) {
+			return expected, nil
+		}
+		return cid.Undef, nil
+	}
+	good := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/ipfs/"+expected.String(), r.URL.Path)
+		w.Write(data)
+	}))
+	defer good.Close()
+	forged := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("forged data"))
+	}))
+	defer forged.Close()
+	missing := httptest.NewServer(http.NotFoundHandler())
+	defer missing.Close()
+
+	result, gateway, err := fetchFromGateways([]string{missing.URL, forged.URL, good.URL + "/"}, expected, 100, computeCid)
+	require.NoError(t, err)
+	require.Equal(t, data, result)
+	require.Equal(t, good.URL+"/", gateway)
+
+	_, _, err = fetchFromGateways([]string{forged.URL}, expected, 100, computeCid)
+	require.Equal(t, GatewayCidMismatchErr, errors.Cause(err))
+
+	_, _, err = fetchFromGateways([]string{good.URL}, expected, 5, computeCid)
+	require.Equal(t, TooBigErr, errors.Cause(err))
+
+	_, _, err = fetchFromGateways(nil, expected, 100, computeCid)
+	require.Equal(t, NoGatewaysErr, err)
+}
+
+func Test_datastoreSpec(t *testing.T) {
+	ipfsConfig := &ipfsConf.Config{}
+	require.NoError(t, ipfsConf.Profiles["badgerds"].Transform(ipfsConfig))
+	spec, err := datastoreSpec(DatastoreBadger, "")
+	require.NoError(t, err)
+	require.Equal(t, ipfsConfig.Datastore.Spec, spec)
+	require.Equal(t, []string{"badgerds"}, datastorePaths(spec))
+
+	spec, err = datastoreSpec(DatastoreFlatfs, "/mnt/hdd/flips")
+	require.NoError(t, err)
+	require.Equal(t, []string{"/mnt/hdd/flips", "datastore"}, datastorePaths(spec))
+
+	_, err = datastoreSpec(DatastorePebble, "")
+	require.Error(t, err)
+	_, err = datastoreSpec("rocksdb", "")
+	require.Error(t, err)
+}
+
+func Test_applySecurity(t *testing.T) {
+	ipfsConfig := &ipfsConf.Config{}
+	require.NoError(t, applySecurity(ipfsConfig, SecurityNoise))
+	noisePriority, _ := ipfsConfig.Swarm.Transports.Security.Noise.WithDefault(300)
+	tlsPriority, tlsEnabled := ipfsConfig.Swarm.Transports.Security.TLS.WithDefault(100)
+	require.True(t, tlsEnabled)
+	require.Less(t, noisePriority, tlsPriority)
+
+	require.NoError(t, applySecurity(ipfsConfig, SecurityNoiseOnly))
+	_, tlsEnabled = ipfsConfig.Swarm.Transports.Security.TLS.WithDefault(100)
+	require.False(t, tlsEnabled)
+	_, noiseEnabled := ipfsConfig.Swarm.Transports.Security.Noise.WithDefault(300)
+	require.True(t, noiseEnabled)
+
+	require.Error(t, applySecurity(ipfsConfig, "plaintext"))
+}
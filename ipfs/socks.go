@@ -0,0 +1,118 @@
+package ipfs
+
+import (
+	"context"
+	"net"
+
+	"github.com/ipfs/go-ipfs/core/node/libp2p"
+	libp2pOpts "github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p-core/transport"
+	tptu "github.com/libp2p/go-libp2p-transport-upgrader"
+	tcp "github.com/libp2p/go-tcp-transport"
+	"github.com/multiformats/go-multiaddr"
+	mafmt "github.com/multiformats/go-multiaddr-fmt"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	netproxy "golang.org/x/net/proxy"
+)
+
+var InboundDisabledErr = errors.New("inbound connections are disabled")
+
+// socksTransport dials tcp peers through the SOCKS5 proxy (e.g. Tor), host names of dns multiaddrs are resolved
+// by the proxy, listening is delegated to the plain tcp transport unless inbound connections are disabled
+type socksTransport struct {
+	upgrader *tptu.Upgrader
+	dialer   netproxy.ContextDialer
+	tcp      *tcp.TcpTransport
+	inbound  bool
+}
+
+// socksConn keeps the dialed multiaddr as the remote one instead of the address of the proxy
+type socksConn struct {
+	net.Conn
+	laddr multiaddr.Multiaddr
+	raddr multiaddr.Multiaddr
+}
+
+func (c *socksConn) LocalMultiaddr() multiaddr.Multiaddr {
+	return c.laddr
+}
+
+func (c *socksConn) RemoteMultiaddr() multiaddr.Multiaddr {
+	return c.raddr
+}
+
+func newSocksTransport(proxyAddr string, inbound bool) (func(upgrader *tptu.Upgrader) *socksTransport, error) {
+	dialer, err := netproxy.SOCKS5("tcp", proxyAddr, nil, netproxy.Direct)
+	if err != nil {
+		return nil, err
+	}
+	contextDialer, ok := dialer.(netproxy.ContextDialer)
+	if !ok {
+		return nil, errors.New("socks5 dialer doesn't support contexts")
+	}
+	return func(upgrader *tptu.Upgrader) *socksTransport {
+		return &socksTransport{
+			upgrader: upgrader,
+			dialer:   contextDialer,
+			tcp:      tcp.NewTCPTransport(upgrader),
+			inbound:  inbound,
+		}
+	}, nil
+}
+
+func (t *socksTransport) Dial(ctx context.Context, raddr multiaddr.Multiaddr, p peer.ID) (transport.CapableConn, error) {
+	_, addr, err := manet.DialArgs(raddr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := t.dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	laddr, err := manet.FromNetAddr(conn.LocalAddr())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return t.upgrader.UpgradeOutbound(ctx, t, &socksConn{Conn: conn, laddr: laddr, raddr: raddr}, p)
+}
+
+func (t *socksTransport) CanDial(addr multiaddr.Multiaddr) bool {
+	return mafmt.TCP.Matches(addr)
+}
+
+func (t *socksTransport) Listen(laddr multiaddr.Multiaddr) (transport.Listener, error) {
+	if !t.inbound {
+		return nil, InboundDisabledErr
+	}
+	return t.tcp.Listen(laddr)
+}
+
+func (t *socksTransport) Protocols() []int {
+	return []int{multiaddr.P_TCP}
+}
+
+func (t *socksTransport) Proxy() bool {
+	return false
+}
+
+func (t *socksTransport) String() string {
+	return "SOCKS5"
+}
+
+// socksHostOption builds the libp2p host with the socks transport, the default transports have to be disabled
+// in the ipfs config to route all dials through the proxy
+func socksHostOption(proxyAddr string, inbound bool) (libp2p.HostOption, error) {
+	constructor, err := newSocksTransport(proxyAddr, inbound)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, id peer.ID, ps peerstore.Peerstore, options ...libp2pOpts.Option) (host.Host, error) {
+		options = append(options, libp2pOpts.Transport(constructor))
+		return libp2p.DefaultHostOption(ctx, id, ps, options...)
+	}, nil
+}
@@ -0,0 +1,144 @@
+package ipfs
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/sec/insecure"
+	tptu "github.com/libp2p/go-libp2p-transport-upgrader"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+// startTestSocksProxy accepts SOCKS5 connect requests without authentication, sends requested addresses
+// to the channel and closes connections after the reply
+func startTestSocksProxy(t *testing.T) (string, chan string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	requested := make(chan string, 10)
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func(conn net.Conn) {
+				defer conn.Close()
+				if addr, err := acceptSocksConnect(conn); err == nil {
+					requested <- addr
+				}
+			}(conn)
+		}
+	}()
+	return listener.Addr().String(), requested
+}
+
+func acceptSocksConnect(conn net.Conn) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if _, err := io.ReadFull(conn, make([]byte, header[1])); err != nil {
+		return "", err
+	}
+	if _, err := conn.Write([]byte{0x05, 0x00}); err != nil {
+		return "", err
+	}
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	var host string
+	switch request[3] {
+	case 0x01:
+		ip := make([]byte, net.IPv4len)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case 0x03:
+		size := make([]byte, 1)
+		if _, err := io.ReadFull(conn, size); err != nil {
+			return "", err
+		}
+		name := make([]byte, size[0])
+		if _, err := io.ReadFull(conn, name); err != nil {
+			return "", err
+		}
+		host = string(name)
+	default:
+		return "", io.ErrUnexpectedEOF
+	}
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+	if _, err := conn.Write([]byte{0x05, 0x00, 0x00, 0x01, 127, 0, 0, 1, 0, 0}); err != nil {
+		return "", err
+	}
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+func newTestSocksTransport(t *testing.T, proxyAddr string, inbound bool) *socksTransport {
+	constructor, err := newSocksTransport(proxyAddr, inbound)
+	require.NoError(t, err)
+	key, _, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPrivateKey(key)
+	require.NoError(t, err)
+	return constructor(&tptu.Upgrader{Secure: insecure.NewWithIdentity(id, key)})
+}
+
+func TestSocksTransport_Dial(t *testing.T) {
+	proxyAddr, requested := startTestSocksProxy(t)
+	transport := newTestSocksTransport(t, proxyAddr, false)
+
+	for _, c := range []struct {
+		addr     string
+		expected string
+	}{
+		{"/ip4/1.2.3.4/tcp/40405", "1.2.3.4:40405"},
+		// host names are resolved by the proxy
+		{"/dns4/node.example.com/tcp/40406", "node.example.com:40406"},
+	} {
+		raddr, err := multiaddr.NewMultiaddr(c.addr)
+		require.NoError(t, err)
+		require.True(t, transport.CanDial(raddr))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		// the proxy closes the connection, so the security handshake fails
+		_, err = transport.Dial(ctx, raddr, peer.ID("remote"))
+		cancel()
+		require.Error(t, err)
+		select {
+		case addr := <-requested:
+			require.Equal(t, c.expected, addr)
+		case <-time.After(time.Second * 5):
+			t.Fatalf("%v is not dialed through the proxy", c.addr)
+		}
+	}
+
+	quicAddr, _ := multiaddr.NewMultiaddr("/ip4/1.2.3.4/udp/40405/quic")
+	require.False(t, transport.CanDial(quicAddr))
+}
+
+func TestSocksTransport_Listen(t *testing.T) {
+	laddr, _ := multiaddr.NewMultiaddr("/ip4/127.0.0.1/tcp/0")
+
+	_, err := newTestSocksTransport(t, "127.0.0.1:9050", false).Listen(laddr)
+	require.Equal(t, InboundDisabledErr, err)
+
+	listener, err := newTestSocksTransport(t, "127.0.0.1:9050", true).Listen(laddr)
+	require.NoError(t, err)
+	require.NoError(t, listener.Close())
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/ipfs"
+	"github.com/urfave/cli"
+	"path/filepath"
+)
+
+var (
+	datastoreFlag = cli.StringFlag{
+		Name:  "datastore",
+		Usage: "Target datastore backend: badger, flatfs or leveldb, the configured one is used if not set",
+	}
+	datastorePathFlag = cli.StringFlag{
+		Name:  "path",
+		Usage: "Target datastore directory, absolute or relative to the ipfs data dir, the configured one is used if not set",
+	}
+
+	ipfsCommand = cli.Command{
+		Name:  "ipfs",
+		Usage: "Embedded ipfs node tools",
+		Subcommands: []cli.Command{
+			{
+				Name:   "migrate-datastore",
+				Usage:  "Copy the ipfs repo into the datastore with another backend or location, the node should be stopped",
+				Flags:  []cli.Flag{datastoreFlag, datastorePathFlag},
+				Action: migrateDatastore,
+			},
+		},
+	}
+)
+
+func migrateDatastore(ctx *cli.Context) error {
+	cfg, err := readCommandConfig(ctx)
+	if err != nil {
+		return err
+	}
+	cfg.IpfsConf.DataDir = filepath.Join(cfg.DataDir, config.DefaultIpfsDataDir)
+	if ctx.IsSet(datastoreFlag.Name) {
+		cfg.IpfsConf.Datastore = ctx.String(datastoreFlag.Name)
+	}
+	if ctx.IsSet(datastorePathFlag.Name) {
+		cfg.IpfsConf.DatastorePath = ctx.String(datastorePathFlag.Name)
+	}
+	copied, err := ipfs.MigrateDatastore(cfg.IpfsConf)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Ipfs datastore is migrated to %v, copied entries: %v\n", cfg.IpfsConf.Datastore, copied)
+	fmt.Println("Set the datastore in the node config before the next start")
+	return nil
+}
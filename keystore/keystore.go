@@ -63,6 +63,10 @@ type KeyStore struct {
 	updating bool // Whether the event notification loop is running
 
 	mu sync.RWMutex
+
+	keydir string
+	meta   *accountsMeta // Names of accounts and the selected account
+	metaMu sync.Mutex
 }
 
 type unlocked struct {
@@ -95,6 +99,7 @@ func (ks *KeyStore) init(keydir string) {
 	// Initialize the set of unlocked keys and the account cache
 	ks.unlocked = make(map[common.Address]*unlocked)
 	ks.cache, ks.changes = newAccountCache(keydir)
+	ks.keydir = keydir
 
 	// TODO: In order for this finalizer to work, there must be no references
 	// to ks. addressCache doesn't keep a reference but unlocked keys do,
@@ -207,6 +212,17 @@ func (ks *KeyStore) Lock(addr common.Address) error {
 	return nil
 }
 
+// Unlocked returns addresses of accounts whose private keys are in memory
+func (ks *KeyStore) Unlocked() []common.Address {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	result := make([]common.Address, 0, len(ks.unlocked))
+	for addr := range ks.unlocked {
+		result = append(result, addr)
+	}
+	return result
+}
+
 // TimedUnlock unlocks the given account with the passphrase. The account
 // stays unlocked for the duration of timeout. A timeout of 0 unlocks the account
 // until the program exits. The account must match a unique key file.
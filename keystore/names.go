@@ -0,0 +1,109 @@
+package keystore
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/idena-network/idena-go/common"
+)
+
+// accountsMetaFile is hidden, so the account cache doesn't treat it as a key file
+const accountsMetaFile = ".accounts.json"
+
+var ErrNameTaken = errors.New("account name is already taken")
+
+type accountsMeta struct {
+	Names    map[common.Address]string `json:"names"`
+	Selected *common.Address           `json:"selected,omitempty"`
+}
+
+func (ks *KeyStore) loadMeta() *accountsMeta {
+	if ks.meta != nil {
+		return ks.meta
+	}
+	ks.meta = &accountsMeta{}
+	if data, err := ioutil.ReadFile(filepath.Join(ks.keydir, accountsMetaFile)); err == nil {
+		json.Unmarshal(data, ks.meta)
+	}
+	if ks.meta.Names == nil {
+		ks.meta.Names = make(map[common.Address]string)
+	}
+	return ks.meta
+}
+
+func (ks *KeyStore) saveMeta() error {
+	data, err := json.Marshal(ks.meta)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(ks.keydir, 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(ks.keydir, accountsMetaFile), data, 0600)
+}
+
+// Name returns the name of the account, empty if it has no name
+func (ks *KeyStore) Name(addr common.Address) string {
+	ks.metaMu.Lock()
+	defer ks.metaMu.Unlock()
+	return ks.loadMeta().Names[addr]
+}
+
+// SetName names the account, names are unique and an empty name removes the name of the account
+func (ks *KeyStore) SetName(addr common.Address, name string) error {
+	if !ks.HasAddress(addr) {
+		return ErrNoMatch
+	}
+	ks.metaMu.Lock()
+	defer ks.metaMu.Unlock()
+	meta := ks.loadMeta()
+	if name == "" {
+		delete(meta.Names, addr)
+		return ks.saveMeta()
+	}
+	for a, n := range meta.Names {
+		if n == name && a != addr {
+			return ErrNameTaken
+		}
+	}
+	meta.Names[addr] = name
+	return ks.saveMeta()
+}
+
+// FindByName returns the address of the named account
+func (ks *KeyStore) FindByName(name string) (common.Address, bool) {
+	ks.metaMu.Lock()
+	defer ks.metaMu.Unlock()
+	for a, n := range ks.loadMeta().Names {
+		if n == name {
+			return a, true
+		}
+	}
+	return common.Address{}, false
+}
+
+// Select makes the account the default one, nil resets the selection
+func (ks *KeyStore) Select(addr *common.Address) error {
+	if addr != nil && !ks.HasAddress(*addr) {
+		return ErrNoMatch
+	}
+	ks.metaMu.Lock()
+	defer ks.metaMu.Unlock()
+	ks.loadMeta().Selected = addr
+	return ks.saveMeta()
+}
+
+// Selected returns the default account, nil if no account is selected or the selected key was removed
+func (ks *KeyStore) Selected() *common.Address {
+	ks.metaMu.Lock()
+	selected := ks.loadMeta().Selected
+	ks.metaMu.Unlock()
+	if selected == nil || !ks.HasAddress(*selected) {
+		return nil
+	}
+	addr := *selected
+	return &addr
+}
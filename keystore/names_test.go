@@ -0,0 +1,49 @@
+package keystore
+
+import (
+	"os"
+	"testing"
+
+	"github.com/idena-network/idena-go/common"
+)
+
+func TestKeyStore_NamesAndSelection(t *testing.T) {
+	dir, ks := tmpKeyStore(t, true)
+	defer os.RemoveAll(dir)
+
+	a1, err := ks.NewAccount("foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a2, err := ks.NewAccount("bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.SetName(a1.Address, "first"); err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.SetName(a2.Address, "first"); err != ErrNameTaken {
+		t.Fatalf("expected %v, got %v", ErrNameTaken, err)
+	}
+	if err := ks.SetName(common.Address{1}, "unknown"); err != ErrNoMatch {
+		t.Fatalf("expected %v, got %v", ErrNoMatch, err)
+	}
+	if err := ks.Select(&a2.Address); err != nil {
+		t.Fatal(err)
+	}
+
+	// names and the selection are persisted
+	reopened := NewKeyStore(dir, veryLightScryptN, veryLightScryptP)
+	if name := reopened.Name(a1.Address); name != "first" {
+		t.Fatalf("unexpected name %v", name)
+	}
+	if addr, ok := reopened.FindByName("first"); !ok || addr != a1.Address {
+		t.Fatalf("unexpected account %v", addr.Hex())
+	}
+	if selected := reopened.Selected(); selected == nil || *selected != a2.Address {
+		t.Fatalf("unexpected selected account %v", selected)
+	}
+	if len(reopened.Accounts()) != 2 {
+		t.Fatalf("unexpected accounts count %v", len(reopened.Accounts()))
+	}
+}
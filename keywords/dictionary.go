@@ -0,0 +1,99 @@
+package keywords
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/hexutil"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/pkg/errors"
+	"io/ioutil"
+	"sync"
+)
+
+// signedDictionary is the file of the external flip words dictionary, the hash of the compact keywords array
+// is signed by the god address and pinned on-chain by ChangeWordsDictionaryTx
+type signedDictionary struct {
+	Keywords  json.RawMessage `json:"keywords"`
+	Signature hexutil.Bytes   `json:"signature"`
+}
+
+var (
+	dictionaries      = make(map[common.Hash][]Keyword)
+	dictionariesMutex sync.RWMutex
+)
+
+// LoadSignedDictionary parses the dictionary file and returns its hash, the address which signed it and the keywords
+func LoadSignedDictionary(path string) (hash common.Hash, signer common.Address, list []Keyword, err error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return common.Hash{}, common.Address{}, nil, err
+	}
+	return ParseSignedDictionary(data)
+}
+
+func ParseSignedDictionary(data []byte) (hash common.Hash, signer common.Address, list []Keyword, err error) {
+	dictionary := new(signedDictionary)
+	if err := json.Unmarshal(data, dictionary); err != nil {
+		return common.Hash{}, common.Address{}, nil, errors.Wrap(err, "cannot parse dictionary")
+	}
+	if err := json.Unmarshal(dictionary.Keywords, &list); err != nil {
+		return common.Hash{}, common.Address{}, nil, errors.Wrap(err, "cannot parse dictionary keywords")
+	}
+	if len(list) == 0 {
+		return common.Hash{}, common.Address{}, nil, errors.New("dictionary is empty")
+	}
+	// the hash doesn't depend on formatting of the file
+	compact := new(bytes.Buffer)
+	if err := json.Compact(compact, dictionary.Keywords); err != nil {
+		return common.Hash{}, common.Address{}, nil, err
+	}
+	hash = crypto.Hash(compact.Bytes())
+	pubKey, err := crypto.SigToPub(hash[:], dictionary.Signature)
+	if err != nil {
+		return common.Hash{}, common.Address{}, nil, errors.Wrap(err, "invalid dictionary signature")
+	}
+	return hash, crypto.PubkeyToAddress(*pubKey), list, nil
+}
+
+// SignDictionary creates the content of the dictionary file signed by the key
+func SignDictionary(list []Keyword, key *ecdsa.PrivateKey) ([]byte, error) {
+	keywords, err := json.Marshal(list)
+	if err != nil {
+		return nil, err
+	}
+	hash := crypto.Hash(keywords)
+	signature, err := crypto.Sign(hash[:], key)
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(&signedDictionary{
+		Keywords:  keywords,
+		Signature: signature,
+	}, "", "  ")
+}
+
+// Register makes the dictionary available by its hash
+func Register(hash common.Hash, list []Keyword) {
+	dictionariesMutex.Lock()
+	defer dictionariesMutex.Unlock()
+	dictionaries[hash] = list
+}
+
+// GetFromDictionary returns the keyword of the dictionary with the given hash, zero hash means the built-in dictionary
+func GetFromDictionary(hash common.Hash, index int) (Keyword, error) {
+	if hash == (common.Hash{}) {
+		return Get(index)
+	}
+	dictionariesMutex.RLock()
+	dictionary, ok := dictionaries[hash]
+	dictionariesMutex.RUnlock()
+	if !ok {
+		return Keyword{}, errors.Errorf("words dictionary %v is not loaded", hash.Hex())
+	}
+	if index >= len(dictionary) || index < 0 {
+		return Keyword{}, errors.New("index is out of range")
+	}
+	return dictionary[index], nil
+}
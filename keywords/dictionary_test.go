@@ -0,0 +1,52 @@
+package keywords
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseSignedDictionary(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	list := []Keyword{{Name: "apple", Desc: "fruit"}, {Name: "boat", Desc: "vessel"}}
+	data, err := SignDictionary(list, key)
+	require.NoError(t, err)
+
+	hash, signer, parsed, err := ParseSignedDictionary(data)
+	require.NoError(t, err)
+	require.Equal(t, crypto.PubkeyToAddress(key.PublicKey), signer)
+	require.Equal(t, list, parsed)
+
+	// formatting of the file doesn't change the hash
+	compact := new(bytes.Buffer)
+	require.NoError(t, json.Compact(compact, data))
+	compactHash, _, _, err := ParseSignedDictionary(compact.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, hash, compactHash)
+
+	// modified keywords are signed by another address
+	_, otherSigner, _, err := ParseSignedDictionary(bytes.Replace(data, []byte("apple"), []byte("pear"), 1))
+	require.NoError(t, err)
+	require.NotEqual(t, signer, otherSigner)
+}
+
+func TestGetFromDictionary(t *testing.T) {
+	hash := common.Hash{0x1}
+	_, err := GetFromDictionary(hash, 0)
+	require.Error(t, err)
+
+	Register(hash, []Keyword{{Name: "apple"}})
+	keyword, err := GetFromDictionary(hash, 0)
+	require.NoError(t, err)
+	require.Equal(t, "apple", keyword.Name)
+	_, err = GetFromDictionary(hash, 1)
+	require.Error(t, err)
+
+	builtIn, _ := Get(0)
+	keyword, err = GetFromDictionary(common.Hash{}, 0)
+	require.NoError(t, err)
+	require.Equal(t, builtIn, keyword)
+}
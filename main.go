@@ -9,8 +9,10 @@ import (
 	"github.com/urfave/cli"
 	"io/ioutil"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"runtime"
+	"syscall"
 )
 
 const (
@@ -33,22 +35,75 @@ func main() {
 		config.TcpPortFlag,
 		config.RpcHostFlag,
 		config.RpcPortFlag,
+		config.GraphQLFlag,
+		config.MetricsHostFlag,
+		config.MetricsPortFlag,
+		config.GrpcHostFlag,
+		config.GrpcPortFlag,
+		config.WsHostFlag,
+		config.WsPortFlag,
 		config.BootNodeFlag,
 		config.AutomineFlag,
 		config.IpfsBootNodeFlag,
+		config.IpfsBootDnsFlag,
+		config.IpfsFlipGatewaysFlag,
+		config.IpfsDatastoreFlag,
+		config.IpfsDatastorePathFlag,
+		config.IpfsSocks5ProxyFlag,
+		config.IpfsNoInboundFlag,
+		config.IpfsSecurityFlag,
+		config.IpfsBootDnsSignerFlag,
+		config.IpfsListenHostsFlag,
+		config.IpfsAnnounceFlag,
 		config.IpfsPortFlag,
 		config.NoDiscoveryFlag,
 		config.VerbosityFlag,
 		config.GodAddressFlag,
 		config.CeremonyTimeFlag,
 		config.MaxNetworkDelayFlag,
+		config.MaxPeerUploadRateFlag,
+		config.MaxPeerDownloadRateFlag,
+		config.TrustedPeersFlag,
+		config.SubnetPeersRatioFlag,
+		config.AsnPeersRatioFlag,
+		config.AsnDatabaseFlag,
+		config.NoSnapshotServingFlag,
+		config.NoPeerExchangeFlag,
+		config.ReplayWindowSizeFlag,
 		config.FastSyncFlag,
 		config.ForceFullSyncFlag,
 		config.ProfileFlag,
 		config.IpfsPortStaticFlag,
+		config.FlipsRetentionFlag,
+		config.NoFlipsGcFlag,
 		config.ApiKeyFlag,
+		config.AdminKeyFlag,
+		config.RpcCorsFlag,
+		config.RpcVHostsFlag,
+		config.RpcTlsCertFlag,
+		config.RpcTlsKeyFlag,
+		config.RpcRateLimitFlag,
+		config.RpcRateBurstFlag,
+		config.RpcExpensiveRateLimitFlag,
+		config.RpcTrustedProxiesFlag,
+		config.RpcReadOnlyFlag,
 		config.LogFileSizeFlag,
 		config.LogColoring,
+		config.ReplayFlag,
+		config.IndexTxHistoryFlag,
+		config.PrescreenFlipsFlag,
+		config.WordsDictionaryFlag,
+		config.ValidationIntervalFlag,
+		config.FlipLotteryDurationFlag,
+		config.ShortSessionDurationFlag,
+		config.LongSessionDurationFlag,
+	}
+
+	app.Commands = []cli.Command{
+		dbCommand,
+		exportCommand,
+		ceremonyCommand,
+		ipfsCommand,
 	}
 
 	app.Action = func(context *cli.Context) error {
@@ -97,7 +152,14 @@ func main() {
 			return err
 		}
 
-		log.Root().SetHandler(log.LvlFilterHandler(logLvl, log.MultiHandler(handler, fileHandler)))
+		// the level can be changed at runtime by admin_setLogLevel
+		glogger := log.NewGlogHandler(log.MultiHandler(handler, fileHandler))
+		glogger.Verbosity(logLvl)
+		log.Root().SetHandler(glogger)
+
+		if context.IsSet(config.ReplayFlag.Name) {
+			return replayConsensus(cfg, context.String(config.ReplayFlag.Name))
+		}
 
 		log.Info("Idena node is starting", "version", version)
 
@@ -106,6 +168,13 @@ func main() {
 			return err
 		}
 		n.Start()
+		go func() {
+			sigc := make(chan os.Signal, 1)
+			signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+			<-sigc
+			log.Info("Got interrupt, shutting down...")
+			n.Stop()
+		}()
 		n.WaitForStop()
 		return nil
 	}
@@ -1,9 +1,11 @@
 package node
 
 import (
+	"crypto/tls"
 	"fmt"
 	"github.com/idena-network/idena-go/api"
 	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/fee"
 	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common/eventbus"
 	util "github.com/idena-network/idena-go/common/ulimit"
@@ -15,29 +17,49 @@ import (
 	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/profile"
 	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/training"
 	"github.com/idena-network/idena-go/core/upgrade"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/deferredtx"
+	"github.com/idena-network/idena-go/graphql"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/keystore"
+	"github.com/idena-network/idena-go/keywords"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/pengings"
+	"github.com/idena-network/idena-go/prometheus"
+	models "github.com/idena-network/idena-go/protobuf"
 	"github.com/idena-network/idena-go/protocol"
 	"github.com/idena-network/idena-go/rpc"
 	"github.com/idena-network/idena-go/secstore"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/idena-network/idena-go/vm"
+	"github.com/idena-network/idena-go/watchlist"
 	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
 	"net"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/syndtr/goleveldb/leveldb/filter"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	levelutil "github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+const (
+	// shutdownDelay is the delay of the shutdown requested by RPC
+	shutdownDelay = time.Second
+	// graphQLPath is the path of the HTTP RPC endpoint which serves GraphQL queries
+	graphQLPath = "/graphql"
 )
 
 type Node struct {
@@ -47,6 +69,7 @@ type Node struct {
 	secStore        *secstore.SecStore
 	pm              *protocol.IdenaGossipHandler
 	stop            chan struct{}
+	stopOnce        sync.Once
 	proposals       *pengings.Proposals
 	votes           *pengings.Votes
 	consensusEngine *consensus.Engine
@@ -55,6 +78,13 @@ type Node struct {
 	rpcAPIs         []rpc.API
 	httpListener    net.Listener // HTTP RPC listener socket to server API requests
 	httpHandler     *rpc.Server  // HTTP RPC request handler to process the API requests
+	metricsListener net.Listener // Prometheus metrics listener socket, nil if the endpoint is disabled
+	grpcServer      *grpc.Server // gRPC server, nil if the endpoint is disabled
+	auth            *rpc.Auth    // authorizer of HTTP and websocket RPC requests, nil if requests aren't authorized
+	db              db.DB
+	compacting      int32
+	wsListener      net.Listener // Websocket RPC listener socket to server API requests
+	wsHandler       *rpc.Server  // Websocket RPC request handler to process the API requests
 	log             log.Logger
 	keyStore        *keystore.KeyStore
 	fp              *flip.Flipper
@@ -63,11 +93,14 @@ type Node struct {
 	ceremony        *ceremony.ValidationCeremony
 	downloader      *protocol.Downloader
 	offlineDetector *blockchain.OfflineDetector
+	misbehavior     *blockchain.MisbehaviorDetector
 	appVersion      string
 	profileManager  *profile.Manager
 	deferJob        *deferredtx.Job
 	subManager      *subscriptions.Manager
+	watchManager    *watchlist.Manager
 	upgrader        *upgrade.Upgrader
+	training        *training.Simulator
 }
 
 type NodeCtx struct {
@@ -180,7 +213,14 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	if err != nil {
 		return nil, err
 	}
+	if moved := database.NewRepo(db).MigrateSavedTxs(); moved > 0 {
+		log.Info("Saved txs are moved to the new db prefix", "count", moved)
+	}
+	if config.Blockchain.WriteBalanceJournal {
+		statsCollector = collector.NewBalanceJournal(statsCollector, db, bus)
+	}
 	validation.SetAppConfig(config)
+	fee.SetAppConfig(config)
 	keyStore := keystore.NewKeyStore(keyStoreDir, keystore.StandardScryptN, keystore.StandardScryptP)
 	secStore := secstore.NewSecStore()
 
@@ -193,10 +233,11 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 
 	upgrader := upgrade.NewUpgrader(config, appState, db)
 
-	votes := pengings.NewVotes(appState, bus, offlineDetector, upgrader)
-
 	txpool := mempool.NewTxPool(appState, bus, config, statsCollector)
-	flipKeyPool := mempool.NewKeysPool(db, appState, bus, secStore)
+	misbehaviorDetector := blockchain.NewMisbehaviorDetector(config, appState, txpool, secStore, bus)
+
+	votes := pengings.NewVotes(appState, bus, offlineDetector, misbehaviorDetector, upgrader)
+	flipKeyPool := mempool.NewKeysPool(db, appState, bus, secStore, ipfsProxy, config.Consensus)
 
 	subManager, err := subscriptions.NewManager(config.DataDir)
 	if err != nil {
@@ -204,25 +245,39 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 	}
 
 	chain := blockchain.NewBlockchain(config, db, txpool, appState, ipfsProxy, secStore, bus, offlineDetector, keyStore, subManager, upgrader)
-	proposals, pendingProofs := pengings.NewProposals(chain, appState, offlineDetector, upgrader)
-	flipper := flip.NewFlipper(db, ipfsProxy, flipKeyPool, txpool, secStore, appState, bus)
-	pm := protocol.NewIdenaGossipHandler(ipfsProxy.Host(), config.P2P, chain, proposals, votes, txpool, flipper, bus, flipKeyPool, appVersion, &ceremonyChecker{
+	proposals, pendingProofs := pengings.NewProposals(chain, appState, offlineDetector, misbehaviorDetector, upgrader)
+	flipper := flip.NewFlipper(db, ipfsProxy, flipKeyPool, txpool, secStore, appState, bus, config.Validation, config.IpfsConf)
+	var archive *pengings.MessageArchive
+	if config.ConsensusArchive.Enabled {
+		if archive, err = pengings.NewMessageArchive(config.DataDir, config.ConsensusArchive); err != nil {
+			return nil, err
+		}
+	}
+	pm := protocol.NewIdenaGossipHandler(ipfsProxy.Host(), config.P2P, chain, proposals, votes, archive, txpool, flipper, bus, flipKeyPool, appVersion, &ceremonyChecker{
 		appState: appState,
 		chain:    chain,
-	})
+	}, db)
 	sm := state.NewSnapshotManager(db, appState.State, bus, ipfsProxy, config)
 	downloader := protocol.NewDownloader(pm, config, chain, ipfsProxy, appState, sm, bus, secStore, statsCollector, subManager, keyStore, upgrader)
 	consensusEngine := consensus.NewEngine(chain, pm, proposals, config, appState, votes, txpool, secStore,
 		downloader, offlineDetector, upgrader, statsCollector)
 	ceremony := ceremony.NewValidationCeremony(appState, bus, flipper, secStore, db, txpool, chain, downloader, flipKeyPool, config)
 	profileManager := profile.NewProfileManager(ipfsProxy)
+	trainingSimulator := training.NewSimulator(database.NewRepo(db), config.Validation.GetShortSessionDuration)
 
 	deferJob, err := deferredtx.NewJob(bus, config.DataDir, appState, chain, txpool, keyStore, secStore, vm.NewVmImpl)
 	if err != nil {
 		return nil, err
 	}
 
+	watchManager, err := watchlist.NewManager(config.DataDir, bus, appState)
+	if err != nil {
+		return nil, err
+	}
+
 	node := &Node{
+		stop:            make(chan struct{}),
+		db:              db,
 		config:          config,
 		blockchain:      chain,
 		pm:              pm,
@@ -240,12 +295,15 @@ func NewNodeWithInjections(config *config.Config, bus eventbus.Bus, statsCollect
 		ceremony:        ceremony,
 		downloader:      downloader,
 		offlineDetector: offlineDetector,
+		misbehavior:     misbehaviorDetector,
 		votes:           votes,
 		appVersion:      appVersion,
 		profileManager:  profileManager,
 		deferJob:        deferJob,
 		subManager:      subManager,
+		watchManager:    watchManager,
 		upgrader:        upgrader,
+		training:        trainingSimulator,
 	}
 	return &NodeCtx{
 		Node:            node,
@@ -301,13 +359,17 @@ func (node *Node) StartWithHeight(height uint64) {
 		}
 	}
 
+	node.loadWordsDictionaries()
+
 	node.txpool.Initialize(node.blockchain.Head, node.secStore.GetAddress(), true)
 	node.flipKeyPool.Initialize(node.blockchain.Head)
 	node.votes.Initialize(node.blockchain.Head)
 	node.fp.Initialize()
 	node.ceremony.Initialize(node.blockchain.GetBlock(node.blockchain.Head.Hash()))
 	node.blockchain.ProvideApplyNewEpochFunc(node.ceremony.ApplyNewEpoch)
+	node.offlineDetector.ProvidePeersCountFunc(node.pm.PeersCount)
 	node.offlineDetector.Start(node.blockchain.Head)
+	node.misbehavior.Start(node.blockchain.Head)
 	node.consensusEngine.Start()
 	node.pm.Start()
 	node.upgrader.Start()
@@ -318,6 +380,20 @@ func (node *Node) StartWithHeight(height uint64) {
 	}
 }
 
+// Stop closes RPC endpoints, persists mempool txs and releases WaitForStop
+func (node *Node) Stop() {
+	node.stopOnce.Do(func() {
+		node.stopHTTP()
+		node.stopWS()
+		if node.config.Mempool.PersistTxs {
+			if err := node.txpool.Persist(); err != nil {
+				node.log.Error("Cannot persist mempool txs", "err", err)
+			}
+		}
+		close(node.stop)
+	})
+}
+
 func (node *Node) WaitForStop() {
 	<-node.stop
 	node.secStore.Destroy()
@@ -330,7 +406,53 @@ func (node *Node) startRPC() error {
 	// Gather all the possible APIs to surface
 	apis := node.apis()
 
-	if err := node.startHTTP(node.config.RPC.HTTPEndpoint(), apis, node.config.RPC.HTTPModules, node.config.RPC.HTTPCors, node.config.RPC.HTTPVirtualHosts, node.config.RPC.HTTPTimeouts, node.config.RPC.APIKey); err != nil {
+	auth, err := rpc.NewAuth(node.config.RPC.APIKey, node.config.RPC.AdminKey, node.config.RPC.APIKeys, api.MethodAccess, node.config.RPC.ReadOnly)
+	if err != nil {
+		return err
+	}
+	node.auth = auth
+	if node.config.RPC.ReadOnly {
+		node.log.Info("RPC is read-only, signing, keystore and admin methods are disabled")
+	}
+
+	httpModules, wsModules := node.config.RPC.HTTPModules, node.config.RPC.WSModules
+	if node.config.RPC.AdminKey != "" && !node.config.RPC.ReadOnly {
+		// admin methods are exposed along with the configured modules, empty lists expose all modules anyway
+		if len(httpModules) > 0 {
+			httpModules = append(append([]string{}, httpModules...), "admin")
+		}
+		if len(wsModules) > 0 {
+			wsModules = append(append([]string{}, wsModules...), "admin")
+		}
+	}
+
+	tlsConfig, err := rpc.LoadTLSConfig(node.config.RPC.HTTPTLSCertFile, node.config.RPC.HTTPTLSKeyFile)
+	if err != nil {
+		return err
+	}
+	limiter, err := rpc.NewRateLimiter(node.config.RPC.HTTPRateLimit, node.config.RPC.HTTPRateBurst, node.config.RPC.HTTPTrustedProxies)
+	if err != nil {
+		return err
+	}
+
+	expensiveMethods := append(append([]string{}, api.ExpensiveMethods...), node.config.RPC.ExpensiveMethods...)
+	quotas := rpc.NewQuotas(node.config.RPC.APIKeys, expensiveMethods, node.config.RPC.ExpensiveRateLimit, node.config.RPC.ExpensiveRateBurst)
+
+	if err := node.startHTTP(node.config.RPC.HTTPEndpoint(), apis, httpModules, node.config.RPC.HTTPCors, node.config.RPC.HTTPVirtualHosts, node.config.RPC.HTTPTimeouts, auth, tlsConfig, limiter, quotas); err != nil {
+		return err
+	}
+
+	if err := node.startWS(node.config.RPC.WSEndpoint(), apis, wsModules, node.config.RPC.WSOrigins, auth, quotas); err != nil {
+		return err
+	}
+
+	node.startGraphQL(apis)
+
+	if err := node.startGrpc(node.config.Grpc.Endpoint(), apis, auth, quotas); err != nil {
+		return err
+	}
+
+	if err := node.startMetrics(node.config.Metrics.HTTPEndpoint()); err != nil {
 		return err
 	}
 
@@ -338,17 +460,109 @@ func (node *Node) startRPC() error {
 	return nil
 }
 
+// startGraphQL serves read-only GraphQL queries over the services of the given APIs at /graphql of the HTTP RPC
+// endpoint, a query is authorized and limited as a call of the graphql_query method.
+func (node *Node) startGraphQL(apis []rpc.API) {
+	if !node.config.GraphQL.Enabled || node.httpHandler == nil {
+		return
+	}
+	var (
+		bcApi       *api.BlockchainApi
+		dnaApi      *api.DnaApi
+		contractApi *api.ContractApi
+	)
+	for _, a := range apis {
+		switch service := a.Service.(type) {
+		case *api.BlockchainApi:
+			bcApi = service
+		case *api.DnaApi:
+			dnaApi = service
+		case *api.ContractApi:
+			contractApi = service
+		}
+	}
+	schema := api.NewGraphQLSchema(bcApi, dnaApi, contractApi, node.config.GraphQL.MaxDepth, node.config.GraphQL.MaxComplexity)
+	handler := node.httpHandler
+	handler.HandleHTTP(graphQLPath, graphql.NewHandler(schema, func(r *http.Request, key string) (time.Duration, error) {
+		return handler.AuthorizeHTTP(r, key, api.GraphQLMethod)
+	}))
+	node.log.Info("GraphQL endpoint opened", "url", fmt.Sprintf("%s://%s%s", node.httpScheme(), node.config.RPC.HTTPEndpoint(), graphQLPath))
+}
+
+// startGrpc starts the gRPC endpoint over the services of the given APIs, calls are authorized
+// by the same API keys and quotas as RPC calls.
+func (node *Node) startGrpc(endpoint string, apis []rpc.API, auth *rpc.Auth, quotas *rpc.Quotas) error {
+	if endpoint == "" {
+		return nil
+	}
+	var (
+		bcApi  *api.BlockchainApi
+		dnaApi *api.DnaApi
+	)
+	for _, a := range apis {
+		switch service := a.Service.(type) {
+		case *api.BlockchainApi:
+			bcApi = service
+		case *api.DnaApi:
+			dnaApi = service
+		}
+	}
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return err
+	}
+	authorizer := rpc.NewGrpcAuthorizer(auth, quotas, api.GrpcMethods)
+	server := grpc.NewServer(grpc.UnaryInterceptor(authorizer.UnaryInterceptor), grpc.StreamInterceptor(authorizer.StreamInterceptor))
+	models.RegisterIdenaApiServer(server, api.NewGrpcServer(bcApi, dnaApi, node.config.RPC.ReadOnly))
+	go server.Serve(listener)
+	node.log.Info("gRPC endpoint opened", "addr", endpoint)
+	node.grpcServer = server
+	return nil
+}
+
+// loadWordsDictionaries registers flip words dictionaries signed by the god address
+func (node *Node) loadWordsDictionaries() {
+	god := node.appState.State.GodAddress()
+	for _, path := range node.config.Validation.WordsDictionaryFiles {
+		hash, signer, list, err := keywords.LoadSignedDictionary(path)
+		if err != nil {
+			node.log.Error("Cannot load words dictionary", "path", path, "err", err)
+			continue
+		}
+		if signer != god {
+			node.log.Error("Words dictionary is not signed by the god address", "path", path, "signer", signer.Hex())
+			continue
+		}
+		keywords.Register(hash, list)
+		node.log.Info("Words dictionary loaded", "hash", hash.Hex(), "size", len(list))
+	}
+}
+
+// startMetrics starts the endpoint which serves node metrics in the Prometheus format.
+func (node *Node) startMetrics(endpoint string) error {
+	if endpoint == "" {
+		return nil
+	}
+	listener, err := prometheus.StartHTTPEndpoint(endpoint, metrics.DefaultRegistry)
+	if err != nil {
+		return err
+	}
+	node.log.Info("Metrics endpoint opened", "url", fmt.Sprintf("http://%s/metrics", endpoint))
+	node.metricsListener = listener
+	return nil
+}
+
 // startHTTP initializes and starts the HTTP RPC endpoint.
-func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, apiKey string) error {
+func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, cors []string, vhosts []string, timeouts rpc.HTTPTimeouts, auth *rpc.Auth, tlsConfig *tls.Config, limiter *rpc.RateLimiter, quotas *rpc.Quotas) error {
 	// Short circuit if the HTTP endpoint isn't being exposed
 	if endpoint == "" {
 		return nil
 	}
-	listener, handler, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, apiKey)
+	listener, handler, err := rpc.StartHTTPEndpoint(endpoint, apis, modules, cors, vhosts, timeouts, auth, tlsConfig, limiter, quotas, node.healthChecks())
 	if err != nil {
 		return err
 	}
-	node.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("http://%s", endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
+	node.log.Info("HTTP endpoint opened", "url", fmt.Sprintf("%s://%s", node.httpScheme(), endpoint), "cors", strings.Join(cors, ","), "vhosts", strings.Join(vhosts, ","))
 
 	node.httpListener = listener
 	node.httpHandler = handler
@@ -356,18 +570,65 @@ func (node *Node) startHTTP(endpoint string, apis []rpc.API, modules []string, c
 	return nil
 }
 
+// startWS initializes and starts the websocket RPC endpoint.
+func (node *Node) startWS(endpoint string, apis []rpc.API, modules []string, wsOrigins []string, auth *rpc.Auth, quotas *rpc.Quotas) error {
+	// Short circuit if the WS endpoint isn't being exposed
+	if endpoint == "" {
+		return nil
+	}
+	listener, handler, err := rpc.StartWSEndpoint(endpoint, apis, modules, wsOrigins, false, auth, quotas)
+	if err != nil {
+		return err
+	}
+	node.log.Info("WebSocket endpoint opened", "url", fmt.Sprintf("ws://%s", listener.Addr()))
+
+	node.wsListener = listener
+	node.wsHandler = handler
+
+	return nil
+}
+
+// stopWS terminates the websocket RPC endpoint.
+func (node *Node) stopWS() {
+	if node.wsListener != nil {
+		node.wsListener.Close()
+		node.wsListener = nil
+
+		node.log.Info("WebSocket endpoint closed", "url", fmt.Sprintf("ws://%s", node.config.RPC.WSEndpoint()))
+	}
+	if node.wsHandler != nil {
+		node.wsHandler.Stop()
+		node.wsHandler = nil
+	}
+}
+
+func (node *Node) httpScheme() string {
+	if node.config.RPC.HTTPTLSCertFile != "" {
+		return "https"
+	}
+	return "http"
+}
+
 // stopHTTP terminates the HTTP RPC endpoint.
 func (node *Node) stopHTTP() {
 	if node.httpListener != nil {
 		node.httpListener.Close()
 		node.httpListener = nil
 
-		node.log.Info("HTTP endpoint closed", "url", fmt.Sprintf("http://%s", node.config.RPC.HTTPEndpoint()))
+		node.log.Info("HTTP endpoint closed", "url", fmt.Sprintf("%s://%s", node.httpScheme(), node.config.RPC.HTTPEndpoint()))
 	}
 	if node.httpHandler != nil {
 		node.httpHandler.Stop()
 		node.httpHandler = nil
 	}
+	if node.grpcServer != nil {
+		node.grpcServer.Stop()
+		node.grpcServer = nil
+	}
+	if node.metricsListener != nil {
+		node.metricsListener.Close()
+		node.metricsListener = nil
+	}
 }
 
 func OpenDatabase(datadir string, name string, cache int, handles int) (db.DB, error) {
@@ -379,6 +640,51 @@ func OpenDatabase(datadir string, name string, cache int, handles int) (db.DB, e
 	})
 }
 
+// CompactDatabase starts the compaction of the chain database in the background, the node keeps working
+// during the compaction
+func (node *Node) CompactDatabase() error {
+	levelDb, ok := node.db.(*db.GoLevelDB)
+	if !ok {
+		return errors.New("database doesn't support compaction")
+	}
+	if !atomic.CompareAndSwapInt32(&node.compacting, 0, 1) {
+		return errors.New("database compaction is already running")
+	}
+	go func() {
+		defer atomic.StoreInt32(&node.compacting, 0)
+		node.log.Info("Database compaction started")
+		start := time.Now()
+		if err := levelDb.DB().CompactRange(levelutil.Range{}); err != nil {
+			node.log.Error("Database compaction failed", "err", err)
+			return
+		}
+		node.log.Info("Database compaction completed", "duration", time.Since(start))
+	}()
+	return nil
+}
+
+// RotateApiKey replaces the API key by a random one, requests with the previous key are rejected afterwards
+func (node *Node) RotateApiKey() (string, error) {
+	if node.auth == nil {
+		return "", errors.New("RPC requests aren't authorized by API keys")
+	}
+	key, err := node.config.RotateApiKey()
+	if err != nil {
+		return "", err
+	}
+	if err := node.auth.SetMasterKey(key); err != nil {
+		return "", err
+	}
+	node.log.Info("API key is rotated")
+	return key, nil
+}
+
+// Shutdown stops the node gracefully, the delay lets the response of the request which triggered it be sent
+func (node *Node) Shutdown() {
+	node.log.Info("Node shutdown is requested")
+	time.AfterFunc(shutdownDelay, node.Stop)
+}
+
 // apis returns the collection of RPC descriptors this node offers.
 func (node *Node) apis() []rpc.API {
 
@@ -394,7 +700,7 @@ func (node *Node) apis() []rpc.API {
 		{
 			Namespace: "dna",
 			Version:   "1.0",
-			Service:   api.NewDnaApi(baseApi, node.blockchain, node.ceremony, node.appVersion, node.profileManager),
+			Service:   api.NewDnaApi(baseApi, node.blockchain, node.ceremony, node.appVersion, node.profileManager, node.deferJob, node.bus, node),
 			Public:    true,
 		},
 		{
@@ -409,10 +715,16 @@ func (node *Node) apis() []rpc.API {
 			Service:   api.NewFlipApi(baseApi, node.fp, node.ipfsProxy, node.ceremony),
 			Public:    true,
 		},
+		{
+			Namespace: "training",
+			Version:   "1.0",
+			Service:   api.NewTrainingApi(node.training),
+			Public:    true,
+		},
 		{
 			Namespace: "bcn",
 			Version:   "1.0",
-			Service:   api.NewBlockchainApi(baseApi, node.blockchain, node.ipfsProxy, node.txpool, node.downloader, node.pm),
+			Service:   api.NewBlockchainApi(baseApi, node.blockchain, node.ipfsProxy, node.txpool, node.downloader, node.pm, node.bus, node.misbehavior),
 			Public:    true,
 		},
 		{
@@ -424,7 +736,37 @@ func (node *Node) apis() []rpc.API {
 		{
 			Namespace: "contract",
 			Version:   "1.0",
-			Service:   api.NewContractApi(baseApi, node.blockchain, node.deferJob, node.subManager),
+			Service:   api.NewContractApi(baseApi, node.blockchain, node.deferJob, node.subManager, node.bus),
+			Public:    true,
+		},
+		{
+			Namespace: "oracle",
+			Version:   "1.0",
+			Service:   api.NewOracleApi(baseApi, node.blockchain, node.bus),
+			Public:    true,
+		},
+		{
+			Namespace: "watch",
+			Version:   "1.0",
+			Service:   api.NewWatchApi(baseApi, node.blockchain, node.watchManager, node.bus),
+			Public:    true,
+		},
+		{
+			Namespace: "consensus",
+			Version:   "1.0",
+			Service:   api.NewConsensusApi(node.consensusEngine),
+			Public:    true,
+		},
+		{
+			Namespace: "debug",
+			Version:   "1.0",
+			Service:   api.NewDebugApi(node.blockchain),
+			Public:    true,
+		},
+		{
+			Namespace: "admin",
+			Version:   "1.0",
+			Service:   api.NewAdminApi(node.pm, node),
 			Public:    true,
 		},
 	}
@@ -0,0 +1,68 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/idena-network/idena-go/api"
+	"github.com/idena-network/idena-go/common/diskusage"
+	"github.com/idena-network/idena-go/rpc"
+)
+
+// Status returns the state of the node checked by health and readiness probes
+func (node *Node) Status() *api.NodeStatus {
+	status := &api.NodeStatus{
+		Syncing:          node.downloader.IsSyncing() || !node.pm.HasPeers() || !node.consensusEngine.Synced(),
+		Peers:            node.pm.PeersCount(),
+		KeystoreAccounts: len(node.keyStore.Accounts()),
+		UnlockedAccounts: node.keyStore.Unlocked(),
+		MinFreeDiskSpace: node.config.RPC.MinFreeDiskSpace * 1024 * 1024,
+	}
+	if node.config.Consensus.Automine {
+		status.Syncing = false
+	}
+	status.CurrentBlock, status.HighestBlock = node.downloader.SyncProgress()
+	if !status.Syncing {
+		status.HighestBlock = status.CurrentBlock
+	}
+
+	var healthProblems, readinessProblems []string
+	if appState, err := node.consensusEngine.ReadonlyAppState(); err != nil {
+		healthProblems = append(healthProblems, fmt.Sprintf("state is not readable: %v", err))
+	} else {
+		status.Epoch = appState.State.Epoch()
+		status.CeremonyPeriod = api.CeremonyPeriod(appState, node.ceremony)
+	}
+	if free, err := diskusage.Free(node.config.DataDir); err != nil {
+		node.log.Debug("Cannot get free disk space", "err", err)
+	} else {
+		status.FreeDiskSpace = free
+		if free < status.MinFreeDiskSpace {
+			healthProblems = append(healthProblems, fmt.Sprintf("free disk space %v MB is less than %v MB", free/1024/1024, node.config.RPC.MinFreeDiskSpace))
+		}
+	}
+	if status.Syncing {
+		readinessProblems = append(readinessProblems, "node is syncing")
+	}
+	if status.Peers == 0 && !node.config.Consensus.Automine {
+		readinessProblems = append(readinessProblems, "node has no peers")
+	}
+
+	status.Problems = append(healthProblems, readinessProblems...)
+	status.Healthy = len(healthProblems) == 0
+	status.Ready = len(status.Problems) == 0
+	return status
+}
+
+func (node *Node) healthChecks() *rpc.HealthChecks {
+	return &rpc.HealthChecks{
+		Health: func() []string {
+			if status := node.Status(); !status.Healthy {
+				return status.Problems
+			}
+			return nil
+		},
+		Ready: func() []string {
+			return node.Status().Problems
+		},
+	}
+}
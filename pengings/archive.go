@@ -0,0 +1,291 @@
+package pengings
+
+import (
+	"bufio"
+	"encoding/binary"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type ArchivedMessageType byte
+
+const (
+	ArchivedProof ArchivedMessageType = 1
+	ArchivedBlock ArchivedMessageType = 2
+	ArchivedVote  ArchivedMessageType = 3
+)
+
+const (
+	ConsensusArchiveDir = "consensus-archive"
+
+	archiveFileExt = ".bin"
+	// type (1 byte), round (8 bytes), unix nano time (8 bytes), data length (4 bytes)
+	archiveRecordHeaderSize = 21
+	archiveQueueSize        = 10000
+)
+
+func (t ArchivedMessageType) String() string {
+	switch t {
+	case ArchivedProof:
+		return "proof"
+	case ArchivedBlock:
+		return "block"
+	case ArchivedVote:
+		return "vote"
+	}
+	return "unknown"
+}
+
+type ArchivedMessage struct {
+	Type  ArchivedMessageType
+	Round uint64
+	Time  time.Time
+	Data  []byte
+}
+
+// MessageArchive keeps proposals and votes of the last rounds on disk, one file per round.
+// Rounds older than the configured window are removed and the size of every round file is capped,
+// so the archive never takes more than Rounds * MaxRoundSize bytes.
+type MessageArchive struct {
+	dir          string
+	rounds       uint64
+	maxRoundSize int64
+	queue        chan *ArchivedMessage
+	sizes        map[uint64]int64
+	newestRound  uint64
+	log          log.Logger
+}
+
+func NewMessageArchive(dataDir string, cfg *config.ConsensusArchiveConfig) (*MessageArchive, error) {
+	archive := &MessageArchive{
+		dir:          filepath.Join(dataDir, ConsensusArchiveDir),
+		rounds:       cfg.Rounds,
+		maxRoundSize: cfg.MaxRoundSize,
+		queue:        make(chan *ArchivedMessage, archiveQueueSize),
+		sizes:        make(map[uint64]int64),
+		log:          log.New("component", "consensusArchive"),
+	}
+	if archive.rounds == 0 {
+		return nil, errors.New("number of archived rounds should be positive")
+	}
+	if err := os.MkdirAll(archive.dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	rounds, err := archivedRounds(archive.dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, round := range rounds {
+		info, err := os.Stat(archiveFilePath(archive.dir, round))
+		if err != nil {
+			return nil, err
+		}
+		archive.sizes[round] = info.Size()
+		if round > archive.newestRound {
+			archive.newestRound = round
+		}
+	}
+	archive.prune()
+	go archive.loop()
+	return archive, nil
+}
+
+func (a *MessageArchive) AddProof(proposal *types.ProofProposal) {
+	if a == nil {
+		return
+	}
+	data, err := proposal.ToBytes()
+	if err != nil {
+		return
+	}
+	a.add(ArchivedProof, proposal.Round, data)
+}
+
+// AddBlock archives the block proposal without the block body to keep the archive compact
+func (a *MessageArchive) AddBlock(proposal *types.BlockProposal) {
+	if a == nil || proposal.Block == nil || proposal.Header == nil {
+		return
+	}
+	compact := &types.BlockProposal{
+		Block:     &types.Block{Header: proposal.Header},
+		Signature: proposal.Signature,
+		Proof:     proposal.Proof,
+	}
+	data, err := compact.ToBytes()
+	if err != nil {
+		return
+	}
+	a.add(ArchivedBlock, proposal.Height(), data)
+}
+
+func (a *MessageArchive) AddVote(vote *types.Vote) {
+	if a == nil || vote.Header == nil {
+		return
+	}
+	data, err := vote.ToBytes()
+	if err != nil {
+		return
+	}
+	a.add(ArchivedVote, vote.Header.Round, data)
+}
+
+func (a *MessageArchive) add(msgType ArchivedMessageType, round uint64, data []byte) {
+	select {
+	case a.queue <- &ArchivedMessage{Type: msgType, Round: round, Time: time.Now().UTC(), Data: data}:
+	default:
+		a.log.Debug("Archive queue is full, message is dropped", "round", round, "type", msgType)
+	}
+}
+
+func (a *MessageArchive) loop() {
+	for msg := range a.queue {
+		if err := a.write(msg); err != nil {
+			a.log.Warn("Failed to archive consensus message", "round", msg.Round, "type", msg.Type, "err", err)
+		}
+	}
+}
+
+func (a *MessageArchive) write(msg *ArchivedMessage) error {
+	if msg.Round+a.rounds <= a.newestRound {
+		return nil
+	}
+	size := int64(archiveRecordHeaderSize + len(msg.Data))
+	if a.sizes[msg.Round]+size > a.maxRoundSize {
+		a.log.Debug("Round archive size limit is reached, message is dropped", "round", msg.Round, "type", msg.Type)
+		return nil
+	}
+	file, err := os.OpenFile(archiveFilePath(a.dir, msg.Round), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	_, err = file.Write(encodeArchivedMessage(msg))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	a.sizes[msg.Round] += size
+	if msg.Round > a.newestRound {
+		a.newestRound = msg.Round
+		a.prune()
+	}
+	return nil
+}
+
+func (a *MessageArchive) prune() {
+	for round := range a.sizes {
+		if round+a.rounds > a.newestRound {
+			continue
+		}
+		if err := os.Remove(archiveFilePath(a.dir, round)); err != nil && !os.IsNotExist(err) {
+			a.log.Warn("Failed to remove archived round", "round", round, "err", err)
+			continue
+		}
+		delete(a.sizes, round)
+	}
+}
+
+func encodeArchivedMessage(msg *ArchivedMessage) []byte {
+	result := make([]byte, archiveRecordHeaderSize+len(msg.Data))
+	result[0] = byte(msg.Type)
+	binary.BigEndian.PutUint64(result[1:], msg.Round)
+	binary.BigEndian.PutUint64(result[9:], uint64(msg.Time.UnixNano()))
+	binary.BigEndian.PutUint32(result[17:], uint32(len(msg.Data)))
+	copy(result[archiveRecordHeaderSize:], msg.Data)
+	return result
+}
+
+func readArchivedMessage(r io.Reader) (*ArchivedMessage, error) {
+	header := make([]byte, archiveRecordHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, err
+	}
+	msg := &ArchivedMessage{
+		Type:  ArchivedMessageType(header[0]),
+		Round: binary.BigEndian.Uint64(header[1:]),
+		Time:  time.Unix(0, int64(binary.BigEndian.Uint64(header[9:]))).UTC(),
+		Data:  make([]byte, binary.BigEndian.Uint32(header[17:])),
+	}
+	if _, err := io.ReadFull(r, msg.Data); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+func archiveFilePath(dir string, round uint64) string {
+	return filepath.Join(dir, strconv.FormatUint(round, 10)+archiveFileExt)
+}
+
+func archivedRounds(dir string) ([]uint64, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var rounds []uint64
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, archiveFileExt) {
+			continue
+		}
+		round, err := strconv.ParseUint(strings.TrimSuffix(name, archiveFileExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		rounds = append(rounds, round)
+	}
+	sort.Slice(rounds, func(i, j int) bool {
+		return rounds[i] < rounds[j]
+	})
+	return rounds, nil
+}
+
+// ReadMessageArchive calls fn for every archived message of rounds in [from, to] in round order,
+// a truncated last record of a round file is skipped
+func ReadMessageArchive(dataDir string, from, to uint64, fn func(msg *ArchivedMessage) error) error {
+	dir := filepath.Join(dataDir, ConsensusArchiveDir)
+	rounds, err := archivedRounds(dir)
+	if err != nil {
+		return err
+	}
+	for _, round := range rounds {
+		if round < from || round > to {
+			continue
+		}
+		if err := readArchivedRound(archiveFilePath(dir, round), fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readArchivedRound(path string, fn func(msg *ArchivedMessage) error) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	r := bufio.NewReader(file)
+	for {
+		msg, err := readArchivedMessage(r)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := fn(msg); err != nil {
+			return err
+		}
+	}
+}
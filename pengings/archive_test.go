@@ -0,0 +1,74 @@
+package pengings
+
+import (
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/log"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMessageArchive_write(t *testing.T) {
+	dir, err := ioutil.TempDir("", "archive")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	archiveDir := filepath.Join(dir, ConsensusArchiveDir)
+	require.NoError(t, os.MkdirAll(archiveDir, os.ModePerm))
+
+	vote := &types.Vote{
+		Header: &types.VoteHeader{
+			Round:     1,
+			VotedHash: common.Hash{0x1},
+		},
+		Signature: []byte{0x1, 0x2},
+	}
+	data, _ := vote.ToBytes()
+	recordSize := int64(archiveRecordHeaderSize + len(data))
+
+	archive := &MessageArchive{
+		dir:          archiveDir,
+		rounds:       2,
+		maxRoundSize: recordSize * 2,
+		sizes:        make(map[uint64]int64),
+		log:          log.New(),
+	}
+	now := time.Unix(100, 0).UTC()
+	write := func(round uint64) {
+		require.NoError(t, archive.write(&ArchivedMessage{Type: ArchivedVote, Round: round, Time: now, Data: data}))
+	}
+
+	// the third message of the round exceeds the size limit
+	write(1)
+	write(1)
+	write(1)
+	require.Equal(t, recordSize*2, archive.sizes[1])
+
+	write(2)
+	write(3)
+	// round 1 is out of the window
+	write(1)
+
+	var read []*ArchivedMessage
+	require.NoError(t, ReadMessageArchive(dir, 0, 10, func(msg *ArchivedMessage) error {
+		read = append(read, msg)
+		return nil
+	}))
+	require.Len(t, read, 2)
+	require.Equal(t, uint64(2), read[0].Round)
+	require.Equal(t, uint64(3), read[1].Round)
+	require.Equal(t, ArchivedVote, read[0].Type)
+	require.Equal(t, now, read[0].Time)
+	require.Equal(t, data, read[0].Data)
+
+	restored, err := NewMessageArchive(dir, &config.ConsensusArchiveConfig{Rounds: 1, MaxRoundSize: recordSize})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), restored.newestRound)
+	require.Equal(t, map[uint64]int64{3: recordSize}, restored.sizes)
+	_, err = os.Stat(archiveFilePath(archiveDir, 2))
+	require.True(t, os.IsNotExist(err))
+}
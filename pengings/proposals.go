@@ -28,6 +28,7 @@ type Proposals struct {
 	log             log.Logger
 	chain           *blockchain.Blockchain
 	offlineDetector *blockchain.OfflineDetector
+	misbehavior     *blockchain.MisbehaviorDetector
 	upgrader        *upgrade.Upgrader
 
 	// proposed blocks are grouped by round
@@ -68,11 +69,12 @@ type bestHash struct {
 
 type ProposerByRound func(round uint64) (hash common.Hash, proposer []byte, ok bool)
 
-func NewProposals(chain *blockchain.Blockchain, appState *appstate.AppState, detector *blockchain.OfflineDetector, upgrader *upgrade.Upgrader) (*Proposals, *sync.Map) {
+func NewProposals(chain *blockchain.Blockchain, appState *appstate.AppState, detector *blockchain.OfflineDetector, misbehavior *blockchain.MisbehaviorDetector, upgrader *upgrade.Upgrader) (*Proposals, *sync.Map) {
 	p := &Proposals{
 		chain:                chain,
 		appState:             appState,
 		offlineDetector:      detector,
+		misbehavior:          misbehavior,
 		upgrader:             upgrader,
 		log:                  log.New(),
 		blocksByRound:        &sync.Map{},
@@ -251,6 +253,7 @@ func (proposals *Proposals) AddProposedBlock(proposal *types.BlockProposal, peer
 			log.Warn("Failed proposed block proof validation", "err", err)
 			return false, false
 		}
+		proposals.misbehavior.ProcessProposal(block)
 
 		m, _ := proposals.blocksByRound.LoadOrStore(block.Height(), &sync.Map{})
 		round := m.(*sync.Map)
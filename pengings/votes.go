@@ -16,6 +16,13 @@ const (
 	MaxKnownVotes              = 10000
 	VotesLag                   = 3
 	PropagateFutureVotesPeriod = 30
+	// PendingVotesPeriod is the number of rounds ahead of the current one whose votes of not online voters are kept
+	// until the node catches up, the voters might have become online in blocks the node hasn't received yet
+	PendingVotesPeriod = 2
+	MaxPendingVotes    = 5000
+	// MaxPendingVotesPerVoter and MaxPendingVotesPerRound keep one voter or one round from taking the whole buffer
+	MaxPendingVotesPerVoter = 20
+	MaxPendingVotesPerRound = MaxPendingVotes / PendingVotesPeriod
 )
 
 type Votes struct {
@@ -27,10 +34,15 @@ type Votes struct {
 	headMutex       sync.RWMutex
 	bus             eventbus.Bus
 	offlineDetector *blockchain.OfflineDetector
+	misbehavior     *blockchain.MisbehaviorDetector
 	upgrade         *upgrade.Upgrader
+	pendingVotes    map[common.Hash]*types.Vote
+	pendingByVoter  map[common.Address]int
+	pendingByRound  map[uint64]int
+	pendingMutex    sync.Mutex
 }
 
-func NewVotes(state *appstate.AppState, bus eventbus.Bus, offlineDetector *blockchain.OfflineDetector, upgrade *upgrade.Upgrader) *Votes {
+func NewVotes(state *appstate.AppState, bus eventbus.Bus, offlineDetector *blockchain.OfflineDetector, misbehavior *blockchain.MisbehaviorDetector, upgrade *upgrade.Upgrader) *Votes {
 	v := &Votes{
 		votesByRound:    &sync.Map{},
 		votesByHash:     &sync.Map{},
@@ -38,7 +50,11 @@ func NewVotes(state *appstate.AppState, bus eventbus.Bus, offlineDetector *block
 		state:           state,
 		bus:             bus,
 		offlineDetector: offlineDetector,
+		misbehavior:     misbehavior,
 		upgrade:         upgrade,
+		pendingVotes:    make(map[common.Hash]*types.Vote),
+		pendingByVoter:  make(map[common.Address]int),
+		pendingByRound:  make(map[uint64]int),
 	}
 	v.bus.Subscribe(events.AddBlockEventID,
 		func(e eventbus.Event) {
@@ -74,6 +90,9 @@ func (votes *Votes) AddVote(vote *types.Vote) bool {
 	}
 
 	if votes.state.ValidatorsCache.OnlineSize() > 0 && !votes.state.ValidatorsCache.IsOnlineIdentity(vote.VoterAddr()) {
+		if vote.Header.Round > head.Height()+1 && vote.Header.Round-head.Height()-1 <= PendingVotesPeriod {
+			votes.addPendingVote(vote)
+		}
 		return false
 	}
 
@@ -87,10 +106,50 @@ func (votes *Votes) AddVote(vote *types.Vote) bool {
 	votes.knownVotes.Add(vote.Hash())
 	votes.votesByHash.Store(vote.Hash(), vote)
 	votes.offlineDetector.ProcessVote(vote)
+	votes.misbehavior.ProcessVote(vote)
 	votes.upgrade.ProcessVote(vote)
 	return true
 }
 
+func (votes *Votes) addPendingVote(vote *types.Vote) {
+	voter := vote.VoterAddr()
+	// only known identities and pools can become online in the blocks the node hasn't received yet
+	if !votes.state.ValidatorsCache.Contains(voter) && !votes.state.ValidatorsCache.IsPool(voter) {
+		return
+	}
+	votes.pendingMutex.Lock()
+	defer votes.pendingMutex.Unlock()
+	if _, ok := votes.pendingVotes[vote.Hash()]; ok {
+		return
+	}
+	if len(votes.pendingVotes) >= MaxPendingVotes || votes.pendingByVoter[voter] >= MaxPendingVotesPerVoter ||
+		votes.pendingByRound[vote.Header.Round] >= MaxPendingVotesPerRound {
+		return
+	}
+	votes.pendingVotes[vote.Hash()] = vote
+	votes.pendingByVoter[voter]++
+	votes.pendingByRound[vote.Header.Round]++
+}
+
+// ProcessPendingVotes adds buffered votes of future rounds and returns the added ones,
+// votes which are still ahead of the current round are kept
+func (votes *Votes) ProcessPendingVotes() []*types.Vote {
+	votes.pendingMutex.Lock()
+	pending := votes.pendingVotes
+	votes.pendingVotes = make(map[common.Hash]*types.Vote)
+	votes.pendingByVoter = make(map[common.Address]int)
+	votes.pendingByRound = make(map[uint64]int)
+	votes.pendingMutex.Unlock()
+
+	var result []*types.Vote
+	for _, vote := range pending {
+		if votes.AddVote(vote) {
+			result = append(result, vote)
+		}
+	}
+	return result
+}
+
 func (votes *Votes) GetVoteByHash(hash common.Hash) *types.Vote {
 	if value, ok := votes.votesByHash.Load(hash); ok {
 		return value.(*types.Vote)
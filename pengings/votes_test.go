@@ -0,0 +1,118 @@
+package pengings
+
+import (
+	"crypto/ecdsa"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/core/validators"
+	"github.com/idena-network/idena-go/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+	"testing"
+)
+
+func TestVotes_PendingVotes(t *testing.T) {
+	require := require.New(t)
+	identityState, _ := state.NewLazyIdentityState(db.NewMemDB())
+	onlineKey, _ := crypto.GenerateKey()
+	obj := identityState.GetOrNewIdentityObject(crypto.PubkeyToAddress(onlineKey.PublicKey))
+	obj.SetState(true)
+	obj.SetOnline(true)
+	offlineKey, _ := crypto.GenerateKey()
+	identityState.GetOrNewIdentityObject(crypto.PubkeyToAddress(offlineKey.PublicKey)).SetState(true)
+	identityState.Commit(false)
+	validatorsCache := validators.NewValidatorsCache(identityState, common.Address{})
+	validatorsCache.Load()
+
+	votes := NewVotes(&appstate.AppState{ValidatorsCache: validatorsCache}, eventbus.New(), nil, nil, nil)
+	votes.Initialize(&types.Header{ProposedHeader: &types.ProposedHeader{Height: 10}})
+
+	signedVote := func(round uint64, step uint8, key *ecdsa.PrivateKey) *types.Vote {
+		vote := &types.Vote{
+			Header: &types.VoteHeader{
+				Round:     round,
+				Step:      step,
+				VotedHash: common.Hash{0x1},
+			},
+		}
+		hash := crypto.SignatureHash(vote)
+		vote.Signature, _ = crypto.Sign(hash[:], key)
+		return vote
+	}
+	vote := func(round uint64) *types.Vote {
+		return signedVote(round, 1, offlineKey)
+	}
+
+	// votes of the current round and of rounds too far ahead are dropped
+	require.False(votes.AddVote(vote(11)))
+	require.False(votes.AddVote(vote(14)))
+	require.Empty(votes.pendingVotes)
+
+	require.False(votes.AddVote(vote(12)))
+	require.False(votes.AddVote(vote(13)))
+	require.Len(votes.pendingVotes, 2)
+
+	// the voter is still offline, votes ahead of the current round are kept
+	votes.Initialize(&types.Header{ProposedHeader: &types.ProposedHeader{Height: 11}})
+	require.Empty(votes.ProcessPendingVotes())
+	require.Len(votes.pendingVotes, 1)
+	for _, v := range votes.pendingVotes {
+		require.Equal(uint64(13), v.Header.Round)
+	}
+
+	// votes of unknown voters are not kept
+	unknownKey, _ := crypto.GenerateKey()
+	require.False(votes.AddVote(signedVote(13, 1, unknownKey)))
+	require.Len(votes.pendingVotes, 1)
+}
+
+func TestVotes_PendingVotesLimits(t *testing.T) {
+	require := require.New(t)
+	identityState, _ := state.NewLazyIdentityState(db.NewMemDB())
+	onlineKey, _ := crypto.GenerateKey()
+	obj := identityState.GetOrNewIdentityObject(crypto.PubkeyToAddress(onlineKey.PublicKey))
+	obj.SetState(true)
+	obj.SetOnline(true)
+	offlineKey, _ := crypto.GenerateKey()
+	identityState.GetOrNewIdentityObject(crypto.PubkeyToAddress(offlineKey.PublicKey)).SetState(true)
+	identityState.Commit(false)
+	validatorsCache := validators.NewValidatorsCache(identityState, common.Address{})
+	validatorsCache.Load()
+
+	votes := NewVotes(&appstate.AppState{ValidatorsCache: validatorsCache}, eventbus.New(), nil, nil, nil)
+	votes.Initialize(&types.Header{ProposedHeader: &types.ProposedHeader{Height: 10}})
+
+	vote := func(round uint64, step uint8) *types.Vote {
+		vote := &types.Vote{
+			Header: &types.VoteHeader{
+				Round:     round,
+				Step:      step,
+				VotedHash: common.Hash{0x1},
+			},
+		}
+		hash := crypto.SignatureHash(vote)
+		vote.Signature, _ = crypto.Sign(hash[:], offlineKey)
+		return vote
+	}
+
+	for step := uint8(1); step <= MaxPendingVotesPerVoter+5; step++ {
+		votes.AddVote(vote(12, step))
+	}
+	require.Len(votes.pendingVotes, MaxPendingVotesPerVoter)
+
+	votes.ProcessPendingVotes()
+	require.Len(votes.pendingVotes, MaxPendingVotesPerVoter)
+	require.Equal(MaxPendingVotesPerVoter, votes.pendingByVoter[crypto.PubkeyToAddress(offlineKey.PublicKey)])
+
+	// the round is full, votes of other rounds are still kept
+	votes.pendingVotes = make(map[common.Hash]*types.Vote)
+	votes.pendingByVoter = make(map[common.Address]int)
+	votes.pendingByRound[12] = MaxPendingVotesPerRound
+	votes.AddVote(vote(12, 1))
+	votes.AddVote(vote(13, 1))
+	require.Len(votes.pendingVotes, 1)
+	require.Equal(1, votes.pendingByRound[13])
+}
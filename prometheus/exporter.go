@@ -0,0 +1,113 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/idena-network/idena-go/log"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	namePrefix  = "idena_"
+	contentType = "text/plain; version=0.0.4"
+)
+
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99}
+
+type handler struct {
+	registry metrics.Registry
+}
+
+// ServeHTTP writes metrics of the registry in the Prometheus text exposition format
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	buf := new(bytes.Buffer)
+	WriteMetrics(buf, h.registry)
+	w.Header().Set("content-type", contentType)
+	if _, err := w.Write(buf.Bytes()); err != nil {
+		log.Debug("Failed to write metrics response", "err", err)
+	}
+}
+
+// WriteMetrics writes metrics of the registry sorted by name, counters and gauges are exported as is,
+// meters as counters of marks and histograms and timers as summaries
+func WriteMetrics(w io.Writer, registry metrics.Registry) {
+	all := make(map[string]interface{})
+	registry.Each(func(name string, metric interface{}) {
+		all[name] = metric
+	})
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		promName := metricName(name)
+		switch metric := all[name].(type) {
+		case metrics.Counter:
+			writeValue(w, promName, "counter", metric.Count())
+		case metrics.Gauge:
+			writeValue(w, promName, "gauge", metric.Value())
+		case metrics.GaugeFloat64:
+			writeValue(w, promName, "gauge", metric.Value())
+		case metrics.Meter:
+			writeValue(w, promName, "counter", metric.Snapshot().Count())
+		case metrics.Histogram:
+			snapshot := metric.Snapshot()
+			writeSummary(w, promName, snapshot.Count(), snapshot.Sum(), snapshot.Percentiles(quantiles))
+		case metrics.Timer:
+			snapshot := metric.Snapshot()
+			writeSummary(w, promName, snapshot.Count(), snapshot.Sum(), snapshot.Percentiles(quantiles))
+		}
+	}
+}
+
+func writeValue(w io.Writer, name string, kind string, value interface{}) {
+	fmt.Fprintf(w, "# TYPE %s %s\n%s %v\n", name, kind, name, value)
+}
+
+func writeSummary(w io.Writer, name string, count int64, sum int64, values []float64) {
+	fmt.Fprintf(w, "# TYPE %s summary\n", name)
+	for i, q := range quantiles {
+		fmt.Fprintf(w, "%s{quantile=\"%v\"} %v\n", name, q, values[i])
+	}
+	fmt.Fprintf(w, "%s_sum %v\n%s_count %v\n", name, sum, name, count)
+}
+
+// metricName converts the dotted go-metrics name to the Prometheus one, e.g. mempool.regular.size -> idena_mempool_regular_size
+func metricName(name string) string {
+	return namePrefix + strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+// StartHTTPEndpoint starts the HTTP endpoint which serves metrics of the registry at /metrics
+func StartHTTPEndpoint(endpoint string, registry metrics.Registry) (net.Listener, error) {
+	listener, err := net.Listen("tcp", endpoint)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", &handler{registry})
+	srv := &http.Server{
+		Handler:      mux,
+		ReadTimeout:  30 * time.Second,
+		WriteTimeout: 30 * time.Second,
+		IdleTimeout:  120 * time.Second,
+	}
+	go srv.Serve(listener)
+	return listener, nil
+}
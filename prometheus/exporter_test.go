@@ -0,0 +1,40 @@
+package prometheus
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rcrowley/go-metrics"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteMetrics(t *testing.T) {
+	registry := metrics.NewRegistry()
+	metrics.GetOrRegisterGauge("ceremony.shortSessionOpened.delay", registry).Update(1500)
+	metrics.GetOrRegisterCounter("consensus.emptyBlocks.timeout", registry).Inc(3)
+	metrics.GetOrRegisterGaugeFloat64("p2p.rate", registry).Update(0.5)
+
+	buf := new(bytes.Buffer)
+	WriteMetrics(buf, registry)
+
+	require.Equal(t, "# TYPE idena_ceremony_shortSessionOpened_delay gauge\n"+
+		"idena_ceremony_shortSessionOpened_delay 1500\n"+
+		"# TYPE idena_consensus_emptyBlocks_timeout counter\n"+
+		"idena_consensus_emptyBlocks_timeout 3\n"+
+		"# TYPE idena_p2p_rate gauge\n"+
+		"idena_p2p_rate 0.5\n", buf.String())
+}
+
+func TestWriteMetrics_Histogram(t *testing.T) {
+	registry := metrics.NewRegistry()
+	histogram := metrics.GetOrRegisterHistogram("block.time", registry, metrics.NewUniformSample(10))
+	for i := int64(1); i <= 4; i++ {
+		histogram.Update(i)
+	}
+
+	buf := new(bytes.Buffer)
+	WriteMetrics(buf, registry)
+
+	require.Contains(t, buf.String(), "# TYPE idena_block_time summary\n")
+	require.Contains(t, buf.String(), "idena_block_time_sum 10\nidena_block_time_count 4\n")
+}
@@ -0,0 +1,408 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package models
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// IdenaApiClient is the client API for IdenaApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type IdenaApiClient interface {
+	LastBlock(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (*ProtoApiBlock, error)
+	Block(ctx context.Context, in *ProtoApiBlockRequest, opts ...grpc.CallOption) (*ProtoApiBlock, error)
+	Transaction(ctx context.Context, in *ProtoApiTransactionRequest, opts ...grpc.CallOption) (*ProtoApiTransaction, error)
+	SendRawTransaction(ctx context.Context, in *ProtoApiRawTransaction, opts ...grpc.CallOption) (*ProtoApiTransactionHash, error)
+	Identity(ctx context.Context, in *ProtoApiIdentityRequest, opts ...grpc.CallOption) (*ProtoApiIdentity, error)
+	Epoch(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (*ProtoApiEpoch, error)
+	SubscribeBlocks(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (IdenaApi_SubscribeBlocksClient, error)
+	SubscribeCeremonyEvents(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (IdenaApi_SubscribeCeremonyEventsClient, error)
+}
+
+type idenaApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewIdenaApiClient(cc grpc.ClientConnInterface) IdenaApiClient {
+	return &idenaApiClient{cc}
+}
+
+func (c *idenaApiClient) LastBlock(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (*ProtoApiBlock, error) {
+	out := new(ProtoApiBlock)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/LastBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) Block(ctx context.Context, in *ProtoApiBlockRequest, opts ...grpc.CallOption) (*ProtoApiBlock, error) {
+	out := new(ProtoApiBlock)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/Block", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) Transaction(ctx context.Context, in *ProtoApiTransactionRequest, opts ...grpc.CallOption) (*ProtoApiTransaction, error) {
+	out := new(ProtoApiTransaction)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/Transaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) SendRawTransaction(ctx context.Context, in *ProtoApiRawTransaction, opts ...grpc.CallOption) (*ProtoApiTransactionHash, error) {
+	out := new(ProtoApiTransactionHash)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/SendRawTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) Identity(ctx context.Context, in *ProtoApiIdentityRequest, opts ...grpc.CallOption) (*ProtoApiIdentity, error) {
+	out := new(ProtoApiIdentity)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/Identity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) Epoch(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (*ProtoApiEpoch, error) {
+	out := new(ProtoApiEpoch)
+	err := c.cc.Invoke(ctx, "/models.IdenaApi/Epoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *idenaApiClient) SubscribeBlocks(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (IdenaApi_SubscribeBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IdenaApi_ServiceDesc.Streams[0], "/models.IdenaApi/SubscribeBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &idenaApiSubscribeBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IdenaApi_SubscribeBlocksClient interface {
+	Recv() (*ProtoApiBlock, error)
+	grpc.ClientStream
+}
+
+type idenaApiSubscribeBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *idenaApiSubscribeBlocksClient) Recv() (*ProtoApiBlock, error) {
+	m := new(ProtoApiBlock)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *idenaApiClient) SubscribeCeremonyEvents(ctx context.Context, in *ProtoApiEmpty, opts ...grpc.CallOption) (IdenaApi_SubscribeCeremonyEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &IdenaApi_ServiceDesc.Streams[1], "/models.IdenaApi/SubscribeCeremonyEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &idenaApiSubscribeCeremonyEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type IdenaApi_SubscribeCeremonyEventsClient interface {
+	Recv() (*ProtoApiCeremonyEvent, error)
+	grpc.ClientStream
+}
+
+type idenaApiSubscribeCeremonyEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *idenaApiSubscribeCeremonyEventsClient) Recv() (*ProtoApiCeremonyEvent, error) {
+	m := new(ProtoApiCeremonyEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// IdenaApiServer is the server API for IdenaApi service.
+// All implementations must embed UnimplementedIdenaApiServer
+// for forward compatibility
+type IdenaApiServer interface {
+	LastBlock(context.Context, *ProtoApiEmpty) (*ProtoApiBlock, error)
+	Block(context.Context, *ProtoApiBlockRequest) (*ProtoApiBlock, error)
+	Transaction(context.Context, *ProtoApiTransactionRequest) (*ProtoApiTransaction, error)
+	SendRawTransaction(context.Context, *ProtoApiRawTransaction) (*ProtoApiTransactionHash, error)
+	Identity(context.Context, *ProtoApiIdentityRequest) (*ProtoApiIdentity, error)
+	Epoch(context.Context, *ProtoApiEmpty) (*ProtoApiEpoch, error)
+	SubscribeBlocks(*ProtoApiEmpty, IdenaApi_SubscribeBlocksServer) error
+	SubscribeCeremonyEvents(*ProtoApiEmpty, IdenaApi_SubscribeCeremonyEventsServer) error
+	mustEmbedUnimplementedIdenaApiServer()
+}
+
+// UnimplementedIdenaApiServer must be embedded to have forward compatible implementations.
+type UnimplementedIdenaApiServer struct {
+}
+
+func (UnimplementedIdenaApiServer) LastBlock(context.Context, *ProtoApiEmpty) (*ProtoApiBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LastBlock not implemented")
+}
+func (UnimplementedIdenaApiServer) Block(context.Context, *ProtoApiBlockRequest) (*ProtoApiBlock, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Block not implemented")
+}
+func (UnimplementedIdenaApiServer) Transaction(context.Context, *ProtoApiTransactionRequest) (*ProtoApiTransaction, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Transaction not implemented")
+}
+func (UnimplementedIdenaApiServer) SendRawTransaction(context.Context, *ProtoApiRawTransaction) (*ProtoApiTransactionHash, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendRawTransaction not implemented")
+}
+func (UnimplementedIdenaApiServer) Identity(context.Context, *ProtoApiIdentityRequest) (*ProtoApiIdentity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Identity not implemented")
+}
+func (UnimplementedIdenaApiServer) Epoch(context.Context, *ProtoApiEmpty) (*ProtoApiEpoch, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Epoch not implemented")
+}
+func (UnimplementedIdenaApiServer) SubscribeBlocks(*ProtoApiEmpty, IdenaApi_SubscribeBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlocks not implemented")
+}
+func (UnimplementedIdenaApiServer) SubscribeCeremonyEvents(*ProtoApiEmpty, IdenaApi_SubscribeCeremonyEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeCeremonyEvents not implemented")
+}
+func (UnimplementedIdenaApiServer) mustEmbedUnimplementedIdenaApiServer() {}
+
+// UnsafeIdenaApiServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to IdenaApiServer will
+// result in compilation errors.
+type UnsafeIdenaApiServer interface {
+	mustEmbedUnimplementedIdenaApiServer()
+}
+
+func RegisterIdenaApiServer(s grpc.ServiceRegistrar, srv IdenaApiServer) {
+	s.RegisterService(&IdenaApi_ServiceDesc, srv)
+}
+
+func _IdenaApi_LastBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).LastBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/LastBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).LastBlock(ctx, req.(*ProtoApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_Block_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).Block(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/Block",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).Block(ctx, req.(*ProtoApiBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_Transaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).Transaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/Transaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).Transaction(ctx, req.(*ProtoApiTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_SendRawTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiRawTransaction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).SendRawTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/SendRawTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).SendRawTransaction(ctx, req.(*ProtoApiRawTransaction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_Identity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).Identity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/Identity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).Identity(ctx, req.(*ProtoApiIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_Epoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProtoApiEmpty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdenaApiServer).Epoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/models.IdenaApi/Epoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdenaApiServer).Epoch(ctx, req.(*ProtoApiEmpty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdenaApi_SubscribeBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProtoApiEmpty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IdenaApiServer).SubscribeBlocks(m, &idenaApiSubscribeBlocksServer{stream})
+}
+
+type IdenaApi_SubscribeBlocksServer interface {
+	Send(*ProtoApiBlock) error
+	grpc.ServerStream
+}
+
+type idenaApiSubscribeBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *idenaApiSubscribeBlocksServer) Send(m *ProtoApiBlock) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _IdenaApi_SubscribeCeremonyEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProtoApiEmpty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(IdenaApiServer).SubscribeCeremonyEvents(m, &idenaApiSubscribeCeremonyEventsServer{stream})
+}
+
+type IdenaApi_SubscribeCeremonyEventsServer interface {
+	Send(*ProtoApiCeremonyEvent) error
+	grpc.ServerStream
+}
+
+type idenaApiSubscribeCeremonyEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *idenaApiSubscribeCeremonyEventsServer) Send(m *ProtoApiCeremonyEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// IdenaApi_ServiceDesc is the grpc.ServiceDesc for IdenaApi service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var IdenaApi_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "models.IdenaApi",
+	HandlerType: (*IdenaApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LastBlock",
+			Handler:    _IdenaApi_LastBlock_Handler,
+		},
+		{
+			MethodName: "Block",
+			Handler:    _IdenaApi_Block_Handler,
+		},
+		{
+			MethodName: "Transaction",
+			Handler:    _IdenaApi_Transaction_Handler,
+		},
+		{
+			MethodName: "SendRawTransaction",
+			Handler:    _IdenaApi_SendRawTransaction_Handler,
+		},
+		{
+			MethodName: "Identity",
+			Handler:    _IdenaApi_Identity_Handler,
+		},
+		{
+			MethodName: "Epoch",
+			Handler:    _IdenaApi_Epoch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeBlocks",
+			Handler:       _IdenaApi_SubscribeBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeCeremonyEvents",
+			Handler:       _IdenaApi_SubscribeCeremonyEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protobuf/api.proto",
+}
@@ -1,13 +1,12 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.23.0
+// 	protoc-gen-go v1.27.1
 // 	protoc        v3.12.3
 // source: protobuf/models.proto
 
 package models
 
 import (
-	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,10 +20,6 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
-
 type ProtoTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -368,10 +363,12 @@ type ProtoBlockCert struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Round      uint64                      `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
-	Step       uint32                      `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
-	VotedHash  []byte                      `protobuf:"bytes,3,opt,name=votedHash,proto3" json:"votedHash,omitempty"`
-	Signatures []*ProtoBlockCert_Signature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	Round               uint64                       `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Step                uint32                       `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
+	VotedHash           []byte                       `protobuf:"bytes,3,opt,name=votedHash,proto3" json:"votedHash,omitempty"`
+	Signatures          []*ProtoBlockCert_Signature  `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures,omitempty"`
+	AggregatedSignature []byte                       `protobuf:"bytes,5,opt,name=aggregatedSignature,proto3" json:"aggregatedSignature,omitempty"`
+	VoterGroups         []*ProtoBlockCert_VoterGroup `protobuf:"bytes,6,rep,name=voterGroups,proto3" json:"voterGroups,omitempty"`
 }
 
 func (x *ProtoBlockCert) Reset() {
@@ -434,6 +431,20 @@ func (x *ProtoBlockCert) GetSignatures() []*ProtoBlockCert_Signature {
 	return nil
 }
 
+func (x *ProtoBlockCert) GetAggregatedSignature() []byte {
+	if x != nil {
+		return x.AggregatedSignature
+	}
+	return nil
+}
+
+func (x *ProtoBlockCert) GetVoterGroups() []*ProtoBlockCert_VoterGroup {
+	if x != nil {
+		return x.VoterGroups
+	}
+	return nil
+}
+
 type ProtoWeakCertificates struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -643,13 +654,14 @@ type ProtoHandshake struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NetworkId  uint32 `protobuf:"varint,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
-	Height     uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Genesis    []byte `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis,omitempty"`
-	Timestamp  int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	AppVersion string `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
-	Peers      uint32 `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
-	OldGenesis []byte `protobuf:"bytes,7,opt,name=oldGenesis,proto3" json:"oldGenesis,omitempty"`
+	NetworkId    uint32 `protobuf:"varint,1,opt,name=networkId,proto3" json:"networkId,omitempty"`
+	Height       uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Genesis      []byte `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	Timestamp    int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	AppVersion   string `protobuf:"bytes,5,opt,name=appVersion,proto3" json:"appVersion,omitempty"`
+	Peers        uint32 `protobuf:"varint,6,opt,name=peers,proto3" json:"peers,omitempty"`
+	OldGenesis   []byte `protobuf:"bytes,7,opt,name=oldGenesis,proto3" json:"oldGenesis,omitempty"`
+	Capabilities uint32 `protobuf:"varint,8,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
 }
 
 func (x *ProtoHandshake) Reset() {
@@ -733,6 +745,13 @@ func (x *ProtoHandshake) GetOldGenesis() []byte {
 	return nil
 }
 
+func (x *ProtoHandshake) GetCapabilities() uint32 {
+	if x != nil {
+		return x.Capabilities
+	}
+	return 0
+}
+
 type ProtoMsg struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -997,8 +1016,9 @@ type ProtoVote struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data      *ProtoVote_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
-	Signature []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	Data         *ProtoVote_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signature    []byte          `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	BlsSignature []byte          `protobuf:"bytes,3,opt,name=blsSignature,proto3" json:"blsSignature,omitempty"`
 }
 
 func (x *ProtoVote) Reset() {
@@ -1047,6 +1067,13 @@ func (x *ProtoVote) GetSignature() []byte {
 	return nil
 }
 
+func (x *ProtoVote) GetBlsSignature() []byte {
+	if x != nil {
+		return x.BlsSignature
+	}
+	return nil
+}
+
 type ProtoGetBlockByHashRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1865,6 +1892,211 @@ func (x *ProtoActivityMonitor) GetActivities() []*ProtoActivityMonitor_Activity
 	return nil
 }
 
+type ProtoBalanceChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height     uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Reason     uint32 `protobuf:"varint,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	TxHash     []byte `protobuf:"bytes,3,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	BalanceOld []byte `protobuf:"bytes,4,opt,name=balanceOld,proto3" json:"balanceOld,omitempty"`
+	BalanceNew []byte `protobuf:"bytes,5,opt,name=balanceNew,proto3" json:"balanceNew,omitempty"`
+	StakeOld   []byte `protobuf:"bytes,6,opt,name=stakeOld,proto3" json:"stakeOld,omitempty"`
+	StakeNew   []byte `protobuf:"bytes,7,opt,name=stakeNew,proto3" json:"stakeNew,omitempty"`
+}
+
+func (x *ProtoBalanceChange) Reset() {
+	*x = ProtoBalanceChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoBalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoBalanceChange) ProtoMessage() {}
+
+func (x *ProtoBalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoBalanceChange.ProtoReflect.Descriptor instead.
+func (*ProtoBalanceChange) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ProtoBalanceChange) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoBalanceChange) GetReason() uint32 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *ProtoBalanceChange) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *ProtoBalanceChange) GetBalanceOld() []byte {
+	if x != nil {
+		return x.BalanceOld
+	}
+	return nil
+}
+
+func (x *ProtoBalanceChange) GetBalanceNew() []byte {
+	if x != nil {
+		return x.BalanceNew
+	}
+	return nil
+}
+
+func (x *ProtoBalanceChange) GetStakeOld() []byte {
+	if x != nil {
+		return x.StakeOld
+	}
+	return nil
+}
+
+func (x *ProtoBalanceChange) GetStakeNew() []byte {
+	if x != nil {
+		return x.StakeNew
+	}
+	return nil
+}
+
+type ProtoEpochCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data      *ProtoEpochCheckpoint_Data `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Signature []byte                     `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ProtoEpochCheckpoint) Reset() {
+	*x = ProtoEpochCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoEpochCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoEpochCheckpoint) ProtoMessage() {}
+
+func (x *ProtoEpochCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoEpochCheckpoint.ProtoReflect.Descriptor instead.
+func (*ProtoEpochCheckpoint) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ProtoEpochCheckpoint) GetData() *ProtoEpochCheckpoint_Data {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *ProtoEpochCheckpoint) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ProtoCheckpointAnnouncement struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid        []byte                `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Checkpoint *ProtoEpochCheckpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+}
+
+func (x *ProtoCheckpointAnnouncement) Reset() {
+	*x = ProtoCheckpointAnnouncement{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoCheckpointAnnouncement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoCheckpointAnnouncement) ProtoMessage() {}
+
+func (x *ProtoCheckpointAnnouncement) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoCheckpointAnnouncement.ProtoReflect.Descriptor instead.
+func (*ProtoCheckpointAnnouncement) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ProtoCheckpointAnnouncement) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *ProtoCheckpointAnnouncement) GetCheckpoint() *ProtoEpochCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
 type ProtoShortAnswerAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1877,7 +2109,7 @@ type ProtoShortAnswerAttachment struct {
 func (x *ProtoShortAnswerAttachment) Reset() {
 	*x = ProtoShortAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[32]
+		mi := &file_protobuf_models_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1890,7 +2122,7 @@ func (x *ProtoShortAnswerAttachment) String() string {
 func (*ProtoShortAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[32]
+	mi := &file_protobuf_models_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2135,7 @@ func (x *ProtoShortAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoShortAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoShortAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{32}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *ProtoShortAnswerAttachment) GetAnswers() []byte {
@@ -1934,7 +2166,7 @@ type ProtoLongAnswerAttachment struct {
 func (x *ProtoLongAnswerAttachment) Reset() {
 	*x = ProtoLongAnswerAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[33]
+		mi := &file_protobuf_models_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1947,7 +2179,7 @@ func (x *ProtoLongAnswerAttachment) String() string {
 func (*ProtoLongAnswerAttachment) ProtoMessage() {}
 
 func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[33]
+	mi := &file_protobuf_models_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1960,7 +2192,7 @@ func (x *ProtoLongAnswerAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoLongAnswerAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoLongAnswerAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{33}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *ProtoLongAnswerAttachment) GetAnswers() []byte {
@@ -2003,7 +2235,7 @@ type ProtoFlipSubmitAttachment struct {
 func (x *ProtoFlipSubmitAttachment) Reset() {
 	*x = ProtoFlipSubmitAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[34]
+		mi := &file_protobuf_models_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2016,7 +2248,7 @@ func (x *ProtoFlipSubmitAttachment) String() string {
 func (*ProtoFlipSubmitAttachment) ProtoMessage() {}
 
 func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[34]
+	mi := &file_protobuf_models_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2029,7 +2261,7 @@ func (x *ProtoFlipSubmitAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoFlipSubmitAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoFlipSubmitAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{34}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *ProtoFlipSubmitAttachment) GetCid() []byte {
@@ -2057,7 +2289,7 @@ type ProtoOnlineStatusAttachment struct {
 func (x *ProtoOnlineStatusAttachment) Reset() {
 	*x = ProtoOnlineStatusAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[35]
+		mi := &file_protobuf_models_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2070,7 +2302,7 @@ func (x *ProtoOnlineStatusAttachment) String() string {
 func (*ProtoOnlineStatusAttachment) ProtoMessage() {}
 
 func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[35]
+	mi := &file_protobuf_models_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2083,7 +2315,7 @@ func (x *ProtoOnlineStatusAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoOnlineStatusAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoOnlineStatusAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{35}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *ProtoOnlineStatusAttachment) GetOnline() bool {
@@ -2104,7 +2336,7 @@ type ProtoBurnAttachment struct {
 func (x *ProtoBurnAttachment) Reset() {
 	*x = ProtoBurnAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[36]
+		mi := &file_protobuf_models_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2117,7 +2349,7 @@ func (x *ProtoBurnAttachment) String() string {
 func (*ProtoBurnAttachment) ProtoMessage() {}
 
 func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[36]
+	mi := &file_protobuf_models_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2130,7 +2362,7 @@ func (x *ProtoBurnAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoBurnAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoBurnAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{36}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *ProtoBurnAttachment) GetKey() string {
@@ -2151,7 +2383,7 @@ type ProtoChangeProfileAttachment struct {
 func (x *ProtoChangeProfileAttachment) Reset() {
 	*x = ProtoChangeProfileAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[37]
+		mi := &file_protobuf_models_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2164,7 +2396,7 @@ func (x *ProtoChangeProfileAttachment) String() string {
 func (*ProtoChangeProfileAttachment) ProtoMessage() {}
 
 func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[37]
+	mi := &file_protobuf_models_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2177,7 +2409,7 @@ func (x *ProtoChangeProfileAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoChangeProfileAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoChangeProfileAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{37}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *ProtoChangeProfileAttachment) GetHash() []byte {
@@ -2198,7 +2430,7 @@ type ProtoDeleteFlipAttachment struct {
 func (x *ProtoDeleteFlipAttachment) Reset() {
 	*x = ProtoDeleteFlipAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[38]
+		mi := &file_protobuf_models_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2211,7 +2443,7 @@ func (x *ProtoDeleteFlipAttachment) String() string {
 func (*ProtoDeleteFlipAttachment) ProtoMessage() {}
 
 func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[38]
+	mi := &file_protobuf_models_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2224,7 +2456,7 @@ func (x *ProtoDeleteFlipAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeleteFlipAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeleteFlipAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{38}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ProtoDeleteFlipAttachment) GetCid() []byte {
@@ -2243,12 +2475,13 @@ type ProtoStateAccount struct {
 	Epoch        uint32                               `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 	Balance      []byte                               `protobuf:"bytes,3,opt,name=balance,proto3" json:"balance,omitempty"`
 	ContractData *ProtoStateAccount_ProtoContractData `protobuf:"bytes,4,opt,name=contractData,proto3" json:"contractData,omitempty"`
+	StakeLocks   []*ProtoStateAccount_ProtoStakeLock  `protobuf:"bytes,5,rep,name=stakeLocks,proto3" json:"stakeLocks,omitempty"`
 }
 
 func (x *ProtoStateAccount) Reset() {
 	*x = ProtoStateAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[39]
+		mi := &file_protobuf_models_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2261,7 +2494,7 @@ func (x *ProtoStateAccount) String() string {
 func (*ProtoStateAccount) ProtoMessage() {}
 
 func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[39]
+	mi := &file_protobuf_models_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2274,7 +2507,7 @@ func (x *ProtoStateAccount) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateAccount.ProtoReflect.Descriptor instead.
 func (*ProtoStateAccount) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{39}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *ProtoStateAccount) GetNonce() uint32 {
@@ -2305,6 +2538,13 @@ func (x *ProtoStateAccount) GetContractData() *ProtoStateAccount_ProtoContractDa
 	return nil
 }
 
+func (x *ProtoStateAccount) GetStakeLocks() []*ProtoStateAccount_ProtoStakeLock {
+	if x != nil {
+		return x.StakeLocks
+	}
+	return nil
+}
+
 type ProtoStateIdentity struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2331,12 +2571,13 @@ type ProtoStateIdentity struct {
 	Delegatee        []byte                       `protobuf:"bytes,19,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
 	DelegationNonce  uint32                       `protobuf:"varint,20,opt,name=delegationNonce,proto3" json:"delegationNonce,omitempty"`
 	DelegationEpoch  uint32                       `protobuf:"varint,21,opt,name=delegationEpoch,proto3" json:"delegationEpoch,omitempty"`
+	AnswersSubmitter []byte                       `protobuf:"bytes,22,opt,name=answersSubmitter,proto3" json:"answersSubmitter,omitempty"`
 }
 
 func (x *ProtoStateIdentity) Reset() {
 	*x = ProtoStateIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[40]
+		mi := &file_protobuf_models_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2349,7 +2590,7 @@ func (x *ProtoStateIdentity) String() string {
 func (*ProtoStateIdentity) ProtoMessage() {}
 
 func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[40]
+	mi := &file_protobuf_models_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2362,7 +2603,7 @@ func (x *ProtoStateIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ProtoStateIdentity) GetStake() []byte {
@@ -2512,30 +2753,42 @@ func (x *ProtoStateIdentity) GetDelegationEpoch() uint32 {
 	return 0
 }
 
+func (x *ProtoStateIdentity) GetAnswersSubmitter() []byte {
+	if x != nil {
+		return x.AnswersSubmitter
+	}
+	return nil
+}
+
 type ProtoStateGlobal struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch                         uint32   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	NextValidationTime            int64    `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
-	ValidationPeriod              uint32   `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
-	GodAddress                    []byte   `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
-	WordsSeed                     []byte   `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
-	LastSnapshot                  uint64   `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
-	EpochBlock                    uint64   `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
-	FeePerGas                     []byte   `protobuf:"bytes,8,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
-	VrfProposerThreshold          uint64   `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
-	EmptyBlocksBits               []byte   `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
-	GodAddressInvites             uint32   `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
-	BlocksCntWithoutCeremonialTxs uint32   `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
-	PrevEpochBlocks               []uint64 `protobuf:"varint,13,rep,packed,name=prevEpochBlocks,proto3" json:"prevEpochBlocks,omitempty"`
+	Epoch                         uint32                               `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NextValidationTime            int64                                `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
+	ValidationPeriod              uint32                               `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
+	GodAddress                    []byte                               `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
+	WordsSeed                     []byte                               `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
+	LastSnapshot                  uint64                               `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
+	EpochBlock                    uint64                               `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
+	FeePerGas                     []byte                               `protobuf:"bytes,8,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
+	VrfProposerThreshold          uint64                               `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
+	EmptyBlocksBits               []byte                               `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
+	GodAddressInvites             uint32                               `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
+	BlocksCntWithoutCeremonialTxs uint32                               `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
+	PrevEpochBlocks               []uint64                             `protobuf:"varint,13,rep,packed,name=prevEpochBlocks,proto3" json:"prevEpochBlocks,omitempty"`
+	BlockGasLimit                 uint64                               `protobuf:"varint,14,opt,name=blockGasLimit,proto3" json:"blockGasLimit,omitempty"`
+	Misbehaviors                  []*ProtoStateGlobal_ProtoMisbehavior `protobuf:"bytes,15,rep,name=misbehaviors,proto3" json:"misbehaviors,omitempty"`
+	MinProposerThreshold          uint64                               `protobuf:"varint,16,opt,name=minProposerThreshold,proto3" json:"minProposerThreshold,omitempty"`
+	WordsDictionary               *ProtoWordsDictionary                `protobuf:"bytes,17,opt,name=wordsDictionary,proto3" json:"wordsDictionary,omitempty"`
+	NextWordsDictionary           *ProtoWordsDictionary                `protobuf:"bytes,18,opt,name=nextWordsDictionary,proto3" json:"nextWordsDictionary,omitempty"`
 }
 
 func (x *ProtoStateGlobal) Reset() {
 	*x = ProtoStateGlobal{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[41]
+		mi := &file_protobuf_models_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2548,7 +2801,7 @@ func (x *ProtoStateGlobal) String() string {
 func (*ProtoStateGlobal) ProtoMessage() {}
 
 func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[41]
+	mi := &file_protobuf_models_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2561,7 +2814,7 @@ func (x *ProtoStateGlobal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateGlobal.ProtoReflect.Descriptor instead.
 func (*ProtoStateGlobal) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{41}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *ProtoStateGlobal) GetEpoch() uint32 {
@@ -2655,20 +2908,56 @@ func (x *ProtoStateGlobal) GetPrevEpochBlocks() []uint64 {
 	return nil
 }
 
-type ProtoStateApprovedIdentity struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Approved  bool   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
-	Online    bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
-	Delegatee []byte `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+func (x *ProtoStateGlobal) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
+func (x *ProtoStateGlobal) GetMisbehaviors() []*ProtoStateGlobal_ProtoMisbehavior {
+	if x != nil {
+		return x.Misbehaviors
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal) GetMinProposerThreshold() uint64 {
+	if x != nil {
+		return x.MinProposerThreshold
+	}
+	return 0
+}
+
+func (x *ProtoStateGlobal) GetWordsDictionary() *ProtoWordsDictionary {
+	if x != nil {
+		return x.WordsDictionary
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal) GetNextWordsDictionary() *ProtoWordsDictionary {
+	if x != nil {
+		return x.NextWordsDictionary
+	}
+	return nil
+}
+
+type ProtoStateApprovedIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approved  bool   `protobuf:"varint,1,opt,name=approved,proto3" json:"approved,omitempty"`
+	Online    bool   `protobuf:"varint,2,opt,name=online,proto3" json:"online,omitempty"`
+	Delegatee []byte `protobuf:"bytes,3,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	BlsPubKey []byte `protobuf:"bytes,4,opt,name=blsPubKey,proto3" json:"blsPubKey,omitempty"`
 }
 
 func (x *ProtoStateApprovedIdentity) Reset() {
 	*x = ProtoStateApprovedIdentity{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[42]
+		mi := &file_protobuf_models_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2681,7 +2970,7 @@ func (x *ProtoStateApprovedIdentity) String() string {
 func (*ProtoStateApprovedIdentity) ProtoMessage() {}
 
 func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[42]
+	mi := &file_protobuf_models_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2694,7 +2983,7 @@ func (x *ProtoStateApprovedIdentity) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateApprovedIdentity.ProtoReflect.Descriptor instead.
 func (*ProtoStateApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{42}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *ProtoStateApprovedIdentity) GetApproved() bool {
@@ -2718,6 +3007,13 @@ func (x *ProtoStateApprovedIdentity) GetDelegatee() []byte {
 	return nil
 }
 
+func (x *ProtoStateApprovedIdentity) GetBlsPubKey() []byte {
+	if x != nil {
+		return x.BlsPubKey
+	}
+	return nil
+}
+
 type ProtoStateIdentityStatusSwitch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2729,7 +3025,7 @@ type ProtoStateIdentityStatusSwitch struct {
 func (x *ProtoStateIdentityStatusSwitch) Reset() {
 	*x = ProtoStateIdentityStatusSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[43]
+		mi := &file_protobuf_models_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2742,7 +3038,7 @@ func (x *ProtoStateIdentityStatusSwitch) String() string {
 func (*ProtoStateIdentityStatusSwitch) ProtoMessage() {}
 
 func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[43]
+	mi := &file_protobuf_models_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2755,7 +3051,7 @@ func (x *ProtoStateIdentityStatusSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateIdentityStatusSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateIdentityStatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{43}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *ProtoStateIdentityStatusSwitch) GetAddresses() [][]byte {
@@ -2776,7 +3072,7 @@ type ProtoStateDelegationSwitch struct {
 func (x *ProtoStateDelegationSwitch) Reset() {
 	*x = ProtoStateDelegationSwitch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[44]
+		mi := &file_protobuf_models_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2789,7 +3085,7 @@ func (x *ProtoStateDelegationSwitch) String() string {
 func (*ProtoStateDelegationSwitch) ProtoMessage() {}
 
 func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[44]
+	mi := &file_protobuf_models_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2802,7 +3098,7 @@ func (x *ProtoStateDelegationSwitch) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelegationSwitch.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelegationSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *ProtoStateDelegationSwitch) GetDelegations() []*ProtoStateDelegationSwitch_Delegation {
@@ -2823,7 +3119,7 @@ type ProtoStateDelayedPenalties struct {
 func (x *ProtoStateDelayedPenalties) Reset() {
 	*x = ProtoStateDelayedPenalties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[45]
+		mi := &file_protobuf_models_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2836,7 +3132,7 @@ func (x *ProtoStateDelayedPenalties) String() string {
 func (*ProtoStateDelayedPenalties) ProtoMessage() {}
 
 func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[45]
+	mi := &file_protobuf_models_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2849,7 +3145,7 @@ func (x *ProtoStateDelayedPenalties) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStateDelayedPenalties.ProtoReflect.Descriptor instead.
 func (*ProtoStateDelayedPenalties) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{45}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *ProtoStateDelayedPenalties) GetIdentities() [][]byte {
@@ -2877,7 +3173,7 @@ type ProtoPredefinedState struct {
 func (x *ProtoPredefinedState) Reset() {
 	*x = ProtoPredefinedState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[46]
+		mi := &file_protobuf_models_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2890,7 +3186,7 @@ func (x *ProtoPredefinedState) String() string {
 func (*ProtoPredefinedState) ProtoMessage() {}
 
 func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[46]
+	mi := &file_protobuf_models_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2903,7 +3199,7 @@ func (x *ProtoPredefinedState) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoPredefinedState.ProtoReflect.Descriptor instead.
 func (*ProtoPredefinedState) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *ProtoPredefinedState) GetBlock() uint64 {
@@ -2974,7 +3270,7 @@ type ProtoCallContractAttachment struct {
 func (x *ProtoCallContractAttachment) Reset() {
 	*x = ProtoCallContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[47]
+		mi := &file_protobuf_models_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2987,7 +3283,7 @@ func (x *ProtoCallContractAttachment) String() string {
 func (*ProtoCallContractAttachment) ProtoMessage() {}
 
 func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[47]
+	mi := &file_protobuf_models_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,7 +3296,7 @@ func (x *ProtoCallContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoCallContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoCallContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{47}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *ProtoCallContractAttachment) GetMethod() string {
@@ -3029,7 +3325,7 @@ type ProtoDeployContractAttachment struct {
 func (x *ProtoDeployContractAttachment) Reset() {
 	*x = ProtoDeployContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[48]
+		mi := &file_protobuf_models_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3042,7 +3338,7 @@ func (x *ProtoDeployContractAttachment) String() string {
 func (*ProtoDeployContractAttachment) ProtoMessage() {}
 
 func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[48]
+	mi := &file_protobuf_models_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3055,7 +3351,7 @@ func (x *ProtoDeployContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoDeployContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoDeployContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{48}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *ProtoDeployContractAttachment) GetCodeHash() []byte {
@@ -3083,7 +3379,7 @@ type ProtoTerminateContractAttachment struct {
 func (x *ProtoTerminateContractAttachment) Reset() {
 	*x = ProtoTerminateContractAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[49]
+		mi := &file_protobuf_models_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3096,7 +3392,7 @@ func (x *ProtoTerminateContractAttachment) String() string {
 func (*ProtoTerminateContractAttachment) ProtoMessage() {}
 
 func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[49]
+	mi := &file_protobuf_models_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3109,7 +3405,7 @@ func (x *ProtoTerminateContractAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoTerminateContractAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoTerminateContractAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{49}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *ProtoTerminateContractAttachment) GetArgs() [][]byte {
@@ -3131,7 +3427,7 @@ type ProtoStoreToIpfsAttachment struct {
 func (x *ProtoStoreToIpfsAttachment) Reset() {
 	*x = ProtoStoreToIpfsAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[50]
+		mi := &file_protobuf_models_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3144,7 +3440,7 @@ func (x *ProtoStoreToIpfsAttachment) String() string {
 func (*ProtoStoreToIpfsAttachment) ProtoMessage() {}
 
 func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[50]
+	mi := &file_protobuf_models_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3157,7 +3453,7 @@ func (x *ProtoStoreToIpfsAttachment) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProtoStoreToIpfsAttachment.ProtoReflect.Descriptor instead.
 func (*ProtoStoreToIpfsAttachment) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{50}
+	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *ProtoStoreToIpfsAttachment) GetCid() []byte {
@@ -3174,31 +3470,32 @@ func (x *ProtoStoreToIpfsAttachment) GetSize() uint32 {
 	return 0
 }
 
-type ProtoTxReceipts struct {
+type ProtoLockStakeAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Receipts []*ProtoTxReceipts_ProtoTxReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	Delay  uint32 `protobuf:"varint,1,opt,name=delay,proto3" json:"delay,omitempty"`
+	Epochs uint32 `protobuf:"varint,2,opt,name=epochs,proto3" json:"epochs,omitempty"`
 }
 
-func (x *ProtoTxReceipts) Reset() {
-	*x = ProtoTxReceipts{}
+func (x *ProtoLockStakeAttachment) Reset() {
+	*x = ProtoLockStakeAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[51]
+		mi := &file_protobuf_models_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoTxReceipts) String() string {
+func (x *ProtoLockStakeAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoTxReceipts) ProtoMessage() {}
+func (*ProtoLockStakeAttachment) ProtoMessage() {}
 
-func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[51]
+func (x *ProtoLockStakeAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3209,44 +3506,50 @@ func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoTxReceipts.ProtoReflect.Descriptor instead.
-func (*ProtoTxReceipts) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ProtoLockStakeAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoLockStakeAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ProtoTxReceipts) GetReceipts() []*ProtoTxReceipts_ProtoTxReceipt {
+func (x *ProtoLockStakeAttachment) GetDelay() uint32 {
 	if x != nil {
-		return x.Receipts
+		return x.Delay
 	}
-	return nil
+	return 0
 }
 
-type ProtoTxReceiptIndex struct {
+func (x *ProtoLockStakeAttachment) GetEpochs() uint32 {
+	if x != nil {
+		return x.Epochs
+	}
+	return 0
+}
+
+type ProtoChangeBlockGasLimitAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid   []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	GasLimit uint64 `protobuf:"varint,1,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
 }
 
-func (x *ProtoTxReceiptIndex) Reset() {
-	*x = ProtoTxReceiptIndex{}
+func (x *ProtoChangeBlockGasLimitAttachment) Reset() {
+	*x = ProtoChangeBlockGasLimitAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[52]
+		mi := &file_protobuf_models_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoTxReceiptIndex) String() string {
+func (x *ProtoChangeBlockGasLimitAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoTxReceiptIndex) ProtoMessage() {}
+func (*ProtoChangeBlockGasLimitAttachment) ProtoMessage() {}
 
-func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[52]
+func (x *ProtoChangeBlockGasLimitAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3257,50 +3560,44 @@ func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoTxReceiptIndex.ProtoReflect.Descriptor instead.
-func (*ProtoTxReceiptIndex) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{52}
-}
-
-func (x *ProtoTxReceiptIndex) GetCid() []byte {
-	if x != nil {
-		return x.Cid
-	}
-	return nil
+// Deprecated: Use ProtoChangeBlockGasLimitAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoChangeBlockGasLimitAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *ProtoTxReceiptIndex) GetIndex() uint32 {
+func (x *ProtoChangeBlockGasLimitAttachment) GetGasLimit() uint64 {
 	if x != nil {
-		return x.Index
+		return x.GasLimit
 	}
 	return 0
 }
 
-type ProtoDeferredTxs struct {
+type ProtoMisbehaviorEvidenceAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Txs []*ProtoDeferredTxs_ProtoDeferredTx `protobuf:"bytes,1,rep,name=Txs,proto3" json:"Txs,omitempty"`
+	Vote1 *ProtoVote `protobuf:"bytes,1,opt,name=vote1,proto3" json:"vote1,omitempty"`
+	Vote2 *ProtoVote `protobuf:"bytes,2,opt,name=vote2,proto3" json:"vote2,omitempty"`
 }
 
-func (x *ProtoDeferredTxs) Reset() {
-	*x = ProtoDeferredTxs{}
+func (x *ProtoMisbehaviorEvidenceAttachment) Reset() {
+	*x = ProtoMisbehaviorEvidenceAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[53]
+		mi := &file_protobuf_models_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoDeferredTxs) String() string {
+func (x *ProtoMisbehaviorEvidenceAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoDeferredTxs) ProtoMessage() {}
+func (*ProtoMisbehaviorEvidenceAttachment) ProtoMessage() {}
 
-func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[53]
+func (x *ProtoMisbehaviorEvidenceAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3311,45 +3608,51 @@ func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoDeferredTxs.ProtoReflect.Descriptor instead.
-func (*ProtoDeferredTxs) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ProtoMisbehaviorEvidenceAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoMisbehaviorEvidenceAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ProtoDeferredTxs) GetTxs() []*ProtoDeferredTxs_ProtoDeferredTx {
+func (x *ProtoMisbehaviorEvidenceAttachment) GetVote1() *ProtoVote {
 	if x != nil {
-		return x.Txs
+		return x.Vote1
 	}
 	return nil
 }
 
-type ProtoSavedEvent struct {
+func (x *ProtoMisbehaviorEvidenceAttachment) GetVote2() *ProtoVote {
+	if x != nil {
+		return x.Vote2
+	}
+	return nil
+}
+
+type ProtoRegisterBlsKeyAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract []byte   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Event    string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
-	Args     [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
+	PubKey []byte `protobuf:"bytes,1,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	Proof  []byte `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *ProtoSavedEvent) Reset() {
-	*x = ProtoSavedEvent{}
+func (x *ProtoRegisterBlsKeyAttachment) Reset() {
+	*x = ProtoRegisterBlsKeyAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[54]
+		mi := &file_protobuf_models_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoSavedEvent) String() string {
+func (x *ProtoRegisterBlsKeyAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoSavedEvent) ProtoMessage() {}
+func (*ProtoRegisterBlsKeyAttachment) ProtoMessage() {}
 
-func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[54]
+func (x *ProtoRegisterBlsKeyAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3360,57 +3663,50 @@ func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoSavedEvent.ProtoReflect.Descriptor instead.
-func (*ProtoSavedEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use ProtoRegisterBlsKeyAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoRegisterBlsKeyAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *ProtoSavedEvent) GetContract() []byte {
+func (x *ProtoRegisterBlsKeyAttachment) GetPubKey() []byte {
 	if x != nil {
-		return x.Contract
+		return x.PubKey
 	}
 	return nil
 }
 
-func (x *ProtoSavedEvent) GetEvent() string {
-	if x != nil {
-		return x.Event
-	}
-	return ""
-}
-
-func (x *ProtoSavedEvent) GetArgs() [][]byte {
+func (x *ProtoRegisterBlsKeyAttachment) GetProof() []byte {
 	if x != nil {
-		return x.Args
+		return x.Proof
 	}
 	return nil
 }
 
-type ProtoUpgradeVotes struct {
+type ProtoTxReceipts struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Votes []*ProtoUpgradeVotes_ProtoUpgradeVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
+	Receipts []*ProtoTxReceipts_ProtoTxReceipt `protobuf:"bytes,1,rep,name=receipts,proto3" json:"receipts,omitempty"`
 }
 
-func (x *ProtoUpgradeVotes) Reset() {
-	*x = ProtoUpgradeVotes{}
+func (x *ProtoTxReceipts) Reset() {
+	*x = ProtoTxReceipts{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[55]
+		mi := &file_protobuf_models_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoUpgradeVotes) String() string {
+func (x *ProtoTxReceipts) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoUpgradeVotes) ProtoMessage() {}
+func (*ProtoTxReceipts) ProtoMessage() {}
 
-func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[55]
+func (x *ProtoTxReceipts) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3421,50 +3717,44 @@ func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoUpgradeVotes.ProtoReflect.Descriptor instead.
-func (*ProtoUpgradeVotes) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ProtoTxReceipts.ProtoReflect.Descriptor instead.
+func (*ProtoTxReceipts) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ProtoUpgradeVotes) GetVotes() []*ProtoUpgradeVotes_ProtoUpgradeVote {
+func (x *ProtoTxReceipts) GetReceipts() []*ProtoTxReceipts_ProtoTxReceipt {
 	if x != nil {
-		return x.Votes
+		return x.Receipts
 	}
 	return nil
 }
 
-type ProtoTransaction_Data struct {
+type ProtoTxReceiptIndex struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce   uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Epoch   uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Type    uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
-	To      []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
-	Amount  []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	MaxFee  []byte `protobuf:"bytes,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
-	Tips    []byte `protobuf:"bytes,7,opt,name=tips,proto3" json:"tips,omitempty"`
-	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	Cid   []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (x *ProtoTransaction_Data) Reset() {
-	*x = ProtoTransaction_Data{}
+func (x *ProtoTxReceiptIndex) Reset() {
+	*x = ProtoTxReceiptIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[56]
+		mi := &file_protobuf_models_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoTransaction_Data) String() string {
+func (x *ProtoTxReceiptIndex) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoTransaction_Data) ProtoMessage() {}
+func (*ProtoTxReceiptIndex) ProtoMessage() {}
 
-func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[56]
+func (x *ProtoTxReceiptIndex) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3475,107 +3765,99 @@ func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoTransaction_Data.ProtoReflect.Descriptor instead.
-func (*ProtoTransaction_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{0, 0}
-}
-
-func (x *ProtoTransaction_Data) GetNonce() uint32 {
-	if x != nil {
-		return x.Nonce
-	}
-	return 0
+// Deprecated: Use ProtoTxReceiptIndex.ProtoReflect.Descriptor instead.
+func (*ProtoTxReceiptIndex) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *ProtoTransaction_Data) GetEpoch() uint32 {
+func (x *ProtoTxReceiptIndex) GetCid() []byte {
 	if x != nil {
-		return x.Epoch
+		return x.Cid
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoTransaction_Data) GetType() uint32 {
+func (x *ProtoTxReceiptIndex) GetIndex() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Index
 	}
 	return 0
 }
 
-func (x *ProtoTransaction_Data) GetTo() []byte {
-	if x != nil {
-		return x.To
-	}
-	return nil
+type ProtoDeferredTxs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Txs []*ProtoDeferredTxs_ProtoDeferredTx `protobuf:"bytes,1,rep,name=Txs,proto3" json:"Txs,omitempty"`
 }
 
-func (x *ProtoTransaction_Data) GetAmount() []byte {
-	if x != nil {
-		return x.Amount
+func (x *ProtoDeferredTxs) Reset() {
+	*x = ProtoDeferredTxs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProtoTransaction_Data) GetMaxFee() []byte {
-	if x != nil {
-		return x.MaxFee
-	}
-	return nil
+func (x *ProtoDeferredTxs) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProtoTransaction_Data) GetTips() []byte {
-	if x != nil {
-		return x.Tips
+func (*ProtoDeferredTxs) ProtoMessage() {}
+
+func (x *ProtoDeferredTxs) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoTransaction_Data) GetPayload() []byte {
+// Deprecated: Use ProtoDeferredTxs.ProtoReflect.Descriptor instead.
+func (*ProtoDeferredTxs) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ProtoDeferredTxs) GetTxs() []*ProtoDeferredTxs_ProtoDeferredTx {
 	if x != nil {
-		return x.Payload
+		return x.Txs
 	}
 	return nil
 }
 
-type ProtoBlockHeader_Proposed struct {
+type ProtoSavedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParentHash     []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	Height         uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Timestamp      int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	TxHash         []byte `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	ProposerPubKey []byte `protobuf:"bytes,5,opt,name=proposerPubKey,proto3" json:"proposerPubKey,omitempty"`
-	Root           []byte `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty"`
-	IdentityRoot   []byte `protobuf:"bytes,7,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
-	Flags          uint32 `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
-	IpfsHash       []byte `protobuf:"bytes,9,opt,name=ipfsHash,proto3" json:"ipfsHash,omitempty"`
-	OfflineAddr    []byte `protobuf:"bytes,10,opt,name=offlineAddr,proto3" json:"offlineAddr,omitempty"`
-	TxBloom        []byte `protobuf:"bytes,11,opt,name=txBloom,proto3" json:"txBloom,omitempty"`
-	BlockSeed      []byte `protobuf:"bytes,12,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
-	FeePerGas      []byte `protobuf:"bytes,13,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
-	Upgrade        uint32 `protobuf:"varint,14,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
-	SeedProof      []byte `protobuf:"bytes,15,opt,name=seedProof,proto3" json:"seedProof,omitempty"`
-	ReceiptsCid    []byte `protobuf:"bytes,16,opt,name=receiptsCid,proto3" json:"receiptsCid,omitempty"`
+	Contract []byte   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Event    string   `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Args     [][]byte `protobuf:"bytes,3,rep,name=args,proto3" json:"args,omitempty"`
 }
 
-func (x *ProtoBlockHeader_Proposed) Reset() {
-	*x = ProtoBlockHeader_Proposed{}
+func (x *ProtoSavedEvent) Reset() {
+	*x = ProtoSavedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[57]
+		mi := &file_protobuf_models_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoBlockHeader_Proposed) String() string {
+func (x *ProtoSavedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoBlockHeader_Proposed) ProtoMessage() {}
+func (*ProtoSavedEvent) ProtoMessage() {}
 
-func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[57]
+func (x *ProtoSavedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3586,154 +3868,173 @@ func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoBlockHeader_Proposed.ProtoReflect.Descriptor instead.
-func (*ProtoBlockHeader_Proposed) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use ProtoSavedEvent.ProtoReflect.Descriptor instead.
+func (*ProtoSavedEvent) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ProtoBlockHeader_Proposed) GetParentHash() []byte {
+func (x *ProtoSavedEvent) GetContract() []byte {
 	if x != nil {
-		return x.ParentHash
+		return x.Contract
 	}
 	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetHeight() uint64 {
+func (x *ProtoSavedEvent) GetEvent() string {
 	if x != nil {
-		return x.Height
+		return x.Event
 	}
-	return 0
+	return ""
 }
 
-func (x *ProtoBlockHeader_Proposed) GetTimestamp() int64 {
+func (x *ProtoSavedEvent) GetArgs() [][]byte {
 	if x != nil {
-		return x.Timestamp
+		return x.Args
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetTxHash() []byte {
-	if x != nil {
-		return x.TxHash
-	}
-	return nil
+type ProtoUpgradeVotes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Votes []*ProtoUpgradeVotes_ProtoUpgradeVote `protobuf:"bytes,1,rep,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (x *ProtoBlockHeader_Proposed) GetProposerPubKey() []byte {
-	if x != nil {
-		return x.ProposerPubKey
+func (x *ProtoUpgradeVotes) Reset() {
+	*x = ProtoUpgradeVotes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetRoot() []byte {
-	if x != nil {
-		return x.Root
-	}
-	return nil
+func (x *ProtoUpgradeVotes) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProtoBlockHeader_Proposed) GetIdentityRoot() []byte {
-	if x != nil {
-		return x.IdentityRoot
+func (*ProtoUpgradeVotes) ProtoMessage() {}
+
+func (x *ProtoUpgradeVotes) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoBlockHeader_Proposed) GetFlags() uint32 {
-	if x != nil {
-		return x.Flags
-	}
-	return 0
+// Deprecated: Use ProtoUpgradeVotes.ProtoReflect.Descriptor instead.
+func (*ProtoUpgradeVotes) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ProtoBlockHeader_Proposed) GetIpfsHash() []byte {
+func (x *ProtoUpgradeVotes) GetVotes() []*ProtoUpgradeVotes_ProtoUpgradeVote {
 	if x != nil {
-		return x.IpfsHash
+		return x.Votes
 	}
 	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetOfflineAddr() []byte {
-	if x != nil {
-		return x.OfflineAddr
-	}
-	return nil
+type ProtoEpochStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FirstBlock uint64 `protobuf:"varint,1,opt,name=firstBlock,proto3" json:"firstBlock,omitempty"`
+	Minted     []byte `protobuf:"bytes,2,opt,name=minted,proto3" json:"minted,omitempty"`
+	FeeBurnt   []byte `protobuf:"bytes,3,opt,name=feeBurnt,proto3" json:"feeBurnt,omitempty"`
 }
 
-func (x *ProtoBlockHeader_Proposed) GetTxBloom() []byte {
-	if x != nil {
-		return x.TxBloom
+func (x *ProtoEpochStats) Reset() {
+	*x = ProtoEpochStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetBlockSeed() []byte {
-	if x != nil {
-		return x.BlockSeed
-	}
-	return nil
+func (x *ProtoEpochStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProtoBlockHeader_Proposed) GetFeePerGas() []byte {
-	if x != nil {
-		return x.FeePerGas
+func (*ProtoEpochStats) ProtoMessage() {}
+
+func (x *ProtoEpochStats) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoBlockHeader_Proposed) GetUpgrade() uint32 {
+// Deprecated: Use ProtoEpochStats.ProtoReflect.Descriptor instead.
+func (*ProtoEpochStats) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ProtoEpochStats) GetFirstBlock() uint64 {
 	if x != nil {
-		return x.Upgrade
+		return x.FirstBlock
 	}
 	return 0
 }
 
-func (x *ProtoBlockHeader_Proposed) GetSeedProof() []byte {
+func (x *ProtoEpochStats) GetMinted() []byte {
 	if x != nil {
-		return x.SeedProof
+		return x.Minted
 	}
 	return nil
 }
 
-func (x *ProtoBlockHeader_Proposed) GetReceiptsCid() []byte {
+func (x *ProtoEpochStats) GetFeeBurnt() []byte {
 	if x != nil {
-		return x.ReceiptsCid
+		return x.FeeBurnt
 	}
 	return nil
 }
 
-type ProtoBlockHeader_Empty struct {
+type ProtoEpochSummary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ParentHash   []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	Height       uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Root         []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
-	IdentityRoot []byte `protobuf:"bytes,4,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
-	Timestamp    int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	BlockSeed    []byte `protobuf:"bytes,6,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
-	Flags        uint32 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
+	Epoch             uint32                               `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height            uint64                               `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	FirstBlock        uint64                               `protobuf:"varint,3,opt,name=firstBlock,proto3" json:"firstBlock,omitempty"`
+	Minted            []byte                               `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	FeeBurnt          []byte                               `protobuf:"bytes,5,opt,name=feeBurnt,proto3" json:"feeBurnt,omitempty"`
+	Identities        []*ProtoEpochSummary_ProtoStateCount `protobuf:"bytes,6,rep,name=identities,proto3" json:"identities,omitempty"`
+	ValidationResults []*ProtoEpochSummary_ProtoStateCount `protobuf:"bytes,7,rep,name=validationResults,proto3" json:"validationResults,omitempty"`
 }
 
-func (x *ProtoBlockHeader_Empty) Reset() {
-	*x = ProtoBlockHeader_Empty{}
+func (x *ProtoEpochSummary) Reset() {
+	*x = ProtoEpochSummary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[58]
+		mi := &file_protobuf_models_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoBlockHeader_Empty) String() string {
+func (x *ProtoEpochSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoBlockHeader_Empty) ProtoMessage() {}
+func (*ProtoEpochSummary) ProtoMessage() {}
 
-func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[58]
+func (x *ProtoEpochSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3744,87 +4045,91 @@ func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoBlockHeader_Empty.ProtoReflect.Descriptor instead.
-func (*ProtoBlockHeader_Empty) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{1, 1}
+// Deprecated: Use ProtoEpochSummary.ProtoReflect.Descriptor instead.
+func (*ProtoEpochSummary) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ProtoBlockHeader_Empty) GetParentHash() []byte {
+func (x *ProtoEpochSummary) GetEpoch() uint32 {
 	if x != nil {
-		return x.ParentHash
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoBlockHeader_Empty) GetHeight() uint64 {
+func (x *ProtoEpochSummary) GetHeight() uint64 {
 	if x != nil {
 		return x.Height
 	}
 	return 0
 }
 
-func (x *ProtoBlockHeader_Empty) GetRoot() []byte {
+func (x *ProtoEpochSummary) GetFirstBlock() uint64 {
 	if x != nil {
-		return x.Root
+		return x.FirstBlock
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoBlockHeader_Empty) GetIdentityRoot() []byte {
+func (x *ProtoEpochSummary) GetMinted() []byte {
 	if x != nil {
-		return x.IdentityRoot
+		return x.Minted
 	}
 	return nil
 }
 
-func (x *ProtoBlockHeader_Empty) GetTimestamp() int64 {
+func (x *ProtoEpochSummary) GetFeeBurnt() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.FeeBurnt
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoBlockHeader_Empty) GetBlockSeed() []byte {
+func (x *ProtoEpochSummary) GetIdentities() []*ProtoEpochSummary_ProtoStateCount {
 	if x != nil {
-		return x.BlockSeed
+		return x.Identities
 	}
 	return nil
 }
 
-func (x *ProtoBlockHeader_Empty) GetFlags() uint32 {
+func (x *ProtoEpochSummary) GetValidationResults() []*ProtoEpochSummary_ProtoStateCount {
 	if x != nil {
-		return x.Flags
+		return x.ValidationResults
 	}
-	return 0
+	return nil
 }
 
-type ProtoBlockProposal_Data struct {
+type ProtoMinerPerformance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Header *ProtoBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Body   *ProtoBlockBody   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
-	Proof  []byte            `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	Epoch             uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Rounds            uint32 `protobuf:"varint,2,opt,name=rounds,proto3" json:"rounds,omitempty"`
+	ExpectedProposals uint32 `protobuf:"varint,3,opt,name=expectedProposals,proto3" json:"expectedProposals,omitempty"`
+	Proposals         uint32 `protobuf:"varint,4,opt,name=proposals,proto3" json:"proposals,omitempty"`
+	ExpectedVotes     uint32 `protobuf:"varint,5,opt,name=expectedVotes,proto3" json:"expectedVotes,omitempty"`
+	Votes             uint32 `protobuf:"varint,6,opt,name=votes,proto3" json:"votes,omitempty"`
+	LastMissedRound   uint64 `protobuf:"varint,7,opt,name=lastMissedRound,proto3" json:"lastMissedRound,omitempty"`
 }
 
-func (x *ProtoBlockProposal_Data) Reset() {
-	*x = ProtoBlockProposal_Data{}
+func (x *ProtoMinerPerformance) Reset() {
+	*x = ProtoMinerPerformance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[59]
+		mi := &file_protobuf_models_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoBlockProposal_Data) String() string {
+func (x *ProtoMinerPerformance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoBlockProposal_Data) ProtoMessage() {}
+func (*ProtoMinerPerformance) ProtoMessage() {}
 
-func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[59]
+func (x *ProtoMinerPerformance) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3835,59 +4140,85 @@ func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoBlockProposal_Data.ProtoReflect.Descriptor instead.
-func (*ProtoBlockProposal_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use ProtoMinerPerformance.ProtoReflect.Descriptor instead.
+func (*ProtoMinerPerformance) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *ProtoBlockProposal_Data) GetHeader() *ProtoBlockHeader {
+func (x *ProtoMinerPerformance) GetEpoch() uint32 {
 	if x != nil {
-		return x.Header
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoBlockProposal_Data) GetBody() *ProtoBlockBody {
+func (x *ProtoMinerPerformance) GetRounds() uint32 {
 	if x != nil {
-		return x.Body
+		return x.Rounds
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoBlockProposal_Data) GetProof() []byte {
+func (x *ProtoMinerPerformance) GetExpectedProposals() uint32 {
 	if x != nil {
-		return x.Proof
+		return x.ExpectedProposals
 	}
-	return nil
+	return 0
 }
 
-type ProtoBlockCert_Signature struct {
+func (x *ProtoMinerPerformance) GetProposals() uint32 {
+	if x != nil {
+		return x.Proposals
+	}
+	return 0
+}
+
+func (x *ProtoMinerPerformance) GetExpectedVotes() uint32 {
+	if x != nil {
+		return x.ExpectedVotes
+	}
+	return 0
+}
+
+func (x *ProtoMinerPerformance) GetVotes() uint32 {
+	if x != nil {
+		return x.Votes
+	}
+	return 0
+}
+
+func (x *ProtoMinerPerformance) GetLastMissedRound() uint64 {
+	if x != nil {
+		return x.LastMissedRound
+	}
+	return 0
+}
+
+type ProtoChangeProposerThresholdAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TurnOffline bool   `protobuf:"varint,1,opt,name=turnOffline,proto3" json:"turnOffline,omitempty"`
-	Upgrade     uint32 `protobuf:"varint,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
-	Signature   []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	Threshold float64 `protobuf:"fixed64,1,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
-func (x *ProtoBlockCert_Signature) Reset() {
-	*x = ProtoBlockCert_Signature{}
+func (x *ProtoChangeProposerThresholdAttachment) Reset() {
+	*x = ProtoChangeProposerThresholdAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[60]
+		mi := &file_protobuf_models_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoBlockCert_Signature) String() string {
+func (x *ProtoChangeProposerThresholdAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoBlockCert_Signature) ProtoMessage() {}
+func (*ProtoChangeProposerThresholdAttachment) ProtoMessage() {}
 
-func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[60]
+func (x *ProtoChangeProposerThresholdAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3898,59 +4229,44 @@ func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoBlockCert_Signature.ProtoReflect.Descriptor instead.
-func (*ProtoBlockCert_Signature) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{6, 0}
-}
-
-func (x *ProtoBlockCert_Signature) GetTurnOffline() bool {
-	if x != nil {
-		return x.TurnOffline
-	}
-	return false
+// Deprecated: Use ProtoChangeProposerThresholdAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoChangeProposerThresholdAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ProtoBlockCert_Signature) GetUpgrade() uint32 {
+func (x *ProtoChangeProposerThresholdAttachment) GetThreshold() float64 {
 	if x != nil {
-		return x.Upgrade
+		return x.Threshold
 	}
 	return 0
 }
 
-func (x *ProtoBlockCert_Signature) GetSignature() []byte {
-	if x != nil {
-		return x.Signature
-	}
-	return nil
-}
-
-type ProtoIdentityStateDiff_IdentityStateDiffValue struct {
+type ProtoCeremonyTimeline struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Deleted bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
-	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Epoch  uint32                         `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Phases []*ProtoCeremonyTimeline_Phase `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases,omitempty"`
 }
 
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) Reset() {
-	*x = ProtoIdentityStateDiff_IdentityStateDiffValue{}
+func (x *ProtoCeremonyTimeline) Reset() {
+	*x = ProtoCeremonyTimeline{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[61]
+		mi := &file_protobuf_models_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) String() string {
+func (x *ProtoCeremonyTimeline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoMessage() {}
+func (*ProtoCeremonyTimeline) ProtoMessage() {}
 
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[61]
+func (x *ProtoCeremonyTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3961,58 +4277,65 @@ func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoIdentityStateDiff_IdentityStateDiffValue.ProtoReflect.Descriptor instead.
-func (*ProtoIdentityStateDiff_IdentityStateDiffValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{13, 0}
-}
-
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetAddress() []byte {
-	if x != nil {
-		return x.Address
-	}
-	return nil
+// Deprecated: Use ProtoCeremonyTimeline.ProtoReflect.Descriptor instead.
+func (*ProtoCeremonyTimeline) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetDeleted() bool {
+func (x *ProtoCeremonyTimeline) GetEpoch() uint32 {
 	if x != nil {
-		return x.Deleted
+		return x.Epoch
 	}
-	return false
+	return 0
 }
 
-func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetValue() []byte {
+func (x *ProtoCeremonyTimeline) GetPhases() []*ProtoCeremonyTimeline_Phase {
 	if x != nil {
-		return x.Value
+		return x.Phases
 	}
 	return nil
 }
 
-type ProtoSnapshotBlock_KeyValue struct {
+type ProtoValidationExplanation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-}
-
-func (x *ProtoSnapshotBlock_KeyValue) Reset() {
-	*x = ProtoSnapshotBlock_KeyValue{}
+	Epoch               uint32                              `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PrevState           uint32                              `protobuf:"varint,2,opt,name=prevState,proto3" json:"prevState,omitempty"`
+	NewState            uint32                              `protobuf:"varint,3,opt,name=newState,proto3" json:"newState,omitempty"`
+	Reason              string                              `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Approved            bool                                `protobuf:"varint,5,opt,name=approved,proto3" json:"approved,omitempty"`
+	Missed              bool                                `protobuf:"varint,6,opt,name=missed,proto3" json:"missed,omitempty"`
+	ValidationFailed    bool                                `protobuf:"varint,7,opt,name=validationFailed,proto3" json:"validationFailed,omitempty"`
+	RequiredFlips       uint32                              `protobuf:"varint,8,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
+	MadeFlips           uint32                              `protobuf:"varint,9,opt,name=madeFlips,proto3" json:"madeFlips,omitempty"`
+	ReportedFlips       uint32                              `protobuf:"varint,10,opt,name=reportedFlips,proto3" json:"reportedFlips,omitempty"`
+	BadAuthor           bool                                `protobuf:"varint,11,opt,name=badAuthor,proto3" json:"badAuthor,omitempty"`
+	BadAuthorReason     uint32                              `protobuf:"varint,12,opt,name=badAuthorReason,proto3" json:"badAuthorReason,omitempty"`
+	Short               *ProtoValidationExplanation_Session `protobuf:"bytes,13,opt,name=short,proto3" json:"short,omitempty"`
+	Long                *ProtoValidationExplanation_Session `protobuf:"bytes,14,opt,name=long,proto3" json:"long,omitempty"`
+	TotalQualifiedFlips uint32                              `protobuf:"varint,15,opt,name=totalQualifiedFlips,proto3" json:"totalQualifiedFlips,omitempty"`
+	TotalScore          float32                             `protobuf:"fixed32,16,opt,name=totalScore,proto3" json:"totalScore,omitempty"`
+}
+
+func (x *ProtoValidationExplanation) Reset() {
+	*x = ProtoValidationExplanation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[62]
+		mi := &file_protobuf_models_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoSnapshotBlock_KeyValue) String() string {
+func (x *ProtoValidationExplanation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoSnapshotBlock_KeyValue) ProtoMessage() {}
+func (*ProtoValidationExplanation) ProtoMessage() {}
 
-func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[62]
+func (x *ProtoValidationExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4023,114 +4346,150 @@ func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoSnapshotBlock_KeyValue.ProtoReflect.Descriptor instead.
-func (*ProtoSnapshotBlock_KeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use ProtoValidationExplanation.ProtoReflect.Descriptor instead.
+func (*ProtoValidationExplanation) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ProtoSnapshotBlock_KeyValue) GetKey() []byte {
+func (x *ProtoValidationExplanation) GetEpoch() uint32 {
 	if x != nil {
-		return x.Key
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoSnapshotBlock_KeyValue) GetValue() []byte {
+func (x *ProtoValidationExplanation) GetPrevState() uint32 {
 	if x != nil {
-		return x.Value
+		return x.PrevState
 	}
-	return nil
+	return 0
 }
 
-type ProtoGossipBlockRange_Block struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProtoValidationExplanation) GetNewState() uint32 {
+	if x != nil {
+		return x.NewState
+	}
+	return 0
+}
 
-	Header *ProtoBlockHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
-	Cert   *ProtoBlockCert         `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
-	Diff   *ProtoIdentityStateDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
+func (x *ProtoValidationExplanation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *ProtoGossipBlockRange_Block) Reset() {
-	*x = ProtoGossipBlockRange_Block{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[63]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProtoValidationExplanation) GetApproved() bool {
+	if x != nil {
+		return x.Approved
 	}
+	return false
 }
 
-func (x *ProtoGossipBlockRange_Block) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProtoValidationExplanation) GetMissed() bool {
+	if x != nil {
+		return x.Missed
+	}
+	return false
 }
 
-func (*ProtoGossipBlockRange_Block) ProtoMessage() {}
+func (x *ProtoValidationExplanation) GetValidationFailed() bool {
+	if x != nil {
+		return x.ValidationFailed
+	}
+	return false
+}
 
-func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[63]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProtoValidationExplanation) GetRequiredFlips() uint32 {
+	if x != nil {
+		return x.RequiredFlips
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ProtoGossipBlockRange_Block.ProtoReflect.Descriptor instead.
-func (*ProtoGossipBlockRange_Block) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{15, 0}
+func (x *ProtoValidationExplanation) GetMadeFlips() uint32 {
+	if x != nil {
+		return x.MadeFlips
+	}
+	return 0
 }
 
-func (x *ProtoGossipBlockRange_Block) GetHeader() *ProtoBlockHeader {
+func (x *ProtoValidationExplanation) GetReportedFlips() uint32 {
 	if x != nil {
-		return x.Header
+		return x.ReportedFlips
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoGossipBlockRange_Block) GetCert() *ProtoBlockCert {
+func (x *ProtoValidationExplanation) GetBadAuthor() bool {
 	if x != nil {
-		return x.Cert
+		return x.BadAuthor
+	}
+	return false
+}
+
+func (x *ProtoValidationExplanation) GetBadAuthorReason() uint32 {
+	if x != nil {
+		return x.BadAuthorReason
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation) GetShort() *ProtoValidationExplanation_Session {
+	if x != nil {
+		return x.Short
 	}
 	return nil
 }
 
-func (x *ProtoGossipBlockRange_Block) GetDiff() *ProtoIdentityStateDiff {
+func (x *ProtoValidationExplanation) GetLong() *ProtoValidationExplanation_Session {
 	if x != nil {
-		return x.Diff
+		return x.Long
 	}
 	return nil
 }
 
-type ProtoProposeProof_Data struct {
+func (x *ProtoValidationExplanation) GetTotalQualifiedFlips() uint32 {
+	if x != nil {
+		return x.TotalQualifiedFlips
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation) GetTotalScore() float32 {
+	if x != nil {
+		return x.TotalScore
+	}
+	return 0
+}
+
+type ProtoWordsDictionary struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
-	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Hash  []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size  uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Epoch uint32 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (x *ProtoProposeProof_Data) Reset() {
-	*x = ProtoProposeProof_Data{}
+func (x *ProtoWordsDictionary) Reset() {
+	*x = ProtoWordsDictionary{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[64]
+		mi := &file_protobuf_models_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoProposeProof_Data) String() string {
+func (x *ProtoWordsDictionary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoProposeProof_Data) ProtoMessage() {}
+func (*ProtoWordsDictionary) ProtoMessage() {}
 
-func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[64]
+func (x *ProtoWordsDictionary) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4141,55 +4500,58 @@ func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoProposeProof_Data.ProtoReflect.Descriptor instead.
-func (*ProtoProposeProof_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{16, 0}
+// Deprecated: Use ProtoWordsDictionary.ProtoReflect.Descriptor instead.
+func (*ProtoWordsDictionary) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *ProtoProposeProof_Data) GetProof() []byte {
+func (x *ProtoWordsDictionary) GetHash() []byte {
 	if x != nil {
-		return x.Proof
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *ProtoProposeProof_Data) GetRound() uint64 {
+func (x *ProtoWordsDictionary) GetSize() uint32 {
 	if x != nil {
-		return x.Round
+		return x.Size
 	}
 	return 0
 }
 
-type ProtoVote_Data struct {
+func (x *ProtoWordsDictionary) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ProtoChangeWordsDictionaryAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Round       uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
-	Step        uint32 `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
-	ParentHash  []byte `protobuf:"bytes,3,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
-	VotedHash   []byte `protobuf:"bytes,4,opt,name=votedHash,proto3" json:"votedHash,omitempty"`
-	TurnOffline bool   `protobuf:"varint,5,opt,name=turnOffline,proto3" json:"turnOffline,omitempty"`
-	Upgrade     uint32 `protobuf:"varint,6,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Size uint32 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
 }
 
-func (x *ProtoVote_Data) Reset() {
-	*x = ProtoVote_Data{}
+func (x *ProtoChangeWordsDictionaryAttachment) Reset() {
+	*x = ProtoChangeWordsDictionaryAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[65]
+		mi := &file_protobuf_models_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoVote_Data) String() string {
+func (x *ProtoChangeWordsDictionaryAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoVote_Data) ProtoMessage() {}
+func (*ProtoChangeWordsDictionaryAttachment) ProtoMessage() {}
 
-func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[65]
+func (x *ProtoChangeWordsDictionaryAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4200,79 +4562,54 @@ func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoVote_Data.ProtoReflect.Descriptor instead.
-func (*ProtoVote_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use ProtoChangeWordsDictionaryAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoChangeWordsDictionaryAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ProtoVote_Data) GetRound() uint64 {
-	if x != nil {
-		return x.Round
-	}
-	return 0
-}
-
-func (x *ProtoVote_Data) GetStep() uint32 {
-	if x != nil {
-		return x.Step
-	}
-	return 0
-}
-
-func (x *ProtoVote_Data) GetParentHash() []byte {
-	if x != nil {
-		return x.ParentHash
-	}
-	return nil
-}
-
-func (x *ProtoVote_Data) GetVotedHash() []byte {
+func (x *ProtoChangeWordsDictionaryAttachment) GetHash() []byte {
 	if x != nil {
-		return x.VotedHash
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *ProtoVote_Data) GetTurnOffline() bool {
-	if x != nil {
-		return x.TurnOffline
-	}
-	return false
-}
-
-func (x *ProtoVote_Data) GetUpgrade() uint32 {
+func (x *ProtoChangeWordsDictionaryAttachment) GetSize() uint32 {
 	if x != nil {
-		return x.Upgrade
+		return x.Size
 	}
 	return 0
 }
 
-type ProtoFlipKey_Data struct {
+type ProtoTrainingFlip struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Epoch uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Epoch       uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	PublicPart  []byte `protobuf:"bytes,2,opt,name=publicPart,proto3" json:"publicPart,omitempty"`
+	PrivatePart []byte `protobuf:"bytes,3,opt,name=privatePart,proto3" json:"privatePart,omitempty"`
+	Answer      uint32 `protobuf:"varint,4,opt,name=answer,proto3" json:"answer,omitempty"`
+	Grade       uint32 `protobuf:"varint,5,opt,name=grade,proto3" json:"grade,omitempty"`
 }
 
-func (x *ProtoFlipKey_Data) Reset() {
-	*x = ProtoFlipKey_Data{}
+func (x *ProtoTrainingFlip) Reset() {
+	*x = ProtoTrainingFlip{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[66]
+		mi := &file_protobuf_models_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoFlipKey_Data) String() string {
+func (x *ProtoTrainingFlip) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoFlipKey_Data) ProtoMessage() {}
+func (*ProtoTrainingFlip) ProtoMessage() {}
 
-func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[66]
+func (x *ProtoTrainingFlip) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4283,51 +4620,71 @@ func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoFlipKey_Data.ProtoReflect.Descriptor instead.
-func (*ProtoFlipKey_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use ProtoTrainingFlip.ProtoReflect.Descriptor instead.
+func (*ProtoTrainingFlip) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *ProtoFlipKey_Data) GetKey() []byte {
+func (x *ProtoTrainingFlip) GetEpoch() uint32 {
 	if x != nil {
-		return x.Key
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoTrainingFlip) GetPublicPart() []byte {
+	if x != nil {
+		return x.PublicPart
 	}
 	return nil
 }
 
-func (x *ProtoFlipKey_Data) GetEpoch() uint32 {
+func (x *ProtoTrainingFlip) GetPrivatePart() []byte {
 	if x != nil {
-		return x.Epoch
+		return x.PrivatePart
+	}
+	return nil
+}
+
+func (x *ProtoTrainingFlip) GetAnswer() uint32 {
+	if x != nil {
+		return x.Answer
 	}
 	return 0
 }
 
-type ProtoPrivateFlipKeysPackage_Data struct {
+func (x *ProtoTrainingFlip) GetGrade() uint32 {
+	if x != nil {
+		return x.Grade
+	}
+	return 0
+}
+
+type ProtoPeerAddrs struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Package []byte `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
-	Epoch   uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Addrs [][]byte `protobuf:"bytes,1,rep,name=addrs,proto3" json:"addrs,omitempty"`
 }
 
-func (x *ProtoPrivateFlipKeysPackage_Data) Reset() {
-	*x = ProtoPrivateFlipKeysPackage_Data{}
+func (x *ProtoPeerAddrs) Reset() {
+	*x = ProtoPeerAddrs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[67]
+		mi := &file_protobuf_models_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPrivateFlipKeysPackage_Data) String() string {
+func (x *ProtoPeerAddrs) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPrivateFlipKeysPackage_Data) ProtoMessage() {}
+func (*ProtoPeerAddrs) ProtoMessage() {}
 
-func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[67]
+func (x *ProtoPeerAddrs) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4338,51 +4695,41 @@ func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPrivateFlipKeysPackage_Data.ProtoReflect.Descriptor instead.
-func (*ProtoPrivateFlipKeysPackage_Data) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{24, 0}
+// Deprecated: Use ProtoPeerAddrs.ProtoReflect.Descriptor instead.
+func (*ProtoPeerAddrs) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *ProtoPrivateFlipKeysPackage_Data) GetPackage() []byte {
+func (x *ProtoPeerAddrs) GetAddrs() [][]byte {
 	if x != nil {
-		return x.Package
+		return x.Addrs
 	}
 	return nil
 }
 
-func (x *ProtoPrivateFlipKeysPackage_Data) GetEpoch() uint32 {
-	if x != nil {
-		return x.Epoch
-	}
-	return 0
-}
-
-type ProtoAnswersDb_Answer struct {
+type ProtoApiEmpty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Answers []byte `protobuf:"bytes,2,opt,name=answers,proto3" json:"answers,omitempty"`
 }
 
-func (x *ProtoAnswersDb_Answer) Reset() {
-	*x = ProtoAnswersDb_Answer{}
+func (x *ProtoApiEmpty) Reset() {
+	*x = ProtoApiEmpty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[68]
+		mi := &file_protobuf_models_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoAnswersDb_Answer) String() string {
+func (x *ProtoApiEmpty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoAnswersDb_Answer) ProtoMessage() {}
+func (*ProtoApiEmpty) ProtoMessage() {}
 
-func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[68]
+func (x *ProtoApiEmpty) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4393,51 +4740,37 @@ func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoAnswersDb_Answer.ProtoReflect.Descriptor instead.
-func (*ProtoAnswersDb_Answer) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{28, 0}
-}
-
-func (x *ProtoAnswersDb_Answer) GetAddress() []byte {
-	if x != nil {
-		return x.Address
-	}
-	return nil
-}
-
-func (x *ProtoAnswersDb_Answer) GetAnswers() []byte {
-	if x != nil {
-		return x.Answers
-	}
-	return nil
+// Deprecated: Use ProtoApiEmpty.ProtoReflect.Descriptor instead.
+func (*ProtoApiEmpty) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{73}
 }
 
-type ProtoActivityMonitor_Activity struct {
+type ProtoApiBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Hash   []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *ProtoActivityMonitor_Activity) Reset() {
-	*x = ProtoActivityMonitor_Activity{}
+func (x *ProtoApiBlockRequest) Reset() {
+	*x = ProtoApiBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[69]
+		mi := &file_protobuf_models_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoActivityMonitor_Activity) String() string {
+func (x *ProtoApiBlockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
+func (*ProtoApiBlockRequest) ProtoMessage() {}
 
-func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[69]
+func (x *ProtoApiBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4448,51 +4781,62 @@ func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
-func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{31, 0}
+// Deprecated: Use ProtoApiBlockRequest.ProtoReflect.Descriptor instead.
+func (*ProtoApiBlockRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
+func (x *ProtoApiBlockRequest) GetHeight() uint64 {
 	if x != nil {
-		return x.Address
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoActivityMonitor_Activity) GetTimestamp() int64 {
+func (x *ProtoApiBlockRequest) GetHash() []byte {
 	if x != nil {
-		return x.Timestamp
+		return x.Hash
 	}
-	return 0
+	return nil
 }
 
-type ProtoStateAccount_ProtoContractData struct {
+type ProtoApiBlock struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CodeHash []byte `protobuf:"bytes,1,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
-	Stake    []byte `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
-}
-
-func (x *ProtoStateAccount_ProtoContractData) Reset() {
-	*x = ProtoStateAccount_ProtoContractData{}
+	Hash           []byte   `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ParentHash     []byte   `protobuf:"bytes,2,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Height         uint64   `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp      int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Coinbase       []byte   `protobuf:"bytes,5,opt,name=coinbase,proto3" json:"coinbase,omitempty"`
+	Root           []byte   `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty"`
+	IdentityRoot   []byte   `protobuf:"bytes,7,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
+	IpfsCid        string   `protobuf:"bytes,8,opt,name=ipfsCid,proto3" json:"ipfsCid,omitempty"`
+	Transactions   [][]byte `protobuf:"bytes,9,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Flags          []string `protobuf:"bytes,10,rep,name=flags,proto3" json:"flags,omitempty"`
+	IsEmpty        bool     `protobuf:"varint,11,opt,name=isEmpty,proto3" json:"isEmpty,omitempty"`
+	OfflineAddress []byte   `protobuf:"bytes,12,opt,name=offlineAddress,proto3" json:"offlineAddress,omitempty"`
+	GasLimit       uint64   `protobuf:"varint,13,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+}
+
+func (x *ProtoApiBlock) Reset() {
+	*x = ProtoApiBlock{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[70]
+		mi := &file_protobuf_models_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateAccount_ProtoContractData) String() string {
+func (x *ProtoApiBlock) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateAccount_ProtoContractData) ProtoMessage() {}
+func (*ProtoApiBlock) ProtoMessage() {}
 
-func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[70]
+func (x *ProtoApiBlock) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4503,106 +4847,127 @@ func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateAccount_ProtoContractData.ProtoReflect.Descriptor instead.
-func (*ProtoStateAccount_ProtoContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{39, 0}
+// Deprecated: Use ProtoApiBlock.ProtoReflect.Descriptor instead.
+func (*ProtoApiBlock) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ProtoStateAccount_ProtoContractData) GetCodeHash() []byte {
+func (x *ProtoApiBlock) GetHash() []byte {
 	if x != nil {
-		return x.CodeHash
+		return x.Hash
 	}
 	return nil
 }
 
-func (x *ProtoStateAccount_ProtoContractData) GetStake() []byte {
+func (x *ProtoApiBlock) GetParentHash() []byte {
 	if x != nil {
-		return x.Stake
+		return x.ParentHash
 	}
 	return nil
 }
 
-type ProtoStateIdentity_Flip struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProtoApiBlock) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
 
-	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+func (x *ProtoApiBlock) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
 }
 
-func (x *ProtoStateIdentity_Flip) Reset() {
-	*x = ProtoStateIdentity_Flip{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[71]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProtoApiBlock) GetCoinbase() []byte {
+	if x != nil {
+		return x.Coinbase
 	}
+	return nil
 }
 
-func (x *ProtoStateIdentity_Flip) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProtoApiBlock) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
 }
 
-func (*ProtoStateIdentity_Flip) ProtoMessage() {}
+func (x *ProtoApiBlock) GetIdentityRoot() []byte {
+	if x != nil {
+		return x.IdentityRoot
+	}
+	return nil
+}
 
-func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[71]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProtoApiBlock) GetIpfsCid() string {
+	if x != nil {
+		return x.IpfsCid
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
-func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40, 0}
+func (x *ProtoApiBlock) GetTransactions() [][]byte {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
 }
 
-func (x *ProtoStateIdentity_Flip) GetCid() []byte {
+func (x *ProtoApiBlock) GetFlags() []string {
 	if x != nil {
-		return x.Cid
+		return x.Flags
 	}
 	return nil
 }
 
-func (x *ProtoStateIdentity_Flip) GetPair() uint32 {
+func (x *ProtoApiBlock) GetIsEmpty() bool {
 	if x != nil {
-		return x.Pair
+		return x.IsEmpty
+	}
+	return false
+}
+
+func (x *ProtoApiBlock) GetOfflineAddress() []byte {
+	if x != nil {
+		return x.OfflineAddress
+	}
+	return nil
+}
+
+func (x *ProtoApiBlock) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
 	}
 	return 0
 }
 
-type ProtoStateIdentity_TxAddr struct {
+type ProtoApiTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *ProtoStateIdentity_TxAddr) Reset() {
-	*x = ProtoStateIdentity_TxAddr{}
+func (x *ProtoApiTransactionRequest) Reset() {
+	*x = ProtoApiTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[72]
+		mi := &file_protobuf_models_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateIdentity_TxAddr) String() string {
+func (x *ProtoApiTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
+func (*ProtoApiTransactionRequest) ProtoMessage() {}
 
-func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[72]
+func (x *ProtoApiTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4613,52 +4978,55 @@ func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
-func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40, 1}
+// Deprecated: Use ProtoApiTransactionRequest.ProtoReflect.Descriptor instead.
+func (*ProtoApiTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
+func (x *ProtoApiTransactionRequest) GetHash() []byte {
 	if x != nil {
 		return x.Hash
 	}
 	return nil
 }
 
-func (x *ProtoStateIdentity_TxAddr) GetAddress() []byte {
-	if x != nil {
-		return x.Address
-	}
-	return nil
-}
-
-type ProtoStateIdentity_Inviter struct {
+type ProtoApiTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash        []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Address     []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	EpochHeight uint32 `protobuf:"varint,3,opt,name=epochHeight,proto3" json:"epochHeight,omitempty"`
-}
-
-func (x *ProtoStateIdentity_Inviter) Reset() {
-	*x = ProtoStateIdentity_Inviter{}
+	Hash      []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Type      string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	From      []byte `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To        []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount    string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Tips      string `protobuf:"bytes,6,opt,name=tips,proto3" json:"tips,omitempty"`
+	MaxFee    string `protobuf:"bytes,7,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	Nonce     uint32 `protobuf:"varint,8,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Epoch     uint32 `protobuf:"varint,9,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Payload   []byte `protobuf:"bytes,10,opt,name=payload,proto3" json:"payload,omitempty"`
+	BlockHash []byte `protobuf:"bytes,11,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	UsedFee   string `protobuf:"bytes,12,opt,name=usedFee,proto3" json:"usedFee,omitempty"`
+	Timestamp int64  `protobuf:"varint,13,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ProtoApiTransaction) Reset() {
+	*x = ProtoApiTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[73]
+		mi := &file_protobuf_models_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateIdentity_Inviter) String() string {
+func (x *ProtoApiTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateIdentity_Inviter) ProtoMessage() {}
+func (*ProtoApiTransaction) ProtoMessage() {}
 
-func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[73]
+func (x *ProtoApiTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4669,58 +5037,127 @@ func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateIdentity_Inviter.ProtoReflect.Descriptor instead.
-func (*ProtoStateIdentity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{40, 2}
+// Deprecated: Use ProtoApiTransaction.ProtoReflect.Descriptor instead.
+func (*ProtoApiTransaction) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ProtoStateIdentity_Inviter) GetHash() []byte {
+func (x *ProtoApiTransaction) GetHash() []byte {
 	if x != nil {
 		return x.Hash
 	}
 	return nil
 }
 
-func (x *ProtoStateIdentity_Inviter) GetAddress() []byte {
+func (x *ProtoApiTransaction) GetType() string {
 	if x != nil {
-		return x.Address
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ProtoApiTransaction) GetFrom() []byte {
+	if x != nil {
+		return x.From
 	}
 	return nil
 }
 
-func (x *ProtoStateIdentity_Inviter) GetEpochHeight() uint32 {
+func (x *ProtoApiTransaction) GetTo() []byte {
 	if x != nil {
-		return x.EpochHeight
+		return x.To
+	}
+	return nil
+}
+
+func (x *ProtoApiTransaction) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *ProtoApiTransaction) GetTips() string {
+	if x != nil {
+		return x.Tips
+	}
+	return ""
+}
+
+func (x *ProtoApiTransaction) GetMaxFee() string {
+	if x != nil {
+		return x.MaxFee
+	}
+	return ""
+}
+
+func (x *ProtoApiTransaction) GetNonce() uint32 {
+	if x != nil {
+		return x.Nonce
 	}
 	return 0
 }
 
-type ProtoStateDelegationSwitch_Delegation struct {
+func (x *ProtoApiTransaction) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoApiTransaction) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ProtoApiTransaction) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ProtoApiTransaction) GetUsedFee() string {
+	if x != nil {
+		return x.UsedFee
+	}
+	return ""
+}
+
+func (x *ProtoApiTransaction) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ProtoApiRawTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Delegator []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
-	Delegatee []byte `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Tx []byte `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
 }
 
-func (x *ProtoStateDelegationSwitch_Delegation) Reset() {
-	*x = ProtoStateDelegationSwitch_Delegation{}
+func (x *ProtoApiRawTransaction) Reset() {
+	*x = ProtoApiRawTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[74]
+		mi := &file_protobuf_models_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoStateDelegationSwitch_Delegation) String() string {
+func (x *ProtoApiRawTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoStateDelegationSwitch_Delegation) ProtoMessage() {}
+func (*ProtoApiRawTransaction) ProtoMessage() {}
 
-func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[74]
+func (x *ProtoApiRawTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4731,62 +5168,90 @@ func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoStateDelegationSwitch_Delegation.ProtoReflect.Descriptor instead.
-func (*ProtoStateDelegationSwitch_Delegation) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 0}
+// Deprecated: Use ProtoApiRawTransaction.ProtoReflect.Descriptor instead.
+func (*ProtoApiRawTransaction) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *ProtoStateDelegationSwitch_Delegation) GetDelegator() []byte {
+func (x *ProtoApiRawTransaction) GetTx() []byte {
 	if x != nil {
-		return x.Delegator
+		return x.Tx
 	}
 	return nil
 }
 
-func (x *ProtoStateDelegationSwitch_Delegation) GetDelegatee() []byte {
+type ProtoApiTransactionHash struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *ProtoApiTransactionHash) Reset() {
+	*x = ProtoApiTransactionHash{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoApiTransactionHash) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoApiTransactionHash) ProtoMessage() {}
+
+func (x *ProtoApiTransactionHash) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoApiTransactionHash.ProtoReflect.Descriptor instead.
+func (*ProtoApiTransactionHash) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ProtoApiTransactionHash) GetHash() []byte {
 	if x != nil {
-		return x.Delegatee
+		return x.Hash
 	}
 	return nil
 }
 
-type ProtoPredefinedState_Global struct {
+type ProtoApiIdentityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Epoch                         uint32   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	NextValidationTime            int64    `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
-	ValidationPeriod              uint32   `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
-	GodAddress                    []byte   `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
-	WordsSeed                     []byte   `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
-	LastSnapshot                  uint64   `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
-	EpochBlock                    uint64   `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
-	FeePerGas                     []byte   `protobuf:"bytes,8,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
-	VrfProposerThreshold          uint64   `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
-	EmptyBlocksBits               []byte   `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
-	GodAddressInvites             uint32   `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
-	BlocksCntWithoutCeremonialTxs uint32   `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
-	PrevEpochBlocks               []uint64 `protobuf:"varint,13,rep,packed,name=prevEpochBlocks,proto3" json:"prevEpochBlocks,omitempty"`
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Global) Reset() {
-	*x = ProtoPredefinedState_Global{}
+func (x *ProtoApiIdentityRequest) Reset() {
+	*x = ProtoApiIdentityRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[75]
+		mi := &file_protobuf_models_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Global) String() string {
+func (x *ProtoApiIdentityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Global) ProtoMessage() {}
+func (*ProtoApiIdentityRequest) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[75]
+func (x *ProtoApiIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4797,127 +5262,173 @@ func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Global.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Global) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 0}
+// Deprecated: Use ProtoApiIdentityRequest.ProtoReflect.Descriptor instead.
+func (*ProtoApiIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *ProtoPredefinedState_Global) GetEpoch() uint32 {
+func (x *ProtoApiIdentityRequest) GetAddress() []byte {
 	if x != nil {
-		return x.Epoch
+		return x.Address
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoPredefinedState_Global) GetNextValidationTime() int64 {
-	if x != nil {
-		return x.NextValidationTime
+type ProtoApiIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       []byte   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	State         string   `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Stake         string   `protobuf:"bytes,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	Age           uint32   `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`
+	Invites       uint32   `protobuf:"varint,5,opt,name=invites,proto3" json:"invites,omitempty"`
+	RequiredFlips uint32   `protobuf:"varint,6,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
+	MadeFlips     uint32   `protobuf:"varint,7,opt,name=madeFlips,proto3" json:"madeFlips,omitempty"`
+	Online        bool     `protobuf:"varint,8,opt,name=online,proto3" json:"online,omitempty"`
+	Penalty       string   `protobuf:"bytes,9,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Delegatee     []byte   `protobuf:"bytes,10,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	Flips         []string `protobuf:"bytes,11,rep,name=flips,proto3" json:"flips,omitempty"`
+}
+
+func (x *ProtoApiIdentity) Reset() {
+	*x = ProtoApiIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ProtoPredefinedState_Global) GetValidationPeriod() uint32 {
-	if x != nil {
-		return x.ValidationPeriod
+func (x *ProtoApiIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoApiIdentity) ProtoMessage() {}
+
+func (x *ProtoApiIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoPredefinedState_Global) GetGodAddress() []byte {
+// Deprecated: Use ProtoApiIdentity.ProtoReflect.Descriptor instead.
+func (*ProtoApiIdentity) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ProtoApiIdentity) GetAddress() []byte {
 	if x != nil {
-		return x.GodAddress
+		return x.Address
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Global) GetWordsSeed() []byte {
+func (x *ProtoApiIdentity) GetState() string {
 	if x != nil {
-		return x.WordsSeed
+		return x.State
 	}
-	return nil
+	return ""
 }
 
-func (x *ProtoPredefinedState_Global) GetLastSnapshot() uint64 {
+func (x *ProtoApiIdentity) GetStake() string {
 	if x != nil {
-		return x.LastSnapshot
+		return x.Stake
+	}
+	return ""
+}
+
+func (x *ProtoApiIdentity) GetAge() uint32 {
+	if x != nil {
+		return x.Age
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Global) GetEpochBlock() uint64 {
+func (x *ProtoApiIdentity) GetInvites() uint32 {
 	if x != nil {
-		return x.EpochBlock
+		return x.Invites
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Global) GetFeePerGas() []byte {
+func (x *ProtoApiIdentity) GetRequiredFlips() uint32 {
 	if x != nil {
-		return x.FeePerGas
+		return x.RequiredFlips
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoPredefinedState_Global) GetVrfProposerThreshold() uint64 {
+func (x *ProtoApiIdentity) GetMadeFlips() uint32 {
 	if x != nil {
-		return x.VrfProposerThreshold
+		return x.MadeFlips
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Global) GetEmptyBlocksBits() []byte {
+func (x *ProtoApiIdentity) GetOnline() bool {
 	if x != nil {
-		return x.EmptyBlocksBits
+		return x.Online
 	}
-	return nil
+	return false
 }
 
-func (x *ProtoPredefinedState_Global) GetGodAddressInvites() uint32 {
+func (x *ProtoApiIdentity) GetPenalty() string {
 	if x != nil {
-		return x.GodAddressInvites
+		return x.Penalty
 	}
-	return 0
+	return ""
 }
 
-func (x *ProtoPredefinedState_Global) GetBlocksCntWithoutCeremonialTxs() uint32 {
+func (x *ProtoApiIdentity) GetDelegatee() []byte {
 	if x != nil {
-		return x.BlocksCntWithoutCeremonialTxs
+		return x.Delegatee
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoPredefinedState_Global) GetPrevEpochBlocks() []uint64 {
+func (x *ProtoApiIdentity) GetFlips() []string {
 	if x != nil {
-		return x.PrevEpochBlocks
+		return x.Flips
 	}
 	return nil
 }
 
-type ProtoPredefinedState_StatusSwitch struct {
+type ProtoApiEpoch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Epoch          uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	StartBlock     uint64 `protobuf:"varint,2,opt,name=startBlock,proto3" json:"startBlock,omitempty"`
+	NextValidation int64  `protobuf:"varint,3,opt,name=nextValidation,proto3" json:"nextValidation,omitempty"`
+	CurrentPeriod  string `protobuf:"bytes,4,opt,name=currentPeriod,proto3" json:"currentPeriod,omitempty"`
 }
 
-func (x *ProtoPredefinedState_StatusSwitch) Reset() {
-	*x = ProtoPredefinedState_StatusSwitch{}
+func (x *ProtoApiEpoch) Reset() {
+	*x = ProtoApiEpoch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[76]
+		mi := &file_protobuf_models_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_StatusSwitch) String() string {
+func (x *ProtoApiEpoch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_StatusSwitch) ProtoMessage() {}
+func (*ProtoApiEpoch) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[76]
+func (x *ProtoApiEpoch) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4928,47 +5439,67 @@ func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_StatusSwitch.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_StatusSwitch) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 1}
+// Deprecated: Use ProtoApiEpoch.ProtoReflect.Descriptor instead.
+func (*ProtoApiEpoch) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *ProtoPredefinedState_StatusSwitch) GetAddresses() [][]byte {
+func (x *ProtoApiEpoch) GetEpoch() uint32 {
 	if x != nil {
-		return x.Addresses
+		return x.Epoch
 	}
-	return nil
+	return 0
 }
 
-type ProtoPredefinedState_Account struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ProtoApiEpoch) GetStartBlock() uint64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
 
-	Address      []byte                                     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Nonce        uint32                                     `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Epoch        uint32                                     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
-	Balance      []byte                                     `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
-	ContractData *ProtoPredefinedState_Account_ContractData `protobuf:"bytes,5,opt,name=contractData,proto3" json:"contractData,omitempty"`
+func (x *ProtoApiEpoch) GetNextValidation() int64 {
+	if x != nil {
+		return x.NextValidation
+	}
+	return 0
 }
 
-func (x *ProtoPredefinedState_Account) Reset() {
-	*x = ProtoPredefinedState_Account{}
+func (x *ProtoApiEpoch) GetCurrentPeriod() string {
+	if x != nil {
+		return x.CurrentPeriod
+	}
+	return ""
+}
+
+type ProtoApiCeremonyEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch     uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Period    string `protobuf:"bytes,2,opt,name=period,proto3" json:"period,omitempty"`
+	Height    uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ProtoApiCeremonyEvent) Reset() {
+	*x = ProtoApiCeremonyEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[77]
+		mi := &file_protobuf_models_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Account) String() string {
+func (x *ProtoApiCeremonyEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Account) ProtoMessage() {}
+func (*ProtoApiCeremonyEvent) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[77]
+func (x *ProtoApiCeremonyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4979,92 +5510,64 @@ func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Account.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Account) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 2}
-}
-
-func (x *ProtoPredefinedState_Account) GetAddress() []byte {
-	if x != nil {
-		return x.Address
-	}
-	return nil
+// Deprecated: Use ProtoApiCeremonyEvent.ProtoReflect.Descriptor instead.
+func (*ProtoApiCeremonyEvent) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *ProtoPredefinedState_Account) GetNonce() uint32 {
+func (x *ProtoApiCeremonyEvent) GetEpoch() uint32 {
 	if x != nil {
-		return x.Nonce
+		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Account) GetEpoch() uint32 {
+func (x *ProtoApiCeremonyEvent) GetPeriod() string {
 	if x != nil {
-		return x.Epoch
+		return x.Period
 	}
-	return 0
+	return ""
 }
 
-func (x *ProtoPredefinedState_Account) GetBalance() []byte {
+func (x *ProtoApiCeremonyEvent) GetHeight() uint64 {
 	if x != nil {
-		return x.Balance
+		return x.Height
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoPredefinedState_Account) GetContractData() *ProtoPredefinedState_Account_ContractData {
+func (x *ProtoApiCeremonyEvent) GetTimestamp() int64 {
 	if x != nil {
-		return x.ContractData
+		return x.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type ProtoPredefinedState_Identity struct {
+type ProtoMultiSendAttachment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address          []byte                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Stake            []byte                                  `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
-	Invites          uint32                                  `protobuf:"varint,3,opt,name=invites,proto3" json:"invites,omitempty"`
-	Birthday         uint32                                  `protobuf:"varint,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
-	State            uint32                                  `protobuf:"varint,5,opt,name=state,proto3" json:"state,omitempty"`
-	QualifiedFlips   uint32                                  `protobuf:"varint,6,opt,name=qualifiedFlips,proto3" json:"qualifiedFlips,omitempty"`
-	ShortFlipPoints  uint32                                  `protobuf:"varint,7,opt,name=shortFlipPoints,proto3" json:"shortFlipPoints,omitempty"`
-	PubKey           []byte                                  `protobuf:"bytes,8,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
-	RequiredFlips    uint32                                  `protobuf:"varint,9,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
-	Flips            []*ProtoPredefinedState_Identity_Flip   `protobuf:"bytes,10,rep,name=flips,proto3" json:"flips,omitempty"`
-	Generation       uint32                                  `protobuf:"varint,11,opt,name=generation,proto3" json:"generation,omitempty"`
-	Code             []byte                                  `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
-	Invitees         []*ProtoPredefinedState_Identity_TxAddr `protobuf:"bytes,13,rep,name=invitees,proto3" json:"invitees,omitempty"`
-	Inviter          *ProtoPredefinedState_Identity_Inviter  `protobuf:"bytes,14,opt,name=inviter,proto3" json:"inviter,omitempty"`
-	Penalty          []byte                                  `protobuf:"bytes,15,opt,name=penalty,proto3" json:"penalty,omitempty"`
-	ValidationBits   uint32                                  `protobuf:"varint,16,opt,name=validationBits,proto3" json:"validationBits,omitempty"`
-	ValidationStatus uint32                                  `protobuf:"varint,17,opt,name=validationStatus,proto3" json:"validationStatus,omitempty"`
-	ProfileHash      []byte                                  `protobuf:"bytes,18,opt,name=profileHash,proto3" json:"profileHash,omitempty"`
-	Scores           []byte                                  `protobuf:"bytes,19,opt,name=scores,proto3" json:"scores,omitempty"`
-	Delegatee        []byte                                  `protobuf:"bytes,20,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-	DelegationNonce  uint32                                  `protobuf:"varint,21,opt,name=delegationNonce,proto3" json:"delegationNonce,omitempty"`
-	DelegationEpoch  uint32                                  `protobuf:"varint,22,opt,name=delegationEpoch,proto3" json:"delegationEpoch,omitempty"`
+	Payments []*ProtoMultiSendAttachment_Payment `protobuf:"bytes,1,rep,name=payments,proto3" json:"payments,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Identity) Reset() {
-	*x = ProtoPredefinedState_Identity{}
+func (x *ProtoMultiSendAttachment) Reset() {
+	*x = ProtoMultiSendAttachment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[78]
+		mi := &file_protobuf_models_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Identity) String() string {
+func (x *ProtoMultiSendAttachment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Identity) ProtoMessage() {}
+func (*ProtoMultiSendAttachment) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[78]
+func (x *ProtoMultiSendAttachment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5075,262 +5578,335 @@ func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Identity.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Identity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 3}
+// Deprecated: Use ProtoMultiSendAttachment.ProtoReflect.Descriptor instead.
+func (*ProtoMultiSendAttachment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *ProtoPredefinedState_Identity) GetAddress() []byte {
+func (x *ProtoMultiSendAttachment) GetPayments() []*ProtoMultiSendAttachment_Payment {
 	if x != nil {
-		return x.Address
+		return x.Payments
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetStake() []byte {
-	if x != nil {
-		return x.Stake
-	}
-	return nil
+type ProtoTransaction_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Nonce        uint32 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Epoch        uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Type         uint32 `protobuf:"varint,3,opt,name=type,proto3" json:"type,omitempty"`
+	To           []byte `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount       []byte `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxFee       []byte `protobuf:"bytes,6,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+	Tips         []byte `protobuf:"bytes,7,opt,name=tips,proto3" json:"tips,omitempty"`
+	Payload      []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+	ExpiryHeight uint64 `protobuf:"varint,9,opt,name=expiryHeight,proto3" json:"expiryHeight,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Identity) GetInvites() uint32 {
-	if x != nil {
-		return x.Invites
+func (x *ProtoTransaction_Data) Reset() {
+	*x = ProtoTransaction_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetBirthday() uint32 {
-	if x != nil {
-		return x.Birthday
+func (x *ProtoTransaction_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoTransaction_Data) ProtoMessage() {}
+
+func (x *ProtoTransaction_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoPredefinedState_Identity) GetState() uint32 {
+// Deprecated: Use ProtoTransaction_Data.ProtoReflect.Descriptor instead.
+func (*ProtoTransaction_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ProtoTransaction_Data) GetNonce() uint32 {
 	if x != nil {
-		return x.State
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetQualifiedFlips() uint32 {
+func (x *ProtoTransaction_Data) GetEpoch() uint32 {
 	if x != nil {
-		return x.QualifiedFlips
+		return x.Epoch
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetShortFlipPoints() uint32 {
+func (x *ProtoTransaction_Data) GetType() uint32 {
 	if x != nil {
-		return x.ShortFlipPoints
+		return x.Type
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetPubKey() []byte {
+func (x *ProtoTransaction_Data) GetTo() []byte {
 	if x != nil {
-		return x.PubKey
+		return x.To
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetRequiredFlips() uint32 {
+func (x *ProtoTransaction_Data) GetAmount() []byte {
 	if x != nil {
-		return x.RequiredFlips
+		return x.Amount
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetFlips() []*ProtoPredefinedState_Identity_Flip {
+func (x *ProtoTransaction_Data) GetMaxFee() []byte {
 	if x != nil {
-		return x.Flips
+		return x.MaxFee
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetGeneration() uint32 {
+func (x *ProtoTransaction_Data) GetTips() []byte {
 	if x != nil {
-		return x.Generation
+		return x.Tips
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetCode() []byte {
+func (x *ProtoTransaction_Data) GetPayload() []byte {
 	if x != nil {
-		return x.Code
+		return x.Payload
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetInvitees() []*ProtoPredefinedState_Identity_TxAddr {
+func (x *ProtoTransaction_Data) GetExpiryHeight() uint64 {
 	if x != nil {
-		return x.Invitees
+		return x.ExpiryHeight
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetInviter() *ProtoPredefinedState_Identity_Inviter {
-	if x != nil {
-		return x.Inviter
+type ProtoBlockHeader_Proposed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentHash     []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Height         uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp      int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	TxHash         []byte `protobuf:"bytes,4,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	ProposerPubKey []byte `protobuf:"bytes,5,opt,name=proposerPubKey,proto3" json:"proposerPubKey,omitempty"`
+	Root           []byte `protobuf:"bytes,6,opt,name=root,proto3" json:"root,omitempty"`
+	IdentityRoot   []byte `protobuf:"bytes,7,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
+	Flags          uint32 `protobuf:"varint,8,opt,name=flags,proto3" json:"flags,omitempty"`
+	IpfsHash       []byte `protobuf:"bytes,9,opt,name=ipfsHash,proto3" json:"ipfsHash,omitempty"`
+	OfflineAddr    []byte `protobuf:"bytes,10,opt,name=offlineAddr,proto3" json:"offlineAddr,omitempty"`
+	TxBloom        []byte `protobuf:"bytes,11,opt,name=txBloom,proto3" json:"txBloom,omitempty"`
+	BlockSeed      []byte `protobuf:"bytes,12,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
+	FeePerGas      []byte `protobuf:"bytes,13,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
+	Upgrade        uint32 `protobuf:"varint,14,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	SeedProof      []byte `protobuf:"bytes,15,opt,name=seedProof,proto3" json:"seedProof,omitempty"`
+	ReceiptsCid    []byte `protobuf:"bytes,16,opt,name=receiptsCid,proto3" json:"receiptsCid,omitempty"`
+	GasLimit       uint64 `protobuf:"varint,17,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+}
+
+func (x *ProtoBlockHeader_Proposed) Reset() {
+	*x = ProtoBlockHeader_Proposed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetPenalty() []byte {
+func (x *ProtoBlockHeader_Proposed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoBlockHeader_Proposed) ProtoMessage() {}
+
+func (x *ProtoBlockHeader_Proposed) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoBlockHeader_Proposed.ProtoReflect.Descriptor instead.
+func (*ProtoBlockHeader_Proposed) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ProtoBlockHeader_Proposed) GetParentHash() []byte {
 	if x != nil {
-		return x.Penalty
+		return x.ParentHash
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetValidationBits() uint32 {
+func (x *ProtoBlockHeader_Proposed) GetHeight() uint64 {
 	if x != nil {
-		return x.ValidationBits
+		return x.Height
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetValidationStatus() uint32 {
+func (x *ProtoBlockHeader_Proposed) GetTimestamp() int64 {
 	if x != nil {
-		return x.ValidationStatus
+		return x.Timestamp
 	}
 	return 0
 }
 
-func (x *ProtoPredefinedState_Identity) GetProfileHash() []byte {
+func (x *ProtoBlockHeader_Proposed) GetTxHash() []byte {
 	if x != nil {
-		return x.ProfileHash
+		return x.TxHash
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetScores() []byte {
+func (x *ProtoBlockHeader_Proposed) GetProposerPubKey() []byte {
 	if x != nil {
-		return x.Scores
+		return x.ProposerPubKey
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetDelegatee() []byte {
+func (x *ProtoBlockHeader_Proposed) GetRoot() []byte {
 	if x != nil {
-		return x.Delegatee
+		return x.Root
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetDelegationNonce() uint32 {
+func (x *ProtoBlockHeader_Proposed) GetIdentityRoot() []byte {
 	if x != nil {
-		return x.DelegationNonce
+		return x.IdentityRoot
 	}
-	return 0
+	return nil
 }
 
-func (x *ProtoPredefinedState_Identity) GetDelegationEpoch() uint32 {
+func (x *ProtoBlockHeader_Proposed) GetFlags() uint32 {
 	if x != nil {
-		return x.DelegationEpoch
+		return x.Flags
 	}
 	return 0
 }
 
-type ProtoPredefinedState_ApprovedIdentity struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Address   []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Approved  bool   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
-	Online    bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
-	Delegatee []byte `protobuf:"bytes,4,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
-}
-
-func (x *ProtoPredefinedState_ApprovedIdentity) Reset() {
-	*x = ProtoPredefinedState_ApprovedIdentity{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[79]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ProtoBlockHeader_Proposed) GetIpfsHash() []byte {
+	if x != nil {
+		return x.IpfsHash
 	}
+	return nil
 }
 
-func (x *ProtoPredefinedState_ApprovedIdentity) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ProtoBlockHeader_Proposed) GetOfflineAddr() []byte {
+	if x != nil {
+		return x.OfflineAddr
+	}
+	return nil
 }
 
-func (*ProtoPredefinedState_ApprovedIdentity) ProtoMessage() {}
-
-func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[79]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ProtoBlockHeader_Proposed) GetTxBloom() []byte {
+	if x != nil {
+		return x.TxBloom
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ProtoPredefinedState_ApprovedIdentity.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_ApprovedIdentity) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 4}
+func (x *ProtoBlockHeader_Proposed) GetBlockSeed() []byte {
+	if x != nil {
+		return x.BlockSeed
+	}
+	return nil
 }
 
-func (x *ProtoPredefinedState_ApprovedIdentity) GetAddress() []byte {
+func (x *ProtoBlockHeader_Proposed) GetFeePerGas() []byte {
 	if x != nil {
-		return x.Address
+		return x.FeePerGas
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_ApprovedIdentity) GetApproved() bool {
+func (x *ProtoBlockHeader_Proposed) GetUpgrade() uint32 {
 	if x != nil {
-		return x.Approved
+		return x.Upgrade
 	}
-	return false
+	return 0
 }
 
-func (x *ProtoPredefinedState_ApprovedIdentity) GetOnline() bool {
+func (x *ProtoBlockHeader_Proposed) GetSeedProof() []byte {
 	if x != nil {
-		return x.Online
+		return x.SeedProof
 	}
-	return false
+	return nil
 }
 
-func (x *ProtoPredefinedState_ApprovedIdentity) GetDelegatee() []byte {
+func (x *ProtoBlockHeader_Proposed) GetReceiptsCid() []byte {
 	if x != nil {
-		return x.Delegatee
+		return x.ReceiptsCid
 	}
 	return nil
 }
 
-type ProtoPredefinedState_ContractKeyValue struct {
+func (x *ProtoBlockHeader_Proposed) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+type ProtoBlockHeader_Empty struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	ParentHash   []byte `protobuf:"bytes,1,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	Height       uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Root         []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	IdentityRoot []byte `protobuf:"bytes,4,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
+	Timestamp    int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	BlockSeed    []byte `protobuf:"bytes,6,opt,name=blockSeed,proto3" json:"blockSeed,omitempty"`
+	Flags        uint32 `protobuf:"varint,7,opt,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (x *ProtoPredefinedState_ContractKeyValue) Reset() {
-	*x = ProtoPredefinedState_ContractKeyValue{}
+func (x *ProtoBlockHeader_Empty) Reset() {
+	*x = ProtoBlockHeader_Empty{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[80]
+		mi := &file_protobuf_models_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_ContractKeyValue) String() string {
+func (x *ProtoBlockHeader_Empty) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_ContractKeyValue) ProtoMessage() {}
+func (*ProtoBlockHeader_Empty) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[80]
+func (x *ProtoBlockHeader_Empty) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5341,51 +5917,87 @@ func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_ContractKeyValue.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_ContractKeyValue) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 5}
+// Deprecated: Use ProtoBlockHeader_Empty.ProtoReflect.Descriptor instead.
+func (*ProtoBlockHeader_Empty) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *ProtoPredefinedState_ContractKeyValue) GetKey() []byte {
+func (x *ProtoBlockHeader_Empty) GetParentHash() []byte {
 	if x != nil {
-		return x.Key
+		return x.ParentHash
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_ContractKeyValue) GetValue() []byte {
+func (x *ProtoBlockHeader_Empty) GetHeight() uint64 {
 	if x != nil {
-		return x.Value
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoBlockHeader_Empty) GetRoot() []byte {
+	if x != nil {
+		return x.Root
 	}
 	return nil
 }
 
-type ProtoPredefinedState_Account_ContractData struct {
+func (x *ProtoBlockHeader_Empty) GetIdentityRoot() []byte {
+	if x != nil {
+		return x.IdentityRoot
+	}
+	return nil
+}
+
+func (x *ProtoBlockHeader_Empty) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProtoBlockHeader_Empty) GetBlockSeed() []byte {
+	if x != nil {
+		return x.BlockSeed
+	}
+	return nil
+}
+
+func (x *ProtoBlockHeader_Empty) GetFlags() uint32 {
+	if x != nil {
+		return x.Flags
+	}
+	return 0
+}
+
+type ProtoBlockProposal_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CodeHash []byte `protobuf:"bytes,1,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
-	Stake    []byte `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	Header *ProtoBlockHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Body   *ProtoBlockBody   `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	Proof  []byte            `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Account_ContractData) Reset() {
-	*x = ProtoPredefinedState_Account_ContractData{}
+func (x *ProtoBlockProposal_Data) Reset() {
+	*x = ProtoBlockProposal_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[81]
+		mi := &file_protobuf_models_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Account_ContractData) String() string {
+func (x *ProtoBlockProposal_Data) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Account_ContractData) ProtoMessage() {}
+func (*ProtoBlockProposal_Data) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[81]
+func (x *ProtoBlockProposal_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5396,51 +6008,59 @@ func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Account_ContractData.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Account_ContractData) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 2, 0}
+// Deprecated: Use ProtoBlockProposal_Data.ProtoReflect.Descriptor instead.
+func (*ProtoBlockProposal_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{4, 0}
 }
 
-func (x *ProtoPredefinedState_Account_ContractData) GetCodeHash() []byte {
+func (x *ProtoBlockProposal_Data) GetHeader() *ProtoBlockHeader {
 	if x != nil {
-		return x.CodeHash
+		return x.Header
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Account_ContractData) GetStake() []byte {
+func (x *ProtoBlockProposal_Data) GetBody() *ProtoBlockBody {
 	if x != nil {
-		return x.Stake
+		return x.Body
 	}
 	return nil
 }
 
-type ProtoPredefinedState_Identity_Flip struct {
+func (x *ProtoBlockProposal_Data) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type ProtoBlockCert_Signature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
-	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	TurnOffline bool   `protobuf:"varint,1,opt,name=turnOffline,proto3" json:"turnOffline,omitempty"`
+	Upgrade     uint32 `protobuf:"varint,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	Signature   []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Identity_Flip) Reset() {
-	*x = ProtoPredefinedState_Identity_Flip{}
+func (x *ProtoBlockCert_Signature) Reset() {
+	*x = ProtoBlockCert_Signature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[82]
+		mi := &file_protobuf_models_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Identity_Flip) String() string {
+func (x *ProtoBlockCert_Signature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Identity_Flip) ProtoMessage() {}
+func (*ProtoBlockCert_Signature) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[82]
+func (x *ProtoBlockCert_Signature) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5451,51 +6071,59 @@ func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Identity_Flip.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Identity_Flip) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 3, 0}
+// Deprecated: Use ProtoBlockCert_Signature.ProtoReflect.Descriptor instead.
+func (*ProtoBlockCert_Signature) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{6, 0}
 }
 
-func (x *ProtoPredefinedState_Identity_Flip) GetCid() []byte {
+func (x *ProtoBlockCert_Signature) GetTurnOffline() bool {
 	if x != nil {
-		return x.Cid
+		return x.TurnOffline
 	}
-	return nil
+	return false
 }
 
-func (x *ProtoPredefinedState_Identity_Flip) GetPair() uint32 {
+func (x *ProtoBlockCert_Signature) GetUpgrade() uint32 {
 	if x != nil {
-		return x.Pair
+		return x.Upgrade
 	}
 	return 0
 }
 
-type ProtoPredefinedState_Identity_TxAddr struct {
+func (x *ProtoBlockCert_Signature) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ProtoBlockCert_VoterGroup struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	TurnOffline bool   `protobuf:"varint,1,opt,name=turnOffline,proto3" json:"turnOffline,omitempty"`
+	Upgrade     uint32 `protobuf:"varint,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	Voters      []byte `protobuf:"bytes,3,opt,name=voters,proto3" json:"voters,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Identity_TxAddr) Reset() {
-	*x = ProtoPredefinedState_Identity_TxAddr{}
+func (x *ProtoBlockCert_VoterGroup) Reset() {
+	*x = ProtoBlockCert_VoterGroup{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[83]
+		mi := &file_protobuf_models_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Identity_TxAddr) String() string {
+func (x *ProtoBlockCert_VoterGroup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Identity_TxAddr) ProtoMessage() {}
+func (*ProtoBlockCert_VoterGroup) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[83]
+func (x *ProtoBlockCert_VoterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5506,52 +6134,59 @@ func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Identity_TxAddr.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Identity_TxAddr) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 3, 1}
+// Deprecated: Use ProtoBlockCert_VoterGroup.ProtoReflect.Descriptor instead.
+func (*ProtoBlockCert_VoterGroup) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{6, 1}
 }
 
-func (x *ProtoPredefinedState_Identity_TxAddr) GetHash() []byte {
+func (x *ProtoBlockCert_VoterGroup) GetTurnOffline() bool {
 	if x != nil {
-		return x.Hash
+		return x.TurnOffline
 	}
-	return nil
+	return false
 }
 
-func (x *ProtoPredefinedState_Identity_TxAddr) GetAddress() []byte {
+func (x *ProtoBlockCert_VoterGroup) GetUpgrade() uint32 {
 	if x != nil {
-		return x.Address
+		return x.Upgrade
+	}
+	return 0
+}
+
+func (x *ProtoBlockCert_VoterGroup) GetVoters() []byte {
+	if x != nil {
+		return x.Voters
 	}
 	return nil
 }
 
-type ProtoPredefinedState_Identity_Inviter struct {
+type ProtoIdentityStateDiff_IdentityStateDiffValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash        []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	Address     []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	EpochHeight uint32 `protobuf:"varint,3,opt,name=epochHeight,proto3" json:"epochHeight,omitempty"`
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Deleted bool   `protobuf:"varint,2,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ProtoPredefinedState_Identity_Inviter) Reset() {
-	*x = ProtoPredefinedState_Identity_Inviter{}
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) Reset() {
+	*x = ProtoIdentityStateDiff_IdentityStateDiffValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[84]
+		mi := &file_protobuf_models_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoPredefinedState_Identity_Inviter) String() string {
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoPredefinedState_Identity_Inviter) ProtoMessage() {}
+func (*ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoMessage() {}
 
-func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[84]
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5562,65 +6197,58 @@ func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoPredefinedState_Identity_Inviter.ProtoReflect.Descriptor instead.
-func (*ProtoPredefinedState_Identity_Inviter) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{46, 3, 2}
+// Deprecated: Use ProtoIdentityStateDiff_IdentityStateDiffValue.ProtoReflect.Descriptor instead.
+func (*ProtoIdentityStateDiff_IdentityStateDiffValue) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{13, 0}
 }
 
-func (x *ProtoPredefinedState_Identity_Inviter) GetHash() []byte {
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetAddress() []byte {
 	if x != nil {
-		return x.Hash
+		return x.Address
 	}
 	return nil
 }
 
-func (x *ProtoPredefinedState_Identity_Inviter) GetAddress() []byte {
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetDeleted() bool {
 	if x != nil {
-		return x.Address
+		return x.Deleted
 	}
-	return nil
+	return false
 }
 
-func (x *ProtoPredefinedState_Identity_Inviter) GetEpochHeight() uint32 {
+func (x *ProtoIdentityStateDiff_IdentityStateDiffValue) GetValue() []byte {
 	if x != nil {
-		return x.EpochHeight
+		return x.Value
 	}
-	return 0
+	return nil
 }
 
-type ProtoTxReceipts_ProtoTxReceipt struct {
+type ProtoSnapshotBlock_KeyValue struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract []byte                        `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Success  bool                          `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	GasUsed  uint64                        `protobuf:"varint,3,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
-	From     []byte                        `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
-	Error    string                        `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	GasCost  []byte                        `protobuf:"bytes,6,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
-	TxHash   []byte                        `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
-	Events   []*ProtoTxReceipts_ProtoEvent `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`
-	Method   string                        `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) Reset() {
-	*x = ProtoTxReceipts_ProtoTxReceipt{}
+func (x *ProtoSnapshotBlock_KeyValue) Reset() {
+	*x = ProtoSnapshotBlock_KeyValue{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[85]
+		mi := &file_protobuf_models_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) String() string {
+func (x *ProtoSnapshotBlock_KeyValue) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoTxReceipts_ProtoTxReceipt) ProtoMessage() {}
+func (*ProtoSnapshotBlock_KeyValue) ProtoMessage() {}
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[85]
+func (x *ProtoSnapshotBlock_KeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5631,100 +6259,114 @@ func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoTxReceipts_ProtoTxReceipt.ProtoReflect.Descriptor instead.
-func (*ProtoTxReceipts_ProtoTxReceipt) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 0}
+// Deprecated: Use ProtoSnapshotBlock_KeyValue.ProtoReflect.Descriptor instead.
+func (*ProtoSnapshotBlock_KeyValue) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{14, 0}
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetContract() []byte {
+func (x *ProtoSnapshotBlock_KeyValue) GetKey() []byte {
 	if x != nil {
-		return x.Contract
+		return x.Key
 	}
 	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetSuccess() bool {
+func (x *ProtoSnapshotBlock_KeyValue) GetValue() []byte {
 	if x != nil {
-		return x.Success
+		return x.Value
 	}
-	return false
+	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetGasUsed() uint64 {
-	if x != nil {
-		return x.GasUsed
-	}
-	return 0
+type ProtoGossipBlockRange_Block struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *ProtoBlockHeader       `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+	Cert   *ProtoBlockCert         `protobuf:"bytes,2,opt,name=cert,proto3" json:"cert,omitempty"`
+	Diff   *ProtoIdentityStateDiff `protobuf:"bytes,3,opt,name=diff,proto3" json:"diff,omitempty"`
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetFrom() []byte {
-	if x != nil {
-		return x.From
+func (x *ProtoGossipBlockRange_Block) Reset() {
+	*x = ProtoGossipBlockRange_Block{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetError() string {
-	if x != nil {
-		return x.Error
-	}
-	return ""
+func (x *ProtoGossipBlockRange_Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetGasCost() []byte {
-	if x != nil {
-		return x.GasCost
+func (*ProtoGossipBlockRange_Block) ProtoMessage() {}
+
+func (x *ProtoGossipBlockRange_Block) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetTxHash() []byte {
+// Deprecated: Use ProtoGossipBlockRange_Block.ProtoReflect.Descriptor instead.
+func (*ProtoGossipBlockRange_Block) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{15, 0}
+}
+
+func (x *ProtoGossipBlockRange_Block) GetHeader() *ProtoBlockHeader {
 	if x != nil {
-		return x.TxHash
+		return x.Header
 	}
 	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetEvents() []*ProtoTxReceipts_ProtoEvent {
+func (x *ProtoGossipBlockRange_Block) GetCert() *ProtoBlockCert {
 	if x != nil {
-		return x.Events
+		return x.Cert
 	}
 	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoTxReceipt) GetMethod() string {
+func (x *ProtoGossipBlockRange_Block) GetDiff() *ProtoIdentityStateDiff {
 	if x != nil {
-		return x.Method
+		return x.Diff
 	}
-	return ""
+	return nil
 }
 
-type ProtoTxReceipts_ProtoEvent struct {
+type ProtoProposeProof_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Event string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	Data  [][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 }
 
-func (x *ProtoTxReceipts_ProtoEvent) Reset() {
-	*x = ProtoTxReceipts_ProtoEvent{}
+func (x *ProtoProposeProof_Data) Reset() {
+	*x = ProtoProposeProof_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[86]
+		mi := &file_protobuf_models_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoTxReceipts_ProtoEvent) String() string {
+func (x *ProtoProposeProof_Data) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoTxReceipts_ProtoEvent) ProtoMessage() {}
+func (*ProtoProposeProof_Data) ProtoMessage() {}
 
-func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[86]
+func (x *ProtoProposeProof_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5735,55 +6377,55 @@ func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoTxReceipts_ProtoEvent.ProtoReflect.Descriptor instead.
-func (*ProtoTxReceipts_ProtoEvent) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{51, 1}
+// Deprecated: Use ProtoProposeProof_Data.ProtoReflect.Descriptor instead.
+func (*ProtoProposeProof_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{16, 0}
 }
 
-func (x *ProtoTxReceipts_ProtoEvent) GetEvent() string {
+func (x *ProtoProposeProof_Data) GetProof() []byte {
 	if x != nil {
-		return x.Event
+		return x.Proof
 	}
-	return ""
+	return nil
 }
 
-func (x *ProtoTxReceipts_ProtoEvent) GetData() [][]byte {
+func (x *ProtoProposeProof_Data) GetRound() uint64 {
 	if x != nil {
-		return x.Data
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-type ProtoDeferredTxs_ProtoDeferredTx struct {
+type ProtoVote_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From    []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	To      []byte `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	Amount  []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
-	Tips    []byte `protobuf:"bytes,5,opt,name=tips,proto3" json:"tips,omitempty"`
-	Block   uint64 `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	Round       uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Step        uint32 `protobuf:"varint,2,opt,name=step,proto3" json:"step,omitempty"`
+	ParentHash  []byte `protobuf:"bytes,3,opt,name=parentHash,proto3" json:"parentHash,omitempty"`
+	VotedHash   []byte `protobuf:"bytes,4,opt,name=votedHash,proto3" json:"votedHash,omitempty"`
+	TurnOffline bool   `protobuf:"varint,5,opt,name=turnOffline,proto3" json:"turnOffline,omitempty"`
+	Upgrade     uint32 `protobuf:"varint,6,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) Reset() {
-	*x = ProtoDeferredTxs_ProtoDeferredTx{}
+func (x *ProtoVote_Data) Reset() {
+	*x = ProtoVote_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[87]
+		mi := &file_protobuf_models_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) String() string {
+func (x *ProtoVote_Data) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoDeferredTxs_ProtoDeferredTx) ProtoMessage() {}
+func (*ProtoVote_Data) ProtoMessage() {}
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[87]
+func (x *ProtoVote_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5794,998 +6436,4132 @@ func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProtoDeferredTxs_ProtoDeferredTx.ProtoReflect.Descriptor instead.
-func (*ProtoDeferredTxs_ProtoDeferredTx) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{53, 0}
+// Deprecated: Use ProtoVote_Data.ProtoReflect.Descriptor instead.
+func (*ProtoVote_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{17, 0}
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetFrom() []byte {
+func (x *ProtoVote_Data) GetRound() uint64 {
 	if x != nil {
-		return x.From
+		return x.Round
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetTo() []byte {
+func (x *ProtoVote_Data) GetStep() uint32 {
 	if x != nil {
-		return x.To
+		return x.Step
 	}
-	return nil
+	return 0
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetAmount() []byte {
+func (x *ProtoVote_Data) GetParentHash() []byte {
 	if x != nil {
-		return x.Amount
+		return x.ParentHash
 	}
 	return nil
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetPayload() []byte {
+func (x *ProtoVote_Data) GetVotedHash() []byte {
 	if x != nil {
-		return x.Payload
+		return x.VotedHash
 	}
 	return nil
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetTips() []byte {
+func (x *ProtoVote_Data) GetTurnOffline() bool {
 	if x != nil {
-		return x.Tips
+		return x.TurnOffline
 	}
-	return nil
+	return false
 }
 
-func (x *ProtoDeferredTxs_ProtoDeferredTx) GetBlock() uint64 {
+func (x *ProtoVote_Data) GetUpgrade() uint32 {
 	if x != nil {
-		return x.Block
+		return x.Upgrade
 	}
 	return 0
 }
 
-type ProtoUpgradeVotes_ProtoUpgradeVote struct {
+type ProtoFlipKey_Data struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Voter   []byte `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
-	Upgrade uint32 `protobuf:"varint,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Epoch uint32 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (x *ProtoUpgradeVotes_ProtoUpgradeVote) Reset() {
-	*x = ProtoUpgradeVotes_ProtoUpgradeVote{}
+func (x *ProtoFlipKey_Data) Reset() {
+	*x = ProtoFlipKey_Data{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_models_proto_msgTypes[88]
+		mi := &file_protobuf_models_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ProtoUpgradeVotes_ProtoUpgradeVote) String() string {
+func (x *ProtoFlipKey_Data) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProtoUpgradeVotes_ProtoUpgradeVote) ProtoMessage() {}
+func (*ProtoFlipKey_Data) ProtoMessage() {}
 
-func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_models_proto_msgTypes[88]
+func (x *ProtoFlipKey_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoFlipKey_Data.ProtoReflect.Descriptor instead.
+func (*ProtoFlipKey_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{22, 0}
+}
+
+func (x *ProtoFlipKey_Data) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ProtoFlipKey_Data) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+type ProtoPrivateFlipKeysPackage_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Package    []byte   `protobuf:"bytes,1,opt,name=package,proto3" json:"package,omitempty"`
+	Epoch      uint32   `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Shards     [][]byte `protobuf:"bytes,3,rep,name=shards,proto3" json:"shards,omitempty"`
+	DataShards uint32   `protobuf:"varint,4,opt,name=dataShards,proto3" json:"dataShards,omitempty"`
+	Size       uint32   `protobuf:"varint,5,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) Reset() {
+	*x = ProtoPrivateFlipKeysPackage_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPrivateFlipKeysPackage_Data) ProtoMessage() {}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPrivateFlipKeysPackage_Data.ProtoReflect.Descriptor instead.
+func (*ProtoPrivateFlipKeysPackage_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) GetPackage() []byte {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) GetShards() [][]byte {
+	if x != nil {
+		return x.Shards
+	}
+	return nil
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) GetDataShards() uint32 {
+	if x != nil {
+		return x.DataShards
+	}
+	return 0
+}
+
+func (x *ProtoPrivateFlipKeysPackage_Data) GetSize() uint32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+type ProtoAnswersDb_Answer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Answers []byte `protobuf:"bytes,2,opt,name=answers,proto3" json:"answers,omitempty"`
+}
+
+func (x *ProtoAnswersDb_Answer) Reset() {
+	*x = ProtoAnswersDb_Answer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoAnswersDb_Answer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoAnswersDb_Answer) ProtoMessage() {}
+
+func (x *ProtoAnswersDb_Answer) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoAnswersDb_Answer.ProtoReflect.Descriptor instead.
+func (*ProtoAnswersDb_Answer) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *ProtoAnswersDb_Answer) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoAnswersDb_Answer) GetAnswers() []byte {
+	if x != nil {
+		return x.Answers
+	}
+	return nil
+}
+
+type ProtoActivityMonitor_Activity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *ProtoActivityMonitor_Activity) Reset() {
+	*x = ProtoActivityMonitor_Activity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoActivityMonitor_Activity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoActivityMonitor_Activity) ProtoMessage() {}
+
+func (x *ProtoActivityMonitor_Activity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoActivityMonitor_Activity.ProtoReflect.Descriptor instead.
+func (*ProtoActivityMonitor_Activity) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *ProtoActivityMonitor_Activity) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoActivityMonitor_Activity) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ProtoEpochCheckpoint_Data struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch        uint32 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height       uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	BlockHash    []byte `protobuf:"bytes,3,opt,name=blockHash,proto3" json:"blockHash,omitempty"`
+	Root         []byte `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	IdentityRoot []byte `protobuf:"bytes,5,opt,name=identityRoot,proto3" json:"identityRoot,omitempty"`
+	Supply       []byte `protobuf:"bytes,6,opt,name=supply,proto3" json:"supply,omitempty"`
+	Stake        []byte `protobuf:"bytes,7,opt,name=stake,proto3" json:"stake,omitempty"`
+}
+
+func (x *ProtoEpochCheckpoint_Data) Reset() {
+	*x = ProtoEpochCheckpoint_Data{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoEpochCheckpoint_Data) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoEpochCheckpoint_Data) ProtoMessage() {}
+
+func (x *ProtoEpochCheckpoint_Data) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[100]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ProtoUpgradeVotes_ProtoUpgradeVote.ProtoReflect.Descriptor instead.
-func (*ProtoUpgradeVotes_ProtoUpgradeVote) Descriptor() ([]byte, []int) {
-	return file_protobuf_models_proto_rawDescGZIP(), []int{55, 0}
-}
-
-func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetVoter() []byte {
-	if x != nil {
-		return x.Voter
-	}
-	return nil
-}
-
-func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetUpgrade() uint32 {
-	if x != nil {
-		return x.Upgrade
-	}
-	return 0
-}
-
-var File_protobuf_models_proto protoreflect.FileDescriptor
-
-var file_protobuf_models_proto_rawDesc = []byte{
-	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22,
-	0xb2, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xb4, 0x01,
-	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
-	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
-	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x22, 0xca, 0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a, 0x0e, 0x70, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xdc, 0x03, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f,
-	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64,
-	0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
-	0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d,
-	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12,
-	0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x75,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65, 0x65, 0x64, 0x50, 0x72,
-	0x6f, 0x6f, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x43,
-	0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70,
-	0x74, 0x73, 0x43, 0x69, 0x64, 0x1a, 0xc9, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
-	0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
-	0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01,
-	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70,
-	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44,
-	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
-	0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x70, 0x66, 0x73,
-	0x46, 0x6c, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x81,
-	0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76,
-	0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
-	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53,
-	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e,
-	0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74,
-	0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x22, 0x2f, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43,
-	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73,
-	0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64,
-	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x69, 0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x73, 0x68, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65,
-	0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12,
-	0x1e, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x6c, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x22,
-	0x38, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x66, 0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x69, 0x66, 0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73,
-	0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75,
-	0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61,
-	0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12,
-	0x3b, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f,
-	0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x99, 0x01, 0x0a,
-	0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04,
-	0x63, 0x65, 0x72, 0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69,
-	0x66, 0x66, 0x52, 0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x1a, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f,
-	0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14,
-	0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x22, 0x82, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f,
-	0x74, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56,
-	0x6f, 0x74, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c,
-	0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xaa, 0x01, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12,
-	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
-	0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a,
-	0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x5a, 0x0a, 0x1a, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68,
-	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61,
-	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50,
-	0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
-	0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61, 0x6e,
-	0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72,
-	0x6f, 0x6f, 0x74, 0x22, 0xb1, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50,
-	0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a,
-	0x36, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x75, 0x6c, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x22, 0x73, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61,
-	0x70, 0x73, 0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12,
-	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c,
-	0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x62, 0x12,
-	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
-	0x61, 0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65,
-	0x72, 0x73, 0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x2e, 0x41, 0x6e,
-	0x73, 0x77, 0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a,
-	0x06, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02,
-	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
-	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72,
-	0x47, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65,
-	0x72, 0x47, 0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0xbf, 0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76,
-	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69,
-	0x74, 0x79, 0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x42,
-	0x0a, 0x08, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x22, 0x48, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74,
-	0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6e,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64, 0x22, 0x71, 0x0a, 0x19,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41,
-	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73,
-	0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77,
-	0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x61, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22,
-	0x41, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x53, 0x75, 0x62, 0x6d,
-	0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x22, 0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f, 0x6e, 0x6c, 0x69, 0x6e,
-	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x22, 0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x03, 0x63, 0x69, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x1a, 0x45, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x22, 0xc9, 0x07, 0x0a, 0x12, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
-	0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46,
-	0x6c, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c,
-	0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68,
-	0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f,
-	0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d,
-	0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69,
-	0x70, 0x73, 0x12, 0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c,
-	0x69, 0x70, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a,
-	0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64,
-	0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
-	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e,
-	0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
-	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18,
-	0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
-	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x70, 0x6f, 0x63, 0x68, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03,
-	0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61,
-	0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa0, 0x04, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70,
-	0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68,
-	0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65,
-	0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65,
-	0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
-	0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61,
-	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e,
-	0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14,
-	0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73,
-	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50,
-	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42,
-	0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f,
-	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65,
-	0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75,
-	0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28,
-	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f,
-	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x6e, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
-	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64,
-	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65,
-	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x65, 0x22, 0x3c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73,
-	0x22, 0x90, 0x14, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73,
-	0x65, 0x65, 0x64, 0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x2e, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c,
-	0x12, 0x4d, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63,
-	0x68, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12,
-	0x40, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x73, 0x12, 0x45, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72,
-	0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65,
-	0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72,
-	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0x96,
-	0x04, 0x0a, 0x06, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
-	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
-	0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72,
-	0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67,
-	0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77,
-	0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
-	0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a,
-	0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a,
-	0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76,
-	0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72,
-	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
-	0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69,
-	0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74,
-	0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a,
-	0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
-	0x18, 0x0d, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63,
-	0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
-	0x65, 0x12, 0x55, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73,
-	0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f,
-	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0xfa, 0x07, 0x0a, 0x08, 0x49,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64,
-	0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61,
-	0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73,
-	0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50,
-	0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a,
-	0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c,
-	0x69, 0x70, 0x73, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05,
-	0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f,
-	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
-	0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x65, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x65, 0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
-	0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a,
-	0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63,
-	0x6f, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
-	0x65, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f,
-	0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18,
-	0x16, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10,
-	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
-	0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63,
-	0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x1a, 0x7e, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f,
-	0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
-	0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65,
-	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x1a, 0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b,
-	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c, 0x6c,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4f,
-	0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e,
-	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61,
-	0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22,
-	0x36, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f, 0x49, 0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63,
-	0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0xa0, 0x03, 0x0a, 0x0f,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12,
-	0x42, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x1a, 0x90, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
-	0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67,
-	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x07, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78,
-	0x48, 0x61, 0x73, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x3a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x1a, 0x36, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d,
-	0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
-	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe2, 0x01,
-	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54,
-	0x78, 0x73, 0x12, 0x3a, 0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65,
-	0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44,
-	0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x1a, 0x91,
-	0x01, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
-	0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
-	0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x22, 0x57, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65,
-	0x73, 0x12, 0x40, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f,
-	0x74, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72,
-	0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a,
-	0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_protobuf_models_proto_rawDescOnce sync.Once
-	file_protobuf_models_proto_rawDescData = file_protobuf_models_proto_rawDesc
-)
-
-func file_protobuf_models_proto_rawDescGZIP() []byte {
-	file_protobuf_models_proto_rawDescOnce.Do(func() {
-		file_protobuf_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_models_proto_rawDescData)
-	})
-	return file_protobuf_models_proto_rawDescData
-}
-
-var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
-var file_protobuf_models_proto_goTypes = []interface{}{
-	(*ProtoTransaction)(nil),                              // 0: models.ProtoTransaction
-	(*ProtoBlockHeader)(nil),                              // 1: models.ProtoBlockHeader
-	(*ProtoBlockBody)(nil),                                // 2: models.ProtoBlockBody
-	(*ProtoBlock)(nil),                                    // 3: models.ProtoBlock
-	(*ProtoBlockProposal)(nil),                            // 4: models.ProtoBlockProposal
-	(*ProtoIpfsFlip)(nil),                                 // 5: models.ProtoIpfsFlip
-	(*ProtoBlockCert)(nil),                                // 6: models.ProtoBlockCert
-	(*ProtoWeakCertificates)(nil),                         // 7: models.ProtoWeakCertificates
-	(*ProtoTransactionIndex)(nil),                         // 8: models.ProtoTransactionIndex
-	(*ProtoFlipPrivateKeys)(nil),                          // 9: models.ProtoFlipPrivateKeys
-	(*ProtoProfile)(nil),                                  // 10: models.ProtoProfile
-	(*ProtoHandshake)(nil),                                // 11: models.ProtoHandshake
-	(*ProtoMsg)(nil),                                      // 12: models.ProtoMsg
-	(*ProtoIdentityStateDiff)(nil),                        // 13: models.ProtoIdentityStateDiff
-	(*ProtoSnapshotBlock)(nil),                            // 14: models.ProtoSnapshotBlock
-	(*ProtoGossipBlockRange)(nil),                         // 15: models.ProtoGossipBlockRange
-	(*ProtoProposeProof)(nil),                             // 16: models.ProtoProposeProof
-	(*ProtoVote)(nil),                                     // 17: models.ProtoVote
-	(*ProtoGetBlockByHashRequest)(nil),                    // 18: models.ProtoGetBlockByHashRequest
-	(*ProtoGetBlocksRangeRequest)(nil),                    // 19: models.ProtoGetBlocksRangeRequest
-	(*ProtoGetForkBlockRangeRequest)(nil),                 // 20: models.ProtoGetForkBlockRangeRequest
-	(*ProtoFlip)(nil),                                     // 21: models.ProtoFlip
-	(*ProtoFlipKey)(nil),                                  // 22: models.ProtoFlipKey
-	(*ProtoManifest)(nil),                                 // 23: models.ProtoManifest
-	(*ProtoPrivateFlipKeysPackage)(nil),                   // 24: models.ProtoPrivateFlipKeysPackage
-	(*ProtoPullPushHash)(nil),                             // 25: models.ProtoPullPushHash
-	(*ProtoSnapshotManifestDb)(nil),                       // 26: models.ProtoSnapshotManifestDb
-	(*ProtoShortAnswerDb)(nil),                            // 27: models.ProtoShortAnswerDb
-	(*ProtoAnswersDb)(nil),                                // 28: models.ProtoAnswersDb
-	(*ProtoBurntCoins)(nil),                               // 29: models.ProtoBurntCoins
-	(*ProtoSavedTransaction)(nil),                         // 30: models.ProtoSavedTransaction
-	(*ProtoActivityMonitor)(nil),                          // 31: models.ProtoActivityMonitor
-	(*ProtoShortAnswerAttachment)(nil),                    // 32: models.ProtoShortAnswerAttachment
-	(*ProtoLongAnswerAttachment)(nil),                     // 33: models.ProtoLongAnswerAttachment
-	(*ProtoFlipSubmitAttachment)(nil),                     // 34: models.ProtoFlipSubmitAttachment
-	(*ProtoOnlineStatusAttachment)(nil),                   // 35: models.ProtoOnlineStatusAttachment
-	(*ProtoBurnAttachment)(nil),                           // 36: models.ProtoBurnAttachment
-	(*ProtoChangeProfileAttachment)(nil),                  // 37: models.ProtoChangeProfileAttachment
-	(*ProtoDeleteFlipAttachment)(nil),                     // 38: models.ProtoDeleteFlipAttachment
-	(*ProtoStateAccount)(nil),                             // 39: models.ProtoStateAccount
-	(*ProtoStateIdentity)(nil),                            // 40: models.ProtoStateIdentity
-	(*ProtoStateGlobal)(nil),                              // 41: models.ProtoStateGlobal
-	(*ProtoStateApprovedIdentity)(nil),                    // 42: models.ProtoStateApprovedIdentity
-	(*ProtoStateIdentityStatusSwitch)(nil),                // 43: models.ProtoStateIdentityStatusSwitch
-	(*ProtoStateDelegationSwitch)(nil),                    // 44: models.ProtoStateDelegationSwitch
-	(*ProtoStateDelayedPenalties)(nil),                    // 45: models.ProtoStateDelayedPenalties
-	(*ProtoPredefinedState)(nil),                          // 46: models.ProtoPredefinedState
-	(*ProtoCallContractAttachment)(nil),                   // 47: models.ProtoCallContractAttachment
-	(*ProtoDeployContractAttachment)(nil),                 // 48: models.ProtoDeployContractAttachment
-	(*ProtoTerminateContractAttachment)(nil),              // 49: models.ProtoTerminateContractAttachment
-	(*ProtoStoreToIpfsAttachment)(nil),                    // 50: models.ProtoStoreToIpfsAttachment
-	(*ProtoTxReceipts)(nil),                               // 51: models.ProtoTxReceipts
-	(*ProtoTxReceiptIndex)(nil),                           // 52: models.ProtoTxReceiptIndex
-	(*ProtoDeferredTxs)(nil),                              // 53: models.ProtoDeferredTxs
-	(*ProtoSavedEvent)(nil),                               // 54: models.ProtoSavedEvent
-	(*ProtoUpgradeVotes)(nil),                             // 55: models.ProtoUpgradeVotes
-	(*ProtoTransaction_Data)(nil),                         // 56: models.ProtoTransaction.Data
-	(*ProtoBlockHeader_Proposed)(nil),                     // 57: models.ProtoBlockHeader.Proposed
-	(*ProtoBlockHeader_Empty)(nil),                        // 58: models.ProtoBlockHeader.Empty
-	(*ProtoBlockProposal_Data)(nil),                       // 59: models.ProtoBlockProposal.Data
-	(*ProtoBlockCert_Signature)(nil),                      // 60: models.ProtoBlockCert.Signature
-	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 61: models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 62: models.ProtoSnapshotBlock.KeyValue
-	(*ProtoGossipBlockRange_Block)(nil),                   // 63: models.ProtoGossipBlockRange.Block
-	(*ProtoProposeProof_Data)(nil),                        // 64: models.ProtoProposeProof.Data
-	(*ProtoVote_Data)(nil),                                // 65: models.ProtoVote.Data
-	(*ProtoFlipKey_Data)(nil),                             // 66: models.ProtoFlipKey.Data
-	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 67: models.ProtoPrivateFlipKeysPackage.Data
-	(*ProtoAnswersDb_Answer)(nil),                         // 68: models.ProtoAnswersDb.Answer
-	(*ProtoActivityMonitor_Activity)(nil),                 // 69: models.ProtoActivityMonitor.Activity
-	(*ProtoStateAccount_ProtoContractData)(nil),           // 70: models.ProtoStateAccount.ProtoContractData
-	(*ProtoStateIdentity_Flip)(nil),                       // 71: models.ProtoStateIdentity.Flip
-	(*ProtoStateIdentity_TxAddr)(nil),                     // 72: models.ProtoStateIdentity.TxAddr
-	(*ProtoStateIdentity_Inviter)(nil),                    // 73: models.ProtoStateIdentity.Inviter
-	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 74: models.ProtoStateDelegationSwitch.Delegation
-	(*ProtoPredefinedState_Global)(nil),                   // 75: models.ProtoPredefinedState.Global
-	(*ProtoPredefinedState_StatusSwitch)(nil),             // 76: models.ProtoPredefinedState.StatusSwitch
-	(*ProtoPredefinedState_Account)(nil),                  // 77: models.ProtoPredefinedState.Account
-	(*ProtoPredefinedState_Identity)(nil),                 // 78: models.ProtoPredefinedState.Identity
-	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 79: models.ProtoPredefinedState.ApprovedIdentity
-	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 80: models.ProtoPredefinedState.ContractKeyValue
-	(*ProtoPredefinedState_Account_ContractData)(nil),     // 81: models.ProtoPredefinedState.Account.ContractData
-	(*ProtoPredefinedState_Identity_Flip)(nil),            // 82: models.ProtoPredefinedState.Identity.Flip
-	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 83: models.ProtoPredefinedState.Identity.TxAddr
-	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 84: models.ProtoPredefinedState.Identity.Inviter
-	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 85: models.ProtoTxReceipts.ProtoTxReceipt
-	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 86: models.ProtoTxReceipts.ProtoEvent
-	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 87: models.ProtoDeferredTxs.ProtoDeferredTx
-	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 88: models.ProtoUpgradeVotes.ProtoUpgradeVote
-}
-var file_protobuf_models_proto_depIdxs = []int32{
-	56, // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
-	57, // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
-	58, // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
-	0,  // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
-	1,  // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
-	2,  // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
-	59, // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
-	60, // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
-	61, // 8: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
-	62, // 9: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
-	63, // 10: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
-	64, // 11: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
-	65, // 12: models.ProtoVote.data:type_name -> models.ProtoVote.Data
-	0,  // 13: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
-	66, // 14: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
-	67, // 15: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
-	68, // 16: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
-	0,  // 17: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
-	69, // 18: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
-	70, // 19: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
-	71, // 20: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
-	72, // 21: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
-	73, // 22: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
-	74, // 23: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
-	75, // 24: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
-	76, // 25: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
-	77, // 26: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
-	78, // 27: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
-	79, // 28: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
-	80, // 29: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
-	85, // 30: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
-	87, // 31: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
-	88, // 32: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
-	1,  // 33: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
-	2,  // 34: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
-	1,  // 35: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
-	6,  // 36: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
-	13, // 37: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
-	81, // 38: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
-	82, // 39: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
-	83, // 40: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
-	84, // 41: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
-	86, // 42: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
-	43, // [43:43] is the sub-list for method output_type
-	43, // [43:43] is the sub-list for method input_type
-	43, // [43:43] is the sub-list for extension type_name
-	43, // [43:43] is the sub-list for extension extendee
-	0,  // [0:43] is the sub-list for field type_name
-}
-
-func init() { file_protobuf_models_proto_init() }
-func file_protobuf_models_proto_init() {
-	if File_protobuf_models_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_protobuf_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransaction); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoEpochCheckpoint_Data.ProtoReflect.Descriptor instead.
+func (*ProtoEpochCheckpoint_Data) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{33, 0}
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetRoot() []byte {
+	if x != nil {
+		return x.Root
+	}
+	return nil
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetIdentityRoot() []byte {
+	if x != nil {
+		return x.IdentityRoot
+	}
+	return nil
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetSupply() []byte {
+	if x != nil {
+		return x.Supply
+	}
+	return nil
+}
+
+func (x *ProtoEpochCheckpoint_Data) GetStake() []byte {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+type ProtoStateAccount_ProtoContractData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CodeHash         []byte `protobuf:"bytes,1,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Stake            []byte `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	StorageSize      uint64 `protobuf:"varint,3,opt,name=storageSize,proto3" json:"storageSize,omitempty"`
+	UnpaidRentEpochs uint32 `protobuf:"varint,4,opt,name=unpaidRentEpochs,proto3" json:"unpaidRentEpochs,omitempty"`
+}
+
+func (x *ProtoStateAccount_ProtoContractData) Reset() {
+	*x = ProtoStateAccount_ProtoContractData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateAccount_ProtoContractData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateAccount_ProtoContractData) ProtoMessage() {}
+
+func (x *ProtoStateAccount_ProtoContractData) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateAccount_ProtoContractData.ProtoReflect.Descriptor instead.
+func (*ProtoStateAccount_ProtoContractData) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42, 0}
+}
+
+func (x *ProtoStateAccount_ProtoContractData) GetCodeHash() []byte {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *ProtoStateAccount_ProtoContractData) GetStake() []byte {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *ProtoStateAccount_ProtoContractData) GetStorageSize() uint64 {
+	if x != nil {
+		return x.StorageSize
+	}
+	return 0
+}
+
+func (x *ProtoStateAccount_ProtoContractData) GetUnpaidRentEpochs() uint32 {
+	if x != nil {
+		return x.UnpaidRentEpochs
+	}
+	return 0
+}
+
+type ProtoStateAccount_ProtoStakeLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount     []byte `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Unlocked   []byte `protobuf:"bytes,2,opt,name=unlocked,proto3" json:"unlocked,omitempty"`
+	StartEpoch uint32 `protobuf:"varint,3,opt,name=startEpoch,proto3" json:"startEpoch,omitempty"`
+	Epochs     uint32 `protobuf:"varint,4,opt,name=epochs,proto3" json:"epochs,omitempty"`
+	Locker     []byte `protobuf:"bytes,5,opt,name=locker,proto3" json:"locker,omitempty"`
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) Reset() {
+	*x = ProtoStateAccount_ProtoStakeLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateAccount_ProtoStakeLock) ProtoMessage() {}
+
+func (x *ProtoStateAccount_ProtoStakeLock) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateAccount_ProtoStakeLock.ProtoReflect.Descriptor instead.
+func (*ProtoStateAccount_ProtoStakeLock) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{42, 1}
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) GetUnlocked() []byte {
+	if x != nil {
+		return x.Unlocked
+	}
+	return nil
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) GetStartEpoch() uint32 {
+	if x != nil {
+		return x.StartEpoch
+	}
+	return 0
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) GetEpochs() uint32 {
+	if x != nil {
+		return x.Epochs
+	}
+	return 0
+}
+
+func (x *ProtoStateAccount_ProtoStakeLock) GetLocker() []byte {
+	if x != nil {
+		return x.Locker
+	}
+	return nil
+}
+
+type ProtoStateIdentity_Flip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *ProtoStateIdentity_Flip) Reset() {
+	*x = ProtoStateIdentity_Flip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateIdentity_Flip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateIdentity_Flip) ProtoMessage() {}
+
+func (x *ProtoStateIdentity_Flip) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateIdentity_Flip.ProtoReflect.Descriptor instead.
+func (*ProtoStateIdentity_Flip) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ProtoStateIdentity_Flip) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_Flip) GetPair() uint32 {
+	if x != nil {
+		return x.Pair
+	}
+	return 0
+}
+
+type ProtoStateIdentity_TxAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ProtoStateIdentity_TxAddr) Reset() {
+	*x = ProtoStateIdentity_TxAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateIdentity_TxAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateIdentity_TxAddr) ProtoMessage() {}
+
+func (x *ProtoStateIdentity_TxAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateIdentity_TxAddr.ProtoReflect.Descriptor instead.
+func (*ProtoStateIdentity_TxAddr) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *ProtoStateIdentity_TxAddr) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_TxAddr) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ProtoStateIdentity_Inviter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address     []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	EpochHeight uint32 `protobuf:"varint,3,opt,name=epochHeight,proto3" json:"epochHeight,omitempty"`
+}
+
+func (x *ProtoStateIdentity_Inviter) Reset() {
+	*x = ProtoStateIdentity_Inviter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateIdentity_Inviter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateIdentity_Inviter) ProtoMessage() {}
+
+func (x *ProtoStateIdentity_Inviter) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateIdentity_Inviter.ProtoReflect.Descriptor instead.
+func (*ProtoStateIdentity_Inviter) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{43, 2}
+}
+
+func (x *ProtoStateIdentity_Inviter) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_Inviter) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoStateIdentity_Inviter) GetEpochHeight() uint32 {
+	if x != nil {
+		return x.EpochHeight
+	}
+	return 0
+}
+
+type ProtoStateGlobal_ProtoMisbehavior struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Round   uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (x *ProtoStateGlobal_ProtoMisbehavior) Reset() {
+	*x = ProtoStateGlobal_ProtoMisbehavior{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateGlobal_ProtoMisbehavior) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateGlobal_ProtoMisbehavior) ProtoMessage() {}
+
+func (x *ProtoStateGlobal_ProtoMisbehavior) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateGlobal_ProtoMisbehavior.ProtoReflect.Descriptor instead.
+func (*ProtoStateGlobal_ProtoMisbehavior) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{44, 0}
+}
+
+func (x *ProtoStateGlobal_ProtoMisbehavior) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoStateGlobal_ProtoMisbehavior) GetRound() uint64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+type ProtoStateDelegationSwitch_Delegation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Delegator []byte `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegatee []byte `protobuf:"bytes,2,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+}
+
+func (x *ProtoStateDelegationSwitch_Delegation) Reset() {
+	*x = ProtoStateDelegationSwitch_Delegation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoStateDelegationSwitch_Delegation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoStateDelegationSwitch_Delegation) ProtoMessage() {}
+
+func (x *ProtoStateDelegationSwitch_Delegation) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoStateDelegationSwitch_Delegation.ProtoReflect.Descriptor instead.
+func (*ProtoStateDelegationSwitch_Delegation) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *ProtoStateDelegationSwitch_Delegation) GetDelegator() []byte {
+	if x != nil {
+		return x.Delegator
+	}
+	return nil
+}
+
+func (x *ProtoStateDelegationSwitch_Delegation) GetDelegatee() []byte {
+	if x != nil {
+		return x.Delegatee
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Global struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch                         uint32                               `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	NextValidationTime            int64                                `protobuf:"varint,2,opt,name=nextValidationTime,proto3" json:"nextValidationTime,omitempty"`
+	ValidationPeriod              uint32                               `protobuf:"varint,3,opt,name=validationPeriod,proto3" json:"validationPeriod,omitempty"`
+	GodAddress                    []byte                               `protobuf:"bytes,4,opt,name=godAddress,proto3" json:"godAddress,omitempty"`
+	WordsSeed                     []byte                               `protobuf:"bytes,5,opt,name=wordsSeed,proto3" json:"wordsSeed,omitempty"`
+	LastSnapshot                  uint64                               `protobuf:"varint,6,opt,name=lastSnapshot,proto3" json:"lastSnapshot,omitempty"`
+	EpochBlock                    uint64                               `protobuf:"varint,7,opt,name=epochBlock,proto3" json:"epochBlock,omitempty"`
+	FeePerGas                     []byte                               `protobuf:"bytes,8,opt,name=feePerGas,proto3" json:"feePerGas,omitempty"`
+	VrfProposerThreshold          uint64                               `protobuf:"varint,9,opt,name=vrfProposerThreshold,proto3" json:"vrfProposerThreshold,omitempty"`
+	EmptyBlocksBits               []byte                               `protobuf:"bytes,10,opt,name=emptyBlocksBits,proto3" json:"emptyBlocksBits,omitempty"`
+	GodAddressInvites             uint32                               `protobuf:"varint,11,opt,name=godAddressInvites,proto3" json:"godAddressInvites,omitempty"`
+	BlocksCntWithoutCeremonialTxs uint32                               `protobuf:"varint,12,opt,name=blocksCntWithoutCeremonialTxs,proto3" json:"blocksCntWithoutCeremonialTxs,omitempty"`
+	PrevEpochBlocks               []uint64                             `protobuf:"varint,13,rep,packed,name=prevEpochBlocks,proto3" json:"prevEpochBlocks,omitempty"`
+	BlockGasLimit                 uint64                               `protobuf:"varint,14,opt,name=blockGasLimit,proto3" json:"blockGasLimit,omitempty"`
+	Misbehaviors                  []*ProtoStateGlobal_ProtoMisbehavior `protobuf:"bytes,15,rep,name=misbehaviors,proto3" json:"misbehaviors,omitempty"`
+	MinProposerThreshold          uint64                               `protobuf:"varint,16,opt,name=minProposerThreshold,proto3" json:"minProposerThreshold,omitempty"`
+	WordsDictionary               *ProtoWordsDictionary                `protobuf:"bytes,17,opt,name=wordsDictionary,proto3" json:"wordsDictionary,omitempty"`
+	NextWordsDictionary           *ProtoWordsDictionary                `protobuf:"bytes,18,opt,name=nextWordsDictionary,proto3" json:"nextWordsDictionary,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Global) Reset() {
+	*x = ProtoPredefinedState_Global{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Global) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Global) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Global) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Global.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Global) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *ProtoPredefinedState_Global) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetNextValidationTime() int64 {
+	if x != nil {
+		return x.NextValidationTime
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetValidationPeriod() uint32 {
+	if x != nil {
+		return x.ValidationPeriod
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetGodAddress() []byte {
+	if x != nil {
+		return x.GodAddress
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetWordsSeed() []byte {
+	if x != nil {
+		return x.WordsSeed
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetLastSnapshot() uint64 {
+	if x != nil {
+		return x.LastSnapshot
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetEpochBlock() uint64 {
+	if x != nil {
+		return x.EpochBlock
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetFeePerGas() []byte {
+	if x != nil {
+		return x.FeePerGas
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetVrfProposerThreshold() uint64 {
+	if x != nil {
+		return x.VrfProposerThreshold
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetEmptyBlocksBits() []byte {
+	if x != nil {
+		return x.EmptyBlocksBits
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetGodAddressInvites() uint32 {
+	if x != nil {
+		return x.GodAddressInvites
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetBlocksCntWithoutCeremonialTxs() uint32 {
+	if x != nil {
+		return x.BlocksCntWithoutCeremonialTxs
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetPrevEpochBlocks() []uint64 {
+	if x != nil {
+		return x.PrevEpochBlocks
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetBlockGasLimit() uint64 {
+	if x != nil {
+		return x.BlockGasLimit
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetMisbehaviors() []*ProtoStateGlobal_ProtoMisbehavior {
+	if x != nil {
+		return x.Misbehaviors
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetMinProposerThreshold() uint64 {
+	if x != nil {
+		return x.MinProposerThreshold
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Global) GetWordsDictionary() *ProtoWordsDictionary {
+	if x != nil {
+		return x.WordsDictionary
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Global) GetNextWordsDictionary() *ProtoWordsDictionary {
+	if x != nil {
+		return x.NextWordsDictionary
+	}
+	return nil
+}
+
+type ProtoPredefinedState_StatusSwitch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses [][]byte `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *ProtoPredefinedState_StatusSwitch) Reset() {
+	*x = ProtoPredefinedState_StatusSwitch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_StatusSwitch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_StatusSwitch) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_StatusSwitch) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_StatusSwitch.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_StatusSwitch) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 1}
+}
+
+func (x *ProtoPredefinedState_StatusSwitch) GetAddresses() [][]byte {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Account struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      []byte                                     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Nonce        uint32                                     `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Epoch        uint32                                     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Balance      []byte                                     `protobuf:"bytes,4,opt,name=balance,proto3" json:"balance,omitempty"`
+	ContractData *ProtoPredefinedState_Account_ContractData `protobuf:"bytes,5,opt,name=contractData,proto3" json:"contractData,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Account) Reset() {
+	*x = ProtoPredefinedState_Account{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Account) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Account) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Account.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Account) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 2}
+}
+
+func (x *ProtoPredefinedState_Account) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Account) GetNonce() uint32 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Account) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Account) GetBalance() []byte {
+	if x != nil {
+		return x.Balance
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Account) GetContractData() *ProtoPredefinedState_Account_ContractData {
+	if x != nil {
+		return x.ContractData
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Identity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          []byte                                  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Stake            []byte                                  `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
+	Invites          uint32                                  `protobuf:"varint,3,opt,name=invites,proto3" json:"invites,omitempty"`
+	Birthday         uint32                                  `protobuf:"varint,4,opt,name=birthday,proto3" json:"birthday,omitempty"`
+	State            uint32                                  `protobuf:"varint,5,opt,name=state,proto3" json:"state,omitempty"`
+	QualifiedFlips   uint32                                  `protobuf:"varint,6,opt,name=qualifiedFlips,proto3" json:"qualifiedFlips,omitempty"`
+	ShortFlipPoints  uint32                                  `protobuf:"varint,7,opt,name=shortFlipPoints,proto3" json:"shortFlipPoints,omitempty"`
+	PubKey           []byte                                  `protobuf:"bytes,8,opt,name=pubKey,proto3" json:"pubKey,omitempty"`
+	RequiredFlips    uint32                                  `protobuf:"varint,9,opt,name=requiredFlips,proto3" json:"requiredFlips,omitempty"`
+	Flips            []*ProtoPredefinedState_Identity_Flip   `protobuf:"bytes,10,rep,name=flips,proto3" json:"flips,omitempty"`
+	Generation       uint32                                  `protobuf:"varint,11,opt,name=generation,proto3" json:"generation,omitempty"`
+	Code             []byte                                  `protobuf:"bytes,12,opt,name=code,proto3" json:"code,omitempty"`
+	Invitees         []*ProtoPredefinedState_Identity_TxAddr `protobuf:"bytes,13,rep,name=invitees,proto3" json:"invitees,omitempty"`
+	Inviter          *ProtoPredefinedState_Identity_Inviter  `protobuf:"bytes,14,opt,name=inviter,proto3" json:"inviter,omitempty"`
+	Penalty          []byte                                  `protobuf:"bytes,15,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	ValidationBits   uint32                                  `protobuf:"varint,16,opt,name=validationBits,proto3" json:"validationBits,omitempty"`
+	ValidationStatus uint32                                  `protobuf:"varint,17,opt,name=validationStatus,proto3" json:"validationStatus,omitempty"`
+	ProfileHash      []byte                                  `protobuf:"bytes,18,opt,name=profileHash,proto3" json:"profileHash,omitempty"`
+	Scores           []byte                                  `protobuf:"bytes,19,opt,name=scores,proto3" json:"scores,omitempty"`
+	Delegatee        []byte                                  `protobuf:"bytes,20,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	DelegationNonce  uint32                                  `protobuf:"varint,21,opt,name=delegationNonce,proto3" json:"delegationNonce,omitempty"`
+	DelegationEpoch  uint32                                  `protobuf:"varint,22,opt,name=delegationEpoch,proto3" json:"delegationEpoch,omitempty"`
+	AnswersSubmitter []byte                                  `protobuf:"bytes,23,opt,name=answersSubmitter,proto3" json:"answersSubmitter,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Identity) Reset() {
+	*x = ProtoPredefinedState_Identity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Identity) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Identity.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Identity) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 3}
+}
+
+func (x *ProtoPredefinedState_Identity) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetStake() []byte {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetInvites() uint32 {
+	if x != nil {
+		return x.Invites
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetBirthday() uint32 {
+	if x != nil {
+		return x.Birthday
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetState() uint32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetQualifiedFlips() uint32 {
+	if x != nil {
+		return x.QualifiedFlips
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetShortFlipPoints() uint32 {
+	if x != nil {
+		return x.ShortFlipPoints
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetPubKey() []byte {
+	if x != nil {
+		return x.PubKey
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetRequiredFlips() uint32 {
+	if x != nil {
+		return x.RequiredFlips
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetFlips() []*ProtoPredefinedState_Identity_Flip {
+	if x != nil {
+		return x.Flips
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetGeneration() uint32 {
+	if x != nil {
+		return x.Generation
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetCode() []byte {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetInvitees() []*ProtoPredefinedState_Identity_TxAddr {
+	if x != nil {
+		return x.Invitees
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetInviter() *ProtoPredefinedState_Identity_Inviter {
+	if x != nil {
+		return x.Inviter
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetPenalty() []byte {
+	if x != nil {
+		return x.Penalty
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetValidationBits() uint32 {
+	if x != nil {
+		return x.ValidationBits
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetValidationStatus() uint32 {
+	if x != nil {
+		return x.ValidationStatus
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetProfileHash() []byte {
+	if x != nil {
+		return x.ProfileHash
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetScores() []byte {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetDelegatee() []byte {
+	if x != nil {
+		return x.Delegatee
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity) GetDelegationNonce() uint32 {
+	if x != nil {
+		return x.DelegationNonce
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetDelegationEpoch() uint32 {
+	if x != nil {
+		return x.DelegationEpoch
+	}
+	return 0
+}
+
+func (x *ProtoPredefinedState_Identity) GetAnswersSubmitter() []byte {
+	if x != nil {
+		return x.AnswersSubmitter
+	}
+	return nil
+}
+
+type ProtoPredefinedState_ApprovedIdentity struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   []byte `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Approved  bool   `protobuf:"varint,2,opt,name=approved,proto3" json:"approved,omitempty"`
+	Online    bool   `protobuf:"varint,3,opt,name=online,proto3" json:"online,omitempty"`
+	Delegatee []byte `protobuf:"bytes,4,opt,name=delegatee,proto3" json:"delegatee,omitempty"`
+	BlsPubKey []byte `protobuf:"bytes,5,opt,name=blsPubKey,proto3" json:"blsPubKey,omitempty"`
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) Reset() {
+	*x = ProtoPredefinedState_ApprovedIdentity{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_ApprovedIdentity) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_ApprovedIdentity.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_ApprovedIdentity) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 4}
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) GetApproved() bool {
+	if x != nil {
+		return x.Approved
+	}
+	return false
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) GetOnline() bool {
+	if x != nil {
+		return x.Online
+	}
+	return false
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) GetDelegatee() []byte {
+	if x != nil {
+		return x.Delegatee
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_ApprovedIdentity) GetBlsPubKey() []byte {
+	if x != nil {
+		return x.BlsPubKey
+	}
+	return nil
+}
+
+type ProtoPredefinedState_ContractKeyValue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ProtoPredefinedState_ContractKeyValue) Reset() {
+	*x = ProtoPredefinedState_ContractKeyValue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_ContractKeyValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_ContractKeyValue) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_ContractKeyValue) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_ContractKeyValue.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_ContractKeyValue) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 5}
+}
+
+func (x *ProtoPredefinedState_ContractKeyValue) GetKey() []byte {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_ContractKeyValue) GetValue() []byte {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Account_ContractData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CodeHash []byte `protobuf:"bytes,1,opt,name=codeHash,proto3" json:"codeHash,omitempty"`
+	Stake    []byte `protobuf:"bytes,2,opt,name=stake,proto3" json:"stake,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Account_ContractData) Reset() {
+	*x = ProtoPredefinedState_Account_ContractData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Account_ContractData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Account_ContractData) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Account_ContractData) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Account_ContractData.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Account_ContractData) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 2, 0}
+}
+
+func (x *ProtoPredefinedState_Account_ContractData) GetCodeHash() []byte {
+	if x != nil {
+		return x.CodeHash
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Account_ContractData) GetStake() []byte {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Identity_Flip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cid  []byte `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	Pair uint32 `protobuf:"varint,2,opt,name=pair,proto3" json:"pair,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Identity_Flip) Reset() {
+	*x = ProtoPredefinedState_Identity_Flip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Identity_Flip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Identity_Flip) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Identity_Flip) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Identity_Flip.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Identity_Flip) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 3, 0}
+}
+
+func (x *ProtoPredefinedState_Identity_Flip) GetCid() []byte {
+	if x != nil {
+		return x.Cid
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity_Flip) GetPair() uint32 {
+	if x != nil {
+		return x.Pair
+	}
+	return 0
+}
+
+type ProtoPredefinedState_Identity_TxAddr struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash    []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Identity_TxAddr) Reset() {
+	*x = ProtoPredefinedState_Identity_TxAddr{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Identity_TxAddr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Identity_TxAddr) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Identity_TxAddr) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Identity_TxAddr.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Identity_TxAddr) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 3, 1}
+}
+
+func (x *ProtoPredefinedState_Identity_TxAddr) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity_TxAddr) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ProtoPredefinedState_Identity_Inviter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash        []byte `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Address     []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	EpochHeight uint32 `protobuf:"varint,3,opt,name=epochHeight,proto3" json:"epochHeight,omitempty"`
+}
+
+func (x *ProtoPredefinedState_Identity_Inviter) Reset() {
+	*x = ProtoPredefinedState_Identity_Inviter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoPredefinedState_Identity_Inviter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoPredefinedState_Identity_Inviter) ProtoMessage() {}
+
+func (x *ProtoPredefinedState_Identity_Inviter) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoPredefinedState_Identity_Inviter.ProtoReflect.Descriptor instead.
+func (*ProtoPredefinedState_Identity_Inviter) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{49, 3, 2}
+}
+
+func (x *ProtoPredefinedState_Identity_Inviter) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity_Inviter) GetAddress() []byte {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ProtoPredefinedState_Identity_Inviter) GetEpochHeight() uint32 {
+	if x != nil {
+		return x.EpochHeight
+	}
+	return 0
+}
+
+type ProtoTxReceipts_ProtoTxReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract  []byte                        `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Success   bool                          `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	GasUsed   uint64                        `protobuf:"varint,3,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	From      []byte                        `protobuf:"bytes,4,opt,name=from,proto3" json:"from,omitempty"`
+	Error     string                        `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	GasCost   []byte                        `protobuf:"bytes,6,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	TxHash    []byte                        `protobuf:"bytes,7,opt,name=txHash,proto3" json:"txHash,omitempty"`
+	Events    []*ProtoTxReceipts_ProtoEvent `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`
+	Method    string                        `protobuf:"bytes,9,opt,name=method,proto3" json:"method,omitempty"`
+	Fee       []byte                        `protobuf:"bytes,10,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeBurned []byte                        `protobuf:"bytes,11,opt,name=feeBurned,proto3" json:"feeBurned,omitempty"`
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) Reset() {
+	*x = ProtoTxReceipts_ProtoTxReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoTxReceipts_ProtoTxReceipt) ProtoMessage() {}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoTxReceipts_ProtoTxReceipt.ProtoReflect.Descriptor instead.
+func (*ProtoTxReceipts_ProtoTxReceipt) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetContract() []byte {
+	if x != nil {
+		return x.Contract
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetGasCost() []byte {
+	if x != nil {
+		return x.GasCost
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetTxHash() []byte {
+	if x != nil {
+		return x.TxHash
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetEvents() []*ProtoTxReceipts_ProtoEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetFee() []byte {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *ProtoTxReceipts_ProtoTxReceipt) GetFeeBurned() []byte {
+	if x != nil {
+		return x.FeeBurned
+	}
+	return nil
+}
+
+type ProtoTxReceipts_ProtoEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Event string   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Data  [][]byte `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ProtoTxReceipts_ProtoEvent) Reset() {
+	*x = ProtoTxReceipts_ProtoEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoTxReceipts_ProtoEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoTxReceipts_ProtoEvent) ProtoMessage() {}
+
+func (x *ProtoTxReceipts_ProtoEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoTxReceipts_ProtoEvent.ProtoReflect.Descriptor instead.
+func (*ProtoTxReceipts_ProtoEvent) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{58, 1}
+}
+
+func (x *ProtoTxReceipts_ProtoEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ProtoTxReceipts_ProtoEvent) GetData() [][]byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ProtoDeferredTxs_ProtoDeferredTx struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From    []byte `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To      []byte `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Amount  []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Payload []byte `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`
+	Tips    []byte `protobuf:"bytes,5,opt,name=tips,proto3" json:"tips,omitempty"`
+	Block   uint64 `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	Id      []byte `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Type    uint32 `protobuf:"varint,8,opt,name=type,proto3" json:"type,omitempty"`
+	Epoch   uint32 `protobuf:"varint,9,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	MaxFee  []byte `protobuf:"bytes,10,opt,name=maxFee,proto3" json:"maxFee,omitempty"`
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) Reset() {
+	*x = ProtoDeferredTxs_ProtoDeferredTx{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoDeferredTxs_ProtoDeferredTx) ProtoMessage() {}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoDeferredTxs_ProtoDeferredTx.ProtoReflect.Descriptor instead.
+func (*ProtoDeferredTxs_ProtoDeferredTx) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetTips() []byte {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetBlock() uint64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetType() uint32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetEpoch() uint32 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ProtoDeferredTxs_ProtoDeferredTx) GetMaxFee() []byte {
+	if x != nil {
+		return x.MaxFee
+	}
+	return nil
+}
+
+type ProtoUpgradeVotes_ProtoUpgradeVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voter   []byte `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Upgrade uint32 `protobuf:"varint,2,opt,name=upgrade,proto3" json:"upgrade,omitempty"`
+}
+
+func (x *ProtoUpgradeVotes_ProtoUpgradeVote) Reset() {
+	*x = ProtoUpgradeVotes_ProtoUpgradeVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoUpgradeVotes_ProtoUpgradeVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoUpgradeVotes_ProtoUpgradeVote) ProtoMessage() {}
+
+func (x *ProtoUpgradeVotes_ProtoUpgradeVote) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoUpgradeVotes_ProtoUpgradeVote.ProtoReflect.Descriptor instead.
+func (*ProtoUpgradeVotes_ProtoUpgradeVote) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetVoter() []byte {
+	if x != nil {
+		return x.Voter
+	}
+	return nil
+}
+
+func (x *ProtoUpgradeVotes_ProtoUpgradeVote) GetUpgrade() uint32 {
+	if x != nil {
+		return x.Upgrade
+	}
+	return 0
+}
+
+type ProtoEpochSummary_ProtoStateCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State uint32 `protobuf:"varint,1,opt,name=state,proto3" json:"state,omitempty"`
+	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ProtoEpochSummary_ProtoStateCount) Reset() {
+	*x = ProtoEpochSummary_ProtoStateCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoEpochSummary_ProtoStateCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoEpochSummary_ProtoStateCount) ProtoMessage() {}
+
+func (x *ProtoEpochSummary_ProtoStateCount) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoEpochSummary_ProtoStateCount.ProtoReflect.Descriptor instead.
+func (*ProtoEpochSummary_ProtoStateCount) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{64, 0}
+}
+
+func (x *ProtoEpochSummary_ProtoStateCount) GetState() uint32 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *ProtoEpochSummary_ProtoStateCount) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ProtoCeremonyTimeline_Phase struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Timestamp int64  `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Scheduled int64  `protobuf:"varint,4,opt,name=scheduled,proto3" json:"scheduled,omitempty"`
+}
+
+func (x *ProtoCeremonyTimeline_Phase) Reset() {
+	*x = ProtoCeremonyTimeline_Phase{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoCeremonyTimeline_Phase) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoCeremonyTimeline_Phase) ProtoMessage() {}
+
+func (x *ProtoCeremonyTimeline_Phase) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoCeremonyTimeline_Phase.ProtoReflect.Descriptor instead.
+func (*ProtoCeremonyTimeline_Phase) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *ProtoCeremonyTimeline_Phase) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProtoCeremonyTimeline_Phase) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ProtoCeremonyTimeline_Phase) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ProtoCeremonyTimeline_Phase) GetScheduled() int64 {
+	if x != nil {
+		return x.Scheduled
+	}
+	return 0
+}
+
+type ProtoValidationExplanation_Session struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FlipsToSolve     uint32  `protobuf:"varint,1,opt,name=flipsToSolve,proto3" json:"flipsToSolve,omitempty"`
+	MissedAnswers    uint32  `protobuf:"varint,2,opt,name=missedAnswers,proto3" json:"missedAnswers,omitempty"`
+	QualifiedFlips   uint32  `protobuf:"varint,3,opt,name=qualifiedFlips,proto3" json:"qualifiedFlips,omitempty"`
+	Point            float32 `protobuf:"fixed32,4,opt,name=point,proto3" json:"point,omitempty"`
+	Score            float32 `protobuf:"fixed32,5,opt,name=score,proto3" json:"score,omitempty"`
+	NoAnswers        bool    `protobuf:"varint,6,opt,name=noAnswers,proto3" json:"noAnswers,omitempty"`
+	NoQualifiedFlips bool    `protobuf:"varint,7,opt,name=noQualifiedFlips,proto3" json:"noQualifiedFlips,omitempty"`
+}
+
+func (x *ProtoValidationExplanation_Session) Reset() {
+	*x = ProtoValidationExplanation_Session{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoValidationExplanation_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoValidationExplanation_Session) ProtoMessage() {}
+
+func (x *ProtoValidationExplanation_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoValidationExplanation_Session.ProtoReflect.Descriptor instead.
+func (*ProtoValidationExplanation_Session) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{68, 0}
+}
+
+func (x *ProtoValidationExplanation_Session) GetFlipsToSolve() uint32 {
+	if x != nil {
+		return x.FlipsToSolve
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation_Session) GetMissedAnswers() uint32 {
+	if x != nil {
+		return x.MissedAnswers
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation_Session) GetQualifiedFlips() uint32 {
+	if x != nil {
+		return x.QualifiedFlips
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation_Session) GetPoint() float32 {
+	if x != nil {
+		return x.Point
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation_Session) GetScore() float32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *ProtoValidationExplanation_Session) GetNoAnswers() bool {
+	if x != nil {
+		return x.NoAnswers
+	}
+	return false
+}
+
+func (x *ProtoValidationExplanation_Session) GetNoQualifiedFlips() bool {
+	if x != nil {
+		return x.NoQualifiedFlips
+	}
+	return false
+}
+
+type ProtoMultiSendAttachment_Payment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	To     []byte `protobuf:"bytes,1,opt,name=to,proto3" json:"to,omitempty"`
+	Amount []byte `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ProtoMultiSendAttachment_Payment) Reset() {
+	*x = ProtoMultiSendAttachment_Payment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_models_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProtoMultiSendAttachment_Payment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProtoMultiSendAttachment_Payment) ProtoMessage() {}
+
+func (x *ProtoMultiSendAttachment_Payment) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_models_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProtoMultiSendAttachment_Payment.ProtoReflect.Descriptor instead.
+func (*ProtoMultiSendAttachment_Payment) Descriptor() ([]byte, []int) {
+	return file_protobuf_models_proto_rawDescGZIP(), []int{84, 0}
+}
+
+func (x *ProtoMultiSendAttachment_Payment) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ProtoMultiSendAttachment_Payment) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+var File_protobuf_models_proto protoreflect.FileDescriptor
+
+var file_protobuf_models_proto_rawDesc = []byte{
+	0x0a, 0x15, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x22,
+	0xd6, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x52, 0x6c, 0x70, 0x1a, 0xd8, 0x01,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xe6, 0x06, 0x0a, 0x10, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x49, 0x0a,
+	0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x64, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x40, 0x0a, 0x0b, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x0b, 0x65,
+	0x6d, 0x70, 0x74, 0x79, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0xf8, 0x03, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74,
+	0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x70,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f,
+	0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x69,
+	0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x6c, 0x69,
+	0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6f, 0x66,
+	0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x78, 0x42,
+	0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x74, 0x78, 0x42, 0x6c,
+	0x6f, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x65,
+	0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x65,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x43, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x43, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0xc9, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x22, 0x4e, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x6f, 0x64, 0x79, 0x12, 0x3c, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x22, 0xe3, 0x01,
+	0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x61, 0x6c, 0x12, 0x33, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x2e, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x7a, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x6f, 0x64, 0x79, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72,
+	0x6f, 0x6f, 0x66, 0x22, 0x69, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x70, 0x66, 0x73,
+	0x46, 0x6c, 0x69, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0xda,
+	0x03, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x76,
+	0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x40, 0x0a, 0x0a, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x0a, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x13, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67,
+	0x61, 0x74, 0x65, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x43, 0x0a,
+	0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x72,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x1a, 0x65, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x60, 0x0a, 0x0a, 0x56, 0x6f, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75,
+	0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x73, 0x22, 0x2f, 0x0a, 0x15, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x57, 0x65, 0x61, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x47, 0x0a, 0x15,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x03, 0x69, 0x64, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c,
+	0x69, 0x70, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x6b, 0x65, 0x79,
+	0x73, 0x22, 0x3e, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0xf8, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x48, 0x61, 0x6e, 0x64, 0x73,
+	0x68, 0x61, 0x6b, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x70, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x6c, 0x64, 0x47,
+	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x6f, 0x6c,
+	0x64, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x38, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x73, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70,
+	0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0xcb, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x12, 0x4d, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66,
+	0x66, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44,
+	0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x1a, 0x62, 0x0a, 0x16, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x66, 0x66, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x1a, 0x32, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x8a, 0x02, 0x0a, 0x15, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63, 0x68, 0x49, 0x64, 0x12, 0x3b, 0x0a, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x6f, 0x73, 0x73, 0x69,
+	0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x99, 0x01, 0x0a, 0x05, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x30, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x04, 0x63, 0x65, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x43, 0x65, 0x72, 0x74, 0x52, 0x04, 0x63, 0x65, 0x72,
+	0x74, 0x12, 0x32, 0x0a, 0x04, 0x64, 0x69, 0x66, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x66, 0x66, 0x52,
+	0x04, 0x64, 0x69, 0x66, 0x66, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x32, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50,
+	0x72, 0x6f, 0x6f, 0x66, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x32, 0x0a,
+	0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0xa6, 0x02, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x12,
+	0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65,
+	0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x6c, 0x73,
+	0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x62, 0x6c, 0x73, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xaa, 0x01,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x76, 0x6f, 0x74, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x5a, 0x0a, 0x1a,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x22, 0x51, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x47, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x61, 0x6e,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x89, 0x01, 0x0a, 0x09,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x3a, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0x2e, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4d, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x72, 0x6f, 0x6f, 0x74, 0x22, 0xfe, 0x01, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x4b, 0x65, 0x79, 0x73,
+	0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x1a, 0x82, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x68, 0x61, 0x72, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x53, 0x68, 0x61, 0x72, 0x64,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x3b, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x75,
+	0x6c, 0x6c, 0x50, 0x75, 0x73, 0x68, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x22, 0x73, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x44, 0x62, 0x12, 0x10, 0x0a,
+	0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x22, 0x46, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x44, 0x62, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x87, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x44, 0x62, 0x12, 0x37, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x44, 0x62, 0x2e, 0x41, 0x6e, 0x73, 0x77,
+	0x65, 0x72, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x1a, 0x3c, 0x0a, 0x06, 0x41,
+	0x6e, 0x73, 0x77, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x22, 0x55, 0x0a, 0x0f, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x9b, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x02, 0x74, 0x78,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x02, 0x74, 0x78, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47,
+	0x61, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xbf,
+	0x01, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x45, 0x0a, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74,
+	0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79,
+	0x52, 0x0a, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x69, 0x65, 0x73, 0x1a, 0x42, 0x0a, 0x08,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0xd4, 0x01, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x6c, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4f, 0x6c, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x77, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0a, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4e, 0x65, 0x77, 0x12,
+	0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4f, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4f, 0x6c, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x4e, 0x65, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x4e, 0x65, 0x77, 0x22, 0xa6, 0x02, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x12, 0x35, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70, 0x6f,
+	0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x1a, 0xb8, 0x01, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65,
+	0x22, 0x6d, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x75, 0x6e, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69,
+	0x64, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22,
+	0x48, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x41, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x6e, 0x64, 0x22, 0x71, 0x0a, 0x19, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x4c, 0x6f, 0x6e, 0x67, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x61, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x61, 0x6c, 0x74, 0x22, 0x41, 0x0a, 0x19,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x46, 0x6c, 0x69, 0x70, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x22,
+	0x35, 0x0a, 0x1b, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x27, 0x0a, 0x13, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x42,
+	0x75, 0x72, 0x6e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22,
+	0x32, 0x0a, 0x1c, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x2d, 0x0a, 0x19, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63,
+	0x69, 0x64, 0x22, 0xa1, 0x04, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x4f,
+	0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x48, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x52, 0x0a, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x73, 0x1a, 0x93, 0x01, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x52, 0x65, 0x6e,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x75,
+	0x6e, 0x70, 0x61, 0x69, 0x64, 0x52, 0x65, 0x6e, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x1a,
+	0x94, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x4c, 0x6f,
+	0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x75, 0x6e,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x22, 0xf5, 0x07, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74,
+	0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12,
+	0x35, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52,
+	0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x3d, 0x0a, 0x08, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52,
+	0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x52, 0x07,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c,
+	0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42,
+	0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65,
+	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x13, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x53, 0x75, 0x62, 0x6d,
+	0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10, 0x61, 0x6e, 0x73,
+	0x77, 0x65, 0x72, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x1a, 0x2c, 0x0a,
+	0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36, 0x0a, 0x06, 0x54,
+	0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
+	0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xa5,
+	0x07, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f,
+	0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x6e, 0x65, 0x78,
+	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53, 0x65,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x53,
+	0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x50, 0x65,
+	0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x50,
+	0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42,
+	0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11,
+	0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69,
+	0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54,
+	0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47,
+	0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x6d, 0x69, 0x73, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x69, 0x73,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x0c, 0x6d, 0x69, 0x73, 0x62, 0x65, 0x68,
+	0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f,
+	0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x46, 0x0a, 0x0f, 0x77, 0x6f,
+	0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72,
+	0x79, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x12, 0x4e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44,
+	0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x6f,
+	0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x52, 0x13, 0x6e,
+	0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x72, 0x79, 0x1a, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x69, 0x73, 0x62, 0x65,
+	0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x22, 0x8c, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75,
+	0x62, 0x4b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50,
+	0x75, 0x62, 0x4b, 0x65, 0x79, 0x22, 0x3e, 0x0a, 0x1e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x12, 0x4f, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x2e, 0x44, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x22,
+	0x3c, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x65, 0x6c,
+	0x61, 0x79, 0x65, 0x64, 0x50, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0x9c, 0x17,
+	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64,
+	0x12, 0x3b, 0x0a, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x47,
+	0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x52, 0x06, 0x67, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x4d, 0x0a,
+	0x0c, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x08,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65,
+	0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x45,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x5d, 0x0a, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65,
+	0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x52, 0x12, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x69, 0x65, 0x73, 0x12, 0x55, 0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x1a, 0xd7, 0x06, 0x0a, 0x06,
+	0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x2e, 0x0a, 0x12,
+	0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x6f, 0x64, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x67, 0x6f,
+	0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x64,
+	0x73, 0x53, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x77, 0x6f, 0x72,
+	0x64, 0x73, 0x53, 0x65, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65,
+	0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66,
+	0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x76, 0x72, 0x66, 0x50,
+	0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x76, 0x72, 0x66, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x11, 0x67, 0x6f, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x1d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6e,
+	0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x69,
+	0x61, 0x6c, 0x54, 0x78, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x43, 0x6e, 0x74, 0x57, 0x69, 0x74, 0x68, 0x6f, 0x75, 0x74, 0x43, 0x65, 0x72,
+	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x61, 0x6c, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20,
+	0x03, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x47, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x6d, 0x69,
+	0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x47, 0x6c, 0x6f, 0x62, 0x61, 0x6c, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x52, 0x0c, 0x6d, 0x69, 0x73,
+	0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x6d, 0x69, 0x6e,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x50, 0x72, 0x6f, 0x70,
+	0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x46, 0x0a,
+	0x0f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x72, 0x79, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x4e, 0x0a, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72,
+	0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x52, 0x13, 0x6e, 0x65, 0x78, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x72, 0x79, 0x1a, 0x2c, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x1a, 0x82, 0x02, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e,
+	0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x55, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x40, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x1a, 0xa6, 0x08, 0x0a, 0x08, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c,
+	0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0f, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x46, 0x6c, 0x69, 0x70, 0x50, 0x6f, 0x69,
+	0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x72,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70,
+	0x73, 0x12, 0x40, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x46, 0x6c, 0x69, 0x70, 0x52, 0x05, 0x66, 0x6c,
+	0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x48, 0x0a, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x65, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x2e, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x52, 0x08, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65,
+	0x73, 0x12, 0x47, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x72, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65,
+	0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x70, 0x6f, 0x63, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x53,
+	0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x10,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x74, 0x65, 0x72,
+	0x1a, 0x2c, 0x0a, 0x04, 0x46, 0x6c, 0x69, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x61, 0x69, 0x72, 0x1a, 0x36,
+	0x0a, 0x06, 0x54, 0x78, 0x41, 0x64, 0x64, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x59, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x20, 0x0a, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x1a, 0x9c, 0x01, 0x0a, 0x10, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x73, 0x50, 0x75, 0x62, 0x4b, 0x65, 0x79,
+	0x1a, 0x3a, 0x0a, 0x10, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x4b, 0x65, 0x79, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x49, 0x0a, 0x1b,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x61, 0x6c, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4f, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x44, 0x65, 0x70, 0x6c, 0x6f, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x74,
+	0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x43, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x36, 0x0a, 0x20, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x61, 0x72, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73,
+	0x22, 0x42, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x54, 0x6f,
+	0x49, 0x70, 0x66, 0x73, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x69, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04,
+	0x73, 0x69, 0x7a, 0x65, 0x22, 0x48, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4c, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x64, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x73, 0x22, 0x40,
+	0x0a, 0x22, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x76, 0x0a, 0x22, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x69, 0x73, 0x62, 0x65, 0x68, 0x61,
+	0x76, 0x69, 0x6f, 0x72, 0x45, 0x76, 0x69, 0x64, 0x65, 0x6e, 0x63, 0x65, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x31, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x31, 0x12,
+	0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x32, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x32, 0x22, 0x4d, 0x0a, 0x1d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x42, 0x6c, 0x73, 0x4b, 0x65, 0x79, 0x41,
+	0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62,
+	0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x4b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0xd0, 0x03, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x72,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x1a,
+	0xc0, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73,
+	0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67,
+	0x61, 0x73, 0x43, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x3a,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52,
+	0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x03, 0x66, 0x65, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x65,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e,
+	0x65, 0x64, 0x1a, 0x36, 0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x13, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03,
+	0x63, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xb4, 0x02, 0x0a, 0x10, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x73, 0x12, 0x3a,
+	0x0a, 0x03, 0x54, 0x78, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x6f,
+	0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72,
+	0x65, 0x64, 0x54, 0x78, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72,
+	0x72, 0x65, 0x64, 0x54, 0x78, 0x52, 0x03, 0x54, 0x78, 0x73, 0x1a, 0xe3, 0x01, 0x0a, 0x0f, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x54, 0x78, 0x12, 0x12,
+	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
+	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x46,
+	0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65,
+	0x22, 0x57, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x61, 0x76, 0x65, 0x64, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x99, 0x01, 0x0a, 0x11, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x40, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x1a, 0x42, 0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x75,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x75, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x65, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70,
+	0x6f, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x66, 0x65, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x74, 0x22, 0xf8, 0x02, 0x0a,
+	0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x42,
+	0x75, 0x72, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x66, 0x65, 0x65, 0x42,
+	0x75, 0x72, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69,
+	0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12,
+	0x57, 0x0a, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x75,
+	0x6d, 0x6d, 0x61, 0x72, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xf7, 0x01, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x50, 0x65, 0x72, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12,
+	0x2c, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x61, 0x6c, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65,
+	0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x4d,
+	0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x6e,
+	0x64, 0x22, 0x46, 0x0a, 0x26, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x22, 0xdb, 0x01, 0x0a, 0x15, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x6c,
+	0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x3b, 0x0a, 0x06, 0x70, 0x68, 0x61,
+	0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6d, 0x6f, 0x64, 0x65,
+	0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79,
+	0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x52, 0x06,
+	0x70, 0x68, 0x61, 0x73, 0x65, 0x73, 0x1a, 0x6f, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0xde, 0x06, 0x0a, 0x1a, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x70, 0x72, 0x65, 0x76, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x65,
+	0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6e, 0x65,
+	0x77, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69,
+	0x73, 0x73, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x46,
+	0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x69, 0x70,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x61, 0x64, 0x65, 0x46, 0x6c, 0x69,
+	0x70, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x6c,
+	0x69, 0x70, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x62, 0x61, 0x64,
+	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x62, 0x61, 0x64, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0f, 0x62, 0x61, 0x64, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x40, 0x0a, 0x05, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x05, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x12, 0x3e, 0x0a, 0x04, 0x6c, 0x6f, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x6c, 0x6f,
+	0x6e, 0x67, 0x12, 0x30, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46,
+	0x6c, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x63, 0x6f, 0x72, 0x65, 0x1a, 0xf1, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x54, 0x6f, 0x53, 0x6f, 0x6c, 0x76, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x54, 0x6f, 0x53,
+	0x6f, 0x6c, 0x76, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64, 0x41, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x69, 0x73,
+	0x73, 0x65, 0x64, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x71, 0x75,
+	0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69,
+	0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x02, 0x52, 0x05, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x6e, 0x6f, 0x41, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x6e, 0x6f, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6e, 0x6f, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x22, 0x54, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x57, 0x6f, 0x72, 0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x4e,
+	0x0a, 0x24, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x6f, 0x72,
+	0x64, 0x73, 0x44, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x72, 0x79, 0x41, 0x74, 0x74, 0x61,
+	0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x22, 0x99,
+	0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x54, 0x72, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67,
+	0x46, 0x6c, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x50, 0x61, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6e, 0x73, 0x77, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x61, 0x6e,
+	0x73, 0x77, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x05, 0x67, 0x72, 0x61, 0x64, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x61, 0x64, 0x64,
+	0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xff, 0x02, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x41, 0x70, 0x69, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1e, 0x0a,
+	0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x63, 0x6f, 0x69, 0x6e, 0x62, 0x61, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x72,
+	0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x6f, 0x6f, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x70, 0x66, 0x73, 0x43,
+	0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x69, 0x70, 0x66, 0x73, 0x43, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x69,
+	0x73, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
+	0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f,
+	0x66, 0x66, 0x6c, 0x69, 0x6e, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x30, 0x0a, 0x1a, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x41, 0x70, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0xc1, 0x02, 0x0a, 0x13,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61, 0x78,
+	0x46, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46,
+	0x65, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x73, 0x65, 0x64, 0x46, 0x65,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22,
+	0x28, 0x0a, 0x16, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x52, 0x61, 0x77, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x78, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x78, 0x22, 0x2d, 0x0a, 0x17, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x41, 0x70, 0x69, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x33, 0x0a, 0x17, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x41, 0x70, 0x69, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xae, 0x02,
+	0x0a, 0x10, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
+	0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x72, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x61,
+	0x64, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d,
+	0x61, 0x64, 0x65, 0x46, 0x6c, 0x69, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x6c, 0x69,
+	0x6e, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6f, 0x6e, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61, 0x6c, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x64,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6c, 0x69, 0x70,
+	0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x69, 0x70, 0x73, 0x22, 0x93,
+	0x01, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x22, 0x7b, 0x0a, 0x15, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x41, 0x70, 0x69,
+	0x43, 0x65, 0x72, 0x65, 0x6d, 0x6f, 0x6e, 0x79, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x65, 0x70,
+	0x6f, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x93, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x44,
+	0x0a, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x53, 0x65, 0x6e, 0x64, 0x41, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65,
+	0x6e, 0x74, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x1a, 0x31, 0x0a, 0x07, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_models_proto_rawDescOnce sync.Once
+	file_protobuf_models_proto_rawDescData = file_protobuf_models_proto_rawDesc
+)
+
+func file_protobuf_models_proto_rawDescGZIP() []byte {
+	file_protobuf_models_proto_rawDescOnce.Do(func() {
+		file_protobuf_models_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_models_proto_rawDescData)
+	})
+	return file_protobuf_models_proto_rawDescData
+}
+
+var file_protobuf_models_proto_msgTypes = make([]protoimpl.MessageInfo, 126)
+var file_protobuf_models_proto_goTypes = []interface{}{
+	(*ProtoTransaction)(nil),                              // 0: models.ProtoTransaction
+	(*ProtoBlockHeader)(nil),                              // 1: models.ProtoBlockHeader
+	(*ProtoBlockBody)(nil),                                // 2: models.ProtoBlockBody
+	(*ProtoBlock)(nil),                                    // 3: models.ProtoBlock
+	(*ProtoBlockProposal)(nil),                            // 4: models.ProtoBlockProposal
+	(*ProtoIpfsFlip)(nil),                                 // 5: models.ProtoIpfsFlip
+	(*ProtoBlockCert)(nil),                                // 6: models.ProtoBlockCert
+	(*ProtoWeakCertificates)(nil),                         // 7: models.ProtoWeakCertificates
+	(*ProtoTransactionIndex)(nil),                         // 8: models.ProtoTransactionIndex
+	(*ProtoFlipPrivateKeys)(nil),                          // 9: models.ProtoFlipPrivateKeys
+	(*ProtoProfile)(nil),                                  // 10: models.ProtoProfile
+	(*ProtoHandshake)(nil),                                // 11: models.ProtoHandshake
+	(*ProtoMsg)(nil),                                      // 12: models.ProtoMsg
+	(*ProtoIdentityStateDiff)(nil),                        // 13: models.ProtoIdentityStateDiff
+	(*ProtoSnapshotBlock)(nil),                            // 14: models.ProtoSnapshotBlock
+	(*ProtoGossipBlockRange)(nil),                         // 15: models.ProtoGossipBlockRange
+	(*ProtoProposeProof)(nil),                             // 16: models.ProtoProposeProof
+	(*ProtoVote)(nil),                                     // 17: models.ProtoVote
+	(*ProtoGetBlockByHashRequest)(nil),                    // 18: models.ProtoGetBlockByHashRequest
+	(*ProtoGetBlocksRangeRequest)(nil),                    // 19: models.ProtoGetBlocksRangeRequest
+	(*ProtoGetForkBlockRangeRequest)(nil),                 // 20: models.ProtoGetForkBlockRangeRequest
+	(*ProtoFlip)(nil),                                     // 21: models.ProtoFlip
+	(*ProtoFlipKey)(nil),                                  // 22: models.ProtoFlipKey
+	(*ProtoManifest)(nil),                                 // 23: models.ProtoManifest
+	(*ProtoPrivateFlipKeysPackage)(nil),                   // 24: models.ProtoPrivateFlipKeysPackage
+	(*ProtoPullPushHash)(nil),                             // 25: models.ProtoPullPushHash
+	(*ProtoSnapshotManifestDb)(nil),                       // 26: models.ProtoSnapshotManifestDb
+	(*ProtoShortAnswerDb)(nil),                            // 27: models.ProtoShortAnswerDb
+	(*ProtoAnswersDb)(nil),                                // 28: models.ProtoAnswersDb
+	(*ProtoBurntCoins)(nil),                               // 29: models.ProtoBurntCoins
+	(*ProtoSavedTransaction)(nil),                         // 30: models.ProtoSavedTransaction
+	(*ProtoActivityMonitor)(nil),                          // 31: models.ProtoActivityMonitor
+	(*ProtoBalanceChange)(nil),                            // 32: models.ProtoBalanceChange
+	(*ProtoEpochCheckpoint)(nil),                          // 33: models.ProtoEpochCheckpoint
+	(*ProtoCheckpointAnnouncement)(nil),                   // 34: models.ProtoCheckpointAnnouncement
+	(*ProtoShortAnswerAttachment)(nil),                    // 35: models.ProtoShortAnswerAttachment
+	(*ProtoLongAnswerAttachment)(nil),                     // 36: models.ProtoLongAnswerAttachment
+	(*ProtoFlipSubmitAttachment)(nil),                     // 37: models.ProtoFlipSubmitAttachment
+	(*ProtoOnlineStatusAttachment)(nil),                   // 38: models.ProtoOnlineStatusAttachment
+	(*ProtoBurnAttachment)(nil),                           // 39: models.ProtoBurnAttachment
+	(*ProtoChangeProfileAttachment)(nil),                  // 40: models.ProtoChangeProfileAttachment
+	(*ProtoDeleteFlipAttachment)(nil),                     // 41: models.ProtoDeleteFlipAttachment
+	(*ProtoStateAccount)(nil),                             // 42: models.ProtoStateAccount
+	(*ProtoStateIdentity)(nil),                            // 43: models.ProtoStateIdentity
+	(*ProtoStateGlobal)(nil),                              // 44: models.ProtoStateGlobal
+	(*ProtoStateApprovedIdentity)(nil),                    // 45: models.ProtoStateApprovedIdentity
+	(*ProtoStateIdentityStatusSwitch)(nil),                // 46: models.ProtoStateIdentityStatusSwitch
+	(*ProtoStateDelegationSwitch)(nil),                    // 47: models.ProtoStateDelegationSwitch
+	(*ProtoStateDelayedPenalties)(nil),                    // 48: models.ProtoStateDelayedPenalties
+	(*ProtoPredefinedState)(nil),                          // 49: models.ProtoPredefinedState
+	(*ProtoCallContractAttachment)(nil),                   // 50: models.ProtoCallContractAttachment
+	(*ProtoDeployContractAttachment)(nil),                 // 51: models.ProtoDeployContractAttachment
+	(*ProtoTerminateContractAttachment)(nil),              // 52: models.ProtoTerminateContractAttachment
+	(*ProtoStoreToIpfsAttachment)(nil),                    // 53: models.ProtoStoreToIpfsAttachment
+	(*ProtoLockStakeAttachment)(nil),                      // 54: models.ProtoLockStakeAttachment
+	(*ProtoChangeBlockGasLimitAttachment)(nil),            // 55: models.ProtoChangeBlockGasLimitAttachment
+	(*ProtoMisbehaviorEvidenceAttachment)(nil),            // 56: models.ProtoMisbehaviorEvidenceAttachment
+	(*ProtoRegisterBlsKeyAttachment)(nil),                 // 57: models.ProtoRegisterBlsKeyAttachment
+	(*ProtoTxReceipts)(nil),                               // 58: models.ProtoTxReceipts
+	(*ProtoTxReceiptIndex)(nil),                           // 59: models.ProtoTxReceiptIndex
+	(*ProtoDeferredTxs)(nil),                              // 60: models.ProtoDeferredTxs
+	(*ProtoSavedEvent)(nil),                               // 61: models.ProtoSavedEvent
+	(*ProtoUpgradeVotes)(nil),                             // 62: models.ProtoUpgradeVotes
+	(*ProtoEpochStats)(nil),                               // 63: models.ProtoEpochStats
+	(*ProtoEpochSummary)(nil),                             // 64: models.ProtoEpochSummary
+	(*ProtoMinerPerformance)(nil),                         // 65: models.ProtoMinerPerformance
+	(*ProtoChangeProposerThresholdAttachment)(nil),        // 66: models.ProtoChangeProposerThresholdAttachment
+	(*ProtoCeremonyTimeline)(nil),                         // 67: models.ProtoCeremonyTimeline
+	(*ProtoValidationExplanation)(nil),                    // 68: models.ProtoValidationExplanation
+	(*ProtoWordsDictionary)(nil),                          // 69: models.ProtoWordsDictionary
+	(*ProtoChangeWordsDictionaryAttachment)(nil),          // 70: models.ProtoChangeWordsDictionaryAttachment
+	(*ProtoTrainingFlip)(nil),                             // 71: models.ProtoTrainingFlip
+	(*ProtoPeerAddrs)(nil),                                // 72: models.ProtoPeerAddrs
+	(*ProtoApiEmpty)(nil),                                 // 73: models.ProtoApiEmpty
+	(*ProtoApiBlockRequest)(nil),                          // 74: models.ProtoApiBlockRequest
+	(*ProtoApiBlock)(nil),                                 // 75: models.ProtoApiBlock
+	(*ProtoApiTransactionRequest)(nil),                    // 76: models.ProtoApiTransactionRequest
+	(*ProtoApiTransaction)(nil),                           // 77: models.ProtoApiTransaction
+	(*ProtoApiRawTransaction)(nil),                        // 78: models.ProtoApiRawTransaction
+	(*ProtoApiTransactionHash)(nil),                       // 79: models.ProtoApiTransactionHash
+	(*ProtoApiIdentityRequest)(nil),                       // 80: models.ProtoApiIdentityRequest
+	(*ProtoApiIdentity)(nil),                              // 81: models.ProtoApiIdentity
+	(*ProtoApiEpoch)(nil),                                 // 82: models.ProtoApiEpoch
+	(*ProtoApiCeremonyEvent)(nil),                         // 83: models.ProtoApiCeremonyEvent
+	(*ProtoMultiSendAttachment)(nil),                      // 84: models.ProtoMultiSendAttachment
+	(*ProtoTransaction_Data)(nil),                         // 85: models.ProtoTransaction.Data
+	(*ProtoBlockHeader_Proposed)(nil),                     // 86: models.ProtoBlockHeader.Proposed
+	(*ProtoBlockHeader_Empty)(nil),                        // 87: models.ProtoBlockHeader.Empty
+	(*ProtoBlockProposal_Data)(nil),                       // 88: models.ProtoBlockProposal.Data
+	(*ProtoBlockCert_Signature)(nil),                      // 89: models.ProtoBlockCert.Signature
+	(*ProtoBlockCert_VoterGroup)(nil),                     // 90: models.ProtoBlockCert.VoterGroup
+	(*ProtoIdentityStateDiff_IdentityStateDiffValue)(nil), // 91: models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	(*ProtoSnapshotBlock_KeyValue)(nil),                   // 92: models.ProtoSnapshotBlock.KeyValue
+	(*ProtoGossipBlockRange_Block)(nil),                   // 93: models.ProtoGossipBlockRange.Block
+	(*ProtoProposeProof_Data)(nil),                        // 94: models.ProtoProposeProof.Data
+	(*ProtoVote_Data)(nil),                                // 95: models.ProtoVote.Data
+	(*ProtoFlipKey_Data)(nil),                             // 96: models.ProtoFlipKey.Data
+	(*ProtoPrivateFlipKeysPackage_Data)(nil),              // 97: models.ProtoPrivateFlipKeysPackage.Data
+	(*ProtoAnswersDb_Answer)(nil),                         // 98: models.ProtoAnswersDb.Answer
+	(*ProtoActivityMonitor_Activity)(nil),                 // 99: models.ProtoActivityMonitor.Activity
+	(*ProtoEpochCheckpoint_Data)(nil),                     // 100: models.ProtoEpochCheckpoint.Data
+	(*ProtoStateAccount_ProtoContractData)(nil),           // 101: models.ProtoStateAccount.ProtoContractData
+	(*ProtoStateAccount_ProtoStakeLock)(nil),              // 102: models.ProtoStateAccount.ProtoStakeLock
+	(*ProtoStateIdentity_Flip)(nil),                       // 103: models.ProtoStateIdentity.Flip
+	(*ProtoStateIdentity_TxAddr)(nil),                     // 104: models.ProtoStateIdentity.TxAddr
+	(*ProtoStateIdentity_Inviter)(nil),                    // 105: models.ProtoStateIdentity.Inviter
+	(*ProtoStateGlobal_ProtoMisbehavior)(nil),             // 106: models.ProtoStateGlobal.ProtoMisbehavior
+	(*ProtoStateDelegationSwitch_Delegation)(nil),         // 107: models.ProtoStateDelegationSwitch.Delegation
+	(*ProtoPredefinedState_Global)(nil),                   // 108: models.ProtoPredefinedState.Global
+	(*ProtoPredefinedState_StatusSwitch)(nil),             // 109: models.ProtoPredefinedState.StatusSwitch
+	(*ProtoPredefinedState_Account)(nil),                  // 110: models.ProtoPredefinedState.Account
+	(*ProtoPredefinedState_Identity)(nil),                 // 111: models.ProtoPredefinedState.Identity
+	(*ProtoPredefinedState_ApprovedIdentity)(nil),         // 112: models.ProtoPredefinedState.ApprovedIdentity
+	(*ProtoPredefinedState_ContractKeyValue)(nil),         // 113: models.ProtoPredefinedState.ContractKeyValue
+	(*ProtoPredefinedState_Account_ContractData)(nil),     // 114: models.ProtoPredefinedState.Account.ContractData
+	(*ProtoPredefinedState_Identity_Flip)(nil),            // 115: models.ProtoPredefinedState.Identity.Flip
+	(*ProtoPredefinedState_Identity_TxAddr)(nil),          // 116: models.ProtoPredefinedState.Identity.TxAddr
+	(*ProtoPredefinedState_Identity_Inviter)(nil),         // 117: models.ProtoPredefinedState.Identity.Inviter
+	(*ProtoTxReceipts_ProtoTxReceipt)(nil),                // 118: models.ProtoTxReceipts.ProtoTxReceipt
+	(*ProtoTxReceipts_ProtoEvent)(nil),                    // 119: models.ProtoTxReceipts.ProtoEvent
+	(*ProtoDeferredTxs_ProtoDeferredTx)(nil),              // 120: models.ProtoDeferredTxs.ProtoDeferredTx
+	(*ProtoUpgradeVotes_ProtoUpgradeVote)(nil),            // 121: models.ProtoUpgradeVotes.ProtoUpgradeVote
+	(*ProtoEpochSummary_ProtoStateCount)(nil),             // 122: models.ProtoEpochSummary.ProtoStateCount
+	(*ProtoCeremonyTimeline_Phase)(nil),                   // 123: models.ProtoCeremonyTimeline.Phase
+	(*ProtoValidationExplanation_Session)(nil),            // 124: models.ProtoValidationExplanation.Session
+	(*ProtoMultiSendAttachment_Payment)(nil),              // 125: models.ProtoMultiSendAttachment.Payment
+}
+var file_protobuf_models_proto_depIdxs = []int32{
+	85,  // 0: models.ProtoTransaction.data:type_name -> models.ProtoTransaction.Data
+	86,  // 1: models.ProtoBlockHeader.proposedHeader:type_name -> models.ProtoBlockHeader.Proposed
+	87,  // 2: models.ProtoBlockHeader.emptyHeader:type_name -> models.ProtoBlockHeader.Empty
+	0,   // 3: models.ProtoBlockBody.transactions:type_name -> models.ProtoTransaction
+	1,   // 4: models.ProtoBlock.header:type_name -> models.ProtoBlockHeader
+	2,   // 5: models.ProtoBlock.body:type_name -> models.ProtoBlockBody
+	88,  // 6: models.ProtoBlockProposal.data:type_name -> models.ProtoBlockProposal.Data
+	89,  // 7: models.ProtoBlockCert.signatures:type_name -> models.ProtoBlockCert.Signature
+	90,  // 8: models.ProtoBlockCert.voterGroups:type_name -> models.ProtoBlockCert.VoterGroup
+	91,  // 9: models.ProtoIdentityStateDiff.values:type_name -> models.ProtoIdentityStateDiff.IdentityStateDiffValue
+	92,  // 10: models.ProtoSnapshotBlock.data:type_name -> models.ProtoSnapshotBlock.KeyValue
+	93,  // 11: models.ProtoGossipBlockRange.blocks:type_name -> models.ProtoGossipBlockRange.Block
+	94,  // 12: models.ProtoProposeProof.data:type_name -> models.ProtoProposeProof.Data
+	95,  // 13: models.ProtoVote.data:type_name -> models.ProtoVote.Data
+	0,   // 14: models.ProtoFlip.transaction:type_name -> models.ProtoTransaction
+	96,  // 15: models.ProtoFlipKey.data:type_name -> models.ProtoFlipKey.Data
+	97,  // 16: models.ProtoPrivateFlipKeysPackage.data:type_name -> models.ProtoPrivateFlipKeysPackage.Data
+	98,  // 17: models.ProtoAnswersDb.answers:type_name -> models.ProtoAnswersDb.Answer
+	0,   // 18: models.ProtoSavedTransaction.tx:type_name -> models.ProtoTransaction
+	99,  // 19: models.ProtoActivityMonitor.activities:type_name -> models.ProtoActivityMonitor.Activity
+	100, // 20: models.ProtoEpochCheckpoint.data:type_name -> models.ProtoEpochCheckpoint.Data
+	33,  // 21: models.ProtoCheckpointAnnouncement.checkpoint:type_name -> models.ProtoEpochCheckpoint
+	101, // 22: models.ProtoStateAccount.contractData:type_name -> models.ProtoStateAccount.ProtoContractData
+	102, // 23: models.ProtoStateAccount.stakeLocks:type_name -> models.ProtoStateAccount.ProtoStakeLock
+	103, // 24: models.ProtoStateIdentity.flips:type_name -> models.ProtoStateIdentity.Flip
+	104, // 25: models.ProtoStateIdentity.invitees:type_name -> models.ProtoStateIdentity.TxAddr
+	105, // 26: models.ProtoStateIdentity.inviter:type_name -> models.ProtoStateIdentity.Inviter
+	106, // 27: models.ProtoStateGlobal.misbehaviors:type_name -> models.ProtoStateGlobal.ProtoMisbehavior
+	69,  // 28: models.ProtoStateGlobal.wordsDictionary:type_name -> models.ProtoWordsDictionary
+	69,  // 29: models.ProtoStateGlobal.nextWordsDictionary:type_name -> models.ProtoWordsDictionary
+	107, // 30: models.ProtoStateDelegationSwitch.delegations:type_name -> models.ProtoStateDelegationSwitch.Delegation
+	108, // 31: models.ProtoPredefinedState.global:type_name -> models.ProtoPredefinedState.Global
+	109, // 32: models.ProtoPredefinedState.statusSwitch:type_name -> models.ProtoPredefinedState.StatusSwitch
+	110, // 33: models.ProtoPredefinedState.accounts:type_name -> models.ProtoPredefinedState.Account
+	111, // 34: models.ProtoPredefinedState.identities:type_name -> models.ProtoPredefinedState.Identity
+	112, // 35: models.ProtoPredefinedState.approvedIdentities:type_name -> models.ProtoPredefinedState.ApprovedIdentity
+	113, // 36: models.ProtoPredefinedState.contractValues:type_name -> models.ProtoPredefinedState.ContractKeyValue
+	17,  // 37: models.ProtoMisbehaviorEvidenceAttachment.vote1:type_name -> models.ProtoVote
+	17,  // 38: models.ProtoMisbehaviorEvidenceAttachment.vote2:type_name -> models.ProtoVote
+	118, // 39: models.ProtoTxReceipts.receipts:type_name -> models.ProtoTxReceipts.ProtoTxReceipt
+	120, // 40: models.ProtoDeferredTxs.Txs:type_name -> models.ProtoDeferredTxs.ProtoDeferredTx
+	121, // 41: models.ProtoUpgradeVotes.votes:type_name -> models.ProtoUpgradeVotes.ProtoUpgradeVote
+	122, // 42: models.ProtoEpochSummary.identities:type_name -> models.ProtoEpochSummary.ProtoStateCount
+	122, // 43: models.ProtoEpochSummary.validationResults:type_name -> models.ProtoEpochSummary.ProtoStateCount
+	123, // 44: models.ProtoCeremonyTimeline.phases:type_name -> models.ProtoCeremonyTimeline.Phase
+	124, // 45: models.ProtoValidationExplanation.short:type_name -> models.ProtoValidationExplanation.Session
+	124, // 46: models.ProtoValidationExplanation.long:type_name -> models.ProtoValidationExplanation.Session
+	125, // 47: models.ProtoMultiSendAttachment.payments:type_name -> models.ProtoMultiSendAttachment.Payment
+	1,   // 48: models.ProtoBlockProposal.Data.header:type_name -> models.ProtoBlockHeader
+	2,   // 49: models.ProtoBlockProposal.Data.body:type_name -> models.ProtoBlockBody
+	1,   // 50: models.ProtoGossipBlockRange.Block.header:type_name -> models.ProtoBlockHeader
+	6,   // 51: models.ProtoGossipBlockRange.Block.cert:type_name -> models.ProtoBlockCert
+	13,  // 52: models.ProtoGossipBlockRange.Block.diff:type_name -> models.ProtoIdentityStateDiff
+	106, // 53: models.ProtoPredefinedState.Global.misbehaviors:type_name -> models.ProtoStateGlobal.ProtoMisbehavior
+	69,  // 54: models.ProtoPredefinedState.Global.wordsDictionary:type_name -> models.ProtoWordsDictionary
+	69,  // 55: models.ProtoPredefinedState.Global.nextWordsDictionary:type_name -> models.ProtoWordsDictionary
+	114, // 56: models.ProtoPredefinedState.Account.contractData:type_name -> models.ProtoPredefinedState.Account.ContractData
+	115, // 57: models.ProtoPredefinedState.Identity.flips:type_name -> models.ProtoPredefinedState.Identity.Flip
+	116, // 58: models.ProtoPredefinedState.Identity.invitees:type_name -> models.ProtoPredefinedState.Identity.TxAddr
+	117, // 59: models.ProtoPredefinedState.Identity.inviter:type_name -> models.ProtoPredefinedState.Identity.Inviter
+	119, // 60: models.ProtoTxReceipts.ProtoTxReceipt.events:type_name -> models.ProtoTxReceipts.ProtoEvent
+	61,  // [61:61] is the sub-list for method output_type
+	61,  // [61:61] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_models_proto_init() }
+func file_protobuf_models_proto_init() {
+	if File_protobuf_models_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_models_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockBody); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockProposal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoIpfsFlip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockCert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoWeakCertificates); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTransactionIndex); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipPrivateKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoHandshake); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMsg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoIdentityStateDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSnapshotBlock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGossipBlockRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoProposeProof); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetBlockByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetBlocksRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGetForkBlockRangeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPrivateFlipKeysPackage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPullPushHash); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSnapshotManifestDb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoShortAnswerDb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoAnswersDb); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBurntCoins); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSavedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoActivityMonitor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBalanceChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEpochCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoCheckpointAnnouncement); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoShortAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6796,8 +10572,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader); i {
+		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoLongAnswerAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6808,8 +10584,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockBody); i {
+		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipSubmitAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6820,8 +10596,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlock); i {
+		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoOnlineStatusAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6832,8 +10608,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockProposal); i {
+		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBurnAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6844,8 +10620,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIpfsFlip); i {
+		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoChangeProfileAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6856,8 +10632,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockCert); i {
+		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDeleteFlipAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6868,8 +10644,32 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoWeakCertificates); i {
+		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateIdentity); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateGlobal); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6880,8 +10680,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransactionIndex); i {
+		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateApprovedIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6892,8 +10692,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipPrivateKeys); i {
+		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6904,8 +10704,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProfile); i {
+		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateDelegationSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6916,8 +10716,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoHandshake); i {
+		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateDelayedPenalties); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6928,8 +10728,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoMsg); i {
+		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6940,8 +10740,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIdentityStateDiff); i {
+		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoCallContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6952,8 +10752,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotBlock); i {
+		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDeployContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6964,8 +10764,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGossipBlockRange); i {
+		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTerminateContractAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6976,8 +10776,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProposeProof); i {
+		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStoreToIpfsAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6988,8 +10788,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoVote); i {
+		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoLockStakeAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7000,8 +10800,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGetBlockByHashRequest); i {
+		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoChangeBlockGasLimitAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7012,8 +10812,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGetBlocksRangeRequest); i {
+		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMisbehaviorEvidenceAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7024,8 +10824,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGetForkBlockRangeRequest); i {
+		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoRegisterBlsKeyAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7036,8 +10836,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlip); i {
+		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTxReceipts); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7048,8 +10848,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipKey); i {
+		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTxReceiptIndex); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7060,8 +10860,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoManifest); i {
+		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDeferredTxs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7072,8 +10872,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPrivateFlipKeysPackage); i {
+		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSavedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7084,8 +10884,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPullPushHash); i {
+		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoUpgradeVotes); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7096,8 +10896,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotManifestDb); i {
+		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEpochStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7108,8 +10908,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoShortAnswerDb); i {
+		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEpochSummary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7120,8 +10920,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoAnswersDb); i {
+		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMinerPerformance); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7132,8 +10932,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBurntCoins); i {
+		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoChangeProposerThresholdAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7144,8 +10944,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSavedTransaction); i {
+		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoCeremonyTimeline); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7156,8 +10956,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor); i {
+		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoValidationExplanation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7168,8 +10968,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoShortAnswerAttachment); i {
+		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoWordsDictionary); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7180,8 +10980,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoLongAnswerAttachment); i {
+		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoChangeWordsDictionaryAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7192,8 +10992,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipSubmitAttachment); i {
+		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTrainingFlip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7204,8 +11004,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoOnlineStatusAttachment); i {
+		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPeerAddrs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7216,8 +11016,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBurnAttachment); i {
+		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiEmpty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7228,8 +11028,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoChangeProfileAttachment); i {
+		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7240,8 +11040,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeleteFlipAttachment); i {
+		file_protobuf_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7252,8 +11052,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateAccount); i {
+		file_protobuf_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7264,8 +11064,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity); i {
+		file_protobuf_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7276,8 +11076,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateGlobal); i {
+		file_protobuf_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiRawTransaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7288,8 +11088,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateApprovedIdentity); i {
+		file_protobuf_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiTransactionHash); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7300,8 +11100,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentityStatusSwitch); i {
+		file_protobuf_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiIdentityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7312,8 +11112,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelegationSwitch); i {
+		file_protobuf_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7324,8 +11124,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelayedPenalties); i {
+		file_protobuf_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiEpoch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7336,8 +11136,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState); i {
+		file_protobuf_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoApiCeremonyEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7348,8 +11148,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoCallContractAttachment); i {
+		file_protobuf_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMultiSendAttachment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7360,8 +11160,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeployContractAttachment); i {
+		file_protobuf_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTransaction_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7372,8 +11172,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTerminateContractAttachment); i {
+		file_protobuf_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockHeader_Proposed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7384,8 +11184,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStoreToIpfsAttachment); i {
+		file_protobuf_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockHeader_Empty); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7396,8 +11196,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts); i {
+		file_protobuf_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockProposal_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7408,8 +11208,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceiptIndex); i {
+		file_protobuf_models_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockCert_Signature); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7420,8 +11220,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeferredTxs); i {
+		file_protobuf_models_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoBlockCert_VoterGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7432,8 +11232,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSavedEvent); i {
+		file_protobuf_models_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7444,8 +11244,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoUpgradeVotes); i {
+		file_protobuf_models_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7456,8 +11256,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTransaction_Data); i {
+		file_protobuf_models_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoGossipBlockRange_Block); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7468,8 +11268,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Proposed); i {
+		file_protobuf_models_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoProposeProof_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7480,8 +11280,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockHeader_Empty); i {
+		file_protobuf_models_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoVote_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7492,8 +11292,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockProposal_Data); i {
+		file_protobuf_models_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoFlipKey_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7504,8 +11304,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoBlockCert_Signature); i {
+		file_protobuf_models_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7516,8 +11316,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoIdentityStateDiff_IdentityStateDiffValue); i {
+		file_protobuf_models_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoAnswersDb_Answer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7528,8 +11328,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoSnapshotBlock_KeyValue); i {
+		file_protobuf_models_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoActivityMonitor_Activity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7540,8 +11340,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoGossipBlockRange_Block); i {
+		file_protobuf_models_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEpochCheckpoint_Data); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7552,8 +11352,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoProposeProof_Data); i {
+		file_protobuf_models_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateAccount_ProtoContractData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7564,8 +11364,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoVote_Data); i {
+		file_protobuf_models_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateAccount_ProtoStakeLock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7576,8 +11376,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoFlipKey_Data); i {
+		file_protobuf_models_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateIdentity_Flip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7588,8 +11388,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPrivateFlipKeysPackage_Data); i {
+		file_protobuf_models_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateIdentity_TxAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7600,8 +11400,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoAnswersDb_Answer); i {
+		file_protobuf_models_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateIdentity_Inviter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7612,8 +11412,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoActivityMonitor_Activity); i {
+		file_protobuf_models_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateGlobal_ProtoMisbehavior); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7624,8 +11424,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateAccount_ProtoContractData); i {
+		file_protobuf_models_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoStateDelegationSwitch_Delegation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7636,8 +11436,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_Flip); i {
+		file_protobuf_models_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Global); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7648,8 +11448,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_TxAddr); i {
+		file_protobuf_models_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7660,8 +11460,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateIdentity_Inviter); i {
+		file_protobuf_models_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Account); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7672,8 +11472,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoStateDelegationSwitch_Delegation); i {
+		file_protobuf_models_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7684,8 +11484,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Global); i {
+		file_protobuf_models_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7696,8 +11496,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_StatusSwitch); i {
+		file_protobuf_models_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_ContractKeyValue); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7708,8 +11508,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Account); i {
+		file_protobuf_models_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Account_ContractData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7720,8 +11520,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity); i {
+		file_protobuf_models_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7732,8 +11532,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_ApprovedIdentity); i {
+		file_protobuf_models_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7744,8 +11544,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_ContractKeyValue); i {
+		file_protobuf_models_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoPredefinedState_Identity_Inviter); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7756,8 +11556,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Account_ContractData); i {
+		file_protobuf_models_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTxReceipts_ProtoTxReceipt); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7768,8 +11568,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_Flip); i {
+		file_protobuf_models_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoTxReceipts_ProtoEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7780,8 +11580,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_TxAddr); i {
+		file_protobuf_models_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoDeferredTxs_ProtoDeferredTx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7792,8 +11592,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoPredefinedState_Identity_Inviter); i {
+		file_protobuf_models_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoUpgradeVotes_ProtoUpgradeVote); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7804,8 +11604,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts_ProtoTxReceipt); i {
+		file_protobuf_models_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoEpochSummary_ProtoStateCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7816,8 +11616,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoTxReceipts_ProtoEvent); i {
+		file_protobuf_models_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoCeremonyTimeline_Phase); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7828,8 +11628,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoDeferredTxs_ProtoDeferredTx); i {
+		file_protobuf_models_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoValidationExplanation_Session); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7840,8 +11640,8 @@ func file_protobuf_models_proto_init() {
 				return nil
 			}
 		}
-		file_protobuf_models_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProtoUpgradeVotes_ProtoUpgradeVote); i {
+		file_protobuf_models_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProtoMultiSendAttachment_Payment); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7859,7 +11659,7 @@ func file_protobuf_models_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_models_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   89,
+			NumMessages:   126,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
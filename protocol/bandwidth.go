@@ -0,0 +1,140 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+)
+
+// MsgTraffic is the traffic of one message type exchanged with a peer
+type MsgTraffic struct {
+	BytesIn  uint64
+	BytesOut uint64
+	MsgsIn   uint64
+	MsgsOut  uint64
+}
+
+// peerTraffic accounts bytes received from and sent to a peer per message code
+type peerTraffic struct {
+	byCode map[uint64]*MsgTraffic
+	mutex  sync.Mutex
+}
+
+func newPeerTraffic() *peerTraffic {
+	return &peerTraffic{
+		byCode: make(map[uint64]*MsgTraffic),
+	}
+}
+
+func (t *peerTraffic) get(code uint64) *MsgTraffic {
+	traffic, ok := t.byCode[code]
+	if !ok {
+		traffic = &MsgTraffic{}
+		t.byCode[code] = traffic
+	}
+	return traffic
+}
+
+func (t *peerTraffic) addIn(code uint64, size int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	traffic := t.get(code)
+	traffic.BytesIn += uint64(size)
+	traffic.MsgsIn++
+}
+
+func (t *peerTraffic) addOut(code uint64, size int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	traffic := t.get(code)
+	traffic.BytesOut += uint64(size)
+	traffic.MsgsOut++
+}
+
+func (t *peerTraffic) snapshot() map[uint64]MsgTraffic {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	result := make(map[uint64]MsgTraffic, len(t.byCode))
+	for code, traffic := range t.byCode {
+		result[code] = *traffic
+	}
+	return result
+}
+
+// rateLimiter is a token bucket which delays the caller until the requested number of bytes fits into the rate,
+// a message larger than the bucket is let through once the bucket is full
+type rateLimiter struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	mutex  sync.Mutex
+	now    func() time.Time
+}
+
+// newRateLimiter creates a limiter of bytesPerSec with one second burst, nil means no limit
+func newRateLimiter(bytesPerSec int) *rateLimiter {
+	if bytesPerSec <= 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:   float64(bytesPerSec),
+		burst:  float64(bytesPerSec),
+		tokens: float64(bytesPerSec),
+		now:    time.Now,
+	}
+}
+
+// reserve takes size bytes from the bucket and returns how long the caller has to wait before transferring them
+func (l *rateLimiter) reserve(size int) time.Duration {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	now := l.now()
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.rate
+		if l.tokens > l.burst {
+			l.tokens = l.burst
+		}
+	}
+	l.last = now
+	need := float64(size)
+	if need > l.burst {
+		need = l.burst
+	}
+	l.tokens -= need
+	if l.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
+
+func (l *rateLimiter) wait(size int) {
+	if l == nil {
+		return
+	}
+	if delay := l.reserve(size); delay > 0 {
+		time.Sleep(delay)
+	}
+}
+
+// PeerStats is the traffic exchanged with a connected peer since the connection was established
+type PeerStats struct {
+	ID          string
+	RemoteAddr  string
+	ConnectedAt time.Time
+	Latency     time.Duration
+	Traffic     map[string]MsgTraffic
+}
+
+func (p *protoPeer) stats() PeerStats {
+	traffic := p.traffic.snapshot()
+	result := PeerStats{
+		ID:          p.ID(),
+		RemoteAddr:  p.RemoteAddr(),
+		ConnectedAt: p.createdAt,
+		Traffic:     make(map[string]MsgTraffic, len(traffic)),
+	}
+	for code, item := range traffic {
+		result.Traffic[msgCodeToString(code)] = item
+	}
+	return result
+}
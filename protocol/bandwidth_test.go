@@ -0,0 +1,60 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_reserve(t *testing.T) {
+	require.Nil(t, newRateLimiter(0))
+	// nil limiter doesn't wait
+	newRateLimiter(-1).wait(1000)
+
+	l := newRateLimiter(1000)
+	now := time.Unix(1600000000, 0)
+	l.now = func() time.Time { return now }
+
+	// the full bucket lets the burst through
+	require.Zero(t, l.reserve(600))
+	require.Zero(t, l.reserve(400))
+
+	// the empty bucket delays bytes until they are refilled by the rate
+	require.Equal(t, 500*time.Millisecond, l.reserve(500))
+	require.Equal(t, time.Second, l.reserve(500))
+
+	// refilled tokens pay off the debt first
+	now = now.Add(time.Second)
+	require.Zero(t, l.reserve(0))
+	require.Equal(t, 500*time.Millisecond, l.reserve(500))
+
+	// the bucket doesn't grow above the burst
+	now = now.Add(time.Hour)
+	require.Zero(t, l.reserve(1000))
+	require.Equal(t, 100*time.Millisecond, l.reserve(100))
+
+	// the message larger than the bucket takes the whole bucket only
+	now = now.Add(time.Hour)
+	require.Zero(t, l.reserve(5000))
+	require.Equal(t, time.Second, l.reserve(1000))
+}
+
+func TestPeerTraffic(t *testing.T) {
+	traffic := newPeerTraffic()
+	traffic.addIn(Push, 10)
+	traffic.addIn(Push, 20)
+	traffic.addOut(Push, 5)
+	traffic.addOut(Pull, 7)
+
+	snapshot := traffic.snapshot()
+	require.Equal(t, map[uint64]MsgTraffic{
+		Push: {BytesIn: 30, BytesOut: 5, MsgsIn: 2, MsgsOut: 1},
+		Pull: {BytesOut: 7, MsgsOut: 1},
+	}, snapshot)
+
+	// the snapshot isn't changed by the further traffic
+	traffic.addIn(Pull, 3)
+	require.Equal(t, MsgTraffic{BytesOut: 7, MsgsOut: 1}, snapshot[Pull])
+	require.Equal(t, MsgTraffic{BytesIn: 3, BytesOut: 7, MsgsIn: 1, MsgsOut: 1}, traffic.snapshot()[Pull])
+}
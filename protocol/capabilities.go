@@ -0,0 +1,53 @@
+package protocol
+
+import "github.com/idena-network/idena-go/config"
+
+// Capabilities are exchanged in the handshake as a bitmap, a feature is used with the peer only if both sides
+// advertise it, so new sub-protocols roll out without bumping the protocol version. Peers of older versions
+// send no bitmap and get the legacy behaviour.
+const (
+	// capabilityFlipKeyDigests means the peer accepts digests of public flip keys and pulls their bodies on request
+	capabilityFlipKeyDigests uint32 = 1 << 0
+	// capabilityZstdCompression means the peer decodes zstd compressed messages
+	capabilityZstdCompression uint32 = 1 << 1
+	// capabilitySnapshotServing means the peer announces snapshot manifests and serves snapshots for fast sync
+	capabilitySnapshotServing uint32 = 1 << 2
+	// 1 << 3 is reserved, it was advertised by nodes aggregating votes with BLS keys
+	// capabilityPeerExchange means the peer shares and accepts addresses of known good peers
+	capabilityPeerExchange uint32 = 1 << 4
+
+	supportedCapabilities = capabilityFlipKeyDigests | capabilityZstdCompression | capabilitySnapshotServing | capabilityPeerExchange
+)
+
+var capabilityNames = []struct {
+	capability uint32
+	name       string
+}{
+	{capabilityFlipKeyDigests, "flipKeyDigests"},
+	{capabilityZstdCompression, "zstdCompression"},
+	{capabilitySnapshotServing, "snapshotServing"},
+	{capabilityPeerExchange, "peerExchange"},
+}
+
+// localCapabilities returns capabilities advertised by the node
+func localCapabilities(cfg config.P2P) uint32 {
+	capabilities := supportedCapabilities
+	if cfg.DisableSnapshotServing {
+		capabilities &^= capabilitySnapshotServing
+	}
+	if cfg.DisablePeerExchange {
+		capabilities &^= capabilityPeerExchange
+	}
+	return capabilities
+}
+
+// CapabilityNames returns names of capabilities set in the bitmap
+func CapabilityNames(capabilities uint32) []string {
+	result := make([]string, 0)
+	for _, item := range capabilityNames {
+		if capabilities&item.capability != 0 {
+			result = append(result, item.name)
+		}
+	}
+	return result
+}
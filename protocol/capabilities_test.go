@@ -0,0 +1,26 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/idena-network/idena-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalCapabilities(t *testing.T) {
+	require.Equal(t, supportedCapabilities, localCapabilities(config.P2P{}))
+
+	capabilities := localCapabilities(config.P2P{DisableSnapshotServing: true, DisablePeerExchange: true})
+	require.Equal(t, []string{"flipKeyDigests", "zstdCompression"}, CapabilityNames(capabilities))
+}
+
+func TestProtoPeer_servesSnapshots(t *testing.T) {
+	local := localCapabilities(config.P2P{DisableSnapshotServing: true})
+	newPeer := func(remote uint32) *protoPeer {
+		return &protoPeer{remoteCapabilities: remote, capabilities: remote & local}
+	}
+
+	require.True(t, newPeer(0).servesSnapshots())
+	require.True(t, newPeer(capabilityFlipKeyDigests|capabilitySnapshotServing).servesSnapshots())
+	require.False(t, newPeer(capabilityFlipKeyDigests|capabilityZstdCompression).servesSnapshots())
+}
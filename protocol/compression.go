@@ -0,0 +1,35 @@
+package protocol
+
+import (
+	"github.com/klauspost/compress/zstd"
+)
+
+const (
+	// minZstdCompressionSize is the size of the message starting from which zstd is used instead of s2
+	// if the peer supports it, zstd compresses better but is slower, so it pays off for large payloads only
+	minZstdCompressionSize = 16 * 1024 // bytes
+
+	maxZstdDecodedSize = 64 * 1024 * 1024 // bytes
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderMaxMemory(maxZstdDecodedSize))
+)
+
+// isLargePayloadMsg returns true for messages carrying blocks and flips, they are worth compressing with zstd
+func isLargePayloadMsg(msgcode uint64) bool {
+	switch msgcode {
+	case Block, BlocksRange, ProposeBlock, FlipBody:
+		return true
+	}
+	return false
+}
+
+func zstdEncode(src []byte) []byte {
+	return zstdEncoder.EncodeAll(src, []byte{zstdCompression})
+}
+
+func zstdDecode(src []byte) ([]byte, error) {
+	return zstdDecoder.DecodeAll(src, nil)
+}
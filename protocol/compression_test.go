@@ -0,0 +1,58 @@
+package protocol
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncode(t *testing.T) {
+	large := make([]byte, minZstdCompressionSize)
+	rand.New(rand.NewSource(1)).Read(large[:len(large)/2])
+
+	tests := []struct {
+		name          string
+		msgcode       uint64
+		src           []byte
+		zstdSupported bool
+		compression   compression
+	}{
+		{"small", Block, []byte{0x1, 0x2}, true, noCompression},
+		{"keys package", FlipKeysPackage, large, true, noCompression},
+		{"large block", Block, large, true, zstdCompression},
+		{"large block without zstd", Block, large, false, s2Compression},
+		{"large tx", NewTx, large, true, s2Compression},
+		{"small block", Block, large[:minZstdCompressionSize-1], true, s2Compression},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			encoded := Encode(tc.msgcode, tc.src, tc.zstdSupported)
+			require.Equal(t, byte(tc.compression), encoded[0])
+			decoded, err := Decode(encoded)
+			require.NoError(t, err)
+			require.True(t, bytes.Equal(tc.src, decoded))
+		})
+	}
+
+	_, err := Decode(nil)
+	require.Error(t, err)
+	_, err = Decode([]byte{0xff, 0x1})
+	require.Error(t, err)
+	_, err = Decode([]byte{byte(zstdCompression), 0x1, 0x2})
+	require.Error(t, err)
+}
+
+func TestZstdDecode_maxSize(t *testing.T) {
+	encoded := zstdEncode(make([]byte, maxZstdDecodedSize))
+	decoded, err := Decode(encoded)
+	require.NoError(t, err)
+	require.Len(t, decoded, maxZstdDecodedSize)
+
+	// the tiny message can't make the node allocate more than the cap
+	encoded = zstdEncode(make([]byte, maxZstdDecodedSize+1))
+	require.Less(t, len(encoded), 64*1024)
+	_, err = Decode(encoded)
+	require.Error(t, err)
+}
@@ -35,12 +35,15 @@ type ConnManager struct {
 	connMutex sync.Mutex
 	host      core.Host
 	cfg       config.P2P
+
+	trustedPeers map[peer.ID]peer.AddrInfo
 }
 
 func NewConnManager(host core.Host, cfg config.P2P) *ConnManager {
 	return &ConnManager{
 		host:              host,
 		cfg:               cfg,
+		trustedPeers:      parseTrustedPeers(cfg.TrustedPeers),
 		bannedPeers:       mapset.NewSet(),
 		activeConnections: make(map[peer.ID]network.Conn),
 		inboundPeers:      make(map[peer.ID]struct{}),
@@ -92,6 +95,15 @@ func (m *ConnManager) BanPeer(id peer.ID) {
 	}
 }
 
+func (m *ConnManager) IsTrusted(id peer.ID) bool {
+	_, ok := m.trustedPeers[id]
+	return ok
+}
+
+func (m *ConnManager) UnbanPeer(id peer.ID) {
+	m.bannedPeers.Remove(id)
+}
+
 func (m *ConnManager) DialRandomPeer() (network.Stream, error) {
 	m.connMutex.Lock()
 	conns := make([]network.Conn, 0, len(m.activeConnections))
@@ -108,7 +120,7 @@ func (m *ConnManager) DialRandomPeer() (network.Stream, error) {
 		_, inbound := m.inboundPeers[id]
 		_, outbound := m.outboundPeers[id]
 		m.peerMutex.RUnlock()
-		if !inbound && !outbound && m.CanConnect(id) {
+		if !inbound && !outbound && !m.IsTrusted(id) && m.CanConnect(id) {
 			filteredConns = append(filteredConns, c)
 		}
 	}
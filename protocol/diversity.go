@@ -0,0 +1,250 @@
+package protocol
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/rcrowley/go-metrics"
+)
+
+var (
+	SubnetPeersLimitReached = errors.New("too many peers from the same subnet")
+	AsnPeersLimitReached    = errors.New("too many peers from the same autonomous system")
+)
+
+type asnRange struct {
+	network *net.IPNet
+	start   net.IP
+	asn     uint32
+}
+
+// asnDatabase maps ip ranges to autonomous system numbers, it is loaded from a csv file with `cidr,asn` lines
+type asnDatabase struct {
+	ranges []asnRange
+}
+
+func loadAsnDatabase(path string) (*asnDatabase, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	db := new(asnDatabase)
+	scanner := bufio.NewScanner(file)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		parts := strings.Split(text, ",")
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid asn database line %v", line)
+		}
+		_, network, err := net.ParseCIDR(strings.TrimSpace(parts[0]))
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid asn database line %v", line)
+		}
+		asn, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimSpace(parts[1]), "AS"), 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid asn database line %v", line)
+		}
+		db.ranges = append(db.ranges, asnRange{
+			network: network,
+			start:   network.IP.To16(),
+			asn:     uint32(asn),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	sort.Slice(db.ranges, func(i, j int) bool {
+		return bytes.Compare(db.ranges[i].start, db.ranges[j].start) < 0
+	})
+	return db, nil
+}
+
+// lookup returns the asn of the narrowest preceding range containing the ip, ranges are expected not to overlap
+func (db *asnDatabase) lookup(ip net.IP) (uint32, bool) {
+	ip16 := ip.To16()
+	idx := sort.Search(len(db.ranges), func(i int) bool {
+		return bytes.Compare(db.ranges[i].start, ip16) > 0
+	})
+	if idx == 0 {
+		return 0, false
+	}
+	r := db.ranges[idx-1]
+	if !r.network.Contains(ip) {
+		return 0, false
+	}
+	return r.asn, true
+}
+
+// subnetKey returns /16 for IPv4 and /32 for IPv6 addresses
+func subnetKey(ip net.IP) string {
+	if ip4 := ip.To4(); ip4 != nil {
+		return fmt.Sprintf("%v.%v.0.0/16", ip4[0], ip4[1])
+	}
+	return (&net.IPNet{IP: ip.Mask(net.CIDRMask(32, 128)), Mask: net.CIDRMask(32, 128)}).String()
+}
+
+type peerGroup struct {
+	subnet string
+	asn    uint32
+}
+
+// PeersDiversity is the number of connected peers per subnet and per autonomous system
+type PeersDiversity struct {
+	Subnets          map[string]int
+	Asns             map[uint32]int
+	MaxSubnetPeers   int
+	MaxAsnPeers      int
+	AsnDatabaseReady bool
+}
+
+// peerDiversity limits the number of peers from the same subnet and autonomous system to harden the node
+// against eclipse attacks
+type peerDiversity struct {
+	maxSubnetPeers int
+	maxAsnPeers    int
+	asnDb          *asnDatabase
+	peers          map[peer.ID]peerGroup
+	subnets        map[string]int
+	asns           map[uint32]int
+	mutex          sync.Mutex
+
+	subnetsGauge    metrics.Gauge
+	maxSubnetGauge  metrics.Gauge
+	asnsGauge       metrics.Gauge
+	maxAsnPeerGauge metrics.Gauge
+}
+
+// newPeerDiversity creates limits as fractions of maxPeers, a zero ratio disables the limit
+func newPeerDiversity(maxPeers int, subnetRatio, asnRatio float64, asnDb *asnDatabase) *peerDiversity {
+	limit := func(ratio float64) int {
+		if ratio <= 0 {
+			return 0
+		}
+		if value := int(ratio * float64(maxPeers)); value > 1 {
+			return value
+		}
+		return 1
+	}
+	return &peerDiversity{
+		maxSubnetPeers:  limit(subnetRatio),
+		maxAsnPeers:     limit(asnRatio),
+		asnDb:           asnDb,
+		peers:           make(map[peer.ID]peerGroup),
+		subnets:         make(map[string]int),
+		asns:            make(map[uint32]int),
+		subnetsGauge:    metrics.GetOrRegisterGauge("p2p.diversity.subnets", metrics.DefaultRegistry),
+		maxSubnetGauge:  metrics.GetOrRegisterGauge("p2p.diversity.maxSubnetPeers", metrics.DefaultRegistry),
+		asnsGauge:       metrics.GetOrRegisterGauge("p2p.diversity.asns", metrics.DefaultRegistry),
+		maxAsnPeerGauge: metrics.GetOrRegisterGauge("p2p.diversity.maxAsnPeers", metrics.DefaultRegistry),
+	}
+}
+
+func (d *peerDiversity) group(ip net.IP) peerGroup {
+	g := peerGroup{
+		subnet: subnetKey(ip),
+	}
+	if d.asnDb != nil {
+		g.asn, _ = d.asnDb.lookup(ip)
+	}
+	return g
+}
+
+// check returns an error if one more peer with the ip exceeds the limits
+func (d *peerDiversity) check(ip net.IP) error {
+	g := d.group(ip)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if d.maxSubnetPeers > 0 && d.subnets[g.subnet] >= d.maxSubnetPeers {
+		return SubnetPeersLimitReached
+	}
+	if d.maxAsnPeers > 0 && g.asn != 0 && d.asns[g.asn] >= d.maxAsnPeers {
+		return AsnPeersLimitReached
+	}
+	return nil
+}
+
+func (d *peerDiversity) add(id peer.ID, ip net.IP) {
+	g := d.group(ip)
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	if _, ok := d.peers[id]; ok {
+		return
+	}
+	d.peers[id] = g
+	d.subnets[g.subnet]++
+	if g.asn != 0 {
+		d.asns[g.asn]++
+	}
+	d.updateMetrics()
+}
+
+func (d *peerDiversity) remove(id peer.ID) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	g, ok := d.peers[id]
+	if !ok {
+		return
+	}
+	delete(d.peers, id)
+	if d.subnets[g.subnet]--; d.subnets[g.subnet] <= 0 {
+		delete(d.subnets, g.subnet)
+	}
+	if g.asn != 0 {
+		if d.asns[g.asn]--; d.asns[g.asn] <= 0 {
+			delete(d.asns, g.asn)
+		}
+	}
+	d.updateMetrics()
+}
+
+func (d *peerDiversity) updateMetrics() {
+	maxSubnet, maxAsn := 0, 0
+	for _, cnt := range d.subnets {
+		if cnt > maxSubnet {
+			maxSubnet = cnt
+		}
+	}
+	for _, cnt := range d.asns {
+		if cnt > maxAsn {
+			maxAsn = cnt
+		}
+	}
+	d.subnetsGauge.Update(int64(len(d.subnets)))
+	d.maxSubnetGauge.Update(int64(maxSubnet))
+	d.asnsGauge.Update(int64(len(d.asns)))
+	d.maxAsnPeerGauge.Update(int64(maxAsn))
+}
+
+func (d *peerDiversity) stats() PeersDiversity {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	result := PeersDiversity{
+		Subnets:          make(map[string]int, len(d.subnets)),
+		Asns:             make(map[uint32]int, len(d.asns)),
+		MaxSubnetPeers:   d.maxSubnetPeers,
+		MaxAsnPeers:      d.maxAsnPeers,
+		AsnDatabaseReady: d.asnDb != nil,
+	}
+	for k, v := range d.subnets {
+		result.Subnets[k] = v
+	}
+	for k, v := range d.asns {
+		result.Asns[k] = v
+	}
+	return result
+}
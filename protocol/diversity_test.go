@@ -0,0 +1,106 @@
+package protocol
+
+import (
+	"io/ioutil"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTestAsnDatabase(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "asn.csv")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestAsnDatabase_lookup(t *testing.T) {
+	db, err := loadAsnDatabase(writeTestAsnDatabase(t, `# cidr,asn
+10.1.0.0/16,AS100
+10.0.0.0/16,200
+
+2001:db8::/32,300
+`))
+	require.NoError(t, err)
+
+	for _, c := range []struct {
+		ip  string
+		asn uint32
+		ok  bool
+	}{
+		{"10.0.5.1", 200, true},
+		{"10.1.255.255", 100, true},
+		{"10.2.0.1", 0, false},
+		{"9.255.255.255", 0, false},
+		{"2001:db8::1", 300, true},
+		{"2001:db9::1", 0, false},
+	} {
+		asn, ok := db.lookup(net.ParseIP(c.ip))
+		require.Equal(t, c.ok, ok, c.ip)
+		require.Equal(t, c.asn, asn, c.ip)
+	}
+
+	_, err = loadAsnDatabase(writeTestAsnDatabase(t, "10.0.0.0/16\n"))
+	require.Error(t, err)
+	_, err = loadAsnDatabase(writeTestAsnDatabase(t, "10.0.0.0,100\n"))
+	require.Error(t, err)
+	_, err = loadAsnDatabase(writeTestAsnDatabase(t, "10.0.0.0/16,ASX\n"))
+	require.Error(t, err)
+}
+
+func TestSubnetKey(t *testing.T) {
+	require.Equal(t, "1.2.0.0/16", subnetKey(net.ParseIP("1.2.3.4")))
+	require.Equal(t, "1.2.0.0/16", subnetKey(net.ParseIP("::ffff:1.2.250.4")))
+	require.Equal(t, "2001:db8::/32", subnetKey(net.ParseIP("2001:db8:1:2::1")))
+}
+
+func TestPeerDiversity_limits(t *testing.T) {
+	asnDb, err := loadAsnDatabase(writeTestAsnDatabase(t, "10.0.0.0/8,100\n"))
+	require.NoError(t, err)
+	d := newPeerDiversity(10, 0.2, 0.3, asnDb)
+	require.Equal(t, 2, d.maxSubnetPeers)
+	require.Equal(t, 3, d.maxAsnPeers)
+
+	require.NoError(t, d.check(net.ParseIP("10.0.0.1")))
+	d.add("p1", net.ParseIP("10.0.0.1"))
+	d.add("p1", net.ParseIP("10.0.0.1"))
+	d.add("p2", net.ParseIP("10.0.0.2"))
+	require.Equal(t, SubnetPeersLimitReached, d.check(net.ParseIP("10.0.0.3")))
+
+	d.add("p3", net.ParseIP("10.1.0.1"))
+	require.Equal(t, AsnPeersLimitReached, d.check(net.ParseIP("10.2.0.1")))
+	// the ip without a known asn is limited by the subnet only
+	require.NoError(t, d.check(net.ParseIP("11.0.0.1")))
+
+	stats := d.stats()
+	require.Equal(t, map[string]int{"10.0.0.0/16": 2, "10.1.0.0/16": 1}, stats.Subnets)
+	require.Equal(t, map[uint32]int{100: 3}, stats.Asns)
+	require.True(t, stats.AsnDatabaseReady)
+
+	d.remove("p1")
+	d.remove("unknown")
+	require.NoError(t, d.check(net.ParseIP("10.0.0.3")))
+	require.NoError(t, d.check(net.ParseIP("10.2.0.1")))
+	require.Equal(t, map[string]int{"10.0.0.0/16": 1, "10.1.0.0/16": 1}, d.stats().Subnets)
+
+	d.remove("p2")
+	d.remove("p3")
+	require.Empty(t, d.stats().Subnets)
+	require.Empty(t, d.stats().Asns)
+}
+
+func TestPeerDiversity_disabled(t *testing.T) {
+	d := newPeerDiversity(10, 0, 0, nil)
+	for i := 0; i < 20; i++ {
+		require.NoError(t, d.check(net.ParseIP("10.0.0.1")))
+		d.add(peer.ID(strconv.Itoa(i)), net.ParseIP("10.0.0.1"))
+	}
+	require.Equal(t, map[string]int{"10.0.0.0/16": 20}, d.stats().Subnets)
+	require.False(t, d.stats().AsnDatabaseReady)
+
+	// a small ratio still allows one peer
+	require.Equal(t, 1, newPeerDiversity(10, 0.01, 0.01, nil).maxSubnetPeers)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/core/state/snapshot"
 	"github.com/idena-network/idena-go/core/upgrade"
+	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/ipfs"
 	"github.com/idena-network/idena-go/keystore"
 	"github.com/idena-network/idena-go/log"
@@ -21,6 +22,7 @@ import (
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/subscriptions"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"sync/atomic"
 	"time"
 )
 
@@ -69,6 +71,8 @@ type Downloader struct {
 	keyStore             *keystore.KeyStore
 	subManager           *subscriptions.Manager
 	upgrader             *upgrade.Upgrader
+	// lastRecovery holds the time.Time of the last recovery resync, it is read by API goroutines
+	lastRecovery atomic.Value
 }
 
 func (d *Downloader) IsSyncing() bool {
@@ -279,17 +283,45 @@ func (d *Downloader) ClearPotentialForks() {
 	d.potentialForkedPeers.Clear()
 }
 
+// StartRecovery marks peers which are ahead of the stale head as potentially forked, so their blocks are requested
+// starting from own top ancestors and the fork is re-evaluated by fork resolver
+func (d *Downloader) StartRecovery(peers []peer.ID, top uint64) {
+	head := d.chain.Head.Height()
+	d.log.Warn("Head is stale, start recovery resync", "head", head, "top", top, "peers", len(peers))
+	for _, p := range peers {
+		d.potentialForkedPeers.Add(p)
+	}
+	d.lastRecovery.Store(time.Now().UTC())
+	d.bus.Publish(&events.StaleHeadResyncEvent{
+		Head:  head,
+		Top:   top,
+		Peers: len(peers),
+	})
+}
+
+// LastRecovery returns the time of the last recovery resync, zero if there were no recoveries
+func (d *Downloader) LastRecovery() time.Time {
+	lastRecovery, _ := d.lastRecovery.Load().(time.Time)
+	return lastRecovery
+}
+
 func (d *Downloader) createBlockApplier() (loader blockApplier, toHeight uint64) {
 
 	canUseFastSync := d.cfg.Sync.FastSync
 
-	if d.top-d.chain.Head.Height() < d.cfg.Sync.ForceFullSync {
+	// validation only node syncs from any snapshot ahead of the head to skip the history
+	forceFullSync := d.cfg.Sync.ForceFullSync
+	if d.cfg.Sync.ValidationOnly {
+		forceFullSync = 1
+	}
+
+	if d.top-d.chain.Head.Height() < forceFullSync {
 		canUseFastSync = false
 	}
 	var manifest *snapshot.Manifest
 	if canUseFastSync {
 		manifest = d.getBestManifest()
-		if manifest == nil || d.chain.Head.Height() > manifest.Height || manifest.Height-d.chain.Head.Height() < d.cfg.Sync.ForceFullSync {
+		if manifest == nil || d.chain.Head.Height() > manifest.Height || manifest.Height-d.chain.Head.Height() < forceFullSync {
 			canUseFastSync = false
 		}
 	}
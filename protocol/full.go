@@ -156,6 +156,8 @@ func (fs *fullSync) processBatch(batch *batch, attemptNum int) error {
 			fs.log.Warn("process batch - timeout was reached", "peer", batch.p.id)
 			if batch.p.addTimeout() {
 				fs.pm.BanPeer(batch.p.id, BanReasonTimeout)
+			} else {
+				fs.pm.scorePeer(batch.p.id, timeoutScore, BanReasonTimeout)
 			}
 			return reload(i)
 		}
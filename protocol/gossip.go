@@ -15,6 +15,7 @@ import (
 	"github.com/idena-network/idena-go/core/flip"
 	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/state/snapshot"
+	"github.com/idena-network/idena-go/database"
 	"github.com/idena-network/idena-go/events"
 	"github.com/idena-network/idena-go/log"
 	"github.com/idena-network/idena-go/pengings"
@@ -23,7 +24,9 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
 	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -45,6 +48,7 @@ type IdenaGossipHandler struct {
 	incomeBlocks    chan *types.Block
 	proposals       *pengings.Proposals
 	votes           *pengings.Votes
+	archive         *pengings.MessageArchive
 	pushPullManager *PushPullManager
 
 	txpool              mempool.TransactionPool
@@ -65,6 +69,11 @@ type IdenaGossipHandler struct {
 	metrics         *metricCollector
 	ceremonyChecker CeremonyChecker
 	connManager     *ConnManager
+	reputation      *peerReputation
+	diversity       *peerDiversity
+	pex             *peerExchange
+	replay          *replayProtection
+	capabilities    uint32
 }
 
 type metricCollector struct {
@@ -73,7 +82,7 @@ type metricCollector struct {
 	compress       func(code uint64, size int)
 }
 
-func NewIdenaGossipHandler(host core.Host, cfg config.P2P, chain *blockchain.Blockchain, proposals *pengings.Proposals, votes *pengings.Votes, txpool *mempool.TxPool, fp *flip.Flipper, bus eventbus.Bus, flipKeyPool *mempool.KeysPool, appVersion string, ceremonyChecker CeremonyChecker) *IdenaGossipHandler {
+func NewIdenaGossipHandler(host core.Host, cfg config.P2P, chain *blockchain.Blockchain, proposals *pengings.Proposals, votes *pengings.Votes, archive *pengings.MessageArchive, txpool *mempool.TxPool, fp *flip.Flipper, bus eventbus.Bus, flipKeyPool *mempool.KeysPool, appVersion string, ceremonyChecker CeremonyChecker, db dbm.DB) *IdenaGossipHandler {
 	handler := &IdenaGossipHandler{
 		host:                host,
 		cfg:                 cfg,
@@ -83,6 +92,7 @@ func NewIdenaGossipHandler(host core.Host, cfg config.P2P, chain *blockchain.Blo
 		incomeBatches:       &sync.Map{},
 		proposals:           proposals,
 		votes:               votes,
+		archive:             archive,
 		pushPullManager:     NewPushPullManager(),
 		txpool:              mempool.NewAsyncTxPool(txpool),
 		txChan:              make(chan *events.NewTxEvent, 1000),
@@ -97,6 +107,10 @@ func NewIdenaGossipHandler(host core.Host, cfg config.P2P, chain *blockchain.Blo
 		metrics:             new(metricCollector),
 		ceremonyChecker:     ceremonyChecker,
 		connManager:         NewConnManager(host, cfg),
+		capabilities:        localCapabilities(cfg),
+		reputation:          newPeerReputation(database.NewRepo(db)),
+		pex:                 newPeerExchange(),
+		replay:              newReplayProtection(cfg.ReplayWindowSize),
 	}
 	handler.pushPullManager.AddEntryHolder(pushVote, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
 	handler.pushPullManager.AddEntryHolder(pushBlock, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Second*3)))
@@ -104,8 +118,17 @@ func NewIdenaGossipHandler(host core.Host, cfg config.P2P, chain *blockchain.Blo
 	handler.pushPullManager.AddEntryHolder(pushFlip, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Second*5)))
 	handler.pushPullManager.AddEntryHolder(pushKeyPackage, flipKeyPool)
 	handler.pushPullManager.AddEntryHolder(pushTx, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
+	handler.pushPullManager.AddEntryHolder(pushFlipKey, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
 	handler.pushPullManager.Run()
 	handler.registerMetrics()
+	var asnDb *asnDatabase
+	if cfg.AsnDatabase != "" {
+		var err error
+		if asnDb, err = loadAsnDatabase(cfg.AsnDatabase); err != nil {
+			handler.log.Error("Failed to load asn database", "path", cfg.AsnDatabase, "err", err)
+		}
+	}
+	handler.diversity = newPeerDiversity(cfg.MaxInboundPeers+cfg.MaxOutboundPeers, cfg.SubnetPeersRatio, cfg.AsnPeersRatio, asnDb)
 	return handler
 }
 
@@ -114,6 +137,9 @@ func (h *IdenaGossipHandler) Start() {
 	setHandler := func() {
 		h.host.SetStreamHandler(IdenaProtocol, h.acceptStream)
 		h.connManager = NewConnManager(h.host, h.cfg)
+		for _, id := range h.reputation.bannedPeers() {
+			h.connManager.BanPeer(id)
+		}
 		notifiee := &notifiee{
 			connManager: h.connManager,
 		}
@@ -137,6 +163,10 @@ func (h *IdenaGossipHandler) Start() {
 		newFlipEvent := e.(*events.NewFlipEvent)
 		h.sendFlip(newFlipEvent.Flip)
 	})
+	h.bus.Subscribe(events.NewCheckpointAnnouncementEventID, func(e eventbus.Event) {
+		newAnnouncementEvent := e.(*events.NewCheckpointAnnouncementEvent)
+		h.broadcastCheckpointAnnouncement(newAnnouncementEvent.Announcement)
+	})
 	h.bus.Subscribe(events.IpfsPortChangedEventId, func(e eventbus.Event) {
 		portChangedEvent := e.(*events.IpfsPortChangedEvent)
 		h.host = portChangedEvent.Host
@@ -151,13 +181,25 @@ func (h *IdenaGossipHandler) Start() {
 func (h *IdenaGossipHandler) background() {
 	dialTicker := time.NewTicker(time.Second * 15)
 	renewTicker := time.NewTicker(time.Minute * 5)
+	latencyTicker := time.NewTicker(latencyMeasurementInterval)
+	pexTicker := time.NewTicker(peerExchangeInterval)
 
 	for {
 		select {
 		case <-dialTicker.C:
 			h.dialPeers()
+			h.dialTrustedPeers()
 		case <-renewTicker.C:
 			h.renewPeers()
+			for _, id := range h.reputation.expireBans() {
+				h.connManager.UnbanPeer(id)
+			}
+			h.reputation.prune()
+			h.reputation.flush()
+		case <-latencyTicker.C:
+			h.measureLatency()
+		case <-pexTicker.C:
+			h.exchangePeers()
 		}
 	}
 }
@@ -194,6 +236,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 					p.setHeight(b.Header.Height())
 				}
 				close(batch.headers)
+				h.scorePeer(p.id, usefulDataScore, nil)
 				h.batchedLock.Lock()
 				peerBatches.Delete(response.BatchId)
 				if maputil.IsSyncMapEmpty(peerBatches) {
@@ -208,7 +251,10 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := proposal.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.checkRound(proposal.Round) != nil {
+			return nil
+		}
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
@@ -225,7 +271,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if !proposal.IsValid() {
 			return errResp(ValidationErr, "%v", msg)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
@@ -245,7 +291,10 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if !vote.IsValid() {
 			return errResp(ValidationErr, "%v", msg)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.checkRound(vote.Header.Round) != nil {
+			return nil
+		}
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
@@ -258,7 +307,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := tx.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
@@ -296,7 +345,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if !f.IsValid() {
 			return errResp(ValidationErr, "%v", msg)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
@@ -306,10 +355,12 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := flipKey.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayloadWithExpiration(msg.Payload, flipKeyMsgCacheAliveTime)
+		digest, _ := (&pushPullHash{Type: pushFlipKey, Hash: flipKey.Hash128()}).ToBytes()
+		p.markPayloadWithExpiration(digest, flipKeyMsgCacheAliveTime)
 		h.flipKeyPool.AddPublicFlipKey(flipKey, false)
 	case SnapshotManifest:
 		manifest := new(snapshot.Manifest)
@@ -324,7 +375,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 		if err := keysPackage.FromBytes(msg.Payload); err != nil {
 			return errResp(DecodeErr, "%v: %v", msg, err)
 		}
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayloadWithExpiration(msg.Payload, flipKeyMsgCacheAliveTime)
@@ -338,7 +389,7 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			return errResp(ValidationErr, "%v", msg)
 		}
 
-		if pushHash.Type == pushKeyPackage {
+		if pushHash.Type == pushKeyPackage || pushHash.Type == pushFlipKey {
 			p.markPayloadWithExpiration(msg.Payload, flipKeyMsgCacheAliveTime)
 		} else {
 			p.markPayload(msg.Payload)
@@ -365,18 +416,45 @@ func (h *IdenaGossipHandler) handle(p *protoPeer) error {
 			return errResp(ValidationErr, "%v", msg)
 		}
 
-		if h.isProcessed(msg.Payload) {
+		if h.isProcessed(msg.Code, msg.Payload) {
 			return nil
 		}
 		p.markPayload(msg.Payload)
 		h.proposals.AddBlock(block)
+	case CheckpointAnnouncement:
+		announcement := new(types.CheckpointAnnouncement)
+		if err := announcement.FromBytes(msg.Payload); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if !announcement.IsValid() {
+			return errResp(ValidationErr, "%v", msg)
+		}
+		if h.isProcessed(msg.Code, msg.Payload) {
+			return nil
+		}
+		p.markPayload(msg.Payload)
+		if ok, err := h.bcn.AddCheckpointAnnouncement(announcement); err != nil {
+			p.log.Debug("Checkpoint announcement rejected", "err", err)
+		} else if ok {
+			h.broadcastCheckpointAnnouncement(announcement)
+		}
+	case PeerAddrs:
+		peerAddrs := new(models.ProtoPeerAddrs)
+		if err := proto.Unmarshal(msg.Payload, peerAddrs); err != nil {
+			return errResp(DecodeErr, "%v: %v", msg, err)
+		}
+		if len(peerAddrs.Addrs) > maxExchangedAddrs {
+			return errResp(ValidationErr, "%v", msg)
+		}
+		h.receivePeerAddrs(p, peerAddrs.Addrs)
 	}
 
 	return nil
 }
 
 func (h *IdenaGossipHandler) acceptStream(stream network.Stream) {
-	if h.connManager.CanConnect(stream.Conn().RemotePeer()) && h.connManager.CanAcceptStream() {
+	id := stream.Conn().RemotePeer()
+	if h.isTrustedPeer(id) || h.connManager.CanConnect(id) && h.connManager.CanAcceptStream() {
 		h.runPeer(stream, true)
 	}
 }
@@ -401,9 +479,23 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 		h.mutex.Unlock()
 	}()
 
-	peer := newPeer(stream, h.cfg.MaxDelay, h.metrics)
+	remoteAddr := stream.Conn().RemoteMultiaddr()
+	remoteIp, ipErr := manet.ToIP(remoteAddr)
+	checkDiversity := ipErr == nil && manet.IsPublicAddr(remoteAddr) && !h.isTrustedPeer(peerId)
+	if checkDiversity {
+		if err := h.diversity.check(remoteIp); err != nil {
+			stream.Reset()
+			return nil, err
+		}
+	}
+
+	uploadRate, downloadRate := h.cfg.MaxPeerUploadRate*1024, h.cfg.MaxPeerDownloadRate*1024
+	if h.isTrustedPeer(peerId) {
+		uploadRate, downloadRate = 0, 0
+	}
+	peer := newPeer(stream, h.cfg.MaxDelay, h.metrics, h.isCeremonyMsg, uploadRate, downloadRate)
 
-	if err := peer.Handshake(h.bcn.Network(), h.bcn.Head.Height(), h.bcn.GenesisInfo(), h.appVersion, uint32(h.peers.Len())); err != nil {
+	if err := peer.Handshake(h.bcn.Network(), h.bcn.Head.Height(), h.bcn.GenesisInfo(), h.appVersion, uint32(h.peers.Len()), h.capabilities); err != nil {
 		current := semver.New(h.appVersion)
 		if other, errS := semver.NewVersion(peer.appVersion); errS != nil || other.Major > current.Major || other.Minor >= current.Minor && other.Major == current.Major {
 			peer.log.Debug("Idena handshake failed", "err", err)
@@ -411,7 +503,12 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 		return nil, err
 	}
 	h.peers.Register(peer)
-	h.connManager.Connected(peer.id, inbound)
+	if checkDiversity {
+		h.diversity.add(peer.id, remoteIp)
+	}
+	if !h.isTrustedPeer(peer.id) {
+		h.connManager.Connected(peer.id, inbound)
+	}
 	h.host.ConnManager().TagPeer(peer.id, "idena", IdenaProtocolWeight)
 
 	go h.runListening(peer)
@@ -421,6 +518,7 @@ func (h *IdenaGossipHandler) runPeer(stream network.Stream, inbound bool) (*prot
 	go h.syncFlipKeyPool(peer)
 
 	h.sendManifest(peer)
+	go h.sendPeerAddrs(peer)
 
 	h.log.Info("Peer connected", "id", peer.id.Pretty(), "inbound", inbound)
 	return peer, nil
@@ -434,6 +532,7 @@ func (h *IdenaGossipHandler) unregisterPeer(peerId peer.ID) {
 	if err := h.peers.Unregister(peerId); err != nil {
 		return
 	}
+	h.diversity.remove(peerId)
 	close(peer.term)
 	peer.disconnect()
 
@@ -457,6 +556,9 @@ func (h *IdenaGossipHandler) dialPeers() {
 				return
 			}
 			stream, err := h.connManager.DialRandomPeer()
+			if err == NoPeersToDial && h.dialExchangedPeer() {
+				continue
+			}
 			if err != nil {
 				h.log.Error("dial failed", "err", err)
 				return
@@ -492,7 +594,12 @@ func (h *IdenaGossipHandler) renewPeers() {
 }
 
 func (h *IdenaGossipHandler) BanPeer(peerId peer.ID, reason error) {
+	if h.isTrustedPeer(peerId) {
+		h.log.Warn("Trusted peer is not banned", "id", peerId.Pretty(), "reason", reason)
+		return
+	}
 	h.connManager.BanPeer(peerId)
+	h.reputation.ban(peerId, peerBanDuration)
 
 	peer := h.peers.Peer(peerId)
 	if peer != nil {
@@ -503,8 +610,8 @@ func (h *IdenaGossipHandler) BanPeer(peerId peer.ID, reason error) {
 	}
 }
 
-func (h *IdenaGossipHandler) isProcessed(payload []byte) bool {
-	return h.peers.HasPayload(payload)
+func (h *IdenaGossipHandler) isProcessed(msgcode uint64, payload []byte) bool {
+	return h.peers.HasPayload(payload) || h.replay.seen(msgcode, payload)
 }
 
 func (h *IdenaGossipHandler) provideBlocks(p *protoPeer, batchId uint32, from uint64, to uint64) {
@@ -552,6 +659,9 @@ func (h *IdenaGossipHandler) runListening(peer *protoPeer) {
 	for {
 		if err := h.handle(peer); err != nil {
 			peer.log.Debug("Idena message handling failed", "err", err)
+			if _, ok := err.(*msgError); ok {
+				h.scorePeer(peer.id, invalidMsgScore, err)
+			}
 			return
 		}
 	}
@@ -591,6 +701,9 @@ func (h *IdenaGossipHandler) GetKnownManifests() map[peer.ID]*snapshot.Manifest
 		return result
 	}
 	for _, peer := range peers {
+		if !peer.servesSnapshots() {
+			continue
+		}
 		manifest := peer.Manifest()
 		if manifest != nil {
 			result[peer.id] = manifest
@@ -658,6 +771,7 @@ func (h *IdenaGossipHandler) GetForkBlockRange(peerId peer.ID, ownBlocks []commo
 }
 
 func (h *IdenaGossipHandler) ProposeProof(proposal *types.ProofProposal) {
+	h.archive.AddProof(proposal)
 	hash := pushPullHash{
 		Type: pushProof,
 		Hash: proposal.Hash128(),
@@ -667,6 +781,7 @@ func (h *IdenaGossipHandler) ProposeProof(proposal *types.ProofProposal) {
 }
 
 func (h *IdenaGossipHandler) ProposeBlock(block *types.BlockProposal) {
+	h.archive.AddBlock(block)
 	hash := pushPullHash{
 		Type: pushBlock,
 		Hash: block.Hash128(),
@@ -676,6 +791,7 @@ func (h *IdenaGossipHandler) ProposeBlock(block *types.BlockProposal) {
 }
 
 func (h *IdenaGossipHandler) SendVote(vote *types.Vote) {
+	h.archive.AddVote(vote)
 	hash := pushPullHash{
 		Type: pushVote,
 		Hash: vote.Hash128(),
@@ -686,9 +802,12 @@ func (h *IdenaGossipHandler) SendVote(vote *types.Vote) {
 
 func (h *IdenaGossipHandler) sendPush(hash pushPullHash) {
 	data, _ := hash.ToBytes()
-	if hash.Type == pushKeyPackage {
+	switch hash.Type {
+	case pushKeyPackage:
 		h.peers.SendWithFilterAndExpiration(Push, msgKey(data), hash, false, flipKeyMsgCacheAliveTime)
-	} else {
+	case pushVote, pushProof, pushBlock:
+		h.peers.SendWithFilterByLatency(Push, msgKey(data), hash, h.peerLatency)
+	default:
 		h.peers.SendWithFilter(Push, msgKey(data), hash, false)
 	}
 }
@@ -705,6 +824,8 @@ func (h *IdenaGossipHandler) sendEntry(p *protoPeer, hash pushPullHash, entry in
 		p.sendMsg(FlipBody, entry, highPriority)
 	case pushKeyPackage:
 		p.sendMsg(FlipKeysPackage, entry, highPriority)
+	case pushFlipKey:
+		p.sendMsg(FlipKey, entry, highPriority)
 	case pushTx:
 		p.sendMsg(NewTx, entry, highPriority)
 		if highPriority {
@@ -715,8 +836,68 @@ func (h *IdenaGossipHandler) sendEntry(p *protoPeer, hash pushPullHash, entry in
 	}
 }
 
+// msgError is returned when the peer sends a message which can not be decoded or is invalid
+type msgError struct {
+	code int
+	msg  string
+}
+
+func (e *msgError) Error() string {
+	return fmt.Sprintf("%v - %v", e.code, e.msg)
+}
+
 func errResp(code int, format string, v ...interface{}) error {
-	return fmt.Errorf("%v - %v", code, fmt.Sprintf(format, v...))
+	return &msgError{code, fmt.Sprintf(format, v...)}
+}
+
+// scorePeer changes the reputation of the peer and bans it once the score drops to banPeerScore
+func (h *IdenaGossipHandler) scorePeer(id peer.ID, delta int32, reason error) {
+	if h.isTrustedPeer(id) {
+		return
+	}
+	if h.reputation.add(id, delta) {
+		h.BanPeer(id, errors.Wrap(reason, "low score"))
+	}
+}
+
+// PeerReputations returns scores of known peers
+func (h *IdenaGossipHandler) PeerReputations() []PeerReputation {
+	return h.reputation.list()
+}
+
+// SetPeerScore overrides the score of the peer, the peer is banned if the score is not above the ban threshold
+func (h *IdenaGossipHandler) SetPeerScore(id string, score int32) error {
+	peerId, err := peer.Decode(id)
+	if err != nil {
+		return err
+	}
+	if h.reputation.set(peerId, score) {
+		h.BanPeer(peerId, errors.New("score is set by admin"))
+	} else if score > banPeerScore {
+		h.connManager.UnbanPeer(peerId)
+	}
+	return nil
+}
+
+// BanPeerById bans the peer for peerBanDuration and disconnects it
+func (h *IdenaGossipHandler) BanPeerById(id string) error {
+	peerId, err := peer.Decode(id)
+	if err != nil {
+		return err
+	}
+	h.BanPeer(peerId, errors.New("banned by admin"))
+	return nil
+}
+
+// UnbanPeer lifts the ban of the peer and resets its negative score
+func (h *IdenaGossipHandler) UnbanPeer(id string) error {
+	peerId, err := peer.Decode(id)
+	if err != nil {
+		return err
+	}
+	h.reputation.unban(peerId)
+	h.connManager.UnbanPeer(peerId)
+	return nil
 }
 
 func (h *IdenaGossipHandler) broadcastTx(tx *types.Transaction, own bool) {
@@ -741,9 +922,30 @@ func (h *IdenaGossipHandler) sendFlip(flip *types.Flip) {
 	h.sendPush(hash)
 }
 
+// broadcastFlipKey announces the digest of the key to peers which pull keys on request, the rest get the whole key
 func (h *IdenaGossipHandler) broadcastFlipKey(flipKey *types.PublicFlipKey, own bool) {
+	hash := pushPullHash{
+		Type: pushFlipKey,
+		Hash: flipKey.Hash128(),
+	}
+	h.pushPullManager.AddEntry(hash, flipKey, own)
 	b, _ := flipKey.ToBytes()
-	h.peers.SendWithFilterAndExpiration(FlipKey, msgKey(b), flipKey, own, flipKeyMsgCacheAliveTime)
+	data, _ := hash.ToBytes()
+	digestKey, bodyKey := msgKey(data), msgKey(b)
+	for _, p := range h.peers.Peers() {
+		if _, ok := p.msgCache.Get(bodyKey); ok {
+			continue
+		}
+		if p.supports(capabilityFlipKeyDigests) {
+			if _, ok := p.msgCache.Get(digestKey); !ok {
+				p.markKeyWithExpiration(digestKey, flipKeyMsgCacheAliveTime)
+				p.sendMsg(Push, hash, own)
+			}
+			continue
+		}
+		p.markKeyWithExpiration(bodyKey, flipKeyMsgCacheAliveTime)
+		p.sendMsg(FlipKey, flipKey, own)
+	}
 }
 
 func (h *IdenaGossipHandler) broadcastFlipKeysPackage(flipKeysPackage *types.PrivateFlipKeysPackage, own bool) {
@@ -754,6 +956,11 @@ func (h *IdenaGossipHandler) broadcastFlipKeysPackage(flipKeysPackage *types.Pri
 	h.sendPush(hash)
 }
 
+func (h *IdenaGossipHandler) broadcastCheckpointAnnouncement(announcement *types.CheckpointAnnouncement) {
+	b, _ := announcement.ToBytes()
+	h.peers.SendWithFilter(CheckpointAnnouncement, msgKey(b), announcement, false)
+}
+
 func (h *IdenaGossipHandler) sendPull(peerId peer.ID, hash pushPullHash) {
 	peer := h.peers.Peer(peerId)
 	if peer != nil {
@@ -783,6 +990,9 @@ func (h *IdenaGossipHandler) syncTxPool(p *protoPeer) {
 }
 
 func (h *IdenaGossipHandler) sendManifest(p *protoPeer) {
+	if h.capabilities&capabilitySnapshotServing == 0 {
+		return
+	}
 	manifest := h.bcn.ReadSnapshotManifest()
 	if manifest == nil {
 		return
@@ -829,6 +1039,18 @@ func (h *IdenaGossipHandler) Peers() []*protoPeer {
 	return h.peers.Peers()
 }
 
+// PeersStats returns traffic per message type of every connected peer
+func (h *IdenaGossipHandler) PeersStats() []PeerStats {
+	peers := h.peers.Peers()
+	result := make([]PeerStats, 0, len(peers))
+	for _, peer := range peers {
+		stats := peer.stats()
+		stats.Latency = h.peerLatency(peer.id)
+		result = append(result, stats)
+	}
+	return result
+}
+
 func (h *IdenaGossipHandler) PeerHeights() []uint64 {
 	result := make([]uint64, 0)
 	peers := h.peers.Peers()
@@ -849,6 +1071,25 @@ func (h *IdenaGossipHandler) Endpoint() string {
 	return h.host.ID().Pretty()
 }
 
+// Capabilities returns capabilities advertised by the node
+func (h *IdenaGossipHandler) Capabilities() uint32 {
+	return h.capabilities
+}
+
+// PeersDiversity returns the number of connected peers per subnet and autonomous system
+func (h *IdenaGossipHandler) PeersDiversity() PeersDiversity {
+	return h.diversity.stats()
+}
+
+// Endpoints returns all multiaddrs of the node advertised to peers
+func (h *IdenaGossipHandler) Endpoints() []string {
+	var result []string
+	for _, a := range h.host.Addrs() {
+		result = append(result, fmt.Sprintf("%s/ipfs/%s", a.String(), h.host.ID().Pretty()))
+	}
+	return result
+}
+
 func (h *IdenaGossipHandler) AddPeer(url string) error {
 	ma, err := multiaddr.NewMultiaddr(url)
 
@@ -872,6 +1113,15 @@ func (h *IdenaGossipHandler) AddPeer(url string) error {
 	return err
 }
 
+// RemovePeer closes connections to the peer, the peer can be connected again later unless it is banned
+func (h *IdenaGossipHandler) RemovePeer(id string) error {
+	peerId, err := peer.Decode(id)
+	if err != nil {
+		return err
+	}
+	return h.host.Network().ClosePeer(peerId)
+}
+
 func (h *IdenaGossipHandler) WrongTime() bool {
 	return h.wrongTime
 }
@@ -0,0 +1,82 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common/pushpull"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPeer(id string, capabilities uint32) *protoPeer {
+	return &protoPeer{
+		id:             peer.ID(id),
+		msgCache:       cache.New(msgCacheAliveTime, msgCacheGcTime),
+		queuedRequests: make(chan *request, 10),
+		finished:       make(chan struct{}),
+		capabilities:   capabilities,
+	}
+}
+
+func sentRequests(p *protoPeer) []*request {
+	var result []*request
+	for {
+		select {
+		case r := <-p.queuedRequests:
+			result = append(result, r)
+		default:
+			return result
+		}
+	}
+}
+
+func TestIdenaGossipHandler_broadcastFlipKey(t *testing.T) {
+	h := &IdenaGossipHandler{
+		peers:           newPeerSet(),
+		pushPullManager: NewPushPullManager(),
+	}
+	h.pushPullManager.AddEntryHolder(pushFlipKey, pushpull.NewDefaultHolder(1, pushpull.NewDefaultPushTracker(time.Millisecond*300)))
+
+	digestPeer, legacyPeer, knownPeer := newTestPeer("digest", capabilityFlipKeyDigests), newTestPeer("legacy", 0), newTestPeer("known", capabilityFlipKeyDigests)
+	for _, p := range []*protoPeer{digestPeer, legacyPeer, knownPeer} {
+		require.NoError(t, h.peers.Register(p))
+	}
+
+	flipKey := &types.PublicFlipKey{Key: []byte{0x1, 0x2}, Signature: []byte{0x3}, Epoch: 1}
+	data, _ := flipKey.ToBytes()
+	knownPeer.markPayloadWithExpiration(data, flipKeyMsgCacheAliveTime)
+
+	h.broadcastFlipKey(flipKey, false)
+
+	digest := pushPullHash{Type: pushFlipKey, Hash: flipKey.Hash128()}
+	require.Equal(t, []*request{{msgcode: Push, data: digest}}, sentRequests(digestPeer))
+	require.Equal(t, []*request{{msgcode: FlipKey, data: flipKey}}, sentRequests(legacyPeer))
+	require.Empty(t, sentRequests(knownPeer))
+
+	// the body is sent to the peer which pulls the digest
+	entry, _, present := h.pushPullManager.GetEntry(digest)
+	require.True(t, present)
+	require.Equal(t, flipKey, entry)
+
+	// the key is announced once
+	h.broadcastFlipKey(flipKey, false)
+	require.Empty(t, sentRequests(digestPeer))
+	require.Empty(t, sentRequests(legacyPeer))
+}
+
+func TestPublicFlipKey_Hash128(t *testing.T) {
+	key1 := &types.PublicFlipKey{Key: []byte{0x1}, Epoch: 1}
+	key2 := &types.PublicFlipKey{Key: []byte{0x1}, Epoch: 2}
+	require.Equal(t, key1.Hash128(), (&types.PublicFlipKey{Key: []byte{0x1}, Epoch: 1}).Hash128())
+	require.NotEqual(t, key1.Hash128(), key2.Hash128())
+
+	hash := pushPullHash{Type: pushFlipKey, Hash: key1.Hash128()}
+	require.True(t, hash.IsValid())
+	data, _ := hash.ToBytes()
+	var decoded pushPullHash
+	require.NoError(t, decoded.FromBytes(data))
+	require.Equal(t, hash, decoded)
+}
@@ -0,0 +1,41 @@
+package protocol
+
+import (
+	"context"
+	"github.com/libp2p/go-libp2p/p2p/protocol/ping"
+	"time"
+)
+
+const (
+	latencyMeasurementInterval = time.Minute
+	pingTimeout                = time.Second * 10
+)
+
+// measureLatency pings the connected peers once, measured round trip times are recorded to the peerstore
+func (h *IdenaGossipHandler) measureLatency() {
+	for _, p := range h.peers.Peers() {
+		go func(p *protoPeer) {
+			ctx, cancel := context.WithTimeout(context.Background(), pingTimeout)
+			defer cancel()
+			if res := <-ping.Ping(ctx, h.host, p.id); res.Error != nil {
+				p.log.Trace("failed to ping peer", "err", res.Error)
+			}
+		}(p)
+	}
+}
+
+// PeersLatency returns the average latency of the connected peers, zero if it is not measured yet
+func (h *IdenaGossipHandler) PeersLatency() time.Duration {
+	var sum time.Duration
+	var cnt int64
+	for _, p := range h.peers.Peers() {
+		if latency := h.host.Peerstore().LatencyEWMA(p.id); latency > 0 {
+			sum += latency
+			cnt++
+		}
+	}
+	if cnt == 0 {
+		return 0
+	}
+	return sum / time.Duration(cnt)
+}
@@ -139,6 +139,49 @@ func (rm *peersRateMetrics) loopLog(logger log.Logger) {
 	}
 }
 
+func msgCodeToString(code uint64) string {
+	switch code {
+	case Handshake:
+		return "handshake"
+	case ProposeBlock:
+		return "proposeBlock"
+	case ProposeProof:
+		return "proposeProof"
+	case Vote:
+		return "vote"
+	case NewTx:
+		return "newTx"
+	case GetBlockByHash:
+		return "getBlockByHash"
+	case GetBlocksRange:
+		return "getBlocksRange"
+	case BlocksRange:
+		return "blockRange"
+	case FlipBody:
+		return "flipBody"
+	case FlipKey:
+		return "flipKey"
+	case SnapshotManifest:
+		return "snapshotManifest"
+	case Push:
+		return "push"
+	case Pull:
+		return "pull"
+	case GetForkBlockRange:
+		return "getForkBlockRange"
+	case FlipKeysPackage:
+		return "flipKeysPackage"
+	case Block:
+		return "block"
+	case CheckpointAnnouncement:
+		return "checkpointAnnouncement"
+	case PeerAddrs:
+		return "peerAddrs"
+	default:
+		return fmt.Sprintf("unknown code %v", code)
+	}
+}
+
 func (h *IdenaGossipHandler) registerMetrics() {
 
 	totalSent := metrics.GetOrRegisterCounter("bs.total", metrics.DefaultRegistry)
@@ -146,45 +189,6 @@ func (h *IdenaGossipHandler) registerMetrics() {
 	compressTotal := metrics.GetOrRegisterCounter("cd.total", metrics.DefaultRegistry)
 	rate := newPeersRateMetrics(h.ceremonyChecker.IsRunning)
 
-	msgCodeToString := func(code uint64) string {
-		switch code {
-		case Handshake:
-			return "handshake"
-		case ProposeBlock:
-			return "proposeBlock"
-		case ProposeProof:
-			return "proposeProof"
-		case Vote:
-			return "vote"
-		case NewTx:
-			return "newTx"
-		case GetBlockByHash:
-			return "getBlockByHash"
-		case GetBlocksRange:
-			return "getBlocksRange"
-		case BlocksRange:
-			return "blockRange"
-		case FlipBody:
-			return "flipBody"
-		case FlipKey:
-			return "flipKey"
-		case SnapshotManifest:
-			return "snapshotManifest"
-		case Push:
-			return "push"
-		case Pull:
-			return "pull"
-		case GetForkBlockRange:
-			return "getForkBlockRange"
-		case FlipKeysPackage:
-			return "flipKeysPackage"
-		case Block:
-			return "block"
-		default:
-			return fmt.Sprintf("unknown code %v", code)
-		}
-	}
-
 	sortedMetricCodes := []uint64{
 		Block,
 		BlocksRange,
@@ -38,8 +38,9 @@ const (
 type compression = byte
 
 const (
-	noCompression compression = 0
-	s2Compression compression = 1
+	noCompression   compression = 0
+	s2Compression   compression = 1
+	zstdCompression compression = 2
 )
 
 type syncHeight struct {
@@ -72,6 +73,7 @@ type protoPeer struct {
 	manifestLock         sync.Mutex
 	manifest             *snapshot.Manifest
 	queuedRequests       chan *request
+	ceremonyRequests     chan *request
 	highPriorityRequests chan *request
 	term                 chan struct{}
 	finished             chan struct{}
@@ -84,9 +86,19 @@ type protoPeer struct {
 	peers                uint32
 	metrics              *metricCollector
 	skippedRequestsCount uint32
+	isCeremonyMsg        func(msgcode uint64, payload interface{}) bool
+	traffic              *peerTraffic
+	uploadLimiter        *rateLimiter
+	downloadLimiter      *rateLimiter
+	// capabilities are negotiated ones supported by both sides, remoteCapabilities are advertised by the peer
+	capabilities       uint32
+	remoteCapabilities uint32
+	// lastPeerAddrs is the time the peer shared addresses of other peers last time
+	lastPeerAddrs time.Time
 }
 
-func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *protoPeer {
+func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector, isCeremonyMsg func(msgcode uint64, payload interface{}) bool,
+	uploadRate, downloadRate int) *protoPeer {
 	stream.Conn().RemotePeer()
 	rw := msgio.NewReadWriter(stream)
 
@@ -98,6 +110,7 @@ func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *p
 		stream:               stream,
 		rw:                   rw,
 		queuedRequests:       make(chan *request, queuedRequestsSize),
+		ceremonyRequests:     make(chan *request, queuedCeremonyRequestsSize),
 		highPriorityRequests: make(chan *request, queuedHighPriorityRequestsSize),
 		term:                 make(chan struct{}),
 		finished:             make(chan struct{}),
@@ -109,24 +122,40 @@ func newPeer(stream network.Stream, maxDelayMs int, metrics *metricCollector) *p
 		transportErr:         make(chan error, 1),
 		knownHeight:          &syncHeight{},
 		potentialHeight:      &syncHeight{},
+		isCeremonyMsg:        isCeremonyMsg,
+		traffic:              newPeerTraffic(),
+		uploadLimiter:        newRateLimiter(uploadRate),
+		downloadLimiter:      newRateLimiter(downloadRate),
 	}
 	return p
 }
 
 func (p *protoPeer) sendMsg(msgcode uint64, payload interface{}, highPriority bool) {
+	p.sendRequest(&request{msgcode: msgcode, data: payload}, highPriority)
+}
+
+func (p *protoPeer) sendRequest(r *request, highPriority bool) {
+	msgcode, payload := r.msgcode, r.data
 	if highPriority {
 		timer := time.NewTimer(time.Second * 5)
 		defer timer.Stop()
 		select {
-		case p.highPriorityRequests <- &request{msgcode: msgcode, data: payload}:
+		case p.highPriorityRequests <- r:
 		case <-timer.C:
 			p.log.Error("TIMEOUT while sending message (high priority)", "addr", p.stream.Conn().RemoteMultiaddr().String(), "len", len(p.highPriorityRequests))
 			p.disconnect()
 		case <-p.finished:
 		}
+	} else if p.isCeremonyMsg != nil && p.isCeremonyMsg(msgcode, payload) {
+		select {
+		case p.ceremonyRequests <- r:
+		case <-p.finished:
+		default:
+			p.log.Warn("ceremony requests queue is full", "addr", p.stream.Conn().RemoteMultiaddr().String())
+		}
 	} else {
 		select {
-		case p.queuedRequests <- &request{msgcode: msgcode, data: payload}:
+		case p.queuedRequests <- r:
 			atomic.StoreUint32(&p.skippedRequestsCount, 0)
 		case <-p.finished:
 		default:
@@ -143,7 +172,8 @@ func (p *protoPeer) broadcast() {
 	defer close(p.finished)
 	defer p.disconnect()
 	send := func(request *request) error {
-		msg := makeMsg(request.msgcode, request.data)
+		msg := makeMsg(request.msgcode, request.data, p.supports(capabilityZstdCompression))
+		p.uploadLimiter.wait(len(msg))
 
 		ch := make(chan error, 1)
 		timer := time.NewTimer(time.Minute)
@@ -168,14 +198,20 @@ func (p *protoPeer) broadcast() {
 			return err
 		}
 		duration := time.Since(startTime)
+		routeDelay(request)
+		p.traffic.addOut(request.msgcode, len(msg))
 		p.metrics.outcomeMessage(request.msgcode, len(msg), duration, p.prettyId)
 		return nil
 	}
 	logIfNeeded := func(r *request) {
+		if r.fastRoute {
+			return
+		}
 		if r.msgcode == Push || r.msgcode == NewTx || r.msgcode == FlipKey {
 			p.log.Info(fmt.Sprintf("Sent high priority msg, code %v", r.msgcode))
 		}
 	}
+	ceremonySent := 0
 	for {
 		if p.maxDelayMs > 0 {
 			delay := time.Duration(rand.Int31n(int32(p.maxDelayMs)))
@@ -191,23 +227,41 @@ func (p *protoPeer) broadcast() {
 		default:
 		}
 
+		if ceremonySent < ceremonyRequestsPerRegular {
+			select {
+			case request := <-p.ceremonyRequests:
+				if send(request) != nil {
+					return
+				}
+				ceremonySent++
+				continue
+			default:
+			}
+		}
+
 		select {
 		case request := <-p.highPriorityRequests:
 			if send(request) != nil {
 				return
 			}
 			logIfNeeded(request)
+		case request := <-p.ceremonyRequests:
+			if send(request) != nil {
+				return
+			}
+			ceremonySent++
 		case request := <-p.queuedRequests:
 			if send(request) != nil {
 				return
 			}
+			ceremonySent = 0
 		case <-p.term:
 			return
 		}
 	}
 }
 
-func makeMsg(msgcode uint64, payload interface{}) []byte {
+func makeMsg(msgcode uint64, payload interface{}, zstdSupported bool) []byte {
 	data, err := toBytes(msgcode, payload)
 	if err != nil {
 		panic(err)
@@ -216,7 +270,7 @@ func makeMsg(msgcode uint64, payload interface{}) []byte {
 	if err != nil {
 		panic(err)
 	}
-	return Encode(msgcode, msg)
+	return Encode(msgcode, msg, zstdSupported)
 }
 
 func toBytes(msgcode uint64, payload interface{}) ([]byte, error) {
@@ -252,11 +306,15 @@ func toBytes(msgcode uint64, payload interface{}) ([]byte, error) {
 		return pullPush.ToBytes()
 	case Block:
 		return payload.(*types.Block).ToBytes()
+	case CheckpointAnnouncement:
+		return payload.(*types.CheckpointAnnouncement).ToBytes()
+	case PeerAddrs:
+		return proto.Marshal(payload.(*models.ProtoPeerAddrs))
 	}
 	return nil, errors.Errorf("type %T is not serializable", payload)
 }
 
-func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *types.GenesisInfo, appVersion string, peersCount uint32) error {
+func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *types.GenesisInfo, appVersion string, peersCount uint32, capabilities uint32) error {
 	errc := make(chan error, 2)
 	handShake := new(handshakeData)
 	p.log.Trace("start handshake")
@@ -268,13 +326,14 @@ func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *typ
 			Timestamp:    time.Now().UTC().Unix(),
 			AppVersion:   appVersion,
 			Peers:        peersCount,
+			Capabilities: capabilities,
 		}
 		if genesis.OldGenesis != nil {
 			hash := genesis.OldGenesis.Hash()
 			data.OldGenesis = &hash
 		}
 
-		msg := makeMsg(Handshake, data)
+		msg := makeMsg(Handshake, data, false)
 		errc <- p.rw.WriteMsg(msg)
 		p.log.Trace("handshake message sent")
 	}()
@@ -295,6 +354,8 @@ func (p *protoPeer) Handshake(network types.Network, height uint64, genesis *typ
 	}
 	p.knownHeight.Store(handShake.Height)
 	p.peers = handShake.Peers
+	p.remoteCapabilities = handShake.Capabilities
+	p.capabilities = handShake.Capabilities & capabilities
 	return nil
 }
 
@@ -309,15 +370,21 @@ func Decode(src []byte) ([]byte, error) {
 		return src[1:], nil
 	case s2Compression:
 		return s2.Decode(nil, src[1:])
+	case zstdCompression:
+		return zstdDecode(src[1:])
 	default:
 		return nil, errors.New("unknown compression")
 	}
 }
 
-func Encode(msgcode uint64, src []byte) []byte {
+// Encode compresses the message, zstd is used for large blocks and flips if the receiver supports it and s2 otherwise
+func Encode(msgcode uint64, src []byte, zstdSupported bool) []byte {
 	if msgcode == FlipKeysPackage || len(src) < minCompressionSize {
 		return append([]byte{noCompression}, src...)
 	}
+	if zstdSupported && len(src) >= minZstdCompressionSize && isLargePayloadMsg(msgcode) {
+		return zstdEncode(src)
+	}
 	return append([]byte{s2Compression}, s2.Encode(nil, src)...)
 }
 
@@ -343,6 +410,8 @@ func (p *protoPeer) ReadMsg() (*Msg, error) {
 	}
 	p.metrics.incomeMessage(result.Code, len(compressedMsg), duration, p.prettyId)
 	p.metrics.compress(result.Code, len(data)-len(compressedMsg))
+	p.traffic.addIn(result.Code, len(compressedMsg))
+	p.downloadLimiter.wait(len(compressedMsg))
 	return result, nil
 }
 
@@ -422,6 +491,21 @@ func (p *protoPeer) disconnect() {
 	}
 }
 
+// Capabilities returns capabilities advertised by the peer
+func (p *protoPeer) Capabilities() uint32 {
+	return p.remoteCapabilities
+}
+
+func (p *protoPeer) supports(capability uint32) bool {
+	return p.capabilities&capability != 0
+}
+
+// servesSnapshots checks the capability advertised by the peer rather than the negotiated one since the node
+// fast syncs from snapshots even if it doesn't serve them, peers of older versions serve snapshots without advertising it
+func (p *protoPeer) servesSnapshots() bool {
+	return p.remoteCapabilities == 0 || p.remoteCapabilities&capabilitySnapshotServing != 0
+}
+
 func (p *protoPeer) ID() string {
 	return p.id.Pretty()
 }
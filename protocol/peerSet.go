@@ -100,6 +100,21 @@ func (ps *peerSet) SendWithFilterAndExpiration(msgcode uint64, key string, paylo
 	}
 }
 
+// SendWithFilterByLatency sends the message to the fastest peers with high priority first and queues it for the rest
+func (ps *peerSet) SendWithFilterByLatency(msgcode uint64, key string, payload interface{}, latency func(id peer2.ID) time.Duration) {
+	peers := ps.Peers()
+	sortByLatency(peers, latency)
+	fastCnt := fastRoutePeersCount(len(peers))
+	now := time.Now()
+	for idx, p := range peers {
+		if _, ok := p.msgCache.Get(key); !ok {
+			p.markKeyWithExpiration(key, msgCacheAliveTime)
+			fast := idx < fastCnt
+			p.sendRequest(&request{msgcode: msgcode, data: payload, enqueuedAt: now, fastRoute: fast}, fast)
+		}
+	}
+}
+
 func (ps *peerSet) Send(msgcode uint64, payload interface{}) {
 	peers := ps.Peers()
 
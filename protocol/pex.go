@@ -0,0 +1,208 @@
+package protocol
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	models "github.com/idena-network/idena-go/protobuf"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	peerExchangeInterval = time.Minute * 2
+	// peers can't share addresses more often than minPeerExchangeInterval, extra messages are ignored
+	minPeerExchangeInterval = time.Minute
+	maxExchangedAddrs       = 16
+	maxExchangedPeers       = 500
+	exchangedPeerTTL        = time.Hour
+)
+
+type exchangedPeer struct {
+	info    peer.AddrInfo
+	addedAt time.Time
+}
+
+// peerExchange keeps addresses of good peers shared by the connected ones, they are dialed when the node has
+// no more connections to pick outbound peers from, so the node doesn't depend on bootstrap nodes to heal the mesh
+type peerExchange struct {
+	peers map[peer.ID]exchangedPeer
+	mutex sync.Mutex
+	gauge metrics.Gauge
+}
+
+func newPeerExchange() *peerExchange {
+	return &peerExchange{
+		peers: make(map[peer.ID]exchangedPeer),
+		gauge: metrics.GetOrRegisterGauge("p2p.pex.knownPeers", metrics.DefaultRegistry),
+	}
+}
+
+func (e *peerExchange) add(info peer.AddrInfo) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if _, ok := e.peers[info.ID]; !ok && len(e.peers) >= maxExchangedPeers {
+		e.expire()
+		if len(e.peers) >= maxExchangedPeers {
+			return
+		}
+	}
+	e.peers[info.ID] = exchangedPeer{
+		info:    info,
+		addedAt: time.Now(),
+	}
+	e.gauge.Update(int64(len(e.peers)))
+}
+
+// pop removes and returns a random not expired peer
+func (e *peerExchange) pop() (peer.AddrInfo, bool) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.expire()
+	if len(e.peers) == 0 {
+		return peer.AddrInfo{}, false
+	}
+	idx := rand.Intn(len(e.peers))
+	for id, item := range e.peers {
+		if idx == 0 {
+			delete(e.peers, id)
+			e.gauge.Update(int64(len(e.peers)))
+			return item.info, true
+		}
+		idx--
+	}
+	return peer.AddrInfo{}, false
+}
+
+func (e *peerExchange) expire() {
+	for id, item := range e.peers {
+		if time.Since(item.addedAt) > exchangedPeerTTL {
+			delete(e.peers, id)
+		}
+	}
+	e.gauge.Update(int64(len(e.peers)))
+}
+
+func (e *peerExchange) count() int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return len(e.peers)
+}
+
+// peerAddrsSample returns public addresses of a random sample of connected peers with non-negative scores,
+// the recipient and trusted peers are excluded
+func (h *IdenaGossipHandler) peerAddrsSample(recipient peer.ID) [][]byte {
+	peers := h.peers.Peers()
+	rand.Shuffle(len(peers), func(i, j int) {
+		peers[i], peers[j] = peers[j], peers[i]
+	})
+	var result [][]byte
+	for _, p := range peers {
+		if len(result) >= maxExchangedAddrs {
+			break
+		}
+		if p.id == recipient || h.isTrustedPeer(p.id) || h.reputation.score(p.id) < 0 {
+			continue
+		}
+		var addrs []multiaddr.Multiaddr
+		for _, addr := range h.host.Peerstore().Addrs(p.id) {
+			if manet.IsPublicAddr(addr) {
+				addrs = append(addrs, addr)
+			}
+		}
+		if len(addrs) == 0 {
+			continue
+		}
+		p2pAddrs, err := peer.AddrInfoToP2pAddrs(&peer.AddrInfo{ID: p.id, Addrs: addrs})
+		if err != nil {
+			continue
+		}
+		for _, addr := range p2pAddrs {
+			if len(result) >= maxExchangedAddrs {
+				break
+			}
+			result = append(result, addr.Bytes())
+		}
+	}
+	return result
+}
+
+// sendPeerAddrs shares a sample of known good peers with the peer
+func (h *IdenaGossipHandler) sendPeerAddrs(p *protoPeer) {
+	if h.capabilities&capabilityPeerExchange == 0 || p.capabilities&capabilityPeerExchange == 0 {
+		return
+	}
+	addrs := h.peerAddrsSample(p.id)
+	if len(addrs) == 0 {
+		return
+	}
+	p.sendMsg(PeerAddrs, &models.ProtoPeerAddrs{Addrs: addrs}, false)
+}
+
+func (h *IdenaGossipHandler) exchangePeers() {
+	for _, p := range h.peers.Peers() {
+		h.sendPeerAddrs(p)
+	}
+}
+
+// receivePeerAddrs remembers shared peers which are public, not connected and not banned
+func (h *IdenaGossipHandler) receivePeerAddrs(p *protoPeer, addrs [][]byte) {
+	if h.capabilities&capabilityPeerExchange == 0 {
+		return
+	}
+	if time.Since(p.lastPeerAddrs) < minPeerExchangeInterval {
+		return
+	}
+	p.lastPeerAddrs = time.Now()
+	infos := make(map[peer.ID]*peer.AddrInfo)
+	for _, data := range addrs {
+		addr, err := multiaddr.NewMultiaddrBytes(data)
+		if err != nil {
+			continue
+		}
+		info, err := peer.AddrInfoFromP2pAddr(addr)
+		if err != nil || len(info.Addrs) == 0 || !manet.IsPublicAddr(info.Addrs[0]) {
+			continue
+		}
+		if existing, ok := infos[info.ID]; ok {
+			existing.Addrs = append(existing.Addrs, info.Addrs...)
+			continue
+		}
+		infos[info.ID] = info
+	}
+	for id, info := range infos {
+		if id == h.host.ID() || id == p.id || h.peers.Peer(id) != nil || !h.connManager.CanConnect(id) {
+			continue
+		}
+		h.pex.add(*info)
+	}
+}
+
+// dialExchangedPeer connects to a random peer shared by the connected ones
+func (h *IdenaGossipHandler) dialExchangedPeer() bool {
+	info, ok := h.pex.pop()
+	if !ok {
+		return false
+	}
+	if h.peers.Peer(info.ID) != nil || !h.connManager.CanConnect(info.ID) {
+		return true
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	err := h.host.Connect(ctx, info)
+	cancel()
+	if err != nil {
+		h.log.Debug("Failed to dial exchanged peer", "id", info.ID.Pretty(), "err", err)
+		return true
+	}
+	stream, err := h.connManager.newStream(info.ID)
+	if err != nil {
+		h.log.Debug("Failed to open stream to exchanged peer", "id", info.ID.Pretty(), "err", err)
+		return true
+	}
+	h.runPeer(stream, false)
+	return true
+}
@@ -0,0 +1,154 @@
+package protocol
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/database"
+	"github.com/libp2p/go-libp2p-core/host"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p-peerstore/pstoremem"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+)
+
+// testHost provides the peer id and the peerstore only
+type testHost struct {
+	host.Host
+	id        peer.ID
+	peerstore peerstore.Peerstore
+}
+
+func (h *testHost) ID() peer.ID {
+	return h.id
+}
+
+func (h *testHost) Peerstore() peerstore.Peerstore {
+	return h.peerstore
+}
+
+func newTestPexHandler(t *testing.T, trusted ...string) *IdenaGossipHandler {
+	host := &testHost{id: newTestPeerID(t), peerstore: pstoremem.NewPeerstore()}
+	return &IdenaGossipHandler{
+		host:         host,
+		peers:        newPeerSet(),
+		connManager:  NewConnManager(host, config.P2P{TrustedPeers: trusted}),
+		reputation:   newPeerReputation(database.NewRepo(db.NewMemDB())),
+		pex:          newPeerExchange(),
+		capabilities: capabilityPeerExchange,
+	}
+}
+
+func p2pAddrBytes(t *testing.T, addr string, id peer.ID) []byte {
+	ma, err := multiaddr.NewMultiaddr(addr + "/p2p/" + id.Pretty())
+	require.NoError(t, err)
+	return ma.Bytes()
+}
+
+func TestPeerExchange(t *testing.T) {
+	e := newPeerExchange()
+	_, ok := e.pop()
+	require.False(t, ok)
+
+	e.add(peer.AddrInfo{ID: "p1"})
+	e.add(peer.AddrInfo{ID: "p2"})
+	e.add(peer.AddrInfo{ID: "p2"})
+	require.Equal(t, 2, e.count())
+
+	popped := make(map[peer.ID]bool)
+	for i := 0; i < 2; i++ {
+		info, ok := e.pop()
+		require.True(t, ok)
+		popped[info.ID] = true
+	}
+	require.Equal(t, map[peer.ID]bool{"p1": true, "p2": true}, popped)
+	_, ok = e.pop()
+	require.False(t, ok)
+
+	// expired peers are not returned
+	e.add(peer.AddrInfo{ID: "p1"})
+	e.peers["p1"] = exchangedPeer{info: peer.AddrInfo{ID: "p1"}, addedAt: time.Now().Add(-exchangedPeerTTL - time.Second)}
+	_, ok = e.pop()
+	require.False(t, ok)
+	require.Zero(t, e.count())
+}
+
+func TestPeerExchange_capacity(t *testing.T) {
+	e := newPeerExchange()
+	for i := 0; i < maxExchangedPeers; i++ {
+		e.add(peer.AddrInfo{ID: peer.ID(strconv.Itoa(i))})
+	}
+	e.add(peer.AddrInfo{ID: "extra"})
+	require.Equal(t, maxExchangedPeers, e.count())
+	_, ok := e.peers["extra"]
+	require.False(t, ok)
+
+	// an expired peer frees the slot
+	e.peers[peer.ID(strconv.Itoa(0))] = exchangedPeer{addedAt: time.Now().Add(-exchangedPeerTTL - time.Second)}
+	e.add(peer.AddrInfo{ID: "extra"})
+	require.Equal(t, maxExchangedPeers, e.count())
+	_, ok = e.peers["extra"]
+	require.True(t, ok)
+}
+
+func TestIdenaGossipHandler_receivePeerAddrs(t *testing.T) {
+	h := newTestPexHandler(t)
+	sender := newTestPeer("sender", capabilityPeerExchange)
+	public, private, banned, connected := newTestPeerID(t), newTestPeerID(t), newTestPeerID(t), newTestPeerID(t)
+	require.NoError(t, h.peers.Register(newTestPeer(string(connected), capabilityPeerExchange)))
+	h.connManager.BanPeer(banned)
+
+	h.receivePeerAddrs(sender, [][]byte{
+		p2pAddrBytes(t, "/ip4/8.8.8.8/tcp/40404", public),
+		p2pAddrBytes(t, "/ip4/8.8.4.4/tcp/40404", public),
+		p2pAddrBytes(t, "/ip4/10.0.0.1/tcp/40404", private),
+		p2pAddrBytes(t, "/ip4/8.8.8.9/tcp/40404", banned),
+		p2pAddrBytes(t, "/ip4/8.8.8.10/tcp/40404", connected),
+		p2pAddrBytes(t, "/ip4/8.8.8.11/tcp/40404", h.host.ID()),
+		{0x1, 0x2},
+	})
+	require.Equal(t, 1, h.pex.count())
+	require.Len(t, h.pex.peers[public].info.Addrs, 2)
+
+	// the peer can't share addresses too often
+	other := newTestPeerID(t)
+	h.receivePeerAddrs(sender, [][]byte{p2pAddrBytes(t, "/ip4/8.8.8.12/tcp/40404", other)})
+	require.Equal(t, 1, h.pex.count())
+
+	sender.lastPeerAddrs = time.Now().Add(-minPeerExchangeInterval - time.Second)
+	h.receivePeerAddrs(sender, [][]byte{p2pAddrBytes(t, "/ip4/8.8.8.12/tcp/40404", other)})
+	require.Equal(t, 2, h.pex.count())
+
+	// addresses are ignored if the exchange is disabled
+	h.capabilities = 0
+	sender.lastPeerAddrs = time.Time{}
+	h.receivePeerAddrs(sender, [][]byte{p2pAddrBytes(t, "/ip4/8.8.8.13/tcp/40404", newTestPeerID(t))})
+	require.Equal(t, 2, h.pex.count())
+}
+
+func TestIdenaGossipHandler_peerAddrsSample(t *testing.T) {
+	trusted := newTestPeerID(t)
+	h := newTestPexHandler(t, "/ip4/8.8.8.8/tcp/40404/p2p/"+trusted.Pretty())
+	good, private, bad, recipient := newTestPeerID(t), newTestPeerID(t), newTestPeerID(t), newTestPeerID(t)
+	addAddr := func(id peer.ID, addr string) {
+		ma, err := multiaddr.NewMultiaddr(addr)
+		require.NoError(t, err)
+		h.host.Peerstore().AddAddr(id, ma, peerstore.PermanentAddrTTL)
+	}
+	addAddr(good, "/ip4/8.8.8.1/tcp/40404")
+	addAddr(good, "/ip4/192.168.0.1/tcp/40404")
+	addAddr(private, "/ip4/10.0.0.1/tcp/40404")
+	addAddr(bad, "/ip4/8.8.8.2/tcp/40404")
+	addAddr(recipient, "/ip4/8.8.8.3/tcp/40404")
+	addAddr(trusted, "/ip4/8.8.8.4/tcp/40404")
+	for _, id := range []peer.ID{good, private, bad, recipient, trusted} {
+		require.NoError(t, h.peers.Register(newTestPeer(string(id), capabilityPeerExchange)))
+	}
+	h.reputation.add(bad, -1)
+
+	require.Equal(t, [][]byte{p2pAddrBytes(t, "/ip4/8.8.8.1/tcp/40404", good)}, h.peerAddrsSample(recipient))
+}
@@ -0,0 +1,47 @@
+package protocol
+
+import "github.com/idena-network/idena-go/blockchain/types"
+
+const (
+	// queuedCeremonyRequestsSize is the number of slots of the peer queue reserved for ceremony messages
+	queuedCeremonyRequestsSize = 5000
+	// ceremonyRequestsPerRegular is the number of ceremony messages sent to the peer before a regular one
+	// while both queues are not empty, so blocks and ordinary txs are delayed but not starved during the ceremony
+	ceremonyRequestsPerRegular = 4
+)
+
+// isCeremonyMsg returns true if the message should be sent through the ceremony queue: flip keys and ceremonial txs
+// get it while the validation ceremony is running
+func (h *IdenaGossipHandler) isCeremonyMsg(msgcode uint64, payload interface{}) bool {
+	if h.ceremonyChecker == nil || !h.ceremonyChecker.IsRunning() {
+		return false
+	}
+	switch msgcode {
+	case FlipKey, FlipKeysPackage:
+		return true
+	case NewTx:
+		return isCeremonialTx(payload)
+	case Push, Pull:
+		hash, ok := payload.(pushPullHash)
+		if !ok {
+			return false
+		}
+		switch hash.Type {
+		case pushKeyPackage, pushFlipKey:
+			return true
+		case pushTx:
+			entry, _, present := h.pushPullManager.GetEntry(hash)
+			return present && isCeremonialTx(entry)
+		}
+	}
+	return false
+}
+
+func isCeremonialTx(payload interface{}) bool {
+	tx, ok := payload.(*types.Transaction)
+	if !ok {
+		return false
+	}
+	_, ceremonial := types.CeremonialTxs[tx.Type]
+	return ceremonial
+}
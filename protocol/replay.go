@@ -0,0 +1,100 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/willf/bloom"
+)
+
+const (
+	replayWindowPeriod      = 10 * time.Minute
+	replayFalsePositiveRate = 0.0001
+	// consensus messages of rounds older than the head by more than maxReplayRoundLag are rejected as replays
+	maxReplayRoundLag = 5
+)
+
+var ReplayedMsg = errors.New("message is older than the replay window")
+
+// replayWindow is a rolling set of recently seen message ids of a topic built of two bloom filters, the current one
+// becomes the previous one when it gets full or the period elapses, so an id is remembered for at least the period
+// unless the topic exceeds the capacity
+type replayWindow struct {
+	current   *bloom.BloomFilter
+	previous  *bloom.BloomFilter
+	count     uint
+	capacity  uint
+	rotatedAt time.Time
+	mutex     sync.Mutex
+}
+
+func newReplayWindow(capacity uint) *replayWindow {
+	return &replayWindow{
+		current:   bloom.NewWithEstimates(capacity, replayFalsePositiveRate),
+		previous:  bloom.NewWithEstimates(capacity, replayFalsePositiveRate),
+		capacity:  capacity,
+		rotatedAt: time.Now(),
+	}
+}
+
+// seen reports whether the id is in the window and adds it otherwise
+func (w *replayWindow) seen(id []byte) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.current.Test(id) || w.previous.Test(id) {
+		return true
+	}
+	if w.count >= w.capacity || time.Since(w.rotatedAt) > replayWindowPeriod {
+		w.previous, w.current = w.current, w.previous
+		w.current.ClearAll()
+		w.count = 0
+		w.rotatedAt = time.Now()
+	}
+	w.current.Add(id)
+	w.count++
+	return false
+}
+
+// replayProtection keeps replay windows of gossip topics, a nil value disables the protection
+type replayProtection struct {
+	windows map[uint64]*replayWindow
+}
+
+func newReplayProtection(capacity int) *replayProtection {
+	if capacity <= 0 {
+		return nil
+	}
+	windows := make(map[uint64]*replayWindow)
+	// only consensus and flip key messages are replayed from old rounds and epochs, other topics rely on the message
+	// cache of peers since every window takes about 48 KB per 10k of capacity
+	for _, topic := range []uint64{ProposeBlock, ProposeProof, Vote, FlipKey, FlipKeysPackage} {
+		windows[topic] = newReplayWindow(uint(capacity))
+	}
+	return &replayProtection{
+		windows: windows,
+	}
+}
+
+func (r *replayProtection) seen(topic uint64, payload []byte) bool {
+	if r == nil {
+		return false
+	}
+	window, ok := r.windows[topic]
+	if !ok {
+		return false
+	}
+	return window.seen([]byte(msgKey(payload)))
+}
+
+// checkRound rejects consensus messages of rounds which are too old to be useful, the sender is not scored since
+// honest peers relay stale messages while they are catching up
+func (h *IdenaGossipHandler) checkRound(round uint64) error {
+	if h.replay == nil {
+		return nil
+	}
+	if round+maxReplayRoundLag < h.bcn.Head.Height()+1 {
+		return ReplayedMsg
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReplayWindow_Capacity(t *testing.T) {
+	w := newReplayWindow(2)
+	require.False(t, w.seen([]byte{0x1}))
+	require.False(t, w.seen([]byte{0x2}))
+	require.True(t, w.seen([]byte{0x1}))
+
+	// the full window is rotated, previous ids are still remembered
+	require.False(t, w.seen([]byte{0x3}))
+	require.True(t, w.seen([]byte{0x1}))
+	require.True(t, w.seen([]byte{0x2}))
+	require.False(t, w.seen([]byte{0x4}))
+
+	// the second rotation forgets the first ids
+	require.False(t, w.seen([]byte{0x5}))
+	require.True(t, w.seen([]byte{0x3}))
+	require.True(t, w.seen([]byte{0x4}))
+	require.False(t, w.seen([]byte{0x1}))
+}
+
+func TestReplayWindow_Period(t *testing.T) {
+	w := newReplayWindow(100)
+	require.False(t, w.seen([]byte{0x1}))
+
+	w.rotatedAt = time.Now().Add(-replayWindowPeriod - time.Second)
+	require.False(t, w.seen([]byte{0x2}))
+	require.Equal(t, uint(1), w.count)
+	require.True(t, w.seen([]byte{0x1}))
+
+	w.rotatedAt = time.Now().Add(-replayWindowPeriod - time.Second)
+	require.False(t, w.seen([]byte{0x3}))
+	require.True(t, w.seen([]byte{0x2}))
+	require.False(t, w.seen([]byte{0x1}))
+}
+
+func TestReplayProtection_seen(t *testing.T) {
+	require.Nil(t, newReplayProtection(0))
+	var disabled *replayProtection
+	require.False(t, disabled.seen(Vote, []byte{0x1}))
+	require.False(t, disabled.seen(Vote, []byte{0x1}))
+
+	r := newReplayProtection(10)
+	for _, topic := range []uint64{ProposeBlock, ProposeProof, Vote, FlipKey, FlipKeysPackage} {
+		require.False(t, r.seen(topic, []byte{0x1}))
+		require.True(t, r.seen(topic, []byte{0x1}))
+	}
+	for _, topic := range []uint64{Block, CheckpointAnnouncement, NewTx} {
+		require.False(t, r.seen(topic, []byte{0x1}))
+		require.False(t, r.seen(topic, []byte{0x1}))
+	}
+}
+
+func TestIdenaGossipHandler_checkRound(t *testing.T) {
+	h := &IdenaGossipHandler{
+		bcn: &blockchain.Blockchain{Head: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 10}}},
+	}
+	require.NoError(t, h.checkRound(1))
+
+	h.replay = newReplayProtection(10)
+	require.NoError(t, h.checkRound(11))
+	require.NoError(t, h.checkRound(12))
+	require.NoError(t, h.checkRound(6))
+	require.Equal(t, ReplayedMsg, h.checkRound(5))
+	require.Equal(t, ReplayedMsg, h.checkRound(1))
+}
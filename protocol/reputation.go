@@ -0,0 +1,234 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+
+	"github.com/idena-network/idena-go/database"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+const (
+	invalidMsgScore = -20
+	timeoutScore    = -10
+	usefulDataScore = 1
+	minPeerScore    = -100
+	maxPeerScore    = 100
+	banPeerScore    = -100
+	peerBanDuration = 24 * time.Hour
+	// scores of not banned peers which haven't changed for this period are forgotten
+	peerScoreIdleTimeout = 24 * time.Hour
+)
+
+type peerScore struct {
+	score       int32
+	bannedUntil time.Time
+	updated     time.Time
+	// dirty is true if the score has changed since it was persisted
+	dirty     bool
+	persisted bool
+}
+
+// PeerReputation is the score of a peer and the ban expiration, zero time means the peer is not banned
+type PeerReputation struct {
+	ID          peer.ID
+	Score       int32
+	BannedUntil time.Time
+}
+
+// peerReputation scores peers by their behaviour, the peer reaching banPeerScore gets banned.
+// Scores are kept in memory and persisted by flush, bans and scores set by the admin are persisted immediately.
+type peerReputation struct {
+	repo   *database.Repo
+	scores map[peer.ID]*peerScore
+	mutex  sync.Mutex
+	now    func() time.Time
+}
+
+func newPeerReputation(repo *database.Repo) *peerReputation {
+	r := &peerReputation{
+		repo:   repo,
+		scores: make(map[peer.ID]*peerScore),
+		now:    time.Now,
+	}
+	now := r.now()
+	repo.IterateOverPeerScores(func(id []byte, score int32, bannedUntil int64) {
+		s := &peerScore{score: score, updated: now, persisted: true}
+		if bannedUntil > 0 {
+			s.bannedUntil = time.Unix(bannedUntil, 0)
+		}
+		r.scores[peer.ID(id)] = s
+	})
+	return r
+}
+
+func (r *peerReputation) get(id peer.ID) *peerScore {
+	s, ok := r.scores[id]
+	if !ok {
+		s = &peerScore{}
+		r.scores[id] = s
+	}
+	s.updated = r.now()
+	s.dirty = true
+	return s
+}
+
+// score returns the current score of the peer, unknown peers have zero score
+func (r *peerReputation) score(id peer.ID) int32 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	if s, ok := r.scores[id]; ok {
+		return s.score
+	}
+	return 0
+}
+
+func (r *peerReputation) persist(id peer.ID, s *peerScore) {
+	s.dirty = false
+	var bannedUntil int64
+	if !s.bannedUntil.IsZero() {
+		bannedUntil = s.bannedUntil.Unix()
+	}
+	if s.score == 0 && bannedUntil == 0 {
+		delete(r.scores, id)
+		if s.persisted {
+			r.repo.DeletePeerScore([]byte(id))
+		}
+		return
+	}
+	r.repo.WritePeerScore([]byte(id), s.score, bannedUntil)
+	s.persisted = true
+}
+
+// add changes the score of the peer and returns true if the peer has to be banned
+func (r *peerReputation) add(id peer.ID, delta int32) (shouldBeBanned bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s := r.get(id)
+	s.score += delta
+	if s.score > maxPeerScore {
+		s.score = maxPeerScore
+	}
+	if s.score < minPeerScore {
+		s.score = minPeerScore
+	}
+	if s.score <= banPeerScore && !r.isBanned(s) {
+		s.bannedUntil = r.now().Add(peerBanDuration)
+		shouldBeBanned = true
+		r.persist(id, s)
+	}
+	return shouldBeBanned
+}
+
+func (r *peerReputation) isBanned(s *peerScore) bool {
+	return !s.bannedUntil.IsZero() && r.now().Before(s.bannedUntil)
+}
+
+// set overrides the score of the peer, the ban is lifted if the new score is above banPeerScore
+func (r *peerReputation) set(id peer.ID, score int32) (shouldBeBanned bool) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s := r.get(id)
+	s.score = score
+	if score > banPeerScore {
+		s.bannedUntil = time.Time{}
+	} else if !r.isBanned(s) {
+		s.bannedUntil = r.now().Add(peerBanDuration)
+		shouldBeBanned = true
+	}
+	r.persist(id, s)
+	return shouldBeBanned
+}
+
+// ban bans the peer for the duration regardless of its score
+func (r *peerReputation) ban(id peer.ID, duration time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s := r.get(id)
+	s.bannedUntil = r.now().Add(duration)
+	r.persist(id, s)
+}
+
+// unban lifts the ban and resets the negative score of the peer
+func (r *peerReputation) unban(id peer.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	s := r.get(id)
+	s.bannedUntil = time.Time{}
+	if s.score < 0 {
+		s.score = 0
+	}
+	r.persist(id, s)
+}
+
+// bannedPeers returns peers with not expired bans
+func (r *peerReputation) bannedPeers() []peer.ID {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var result []peer.ID
+	for id, s := range r.scores {
+		if r.isBanned(s) {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// expireBans lifts expired bans, the score of the peer is reset to let it prove itself again
+func (r *peerReputation) expireBans() []peer.ID {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	var result []peer.ID
+	for id, s := range r.scores {
+		if !s.bannedUntil.IsZero() && !r.isBanned(s) {
+			s.bannedUntil = time.Time{}
+			s.score = 0
+			r.persist(id, s)
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+func (r *peerReputation) list() []PeerReputation {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	result := make([]PeerReputation, 0, len(r.scores))
+	for id, s := range r.scores {
+		item := PeerReputation{
+			ID:    id,
+			Score: s.score,
+		}
+		if r.isBanned(s) {
+			item.BannedUntil = s.bannedUntil
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+// flush persists the scores changed since the last flush
+func (r *peerReputation) flush() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for id, s := range r.scores {
+		if s.dirty {
+			r.persist(id, s)
+		}
+	}
+}
+
+// prune forgets not banned peers which scores haven't changed for peerScoreIdleTimeout
+func (r *peerReputation) prune() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	for id, s := range r.scores {
+		if r.isBanned(s) || r.now().Sub(s.updated) < peerScoreIdleTimeout {
+			continue
+		}
+		delete(r.scores, id)
+		if s.persisted {
+			r.repo.DeletePeerScore([]byte(id))
+		}
+	}
+}
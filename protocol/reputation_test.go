@@ -0,0 +1,98 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/idena-network/idena-go/database"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+)
+
+func persistedPeerScores(repo *database.Repo) map[peer.ID]int32 {
+	result := make(map[peer.ID]int32)
+	repo.IterateOverPeerScores(func(id []byte, score int32, bannedUntil int64) {
+		result[peer.ID(id)] = score
+	})
+	return result
+}
+
+func TestPeerReputation_add(t *testing.T) {
+	repo := database.NewRepo(db.NewMemDB())
+	r := newPeerReputation(repo)
+	now := time.Unix(1600000000, 0)
+	r.now = func() time.Time { return now }
+
+	id := peer.ID("peer")
+	require.False(t, r.add(id, maxPeerScore+10))
+	require.Equal(t, int32(maxPeerScore), r.score(id))
+	require.Empty(t, persistedPeerScores(repo))
+
+	r.flush()
+	require.Equal(t, map[peer.ID]int32{id: maxPeerScore}, persistedPeerScores(repo))
+
+	require.False(t, r.add(id, -maxPeerScore-1))
+	require.False(t, r.add(id, banPeerScore+2))
+	require.True(t, r.add(id, invalidMsgScore))
+	require.Equal(t, int32(minPeerScore), r.score(id))
+	require.Equal(t, map[peer.ID]int32{id: minPeerScore}, persistedPeerScores(repo))
+	require.Equal(t, []peer.ID{id}, r.bannedPeers())
+
+	// the banned peer is not banned twice
+	require.False(t, r.add(id, invalidMsgScore))
+
+	now = now.Add(peerBanDuration)
+	require.Equal(t, []peer.ID{id}, r.expireBans())
+	require.Empty(t, r.bannedPeers())
+	require.Zero(t, r.score(id))
+	require.Empty(t, persistedPeerScores(repo))
+}
+
+func TestPeerReputation_setAndUnban(t *testing.T) {
+	repo := database.NewRepo(db.NewMemDB())
+	r := newPeerReputation(repo)
+
+	id := peer.ID("peer")
+	require.True(t, r.set(id, banPeerScore))
+	require.False(t, r.set(id, banPeerScore-1))
+	require.Equal(t, []peer.ID{id}, r.bannedPeers())
+	require.Equal(t, map[peer.ID]int32{id: banPeerScore - 1}, persistedPeerScores(repo))
+
+	r.unban(id)
+	require.Empty(t, r.bannedPeers())
+	require.Zero(t, r.score(id))
+	require.Empty(t, persistedPeerScores(repo))
+
+	require.False(t, r.set(id, 10))
+	require.Equal(t, map[peer.ID]int32{id: 10}, persistedPeerScores(repo))
+
+	r.ban(id, time.Hour)
+	require.Equal(t, []peer.ID{id}, r.bannedPeers())
+	require.Equal(t, int32(10), newPeerReputation(repo).score(id))
+	require.Equal(t, []peer.ID{id}, newPeerReputation(repo).bannedPeers())
+}
+
+func TestPeerReputation_prune(t *testing.T) {
+	repo := database.NewRepo(db.NewMemDB())
+	r := newPeerReputation(repo)
+	now := time.Unix(1600000000, 0)
+	r.now = func() time.Time { return now }
+
+	idle, active, banned := peer.ID("idle"), peer.ID("active"), peer.ID("banned")
+	r.add(idle, usefulDataScore)
+	r.add(active, usefulDataScore)
+	r.ban(banned, peerBanDuration*2)
+	r.flush()
+
+	now = now.Add(peerScoreIdleTimeout / 2)
+	r.add(active, usefulDataScore)
+	now = now.Add(peerScoreIdleTimeout / 2)
+	r.prune()
+	r.flush()
+
+	require.Zero(t, r.score(idle))
+	require.Equal(t, int32(2*usefulDataScore), r.score(active))
+	require.Equal(t, []peer.ID{banned}, r.bannedPeers())
+	require.Equal(t, map[peer.ID]int32{active: 2 * usefulDataScore, banned: 0}, persistedPeerScores(repo))
+}
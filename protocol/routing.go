@@ -0,0 +1,62 @@
+package protocol
+
+import (
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/rcrowley/go-metrics"
+)
+
+const (
+	// consensus messages are sent with high priority to the fastest third of peers, but at least to minFastRoutePeers
+	fastRouteRatio    = 3
+	minFastRoutePeers = 3
+)
+
+var (
+	fastRouteDelay  = metrics.GetOrRegisterTimer("p2p.routing.fastDelay", metrics.DefaultRegistry)
+	otherRouteDelay = metrics.GetOrRegisterTimer("p2p.routing.otherDelay", metrics.DefaultRegistry)
+)
+
+// sortByLatency orders peers by the measured round trip time, peers without measurements go last
+func sortByLatency(peers []*protoPeer, latency func(id peer.ID) time.Duration) {
+	latencies := make(map[peer.ID]time.Duration, len(peers))
+	for _, p := range peers {
+		latencies[p.id] = latency(p.id)
+	}
+	sort.SliceStable(peers, func(i, j int) bool {
+		li, lj := latencies[peers[i].id], latencies[peers[j].id]
+		if li == 0 || lj == 0 {
+			return lj == 0 && li != 0
+		}
+		return li < lj
+	})
+}
+
+func fastRoutePeersCount(peersCount int) int {
+	cnt := peersCount / fastRouteRatio
+	if cnt < minFastRoutePeers {
+		cnt = minFastRoutePeers
+	}
+	if cnt > peersCount {
+		cnt = peersCount
+	}
+	return cnt
+}
+
+// routeDelay records the time the routed message spent in the queue of the peer
+func routeDelay(r *request) {
+	if r.enqueuedAt.IsZero() {
+		return
+	}
+	if r.fastRoute {
+		fastRouteDelay.UpdateSince(r.enqueuedAt)
+	} else {
+		otherRouteDelay.UpdateSince(r.enqueuedAt)
+	}
+}
+
+func (h *IdenaGossipHandler) peerLatency(id peer.ID) time.Duration {
+	return h.host.Peerstore().LatencyEWMA(id)
+}
@@ -0,0 +1,87 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+)
+
+func peerIds(peers []*protoPeer) []peer.ID {
+	var result []peer.ID
+	for _, p := range peers {
+		result = append(result, p.id)
+	}
+	return result
+}
+
+func TestSortByLatency(t *testing.T) {
+	latencies := map[peer.ID]time.Duration{
+		"slow":   time.Millisecond * 300,
+		"fast":   time.Millisecond * 10,
+		"medium": time.Millisecond * 100,
+	}
+	var peers []*protoPeer
+	for _, id := range []string{"unknown1", "slow", "fast", "unknown2", "medium"} {
+		peers = append(peers, newTestPeer(id, 0))
+	}
+	sortByLatency(peers, func(id peer.ID) time.Duration {
+		return latencies[id]
+	})
+	require.Equal(t, []peer.ID{"fast", "medium", "slow", "unknown1", "unknown2"}, peerIds(peers))
+}
+
+func TestFastRoutePeersCount(t *testing.T) {
+	require.Equal(t, 0, fastRoutePeersCount(0))
+	require.Equal(t, 2, fastRoutePeersCount(2))
+	require.Equal(t, 3, fastRoutePeersCount(5))
+	require.Equal(t, 3, fastRoutePeersCount(9))
+	require.Equal(t, 4, fastRoutePeersCount(12))
+	require.Equal(t, 10, fastRoutePeersCount(30))
+}
+
+func TestPeerSet_SendWithFilterByLatency(t *testing.T) {
+	ps := newPeerSet()
+	latencies := make(map[peer.ID]time.Duration)
+	var peers []*protoPeer
+	for i, id := range []string{"p1", "p2", "p3", "p4", "p5", "p6"} {
+		p := newTestPeer(id, 0)
+		p.highPriorityRequests = make(chan *request, 10)
+		latencies[p.id] = time.Millisecond * time.Duration(10*(i+1))
+		require.NoError(t, ps.Register(p))
+		peers = append(peers, p)
+	}
+	// the fastest peer already has the message
+	peers[0].markKeyWithExpiration("key", msgCacheAliveTime)
+
+	ps.SendWithFilterByLatency(Push, "key", "payload", func(id peer.ID) time.Duration {
+		return latencies[id]
+	})
+
+	require.Empty(t, peers[0].highPriorityRequests)
+	require.Empty(t, sentRequests(peers[0]))
+	for _, p := range peers[1:3] {
+		require.Len(t, p.highPriorityRequests, 1)
+		r := <-p.highPriorityRequests
+		require.True(t, r.fastRoute)
+		require.False(t, r.enqueuedAt.IsZero())
+		require.Equal(t, "payload", r.data)
+		require.Empty(t, sentRequests(p))
+	}
+	for _, p := range peers[3:] {
+		require.Empty(t, p.highPriorityRequests)
+		requests := sentRequests(p)
+		require.Len(t, requests, 1)
+		require.False(t, requests[0].fastRoute)
+	}
+
+	// the message is sent once
+	ps.SendWithFilterByLatency(Push, "key", "payload", func(id peer.ID) time.Duration {
+		return latencies[id]
+	})
+	for _, p := range peers {
+		require.Empty(t, p.highPriorityRequests)
+		require.Empty(t, sentRequests(p))
+	}
+}
@@ -0,0 +1,66 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+)
+
+// parseTrustedPeers parses multiaddrs of static trusted peers, they are always dialed, never evicted or banned
+// and don't occupy inbound and outbound slots
+func parseTrustedPeers(addrs []string) map[peer.ID]peer.AddrInfo {
+	result := make(map[peer.ID]peer.AddrInfo)
+	for _, addr := range addrs {
+		ma, err := multiaddr.NewMultiaddr(addr)
+		if err != nil {
+			log.Warn("Invalid trusted peer address", "addr", addr, "err", err)
+			continue
+		}
+		info, err := peer.AddrInfoFromP2pAddr(ma)
+		if err != nil {
+			log.Warn("Invalid trusted peer address", "addr", addr, "err", err)
+			continue
+		}
+		if existing, ok := result[info.ID]; ok {
+			info.Addrs = append(existing.Addrs, info.Addrs...)
+		}
+		result[info.ID] = *info
+	}
+	return result
+}
+
+// dialTrustedPeers connects to trusted peers which are not connected yet
+func (h *IdenaGossipHandler) dialTrustedPeers() {
+	for id, info := range h.connManager.trustedPeers {
+		if h.peers.Peer(id) != nil {
+			continue
+		}
+		go func(info peer.AddrInfo) {
+			if err := h.connectTrustedPeer(info); err != nil {
+				h.log.Debug("Failed to dial trusted peer", "id", info.ID.Pretty(), "err", err)
+			}
+		}(info)
+	}
+}
+
+func (h *IdenaGossipHandler) isTrustedPeer(id peer.ID) bool {
+	return h.connManager.IsTrusted(id)
+}
+
+func (h *IdenaGossipHandler) connectTrustedPeer(info peer.AddrInfo) error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*30)
+	err := h.host.Connect(ctx, info)
+	cancel()
+	if err != nil {
+		return err
+	}
+	stream, err := h.connManager.newStream(info.ID)
+	if err != nil {
+		return err
+	}
+	_, err = h.runPeer(stream, false)
+	return err
+}
@@ -0,0 +1,63 @@
+package protocol
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/log"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tm-db"
+)
+
+func newTestPeerID(t *testing.T) peer.ID {
+	_, pub, err := crypto.GenerateEd25519Key(rand.Reader)
+	require.NoError(t, err)
+	id, err := peer.IDFromPublicKey(pub)
+	require.NoError(t, err)
+	return id
+}
+
+func TestParseTrustedPeers(t *testing.T) {
+	id1, id2 := newTestPeerID(t), newTestPeerID(t)
+	peers := parseTrustedPeers([]string{
+		"/ip4/1.2.3.4/tcp/40404/p2p/" + id1.Pretty(),
+		"/ip4/1.2.3.5/tcp/40404/p2p/" + id1.Pretty(),
+		"/ip4/5.6.7.8/tcp/40404/p2p/" + id2.Pretty(),
+		"/ip4/5.6.7.9/tcp/40404",
+		"invalid",
+	})
+	require.Len(t, peers, 2)
+	require.Len(t, peers[id1].Addrs, 2)
+	require.Equal(t, "/ip4/1.2.3.4/tcp/40404", peers[id1].Addrs[0].String())
+	require.Equal(t, "/ip4/1.2.3.5/tcp/40404", peers[id1].Addrs[1].String())
+	require.Len(t, peers[id2].Addrs, 1)
+}
+
+func TestIdenaGossipHandler_trustedPeerIsNotBanned(t *testing.T) {
+	trusted, other := newTestPeerID(t), newTestPeerID(t)
+	h := &IdenaGossipHandler{
+		connManager: NewConnManager(nil, config.P2P{TrustedPeers: []string{"/ip4/1.2.3.4/tcp/40404/p2p/" + trusted.Pretty()}}),
+		reputation:  newPeerReputation(database.NewRepo(db.NewMemDB())),
+		peers:       newPeerSet(),
+		log:         log.New(),
+	}
+	require.True(t, h.isTrustedPeer(trusted))
+	require.False(t, h.isTrustedPeer(other))
+
+	for i := 0; i < 100; i++ {
+		h.scorePeer(trusted, invalidMsgScore, errors.New("invalid msg"))
+	}
+	require.Zero(t, h.reputation.score(trusted))
+	h.BanPeer(trusted, errors.New("banned by admin"))
+	require.True(t, h.connManager.CanConnect(trusted))
+	require.Empty(t, h.reputation.bannedPeers())
+
+	h.BanPeer(other, errors.New("banned by admin"))
+	require.False(t, h.connManager.CanConnect(other))
+	require.Equal(t, []peer.ID{other}, h.reputation.bannedPeers())
+}
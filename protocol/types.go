@@ -25,6 +25,9 @@ type CeremonyChecker interface {
 type request struct {
 	msgcode uint64
 	data    interface{}
+	// enqueuedAt is set for consensus messages routed by latency, fastRoute means the peer is one of the fastest ones
+	enqueuedAt time.Time
+	fastRoute  bool
 }
 
 type Msg struct {
@@ -58,16 +61,18 @@ type handshakeData struct {
 	AppVersion   string
 	Peers        uint32
 	OldGenesis   *common.Hash
+	Capabilities uint32
 }
 
 func (h *handshakeData) ToBytes() ([]byte, error) {
 	protoHandshake := &models.ProtoHandshake{
-		NetworkId:  h.NetworkId,
-		Height:     h.Height,
-		Genesis:    h.GenesisBlock[:],
-		Timestamp:  h.Timestamp,
-		AppVersion: h.AppVersion,
-		Peers:      h.Peers,
+		NetworkId:    h.NetworkId,
+		Height:       h.Height,
+		Genesis:      h.GenesisBlock[:],
+		Timestamp:    h.Timestamp,
+		AppVersion:   h.AppVersion,
+		Peers:        h.Peers,
+		Capabilities: h.Capabilities,
 	}
 	if h.OldGenesis != nil {
 		protoHandshake.OldGenesis = h.OldGenesis.Bytes()
@@ -86,6 +91,7 @@ func (h *handshakeData) FromBytes(data []byte) error {
 	h.Timestamp = protoHandshake.Timestamp
 	h.AppVersion = protoHandshake.AppVersion
 	h.Peers = protoHandshake.Peers
+	h.Capabilities = protoHandshake.Capabilities
 	if protoHandshake.OldGenesis != nil {
 		h.OldGenesis = &common.Hash{}
 		h.OldGenesis.SetBytes(protoHandshake.OldGenesis)
@@ -102,6 +108,7 @@ const (
 	pushFlip       pushType = 4
 	pushKeyPackage pushType = 5
 	pushTx         pushType = 6
+	pushFlipKey    pushType = 7
 )
 
 type pushPullHash struct {
@@ -132,5 +139,5 @@ func (h *pushPullHash) String() string {
 }
 
 func (h *pushPullHash) IsValid() bool {
-	return h.Type >= pushVote && h.Type <= pushTx
+	return h.Type >= pushVote && h.Type <= pushFlipKey
 }
@@ -0,0 +1,126 @@
+package main
+
+import (
+	"fmt"
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/config"
+	"github.com/idena-network/idena-go/consensus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/node"
+	"github.com/idena-network/idena-go/pengings"
+	"github.com/pkg/errors"
+	"strconv"
+	"strings"
+)
+
+func parseRoundRange(value string) (from uint64, to uint64, err error) {
+	parts := strings.Split(value, "-")
+	if len(parts) > 2 {
+		return 0, 0, errors.Errorf("invalid round range %v", value)
+	}
+	if from, err = strconv.ParseUint(strings.TrimSpace(parts[0]), 10, 64); err != nil {
+		return 0, 0, errors.Errorf("invalid round range %v", value)
+	}
+	to = from
+	if len(parts) == 2 {
+		if to, err = strconv.ParseUint(strings.TrimSpace(parts[1]), 10, 64); err != nil {
+			return 0, 0, errors.Errorf("invalid round range %v", value)
+		}
+	}
+	if from == 0 || to < from {
+		return 0, 0, errors.Errorf("invalid round range %v", value)
+	}
+	return from, to, nil
+}
+
+// replayConsensus re-runs the agreement protocol for archived rounds and checks that the blocks of the local chain are reached,
+// rounds without archived messages or available state are skipped
+func replayConsensus(cfg *config.Config, rounds string) error {
+	from, to, err := parseRoundRange(rounds)
+	if err != nil {
+		return err
+	}
+	db, err := node.OpenDatabase(cfg.DataDir, "idenachain", 16, 16)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	repo := database.NewRepo(db)
+	head := repo.ReadHead()
+	if head == nil {
+		return errors.New("head block is not found")
+	}
+	if to > head.Height() {
+		to = head.Height()
+	}
+	bus := eventbus.New()
+	appState, err := appstate.NewAppState(db, bus)
+	if err != nil {
+		return err
+	}
+	if err := appState.Initialize(head.Height()); err != nil {
+		return err
+	}
+	chain := blockchain.NewBlockchain(cfg, db, nil, appState, nil, nil, bus, nil, nil, nil, nil)
+
+	messages := make(map[uint64][]*pengings.ArchivedMessage)
+	err = pengings.ReadMessageArchive(cfg.DataDir, from, to, func(msg *pengings.ArchivedMessage) error {
+		messages[msg.Round] = append(messages[msg.Round], msg)
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(err, "cannot read consensus archive")
+	}
+
+	var replayed, skipped, mismatched int
+	for round := from; round <= to; round++ {
+		if len(messages[round]) == 0 {
+			continue
+		}
+		parent := readHeaderByHeight(repo, round-1)
+		expected := repo.ReadCanonicalHash(round)
+		if parent == nil || expected == (common.Hash{}) {
+			fmt.Printf("Round %v: skipped, block is not found\n", round)
+			skipped++
+			continue
+		}
+		parentState, err := appState.Readonly(parent.Height())
+		if err != nil {
+			fmt.Printf("Round %v: skipped, state is not available: %v\n", round, err)
+			skipped++
+			continue
+		}
+		emptyBlockHash := expected
+		if block := repo.ReadBlockHeader(expected); block == nil || block.EmptyBlockHeader == nil {
+			emptyBlock, err := chain.GenerateEmptyBlockOn(parent)
+			if err != nil {
+				fmt.Printf("Round %v: skipped, cannot generate empty block: %v\n", round, err)
+				skipped++
+				continue
+			}
+			emptyBlockHash = emptyBlock.Hash()
+		}
+		result, err := consensus.ReplayRound(chain, parent, emptyBlockHash, parentState.ValidatorsCache, messages[round])
+		replayed++
+		if err != nil {
+			fmt.Printf("Round %v: MISMATCH, expected %v, agreement failed: %v\n", round, expected.Hex(), err)
+			mismatched++
+			continue
+		}
+		if result.BlockHash != expected {
+			fmt.Printf("Round %v: MISMATCH, expected %v, replayed %v\n", round, expected.Hex(), result.BlockHash.Hex())
+			mismatched++
+			continue
+		}
+		fmt.Printf("Round %v: ok, block %v, empty: %v, final: %v, steps: %v\n", round, expected.Hex(), expected == emptyBlockHash, result.Final, len(result.Steps))
+	}
+	fmt.Printf("Replayed %v rounds, skipped %v, mismatched %v\n", replayed, skipped, mismatched)
+	if mismatched > 0 {
+		return errors.Errorf("%v of %v replayed rounds reached different decisions", mismatched, replayed)
+	}
+	return nil
+}
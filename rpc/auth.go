@@ -0,0 +1,258 @@
+package rpc
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/idena-network/idena-go/log"
+)
+
+// AccessLevel defines which methods an API key can call, each level includes the lower ones
+type AccessLevel int
+
+const (
+	// AccessRead allows methods which don't change the node or the chain
+	AccessRead AccessLevel = iota
+	// AccessSign allows methods which sign data or submit txs on behalf of the node
+	AccessSign
+	// AccessAdmin allows all methods including key management and peer management
+	AccessAdmin
+)
+
+const (
+	masterKeyName = "master"
+	adminKeyName  = "admin"
+	// adminNamespace is the namespace of methods which control the node, they are authorized by the admin key only
+	adminNamespace = "admin"
+)
+
+var auditLog = log.New("component", "rpc-audit")
+
+func (l AccessLevel) String() string {
+	switch l {
+	case AccessRead:
+		return "read"
+	case AccessSign:
+		return "sign"
+	case AccessAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("AccessLevel(%d)", int(l))
+	}
+}
+
+func ParseAccessLevel(s string) (AccessLevel, error) {
+	switch strings.ToLower(s) {
+	case "read":
+		return AccessRead, nil
+	case "sign":
+		return AccessSign, nil
+	case "admin":
+		return AccessAdmin, nil
+	default:
+		return 0, fmt.Errorf("unknown access level %q", s)
+	}
+}
+
+// APIKeyConfig describes an API key with restricted access
+type APIKeyConfig struct {
+	// Name identifies the key in audit logs
+	Name string
+	Key  string
+	// Access is one of read, sign or admin
+	Access string
+	// Methods restricts the key to the listed methods (e.g. dna_identity) if not empty, "namespace_*" matches all
+	// methods of the namespace, the access level is checked as well
+	Methods []string `toml:",omitempty"`
+	// Expires is the unix time after which the key is rejected, the key never expires if it is zero
+	Expires int64 `toml:",omitempty"`
+	// RateLimit is the number of calls per second allowed with the key, zero disables the limit.
+	// RateBurst is the number of calls the key can make at once, one second of calls if it isn't set.
+	RateLimit float64 `toml:",omitempty"`
+	RateBurst int     `toml:",omitempty"`
+}
+
+type authKey struct {
+	name    string
+	level   AccessLevel
+	methods map[string]struct{}
+	expires time.Time
+}
+
+// Auth authorizes requests by API keys, the master key has the admin access to all methods, calls of methods
+// which require the sign access or higher are written to the audit log
+type Auth struct {
+	keys     map[string]*authKey
+	adminKey string
+	access   map[string]AccessLevel
+	readOnly bool
+	mutex    sync.RWMutex
+}
+
+// NewAuth creates an authorizer with the master key, additional keys and access levels required by methods,
+// methodAccess maps a method or "namespace_*" to the level, methods not in the map require the read access.
+// Methods of the admin namespace are authorized by the admin key only and the admin key can't call other methods,
+// the namespace is disabled if the admin key is empty.
+// Only methods which require the read access are allowed in the read-only mode whatever the key is, any key
+// is accepted if there are no keys. Nil is returned if there are no keys and the mode isn't read-only,
+// all requests are allowed in this case.
+func NewAuth(masterKey string, adminKey string, keys []APIKeyConfig, methodAccess map[string]AccessLevel, readOnly bool) (*Auth, error) {
+	if masterKey == "" && adminKey == "" && len(keys) == 0 && !readOnly {
+		return nil, nil
+	}
+	if adminKey != "" && adminKey == masterKey {
+		return nil, errors.New("admin key should differ from the api key")
+	}
+	for i, cfg := range keys {
+		if adminKey != "" && cfg.Key == adminKey {
+			return nil, fmt.Errorf("api key %d equals the admin key", i)
+		}
+	}
+	a := &Auth{
+		adminKey: adminKey,
+		access:   methodAccess,
+		readOnly: readOnly,
+	}
+	if masterKey == "" && len(keys) == 0 {
+		return a, nil
+	}
+	a.keys = make(map[string]*authKey)
+	if masterKey != "" {
+		a.keys[masterKey] = &authKey{name: masterKeyName, level: AccessAdmin}
+	}
+	for i, cfg := range keys {
+		if cfg.Key == "" {
+			return nil, fmt.Errorf("api key %d is empty", i)
+		}
+		if _, ok := a.keys[cfg.Key]; ok {
+			return nil, fmt.Errorf("api key %d is duplicated", i)
+		}
+		level, err := ParseAccessLevel(cfg.Access)
+		if err != nil {
+			return nil, err
+		}
+		key := &authKey{
+			name:  cfg.Name,
+			level: level,
+		}
+		if key.name == "" {
+			key.name = fmt.Sprintf("key%d", i)
+		}
+		if len(cfg.Methods) > 0 {
+			key.methods = make(map[string]struct{}, len(cfg.Methods))
+			for _, m := range cfg.Methods {
+				key.methods[m] = struct{}{}
+			}
+		}
+		if cfg.Expires > 0 {
+			key.expires = time.Unix(cfg.Expires, 0)
+		}
+		a.keys[cfg.Key] = key
+	}
+	return a, nil
+}
+
+// requiredAccess returns the access level required by the method in the namespace_method form
+func (a *Auth) requiredAccess(method string) AccessLevel {
+	if isAdminMethod(method) {
+		return AccessAdmin
+	}
+	if level, ok := a.access[method]; ok {
+		return level
+	}
+	if idx := strings.Index(method, serviceMethodSeparator); idx >= 0 {
+		if level, ok := a.access[method[:idx+1]+"*"]; ok {
+			return level
+		}
+	}
+	return AccessRead
+}
+
+func (k *authKey) allows(method string) bool {
+	if k.methods == nil {
+		return true
+	}
+	if _, ok := k.methods[method]; ok {
+		return true
+	}
+	if idx := strings.Index(method, serviceMethodSeparator); idx >= 0 {
+		if _, ok := k.methods[method[:idx+1]+"*"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// SetMasterKey replaces the master key, requests with the previous key are rejected afterwards
+func (a *Auth) SetMasterKey(masterKey string) error {
+	if masterKey == "" {
+		return errors.New("api key is empty")
+	}
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.adminKey != "" && masterKey == a.adminKey {
+		return errors.New("admin key should differ from the api key")
+	}
+	if k, ok := a.keys[masterKey]; ok && k.name != masterKeyName {
+		return errors.New("api key is already used")
+	}
+	if a.keys == nil {
+		a.keys = make(map[string]*authKey)
+	}
+	for key, k := range a.keys {
+		if k.name == masterKeyName {
+			delete(a.keys, key)
+		}
+	}
+	a.keys[masterKey] = &authKey{name: masterKeyName, level: AccessAdmin}
+	return nil
+}
+
+func isAdminMethod(method string) bool {
+	return strings.HasPrefix(method, adminNamespace+serviceMethodSeparator)
+}
+
+// authorize checks that the key can call the method, a nil authorizer allows everything
+func (a *Auth) authorize(key string, method string) Error {
+	if a == nil {
+		return nil
+	}
+	required := a.requiredAccess(method)
+	if a.readOnly && required > AccessRead {
+		return &accessDeniedError{method}
+	}
+	isAdminKey := a.adminKey != "" && subtle.ConstantTimeCompare([]byte(key), []byte(a.adminKey)) == 1
+	if isAdminMethod(method) {
+		if !isAdminKey {
+			return &accessDeniedError{method}
+		}
+		auditLog.Info("API call", "method", method, "key", adminKeyName, "access", AccessAdmin)
+		return nil
+	}
+	if isAdminKey {
+		return &accessDeniedError{method}
+	}
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+	if a.keys == nil {
+		return nil
+	}
+	k, ok := a.keys[key]
+	if !ok {
+		return &invalidApiKeyError{}
+	}
+	if !k.expires.IsZero() && time.Now().After(k.expires) {
+		return &expiredApiKeyError{}
+	}
+	if k.level < required || !k.allows(method) {
+		return &accessDeniedError{method}
+	}
+	if required >= AccessSign {
+		auditLog.Info("API call", "method", method, "key", k.name, "access", required)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuth_authorize(t *testing.T) {
+	auth, err := NewAuth("master", "", []APIKeyConfig{
+		{Name: "explorer", Key: "read", Access: "read"},
+		{Name: "wallet", Key: "sign", Access: "sign", Methods: []string{"dna_sendTransaction", "bcn_*"}},
+		{Name: "expired", Key: "expired", Access: "admin", Expires: time.Now().Add(-time.Minute).Unix()},
+	}, map[string]AccessLevel{
+		"dna_sendTransaction": AccessSign,
+		"dna_exportKey":       AccessAdmin,
+		"debug_*":             AccessAdmin,
+	}, false)
+	require.NoError(t, err)
+
+	require.Nil(t, auth.authorize("master", "dna_exportKey"))
+	require.Nil(t, auth.authorize("master", "debug_buildBlockDryRun"))
+
+	require.Nil(t, auth.authorize("read", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("read", "dna_sendTransaction"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("read", "debug_buildBlockDryRun"))
+
+	require.Nil(t, auth.authorize("sign", "dna_sendTransaction"))
+	require.Nil(t, auth.authorize("sign", "bcn_lastBlock"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("sign", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("sign", "dna_exportKey"))
+
+	require.IsType(t, &expiredApiKeyError{}, auth.authorize("expired", "dna_identity"))
+	require.IsType(t, &invalidApiKeyError{}, auth.authorize("unknown", "dna_identity"))
+
+	var noAuth *Auth
+	require.Nil(t, noAuth.authorize("", "dna_exportKey"))
+
+	_, err = NewAuth("", "", []APIKeyConfig{{Key: "key", Access: "root"}}, nil, false)
+	require.Error(t, err)
+	_, err = NewAuth("key", "", []APIKeyConfig{{Key: "key", Access: "read"}}, nil, false)
+	require.Error(t, err)
+}
+
+func TestAuth_authorizeReadOnly(t *testing.T) {
+	access := map[string]AccessLevel{
+		"dna_sendTransaction": AccessSign,
+		"account_*":           AccessAdmin,
+	}
+	auth, err := NewAuth("master", "admin", []APIKeyConfig{{Key: "sign", Access: "sign"}}, access, true)
+	require.NoError(t, err)
+
+	require.Nil(t, auth.authorize("master", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("master", "dna_sendTransaction"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("master", "account_list"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("sign", "dna_sendTransaction"))
+	require.IsType(t, &invalidApiKeyError{}, auth.authorize("unknown", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("admin", "admin_shutdown"))
+
+	auth, err = NewAuth("", "", nil, access, true)
+	require.NoError(t, err)
+	require.NotNil(t, auth)
+	require.Nil(t, auth.authorize("", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("", "dna_sendTransaction"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("any", "account_create"))
+}
+
+func TestAuth_adminKey(t *testing.T) {
+	access := map[string]AccessLevel{
+		"admin_*": AccessAdmin,
+	}
+	auth, err := NewAuth("master", "admin", []APIKeyConfig{{Key: "read", Access: "read"}}, access, false)
+	require.NoError(t, err)
+
+	require.Nil(t, auth.authorize("admin", "admin_shutdown"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("admin", "dna_identity"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("master", "admin_shutdown"))
+	require.IsType(t, &accessDeniedError{}, auth.authorize("read", "admin_shutdown"))
+	require.Nil(t, auth.authorize("master", "dna_identity"))
+
+	require.NoError(t, auth.SetMasterKey("master2"))
+	require.IsType(t, &invalidApiKeyError{}, auth.authorize("master", "dna_identity"))
+	require.Nil(t, auth.authorize("master2", "dna_exportKey"))
+	require.Nil(t, auth.authorize("read", "dna_identity"))
+	require.Error(t, auth.SetMasterKey("admin"))
+	require.Error(t, auth.SetMasterKey("read"))
+
+	auth, err = NewAuth("", "", nil, access, false)
+	require.NoError(t, err)
+	require.Nil(t, auth)
+	auth, err = NewAuth("", "admin", nil, access, false)
+	require.NoError(t, err)
+	require.IsType(t, &accessDeniedError{}, auth.authorize("", "admin_shutdown"))
+	require.Nil(t, auth.authorize("", "dna_identity"))
+
+	_, err = NewAuth("key", "key", nil, access, false)
+	require.Error(t, err)
+	_, err = NewAuth("master", "key", []APIKeyConfig{{Key: "key", Access: "read"}}, access, false)
+	require.Error(t, err)
+}
@@ -26,6 +26,31 @@ type Config struct {
 	// interface.
 	HTTPTimeouts HTTPTimeouts
 
+	// HTTPTLSCertFile and HTTPTLSKeyFile are PEM files of the certificate and the private key, the HTTP RPC
+	// endpoint serves HTTPS if both are set
+	HTTPTLSCertFile string `toml:",omitempty"`
+	HTTPTLSKeyFile  string `toml:",omitempty"`
+
+	// HTTPRateLimit is the number of HTTP requests per second allowed from a client IP, zero disables the limit.
+	// HTTPRateBurst is the number of requests a client can send at once, one second of requests if it isn't set.
+	HTTPRateLimit float64 `toml:",omitempty"`
+	HTTPRateBurst int     `toml:",omitempty"`
+
+	// HTTPTrustedProxies are IPs or CIDRs of reverse proxies in front of the HTTP RPC endpoint, the client IP
+	// of their requests is taken from the X-Forwarded-For header
+	HTTPTrustedProxies []string `toml:",omitempty"`
+
+	// ExpensiveRateLimit is the number of calls of expensive methods per second allowed from a client over HTTP
+	// and websocket, zero disables the limit. ExpensiveRateBurst is the number of such calls a client can make
+	// at once, one second of calls if it isn't set. A client is its API key if the key has a rate limit
+	// and its IP otherwise.
+	ExpensiveRateLimit float64 `toml:",omitempty"`
+	ExpensiveRateBurst int     `toml:",omitempty"`
+
+	// ExpensiveMethods are methods in addition to the built-in list which are limited by ExpensiveRateLimit,
+	// "namespace_*" matches all methods of the namespace
+	ExpensiveMethods []string `toml:",omitempty"`
+
 	// HTTPHost is the host interface on which to start the HTTP RPC server. If this
 	// field is empty, no HTTP API endpoint will be started.
 	HTTPHost string `toml:",omitempty"`
@@ -35,7 +60,38 @@ type Config struct {
 	// for ephemeral nodes).
 	HTTPPort int `toml:",omitempty"`
 
+	// WSHost is the host interface on which to start the websocket RPC server. If this
+	// field is empty, no websocket API endpoint will be started.
+	WSHost string `toml:",omitempty"`
+
+	// WSPort is the TCP port number on which to start the websocket RPC server.
+	WSPort int `toml:",omitempty"`
+
+	// WSOrigins is the list of domain to accept websocket requests from. Please be aware
+	// that the server can only act upon the HTTP request the client sends and cannot
+	// verify the validity of the request header.
+	WSOrigins []string `toml:",omitempty"`
+
+	// WSModules is a list of API modules to expose via the websocket RPC interface.
+	// If the module list is empty, all RPC API endpoints designated public will be
+	// exposed.
+	WSModules []string `toml:",omitempty"`
+
 	APIKey string
+
+	// AdminKey authorizes methods of the admin namespace which control the node, they are disabled if it is empty
+	AdminKey string `toml:",omitempty"`
+
+	// APIKeys are additional API keys with restricted access, APIKey has the full access
+	APIKeys []APIKeyConfig `toml:",omitempty"`
+
+	// ReadOnly disables signing, keystore and admin methods over HTTP and websocket whatever the API key is
+	// and tx submission over gRPC, so the endpoints can be exposed to the public
+	ReadOnly bool `toml:",omitempty"`
+
+	// MinFreeDiskSpace is the free space in MB of the data dir file system below which the /health endpoint reports
+	// the node unhealthy, zero disables the check
+	MinFreeDiskSpace uint64 `toml:",omitempty"`
 }
 
 func (c *Config) HTTPEndpoint() string {
@@ -45,14 +101,23 @@ func (c *Config) HTTPEndpoint() string {
 	return fmt.Sprintf("%s:%d", c.HTTPHost, c.HTTPPort)
 }
 
+func (c *Config) WSEndpoint() string {
+	if c.WSHost == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s:%d", c.WSHost, c.WSPort)
+}
+
 func GetDefaultRPCConfig(host string, port int) *Config {
 	// DefaultConfig contains reasonable default settings.
 	return &Config{
 		HTTPCors:         []string{"*"},
 		HTTPHost:         host,
 		HTTPPort:         port,
-		HTTPModules:      []string{"net", "dna", "account", "flip", "bcn", "ipfs", "contract"},
+		HTTPModules:      []string{"net", "dna", "account", "flip", "training", "bcn", "ipfs", "contract", "oracle", "watch", "consensus"},
 		HTTPVirtualHosts: []string{"localhost"},
 		HTTPTimeouts:     DefaultHTTPTimeouts,
+		WSModules:        []string{"net", "dna", "account", "flip", "training", "bcn", "ipfs", "contract", "oracle", "watch", "consensus"},
+		MinFreeDiskSpace: 512,
 	}
 }
@@ -16,7 +16,10 @@
 
 package rpc
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 // request is for an unknown service
 type methodNotFoundError struct {
@@ -71,3 +74,32 @@ type invalidApiKeyError struct{}
 func (e *invalidApiKeyError) ErrorCode() int { return -32800 }
 
 func (e *invalidApiKeyError) Error() string { return "the provided API key is invalid" }
+
+// expired api key
+type expiredApiKeyError struct{}
+
+func (e *expiredApiKeyError) ErrorCode() int { return -32800 }
+
+func (e *expiredApiKeyError) Error() string { return "the provided API key is expired" }
+
+// the api key doesn't have the access to the method
+type accessDeniedError struct{ method string }
+
+func (e *accessDeniedError) ErrorCode() int { return -32801 }
+
+func (e *accessDeniedError) Error() string {
+	return fmt.Sprintf("the provided API key doesn't have access to the method %s", e.method)
+}
+
+// the client exceeded its quota, it can retry after the delay
+type rateLimitError struct{ retryAfter time.Duration }
+
+func (e *rateLimitError) ErrorCode() int { return -32005 }
+
+func (e *rateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded, retry after %d s", retryAfterSeconds(e.retryAfter))
+}
+
+func (e *rateLimitError) ErrorData() interface{} {
+	return map[string]int{"retryAfter": retryAfterSeconds(e.retryAfter)}
+}
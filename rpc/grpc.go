@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// GrpcKeyMetadata is the metadata key which gRPC clients send the API key in
+const GrpcKeyMetadata = "api-key"
+
+// GrpcAuthorizer authorizes gRPC calls by the auth and quotas of the RPC, each gRPC method is authorized as
+// the RPC method it is mapped to (e.g. "/models.IdenaApi/Identity" as dna_identity), calls of gRPC methods
+// which are not mapped are rejected
+type GrpcAuthorizer struct {
+	auth    *Auth
+	quotas  *Quotas
+	methods map[string]string
+}
+
+func NewGrpcAuthorizer(auth *Auth, quotas *Quotas, methods map[string]string) *GrpcAuthorizer {
+	return &GrpcAuthorizer{
+		auth:    auth,
+		quotas:  quotas,
+		methods: methods,
+	}
+}
+
+func (g *GrpcAuthorizer) authorize(ctx context.Context, fullMethod string) error {
+	method, ok := g.methods[fullMethod]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "method %s is not authorized", fullMethod)
+	}
+	var key string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(GrpcKeyMetadata); len(values) > 0 {
+			key = values[0]
+		}
+	}
+	if err := g.auth.authorize(key, method); err != nil {
+		if _, ok := err.(*accessDeniedError); ok {
+			return status.Error(codes.PermissionDenied, err.Error())
+		}
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		ctx = context.WithValue(ctx, "remote", p.Addr.String())
+	}
+	if delay := g.quotas.reserve(requestClientIP(ctx), key, method); delay > 0 {
+		return status.Error(codes.ResourceExhausted, (&rateLimitError{delay}).Error())
+	}
+	return nil
+}
+
+// UnaryInterceptor rejects unauthorized unary calls
+func (g *GrpcAuthorizer) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := g.authorize(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects unauthorized streams
+func (g *GrpcAuthorizer) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.authorize(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestGrpcAuthorizer(t *testing.T) {
+	auth, err := NewAuth("master", "", []APIKeyConfig{
+		{Name: "explorer", Key: "read", Access: "read"},
+	}, map[string]AccessLevel{
+		"bcn_sendRawTx": AccessSign,
+	}, false)
+	require.NoError(t, err)
+	quotas := NewQuotas(nil, []string{"dna_identity"}, 1, 1)
+	authorizer := NewGrpcAuthorizer(auth, quotas, map[string]string{
+		"/test.Api/Identity": "dna_identity",
+		"/test.Api/Send":     "bcn_sendRawTx",
+		"/test.Api/Blocks":   "bcn_subscribe",
+	})
+
+	callCtx := func(key string) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 40000}})
+		if key == "" {
+			return ctx
+		}
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(GrpcKeyMetadata, key))
+	}
+	call := func(ctx context.Context, method string) codes.Code {
+		_, err := authorizer.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, nil
+		})
+		return status.Code(err)
+	}
+
+	require.Equal(t, codes.Unauthenticated, call(callCtx(""), "/test.Api/Identity"))
+	require.Equal(t, codes.Unauthenticated, call(callCtx("unknown"), "/test.Api/Identity"))
+	require.Equal(t, codes.PermissionDenied, call(callCtx("read"), "/test.Api/Send"))
+	require.Equal(t, codes.PermissionDenied, call(callCtx("master"), "/test.Api/Unknown"))
+	require.Equal(t, codes.OK, call(callCtx("master"), "/test.Api/Send"))
+
+	require.Equal(t, codes.OK, call(callCtx("read"), "/test.Api/Identity"))
+	require.Equal(t, codes.ResourceExhausted, call(callCtx("read"), "/test.Api/Identity"))
+
+	stream := func(key string) codes.Code {
+		err := authorizer.StreamInterceptor(nil, &testServerStream{ctx: callCtx(key)}, &grpc.StreamServerInfo{FullMethod: "/test.Api/Blocks"}, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		return status.Code(err)
+	}
+	require.Equal(t, codes.Unauthenticated, stream(""))
+	require.Equal(t, codes.OK, stream("read"))
+}
+
+func TestGrpcAuthorizer_readOnly(t *testing.T) {
+	auth, err := NewAuth("", "", nil, map[string]AccessLevel{"bcn_sendRawTx": AccessSign}, true)
+	require.NoError(t, err)
+	authorizer := NewGrpcAuthorizer(auth, nil, map[string]string{
+		"/test.Api/Identity": "dna_identity",
+		"/test.Api/Send":     "bcn_sendRawTx",
+	})
+	require.NoError(t, authorizer.authorize(context.Background(), "/test.Api/Identity"))
+	require.Equal(t, codes.PermissionDenied, status.Code(authorizer.authorize(context.Background(), "/test.Api/Send")))
+}
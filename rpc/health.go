@@ -0,0 +1,49 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+const (
+	HealthPath = "/health"
+	ReadyPath  = "/ready"
+)
+
+// HealthCheck returns problems of the node, the check passes if there are no problems
+type HealthCheck func() []string
+
+// HealthChecks are served over HTTP GET for probes of orchestration systems: Health tells whether the node
+// should be restarted and Ready tells whether the node can serve requests
+type HealthChecks struct {
+	Health HealthCheck
+	Ready  HealthCheck
+}
+
+type healthResponse struct {
+	Status   string   `json:"status"`
+	Problems []string `json:"problems,omitempty"`
+}
+
+func (h *HealthChecks) check(path string) HealthCheck {
+	switch path {
+	case HealthPath:
+		return h.Health
+	case ReadyPath:
+		return h.Ready
+	}
+	return nil
+}
+
+// serveHealthCheck responds 200 if the check passes and 503 with the problems otherwise
+func serveHealthCheck(w http.ResponseWriter, check HealthCheck) {
+	resp := &healthResponse{Status: "ok"}
+	code := http.StatusOK
+	if problems := check(); len(problems) > 0 {
+		resp = &healthResponse{Status: "fail", Problems: problems}
+		code = http.StatusServiceUnavailable
+	}
+	w.Header().Set("content-type", contentType)
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(resp)
+}
@@ -27,12 +27,15 @@ import (
 	"mime"
 	"net"
 	"net/http"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/idena-network/idena-go/log"
 	"github.com/rs/cors"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 )
 
 const (
@@ -194,10 +197,11 @@ func (t *httpReadWriteNopCloser) Close() error {
 // NewHTTPServer creates a new HTTP RPC server around an API provider.
 //
 // Deprecated: Server implements http.Handler
-func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *Server) *http.Server {
-	// Wrap the CORS-handler within a host-handler
+func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, limiter *RateLimiter, srv *Server) *http.Server {
+	// Wrap the CORS-handler within a host-handler and limit the rate of requests of every client
 	handler := newCorsHandler(srv, cors)
 	handler = newVHostHandler(vhosts, handler)
+	handler = limiter.Handler(handler)
 
 	// Make sure timeout values are meaningful
 	if timeouts.ReadTimeout < time.Second {
@@ -212,6 +216,10 @@ func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *S
 		log.Warn("Sanitizing invalid HTTP idle timeout", "provided", timeouts.IdleTimeout, "updated", DefaultHTTPTimeouts.IdleTimeout)
 		timeouts.IdleTimeout = DefaultHTTPTimeouts.IdleTimeout
 	}
+	// Serve cleartext HTTP/2 (h2c) next to HTTP/1.1 with keep-alive, so clients can multiplex requests over a single connection
+	handler = h2c.NewHandler(handler, &http2.Server{
+		IdleTimeout: timeouts.IdleTimeout,
+	})
 	// Bundle and start the HTTP server
 	return &http.Server{
 		Handler:      handler,
@@ -221,8 +229,45 @@ func NewHTTPServer(cors []string, vhosts []string, timeouts HTTPTimeouts, srv *S
 	}
 }
 
+// HandleHTTP serves requests to the path by the handler instead of JSON-RPC, the handler is responsible for
+// authorizing requests, see AuthorizeHTTP
+func (srv *Server) HandleHTTP(path string, handler http.Handler) {
+	srv.routesMu.Lock()
+	defer srv.routesMu.Unlock()
+	if srv.routes == nil {
+		srv.routes = make(map[string]http.Handler)
+	}
+	srv.routes[path] = handler
+}
+
+// AuthorizeHTTP authorizes a call of the method made by an HTTP request with the key by the auth and quotas of
+// the server, a positive delay is returned if the client exceeded its quota and has to retry after it
+func (srv *Server) AuthorizeHTTP(r *http.Request, key string, method string) (time.Duration, error) {
+	if err := srv.auth.authorize(key, method); err != nil {
+		return 0, err
+	}
+	ctx := context.WithValue(r.Context(), "remote", r.RemoteAddr)
+	if delay := srv.quotas.reserve(requestClientIP(ctx), key, method); delay > 0 {
+		return delay, &rateLimitError{delay}
+	}
+	return 0, nil
+}
+
 // ServeHTTP serves JSON-RPC requests over HTTP.
 func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	srv.routesMu.RLock()
+	route, ok := srv.routes[r.URL.Path]
+	srv.routesMu.RUnlock()
+	if ok {
+		route.ServeHTTP(w, r)
+		return
+	}
+	if r.Method == http.MethodGet && srv.health != nil {
+		if check := srv.health.check(r.URL.Path); check != nil {
+			serveHealthCheck(w, check)
+			return
+		}
+	}
 	// Permit dumb empty requests for remote health-checks (AWS)
 	if r.Method == http.MethodGet && r.ContentLength == 0 && r.URL.RawQuery == "" {
 		return
@@ -250,6 +295,10 @@ func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	defer codec.Close()
 
 	w.Header().Set("content-type", contentType)
+	ctx = context.WithValue(ctx, rateLimitedKey{}, func(retryAfter time.Duration) {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(retryAfter)))
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
 	srv.ServeSingleRequest(ctx, codec, OptionMethodInvocation)
 }
 
@@ -17,10 +17,17 @@
 package rpc
 
 import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+
+	"golang.org/x/net/http2"
 )
 
 func TestHTTPErrorResponseWithDelete(t *testing.T) {
@@ -52,3 +59,154 @@ func testHTTPErrorResponse(t *testing.T, method, contentType, body string, expec
 		t.Fatalf("response code should be %d not %d", expected, code)
 	}
 }
+
+func TestHTTPBatchOverH2C(t *testing.T) {
+	server := NewServer("")
+	if err := server.RegisterName("service", new(Service)); err != nil {
+		t.Fatal(err)
+	}
+	httpServer := httptest.NewServer(NewHTTPServer(nil, []string{"*"}, DefaultHTTPTimeouts, nil, server).Handler)
+	defer httpServer.Close()
+
+	client := &http.Client{
+		Transport: &http2.Transport{
+			AllowHTTP: true,
+			DialTLS: func(network, addr string, cfg *tls.Config) (net.Conn, error) {
+				return net.Dial(network, addr)
+			},
+		},
+	}
+	post := func(body string) *http.Response {
+		resp, err := client.Post(httpServer.URL, contentType, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.ProtoMajor != 2 {
+			t.Fatalf("expected HTTP/2 response, got %v", resp.Proto)
+		}
+		return resp
+	}
+
+	const size = 100
+	var batch bytes.Buffer
+	batch.WriteString("[")
+	for i := 0; i < size; i++ {
+		if i > 0 {
+			batch.WriteString(",")
+		}
+		fmt.Fprintf(&batch, `{"jsonrpc":"2.0","id":%d,"method":"service_echo","params":["s",%d,{"S":"x"}]}`, i, i)
+	}
+	batch.WriteString("]")
+	resp := post(batch.String())
+	var results []struct {
+		Id     int    `json:"id"`
+		Result Result `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if len(results) != size {
+		t.Fatalf("expected %d responses, got %d", size, len(results))
+	}
+	for i, r := range results {
+		if r.Id != i || r.Result.Int != i {
+			t.Fatalf("unexpected response %d: %+v", i, r)
+		}
+	}
+
+	for _, body := range []string{"[]", "[" + strings.Repeat(`{"jsonrpc":"2.0","id":1,"method":"service_rets"},`, maxBatchSize) + `{"jsonrpc":"2.0","id":1,"method":"service_rets"}]`} {
+		resp := post(body)
+		var errResp jsonErrResponse
+		if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if errResp.Error.Code != -32600 {
+			t.Fatalf("expected invalid request error, got %+v", errResp.Error)
+		}
+	}
+}
+
+func TestHTTPHealthChecks(t *testing.T) {
+	server := NewServer("")
+	var problems []string
+	server.health = &HealthChecks{
+		Health: func() []string { return nil },
+		Ready:  func() []string { return problems },
+	}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	get := func(path string, expected int) healthResponse {
+		resp, err := http.Get(httpServer.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != expected {
+			t.Fatalf("response code of %v should be %d not %d", path, expected, resp.StatusCode)
+		}
+		var result healthResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	if resp := get(HealthPath, http.StatusOK); resp.Status != "ok" {
+		t.Fatalf("unexpected health response %+v", resp)
+	}
+	if resp := get(ReadyPath, http.StatusOK); resp.Status != "ok" {
+		t.Fatalf("unexpected readiness response %+v", resp)
+	}
+	problems = []string{"node is syncing"}
+	if resp := get(ReadyPath, http.StatusServiceUnavailable); resp.Status != "fail" || len(resp.Problems) != 1 {
+		t.Fatalf("unexpected readiness response %+v", resp)
+	}
+	if resp := get(HealthPath, http.StatusOK); resp.Status != "ok" {
+		t.Fatalf("unexpected health response %+v", resp)
+	}
+}
+
+func TestHTTPRoutesAuthorization(t *testing.T) {
+	auth, err := NewAuth("master", "", []APIKeyConfig{{Key: "reader", Access: "read"}}, map[string]AccessLevel{"test_sign": AccessSign}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := NewServerWithAuth(auth)
+	server.quotas = NewQuotas(nil, []string{"test_query"}, 1, 1)
+	server.HandleHTTP("/route", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.URL.Query().Get("key")
+		method := r.URL.Query().Get("method")
+		if delay, err := server.AuthorizeHTTP(r, key, method); err != nil {
+			w.Header().Set("Retry-After", fmt.Sprint(retryAfterSeconds(delay)))
+			http.Error(w, err.Error(), http.StatusForbidden)
+		}
+	}))
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	get := func(key, method string) *http.Response {
+		resp, err := http.Get(fmt.Sprintf("%v/route?key=%v&method=%v", httpServer.URL, key, method))
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+	if resp := get("reader", "test_read"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("read method should be allowed, got %d", resp.StatusCode)
+	}
+	if resp := get("reader", "test_sign"); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("sign method should be denied, got %d", resp.StatusCode)
+	}
+	if resp := get("unknown", "test_read"); resp.StatusCode != http.StatusForbidden {
+		t.Fatalf("unknown key should be denied, got %d", resp.StatusCode)
+	}
+	if resp := get("master", "test_query"); resp.StatusCode != http.StatusOK {
+		t.Fatalf("first expensive call should be allowed, got %d", resp.StatusCode)
+	}
+	if resp := get("master", "test_query"); resp.StatusCode != http.StatusForbidden || resp.Header.Get("Retry-After") != "1" {
+		t.Fatalf("second expensive call should exceed the quota, got %d", resp.StatusCode)
+	}
+}
@@ -35,6 +35,8 @@ const (
 	subscribeMethodSuffix    = "_subscribe"
 	unsubscribeMethodSuffix  = "_unsubscribe"
 	notificationMethodSuffix = "_subscription"
+	// maxBatchSize limits the number of requests of a batch, larger batches are rejected as a whole
+	maxBatchSize = 1000
 )
 
 type jsonRequest struct {
@@ -223,6 +225,12 @@ func parseBatchRequest(incomingMsg json.RawMessage) ([]rpcRequest, bool, Error)
 	if err := json.Unmarshal(incomingMsg, &in); err != nil {
 		return nil, false, &invalidMessageError{err.Error()}
 	}
+	if len(in) == 0 {
+		return nil, false, &invalidRequestError{"empty batch"}
+	}
+	if len(in) > maxBatchSize {
+		return nil, false, &invalidRequestError{fmt.Sprintf("batch too large (%d>%d)", len(in), maxBatchSize)}
+	}
 
 	requests := make([]rpcRequest, len(in))
 	for i, r := range in {
@@ -326,6 +334,9 @@ func (c *jsonCodec) CreateResponse(id interface{}, reply interface{}) interface{
 
 // CreateErrorResponse will create a JSON-RPC error response with the given id and error.
 func (c *jsonCodec) CreateErrorResponse(id interface{}, err Error) interface{} {
+	if dataErr, ok := err.(DataError); ok {
+		return c.CreateErrorResponseWithInfo(id, err, dataErr.ErrorData())
+	}
 	return &jsonErrResponse{Version: jsonrpcVersion, Id: id, Error: jsonError{Code: err.ErrorCode(), Message: err.Error()}}
 }
 
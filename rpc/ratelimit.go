@@ -0,0 +1,277 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// rateLimiterCleanupInterval is how often buckets of idle clients are dropped
+const rateLimiterCleanupInterval = time.Minute
+
+type clientBucket struct {
+	tokens float64
+	rate   float64
+	burst  float64
+	last   time.Time
+}
+
+// tokenBuckets keeps a token bucket of every client, buckets of idle clients are dropped
+type tokenBuckets struct {
+	clients     map[string]*clientBucket
+	lastCleanup time.Time
+	mutex       sync.Mutex
+	now         func() time.Time
+}
+
+func newTokenBuckets() tokenBuckets {
+	return tokenBuckets{
+		clients: make(map[string]*clientBucket),
+		now:     time.Now,
+	}
+}
+
+// take takes a token of the client bucket refilled by rate up to burst tokens and returns how long the client
+// has to wait if there are no tokens left
+func (b *tokenBuckets) take(client string, rate, burst float64) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	now := b.now()
+	if now.Sub(b.lastCleanup) >= rateLimiterCleanupInterval {
+		// a bucket refilled up to the burst is the same as a missing one
+		for key, bucket := range b.clients {
+			if now.Sub(bucket.last) >= time.Duration(bucket.burst/bucket.rate*float64(time.Second)) {
+				delete(b.clients, key)
+			}
+		}
+		b.lastCleanup = now
+	}
+	bucket, ok := b.clients[client]
+	if !ok {
+		bucket = &clientBucket{tokens: burst, last: now}
+		b.clients[client] = bucket
+	}
+	bucket.rate, bucket.burst = rate, burst
+	bucket.tokens = math.Min(burst, bucket.tokens+now.Sub(bucket.last).Seconds()*rate)
+	bucket.last = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return 0
+	}
+	return time.Duration((1 - bucket.tokens) / rate * float64(time.Second))
+}
+
+// RateLimiter limits HTTP requests of every client IP by a token bucket. The client IP is taken from
+// the X-Forwarded-For header if the request comes from a trusted reverse proxy and from the remote address otherwise.
+type RateLimiter struct {
+	tokenBuckets
+	rate    float64
+	burst   float64
+	proxies []*net.IPNet
+}
+
+// NewRateLimiter creates a limiter of requestsPerSec per client with the burst of requests, the burst is one second
+// of requests if it isn't set. trustedProxies are IPs or CIDRs of reverse proxies. Nil means no limit.
+func NewRateLimiter(requestsPerSec float64, burst int, trustedProxies []string) (*RateLimiter, error) {
+	if requestsPerSec <= 0 {
+		return nil, nil
+	}
+	l := &RateLimiter{
+		tokenBuckets: newTokenBuckets(),
+		rate:         requestsPerSec,
+		burst:        float64(burst),
+	}
+	if burst <= 0 {
+		l.burst = math.Max(1, requestsPerSec)
+	}
+	for _, proxy := range trustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if proxy == "" {
+			continue
+		}
+		if !strings.Contains(proxy, "/") {
+			if ip := net.ParseIP(proxy); ip != nil && ip.To4() != nil {
+				proxy += "/32"
+			} else {
+				proxy += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %v", proxy, err)
+		}
+		l.proxies = append(l.proxies, ipNet)
+	}
+	return l, nil
+}
+
+func (l *RateLimiter) trusted(ip net.IP) bool {
+	for _, proxy := range l.proxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the IP of the client which sent the request. Addresses of X-Forwarded-For are walked from
+// the closest hop while they belong to trusted proxies, so a client can't hide behind a forged header.
+func (l *RateLimiter) clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !l.trusted(ip) {
+		return host
+	}
+	var hops []string
+	for _, header := range r.Header.Values("X-Forwarded-For") {
+		hops = append(hops, strings.Split(header, ",")...)
+	}
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := net.ParseIP(strings.TrimSpace(hops[i]))
+		if hop == nil {
+			break
+		}
+		ip = hop
+		if !l.trusted(hop) {
+			break
+		}
+	}
+	return ip.String()
+}
+
+// reserve takes a token of the client and returns how long the client has to wait if there are no tokens left
+func (l *RateLimiter) reserve(client string) time.Duration {
+	return l.take(client, l.rate, l.burst)
+}
+
+// Handler rejects requests of clients which exceeded the rate with 429 Too Many Requests, a nil limiter returns next
+func (l *RateLimiter) Handler(next http.Handler) http.Handler {
+	if l == nil {
+		return next
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ip := l.clientIP(r)
+		if delay := l.reserve(ip); delay > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfterSeconds(delay)))
+			http.Error(w, "rate limit exceeded", http.StatusTooManyRequests)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), clientIPKey{}, ip)))
+	})
+}
+
+// clientIPKey is the context key of the client IP determined by the HTTP rate limiter
+type clientIPKey struct{}
+
+// rateLimitedKey is the context key of the callback which is called if all requests of an HTTP call exceeded quotas
+type rateLimitedKey struct{}
+
+type quota struct {
+	rate  float64
+	burst float64
+}
+
+func newQuota(rate float64, burst int) quota {
+	if burst <= 0 {
+		return quota{rate, math.Max(1, rate)}
+	}
+	return quota{rate, float64(burst)}
+}
+
+// Quotas limits calls of API keys which have a rate limit and calls of expensive methods of every client by token
+// buckets. A client is identified by its API key if the key has a rate limit and by its IP otherwise.
+type Quotas struct {
+	tokenBuckets
+	keys      map[string]quota
+	names     map[string]string
+	expensive map[string]struct{}
+	quota     quota
+}
+
+// NewQuotas creates quotas of keys and of expensiveMethods, the methods are listed in the namespace_method form
+// or as "namespace_*", the burst is one second of calls if it isn't set. Nil means no quotas.
+func NewQuotas(keys []APIKeyConfig, expensiveMethods []string, expensivePerSec float64, expensiveBurst int) *Quotas {
+	q := &Quotas{
+		tokenBuckets: newTokenBuckets(),
+		keys:         make(map[string]quota),
+		names:        make(map[string]string),
+	}
+	for i, cfg := range keys {
+		if cfg.RateLimit <= 0 || cfg.Key == "" {
+			continue
+		}
+		q.keys[cfg.Key] = newQuota(cfg.RateLimit, cfg.RateBurst)
+		q.names[cfg.Key] = cfg.Name
+		if cfg.Name == "" {
+			q.names[cfg.Key] = fmt.Sprintf("key%d", i)
+		}
+	}
+	if expensivePerSec > 0 && len(expensiveMethods) > 0 {
+		q.quota = newQuota(expensivePerSec, expensiveBurst)
+		q.expensive = make(map[string]struct{}, len(expensiveMethods))
+		for _, m := range expensiveMethods {
+			q.expensive[m] = struct{}{}
+		}
+	}
+	if len(q.keys) == 0 && q.expensive == nil {
+		return nil
+	}
+	return q
+}
+
+func (q *Quotas) isExpensive(method string) bool {
+	if _, ok := q.expensive[method]; ok {
+		return true
+	}
+	if idx := strings.Index(method, serviceMethodSeparator); idx >= 0 {
+		if _, ok := q.expensive[method[:idx+1]+"*"]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// reserve takes tokens of the call and returns how long the client has to wait if a quota is exceeded,
+// a nil quotas allows everything
+func (q *Quotas) reserve(ip string, key string, method string) time.Duration {
+	if q == nil {
+		return 0
+	}
+	client := "ip:" + ip
+	if keyQuota, ok := q.keys[key]; ok {
+		client = "key:" + q.names[key]
+		if delay := q.take(client, keyQuota.rate, keyQuota.burst); delay > 0 {
+			return delay
+		}
+	}
+	if q.expensive != nil && q.isExpensive(method) {
+		return q.take("expensive:"+client, q.quota.rate, q.quota.burst)
+	}
+	return 0
+}
+
+// requestClientIP returns the client IP of the request context, the IP found by the HTTP rate limiter is preferred
+func requestClientIP(ctx context.Context) string {
+	if ip, ok := ctx.Value(clientIPKey{}).(string); ok {
+		return ip
+	}
+	remote, _ := ctx.Value("remote").(string)
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		return host
+	}
+	return remote
+}
+
+// retryAfterSeconds rounds the delay up to whole seconds as it is sent to clients
+func retryAfterSeconds(delay time.Duration) int {
+	return int(math.Ceil(delay.Seconds()))
+}
@@ -0,0 +1,120 @@
+package rpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter_clientIP(t *testing.T) {
+	limiter, err := NewRateLimiter(1, 0, []string{"10.0.0.0/8", "192.168.1.1"})
+	require.NoError(t, err)
+
+	request := func(remote string, forwarded ...string) *http.Request {
+		r := httptest.NewRequest(http.MethodPost, "/", nil)
+		r.RemoteAddr = remote
+		for _, f := range forwarded {
+			r.Header.Add("X-Forwarded-For", f)
+		}
+		return r
+	}
+
+	require.Equal(t, "1.1.1.1", limiter.clientIP(request("1.1.1.1:1000", "2.2.2.2")))
+	require.Equal(t, "2.2.2.2", limiter.clientIP(request("10.1.2.3:1000", "2.2.2.2")))
+	require.Equal(t, "2.2.2.2", limiter.clientIP(request("192.168.1.1:1000", "3.3.3.3, 2.2.2.2, 10.0.0.1")))
+	require.Equal(t, "2.2.2.2", limiter.clientIP(request("10.1.2.3:1000", "3.3.3.3", "2.2.2.2")))
+	require.Equal(t, "10.0.0.1", limiter.clientIP(request("10.1.2.3:1000", "10.0.0.1")))
+	require.Equal(t, "10.1.2.3", limiter.clientIP(request("10.1.2.3:1000")))
+
+	_, err = NewRateLimiter(1, 0, []string{"proxy"})
+	require.Error(t, err)
+	limiter, err = NewRateLimiter(0, 0, nil)
+	require.NoError(t, err)
+	require.Nil(t, limiter)
+}
+
+func TestRateLimiter_reserve(t *testing.T) {
+	limiter, err := NewRateLimiter(2, 3, nil)
+	require.NoError(t, err)
+	now := time.Unix(1000, 0)
+	limiter.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		require.Zero(t, limiter.reserve("a"))
+	}
+	require.Equal(t, time.Second/2, limiter.reserve("a"))
+	require.Zero(t, limiter.reserve("b"))
+
+	now = now.Add(time.Second / 2)
+	require.Zero(t, limiter.reserve("a"))
+	require.NotZero(t, limiter.reserve("a"))
+
+	now = now.Add(rateLimiterCleanupInterval)
+	require.Zero(t, limiter.reserve("a"))
+	require.Len(t, limiter.clients, 1)
+}
+
+func TestQuotas_reserve(t *testing.T) {
+	require.Nil(t, NewQuotas(nil, []string{"test_rets"}, 0, 0))
+	require.Nil(t, NewQuotas([]APIKeyConfig{{Key: "key"}}, nil, 1, 0))
+
+	quotas := NewQuotas([]APIKeyConfig{{Key: "limited", RateLimit: 1, RateBurst: 2}, {Key: "free"}}, []string{"bcn_traceTx", "contract_*"}, 1, 0)
+	now := time.Unix(1000, 0)
+	quotas.now = func() time.Time { return now }
+
+	// calls of the key are limited whatever the client IP is
+	require.Zero(t, quotas.reserve("1.1.1.1", "limited", "dna_epoch"))
+	require.Zero(t, quotas.reserve("2.2.2.2", "limited", "dna_epoch"))
+	require.Equal(t, time.Second, quotas.reserve("3.3.3.3", "limited", "dna_epoch"))
+
+	// expensive methods are limited by IP if the key has no limit
+	require.Zero(t, quotas.reserve("1.1.1.1", "free", "bcn_traceTx"))
+	require.Equal(t, time.Second, quotas.reserve("1.1.1.1", "", "contract_getLogs"))
+	require.Zero(t, quotas.reserve("2.2.2.2", "free", "contract_getLogs"))
+	require.Zero(t, quotas.reserve("1.1.1.1", "free", "bcn_block"))
+
+	now = now.Add(time.Second)
+	require.Zero(t, quotas.reserve("1.1.1.1", "free", "bcn_traceTx"))
+	require.Zero(t, quotas.reserve("1.1.1.1", "limited", "bcn_traceTx"))
+	require.Equal(t, time.Second, quotas.reserve("1.1.1.1", "limited", "bcn_traceTx"))
+
+	var nilQuotas *Quotas
+	require.Zero(t, nilQuotas.reserve("1.1.1.1", "", "bcn_traceTx"))
+}
+
+func TestHTTPQuotas(t *testing.T) {
+	server := NewServer("")
+	require.NoError(t, server.RegisterName("test", new(Service)))
+	server.quotas = NewQuotas(nil, []string{"test_rets"}, 1, 1)
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	call := func(body string) (*http.Response, jsonErrResponse) {
+		resp, err := http.Post(httpServer.URL, contentType, strings.NewReader(body))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		var result jsonErrResponse
+		if !strings.HasPrefix(body, "[") {
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&result))
+		}
+		return resp, result
+	}
+
+	resp, _ := call(`{"jsonrpc":"2.0","id":1,"method":"test_rets"}`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp, result := call(`{"jsonrpc":"2.0","id":2,"method":"test_rets"}`)
+	require.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+	require.Equal(t, "1", resp.Header.Get("Retry-After"))
+	require.Equal(t, -32005, result.Error.Code)
+	require.Equal(t, map[string]interface{}{"retryAfter": float64(1)}, result.Error.Data)
+
+	// a batch is answered normally if some of its calls are allowed
+	resp, _ = call(`[{"jsonrpc":"2.0","id":3,"method":"test_rets"},{"jsonrpc":"2.0","id":4,"method":"test_noArgsRets"}]`)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+}
@@ -0,0 +1,248 @@
+package rpc
+
+import (
+	"encoding"
+	"encoding/json"
+	"math/big"
+	"path"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	bigIntType        = reflect.TypeOf(big.Int{})
+)
+
+// Schema is a machine-readable description of RPC methods of the server, named structs are described once
+// in definitions and referenced by "#/definitions/<package>.<type>"
+type Schema struct {
+	Methods     []*MethodSchema        `json:"methods"`
+	Definitions map[string]*TypeSchema `json:"definitions"`
+}
+
+// MethodSchema describes a method, a subscription is created by calling <namespace>_subscribe with the name
+// of the subscription without the namespace as the first param
+type MethodSchema struct {
+	Name         string         `json:"name"`
+	Params       []*ParamSchema `json:"params"`
+	Result       *TypeSchema    `json:"result,omitempty"`
+	Subscription bool           `json:"subscription,omitempty"`
+}
+
+// ParamSchema describes a positional param, trailing params which are not required can be omitted
+type ParamSchema struct {
+	Name     string      `json:"name"`
+	Required bool        `json:"required"`
+	Schema   *TypeSchema `json:"schema"`
+}
+
+// TypeSchema describes a JSON value in the JSON Schema form, the format is the Go type of values which have
+// their own JSON encoding (e.g. common.Address)
+type TypeSchema struct {
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Nullable             bool                   `json:"nullable,omitempty"`
+	Items                *TypeSchema            `json:"items,omitempty"`
+	Properties           map[string]*TypeSchema `json:"properties,omitempty"`
+	AdditionalProperties *TypeSchema            `json:"additionalProperties,omitempty"`
+}
+
+// Schema returns the description of methods of the given modules, all modules are described if the list is not set
+func (s *RPCService) Schema(modules *[]string) *Schema {
+	var filter map[string]struct{}
+	if modules != nil {
+		filter = make(map[string]struct{}, len(*modules))
+		for _, m := range *modules {
+			filter[m] = struct{}{}
+		}
+	}
+	builder := &schemaBuilder{definitions: make(map[string]*TypeSchema)}
+	var methods []*MethodSchema
+	for name, svc := range s.server.services {
+		if filter != nil {
+			if _, ok := filter[name]; !ok {
+				continue
+			}
+		}
+		for method, cb := range svc.callbacks {
+			methods = append(methods, builder.method(name+serviceMethodSeparator+method, cb))
+		}
+		for method, cb := range svc.subscriptions {
+			methods = append(methods, builder.method(name+serviceMethodSeparator+method, cb))
+		}
+	}
+	sort.Slice(methods, func(i, j int) bool {
+		return methods[i].Name < methods[j].Name
+	})
+	return &Schema{
+		Methods:     methods,
+		Definitions: builder.definitions,
+	}
+}
+
+type schemaBuilder struct {
+	definitions map[string]*TypeSchema
+}
+
+func (b *schemaBuilder) method(name string, cb *callback) *MethodSchema {
+	res := &MethodSchema{
+		Name:         name,
+		Params:       make([]*ParamSchema, 0, len(cb.argTypes)),
+		Subscription: cb.isSubscribe,
+	}
+	for i, argType := range cb.argTypes {
+		res.Params = append(res.Params, &ParamSchema{
+			Name:     "arg" + strconv.Itoa(i),
+			Required: argType.Kind() != reflect.Ptr,
+			Schema:   b.typeSchema(argType),
+		})
+	}
+	// required params can't follow optional ones since only trailing params can be omitted
+	for i := len(res.Params) - 2; i >= 0; i-- {
+		if res.Params[i+1].Required {
+			res.Params[i].Required = true
+		}
+	}
+	if cb.isSubscribe {
+		res.Result = &TypeSchema{Type: "string", Format: "subscription id"}
+		return res
+	}
+	mtype := cb.method.Type
+	for i := 0; i < mtype.NumOut(); i++ {
+		if i != cb.errPos {
+			res.Result = b.typeSchema(mtype.Out(i))
+			break
+		}
+	}
+	return res
+}
+
+func typeName(t reflect.Type) string {
+	if t.Name() == "" {
+		return t.String()
+	}
+	return path.Base(t.PkgPath()) + "." + t.Name()
+}
+
+func (b *schemaBuilder) typeSchema(t reflect.Type) *TypeSchema {
+	if t.Kind() == reflect.Ptr {
+		res := b.typeSchema(t.Elem())
+		copied := *res
+		copied.Nullable = true
+		return &copied
+	}
+	if t == bigIntType {
+		return &TypeSchema{Type: "integer", Format: typeName(t)}
+	}
+	if t.Implements(jsonMarshalerType) || reflect.PtrTo(t).Implements(jsonMarshalerType) {
+		if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+			return &TypeSchema{Type: "string", Format: typeName(t)}
+		}
+		// the encoding is unknown, any value is allowed
+		return &TypeSchema{Format: typeName(t)}
+	}
+	if t.Implements(textMarshalerType) || reflect.PtrTo(t).Implements(textMarshalerType) {
+		return &TypeSchema{Type: "string", Format: typeName(t)}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &TypeSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		res := &TypeSchema{Type: "integer"}
+		if t.Name() != t.Kind().String() {
+			res.Format = typeName(t)
+		}
+		return res
+	case reflect.Float32, reflect.Float64:
+		return &TypeSchema{Type: "number"}
+	case reflect.String:
+		res := &TypeSchema{Type: "string"}
+		if t.Name() != "string" {
+			res.Format = typeName(t)
+		}
+		return res
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &TypeSchema{Type: "string", Format: "base64"}
+		}
+		return &TypeSchema{Type: "array", Items: b.typeSchema(t.Elem()), Nullable: true}
+	case reflect.Array:
+		return &TypeSchema{Type: "array", Items: b.typeSchema(t.Elem())}
+	case reflect.Map:
+		return &TypeSchema{Type: "object", AdditionalProperties: b.typeSchema(t.Elem()), Nullable: true}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return b.structSchema(t)
+		}
+		name := typeName(t)
+		if _, ok := b.definitions[name]; !ok {
+			// the definition is registered before fields are described to stop recursion of self-referencing types
+			b.definitions[name] = &TypeSchema{Type: "object"}
+			b.definitions[name] = b.structSchema(t)
+		}
+		return &TypeSchema{Ref: "#/definitions/" + name}
+	default:
+		// interfaces and values which can't be encoded, any value is allowed
+		return &TypeSchema{}
+	}
+}
+
+func (b *schemaBuilder) structSchema(t reflect.Type) *TypeSchema {
+	res := &TypeSchema{Type: "object", Properties: make(map[string]*TypeSchema)}
+	b.addFields(res.Properties, t)
+	return res
+}
+
+// addFields adds JSON fields of the struct, fields of embedded structs are promoted unless they are shadowed
+func (b *schemaBuilder) addFields(properties map[string]*TypeSchema, t reflect.Type) {
+	var embedded []reflect.Type
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx >= 0 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+		fieldType := field.Type
+		if field.Anonymous && name == "" {
+			if fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if fieldType.Kind() == reflect.Struct {
+				embedded = append(embedded, fieldType)
+				continue
+			}
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		schema := b.typeSchema(fieldType)
+		for _, opt := range strings.Split(opts, ",") {
+			if opt == "string" {
+				schema = &TypeSchema{Type: "string", Format: schema.Type}
+			}
+		}
+		properties[name] = schema
+	}
+	for _, e := range embedded {
+		promoted := make(map[string]*TypeSchema)
+		b.addFields(promoted, e)
+		for name, schema := range promoted {
+			if _, ok := properties[name]; !ok {
+				properties[name] = schema
+			}
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRPCService_Schema(t *testing.T) {
+	server := NewServer("")
+	require.NoError(t, server.RegisterName("test", new(Service)))
+
+	schema := (&RPCService{server}).Schema(&[]string{"test"})
+	methods := make(map[string]*MethodSchema)
+	for _, m := range schema.Methods {
+		methods[m.Name] = m
+	}
+	require.NotContains(t, methods, "rpc_modules")
+
+	echo := methods["test_echo"]
+	require.NotNil(t, echo)
+	require.Len(t, echo.Params, 3)
+	require.Equal(t, "string", echo.Params[0].Schema.Type)
+	require.True(t, echo.Params[0].Required)
+	require.Equal(t, "integer", echo.Params[1].Schema.Type)
+	require.False(t, echo.Params[2].Required)
+	require.Equal(t, "#/definitions/rpc.Args", echo.Params[2].Schema.Ref)
+	require.Equal(t, "#/definitions/rpc.Result", echo.Result.Ref)
+
+	result := schema.Definitions["rpc.Result"]
+	require.Equal(t, "string", result.Properties["String"].Type)
+	require.True(t, result.Properties["Args"].Nullable)
+
+	require.Equal(t, "integer", methods["test_sleep"].Params[0].Schema.Type)
+	require.Equal(t, "time.Duration", methods["test_sleep"].Params[0].Schema.Format)
+	require.Nil(t, methods["test_noArgsRets"].Result)
+	require.Equal(t, "string", methods["test_rets"].Result.Type)
+	require.True(t, methods["test_subscription"].Subscription)
+
+	_, err := json.Marshal(schema)
+	require.NoError(t, err)
+
+	all := (&RPCService{server}).Schema(nil)
+	require.Greater(t, len(all.Methods), len(schema.Methods))
+}
@@ -24,12 +24,18 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	mapset "github.com/deckarep/golang-set"
 	"github.com/idena-network/idena-go/log"
 )
 
-const MetadataApi = "rpc"
+const (
+	MetadataApi = "rpc"
+
+	// batchConcurrency limits the number of concurrently executed method calls of a batch
+	batchConcurrency = 8
+)
 
 // CodecOption specifies which type of messages this codec supports
 type CodecOption int
@@ -42,10 +48,18 @@ const (
 	OptionSubscriptions = 1 << iota // support pub sub
 )
 
-// NewServer will create a new server instance with no registered handlers.
+// NewServer will create a new server instance with no registered handlers, the api key has the full access
+// to the server, the access isn't restricted if the key is empty.
 func NewServer(apiKey string) *Server {
+	auth, _ := NewAuth(apiKey, "", nil, nil, false)
+	return NewServerWithAuth(auth)
+}
+
+// NewServerWithAuth will create a new server instance with no registered handlers, requests are authorized by auth
+// unless it is nil.
+func NewServerWithAuth(auth *Auth) *Server {
 	server := &Server{
-		apiKey:   apiKey,
+		auth:     auth,
 		services: make(serviceRegistry),
 		codecs:   mapset.NewSet(),
 		run:      1,
@@ -158,7 +172,7 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 
 	// test if the server is ordered to stop
 	for atomic.LoadInt32(&s.run) == 1 {
-		reqs, batch, err := s.readRequest(codec)
+		reqs, batch, err := s.readRequest(ctx, codec)
 		if err != nil {
 			// If a parsing error occurred, send an error
 			if err.Error() != "EOF" {
@@ -185,6 +199,11 @@ func (s *Server) serveRequest(ctx context.Context, codec ServerCodec, singleShot
 			}
 			return nil
 		}
+		if rateLimited, ok := ctx.Value(rateLimitedKey{}).(func(time.Duration)); ok {
+			if delay := rejectedByQuotas(reqs); delay > 0 {
+				rateLimited(delay)
+			}
+		}
 		// If a single shot request is executing, run and return immediately
 		if singleShot {
 			if batch {
@@ -343,19 +362,48 @@ func (s *Server) exec(ctx context.Context, codec ServerCodec, req *serverRequest
 }
 
 // execBatch executes the given requests and writes the result back using the codec.
-// It will only write the response back when the last request is processed.
+// It will only write the response back when the last request is processed. Method calls of a batch without
+// (un)subscriptions are executed concurrently, the order of responses matches the order of requests.
 func (s *Server) execBatch(ctx context.Context, codec ServerCodec, requests []*serverRequest) {
 	responses := make([]interface{}, len(requests))
 	var callbacks []func()
-	for i, req := range requests {
-		if req.err != nil {
-			responses[i] = codec.CreateErrorResponse(&req.id, req.err)
-		} else {
-			var callback func()
-			if responses[i], callback = s.handle(ctx, codec, req); callback != nil {
-				callbacks = append(callbacks, callback)
+	if hasSubscriptions(requests) {
+		for i, req := range requests {
+			if req.err != nil {
+				responses[i] = codec.CreateErrorResponse(&req.id, req.err)
+			} else {
+				var callback func()
+				if responses[i], callback = s.handle(ctx, codec, req); callback != nil {
+					callbacks = append(callbacks, callback)
+				}
 			}
 		}
+	} else {
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, batchConcurrency)
+		for i, req := range requests {
+			if req.err != nil {
+				responses[i] = codec.CreateErrorResponse(&req.id, req.err)
+				continue
+			}
+			wg.Add(1)
+			sem <- struct{}{}
+			go func(i int, req *serverRequest) {
+				defer func() {
+					if err := recover(); err != nil {
+						const size = 64 << 10
+						buf := make([]byte, size)
+						buf = buf[:runtime.Stack(buf, false)]
+						log.Error(string(buf))
+						responses[i] = codec.CreateErrorResponse(&req.id, &callbackError{"method handler crashed"})
+					}
+					<-sem
+					wg.Done()
+				}()
+				responses[i], _ = s.handle(ctx, codec, req)
+			}(i, req)
+		}
+		wg.Wait()
 	}
 
 	if err := codec.Write(responses); err != nil {
@@ -369,10 +417,19 @@ func (s *Server) execBatch(ctx context.Context, codec ServerCodec, requests []*s
 	}
 }
 
+func hasSubscriptions(requests []*serverRequest) bool {
+	for _, req := range requests {
+		if req.isUnsubscribe || req.callb != nil && req.callb.isSubscribe {
+			return true
+		}
+	}
+	return false
+}
+
 // readRequest requests the next (batch) request from the codec. It will return the collection
 // of requests, an indication if the request was a batch, the invalid request identifier and an
 // error when the request could not be read/parsed.
-func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error) {
+func (s *Server) readRequest(ctx context.Context, codec ServerCodec) ([]*serverRequest, bool, Error) {
 	reqs, batch, err := codec.ReadRequestHeaders()
 	if err != nil {
 		return nil, batch, err
@@ -390,8 +447,13 @@ func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error)
 			continue
 		}
 
-		if s.apiKey != "" && r.key != s.apiKey {
-			requests[i] = &serverRequest{id: r.id, err: &invalidApiKeyError{}}
+		if err := s.auth.authorize(r.key, requestMethod(r)); err != nil {
+			requests[i] = &serverRequest{id: r.id, err: err}
+			continue
+		}
+
+		if delay := s.quotas.reserve(requestClientIP(ctx), r.key, requestMethod(r)); delay > 0 {
+			requests[i] = &serverRequest{id: r.id, err: &rateLimitError{delay}}
 			continue
 		}
 
@@ -446,3 +508,27 @@ func (s *Server) readRequest(codec ServerCodec) ([]*serverRequest, bool, Error)
 
 	return requests, batch, nil
 }
+
+// rejectedByQuotas returns the longest retry delay if all requests exceeded quotas and zero otherwise
+func rejectedByQuotas(reqs []*serverRequest) time.Duration {
+	var delay time.Duration
+	for _, r := range reqs {
+		err, ok := r.err.(*rateLimitError)
+		if !ok {
+			return 0
+		}
+		if err.retryAfter > delay {
+			delay = err.retryAfter
+		}
+	}
+	return delay
+}
+
+// requestMethod returns the name of the requested method in the namespace_method form, the name of the subscription
+// is used for subscribe requests
+func requestMethod(r rpcRequest) string {
+	if r.isPubSub && strings.HasSuffix(r.method, unsubscribeMethodSuffix) {
+		return r.method
+	}
+	return r.service + serviceMethodSeparator + r.method
+}
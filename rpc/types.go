@@ -19,6 +19,7 @@ package rpc
 import (
 	"fmt"
 	"math"
+	"net/http"
 	"reflect"
 	"strings"
 	"sync"
@@ -70,7 +71,12 @@ type subscriptions map[string]*callback  // collection of subscription callbacks
 // Server represents a RPC server
 type Server struct {
 	services serviceRegistry
-	apiKey   string
+	auth     *Auth
+	health   *HealthChecks
+	quotas   *Quotas
+
+	routesMu sync.RWMutex
+	routes   map[string]http.Handler
 
 	run      int32
 	codecsMu sync.Mutex
@@ -94,6 +100,12 @@ type Error interface {
 	ErrorCode() int // returns the code
 }
 
+// DataError is an Error with additional information sent in the data field of the response
+type DataError interface {
+	Error
+	ErrorData() interface{}
+}
+
 // ServerCodec implements reading, parsing and writing RPC messages for the server side of
 // a RPC session. Implementations must be go-routine safe since the codec can be called in
 // multiple go-routines concurrently.
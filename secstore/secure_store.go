@@ -7,6 +7,7 @@ import (
 	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/bls"
 	"github.com/idena-network/idena-go/crypto/ecies"
 	"github.com/idena-network/idena-go/crypto/vrf/p256"
 	"os"
@@ -14,6 +15,7 @@ import (
 
 type SecStore struct {
 	buffer *memguard.LockedBuffer
+	blsKey *bls.PrivateKey
 }
 
 func NewSecStore() *SecStore {
@@ -26,6 +28,7 @@ func NewSecStore() *SecStore {
 }
 
 func (s *SecStore) AddKey(secret []byte) {
+	s.blsKey = bls.GenerateKey(secret)
 	buffer := memguard.NewBufferFromBytes(secret)
 	s.buffer = buffer
 }
@@ -45,6 +48,11 @@ func (s *SecStore) SignFlipKeysPackage(fk *types.PrivateFlipKeysPackage) (*types
 	return types.SignFlipKeysPackage(fk, sec)
 }
 
+func (s *SecStore) SignEpochCheckpoint(c *types.EpochCheckpoint) (*types.EpochCheckpoint, error) {
+	sec, _ := crypto.ToECDSA(s.buffer.Bytes())
+	return types.SignEpochCheckpoint(c, sec)
+}
+
 func (s *SecStore) GetAddress() common.Address {
 	sec, _ := crypto.ToECDSA(s.buffer.Bytes())
 	return crypto.PubkeyToAddress(sec.PublicKey)
@@ -70,6 +78,20 @@ func (s *SecStore) Sign(data []byte) []byte {
 	return sig
 }
 
+// BlsPublicKey returns the public key of the BLS key derived from the node key when the key is added
+func (s *SecStore) BlsPublicKey() []byte {
+	return s.blsKey.PublicKey()
+}
+
+func (s *SecStore) BlsSign(data []byte) []byte {
+	return s.blsKey.Sign(data)
+}
+
+// BlsProof returns the proof of possession of the BLS key required to register it
+func (s *SecStore) BlsProof() []byte {
+	return s.blsKey.Proof()
+}
+
 func (s *SecStore) Destroy() {
 	if s.buffer != nil {
 		s.buffer.Destroy()
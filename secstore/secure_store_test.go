@@ -2,6 +2,7 @@ package secstore
 
 import (
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/idena-network/idena-go/crypto/bls"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -16,3 +17,13 @@ func TestSecStore_VrfEvaluate(t *testing.T) {
 	require.Equal(t, index, index2)
 	require.NotEqual(t, proof, proof2)
 }
+
+func TestSecStore_BlsKey(t *testing.T) {
+	secStore := NewSecStore()
+	key, _ := crypto.GenerateKey()
+	secStore.AddKey(crypto.FromECDSA(key))
+
+	require.Equal(t, bls.GenerateKey(crypto.FromECDSA(key)).PublicKey(), secStore.BlsPublicKey())
+	require.True(t, bls.Verify(secStore.BlsPublicKey(), []byte{0x1}, secStore.BlsSign([]byte{0x1})))
+	require.True(t, bls.VerifyProof(secStore.BlsPublicKey(), secStore.BlsProof()))
+}
@@ -0,0 +1,259 @@
+package collector
+
+import (
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/database"
+	"github.com/idena-network/idena-go/events"
+	dbm "github.com/tendermint/tm-db"
+	"math/big"
+	"sync"
+)
+
+type balanceSnapshot struct {
+	balance *big.Int
+	stake   *big.Int
+}
+
+type balanceUpdate struct {
+	reason    types.BalanceChangeReason
+	txHash    *common.Hash
+	snapshots map[common.Address]*balanceSnapshot
+	addresses []common.Address
+	fees      map[common.Address]*big.Int
+}
+
+type journalEntry struct {
+	address common.Address
+	change  *types.BalanceChange
+}
+
+// balanceJournal wraps a stats collector and records every balance and stake change of the block being inserted
+// together with the reason of the change.
+type balanceJournal struct {
+	StatsCollector
+	db         dbm.DB
+	repo       *database.Repo
+	collecting bool
+	updates    []*balanceUpdate
+	currentTx  *types.Transaction
+	pending    []*journalEntry
+	mutex      sync.Mutex
+}
+
+func NewBalanceJournal(inner StatsCollector, db dbm.DB, bus eventbus.Bus) StatsCollector {
+	j := &balanceJournal{
+		StatsCollector: inner,
+		db:             db,
+		repo:           database.NewRepo(db),
+	}
+	bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		newBlockEvent := e.(*events.NewBlockEvent)
+		j.flush(newBlockEvent.Block.Height())
+	})
+	return j
+}
+
+func (j *balanceJournal) EnableCollecting() {
+	j.mutex.Lock()
+	j.collecting = true
+	j.updates = nil
+	j.pending = nil
+	j.mutex.Unlock()
+	j.StatsCollector.EnableCollecting()
+}
+
+func (j *balanceJournal) CompleteCollecting() {
+	j.mutex.Lock()
+	j.collecting = false
+	j.updates = nil
+	j.pending = nil
+	j.currentTx = nil
+	j.mutex.Unlock()
+	j.StatsCollector.CompleteCollecting()
+}
+
+func (j *balanceJournal) flush(height uint64) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if len(j.pending) == 0 {
+		return
+	}
+	batch := j.db.NewBatch()
+	defer batch.Close()
+	for idx, entry := range j.pending {
+		entry.change.Height = height
+		j.repo.WriteBalanceChange(batch, entry.address, uint32(idx), entry.change)
+	}
+	batch.WriteSync()
+	j.pending = nil
+}
+
+func (j *balanceJournal) begin(reason types.BalanceChangeReason, txHash *common.Hash, appState *appstate.AppState, addresses ...common.Address) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	if !j.collecting {
+		return
+	}
+	update := &balanceUpdate{
+		reason:    reason,
+		txHash:    txHash,
+		snapshots: make(map[common.Address]*balanceSnapshot),
+		fees:      make(map[common.Address]*big.Int),
+	}
+	for _, addr := range addresses {
+		if _, ok := update.snapshots[addr]; ok {
+			continue
+		}
+		update.snapshots[addr] = &balanceSnapshot{
+			balance: new(big.Int).Set(appState.State.GetBalance(addr)),
+			stake:   new(big.Int).Set(appState.State.GetStakeBalance(addr)),
+		}
+		update.addresses = append(update.addresses, addr)
+	}
+	j.updates = append(j.updates, update)
+}
+
+func (j *balanceJournal) CompleteBalanceUpdate(appState *appstate.AppState) {
+	j.mutex.Lock()
+	if j.collecting && len(j.updates) > 0 {
+		update := j.updates[len(j.updates)-1]
+		j.updates = j.updates[:len(j.updates)-1]
+		for _, addr := range update.addresses {
+			prev := update.snapshots[addr]
+			balance := new(big.Int).Set(appState.State.GetBalance(addr))
+			stake := new(big.Int).Set(appState.State.GetStakeBalance(addr))
+			if fee, ok := update.fees[addr]; ok && fee.Sign() > 0 {
+				afterFee := new(big.Int).Sub(prev.balance, fee)
+				j.pending = append(j.pending, &journalEntry{addr, &types.BalanceChange{
+					Reason:     types.FeeReason,
+					TxHash:     update.txHash,
+					BalanceOld: prev.balance,
+					BalanceNew: afterFee,
+					StakeOld:   prev.stake,
+					StakeNew:   prev.stake,
+				}})
+				prev.balance = afterFee
+			}
+			if balance.Cmp(prev.balance) == 0 && stake.Cmp(prev.stake) == 0 {
+				continue
+			}
+			j.pending = append(j.pending, &journalEntry{addr, &types.BalanceChange{
+				Reason:     update.reason,
+				TxHash:     update.txHash,
+				BalanceOld: prev.balance,
+				BalanceNew: balance,
+				StakeOld:   prev.stake,
+				StakeNew:   stake,
+			}})
+		}
+	}
+	j.mutex.Unlock()
+	j.StatsCollector.CompleteBalanceUpdate(appState)
+}
+
+func (j *balanceJournal) BeginApplyingTx(tx *types.Transaction, appState *appstate.AppState) {
+	j.mutex.Lock()
+	j.currentTx = tx
+	j.mutex.Unlock()
+	j.StatsCollector.BeginApplyingTx(tx, appState)
+}
+
+func (j *balanceJournal) CompleteApplyingTx(appState *appstate.AppState) {
+	j.mutex.Lock()
+	j.currentTx = nil
+	j.mutex.Unlock()
+	j.StatsCollector.CompleteApplyingTx(appState)
+}
+
+func (j *balanceJournal) BeginTxBalanceUpdate(tx *types.Transaction, appState *appstate.AppState) {
+	sender, _ := types.Sender(tx)
+	addresses := []common.Address{sender}
+	if tx.To != nil {
+		addresses = append(addresses, *tx.To)
+	}
+	if tx.Type == types.MultiSendTx {
+		if attachment := attachments.ParseMultiSendAttachment(tx); attachment != nil {
+			for _, payment := range attachment.Payments {
+				addresses = append(addresses, payment.To)
+			}
+		}
+	}
+	hash := tx.Hash()
+	j.begin(types.TxReason, &hash, appState, addresses...)
+	j.StatsCollector.BeginTxBalanceUpdate(tx, appState)
+}
+
+func (j *balanceJournal) AddFeeBurntCoins(addr common.Address, feeAmount *big.Int, burntRate float32, tx *types.Transaction) {
+	j.mutex.Lock()
+	if j.collecting && len(j.updates) > 0 && feeAmount != nil {
+		j.updates[len(j.updates)-1].fees[addr] = new(big.Int).Set(feeAmount)
+	}
+	j.mutex.Unlock()
+	j.StatsCollector.AddFeeBurntCoins(addr, feeAmount, burntRate, tx)
+}
+
+func (j *balanceJournal) AddContractBalanceUpdate(address common.Address, getCurrentBalance GetBalanceFunc, newBalance *big.Int, appState *appstate.AppState) {
+	j.mutex.Lock()
+	if j.collecting {
+		var txHash *common.Hash
+		if j.currentTx != nil {
+			hash := j.currentTx.Hash()
+			txHash = &hash
+		}
+		stake := new(big.Int).Set(appState.State.GetStakeBalance(address))
+		j.pending = append(j.pending, &journalEntry{address, &types.BalanceChange{
+			Reason:     types.TxReason,
+			TxHash:     txHash,
+			BalanceOld: new(big.Int).Set(getCurrentBalance(address)),
+			BalanceNew: new(big.Int).Set(newBalance),
+			StakeOld:   stake,
+			StakeNew:   stake,
+		}})
+	}
+	j.mutex.Unlock()
+	j.StatsCollector.AddContractBalanceUpdate(address, getCurrentBalance, newBalance, appState)
+}
+
+func (j *balanceJournal) BeginVerifiedStakeTransferBalanceUpdate(addrFrom, addrTo common.Address, appState *appstate.AppState) {
+	j.begin(types.StakingReason, nil, appState, addrFrom, addrTo)
+	j.StatsCollector.BeginVerifiedStakeTransferBalanceUpdate(addrFrom, addrTo, appState)
+}
+
+func (j *balanceJournal) BeginProposerRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	j.begin(types.BlockRewardReason, nil, appState, balanceDest, stakeDest)
+	j.StatsCollector.BeginProposerRewardBalanceUpdate(balanceDest, stakeDest, appState)
+}
+
+func (j *balanceJournal) BeginCommitteeRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	j.begin(types.BlockRewardReason, nil, appState, balanceDest, stakeDest)
+	j.StatsCollector.BeginCommitteeRewardBalanceUpdate(balanceDest, stakeDest, appState)
+}
+
+func (j *balanceJournal) BeginEpochRewardBalanceUpdate(balanceDest, stakeDest common.Address, appState *appstate.AppState) {
+	j.begin(types.ValidationRewardReason, nil, appState, balanceDest, stakeDest)
+	j.StatsCollector.BeginEpochRewardBalanceUpdate(balanceDest, stakeDest, appState)
+}
+
+func (j *balanceJournal) BeginFailedValidationBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	j.begin(types.PenaltyReason, nil, appState, addr)
+	j.StatsCollector.BeginFailedValidationBalanceUpdate(addr, appState)
+}
+
+func (j *balanceJournal) BeginPenaltyBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	j.begin(types.PenaltyReason, nil, appState, addr)
+	j.StatsCollector.BeginPenaltyBalanceUpdate(addr, appState)
+}
+
+func (j *balanceJournal) BeginEpochPenaltyResetBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	j.begin(types.PenaltyReason, nil, appState, addr)
+	j.StatsCollector.BeginEpochPenaltyResetBalanceUpdate(addr, appState)
+}
+
+func (j *balanceJournal) BeginDustClearingBalanceUpdate(addr common.Address, appState *appstate.AppState) {
+	j.begin(types.DustClearingReason, nil, appState, addr)
+	j.StatsCollector.BeginDustClearingBalanceUpdate(addr, appState)
+}
@@ -73,6 +73,7 @@ type StatsCollector interface {
 	AddContractBalanceUpdate(address common.Address, getCurrentBalance GetBalanceFunc, newBalance *big.Int, appState *appstate.AppState)
 	AddContractBurntCoins(address common.Address, getAmount GetBalanceFunc)
 	AddContractTerminationBurntCoins(address common.Address, stake, refund *big.Int)
+	AddContractStorageRentBurntCoins(address common.Address, amount *big.Int)
 
 	AddOracleVotingDeploy(contractAddress common.Address, startTime uint64, votingMinPayment *big.Int,
 		fact []byte, state byte, votingDuration, publicVotingDuration uint64, winnerThreshold, quorum byte, committeeSize uint64, ownerFee byte)
@@ -671,6 +672,17 @@ func AddContractTerminationBurntCoins(c StatsCollector, address common.Address,
 	c.AddContractTerminationBurntCoins(address, stake, refund)
 }
 
+func (c *collectorStub) AddContractStorageRentBurntCoins(address common.Address, amount *big.Int) {
+	// do nothing
+}
+
+func AddContractStorageRentBurntCoins(c StatsCollector, address common.Address, amount *big.Int) {
+	if c == nil {
+		return
+	}
+	c.AddContractStorageRentBurntCoins(address, amount)
+}
+
 func (c *collectorStub) AddOracleVotingDeploy(contractAddress common.Address, startTime uint64,
 	votingMinPayment *big.Int, fact []byte, state byte, votingDuration, publicVotingDuration uint64, winnerThreshold, quorum byte,
 	committeeSize uint64, ownerFee byte) {
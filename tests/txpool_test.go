@@ -5,12 +5,15 @@ import (
 	"github.com/idena-network/idena-go/blockchain"
 	"github.com/idena-network/idena-go/blockchain/attachments"
 	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/blockchain/validation"
 	"github.com/idena-network/idena-go/common"
 	"github.com/idena-network/idena-go/config"
 	"github.com/idena-network/idena-go/core/appstate"
 	"github.com/idena-network/idena-go/core/mempool"
 	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/crypto"
+	"github.com/ipfs/go-cid"
+	"github.com/multiformats/go-multihash"
 	"github.com/stretchr/testify/require"
 	"math/big"
 	"testing"
@@ -40,7 +43,8 @@ func TestTxPool_BuildBlockTransactions(t *testing.T) {
 
 	result := pool.BuildBlockTransactions()
 
-	require.Equal(t, 2, len(result))
+	// queued tx with nonce 3 is promoted once the gap is filled
+	require.Equal(t, 3, len(result))
 
 	for i := uint32(0); i < uint32(len(result)); i++ {
 		require.Equal(t, i+1, result[i].AccountNonce)
@@ -407,3 +411,36 @@ func TestTxPool_BuildBlockTransactionsWithPriorityTypes(t *testing.T) {
 func newBlockchain(withIdentity bool, alloc map[common.Address]config.GenesisAllocation, queueSlots int, executableSlots int, executableLimit int, queueLimit int) (*blockchain.TestBlockchain, *appstate.AppState, *mempool.TxPool, *ecdsa.PrivateKey) {
 	return blockchain.NewTestBlockchainWithConfig(withIdentity, config.GetDefaultConsensusConfig(), &config.ValidationConfig{}, alloc, queueSlots, executableSlots, executableLimit, queueLimit)
 }
+
+func TestTxPool_CeremonyTxGuards(t *testing.T) {
+	require := require.New(t)
+	key, _ := crypto.GenerateKey()
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+
+	alloc := make(map[common.Address]config.GenesisAllocation)
+	alloc[addr] = config.GenesisAllocation{
+		Balance: new(big.Int).Mul(common.DnaBase, big.NewInt(100)),
+		State:   uint8(state.Verified),
+	}
+	chain, app, pool, _ := newBlockchain(true, alloc, -1, -1, -1, -1)
+	app.State.SetRequiredFlips(addr, 3)
+	app.Commit(nil)
+
+	block := chain.GenerateEmptyBlock()
+	chain.Head = block.Header
+	pool.ResetTo(block)
+
+	flipCid := func(data byte) []byte {
+		c, _ := cid.V1Builder{Codec: cid.Raw, MhType: multihash.SHA2_256}.Sum([]byte{data})
+		return c.Bytes()
+	}
+
+	require.NoError(pool.AddInternalTx(GetFullTx(1, 0, key, types.SubmitFlipTx, nil, nil, attachments.CreateFlipSubmitAttachment(flipCid(1), 0))))
+	require.Equal(mempool.DuplicateFlipError, pool.AddInternalTx(GetFullTx(2, 0, key, types.SubmitFlipTx, nil, nil, attachments.CreateFlipSubmitAttachment(flipCid(1), 1))))
+	require.Equal(mempool.DuplicateFlipPairError, pool.AddInternalTx(GetFullTx(2, 0, key, types.SubmitFlipTx, nil, nil, attachments.CreateFlipSubmitAttachment(flipCid(2), 0))))
+	require.NoError(pool.AddInternalTx(GetFullTx(2, 0, key, types.SubmitFlipTx, nil, nil, attachments.CreateFlipSubmitAttachment(flipCid(2), 1))))
+
+	require.Equal(validation.NoValidationSession, pool.AddInternalTx(GetFullTx(3, 0, key, types.SubmitAnswersHashTx, nil, nil, common.Hash{0x1}.Bytes())))
+	require.Equal(validation.NoValidationSession, pool.AddInternalTx(GetFullTx(3, 0, key, types.SubmitShortAnswersTx, nil, nil, attachments.CreateShortAnswerAttachment(nil, 100))))
+	require.Equal(validation.NoValidationSession, pool.AddInternalTx(GetTypedTx(3, 0, key, types.EvidenceTx)))
+}
@@ -2,7 +2,9 @@ package embedded
 
 import (
 	"bytes"
+	"github.com/idena-network/idena-go/blockchain/types"
 	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
 	"github.com/idena-network/idena-go/stats/collector"
 	"github.com/idena-network/idena-go/vm/env"
 	"github.com/idena-network/idena-go/vm/helpers"
@@ -15,10 +17,16 @@ const (
 	multisigInitialized   = byte(2)
 )
 
+var (
+	multisigVoteAddressPrefix = []byte("addr")
+	multisigGodTxPrefix       = []byte("godTx")
+)
+
 type Multisig struct {
 	*BaseContract
 	voteAddress *env.Map
 	voteAmount  *env.Map
+	godTxs      *env.Map
 }
 
 func NewMultisig(ctx env.CallContext, e env.Env, statsCollector collector.StatsCollector) *Multisig {
@@ -26,7 +34,14 @@ func NewMultisig(ctx env.CallContext, e env.Env, statsCollector collector.StatsC
 		ctx:            ctx,
 		env:            e,
 		statsCollector: statsCollector,
-	}, env.NewMap([]byte("addr"), e, ctx), env.NewMap([]byte("amount"), e, ctx)}
+	}, env.NewMap(multisigVoteAddressPrefix, e, ctx), env.NewMap([]byte("amount"), e, ctx), nil}
+}
+
+// NewMultisig2 creates multisig which also supports approvals of god txs
+func NewMultisig2(ctx env.CallContext, e env.Env, statsCollector collector.StatsCollector) *Multisig {
+	m := NewMultisig(ctx, e, statsCollector)
+	m.godTxs = env.NewMap(multisigGodTxPrefix, e, ctx)
+	return m
 }
 
 func (m *Multisig) Deploy(args ...[]byte) error {
@@ -65,6 +80,11 @@ func (m *Multisig) Call(method string, args ...[]byte) (err error) {
 		return m.send(args...)
 	case "push":
 		return m.push(args...)
+	case "approveGodTx":
+		if m.godTxs == nil {
+			return errors.New("unknown method")
+		}
+		return m.approveGodTx(args...)
 	default:
 		return errors.New("unknown method")
 	}
@@ -168,6 +188,31 @@ func (m *Multisig) push(args ...[]byte) error {
 	return nil
 }
 
+// approveGodTx records sender's approval of god tx with given type, recipient and optional payload,
+// the tx can be sent by any signer when the god address is this contract and minVotes signers approved it
+func (m *Multisig) approveGodTx(args ...[]byte) error {
+	if m.GetByte("state") != multisigInitialized {
+		return errors.New("contract is not initialized")
+	}
+	if m.voteAddress.Get(m.ctx.Sender().Bytes()) == nil {
+		return errors.New("unknown sender")
+	}
+	txType, err := helpers.ExtractByte(0, args...)
+	if err != nil {
+		return err
+	}
+	dest, err := helpers.ExtractAddr(1, args...)
+	if err != nil {
+		return err
+	}
+	approval := append([]byte{txType}, dest.Bytes()...)
+	if data, err := helpers.ExtractArray(2, args...); err == nil {
+		approval = append(approval, data...)
+	}
+	m.godTxs.Set(m.ctx.Sender().Bytes(), approval)
+	return nil
+}
+
 func (m *Multisig) Terminate(args ...[]byte) (common.Address, error) {
 	if !m.IsOwner() {
 		return common.Address{}, errors.New("sender is not an owner")
@@ -187,3 +232,55 @@ func (m *Multisig) Terminate(args ...[]byte) (common.Address, error) {
 	collector.AddMultisigTermination(m.statsCollector, dest)
 	return dest, nil
 }
+
+// IsInitializedMultisig checks that the address is a multisig contract with all signers added
+func IsInitializedMultisig(s *state.StateDB, addr common.Address) bool {
+	codeHash := s.GetCodeHash(addr)
+	if codeHash == nil || *codeHash != MultisigContract {
+		return false
+	}
+	return bytes.Equal(s.GetContractValue(addr, []byte("state")), []byte{multisigInitialized})
+}
+
+// GodTxApproved checks that the sender is a signer of the god multisig and at least minVotes signers
+// approved god tx of given type to the recipient with given data
+func GodTxApproved(s *state.StateDB, god common.Address, sender common.Address, txType types.TxType, to common.Address, data []byte) bool {
+	if !IsInitializedMultisig(s, god) {
+		return false
+	}
+	if s.GetContractValue(god, env.FormatMapKey(multisigVoteAddressPrefix, sender.Bytes())) == nil {
+		return false
+	}
+	approval := append(append([]byte{byte(txType)}, to.Bytes()...), data...)
+	votes := 0
+	iterateMultisigGodTxs(s, god, func(signer []byte, value []byte) {
+		if bytes.Equal(value, approval) && s.GetContractValue(god, env.FormatMapKey(multisigVoteAddressPrefix, signer)) != nil {
+			votes++
+		}
+	})
+	minVotes := s.GetContractValue(god, []byte("minVotes"))
+	return len(minVotes) > 0 && votes >= int(minVotes[0])
+}
+
+// ClearGodTxApprovals removes all god tx approvals of the multisig, so they can't be used twice
+func ClearGodTxApprovals(s *state.StateDB, god common.Address) {
+	var keys [][]byte
+	iterateMultisigGodTxs(s, god, func(signer []byte, value []byte) {
+		keys = append(keys, env.FormatMapKey(multisigGodTxPrefix, signer))
+	})
+	for _, key := range keys {
+		s.RemoveContractValue(god, key)
+	}
+}
+
+func iterateMultisigGodTxs(s *state.StateDB, god common.Address, f func(signer []byte, value []byte)) {
+	minKey := append(multisigGodTxPrefix[:0:0], multisigGodTxPrefix...)
+	maxKey := append(multisigGodTxPrefix[:0:0], multisigGodTxPrefix...)
+	for i := len(maxKey); i < common.MaxContractStoreKeyLength; i++ {
+		maxKey = append(maxKey, 0xFF)
+	}
+	s.IterateContractStore(god, minKey, maxKey, func(key []byte, value []byte) bool {
+		f(common.CopyBytes(key[len(multisigGodTxPrefix):]), value)
+		return false
+	})
+}
@@ -40,6 +40,25 @@ func NewOracleVotingContract3(ctx env.CallContext, e env.Env, statsCollector col
 	}
 }
 
+// OracleVotingStateName returns the name of the stored state of the oracle voting contract
+func OracleVotingStateName(state byte) string {
+	switch state {
+	case oracleVotingStatePending:
+		return "pending"
+	case oracleVotingStateStarted:
+		return "started"
+	case oracleVotingStateFinished:
+		return "finished"
+	default:
+		return "unknown"
+	}
+}
+
+// MinOracleVotingFund returns the contract balance required to start the voting with the committee of the given size
+func MinOracleVotingFund(committeeSize uint64, networkSize int) *big.Int {
+	return new(big.Int).Mul(minOracleReward(committeeSize, networkSize), big.NewInt(int64(committeeSize)))
+}
+
 func (f *OracleVoting3) Call(method string, args ...[]byte) error {
 	switch method {
 	case "startVoting":
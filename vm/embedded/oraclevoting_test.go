@@ -643,3 +643,9 @@ func TestOracleVoting_RewardPools(t *testing.T) {
 
 	require.Equal(t, big.NewInt(0).Mul(balance, big.NewInt(5)).String(), caller.contractTester.appState.State.GetBalance(pool2).String())
 }
+
+func TestMinOracleVotingFund(t *testing.T) {
+	require.Equal(t, new(big.Int).Mul(minOracleReward(100, 1000), big.NewInt(100)), MinOracleVotingFund(100, 1000))
+	require.Equal(t, "started", OracleVotingStateName(oracleVotingStateStarted))
+	require.Equal(t, "unknown", OracleVotingStateName(10))
+}
@@ -65,6 +65,7 @@ type EnvImp struct {
 	droppedContracts      map[common.Address]struct{}
 	events                []*types.TxEvent
 	contractStakeCache    map[common.Address]*big.Int
+	storageAccounting     bool
 }
 
 func NewEnvImp(s *appstate.AppState, block *types.Header, gasCounter *GasCounter, secStore *secstore.SecStore, statsCollector collector.StatsCollector) *EnvImp {
@@ -79,6 +80,11 @@ func NewEnvImp(s *appstate.AppState, block *types.Header, gasCounter *GasCounter
 	}
 }
 
+// SetStorageAccounting enables tracking of contract store size on commit
+func (e *EnvImp) SetStorageAccounting(enabled bool) {
+	e.storageAccounting = enabled
+}
+
 func (e *EnvImp) Epoch() uint16 {
 	e.gasCounter.AddGas(10)
 	return e.state.State.Epoch()
@@ -284,6 +290,9 @@ func (e *EnvImp) Terminate(ctx CallContext, dest common.Address) {
 
 func (e *EnvImp) Commit() []*types.TxEvent {
 	for contract, cache := range e.contractStoreCache {
+		if e.storageAccounting {
+			e.updateStorageSize(contract, cache)
+		}
 		for k, v := range cache {
 			if v.removed {
 				e.state.State.RemoveContractValue(contract, []byte(k))
@@ -308,6 +317,28 @@ func (e *EnvImp) Commit() []*types.TxEvent {
 	return e.events
 }
 
+func (e *EnvImp) updateStorageSize(contract common.Address, cache map[string]*contractValue) {
+	var delta int64
+	for k, v := range cache {
+		if prev := e.state.State.GetContractValue(contract, []byte(k)); prev != nil {
+			delta -= int64(len(k) + len(prev))
+		}
+		if !v.removed {
+			delta += int64(len(k) + len(v.value))
+		}
+	}
+	size := int64(e.state.State.GetContractStorageSize(contract)) + delta
+	if size < 0 {
+		// the store has values which are not counted yet, count the whole store
+		size = delta
+		e.state.State.IterateContractStore(contract, nil, nil, func(key []byte, value []byte) bool {
+			size += int64(len(key) + len(value))
+			return false
+		})
+	}
+	e.state.State.SetContractStorageSize(contract, uint64(size))
+}
+
 func (e *EnvImp) Event(name string, args ...[]byte) {
 	if !eventRegexp.MatchString(name) {
 		panic("event name should contain only ASCII characters. Length should be 1-32")
@@ -155,3 +155,47 @@ func TestEnvImp_basicMethods(t *testing.T) {
 	})
 	require.Equal(t, 0, cnt)
 }
+
+func TestEnvImp_storageAccounting(t *testing.T) {
+	db := db2.NewMemDB()
+	appState, _ := appstate.NewAppState(db, eventbus.New())
+
+	contract := common.Address{0x1}
+	tx := &types.Transaction{
+		To:   &contract,
+		Type: types.CallContractTx,
+	}
+	key, _ := crypto.GenerateKey()
+	tx, _ = types.SignTx(tx, key)
+	ctx := NewCallContextImpl(tx, common.Hash{0x1})
+
+	header := &types.Header{ProposedHeader: &types.ProposedHeader{Height: 1}}
+	env := NewEnvImp(appState, header, &GasCounter{gasLimit: -1}, secstore.NewSecStore(), nil)
+	env.SetStorageAccounting(true)
+
+	env.SetValue(ctx, []byte{0x1}, []byte{0x1, 0x2, 0x3})
+	env.SetValue(ctx, []byte{0x2, 0x2}, []byte{0x1})
+	env.Commit()
+	env.Reset()
+	require.Equal(t, uint64(7), appState.State.GetContractStorageSize(contract))
+
+	env.SetValue(ctx, []byte{0x1}, []byte{0x1})
+	env.RemoveValue(ctx, []byte{0x2, 0x2})
+	env.RemoveValue(ctx, []byte{0x3})
+	env.Commit()
+	env.Reset()
+	require.Equal(t, uint64(2), appState.State.GetContractStorageSize(contract))
+
+	env.SetStorageAccounting(false)
+	env.SetValue(ctx, []byte{0x4}, []byte{0x4})
+	env.Commit()
+	env.Reset()
+	require.Equal(t, uint64(2), appState.State.GetContractStorageSize(contract))
+
+	// removing more than counted recounts the store instead of dropping the rest of values
+	appState.State.SetContractStorageSize(contract, 1)
+	env.SetStorageAccounting(true)
+	env.RemoveValue(ctx, []byte{0x1})
+	env.Commit()
+	require.Equal(t, uint64(2), appState.State.GetContractStorageSize(contract))
+}
@@ -36,7 +36,9 @@ type VmCreator = func(appState *appstate.AppState, block *types.Header, store *s
 
 func NewVmImpl(appState *appstate.AppState, block *types.Header, store *secstore.SecStore, statsCollector collector.StatsCollector, cfg *config.Config) VM {
 	gasCounter := new(env2.GasCounter)
-	return &VmImpl{env: env2.NewEnvImp(appState, block, gasCounter, store, statsCollector), appState: appState, gasCounter: gasCounter,
+	env := env2.NewEnvImp(appState, block, gasCounter, store, statsCollector)
+	env.SetStorageAccounting(cfg.Consensus.EnableContractStorageRent)
+	return &VmImpl{env: env, appState: appState, gasCounter: gasCounter,
 		statsCollector: statsCollector, cfg: cfg}
 }
 
@@ -63,6 +65,9 @@ func (vm *VmImpl) createContract(ctx env2.CallContext) embedded.Contract {
 		}
 		return embedded.NewRefundableOracleLock(ctx, vm.env, vm.statsCollector)
 	case embedded.MultisigContract:
+		if vm.cfg.Consensus.EnableGodMultisig {
+			return embedded.NewMultisig2(ctx, vm.env, vm.statsCollector)
+		}
 		return embedded.NewMultisig(ctx, vm.env, vm.statsCollector)
 	default:
 		return nil
@@ -0,0 +1,254 @@
+package watchlist
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/common/eventbus"
+	"github.com/idena-network/idena-go/core/appstate"
+	"github.com/idena-network/idena-go/events"
+	"github.com/idena-network/idena-go/log"
+	"github.com/pkg/errors"
+)
+
+const (
+	Folder = "watchlist"
+
+	// MaxAddresses limits the number of watched addresses
+	MaxAddresses = 1000
+
+	webhookTimeout = 5 * time.Second
+	// webhookQueueSize limits the number of changes waiting for delivery, changes are dropped when the queue is full
+	webhookQueueSize = 10000
+	// blockQueueSize limits the number of blocks waiting for tracking, blocks are skipped when the queue is full
+	blockQueueSize = 100
+)
+
+// Entry is a watched address, changes of the address are posted to the webhook if it is set
+type Entry struct {
+	Address common.Address `json:"address"`
+	Webhook string         `json:"webhook,omitempty"`
+}
+
+type webhookCall struct {
+	url    string
+	change *Change
+}
+
+// Manager keeps the persisted list of watched addresses and posts changes of addresses with webhooks
+type Manager struct {
+	datadir  string
+	list     []*Entry
+	mutex    sync.Mutex
+	appState *appstate.AppState
+	tracker  *Tracker
+	blocks   chan *types.Block
+	queue    chan *webhookCall
+	client   *http.Client
+	log      log.Logger
+}
+
+func NewManager(datadir string, bus eventbus.Bus, appState *appstate.AppState) (*Manager, error) {
+	m := &Manager{
+		datadir:  datadir,
+		appState: appState,
+		tracker:  NewTracker(),
+		blocks:   make(chan *types.Block, blockQueueSize),
+		queue:    make(chan *webhookCall, webhookQueueSize),
+		client:   &http.Client{Timeout: webhookTimeout},
+		log:      log.New("component", "watchlist"),
+	}
+
+	file, err := m.openFile()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &m.list); err != nil {
+			m.log.Warn("cannot parse watchlist.json", "err", err)
+		}
+	}
+
+	// states are loaded and changes are tracked by the loop to not hold up the bus
+	bus.Subscribe(events.AddBlockEventID, func(e eventbus.Event) {
+		block := e.(*events.NewBlockEvent).Block
+		select {
+		case m.blocks <- block:
+		default:
+			m.log.Warn("block queue is full, block is not tracked", "height", block.Height())
+		}
+	})
+	go m.trackLoop()
+	go m.postLoop()
+	return m, nil
+}
+
+// Add starts watching the address, changes are posted to the webhook if it isn't empty
+func (m *Manager) Add(addr common.Address, webhook string) error {
+	if webhook != "" {
+		u, err := url.Parse(webhook)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.New("webhook should be an absolute http or https URL")
+		}
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, e := range m.list {
+		if e.Address == addr {
+			return errors.New("address is already watched")
+		}
+	}
+	if len(m.list) >= MaxAddresses {
+		return errors.Errorf("too many watched addresses, max %v", MaxAddresses)
+	}
+	m.list = append(m.list, &Entry{
+		Address: addr,
+		Webhook: webhook,
+	})
+	return m.persist()
+}
+
+// Remove stops watching the address
+func (m *Manager) Remove(addr common.Address) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for i, e := range m.list {
+		if e.Address == addr {
+			list := make([]*Entry, 0, len(m.list)-1)
+			list = append(list, m.list[:i]...)
+			m.list = append(list, m.list[i+1:]...)
+			return m.persist()
+		}
+	}
+	return errors.New("address is not watched")
+}
+
+// List returns watched addresses in the order of addition
+func (m *Manager) List() []Entry {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := make([]Entry, 0, len(m.list))
+	for _, e := range m.list {
+		result = append(result, *e)
+	}
+	return result
+}
+
+// Addresses returns watched addresses
+func (m *Manager) Addresses() []common.Address {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := make([]common.Address, 0, len(m.list))
+	for _, e := range m.list {
+		result = append(result, e.Address)
+	}
+	return result
+}
+
+func (m *Manager) trackLoop() {
+	for block := range m.blocks {
+		m.handleBlock(block)
+	}
+}
+
+func (m *Manager) handleBlock(block *types.Block) {
+	webhooks := make(map[common.Address]string)
+	var addresses []common.Address
+	m.mutex.Lock()
+	for _, e := range m.list {
+		if e.Webhook != "" {
+			webhooks[e.Address] = e.Webhook
+			addresses = append(addresses, e.Address)
+		}
+	}
+	m.mutex.Unlock()
+	if len(addresses) == 0 {
+		return
+	}
+	blockState, err := m.appState.State.Readonly(int64(block.Height()))
+	if err != nil {
+		m.log.Warn("cannot read state of the block", "height", block.Height(), "err", err)
+		return
+	}
+	prevState, err := m.appState.State.Readonly(int64(block.Height() - 1))
+	if err != nil {
+		// addresses which are already tracked don't need the previous state
+		prevState = blockState
+	}
+	for _, change := range m.tracker.Track(block, prevState, blockState, addresses) {
+		select {
+		case m.queue <- &webhookCall{url: webhooks[change.Address], change: change}:
+		default:
+			m.log.Warn("webhook queue is full, change is dropped", "address", change.Address.Hex(), "type", change.Type)
+		}
+	}
+}
+
+func (m *Manager) postLoop() {
+	for call := range m.queue {
+		if err := m.post(call); err != nil {
+			m.log.Warn("cannot post change to webhook", "url", call.url, "err", err)
+		}
+	}
+}
+
+func (m *Manager) post(call *webhookCall) error {
+	data, err := json.Marshal(call.change)
+	if err != nil {
+		return err
+	}
+	resp, err := m.client.Post(call.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("unexpected status %v", resp.Status)
+	}
+	return nil
+}
+
+func (m *Manager) persist() error {
+	file, err := m.openFile()
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	data, err := json.Marshal(m.list)
+	if err != nil {
+		return err
+	}
+	if err := file.Truncate(0); err != nil {
+		return err
+	}
+	if _, err := file.Write(data); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Manager) openFile() (*os.File, error) {
+	dir := filepath.Join(m.datadir, Folder)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return os.OpenFile(filepath.Join(dir, "watchlist.json"), os.O_RDWR|os.O_CREATE, 0666)
+}
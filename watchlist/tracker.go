@@ -0,0 +1,131 @@
+package watchlist
+
+import (
+	"math/big"
+
+	"github.com/idena-network/idena-go/blockchain"
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	BalanceChange  = "balance"
+	IdentityChange = "identity"
+	IncomingTx     = "tx"
+)
+
+// Change describes a change of a watched address made by a block, fields which don't belong to the type are omitted
+type Change struct {
+	Address     common.Address   `json:"address"`
+	Type        string           `json:"type"`
+	Height      uint64           `json:"height"`
+	PrevBalance *decimal.Decimal `json:"prevBalance,omitempty"`
+	Balance     *decimal.Decimal `json:"balance,omitempty"`
+	PrevState   string           `json:"prevState,omitempty"`
+	State       string           `json:"state,omitempty"`
+	TxHash      *common.Hash     `json:"txHash,omitempty"`
+	From        *common.Address  `json:"from,omitempty"`
+	Amount      *decimal.Decimal `json:"amount,omitempty"`
+}
+
+// Tracker keeps the last seen balances and identity states of addresses to detect their changes block by block
+type Tracker struct {
+	balances map[common.Address]*big.Int
+	states   map[common.Address]state.IdentityState
+}
+
+func NewTracker() *Tracker {
+	return &Tracker{
+		balances: make(map[common.Address]*big.Int),
+		states:   make(map[common.Address]state.IdentityState),
+	}
+}
+
+// Track returns changes of the addresses made by the block, blockState is the state after the block and prevState
+// is the state before it. The previous state is read only for addresses which aren't tracked yet, addresses which
+// aren't passed stop being tracked.
+func (t *Tracker) Track(block *types.Block, prevState, blockState *state.StateDB, addresses []common.Address) []*Change {
+	var result []*Change
+	balances := make(map[common.Address]*big.Int, len(addresses))
+	states := make(map[common.Address]state.IdentityState, len(addresses))
+	payments := incomingPayments(block)
+	for _, addr := range addresses {
+		prevBalance, ok := t.balances[addr]
+		if !ok {
+			prevBalance = prevState.GetBalance(addr)
+		}
+		prevIdentityState, ok := t.states[addr]
+		if !ok {
+			prevIdentityState = prevState.GetIdentityState(addr)
+		}
+		for _, p := range payments[addr] {
+			txHash, from, amount := p.txHash, p.from, blockchain.ConvertToFloat(p.amount)
+			result = append(result, &Change{
+				Address: addr,
+				Type:    IncomingTx,
+				Height:  block.Height(),
+				TxHash:  &txHash,
+				From:    &from,
+				Amount:  &amount,
+			})
+		}
+		balance := blockState.GetBalance(addr)
+		if balance.Cmp(prevBalance) != 0 {
+			prev, current := blockchain.ConvertToFloat(prevBalance), blockchain.ConvertToFloat(balance)
+			result = append(result, &Change{
+				Address:     addr,
+				Type:        BalanceChange,
+				Height:      block.Height(),
+				PrevBalance: &prev,
+				Balance:     &current,
+			})
+		}
+		identityState := blockState.GetIdentityState(addr)
+		if identityState != prevIdentityState {
+			result = append(result, &Change{
+				Address:   addr,
+				Type:      IdentityChange,
+				Height:    block.Height(),
+				PrevState: prevIdentityState.Name(),
+				State:     identityState.Name(),
+			})
+		}
+		balances[addr] = balance
+		states[addr] = identityState
+	}
+	t.balances, t.states = balances, states
+	return result
+}
+
+type payment struct {
+	txHash common.Hash
+	from   common.Address
+	amount *big.Int
+}
+
+// incomingPayments returns payments of the block txs grouped by recipients, every payment of a multi send tx
+// is a separate payment
+func incomingPayments(block *types.Block) map[common.Address][]payment {
+	if block.IsEmpty() {
+		return nil
+	}
+	result := make(map[common.Address][]payment)
+	for _, tx := range block.Body.Transactions {
+		sender, _ := types.Sender(tx)
+		if tx.Type == types.MultiSendTx {
+			if attachment := attachments.ParseMultiSendAttachment(tx); attachment != nil {
+				for _, p := range attachment.Payments {
+					result[p.To] = append(result[p.To], payment{tx.Hash(), sender, p.Amount})
+				}
+			}
+			continue
+		}
+		if tx.To != nil {
+			result[*tx.To] = append(result[*tx.To], payment{tx.Hash(), sender, tx.AmountOrZero()})
+		}
+	}
+	return result
+}
@@ -0,0 +1,62 @@
+package watchlist
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/idena-network/idena-go/blockchain/attachments"
+	"github.com/idena-network/idena-go/blockchain/types"
+	"github.com/idena-network/idena-go/common"
+	"github.com/idena-network/idena-go/core/state"
+	"github.com/idena-network/idena-go/tests"
+	"github.com/stretchr/testify/require"
+	db "github.com/tendermint/tm-db"
+)
+
+func TestTracker_Track(t *testing.T) {
+	require := require.New(t)
+	stateDb, _ := state.NewLazy(db.NewMemDB())
+	addr, addr2, other := tests.GetRandAddr(), tests.GetRandAddr(), tests.GetRandAddr()
+	stateDb.SetBalance(addr, big.NewInt(10))
+	stateDb.Commit(true)
+
+	stateDb.AddBalance(addr, big.NewInt(5))
+	stateDb.AddBalance(addr2, big.NewInt(7))
+	stateDb.SetState(addr2, state.Candidate)
+	stateDb.Commit(true)
+
+	prevState, _ := stateDb.Readonly(1)
+	blockState, _ := stateDb.Readonly(2)
+	block := &types.Block{
+		Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 2}},
+		Body: &types.Body{Transactions: []*types.Transaction{
+			{Type: types.SendTx, To: &addr, Amount: big.NewInt(5)},
+			{Type: types.SendTx, To: &other, Amount: big.NewInt(1)},
+			{Type: types.MultiSendTx, Amount: big.NewInt(7), Payload: attachments.CreateMultiSendAttachment([]*attachments.MultiSendPayment{
+				{To: addr2, Amount: big.NewInt(7)},
+			})},
+		}},
+	}
+
+	tracker := NewTracker()
+	changes := tracker.Track(block, prevState, blockState, []common.Address{addr, addr2})
+	require.Len(changes, 5)
+	require.Equal(IncomingTx, changes[0].Type)
+	require.Equal(addr, changes[0].Address)
+	require.Equal(block.Body.Transactions[0].Hash(), *changes[0].TxHash)
+	require.Equal(BalanceChange, changes[1].Type)
+	require.Equal(addr, changes[1].Address)
+	require.Equal(IncomingTx, changes[2].Type)
+	require.Equal(addr2, changes[2].Address)
+	require.Equal(BalanceChange, changes[3].Type)
+	require.Equal(IdentityChange, changes[4].Type)
+	require.Equal("Undefined", changes[4].PrevState)
+	require.Equal("Candidate", changes[4].State)
+
+	// tracked values are compared with the state of the next block
+	emptyBlock := &types.Block{
+		Header: &types.Header{ProposedHeader: &types.ProposedHeader{Height: 3}},
+		Body:   &types.Body{},
+	}
+	require.Empty(tracker.Track(emptyBlock, prevState, blockState, []common.Address{addr, addr2}))
+}